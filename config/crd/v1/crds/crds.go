@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbeŽ\x04~\x9b\xf5x\x11a7sƎ\xe3<\x04y\xa0\xbaK\x12\xcf\xddd\x87dˣ\x04\xf9\xefA\x15\xc9\xfeR\u007fP^\xcf\xdd^0|\x99q\x8b,\x16\xab\x8a\xf5\xc5\"\xafV\xabՕ\xa8\xe43\x1a+\xb5\xba\x03QI\xfc\xeaP\xd1_v\xfd\xf2\xcfv-\xf5\xed\xf1\x87\xab\x17\xa9\xf2;\xb8\xaf\xad\xd3\xe5\xafhum2\xfc\x84;\xa9\xa4\x93Z]\x95\xe8D.\x9c\xb8\xbb\x02\x10Ji'賥?\x012\xad\x9c\xd1E\x81f\xb5G\xb5~\xa9\xb7\xb8\xade\x91\xa3a\xe0q\xea\xe3\x9f\xd6\u007f^\xff\xe9\n 3\xc8ßd\x89։\xb2\xba\x03U\x17\xc5\x15\x80\x12%\xde\xc1Vd/ue\xd7G,\xd0\xe8\xb5\xd4W\xb6\u008c\xe6\xda\x1b]Ww\xd0\xfe\xe0\x87\x04<\xfc\x1a~\xe4\xd1\xfc\xa1\x90\xd6\xfd\xdc\xf9\xf8\x8b\xb4\x8e\u007f\xa8\x8aڈ\xa2\x99\x89\xbfY\xa9\xf6u!L\xfcz\x05`3]\xe1\x1d|\xa6)*\x91a~\x05\x10\x96\xc3S\xae\x02\xc2\xc7\x1f<\x84쀥\xf0\xb8\x00\xe8\n\xd5\xc7\xc7\xcd\xf3\x9f\xbf\xf4>\x03\xe4h3#+\xc7D\U00048074 \xe0\x99\x97\x05&\x90\x1f\xdcA80X\x19\xb4\xa8\x9c\x05w@\xc8D\xe5j\x83\xa0w\xf0s\xbdE\xa3Сm@\x03dEm\x1d\x1a\xb0N8\x04\xe1@@\xa5\xa5r \x158Y\"\xfc\xe1\xe3\xe3\x06\xf4\xf6\xaf\x989\vB\xe5 \xacՙ\x14\x0es8\xea\xa2.я\xfd㺁Z\x19]\xa1q2\xd2ٷ\x8eTu\xbe\x0e\x96\xf7\x81(\xe0{AN\xe2\x84~\x19\x81\x8a\x98\a\xa2\xd1z\xdcA\xdav\xb9,!=\xc0@\x9d\x84\nȯ\xe1\v\x1a\x02\x03\xf6\xa0\xeb\"')<\xa2!\x82ez\xaf\xe4\u007f7\xb0-8͓\x16\xc2a\x10\x80\xb6I\xe5\xd0(Q\xc0Q\x145\xde0IJq\x02\x834\vԪ\x03\x8f\xbb\xd85\xfc\xab6\bR\xed\xf4\x1d\x1c\x9c\xab\xec\xdd\xed\xed^\xba\xb8\x9b2]\x96\xb5\x92\xeet\xcb\x1bCnk\xa7\x8d\xbd\xcd\xf1\x88ŭ\x95\xfb\x950\xd9A:̈\x91\xb7\xa2\x92+F]\xf1\x8eZ\x97\xf9?E\x01\xb0\x1fz\xb8\xba\x13\t\xa3uF\xaa}\xe7\a\x96\xfa\x19\x0e\xd0\x06\xf0\xf2\xe5\x87\xfaU\xb4\x84\xa6OD\x9d_\x1f\xbe<ueO\xda!\xf5\x99\xee\x1d\x81lY@\x04\x93j\x87\xc63qgt\xc90Q\xe5^\xfaXt\v\x89jH~[oK\xe9\x88\xef\xffU\xa3%!\xd7k\xb8g\x15\x03[\x84\xba\xcaI2װQp/J,\xee\x85\xc5o\xce\x00\xa2\xb4]\x11a\xd3X\xd0Վ\xc3Ξj\x9d\x1f\xa2.\x9b\xe0\x97W\b_*\xccz\x1b\x86Fɝ\xccx[\xc0N\x9bV_xu\xb5\xee\x81\x1c߲~\xb2\x9d\xa8\v\xf7\xcc[\xdd>\xe9_\xd1:\x99\r{\r\x90\xfa4:(\"\x85\x16^\x0f\xe8\x0ehH~\xf8\aޒg0\x81Yj1\xe7\x1d)^\x10D\xc0\x9e\xb7vQ@\xa5\xa3\x16\xb2\xb0=Ed\xd7g\x90<m\xb7Z\x17(\x86Z\x02\xbffE\x9dcި\xed3\x1a\fV\xf7p6\x80M\x9a\x90\x8av\r\x19\x11BO\xb5\xbf\x92b\x1eY\x9c0\b$\xb7Ryx\xacs\x0f8\xca j\xd2a9\x82ۤ\x98\xf9F\xa6Rl\v\xbc\x03gj\x9c\xa0\x8c0F\x9c&\xe8\x12\xcd{*Y\x9a\xfeA\x8b\x142c\xfb\xd3\xe8\n\xa6\x8c\xb7V\u009cc\x04\xbfg\xa2\x1c\xb4~Y\"ĿP\x9fV\xefA\xc6^\x12l\xf1 \x8eR\x9b\xb0\xf4`\x86\xb6\b\xf8\x15\xb3\xda\xe1\x98\xfc\v\a\xb9\xdc\xed\xd0\x10\x9c\xea ,Zo\xfa\xa6\t2\xbd\x95\xa9\x99if\x9e\xad\xa3e$I*\xaf|\nu\xda\xd0\xc3}\x15\x1b!JF\x83\xdc\x16\x95ˣ\xcckQ\x80T\xd6\t\x95\xf9\xf5\x88\x06\xaf\xf3\xf5\xc0\x1c\x93\xcfp\xf6\xea0bN\x9c\xe8\xa9F\xad\x10\xb4\x81\x92\xec\xc1yס\x05k\xdbԲ\xb7\x82\xb4\x93\xf6\"j\xea\x02m\x98*g\x9d\xdbꀛI\xd0\rG\xbc/Q\x88-\x16`\xb1\xc0\xcci3N\x8e%&\xfb\x96\xa2\xd7&\xa88\xa2\xe1Z\xddMKm\x176\x03\x12Hm\xbf\x1edv\xf0f\x9e$\x88\xe1@\xae\xd1\xf2.\x17UU\x9c\xa6\x16\tK\x9c\x0f\x93\xccm\xf4\xb6-l\xf9!\xbc\xb1\xcd߶\x04\xddض\x05-٧l#\x0e\xe0\xf4\xec\xb2\xff\u007f\x126\xaa\xfd7\b\xed\xe6l\xe8\xfb\n-\x91T\x92;\xbf\xd9\x01\x96\x95;݀t\xf1\xeb\x12DrV\xda\xf9\xff\x81\x19s\xb9\xc4o\x86#\xdfU\xe2g\xb9\xb2\x04\x91\xb8\xd2L\xff\x0f\xc8\x146\x16_\x82\xadHf\xc8/\xddQ7 w\rC\xf2\x1b\xd8\xc9¡\x19p\xe67\xed\x97\xf7 F\x8a\xbd\xa3V\n\x97\x1d\x1e\xbe\x92\xe7eۄS\"]\x86\x83\xbd\xff\x1a\xfd\xf9\xbea^\x80\v\x1c\xa0J\x83\xa5\x0f|\x9f\x98\x9a\xed\x17\xf6\xa8>~\xfe\x84\xf9\x1cy M\xf2\xce\x16\xf2q\x80lw\xea\xe0\x94\xa7.#\xb8>M|\xe3S\x1a7 \xe0\x05O\xdec\x11\n\x889\x82&\x9a\x88tΉù\x15\x16\xb2\x17<1\x98\x90,Y\x1c\x9d*\n\xbe\xbd\xe0)\xa5ۀ\x80\x84\x93\xb4!\tD\x94\xa4\x0fL\b\x8e\xadӉ\a\x9c\xf8\x8a\xbahyq\x90\xaeHb\x8b\xb4\u007f\xc32\x1b\xb6u\x92\x86\xcc\xd8\x0fֳ\x88v\xc1AV\x89\v%3\a\x16y\xb7\xc4\xd4׳(d\xdeL\xe4\xe5~\xa3\xa6\xbd\xe1~\xfb\xac\xddF\xdd\xc0\xc3WiC\xf6\xf1\x93F\xfbY;\xfe\xf2M\xc8\xe9\x11\u007f\x031\xfd@\xde^ʫm\xa2C7\x87\x96 ܾm|\x84װGZ\xd8(\x8a[\x02=8#\ua9db\xb7\x0f\xfdV֖\x93dJ\xab\x15\x9b\xca\xf5\xd8L\x9e؉ \xb5\xe9q\xe4\x1c\xb5fR?a\"\xd8'\xb2$~\xbc\xcf\xf1\x16\"\xc3\x1c\xf2\x9a\x89əI\xe1p/3(\xd1\xec\xe7\fG\xb7U\xa4\xdf\xd3PHԺ\xbe](ai\xa6=\xb6\xa0\xba\xf3edV\xb4s\x13zEf/v\x9dHHNw]^\x11\x9bX\xf6?\x16\xa9+\xf2\x9cϒD\xf1x\x81ƿ\x80\x17\xe7\xb6\xdf#\xe6-d)8\xc9\xf8?d\xe6X\xa0\xff\x17*!M\xc2\x1e\xfe\xc8GC\x05\xf6Ɔ,Vw\x1a\x9aAZ \xfe\x1eEq\x9e\xea\x1eY\x9c&݂\x857\xe4zw\xe6\xb1\xdc\xc0\xebA[oSw\x12GS\xaa\xfd&-\\\xbf\xe0\xe9\xfa\xe6L\x0f\\oԵ7\xf0\x17\xab\x9b\xc6[Ъ8\xc15\x8f\xbd\xfe-NP\xa2$&u\xe3#\xb8TW\x99b\xc9\xe8\t\xd0\xc0\xe6܉\xdc\xdc9\xac\x93\xe4\xb0\xd2\xd6%\xa3\xf2\xa8\xad\xf3\x99Ş[zI\x16\v\xbc\f\x85\xec\x15\x88\x9d?\xf9\xd3&\x9e\xe9\x90\xda\x1b$\\\x89kv^\xc3\x12\x1b\x9b\x8c\x98\aJ\x81\xd5u\xbb\x83\xbd>\xbd\xf6\a=<\x89\xc8عX\x84[\x19\x9d\xa1\xb5\xf3\"\x92\xa0\xad\x17\x92\x84M\x82P\xf8\x00\xc6\x1f\x98\xcc'%cKwH\x89H\x17\xba\xf2\x0f_;\xd9K\xda\xfc\xf4\xf7\x92\xf0]\x8a\x17\xf0\x9e-K1<\x19LB\xf1ޏ\x8c\xdb$\x00\xf2\xa1\x81\xd9\u05fc\xd5\xd3=\xc8 H\xbf\a3]J\xb5\xe1\t\xe0\x87w7덒ķ8\xee\xf7qlK\xf4\xe6\x03\xef\xdeT\x8fHs\xe6\xde`\x8fs\xe7ynr\x14\x13A*\xed\xba\xe9\x04\x82[\xe9\xfc\x83\x85\x9d4\xd6u\x11M\x15\x8aza\xf7\xb7\xed\xd2\xc8I=\x18\xf3\xa6\xc0\xe9/~d'\x91uЯ\xf1|u\xf20s\xac\xf1\xa1\x10\x82܁t\x80*ӵ\xe2\xf4\vmu\x9e³\xc0+\xe8d\x92\xa5)\bj\xa8\xea2\x8d\x00+\x96:\xa9f\xf34\xdd\xee?\tY|\v\xb69Y\xa2\xaeg\rg\xdbzl{\xf2#{\a\xe5\xa5\xf8*˺\x04Q\x12\xe9SÞ\x9d/\x8e\xe9q\x1c^\x85tl9\b.\x9b\x11\xa7iSU\x05\xba\xd4\x1d\xb9ŝ6\xbc\x9f\xaḏ1\xccA\n\xb4\x02\x01;!\x8b\xda$jȋh{I\xac\x11\x94\xc5\xfb\x05\x11i\x93\xaf\x98\x14\t\x89\xd8Dgq^[W&\xddU|4\x98\xe6\x9e-%\xa5\xa3{V\x19I\xb2\xa4\xdf\xdbC\v\"&\xd4黋v־\xbbh\v\xed\xbb\x8b6پ\xbbh\xcb\xed\xbb\x8b\x16\xdaw\x17-\xb6\xef.\xdaw\x17m\xaeۜ\xb6^\xc2\xc8W\xdcO\xfc\xb8\x88E\xc2\xf1\xf4\x1c\x8a3\xf0C5Ž\xaf\xbeO\xad\xb0܌\x8f\x1a\xa9\xab\re\xfd+\xbe\x910&\x01m\xd1EkJ\x9a\x92K\xda Q\xbc}\x01\xf1B\x11fR9\xe5x\xf5mJ\xc1\xcfR\x99O\xbfδ)\xb3\x89\x85\xa6:N2B\x87x\xb3\x81\xdc\xden\rI\xbf^\x87\xfd܈\xe9߽\x065\xa1\x14g\xa1\x00g\xbe0w\x8e^\x83УO0\xd3+\x18\xfd\xdd\xd0k\xa1Jf\xba6&\x9c\x04\xa1\x13\xc7\x1f\xd6\xfd_\x9c\x0e\x952\xf0*\xddad)\xaf\aT|\x86\xa5\xf6ݲ\xd7(o\xe1\x8aɐ\x8e\xa0\r(Y09g\xa4\xb5G^\xf8K\xe5C\xb8\x8b\xf7\xe5|\xf8\x91VK\xf3\xe6\n\x9a~\x85̄\x8a\xbe\xf4\xc8(\xbdP8\xbdFf\xbe\xa8\xe5\x92ʘa\xdd\xcb$\xd0\xe5z\x98\x94\xc8q\xa1\xf6\xe5\r\x15/\x89Վ\xbf\xf9`,\xa5\xa6\xe5M\x95,\x8b\x05\x81\x89\xf5+\xfdʔy\x90\x17T\xad$\x11g\xb9B\xe5⺔P\a2\xbb\x8e\xe4j\x94\x91:\x93Y\xc0\x935(s\xd5%\vY\xa9\xf3ʓ\xf4\x9a\x92Y\xd0\\o\xb2\\I\xf2~\xf5\xa2\xef\xe1\x03O\xab\x9a\xc5j\x90E\x1fy\x1e\xbf\xc5z\x8fK\xaa<\x16)\xf6Ɗ\x8e\xa6bcb\xdeK\xeb8\xfau\x1a\x13@S\xaa7&\xaa3& \xce\xd6l\xa4\xd6dL\xc0^0\xbb\xb3R2\xf3\xe3\xf8EHX\xb4o\xc5\xdfJ\xa2\u07ba0mr4\xb3\x1ez*\x9a\xb3(\xf63^\x839\ae\xf6\xf1\xe2$\xf5\xeaz\xfdc,\xd7MIx\x06?K\x95{9!A\xef\xf8\t|Q\x98\x8bb\x1aw\xa5\xf5\xf7Ɓ\x0e\"\r\x8b\x950|\x93|{\xf2\xd9\n\xbb\x86\a\x91\x1d\xfa\x1d\xe1 ,Ť\xe5\xa8\x1bv݄i\xb7q\x14}\xb9^\x03\xfc\xa4\x9bH\xb8{\xcd\xcaʲ*NP[\x84\xeb\xfe\x90\xb7\xc5\x1c\xa3\x12`\x95\xa8\xecA\xc7;\xb0\vaǗ~\uf448>ހ\xcd\n]\xe7\r\xf4\t\xe6\tu\x82\xc7g\xf6}\xf8\xee`\xd6ޣ\f\xfeM\x8c$\x86\xd7,\u007f|\xff\b\xdf:m\xc4\x1e\u007f\xd1\xfe2\xf2\x12%\xfa\xbd{7у\x0e\x8b\x19\xb7X\x90%F\x88\x10\xaeE\x0f\x80\xb5\x89\xf4\xb0\x1b\xda\xe4\aa9\xa6\xdef\xf6\x9fs\xc5\xc2b\x9e\x9e~\xf1\vp\xb2\xc4\xf5\xa7\xdagSV\x950\x16\x89\x9aqa~Ж\xfe{Яc\nO\x875\xff8\xc4\xdb '\xeb9is\x11\xf6\xfe\xdat\x14\xbcH\xa2%A}\x1e\x1f\xd5\t\xf4:L\xf2\xbb\\\x8f\x9dKL\xc1\xe9\xbc.A\x81\xb5/\xb6{\u07fb\xbfS\x1e\xcb\xd4\xfd{'\\m\x97o\xe0s\xb7\xf8\xdeF8\xf2\xa9\r_\xdc\xf5 \xfcE\xd77]\xc2\x0f\x19\xea\xde\x1b(\xf3|\xba?\x1f\xc1/]\x98ܣƹ\xf1\xe66\xfd\xab\xb0M\x16|\xd4ķ\xe0\xfcH\xf6\xa0\t\x1a\xe6\x80GT\xa0\x15'\xbd\xf9J\xac\u007f\x8de8f,\x99ԁ\x12\xb2\xeauUh\x91\xc7\x1d\x1emVx\xc1\xe3\x89\xf5\x979\xa2\xf9`g`\xf2\x8b\x01;mƈp\xae0\xbda\xb9\x83\\8\\\x8d\x02M\xd2}\xa3\xc2\xc6\xe7C\x8b\xf7湓\xdfA|\xb8\x14\x1f7\xf0\xa7K%Z+\xf6\xf1\xc2\xfc+)\xb0=*d\xbb9\xb2\xfe\x10ϴ\xa7\x11\xfd\xeb\xe2>\xa5\"2W\x8b0A\xcc&uz}\x183+\x85\xde\xc3N\x16\xdc5<\xed\x114\xfb\x94ڑ\xca\xe1\x1e\x87\xee*~\xad\xa4I\xb1\x04\x0fMG\xa2\r\xe7\xd3X\x1b\xb4O\xe0`!\xf7\x92\xd4(1{/\xccV\xecq\x95\xe9\xa2@.]8\xc7\xeb[\xf2\xda\xc3\x1e}\xe2\xe6li?u\xfbF\u007f*\b\xbb\x87\x13_\xbc\xb9\t\x16z\xdcA-\xc5_\xb5\xb9\x81R*\xfa\x87\xdc0\x8eL\xe3\xe0\x8b\xec\x01\xbf.\xb0\x80\xf7#\xf5i\x0e\xb4;\xda\r\xa3\x98M\xf9\x0f㇘+\xf8\x8c\xe7\xe6ΟKbι\x97\xb1w}\xa8\xcbF=\x1a\xbd\xa7\xd0i\xe4\xc7\xfb\xa8\xcbF~{\x14\xc6IQ\x14'?\xc9\xe4\xec#?|B\xd2&\x93&e\x9c\xac\x01\xcb%ʆnm\x9c&\x95\x97\x04>Q\xdc\xea\xda\xf56h\xbb\xc1G\xc4\"ι\x86\xcf\xdaaL\xe3\xc9>L\xb2\xabh\xdd\nw;m\x9c\x0f\vW+\x90\xbb`\xa2F\xe0\x92\x86\xe7Ե\u007ff\a\xa4k\xd3'\xad\xf4\xb2\xf7iPX\x96^Ǐ\xfd\xf0\xe9\x92\xc82\xf2\x80\xf0\xd6:Q\x8ch\x8dߔ\xadf_\x80\xa4\x0f\xf3\u007f\x1b1\x8eg\x04\xdft\xfb7%\xfeu\xb9\xf5\xd1\x11\x83\xf3\x94\xe3\xd3\u007f\xaf1\x8b\xa9<\xca\x16Q\xc1\xab\x91Α\x96\xea\xe6\xf6\xc1\x91^*\n\xb0\x1avb\xe2A\x879}ɿk'\x8a\xcdtN\xa9\xeft6\x9d\xe3\xb2x\xf8\xf9\xe24\xb1e\xcb$\x98X\x96\xaf.\x936\x8e%Vf\a\xa1\xf6$TF\xd7\xfbC\x94\xcb\t{3\x95\x89\xaf\t)\xa8\x8azO\xa2\x1er\xe3\xae6\xaa\x13\x17\x87ly\xdeAWd/\x93\x98\x86\xec`|\xea\xed6<Ѱ\xda\x19]\xae\x02/8op\x13\xe2U#59e\x14]M\x00m\xefB\xb3\x18T\x15*\x106\xe0\x93P\xfa6\xcfֹ\xe0\xd1\t\xe3R]\xbd/\xbd\xce\v^\x1eC\x1e\xc7\xf7K\x88\xc6}\t\xe0\xfd\xf0\xd1=\x8a\x9bU|e\xceG\xfb^\x14,9\u007f\x069\x80\x1a=\xaf8s\xdbzNZ\x1f\xfd\xbf\xad\u007fvl,\xccC\x8a\xa7\xf6<\xe8>8G\xa5]\xdeB\f\xde\xd5\b=\xfe w\xfe %#\xac\xff\xf8w?\x1f=&\xf9,\x1ff\xdd\x15\xf6D\x1a\xbf\x03>ae0\xa3\xdd;\xb6\x8c\xc7\x02ɏ\xb0\x88}O\xe8\xc3E\x8ed?\x80\xb5\x1f\x9dò\x1a\x9dq&\x82m\x87M)K\x11;\x8c,$>\x80\x18\x81\x85Ң\x99\x90\xf5\x82\x055N\xcce\vj\x86M-\xc8\xd6\x19)\xad]=nΚ8\xf0\x9dW\xf7*\x8c\x92j\xbf\xb4\xc7\xfe=t\x1b\x89\x87\x02\x84\x91\x88hd\x19M\x8c\xb4\x18\x11u\x02\xa2\x88\xe3Ļd\x83 \xe9\x9dB\xa2Q;p\xf6\x91\x15h\xde\xd9\xdba\xa6\xf0\xa5\xcdR\x88,C\x12\xd7\xcfÇN\xaf\xaf\xf9\x8f\xf8\x96)\xff\x99i\xe5ͭ\xbd\x83\xff\xf8\xcf+\bi\xb0\xe7\xf8h)}\xfc\xbf\x00\x00\x00\xff\xff\bB\tb\x14V\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YK\x8f\xe3\xb8\x11\xbe\xfbW\x14f\x0f}\x19˳\xd9K\xe0K\xe0\xf1$\xc0 =ۍq\xa7sH\x02,M\x96lnS\xa4BR\xf6:A\xfe{P|H\xb2$\xb7݃d\x97\x97n\xf3Q\xac\xfa\xeaM\xcd\xe6\xf3\xf9\x8c\xd5\xf2\x19\xad\x93F/\x81\xd5\x12\u007f\xf1\xa8\xe9\x97+^~\xef\ni\x16\x87\xefg/R\x8b%\xac\x1b\xe7M\xf5\x15\x9di,\xc7OXJ-\xbd4zV\xa1g\x82y\xb6\x9c\x010\xad\x8dg4\xed\xe8'\x007\xda[\xa3\x14\xda\xf9\x0eu\xf1\xd2lq\xdbH%\xd0\x06\xe2\xf9\xeaÇ\xe2\x87\xe2\xc3\f\x80[\fǟd\x85γ\xaa^\x82n\x94\x9a\x01hV\xe1\x12\xb6\x8c\xbf4\xb5\xf3Ʋ\x1d*\xc3\xe3]\xc5\x01\x15ZSH3s5r\xbazgMS/\xa1[\x88\x14\x12[Q\xa4\x8f\x81\xd8&\x12\xbbO\xc4º\x92\xce\xff\xf9\xf2\x9e{\xe9|\xd8W\xab\xc62u\x89\xad\xb0\xc5\xed\x8d\xf5?vW\xcfa\xebT\\\x91z\xd7(f/\x1c\x9f\x018nj\\B8]3\x8eb\x06\x900\v\xd4\xe6\xc0\x84\bZ`\xea\xd1J\xedѮ\x8dj*\xdd\xde%\xd0q+k\x1fP\x8e\xb2@\x12\x06\xb24\xe0<\xf3\x8d\x03\xd7\xf0=0\a\xab\x03\x93\x8am\x15.\xfe\xa2Y\xfe?\xd0\x03\xf8\xd9\x19\xfd\xc8\xfc~\tE<U\xd4{\xe6\xf2j\xd4\xd1coƟH\x00\xe7\xadԻ)\x96\xee\x99\xf3\xcfLI\xd1j\x1d\xa4\x03\xbfGP\xccy\xf04A\xbf\"B@\x10!d\x84\xe0\xc8\\\xba\a\xe0\x10\xa9\x04\x8c\xa69U\xa3\xbb\xce\xd8&V\xe0y@%\xf2O3\x89\xfb\x1e\xd9l\xf8\xc5\xc8h\xcf\xe8\xaevx\x89\xd8\x19\x14\x9f\xb0d\x8d\xf2}QIK\xaao\x97\xe7b\xd5\xc8\v\x11O\x9d\xdd\xf8\xe9l.\u07ba5F!\x8bT\xe2\xae\xc3\xf7\xd1\n\xf9\x1e+\xb6L\x9bM\x8dz\xf5\xf8\xf9\xf9\x87\xcd\xd94L\x19\xd2\xc0)Hq\xac\xa7\x9b=Z\x84\xe7\xe0\u007fQo.\x89\xd6\xd2\x040۟\x91\xfbN\x89\xb555Z/\xb3\xb3\xc4\xd1\vR\xbd\xd9\x01Ow\xc4v\xdc\x05\x82\xa2\x13F;J\xfe\x82\"I\n\xa6\x04\xbf\x97\x0e,\xd6\x16\x1dj߇\xb7e\xac\x04\xa6\x13{\x05l\xd0\x12\x19\xf2\xe5F\t\nj\a\xb4\x1e,r\xb3\xd3\xf2_-m\a\xde$\xe3\xf5\xe8\xfc\x80f\xf0O\xcd\x14\x99j\x83\xef\x81i\x01\x15;\x81E\xba\x05\x1aݣ\x17\xb6\xb8\x02\xbe\x90\xbdK]\x9a%콯\xddr\xb1\xd8I\x9f\x8337U\xd5h\xe9O\x8b\x10g\xe5\xb6\xf1ƺ\x85\xc0\x03\xaa\x85\x93\xbb9\xb3|/=r\xdfX\\\xb0Z\xce\x03\xeb:\x06\xcdJ|gS8wwg\xbc\x8e\xbc6\x8e\x105_\xd1\x00E\xcch\x05\xf1h\x94\xa2\x03\x9a\xa6\b\x9d\xaf\u007f\xdc<A\xbe:(c\x88~\xc0\xbd;\xe8:\x15\x10`R\x97h\xa3\x12Kk\xaa@\x13\xb5\xa8\x8d\xd4>\xfc\xe0J\xa2\x1e\xc2\xef\x9am%=\xe9\xfd\x9f\r:O\xba*`\x1d2\x16l\x11\x9a:\xf8}\x01\x9f5\xacY\x85j\xcd\x1c\xfe\xdf\x15@H\xbb9\x01{\x9b\n\xfa\xc9v\xb89\xa2\xd6[ȹ\xf0\x82\xbe&\xbdxS#?\xf3\x1f\x81NZ\xb2p\xcf<\x06\xbf\x18\xe0\x9a\\\xfcr2\xcdcڹi0\xceѹ/F\xe0pe\xc0\xf2\xaa\xddx\xc6c\x8d\xb6\x92.\xa4E(\x8d\x1df\f\xd6F\xe0\xfeȑ\xaa\x18\xad\xa1n\xaa1#s\xf8\x8aL<hu\xba\xb0\xf4W+\xfd\xf8\xa2\v\x8a\xa4\x11Yܜ4\u007fD+\x8d\xb8\"\xfc\xc7\xc1\xf6\x16\x82\xbd9B\x19\xccZ{u\xa2\x18\xe4N\x9a\x8f\xa3m\x1e\xab\xc7\xcf9\xf2F\aJ\xfe\x96\xb0*`\x95<ה\xf0\x01\x84tT\x00\xb8@t\f\x16\x95g\xb4\xbe\x04o\x9b7\x89ύ.\xe5n,t\xbf\xa6\xb9d1WH\x0f\x90[\x87\x9b(4\x91u\xd4\xd6\x1c\xa4@;'\xff\x90\xa5䉓\xc6\xc6\xccUJT\u008d%\xbd\xe0eA\x14\x8b\x82\xbc\x9a\xa9+:\\\xb7\x1bCi̤\x8e\x16\xdc\x11\b\xc1\xc6V)\xa5j\x8fZ\xb4\xd5\xc8\x197&D-\x87\x02\x8e\xd2\xefc8TS~\a\xaf\xfa\x1e\x8d\x17<MM\x0fx\u007f\xda#\xed\x8c\t\x14\xc1!\xb7胵\xa1\"\xf3!S*\x00\xbe4.\x04\xd4a\x9c\xc8#\x14j\xf9\xf4\v\x9e\xc6@\xc35\xe5\xa6\x12\xe6:\xcbwT:g\x86-\x96hQ\xfbɠN\x9d\x89\xd5\xe81\xc4ua\xb8\xa3\x90α\xf6na\x0eh\x0f\x12\x8f\x8b\xa3\xb1/R\xef\xe6\x04\xf8<y\xd0\"\xb4\x15\x8b\xef\u009f\v\"?=|zX\xc2J\b0~\x8f\x96\xb4V6*\x1bZ\xaf\xbey\x1fr\xec{h\xa4\xf8\xc3ݷ\xe0b\xea\xe897`\xb3\t\xd6\u007f\xa2B-0E\x10m\xa2V\x8c\x05ʔ\xa4\xec*i3ƚ)C\x9c\xaa0\xfb\x83\x02\x13e\x90\xa9\x88\xfa\x82\xe3`\xfa\x8a\x9b\xa5b\xf7\x8a\x8f\xe5BZj!9\x15b羑\x1b\fqVoO\b6\xac\xc0/E\x84i\xc1\xa3\x00)\x1f^\xe1\xf8\xa1\xbf\xb7k\xcbbxJ9Ρ\xa7\x02ʁFʁ̎\x91\vA\x81\x1b\xad\xc9\x1b\xbd\x01ֆ\xba;7\x8c\xf1o\x8c\x10ۆ\xbf\xe0\x04\xf0#Q>\x86\x8d\x19\xe3x\x8cxi\x1c\x86\xe0{\x8d\r\xb8n㜭\xd1\xde\xc2\xcbzE\x1b\xdb4\xc9`\xbd\x82m\xa3\x85\xc2\xcc\xd1q\x8f\x9a:\x04Y\x9e\xa6\xef\xa2\xf1t\xbfɨ\x86\n#\xd5\xf8\x19\xdbi\x19b\f_\xc2\xf64Q\x13\xdc dm\xb1\x94\xbf\xdc \xe4cؘ\x01\xaf\x99߃\xd4N\n\x046\x01\u007f,\xd6.\b\xda\xe6\xff\x87\x14E\xbeA=\xafy{d\xe7-\x0e\x9f1\xbe\xe2?\x8fi[\x8bB\xfe\x9d\"\xffy-xɏ'%:\xb4\x0f\x06\u007f\x8a\x15\x16\x9fH\x95g\xcc<\x8fO\xbcR\xa9\xe5g\x8b)g\xa6\xba\xc0X\x8b\xae6ZP\xf3t[\x9dֱ\xfc\xbf\xab֦\xd5:?\x8fr\x83\xb5\xac\x85\x9bZ\x95\xf0D\xf3\xe6f%>\\\xf5[\x01\xb3u\xd4)v\xfd\xca@\xc6_\xa5My\xd7\xebS\xa8\x1f\xd6\xd0\xe8P\xa9\x85\x8c_\xc0\xdf5|\xa2ޖ\xb2\x93X\x12\xdfv\xca\x00\xa4\x03m\x8et\xbcG/\x90\x00\xa3c\xbe\xa6n\x8di\x91\x9a\xe1\xb0t\x94JQƶX\x99\xc3dƦBӢ:\x01sd:\x87\xdf\x15\x1f\x8aw\xbfY\x17\xa4\x98\xf3\xd4Ԡ\xf8\x8a\a9~\xe5\x19\xa3{?:\x91\x1d\xbfu\a\xfa\xf1Sn\x96\x176m\xfbi\x02\x8cR*\xaa\x05'\xe2DW1\x8c\xdf#?n\xee\xef\\(\xe1Q\xfb\xa9\xb2\xef\x88\x16CǄ\x82\xaax\x93\xde%\x1a\xe7\xd1N\x18@\xab\xbd\xa0sPF\xef\x06\x8e\x13Gz\xa5\xa0\n-\x1a\x94\xb1 \xd0Sj\xd2;\xe0{\xa6wؽB%\xfe_\xe7\x94\xccg`3\x9d\x85H}\xc9<n\xd2蓜*\xd3G/\xc0\xdd\xe6\xe9\xd7\xdf\xcc}\xd6\xec\xc56\xe7\n\xee\xa3\xfd9K\x13\xa8s߽\bw\xe3\xdb\xdb\xdb\xf1s\xf3\rH\xbc\xf5-\xfc\x95w\r82\u05fd\x8a\xffv8\x84\x0f\x04\xd7\x128\xed\xc9\xd2\xf2\xc6R\x9bֽ8\x05\x87\x9b\x8a۷?ݬ\x06\xdf1\xfak\xe3\xaf\x1c7\xc85\x99\xc7F\x931\x17\xf50K\xa1\xa5?\xd3l\xdbW\xd8\xccyʆ\xf0\xef\xff̺\xc4H٧\xf6(~\x1c~\xc6z\x17\x9d3\u007f\x8b\n?9U\f\xf1;\x1c\xfc\xed\x1f\xb3x1\x8a\xe7\xfc\xf1\x88&\xff\x1b\x00\x00\xff\xff=\xe0\xd66\x01\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V=s\xe36\x10\xed\xf9+v.\xc55\x11u7\xd7d\xd4%\xbe+<I<\x1e\xfb\xc6M&\x05\x04\xacčI\x00\xd9]\xc8q~}\x06\x00iI\x14}q\x8a\xb0\xe3~\xe1\xe1\xbd\xdd%\x9b\xd5j\u0558H\x0f\xc8B\xc1o\xc0D¿\x14}~\x93\xf6\xf1\ai)\xac\x0f\x1f\x9bG\xf2n\x03WI4\fw(!\xb1\xc5ϸ#OJ\xc17\x03\xaaqFͦ\x010\xde\a5\xd9,\xf9\x15\xc0\x06\xaf\x1c\xfa\x1ey\xb5G\xdf>\xa6-n\x13\xf5\x0e\xb9\x14\x9f\x8e>|h?\xb5\x1f\x1a\x00\xcbXҿҀ\xa2f\x88\x1b\xf0\xa9\xef\x1b\x00o\x06܀\xc3\x1e\x15\xb7\xc6>\xa6\xc8\xf8gBQi\x0f\xd8#\x87\x96B#\x11m>x\xcf!\xc5\r\x1c\x1d5\u007f\x04U/\xf4\xb9\x94\xfa\xa9\x94\xba\xab\xa5\x8a\xb7'џ_\x8b\xf8\x85ƨ\xd8'6\xfd2\xa0\x12 \xe4\xf7\xa97\xbc\x18\xd2\x00\x88\r\x117p\x93aEc\xd15\x00#\x1f\x05\xe6j\xbc\xf1\xe1c-g;\x1cL\xc5\x0f\x10\"\xfa\x1fo\xaf\x1f>ݟ\x99\x01\x1c\x8ae\x8aZX]\xc0\x0f$``D\x01\x1aFp\x10<B`\x18\x02#T\xa4Ҿ\x14\x8d\x1c\"\xb2\xd2\xc4_}NZ\xe7\xc4:\x83\xf0>\xa3\xacQ\xe0rϠ\x80v8\xdd\x14\xddx1\b;Ў\x04\x18#\xa3\xa0\xaf]tV\x18r\x90\xf1\x10\xb6\u007f\xa0\xd5\x16\xee\x91s\x19\x90.\xa4\xde\xe5V; +0ڰ\xf7\xf4\xf7Km\xc9\xf7̇\xf6F'\x91\x8f\x0fyE\xf6\xa6\x87\x83\xe9\x13~\x0f\xc6;\x18\xcc30\xe6S \xf9\x93z%DZ\xf85\xd3D~\x176ЩF٬\xd7{\xd2idl\x18\x86\xe4I\x9fץ\xfbi\x9b4\xb0\xac\x1d\x1e\xb0_\v\xedW\x86mG\x8aV\x13\xe3\xdaDZ\x15辌M;\xb8\xefx\x1c2y\u007f\x86U\x9fsÈ2\xf9\xfd\x89\xa3t\xf37\x14Ƚ\\e\xaf\xa9\xf5\x16G\xa2\xb3)\xb3s\xf7\xe5\xfe+LG\x171\xe6\xec\x17ޏ\x89r\x94 \x13F~\x87\\E\xdcq\x18JM\xf4.\x06\xf2Z^lO\xe8\xe7\xf4K\xda\x0e\xa42\xb5d֪\x85\xab\xb2G`\x8b\x90\xa23\x8a\xae\x85k\x0fWf\xc0\xfe\xca\b\xfe\xef\x02d\xa6e\x95\x89}\x9b\x04\xa7+p\x1e\\Y;qL;\xea\x15\xbd\x16\x86\xf6>\xa2\xcd\nf\x12s6\xedȖ\xf1\x80]`x\xea\xc8v\xd3\xd0\xce\xd8}\x19\xf0\xf6̱<\xd0\xf9\xa9e\xf2R\x9a{^\xbd<\x14\xed\x88qօ\xab\x93bo\xe2E\x8d&\xf9\x8f̔\x9c\x89\x1b\x9b\x98\xd1\xebX\xa9l\x8b\xa5\xa4\xb7r\x81́/\xac3P_JP\xf9\xce\x19\xf2\x02\xc6?\x8f\x89\xa0\x9dQxB\xcec`C\xca{\x06\x1d\xb8t\xc1\xdfHK\x87U\xac,l\xe4`Q\xa4\xbd\x88#\xc5a\x01\xd37\xd4\xc9O\xfe\x86\x9am\x8f\x1bPN\xf8\x8a\xb2\x86\xd9<\xcf|\xb13\xb2\xd0\ng\x14\xdc\xe6\x98%\r\xb0nu\xfcw\x11\n\xdd>\r\x97'\xad\xe0\x06\x9f\x16\xac\xd7\xfe\x96ÞQ\xe6-\x9f\x9d\xb7\x95\xbd\xf2M}#K\x8bMya\x94\xbc\xef\xdc\t\x8b\xa2\x81\xcd~\xe2\xf5\xd8\xc2\xc6Z\x8c\x8a\xeef\xfe\xd7\xf1\xee\xdd\xd9\xefCy\xb5\xc1;\xaa?M\xf0\xdb\xefM\xad\x8a\xeea\xfa\x1b\xc8\xc6\u007f\x02\x00\x00\xff\xff~\x96\x80P\xae\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMo\xdc6\x10\xbd\xebW\f\xd2CZ \xd2&ȥ\xd8[\xeb\xe4\x104\r\x02;\xf5\xa5\xe8\x81K\xcdJ\xac)\x92\xe5\f\xd7q\x8b\xfe\xf7bHi?\xb4Z\xdb9T\xb7%\x87\xc3\xc7\xc7\xf7\x86\xb3U]ו\n\xe6\x16#\x19\xef֠\x82\xc1\xaf\x8cN~Qs\xf7#5Ưvo\xaa;\xe3\xda5\\%b?\\#\xf9\x145\xbeíq\x86\x8dwՀ\xacZ\xc5j]\x01(\xe7<+\x19&\xf9\t\xa0\xbd\xe3\xe8\xad\xc5Xw蚻\xb4\xc1M2\xb6Ř\x93O[\xef^7o\x9b\xd7\x15\x80\x8e\x98\x97\u007f1\x03\x12\xab!\xac\xc1%k+\x00\xa7\x06\\C\xeb\xef\x9d\xf5\xaa\x8d\xf8WBbjvh1\xfa\xc6\xf8\x8a\x02jٴ\x8b>\x855\x1c&\xca\xda\x11P9̻1\xcduI\x93g\xac!\xfeei\xf6\xa3\x19#\x82MQ\xd9s\x10y\x92\x8c\xeb\x92U\xf1l\xba\x02 \xed\x03\xae\xe1\x93\xc0\bJc[\x01\x8cgϰ\xea\xf1t\xbb7%\x95\xeeqP\x05/\x80\x0f\xe8~\xfa\xfc\xe1\xf6\xed\xcd\xc90@\x8b\xa4\xa3\t\x9c\x19\x9ca\x06C\xa0`D\x00\xec\xf7\xa0@9P\x91\xcdVi\x86m\xf4\x03l\x94\xbeKa\x9f\x15\xc0o\xfeD\xcd@\xec\xa3\xea\xf0\x15P\xd2=(\xc9WB\xc1\xfa\x0e\xb6\xc6b\xb3_\x14\xa2\x0f\x18\xd9L,\x97\xefH\\G\xa33\xe0/\xe5l%\nZQ\x15\x12p\x8f\x13?؎t\x80\xdf\x02\xf7\x86 b\x88H\xe8\x8a\xceN\x12\x83\x04)7\x9e\xa0\x81\x1b\x8c\x92\x06\xa8\xf7ɶ\"\xc6\x1dF\x86\x88\xdaw\xce\xfc\xbd\xcfM\u0090lj\x15Or8|\xc61F\xa7,\xec\x94M\xf8\n\x94kaP\x0f\x101\xf3\x94\xdcQ\xbe\x1cB\r\xfc\xea#\x82q[\xbf\x86\x9e9\xd0z\xb5\xea\fO\xa6\xd2~\x18\x923\xfc\xb0\xca\xfe0\x9b\xc4>Ҫ\xc5\x1d\xda\x15\x99\xaeVQ\xf7\x86Qs\x8a\xb8R\xc1\xd4\x19\xba\xcb\xc6j\x86\xf6\xbb8ڐ^\x9e`\xe5\a\x91\x19q4\xae;\x9aȚ\u007f\xe4\x06D\xf5E0ei9Łh\x19\x12v\xae\xdf\xdf|\x81i\xeb|\x19s\xf6\x8br\xf6\v\xe9p\x05B\x98q[\x8c\xe5\x12\xb3\xf2$'\xba6x\xe38\xff\xd0֠\x9b\xd3Oi3\x18\xa6I\xccrW\r\\\xe5J\x03\x1b\x84\x14Z\xc5\xd86\xf0\xc1\xc1\x95\x1a\xd0^)\xc2\xff\xfd\x02\x84i\xaa\x85\xd8\xe7]\xc1q\x91\x9c\a\x17֎&\xa6Jv\xe1\xbefV\xbf\t\xa8\xe5\xf6\x84@Yi\xb6Fgk\xc0\xd6GP\a\xe7\x8f\x046'\x99\x97\x9d\x9b\xc1\xa9\xd8!\xcfGgX\xbe\xe4 \xd9\xfe\xbeW\xa7\x85\xe6{l\xbaFj\x05\x8d@J\xf5\xf8\xa19\xcbx\x19\x03,\xaaw\x11\xc9$b\xa1Ax\x95R E\xea\x18\xd3\xf9\xd6\xf2\xa1K\xc3\xf2\x065\xfc\x9c1\u007f\xf4ݣ\xf3Wޱ\xc8\xfdѠ[oӀ7N\x05\xea\xfd\x13\xb1\xd33\xbb\u007fz\xce\x03\xafQ\n4^\x866\x06\\#%{a\xbb\vb\x9d\xbe\xfc(=ͼ<k\x13\xf3\xb2\xa4Tj\x04y\xec\xa3CF:\x14\x8d{\xc3\xfdbF\x80\xfb\xde\xe8>/\xcc\xd7&\xf5\x88\xc8k\x93\xdd\xfd\xed\xf0E\xed&\xe2\x82t\xea,\xa9\x85a\x01\u007f6|\xc1\xa3\x976\xa8G\xdf<\xcb\xe7\xac8\xd178=\xc7OT\xeb\x14#:\x1e\xb3\xe4\x97o\xbe\xe0\xb9V\x9f\xfc\xf1\xdb\xf5\xc7'\xfc\xfe\xee\x10\x99{;e\\A\x13\"\xd6d:y\xafeN\x1c\x9f\x9dxNF\xf9N\xfb\x87S\xa2\x16o\x14\xbf\x06\x13s]{\x02\xe2\xfb}`)K\xe8ʓ3\xef\x90rB\xa4\xfc\x9ck5o$\xe4\xdb \xb4h\x91\xb1\x85\xcdC\xa9\xaf\x0f\xc48\x9c\xe3\xde\xfa8(^\x83<E5\x9b\x05\x19I\x17\xab6\x16\xd7\xc01]R\xd9\xe2\xc1C\xafh\xc1\x86'g\xfe,1K\xc2؛\xf1Qe\xc0\xc5*X\xc3'\xbc_\x18\xfd\x1c\xbdF\"<\xb7\xd1œ,\x9a\xe0l\x90\xa4_h\x8fX\x1a\xdb\xd0㑴\xd9\xf7?\x13\xe2\xd1J\xf0Ͽ\xd5\xc1UJk\f\x8c\xed\xa7y\xfb\xff\xe2\xc5I?\x9f\u007fj\xefZS\xfe\xb9\xc0\xef\u007fTeclo\xa76]\x06\xff\v\x00\x00\xff\xffj\x11\xef\x043\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo#\xb9\x11\xbe\xebW\x14\xbc\ag\x01\xb7\xb4\x8f \bt۱\xb3\x81\x93]\x8f1r\xe62\x98C\xa9Y\xdd͘M2$[\x1a%\xc8\u007f\x0f\x8ad\xeb\xd1jI\xb6\x81\x99\xf4e\xc6d\xb1X\xef\xfa\x8a\x9a\x14E1A+?\x92\xf3\xd2\xe89\xa0\x95\xf4%\x90\xe6\xbf\xfc\xf4\xf9\xcf~*\xcdl\xf5\xe3\xe4Yj1\x87\xdb\xce\a\xd3~ o:W\xd2\x1dUR\xcb \x8d\x9e\xb4\x14P`\xc0\xf9\x04\x00\xb56\x01y\xd9\xf3\x9f\x00\xa5\xd1\xc1\x19\xa5\xc8\x155\xe9\xe9s\xb7\xa4e'\x95 \x17\x99\xf7W\xaf~\x98\xfe<\xfda\x02P:\x8aǟdK>`k\xe7\xa0;\xa5&\x00\x1a[\x9a\x835beT\xd7\xd2\x12\xcb\xe7\xce\xfa\xe9\x8a\x1493\x95f\xe2-\x95|i\xedLg\xe7\xb0\xdbHg\xb3@I\x99G#>F6\xef\"\x9b\xb8\xa3\xa4\x0f\u007f\x1f\xdb\xfdM\xfa\x10)\xac\xea\x1c\xaac!⦗\xba\xee\x14\xba\xa3\xed\t\x80/\x8d\xa59<\xb0\x18\x16K\x12\x13\x80\xac{\x14\xab\xc8ڭ~L\xacʆZL\xf2\x02\x18K\xfa\x97\xc7\xfb\x8f?/\x0e\x96\x01\xac3\x96\\\x90\xbdj\xe9\xdb\xf3\xe8\xde*\x80 _:iC\xb4\xf753LT ؕ\xe4!4\xd4\vE\"\xcb\x00\xa6\x82\xd0H\x0f\x8e\xac#O:9\xf7\x8010\x11j0\xcb\u007fR\x19\xa6\xb0 \xc7l\xc07\xa6S\x82#`E.\x80\xa3\xd2\xd4Z\xfe{\xcb\xdbC0\xf1R\x85\x81\xb2\x85w\x9fԁ\x9cF\x05+T\x1d\xdd\x00j\x01-n\xc0\x11\xdf\x02\x9d\xde\xe3\x17I\xfc\x14~7\x8e@\xea\xca̡\t\xc1\xfa\xf9lV\xcb\xd0Griڶ\xd32lf1(\xe5\xb2\v\xc6\xf9\x99\xa0\x15\xa9\x99\x97u\x81\xaeld\xa02t\x8efhe\x11E\xd71\x9a\xa7\xad\xf8\xce\xe5\xd8\xf7\xd7\a\xb2\x86\r\xfb\xd6\a'u\xbd\xb7\x11\x03\xed\x8c\a8\xd4@z\xc0|4i\xb134/\xb1u>\xfce\xf1\x04\xfd\xd5\xd1\x19C\xebG\xbb\xef\x0e\xfa\x9d\v\xd8`RW\xe4\x92\x13+g\xdaȓ\xb4\xb0F\xea\x10\xff(\x95$=4\xbf\uf5ad\f\xec\xf7\u007fu\xe4\x03\xfbj\n\xb71\xbdaI\xd0Y\x81\x81\xc4\x14\xee5\xdcbK\xea\x16=}u\a\xb0\xa5}\xc1\x86}\x99\v\xf6+Ӑ8Ymo\xa3/\x1f'\xfc5\xa8\t\vK%{\x8f\r\xc8'e%˘\x1aP\x19\a8$\x9f\x1e0\x1eO\\\xfeR\xc5X\x04㰦\xdfLb9$\x1aH\xf6n\xecL/\x1bו\x94Ĕ\x99\x83O\x94GL\x01T\u007fxݐ\xa3xƑ\x0f\xb2\xe4\xe02^\x06\xe36̘9\x90\x98\x1eq8\xe1\x06\xfe\xb4\x11tA\x8f\a#hLl>\n\xa1\xc1\x14\xad\x8f&f\x8d\xeb\xb4>\xbe\x85?\xa3_%\x985\xe2\x82\\\xf9F\x04G\x159Ҝ\x85\xa9pY\x13\xcb[@\xa9\xfblM\x85\x1f\x82\x19\x91l\x99\\@\x02\x86\x01\x01g\x83\x02\xceT\xf5Q\x89\u007fy\xbc\xef+yo\xc4,{8\xbe\xf7\x82}\xf8\xab$)\xf1\x88\xa1y\xc1\xdd\xd7\xf7U\xba,ִ`\x00\xc1J*\xe9\xa0I\x80\xd4>\x10\n0\xd5(G\x06\x12\xc0\x89\xef(\x9f\xb8I\x15,\x97\xca]ka\xdb\x03r\xed\x94\x02\xfe\xb6x\xff0\xfb\xeb\x98\xe9\xb7Z\x00\x96%yf\x84\x81Z\xd2\xe1\x06|W6\x80\x9eՐ\x8eĂw\xa6-jY\x91\x0f\xd3|\a9\xff\xe9\xa7\xcf\xe3\xd6\x03\xf8\xd58\xa0/\xd8ZE7 \x93ŷe\xb9\x0f\x1a\xe9\x939\xb6\x1ca-C#\x87\xcdtk\x01\x0e\xaf\xac\xf6:\xaa\x1b\xf0\x99\xc0du;\x02%\x9fi\x0eW\\~\xf6\xc4\xfc\x0f\xe7\xce\u007f\xafNp\xfdCJ\xed+&\xbaJ\xc2m\xfb\xf0~\xd2\xed\x84L\x99\xe7d]\x93\x8b\xc0e\xec\x8bM\x85K\xf5\xf7`\x1c[@\x9b=\x16\x911{/\x15J\x12GB\u007f\xfa\xe9\xf3I\x89\x0f\xed\x05R\v\xfa\x02?\x81\xd4\xc96ֈ\xef\xa7\xf0\x14\xa3c\xa3\x03~\xe1\x9b\xca\xc6x:eY\xa3ՆunpE\xe0MK\xb0&\xa5\x8a\x84\x83\x04\xacq\xc3V\xe8\x1d\xc7\xf1\x86`х\xb3\xd1ڣ\x9f\xa7\xf7w\xef\xe7I2\x0e\xa8:Vb\ue695d4\xc30&\xf5\xe2\x18\x8dGͼ\xff|\x97\xc2'\x18(\x1b\xd45%}\t\xaa\x8e\xbb\xe3\xf4\xfa-y|\fI\xfao\x04\x9a\f\v\xc7\xff\xad\xb9\xbfP\xb9\x88\xa0_\xa0\xdc\xc3^\x94\x9fU\x8eg\x15\xa7)P\xd4O\x98ҳj%\xd9\xe0gfEn%i=[\x1b\xf7,u]ph\x16)\x06\xfc,\x8e\x1b\xb3\xef\xe2?o\xd6%\x0e\n/U(\x12\u007f\v\xad\xf8\x1e?{\x93R=\x86}y\x1f\xbb^dd5<\xcbi\xb1nd\xd9\xf4\xc3I\xae\xb1'\x92I2\x12\x16\xa94\xa3\xde|\xf5Pf\x83v\x8e%\xda\x14y\x00.P\v\xfe\xbf\x97>\xf0\xfa\x9b,\xd8\xc9\x17\xa5\xef?\xee\xef\xbeM\x80w\xf2M\xb9z\x02\x80\xa7\x18\xb1\xe6^\xb0)+I\xee\x020\xfbp@\xdcC\xc7\x11ĺ\xa5y\x152\fX\x8f@1\x14\">{\xa0z<\v\xd8\xceZ\xe0@\x8d'\xac=\xa0#@hѲ\xe7\x9eiS\xa4\x16oQr\u007f\xe6\x16\x9c1ϒ\x00\xadUr\xb4\x15\xe7F\x9eAh\xc6\xfb<hc\xedO\xe9>\xea\x87\xc4\xe1\x82\xfdӀ3\x06ٳ\x00\t\xdfla{0\xb0\x1cK\xd13\xa0\xf8\xa4\x15y.e\xb4v(b1>@\rhx\xa0\x18,Y#\x06+\x87\x918\xd8L\xfa\xbdh\xaa\f\x18:\xff\x8a\xb92\xd2\xf76MU$d.\x11B\xbfu\xb2,\r\xa3\xd3ç\xb5\xf3\xee\xbd=>\x11\x1fq\x9cH\xc2\x05\xd9r\xcc\xe6([\xa3\xef\xef\x18\x1b\ra\x8f]:\x19\xeb6s#\x11\xa1##\xdb\n\xa5\"\x01\xfd\xdb\xde\xf0\xcc\b\xd7}.K\xaa\xb8\xc8uV\x19\x14\xfd@\x96\xc5\xdb\xc23\x9e\xd7\xe3\xebȵ?ó\xf3$\xe2$?b\x84c\xc8V\x19\xd7b\x98\x83\xc0@\xc5(S\xdd)\x85KEs\b\xae;\xde>S,Z\xf2\x1e\xebK\xa9\xf8{\xa2Jsj>\x02\xb84]\xd8\x0e\xaa\aE\xe1\xda\xe7\x98zݬ<:\x02\x1e\x8632D\xf7\x19\xaa*\x15\xcf\xec\x17\x82݃p\x94jI\xe3\xad\xee-5\x01\xc06\xe8/\x99\xea\x91i\xc6\x12l[\xbd\xcef\x18\u007f\xa4\xbb\xf6\xf8\x96\x02\x1eh=\xb2z\xaf\x1f\x9d\xa9\x1d\xf9\xe3\xc0)\xfa\xf8\x1a\xa9\xe6\x05\xfc\x1a\xb3\xe1U\xfa\xe7\x8b.\x99 \x93AcT\x9f\xcc&\xa0\x02ݵKrl\x87\xe5&\x90?,\xe7c\xaf\x12q\x9aٙq\xef|\xef\xbf\xc4)\x0fh%\xea\xf8z\xc8\xd9\x15\f\b\xe9\xad\xc2\xcd\b\xe3^\x91\x88X8\xb9\xb8\x04\xec\xe2\xb9OjK.n\xbd\xf65%\xcatg\xf4\t|\xdd\xe7\xb3\xd4\xe1O\u007f<\x83o\xa4\x0eT\x0f\x9aC\xdegs\xbe\xe3[\xbe\xce\rgZ\xb7\xd7h}c\xc2\xfd݅(Xl\t\xfbl\xd8\x01\xa5X\xfb\xe2\xdbf&ʡ0\xe6\xaammyU\xaa\xfa\x80.\xbc\xb4\x15-\x0e\x88/t\xa1\xc8y\xbc\a-Ȣ\xe3L\x8f/\xe1\xb7\xc3ߚn\xc0\xcb\xf8\xbc\xc7x+\x01\xb04|{nN\f,\x8d\xa3\x91\x92\t\xc7m堉\x1c\x8a\xff-\xfb\xc7h\x9c\x1c-F\xc9\xc5\x1e\xef\xfcD\x9cWv\x18\x06K\x9e\x0eH<\f\u007fO\xbbJ\xaf7\xfd\x0fd\xf1\xcf\xd2\xe8\x04\x95\xfd\x1c>}\x9e@~6\xfe\xd8\xff\xeeŋ\xff\v\x00\x00\xff\xff~\xe4\xff\xab\x84\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo\xe3\xc8\x11\xbe\xebW\x14\xbc\ag\x81!\xb5;\x1b\x04\x81n\xbbv6P\xb2\xeb1F\xce\\\x06sh\xb1\x8bb\xc5d7\xd3]\x94\xac\x04\xf9\xefAu\xb3\xf5\xa4\x1e60\x13^l\xf5\xa3\xfa\xab\xaf\x1e]E\x8e\xb2,\x1b\xa9\x96>\xa1\xf3d\xcd\x04TK\xf8\xc2h\xe4\x97ϟ\xff\xecs\xb2\xe3口g2z\x02w\x9dg\xdb|Do;W\xe0=\x96d\x88ɚQ\x83\xac\xb4b5\x19\x01(c,+\x19\xf6\xf2\x13\xa0\xb0\x86\x9d\xadkt\xd9\x02M\xfe\xdc\xcdq\xdeQ\xad\xd1\x05\xe1\xe9\xe8\xe5\x0f\xf9O\xf9\x0f#\x80\xc2a\xd8\xfeD\rzVM;\x01\xd3\xd5\xf5\b\xc0\xa8\x06'\xd0Z\xbd\xb4uנC\xcf֡ϗX\xa3\xb39ّo\xb1\x90S\x17\xcev\xed\x04\xb6\x13qs\x8f(j\xf3h\xf5\xa7 \xe7c\x94\x13\xa6j\xf2\xfc\xf7\xc1\xe9\xdf\xc8sX\xd2֝S\xf5\x00\x8e0\xeb\xc9,\xbaZ\xb9\xe3\xf9\x11\x80/l\x8b\x13x\x10(\xad*P\x8f\x00z\x02\x02\xb4\xacWq\xf9c\x94UTب\x88\x19\xc0\xb6h~~\x9c~\xfai\xb67\f\xd0:ۢcJ\xea\xc5gǬ;\xa3\x00\x1a}\xe1\xa8\xe5@\xfa\xad\b\x8c\xab@\x8b=\xd1\x03W\x98@\xa1\xee1\x80-\x81+\xf2\xe0\xb0u\xe8\xd1D\v\xef\t\x06Y\xa4\f\xd8\xf9?\xb1\xe0\x1cf\xe8D\f\xf8\xcav\xb5\x167X\xa2cpX\u0605\xa1\u007fod{`\x1b\x0e\xad\x15c\xcf\xf1\xf6!\xc3茪a\xa9\xea\x0e߁2\x1a\x1a\xb5\x06\x87r\ntfG^X\xe2s\xf8\xdd:\x042\xa5\x9d@\xc5\xdc\xfa\xc9x\xbc N\xee\\ئ\xe9\f\xf1z\x1c<\x93\xe6\x1d[\xe7\xc7\x1a\x97X\x8f=-2劊\x18\v\xee\x1c\x8eUKY\x80n\x82K\xe7\x8d\xfe\xce\xf5\x01\xe0o\xf7\xb0\xf2Zl\xebّY\xecL\x04g;c\x01\xf16 \x0f\xaa\xdf\x1a\xb5\xd8\x12-C\xc2\xceǿ̞ \x1d\x1d\x8cq\xc8~\xe0}\xbb\xd1oM \x84\x91)\xd1E#\x96\xce6A&\x1a\xddZ2\x1c~\x145\xa19\xa4\xdfw\xf3\x86X\xec\xfe\xaf\x0e=\x8b\xadr\xb8\v1\x0es\x84\xaeՊQ\xe705p\xa7\x1a\xac\xef\x94ǯn\x00a\xdagB\xecu&\xd8MO\x87\x8b#k;\x13)\x85\x9c\xb0\xd7aZ\x98\xb5X\x88\xf9\x84A\xd9J%\x15!6\xa0\xb4\x0e\xd4\xd1\xfa|O\xf4p\xe8\xca3W\xc5s\xd7\xce\xd8:\xb5\xc0\xdfl\x94y\xb8\xe8\x00\xdb/C{\x128\xc9,1\x8c\xb1\x17\x0e>\xae<\x12\nP\xa7ͫ\n\x1d\x86=\x92Ũ\x10\xf7\xb2\x9eغ\xb5\b\x0e*\xe9\xfcH\xc2\tC\x04\x95\xad\xbe\xa0ƣ\xed\x03\xc2a\x89\x0e\x8d\xb8{\xcc\x10\xad\ry\x84\x15\x99\x14\x161\xc5\x02\xdb\x01-\xe6\x11\xf50\xc4\xd3\xd4Ù\xec9\b\xf8\xe7\xc7iʘ\x89\xe1\x1e:\x1f\x9f{\x81\x1eyJ\xc2Z?*\xae\xae8\xfbvZ\xc6\xc3B\xee`\v\nZ\xc2\x02\xf7\x921\x90\xf1\x8cJ\x83-\a%ʭ\r\x12`\x0e\xfb\x1d\xefb\xa6\xe8S\xd26\x85\v\xf5\xa0$G\x91\x86\xbf\xcd><\x8c\xff:\xc4\xfcF\vPE\x81^\x04)\xc6\x06\r\xbf\x03\xdf\x15\x15(/j\x90C=\x93\x99\xbcQ\x86J\xf4\x9c\xf7g\xa0\xf3\x9f\xdf\u007f\x19f\x0f\xe0W\xeb\x00_T\xd3\xd6\xf8\x0e(2\xbeI\u007f\xc9g\xc8G:6\x12aE\\\xd1\u1975a@\xbc\xabW{\x15\xd4e\xf5\x8c`{u;\x84\x9a\x9eq\x027\x12\xe5;0\xff#\x81\xf5ߛ\x13R\xff\x10\x03\xe8F\x16\xddDp\x9b\xfbn7\"\xb7 \xb9R\f\xech\xb1@\x17\n\x84\xa1'$oI\x89߃u\u0080\xb1;\"\x82`\xb1^\xccG\xa8\x8f@\u007f~\xff\xe5$\xe2}\xbe\x80\x8c\xc6\x17x\x0fd\"7\xad\xd5\xdf\xe7\xf0\x14\xbccmX\xbd\xc8IEe=\x9eb֚z-:Wj\x89\xe0m\x83\xb0º\xceb\xbd\xa1a\xa5\xd6\xc2B2\x9c\xf8\x9b\x82V9>뭩\xcax\xfap\xffa\x12\x91\x89C-B\xbe\x93۩$\xa9\x1a\xa4\\\x88w^\xf0ƣK3=\xbe\x8b\xee\xc3\x16\x8aJ\x99\x05F}\x11\xcaNn\xa1\xfc\xf6-q||\xf5\xa7g\xa0\x048L\x1c\xff\xb7K\xf4J\xe5B\xa5z\x85r\x0f;^~V9i\f\x9cAƠ\x9f\xb6\x85\x17\xd5\nlُ\xed\x12ݒp5^Y\xf7Lf\x91\x89kf\xd1\a\xfc8\x94\xf6\xe3\xef\u009f7\xeb\x12\n\xf2k\x15\n\x8b\xbf\x85Vr\x8e\x1f\xbfI\xa9T+^\u007f\x8f\xdd\xce\xfa\x02\xe6p\xaf\x84Ū\xa2\xa2JM@\x9fcO\x04\x13IũcjVf\xfd\xd5]Y\b\xed\x9c Zg}\xb7\x99)\xa3\xe5\u007fO\x9ee\xfcM\fvtU\xf8\xfecz\xffm\x1c\xbc\xa37\xc5\xea\x89B7\xfaHk\xa7Z\xa8,\t݅\xba\xec\xe3\xde\xe2TW\x0eԅ\x9b5\xaf*\f\xbdQ\xad\xaf,O\xef/\xe0\x98m\x16&\f[\x03\xf4\xe5`\x92%\x8e{\xb6\n<\x83'\x8a\xba\x80%\xd6\xf6C5v\x8f$\xd6\x1caD\xeaڀg8X_\x8bPZ2)\xa0\xf6\x11fÝ\xc3\xc1\x9a\xd6ꃑ}O8\x98ܚ\xe6`\"*yU[Ŋ;\xff\x9a\xc6*lH\xcc\xc6\xf8\xe6^L(n\xdf\xdcZ\x15V\n\xc7\xfdWL\xe7\xad|w\xbc#\xbc\xc7p:\xa2cj0\xf4+\x01\a\xac\x94O\x87\fY\x14v\xe4ŭ!\xa7\x8a8ԡ\xac\x93\xaa\xb3TT\xa3\x86\xcdK.x\x92\x0e34\xf4\xb7CUL\x12\xd4yԡ\xf7\x1c\x00}\xbc\xaf\xb4\xaeQ<\x01i\xe33\x11q\xb4\xc2tu\xad\xe65N\x80]w<}&\x80\x1a\xf4^-.E\xd0\xefqU\xec\xf8\xfa-\xa0\xe6\xb6\xe3M\xcbׇROŭ\xef\xbd\xe0umg\xa5\xfc%(\x8f\xb2f\xc8\xe36A}\xde\xe5\xe4A\xd35\xc7\xc7d\xf0\x80\xab\x81ѩytv\xe1\xd0\x1f[&K\x06\x1ch\x022\xf85xǫ\b\xe8\x0f\xba\xc4A\xbf\f*['ﶬj0]3G'D\xcc\u05cc>1\x92R\xc3P\x0f\x1dj\xef-\x93[\t)\xdbEQ}7Q(\x13^)\x89\xff\xb2\x05M\xbe\xad\xd5z@n\xd2$\\\xaf\xe2\xbe\x12G[\x8fIQ(\xe1\x1f\xe6^\xdb\xfb\aP\xf7֜\xa8\x06SȐ\xe1?\xfd\xf1\xccmL\x86qq\x90J\xfby!\xf4\x179\xe5\xeb\x9cp\xe6\xc2\xf7\xac\x1c_\x9b\xf6f{\x8b/e\xbc z8\xdf\xed\xa6\xae\xe3D\xb5\u007f̷\xccQ\x83D\x1d\r\x06\xe4zGv\xffެ\x1f\xd9\xdel\xaa\x90b\x0e\xf5\xc3ᧆ\x9b\x9b\xbd/\a\xe1ga\x8d\xa6\xf8\x99\x04>\u007f\x19A\xff.\xedS\xfa\x1c \x83\xff\v\x00\x00\xff\xff\xb9\xf7H\xe3\xa0\x19\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xc1n\xe36\x10\xbd\xfb+\x06\xdb\xc3^*y\x17{)tk\xd3\x16\b\x9a\x04\vg\x91K\xd1\x03E\x8d\xeci(\x92%\x87Nݯ/\x86\x92bYV\xe2\xcd\x02\xab\x9bə\xc77\xf3f\x86\xf4\xaa(\x8a\x95\xf2\xf4\x80!\x92\xb3\x15(O\xf8/\xa3\x95_\xb1|\xfc)\x96\xe4\xd6\xfb\x8f\xabG\xb2M\x05W)\xb2\xeb6\x18]\n\x1a\u007fŖ,19\xbb\xea\x90U\xa3XU+\x00e\xadc%\xcbQ~\x02hg98c0\x14[\xb4\xe5c\xaa\xb1Nd\x1a\f\x19|<z\xff\xa1\xfcT~X\x01\xe8\x80\xd9\xfd\vu\x18Yu\xbe\x02\x9b\x8cY\x01X\xd5a\x05\x01#\x93\x0e\xe8]$v\x810\x96{4\x18\\In\x15=j9v\x1b\\\xf2\x15\x1c7z\xef\x81R\x1f\xce&\x03mF\xa0C\xde2\x14\xf9\x8f\xc5\xed\x1b\x8a\x9cM\xbcIA\x99%\"y;\x92\xdd&\xa3\u0099\x81\x1c\x10\xb5\xf3X\xc1\x9dp\xf1Jc\xb3\x02\x18R\x90\xb9\x15C\x90\xfb\x8f=\x96\xdea\xa7z\xd2\x00Σ\xfd\xf9\xf3\xf5ç\xfb\x93e\x00\x1f\x9c\xc7\xc04\xc6\xd7\u007f\x13a'\xab\x00\rF\x1d\xc8sN\xfb{\x01쭠\x11E1\x02\xefp$\x85\xcd\xc0\x01\\\v\xbc\xa3\b\x01}\xc0\x88\xb6\xd7\xf8\x04\x18\xc4HYp\xf5ߨ\xb9\x84{\f\x02\x03q\xe7\x92i\xa4\x10\xf6\x18\x18\x02j\xb7\xb5\xf4\xdf3v\x04v\xf9P\xa3\x18\x87$\x1f?\xb2\x8c\xc1*\x03{e\x12\xfe\b\xca6Щ\x03\x04\x94S \xd9\t^6\x89%ܺ\x80@\xb6u\x15\xec\x98}\xac\xd6\xeb-\xf1X\xd0\xdau]\xb2ću\xaeM\xaa\x13\xbb\x10\xd7\r\xeeѬ#m\v\x15\xf4\x8e\x185\xa7\x80k\xe5\xa9\xc8\xd4m.\xea\xb2k~\bC\v\xc4\xf7'\\\xf9 \xdaF\x0ed\xb7\x93\x8d\\m\xaf( \xe5\x06\x14A\r\xae}\x14\xc7D˒dg\xf3\xdb\xfd\x17\x18\x8f\xceb̳\x9f\xf3~t\x8cG\t$ad[\f\xbd\x88mp]\xc6D\xdbxG\x96\xf3\x0fm\b\xed<\xfd1\xd5\x1d\xb1\xe8\xfeO\xc2ȢU\tW\xb9ˡFH\xbeQ\x8cM\t\xd7\x16\xaeT\x87\xe6JE\xfc\xee\x02H\xa6c!\x89\xfd:\t\xa6\x03jn\xdcgm\xb21ΐ\x17\xf4\x9aυ{\x8fZ\xe4\x93\f\x8a+\xb5\xa4so@\xeb\x02\xa83\xfb\xf2\x04z\xb9u嫕~L\xfe\x9e]P[\xbcq=\xe6\xdch\xc6\xed\x97%\x9f\x91\x9cL\x96\xbe\x8dq\xd9\xf0\f\x1b\x80w\x8a'\xfdˊ\xec\xf3\x18X\x8c\xe7\x15\x11\xb2\x10J\xda\xd9*\xab\xf1\xf7\\QV\x1f.\xc4t\xbb\xe0\"!\xed\xdc\x13\xb8\x96\xd1NA\a\xae\v\x91\xd4\b!\xd97\x91\xed\xe7\xf7u#\x85\xd7\x12\x86\vD73\xf31\xefm2f\xc0*\xb4\xeb\xbcb\xaa\r.\x1f)\x9f\x94\r\xf5(\x87\xbe\xf7\xbf=\xdf{gR\x87\xcf\xd7ͅ\b\x1eN\xad\xa7\x85\xd3/\fT$\x14\b\xa7\x17\xe7\xe97\xd4J\x04\uf681\xc4P\xd0Q\xe2{C\f\"9\x05\x9cM\xd0b\xb9=f6K\xd563\x99k<۞\xe5\xef\xab\xc6\a+N\xf1-\x03$;\x8c\xc9\xd6)\x04\xb4<\xc0\xe4\x1b\xf5\x9bG\x88Q\x91'\xed#/\xaa\v\x15ps\xee1\x12\x130`Y\x98\xf6ۓ\x9a\xdfBY\xb4\xa5Nk]\xe8\x14W \x17F!@g\x16\xf2\xceS\xb5\xc1\n8\xa4\xf3\xed\xd7\xe6\nƨ\xb6\x97\xa2\xbb\xed\xad\xfa\xcbvp\x01U\xbb\xc4/\xa4\x9ew\xe7,\xe0\x82\x1c\x17\x98\xfa\x9d\x8a\x97x~\x16\x9b\xa5\x82x\x9eߗ)\xa0M\xdd\xf91\x05\xdc\xe1\xd3\xc2\xea\x06Us\xde\xc7\x05\xdc9^\xdez1\xc2Ů8[\x8c\xf2.i&:Ǿ\x91\x87\x95c\x0f)\xad\xd136w\xf3\xd7\xfb\xbbw'\x8f\xf1\xfcS;\xdbP\xff\xd7\x03\xfe\xfckգb\xf30>\xb0e\xf1\xff\x00\x00\x00\xff\xff\x1b\xa0}D\xf4\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}}s#\xb7\x91\xf7\xff\xfe\x14\xa8\x8d\xeb\x91t\x11\xa9\xdd\xe4.u\xb7\x95z\\ʮ\xec\xa8\xecղV\xca\xfaq9>\x1f8\xd3$q\x9a\x01&\x00\x86\x12s\xbe\xef\xfe\x14\x1a\xc0\xbc\x90\xc3\x17`\xa8}\xb1\aWu\xf1R3=@\xa3\xd1\xe8n\xfc\xbaA\v\xf6\x1e\xa4b\x82\xbf$\xb4`𨁛\u007f\xa9\xf1\xfd\xbf\xab1\x13\x17\xcb\x17_\xdc3\x9e\xbe$\xafJ\xa5E\xfe\x0e\x94(e\x02\xafa\xc68\xd3L\xf0/r\xd04\xa5\x9a\xbe\xfc\x82\x10ʹ\xd0\xd4\xfc\xac\xcc?\tI\x04\xd7Rd\x19\xc8\xd1\x1c\xf8\xf8\xbe\x9c´dY\n\x12\x89\xfbO/\x9f\x8f\xff8~\xfe\x05!\x89\x04|\xfd\x8e\xe5\xa04͋\x97\x84\x97Y\xf6\x05!\x9c\xe6\xf0\x92HPZHP\xe3%d Ř\x89/T\x01\x89\xf9\xd8\\\x8a\xb2xI\xea?\xd8w\\G\xec \xde\xd9\xd7\xf1\x97\x8c)\xfdm\xf3\xd7\xef\x98\xd2\xf8\x97\"+%\xcd\xea\x8fᏊ\xf1y\x99QY\xfd\xfc\x05!*\x11\x05\xbc$7\xe63\x05M \xfd\x82\x107&\xfc\xec\xc8\xf5z\xf9\u0092H\x16\x90S\xdb\x1fBD\x01\xfcrr\xfd\xfe\x8f\xb7\xad\x9f\tIA%\x92\x15\x1a9\xe3\xfaF\x98\"\x94\xbcǱ\x99\x0e\xe0$\x10\xbd\xa0\x9aH($(\xe0Z\x11\xbd\x00B\x8b\"c\t2\xb1\xa2H\x88\x98Uo)2\x93\"\xaf\xa9Mir_\x16D\vB\x89\xa6r\x0e\x9a|[NArРH\x92\x95J\x83\x1cW\xb4\n)\n\x90\x9ay\xc6\xda\u0590\xa3Ưkc91õO\x91\xd4\b\x10\xd8.;\x96A\xea8dz\xab\x17L\xd5C[\x1f\x8e\x1b\x12\xe5DL\xff\x1b\x12=&\xb7 \r\x19\xa2\x16\xa2\xccR#wK\x90\x869\x89\x98s\xf6ϊ\xb62\x035\x1fͨ\x067\xdfuc\\\x83\xe44#K\x9a\x95pN(OINWD\x82\xf9\n)y\x83\x1e>\xa2\xc6\xe4\rN\x0f\x9f\x89\x97d\xa1u\xa1^^\\̙\xf6\xeb'\x11y^r\xa6W\x17\xb8\x14ش\xd4B\xaa\x8b\x14\x96\x90](6\x1fQ\x99,\x98\x86D\x97\x12.h\xc1F\xd8u\x8ekh\x9c\xa7\xbf\xab\xa6\xed\xa4\xd5W\xbd2\x92\xa7\xb4d|\xde\xf8\x03\x8a\xf9\x8e\x190\x02oeɾjGQ3\xda\xfcd\xb8\xf3\xee\xea\xf6\xae)gL\xads\x1f\xf9\xde\x10\xbez\n\f\xc3\x18\x9f\x81\xb4\x93\x88\xd2fh\x02O\v\xc1\xb8\xc6\u007f$\x19\x03\xbe\xce~UNs\xa6ͼ\xff\xa3\x04e\x04Z\x8c\xc9+T*d\n\xa4,R\xaa!\x1d\x93kN^\xd1\x1c\xb2WT\xc1\x93O\x80\xe1\xb4\x1a\x19\xc6\x1e6\x05M}\xb8\xfe\xb0\xe5Z\xe3\x0f^ym\x99/\xb7\xfao\vHZ+Ƽ\xc6fn\x99\x93\x99\x90-\xe5`^\x19\xb7\x88v/Z\xd3\xec\xea7\x1al\xfd/k]\xf9K\xf5\xa0\x91\x1fӉ\x92\xb3\u007f\x94\x80*ήX\xd8P)\x1b$\x89\xef\x1f\x8a\xc5x\xe3\xef[xj\x1a<&Y\x99BZiۍ\xb1\xac\xf5\xf8j\xe3\x05\u070e(\xe3F\xfe\x8d\xfa7\xdd\xe6\xf5_\x8d:\xed\xe81\x95@\x8c\x042n\xe9\x11\xc6q\xb0\x9d\x9c6\x8di\xc8;:\xb7st\x04\xf79:\xcd\xe0%Ѳ\x84-\x9c\xa1R\xd2\xd5\x16\xc6\xf8\xbd\xf9P\xbeT\xcf;\x85\x90\xb1\x04\x9a\x1b\x85e\x8d\xddd\xa8\xdc\xec\x11\xf9\xa4\xb9\xb2\x10\xe2~\x1f'\xfej\x9e\xa9u\x18I\xd0\xc6!SX\xd0%\x13ҍ\xddm)S \xf0\bI\xa9q\x9bߠ[\xa2:\x15\x92\x14B\xe9\xed\\ؾ\x12\x89]\x1cۦp'\v7F\xe6\x14\x87\x9fb3Ж\x12\x11\x1cL_s\xb3\x12\xebg\xa5(\xed\xb3\xebھ\xc1\xf0n\x8e\x90)U\x90\x12\xe1d\xa0\xcc@\xb9o\xa5\xa8\x9e\xeaUv\xbe\x95t5x\xbb\xefft\n\x19Q\x90A\xa2\x85\xdc\xe4\xe4!\xfc\xb4\xed\x10ͱ\x85\x8f\x1d:\xc4\xe9^\xa7\x89\xeb\x81\xed I\x8c\xd1\xf1\xb0`\xc9\xc2n\x89F6\x91\x0eI\x05(\\F\xc6l[m\x1b$\xd97\xf7\xee#\xbb\x16R\xdd\xf6,\xa9uz]\x8b\xabn\a(\x9f\xba\xedQCm\xce\xd6\xf6j\xe7>R\xb7_'c\xbd^\x8d\x10\xda\xeb\x8dW\x8f+\xb4\xe8b\x18\xd3\xf7zF /\xf4\xea\x9c0\xed\u007f\xddG\x91fY\xe3\xfb\x9f\xf1ĄK\xfc\xf5\xfa\x9bG\x95\xf8\x9d\xb3\xb2\x8f\xa2\x99\x95\xea\xf3\x9f\xe1\xa4\xe0fq\xeb\xf6\x8a\x83'\xe4\xbb\xe6[\xe7\x84ͪ\tI\xcfɌe\x1a\xe4\xda\xcc\xf4Z/\xc7`\xc6!\xfb\x9di9\xd5\xc9\xe2\xea\xd1X6\xaa\x0e\xc7\x1cȗ\xf5\x97\xad\x81\xe8-\xe6\xf6Ƽ\x87.Ag\x8eIȭ\x93x\x87ܬ\u007fA\xeb\xfa\xf2\xe65\xa4\xbb\xd8C\x0e\x93\xbc\x8d\x81\\\xaeu\xb6\xf9ig\xf5\x1e:\fg\xfaT\x1e\x84u\xff\xcf\t%\xf7\xb0\xb2\x16\v\xe5\xc4L\x0e5\x1f\xda\xe2Kl2\a\xe3\x10(d\xf7\xb0B2.\xb0\xb0\xf7\xedCE\xc1\xb6{X\x1d\xf2\xd8\x1a\x03M\x9f\x9c\xbbg9i~@F\xa0\x1bz8\xf3\b\x06\x89\xbc.\xda?8r\xb8\"\xf1\xcd\xf3>b\x98մ5\x82i8\xb1'\xcaN\x91Y\x05\vV\x1c8P\x8c\xa5)\xc0\xd5\xe2\xc3D\xefi\xc6\xd2\xeaCV\xee\xaf\xf9vk\xb8\xddn\x84\xbe\xe6\xe7\xe4\xea\x91)\xd35\x9e\x92\xd7\x02ԍ\xd0\xf8˓\xb0\xd3v<\x82\x99\xf6E\\^ܪmÇf\xbc\xe9\x00\xe1\xb6\xedچ\x15\xaa\xe9a\x8a\\s\xe3\xb88~`\xf4\xd0~n\xf7\xfe\xd0ny\xa90\xa0\xc4\x05\x1f\xe1V9\xee\xfa\x92e\xf6\x81$\x85l\xcd\xc8fת\x8f\xda\x0f\x1eH\xf6\xce\xec$\xf6}\x1b\x0f\xcdh\x02\xa9\xf761\x8aG5\xccYBr\x90\xf3]\x1bG\xb3\x15F\xbf\x1fօ\x03\xb5\xaem\x81\x12v\xd8\xd6\xee\x9bS\xdd\xe9\xfeΌ\xcc\xca=\xe0)?\xd9{\x1f\xdd\x12\xbc\xdb\xfe\xe8\xfe\x11\xe1\x16\x8b\xf6\xc7^\xee\xd24œ\x16\x9aM\x024~\xc0\\l\xee\xfd\xb6cv\x87\xccia\xd6\xef\xff\x98m\x0e\x05\xfa\u007fIA\x99<`\r_\xe2\xa1I\x06\xadw]\x98\xa8\xf9\x19\xf3\x05\xa6\x88\x99\xdf%\xcd6\xc3\xc2\x1d\x83\x13F\xb7@f7r1۰X\xce\xc9\xc3B(\xbb\xa7\xce\x18d]!\x9bvc\x8a<\xbb\x87ճ\xf3\r=\xf0\xec\x9a?\xb3\x1b|\xb0\xba\xa9\xac\x05\xc1\xb3\x15y\x86\xef>\xebc\x04\x1d(\x89\a=\xc6;\x83\xbeuk\x89E3\xf0[G|\x9d\x99\xbb\xab\xd7\a\xc9a!\x94\xfekw\xc0nK\u007f&\xfe\x8d\xb6m\xda\x11\xf7\xdak\xb3\xbb\x18V\xa5T\x8d%7\xd3 ]\x10\xcf*Z\xef\x01\xf4\xf4\x8d\xf6\x05\xe9\xaa\x00\x1d\xad\x02\xe5\x86\xc1{\xa4\xc2\x1e\x00\x1c\xd2\xc5\x10\xab\xd1\xf0%\xd0\u07bezl\xc4\x18\xcd\n5\xffn\x0e\xe4\xd8Vm\"\xf2\x9c\xae\x1fy\x1d\xd4\xd5W\xf6M/ӎ\x90\x9d}9/q]\x1en\xeey\x19\xc2î\a\xa6\x17\x8c\x13\xea\x97?H'P\x94\x14b\xbf&\xb2mA\x15\x99\x02pϾ\xbd\xaa\xc1\xb6\xa7ݯsƯ\xf1\x03\xe4\xc5\xd1\xf7wR\xb3+j:=\xab\xab\t\xad~\xc0\x1d\xe7P\xd3H\xa4\xe4a\x01\x12ZR\xb1\x19\xf06\x16\xe3\x81$\xb9\xd0\u0378\x82\xa1[\x88\xf4D\x91\x19\x93J7;z\xa8\xc0\x95\xeaPq\b\x9ca3\xba;\x96\x83(u\xc4\x1c\\\xd5o\xb7N+s\xfa\xc8\xf22'4\x17\xe5\x01\x9b\xbbmf\u007fayu\xa4\xe8f\xe0\x812\x8d\xea\xce\xd0\xc5\b\x8b\x16f\x96\x8a\f\xf4\xa1S<\x85\x99QG\x89\xe0\x8a\xa5 \xfd\x91\xb7\x9dY&\xcc\u009dQ\x96\x95]\xc77]-\xd4M\xe5WRFy\xa9o훍\xa8\xe1B<\xb4\x19t0\v\x16t\t\x84\xcd\b\xd3\x04xb\xe6\x05\xa4U\xd9\xf8\t\xc7\fd\xcd\xc1by\x98\x827\rx\x99\x1fƀ\x11\xael\xc6w\x06Ś\x8f\u007fMY\xf6\x14\xd3f$/~i|_\xbf\xfdA\x96F\xa5T\x0e\xdf¦@\xde\x01MW~}P\xad\x8d\xab\x8a2 \x88,yS#>\xc1\xca\b\xf1\xef\\/\x8e\xe9\xb81\xce\x0e\x98صx>\xd3MkǐxRk\xc7|\xa0\xda\xe8bB3\xd7-\x02f\xab\xf4\x863\xf6\xbd\x92\x9a\x00\xcbg\n\xc6A\x85\xd4\x06\xbd\xcc\xf6\xe9\xech\v\xe4\xd9r\f\xde9\xba\x10\xd3e-\xcc\xeb\x1c\xcd\x06\xf8-x\t\xb8\x00\xefJ\x94\xe4\x81r텾2\xe6\nq\xa0ԇΪmT\xce\x03\x9e\xde@\xd6y\x93\xd5\xc3ۀk\xb9B\xb8ա\x9d\xb6\u0378\xa6\xa9H\xee\x8d9\x92\xd39\x9c\x9c(\xf2\xea\xcdk#*\xc6\xea0[F\xc0\x8e`\x1b\xb3'\xb1\x85\x14K\x96\x1a\xd3\xe9=\x95\x8cN3\xe3\x04\xcf@\x02O@\x91/O\xdf_\xbe\xfb\xf9\xe6\xf2\xcd\xd5Y\x10q\xe3:\xc3cA\xb9\x91\xc1R\xf9ݼ\x9a}3\x00\xe0K&\x057\f\n\xe3\xc6\xf5\x8cP\xb2\xf4\xbdM*$\x9aq\xb5\xb2\xa5\xb3\xe6\x82(V#\xf6\x81\x10ƋR\xfb\xe8\xe8\x03\xcb22\r\xa3X\xf2dA\xf9\xdc\xf0\xd5L^\x83\x8fD\xad\xb8\xa6\x8f\xa6\xdfA\x14\x8d\x99\xab\x12Z@\x8a\xd2O(IEi\x18\xf0\xe5\x97\xe7\x84\xc1K\xf2e\xe3#a\f\xbdrt\x1b\x13\x8fc\xe6\xb0\x04i\xedk;\x95\x87\x06\xc6m\x930\xa72\xcd@)\xa3\xcb\x1e\x16\xa0\x17 ]\xb8\xc6M\x1e\x84Dsm\x13\xd2\xc8m'\x02\xb1\xc6\x1c\x06Q\xf4\xf8\xc4\xfb\n`;f\xe2\"\x15\x89\xba\xd0Tݫ\v\xc6\xcdV5J\xa9\xa6\xa3\x862\xbb\xb0\xbb\xcc\xc8\xed{#\uf84e*1\xbf\xf8\x9d,9g|>\xa2\xd5S\x8c\x8f\xe8H- \xcbN\x02z\x19\xa4\x86m\v\xf6#\x9b\xaf\x85\x04\xee\x03\x1d~\xdbښ\xf2\xaaR\x8c\xf6\xcbcr#\xf4.d\xd7\xf6Vm\r\xc8\xe3q\xa7\uef3a\xb9{\xf7\xc3\xe4\xed\xf5\xcd]\xa8\xcal\xaa\xdb\xed*4N\xf9\xb4\xd4m\x87\n\r\x9b\xfd]궭B\x83\xe8nQ\xb7\x1b*4\x8c\xb1\x1d\xeav\x87\n\r\xa2]\xab\u06dd*4\xac\xbf\xeb\xeav\x9b\n\r\xa2\xba\xa9n\xbbUh\xb0\x1c\xac\xa9\xdbM\x15\x1a\xb6\xb5m\xaa\xdbA\x85v\xbc\x16\xa6B\x81/\xa3\xd5\xe7w\xce]h,\xf1j\xce\xc36W-\xf0\x84\x9c\xf1\xb6\xfe\xe8\xdam\x9f\x96\xf3\xed\x10\x16_\xbe\xa7m\x18\x00o\x0e6\x882\xa9\x97\x83G\x18\xe3vQy`a\xb6S\x8cWa۾\x93\x9e\xee\xb6y\xfe\xe3P\xfe\xf1\xfc \r\x9e\x8c\xc9\x1bw\"Nɫ\x9f\xaf__\xdd\xdc]\u007f}}\xf5.\x8c)$~\xed\x10\x0fr\xe8ɚ\x93\x0ew&\x82/\xbbw\xe4\xe0\x8dζB\u0092\x89\xb2\x06c\xf7_\xb8\xb6\xad\xaf[\a\x80Z\x11\x05rɒ\x98\xbevv\xad\x8f\x01a\xdb^3\"~\xf4{\x8c\x89\b\xc2\xdb=\xb8\x86I\x11A\xf7\xd8~\x9cm\xfb\xbc\xb9\x18!8\xa2Ab\xdb.\xb3\xe45\xcch\x99\x05\xba\x85\x8e\xac Ϟ\x8dC\xac\x02\xf7^Oe\xf5\xb5\x14\a\x86Λ\xad\xa5\xb0nmz\x8f\x8f\x15\x1fG\x9d\x9f8Hdk\x03WQ\xc2\xca\x1cj\xce{=A\x88\xa9\xba\xc5\xef\x97\xc4\x1eF\xce\xd8\xfc\r-\xbe\x85\xd5;\x98ŐXg;\xa2%\x1d\xb0\x90\x88C\x8f\x0f\xd7\x1b\xda\x0f\xb6k1k\xb6/_H\b\x96\xb4\xab\xb5xr\xe7p\xafh\r\x1a\xf6\xc4\r\x89\xf4[X\xbe\xc5\xd9I\xbe\xb5\x8d\x82\x86\xc1\x14M\xb1\xf2\xd8\xf5\xa1.P\"x\x02\x85V\x17bi\xf6ax\xb8x\x10\xf2\xde\xf84f\a\x18ٓ\x10u\x81\t\x18\x17\xbf\xc3\xff\xe9ѻ\xbb\xb7\xaf߾$\x97iJ\x04\x06\xdcJ\x05\xb32\xb3\x80\xab\x831\x9e]\xadN'?ǔ\xe6sR\xb2\xf4\xabpe\xeb\xdb\x11dC\x14\x16\x83w$\xf9\xb8\xc53\xdcU\x8f}\xcd7\xa3\xc2+\x8d`v6\xa6\xd5A\x00\xc8\xed\xcdCU\x9d\xd1ؓ\xedS!2\b\fA\xfbv\xf8\xa1`W;\f\b\xda\xd5\x02\x0e\x0e\xbb\x1a\xae\x80\xe3\xec\x1a'\xf5\xb6q\x18\x90\xb1\xbb9\u05ed\x10\xe9K\xa2ʢ\x10R\xab*U}l\x14AX\\\xaan-\"\x98\xd5uN\xfe\xab\xfa\x11\xb3\x06ԏ''\u007f\xfe\xf6\xea\x87\xff{r\xf2\xd3\u007f\xc5~\xa7\xa6٨2r\fª\x80d\xccE\nFe\x9f\xdb\u007f:/\xe62Ah\xc4M\x0f\xf6(Mu\xa9\xc6\v\xa1\xf4\xf5\xe4\xdc\xff\xb3\x10\xe9\xf5\xa4'I\xa4\xa1\"LPr\x14#`[ɏ\x90֒t_\x1a\xa4\xf7\xa6骈\x18y\xff\xda,\x99\tՋ\xc3\xc1U]\xedA2\xad\x81\xa3\xdf\a27\x1eɹю\xb1\u0383oƉX\xbex\xf6Q\x8d\x9e\x99gё\xa6\x11\xb9\xed\xd4M\x1f\x8dE|\x90Ш?\xefqW8\xba\x1eD/'\u05fe\xe4\xccGd|ߝ\xad\x9a\xb6\x8f\xb1\xbfy\xa8\xf1\xd7O\xb2\xcfy\xea\xfd\xb6\xba\x1aMj\xd1\xf7\x87\xe5pno\x19\xc3\xda4\x94\xa7u}\x9aS\xfb\xe38)\xcaXe\xee(\xe4\x90\v\xb9:\xf7\xff\x84b\x019H\x9a\x8d\x94\x16\x92Σ\xb7\x1f\xdfU\xecb\xfd/\xfb\xb9X\xcd\xdf`\xc1fO\xc3\xce\xcc\x1aT%\x90\xa4\x94\xc6\xdb\xc9V\xdeF\x81\xf4\xa3\xedo\x95\xfct\x17\xc79\xb4\xb5\x85\xbc\x86)\xf7\xf35k\xfd\x81a\x9c\xa5\xc8\xca\x1c\xd4y\xe5\xa5\xf4 l\xe8\x01_\x92%\x95\xea\xa3z\\)[2u(P\xb6\xabQ\xbez\x1b\xa9\x9a\b\xeaX;\b\xc65\xcc{\xb8h\xa3c0\xa3\xd3}t\x85\x03zL\xb6(uQ\"l4\xa7\xba:\xdfy,D\\\xe4ηF)\x17o%\xa1\xad\xf3\xe2Y4тj\r\x92\xbf$\xffy\xfa\xf7\xdf\xff2:\xfb\xea\xf4\xf4\xc7\xe7\xa3\xff\xf8\xe9\xf7\xa7\u007f\x1f\xe3\u007f\xfc\xcb\xd9Wg\xbf\xf8\u007f\xfc\xfe\xec\xec\xf4\xf4\xc7o\xdf|s7\xb9\xfa\x89\x9d\xfd\xf2#/\xf3{\xfb\xaf_N\u007f\x84\xab\x9f\x0e$rv\xf6\u0557\xd1]~\x1c\xd5\x11\x9a\x11\xe3z$\xe4\xc8\n\xc1\xde4\xff]\xcd3\xf7X:靷D\xfan\xbc\xa4i\xb1}D\xd5\xd1״\xeaņ\x9e\x96\x95\x82D\x82\xfe\xf4bζ_\xde\f\xb7\xf9+\x95\xc3\xff\xab\tC\xf7w=-\x9bj\xbf\x05\xcb\xdc\x11<\xea\xeeA\x16\x0fɗXA\xc0}\xe1\x1e\"ND|\x1bB\xe5C\xa8|[\xfb\xb5\x87\xcao\xed\xfa\xa9\xe3\xe4\x18\xed\xee\xb76\x878y\xd4n\x17\xfdr\xdchm5\xe6\xa0W\"{\x18\x89\xca\v=\xda\xefD\xe6\xd5%\xc4H!\x8a2\xa3\a\xe7EV\xfd\xd8D\xe1l\xc2\xf4\x82h\xba\xed\xb5F!\xd5\xc8i\vE\b^\x82\x9b\xa81r\x99e\x84q\xbbI\xe2ǂQ\xb1\b\x11\xb2Q\aB-\xf2oi\xd8\xf0\xb0\x00\x1e\x95Ug\x1bSDi*5\xe3\xf31\xf9\xdeв֘â0N\xf22Ӭ\bD7U\x1eVU\x95\x82P\xa5D¨\xf6\xa8\xe3\xd0\r5\xa3J\xfb)A \x8f\xa6\xf7\x88]L \x05\x9e\x00V1*\x03\xf7A?\xe7ӕ\xe1\xe8\x15_V`\xa4҂s!X\xfbt\xf7\xedc\x03G\xcd\xf2uК\x1a?\x1a\xa8\x141\xc6mi\x88Y]D\xaa:\xdf\r\xa3\x17obW\xe8\x97(7dö\xaeϧ+\xcb8\xe6TU\x8a\xfc\x03\xa3\x80\xe2\xcdܭ&nm\xa8F\xda\v\x9f\x9ay\xfb$\xa6\xed1\xcdڞ&m?sv\x97)\xdb\xc3\xe3\xa9W\xd41\xc0\x1a\xfd\f\xd0\x1eF`!a\xc6\x1e{j\x98K^\xcd\x11a)p\xcdf,\x0e**L\x87\n\xe0\x98\xcd\f4Y\xd8\x02\x88\xbc\r\xb2\x89\x91\xe9O\x00\xebn#\a\xc7Q\xe8\xb7kq\x8eA\x9b\x0f\xda|G\x1b\xb4\xf9\xee\xe6\x96\xd3g\xac\xca?\xa0\xa7\x8c\xb9\xb5\xf1\t\xc0\xaf\x1b\x19\xba\xa8\x11\x9e\"\x9b\xbbZ\xafuI\x8d\v\xfcbز\xc4\xf2\x9f\xb8\xf4\x8c\x17Ymr\xb6\x8c\xbax \v6\x0f\x8d\x88e\xb0\x84\xcc\xd9\xf7$\xa7\x9c\xcem\x11E-\xfcQ\x1d9\xb8\xa0\xafmF%I\x96\xb6Jn\xe1P\xcd\xc6i\xd4T&h\x98,\u05f7\x86)\x92\xb1{ \xaf\xa1\xc8\xc4\xca\xd5J\xe4)\xb9\xd5T\x1b\xb5t\v:\f\x00\x17\xa5<p4\x932\xcb&\"cIЙC[\xf4\xaeQ\xe6\x8a2\xcbH\x81\xa4\xc6\xe4-\x87\xd0c\x99\xcb쁮\xd49\xb9\x81%\xc8sr=\xbb\x11zb\xdd\xcdȌ\x16-\x1cQ\xc2f䥽\x19\x8bh:\xc7\xd0EU\xb9,L(d\xabcv3y`\xaa\xaf\x9f\x1e\xbcan,\xc0\xdf\xe1W\xcd\xd6i\xff\xfd\xe4⓱\x19$\xab$\x8b\xd7Y\x97\t\x82]\xeb\xc2\xdb\xf5\xba\r\v\x19\xad\x94\x86\xdc\x17\x8c\xc2\x00\n\xc3\x02\x83\x85\xe0\nl)\xb5\x98\bW5B\x1b0S=\xe78\xd6\xc8+\x84ҷ\x9a\xca\x03\vtխ\xbdJ'\x9e\x8c\x11\xff\x84f\x19\xa4\x84\xe59\xa4\x8cj\xc8\xc2\xe3\xe4\xbe\xf6c\xb3П\xbd\xe8Ж\x11\x8c\xb2\x1b\x16\x94\xa7\x19H\xacT\xe7b\x80-\xfa\x1ad\xce8\r-iA*x\x17\x86,!%4I\x84L]\x150_Ӊ\xcap\x8b\xbf\xd2xh+5v\x9eu\xbc^0\xe5i&\x92{EJ\xaeYV\x17\x06\xf4U\x01\xdd\x15}\xc1T\xa3TL\xf5\x9f\xa3jM\x8c\xf0&\xaa\x8b\xdf\xd5\u007f\xc2\x1fB\x8d\xdf>\x9e\xcfa\x95\\7\xdbZ\xf5A@\xd1@0\xa5\b߶|C\\\xb00\xe6\x8b\x11\xaa\xba\xb2f\xb5\xd1\xc49\x19Xz\xb6}\x9f\nE\xb5\x89\x15\xb1\xe8\xfd\x81E\xc8ڭ/F!\xa2ZM\xb3\xed(X\x1b}\xd6\xe8K\x9af\x8cC\xb3r-\xc3j\x98\xf1G\x98\xcd\x15l\xf5\x91\xf3P\xe3\x0f\x81\x99\xc4\xdb%V\x8d\xaa\x86\xb6\xef}\xc0\xfcR\bMNO.N\xce6\x0e\xb5N\xe2\xa9\xceX\x06vw\xb5%d\x92j\xb2\xa2I*\x96\x17\xd9\n\xe7\xe7$Ż|\\:\xac,\xe3Έ\t*53˾d\xd19Q\x82hI}}\xf9\xf8\xbe\x1aj\x86\xb8\x96\xa5\xb3UNO~99'\xa0\x93X<0!\x0f\x82\x9fh\x14\xa31\xb9\x13\xa4Tuǣi\xaeDI8\xd8r\x85\xf0Xd,a:[\xe16\x1fMS\x94ږ\xddë\xf1\xb0\x14\xd4\xd5#\xd3.O'\x9e\xec\x8c<\xc7\xd5nM\x05B\x8d3\xb4\x84\x8b\x05\xd0L/\xe2\xf3\xfb\x8c\\r\xc1G\xff\x04)\xb0\xd0\x14w\x14c\xa3;\x11gg\xcdv\x04\xc4IL\x18a\xfd\xedH\x10\x831\x10\xbe\x81`\x93\x93l\xdcEyw7\xf9\x06t{\v\x8bb\x87\xe9\x91\xc7\xe7cH\x1b\xe4LȎ\xcbV\xf7\xb7\xbe\xfb\xdfB\xa8(ΐ͛:\x95\xb6\xf7\x0eX'\x85\xc7ŜmӢ\rKv\x88Fr=\x89\ab\xfd Jí)\x9df\xab\xaa~\xa8\x02M\x9e\x99\xae\xc7Þ\x19\xc7\xf9\xfc+\xd0\x14+\xb6r\xa5\x81F\x9aHGXj\x8d\xbe\x1cǨ\xb17\xae.,\xc9\x1e3\xda,&\xe5d\u007f\x8ck*^M\x1a\x13\x15\xefܱ\xea\xd7\xf5\xf1#)\xc9\r]ag\xc1\xfd>\xed\x85M\xa4\xfe\xe2[;DW\xd57\xb2x\x87o\x8cc7qQ\xf4\xe8]\u007f\x940\xe9\x8dV%]'`\x96W\xbdh\xba\xdc\xcbpX\xdaz;J~It1\xacf{J6a\xf7>>\x9f\xfaA-I\x1c\x10\xb1\xfdz?N\xf4\xccP \xbd\xed-L\xe6\x89N7\xdeL6ւ\xd0$\x01\xd5\xc3Զ\x1b\a*,\x05r\x19\np\xac[o\x11+Dx\xfcҷ^\to\xc7Iw;J\xb2[G\xe5AIx\x99O{h\x92\xaa\x00\x86Ե\xc0\xb8\x89\xef\x11L\xa9\xcaV\xde`\xf7\xfc\x11n\x1f{\x0fM\x18\xca\xe7@^\x98\x9e\xfe\xe9\xdf\xfe\xed\x8f\xff6F6DS\xf5\a˔\x93\xeb˛˟o߿ªo\xb1R\xfe$\x99mX\xb6!z\xffi\x9f\xcc#)ýRa\xa1\xb3>3l|\r\x17\xff\xb6\xc1eկr\x9cmZ\xa0\xba\xf9Hz\xa6\xcf&6\xc2E\xf4\xa1\xfdl\x9d\x14\xb7\"\xb9?\x82\xa7}r\xf7jbI\xd5\xcev\xd4,P\xeeČ/E\xb6\xb47\xc5ݽ\x9a \x83\xe2fּ\x8d\xe7\x03\x18\xea[\x99>\xfaLx\v͉\xa2\xca\xf2\xc2ݕH\x89\x04\x9a1\xa5Y\x82ߪ\x8e)\"\xfd{q\x1f\x83\xe2\xf9d\xe2\n'o=\x1c\bC\fѫy-4\xd1\n1\xf4Q\x11\x8d\xd0DlJ\xd7`\x91\x1c\xdd\"\xb1[\xbd\x90\xfd\xec\xf8\xc1\"\xf9\xb4-\x92\xcfm\x8f\x8c~\xb5\x90p\xabE\xd1\x135a\x89\x1c\t3\xe1\xef \xdb\x06j iĔ\xda[\x83/'\xd7Ut\\\xb4\x80\b\b^\t\xa6\xaa\xcad\xe1\xcff8(u\x81\xf0\x88\xb2\xb0\x91/\u007f\x95`\xf8\x89U!\x01\xef_\x13\xfc\xbc\xaaH\x80\xec\x00n\u007f\x04\x9d\x84\xaf\x16\x8c\xc98\xec\x88;O\xf4\xd3\xd5\x17\x86\x91H\xaa\x16\x80ŕ\xe1\x91i\xe5\xef9\xa6Jp{\x84릏\x89\xf0\x03L\xa6HA\x95\xb2\aw\xba\x1e\x84\xfd\xc8D\xa4'\x11\xa7\xb7\x8d\x0e\x91\xb9\xa4\t\x90\x02$\x13)\xc1\xaa\u007f\xa9x\b\xef\xe7\x14\xe6\x8c+/\xbf\xa6\xa3~a\x18[\t\xa2N\x84\xeb;Gߵjb\xe3EեND\x84\x1ev\xaf;.\xae\x03\x88\x82S'\xb1\x9bf\xf9\x944\xcbV\xf5B\xf5\x99\x9e\xfa\xf8\x93\xb4\x89$\x8aeB=\xeeu$Q\xf8i`\vyd\x96B\x8dJ\xea#\xfe-\xe9dʬ\xaad\xd1\xe3\"*\x12\x0e_\x1e\xa0M{\xdb\x00m:\xb8\rЦ\x01\xda4@\x9b\x06h\xd3\x00m:\x88\xc4\x00m\xaaz4@\x9bv\xb4\x01\xda4@\x9b\xf6\xb7\x01\xda4@\x9b\xea6@\x9b\x0ej\x03\xb4\xe9\x806@\x9b\x06h\xd3\xd66\x1c$\x0eЦ\xdf\xe2A\xe2\x00m\n{}\x806\x05\xb4\x01\xda4@\x9b|\x1b\xa0M\x81m\xb0H\x06h\xd3o\xd1\"\xf9\xdc\xf6\xc8^\xd5\xc5\x02_\xf38\x9e\x89\x14\xd3\x1ee\xc6&xV\xcf\x12\a\x03\x12\xb3\xe8\xda:\xb6;c\xf2\xaa\x05\xcfp\x17\xda\xdb*-A\x14\x1dЧ\x86'\xf5\xad\xd7\x13\\\x93\xc9\x17\x05S\x17\x85\xb0\xff\xaf\xc6\x144\xc0\x046\xbe\x16\xb29\xc4n\xbe1(\x82}\b\x82(]\xb7\x1b=\x80H\x80`\x9a\xc7D\x0e\xf4\xb1nz \x06v\xa0\x05<\xd9(\xe5ڍ\x14X;\U0004f1828\x94\xc0\xe6i\u007f\x1f\xc0\x05B\xe16N\xfa#)V\xe8\x80m\xa7\xfcq&\xb9:\xfe\t\xff\x13\x9c\xee\x1f\xffd\u007fǩ>Y\x892\x8a\xe6\x96\x13}w2\x1f)\x9b\x9d\xa7\xf9\xfeT>\x8ef\xf7I~\xebD>V\x98z\x9d\xe2\xf78\x9c\xeai\\\xc7G\x92\xa3-%\a6\xbe[HP\v\x91\x05\xebږ\x9e}\xc38\xcb\xcbܨ\te\xd4#[Vh\xe6p\x19\xf18'ku\xd8c8C\x98\xa5\x80\x97XR\x96Ŕ\xaa\xc3\xd2z\v\x8a\xf1\tU&\t@j\xf6\xc9\xd7\xf5\x11x0\xcd?\x8e\xab\x91\xdb[5\x98\"/B%\xcf^\xa8\x88\xfe\xdd\x1f\xff\x105\xfb1\x9ea$`c?X\x03)\as\xb2?P\xa3\x8f\xb9\x11\x1bHy\x1ap\xc6\x0e`\x06\xf9!rk\xd8\x01\xca ,n\x9b=\x12 \xa3\x97\xe6\xec\t\xc4\xd8\x01\xc2p<\x8a4@6\x01\x18\xeb@\x8a8\x96ǃ/z\xecmO\x05\xba\xd8\x0e\xb8\x88\x15I\xd2\x1bl\xd1\x1fhq\xc4\xdb\x0ek\xe4@\xef\xdb\xf1{\x85\xe8\x8e\x109\xec\t\xaax*\xb6\x1c\x03B\xf0\x11o\x9f\x8d\x9e\xd5>\xe0\x89\x9e\xc0\x89>\xa0\x89X\xc0\xc4\x0e\xb0D\x9fHsO\xa0D/\xf1\x89=\x8e\x88>\x8a\xe8\u007f\f\xd1\xfb\bb\a \xa2ϝ\xb0\x9dG\x0f\xb1\xf7\x17\xfa\xd6>vX;>\x884\v\x9bG\x0eG=:8\xfa\xb1A<\x88a7\x80\xa1\x01D\x88\xe5\xe1&x\xa1\x0f\b\xa1\x87D\xc7*\xff\xa8C\x95h\xa5\xcd8ӌf\xaf!\xa3\xab[H\x04O\x83-\xa3\xb5\v\x91\xaa\xf5\xaa,9뙇#!Z٘\v\xean΄\xd4'\xd4\xfaӐpՊ\xe6#\xa1xNaF\xaf\xdbٓ\x1f\xf7܂|\xb4\x90\x81M)=\x86\x10\xfcU<\x101\xd3\xc0\xc9)\xe3^\x0e\xc2\xe3\xa8u\xb0\xa0\x8e\x17U\xcb\xda\xfc\xf5\xc5\xf3p\xcde;\xf3\xf9\x06v0\xb4\xa5\xd4\xd3\xc5\xf5\xdc\a\x8e\x1f\xd8s\x84gex\x8c\xbe\x15ܳ\x01¶~\x0f\x9e\xbc\xfa\x1a\xbe\x17\xd8o\xafM0J\xed\xca6D\xd0\xfcL\x85*\x1av\xb6\x17rF\"n\x1e\xdb\x057\xab\xa1c\xc1d\xb7@\xcdj\xd8XxG\xb7\xc1̢ c\x1f=¹\x06\x13\x8bw?\xb7@Ĝy\x16i\xc4G\xc3\xc3\x06?,\xb0퀁\r~\xd8'\xe4\x87}\x1e\x1e\x86f9\x88R\u007fR\xce\xc5Â%\x8b\xa6\xad\xc2rPD\x94} \xef\xc6\nq\xdd\xea\xb4.\x9f\xfa\xea\xa9_\x9dG\x12%q\xa1\xe1\xf9\x8e\x98\xd3z%\xa0\nt\x154n\xaa\b%\xafon\u007f\xfe\xee\xf2/Wߍ\xc9\x15M\x16\xcd\x12f\x9cP\xb3\xa9\x05\xd1D]\xb4\xa0K \x94\x94\x9c\xfd\xa3\xb4\xb7\x96\x92\xd3\xea;g\x1e\xbf\x17D7\x0e\xeb\x17\xb5\xcb\x18\xcd\x13\xa4\x04Z\x13\xf4\x1dSxI\x1cRqP\x18\xa1 \xfc\x02\xe9\xf6\xceC\xae\f\x19ki\xe2.\xb5\x00\tdΖ\x81N\x90\xa1\xea.V\xa4\xa9\a$\xe1\x126\xab\xc7شt*\xca@\xf5\xb9\x00\xc2A\x9b\xd5]E\xc7\x04W\xadzx\xa5\x02\x15\x86M\x9b\x96\b\xc3*$˩d٪\xd9I\x9a\x8dɍ\xf06\xfc*Ԇh\xb2\xf0\xf5۫[r\xf3\xf6\x8e\x14\x12K\x82Y\xbcQ\xf0\xeec\xa6\x97L\xc1L\x90\x9d\xf0tL.\xf9\xca~\xc8\xea\xf2@\xac\x921ځ\x1b\x82\xce\f\xf1\x17\xde>{>\xc6\xff{ffP\x86\x86\x97*hZ\xb2\x01еV\x0f\x9b\x06\xe6\xa0\xe0\xd0\x1b2\xd0\x13\x9f\x1bqL\xbc\x06\xb8t\xe3\x9a\x18\xd6K(읮\xa1\xbb%\xadD\x1a\xa7\x10\x95\xa1Y\u007fY\xb4=\x18\xef<%\xcd!\xf5\xbc\x0f\xbf\xb6O\xbc\xb1k\xe55\xc2VtrY\x88\xf4D\x91\xeb\x89\x17Ǳ\xbd\b\xda\xec\x04\x11D\x8d\x1c\x1a\x9b\x84\xa5\xb6\x83\xf6p\xe5\x9c<'\u007f&\x8f\xe4\xcf\x11\x14\x8d\xa9\xfc\xa7p[\xb8\x8f=\xd1'\xd0a=\xe5\xebI\xcfy\xfeި1C\xc9̌\x16dʢ\xf0\xb1f\x82\xe1Q\x834ۄ\x93\x98p^\xf6\xf0\xf6\xcc\x10>I\xb1\xb7'8׳Ɲ\xd3D/\xa2\x00\xa9\x95\x03\xb7E\xf0c\xfc8\xf2g'\xf8\xa6\x8b\u007f\x15J\xdf8u\x16\u007fK\xb6m\xda/n\x92S\x9d,\xda\xfaָ\x10Q˾.\xddHR\x81q0\v%^\xb0\b\xac\xd1\xc7[\xbaqЛ\x96\xa4nJT\x1fU\xba\x16\x12\xc0ر\xb3\xcbm\xb1\xd3H\x9dP\x88\xd49\ff\xc8ic\x93\f\xf5\x18l\xdb\xe27\xb8\bG\\\xe2x\x9d\xd4gtaB\xb9\xcdB\x9a\x81\x94\x88R\x8fa\xe9\n\xd1\x16,\x81\b\xb1\xec\xa1\x05\v)\xb4HD\xd6S\xb6&\x8e\f\x9e'\xd8`\xf5\x9bh\xd9\xfa\xdb\xeb\xc99\xb9{59'B\x92\xdbWw\x93~HfB\x9eݽ\x9a<\xfb\x80l\x8d\vN\x8d\xda\xe6X\xe0\xbb~\"\xc3\x1c\xac>\t\x85\xa18\xa7V\x04\xd08!\xa3\x9c\x16\xa3{X\x05\x99\xad\xf1\\\x8a\xe2\xd1f\xa7\xed\xe0sz8\x10\\\x02M\xd9'\x94K\xe9\x14Mݯ\xee\xa4\xca\\,\x03\xe3>\xe8\xb0y\xea\xc0\xd3B0\xaeUW\xa6e\x10\xd9M\xaf\xef\x93A,\f\x99\x96\x1dmȴ\xdcچL\xcb!\xd3rȴ\x1c2-\xbbېi9dZ~Vة!ӲцLˈ6dZnkC\xa6\xe5Amȴ\xdclC\xa6eg\x1b2-;ڐiyh\x1b2-\xab\xf6\xebA\xf8\x0e\x99\x96\x9f*\xc2wȴ<\xa4}\x1e8\xe8!\xd3rȴ\xf4|\x192-\x83ڐi\xb9ֆL\xcb\xcfU\xa8\x86L\xcb!\xd3rȴ\xec\xfe\xeeo\xdd\x0f\x1b2-?U?\xec\xf3\xf00\x86L\xcb!\xd3\xf2\xc3eZJP\xa2\x94I\xd8\x1e\xda\x16\xb2W\"/J\r\xe4\x9d'U-\xb4\xa0\x81OW\x16\xc9\xddH\xe8\xfb\xb0\x97\x18$\x82\xcfؼ\x94\x98>w\x91SN\xe70J\xec\xe0F\x15\x9fFU\xff.>\x04\xbc.c9\vK\xb54\xad\xce[\x9c\xf40\x92\"\xf7\xe4\xbe;r\xcf\xfd\xb8\xa0Z\x83\xe4/\xc9\u007f\x9e\xfe\xfd\xf7\xbf\x8cξ:=\xfd\xf1\xf9\xe8?~\xfa\xfd\xe9\xdf\xc7\xf8\x1f\xffr\xf6\xd5\xd9/\xfe\x1f\xbf?;;=\xfd\xf1\xdb7\xdf\xdcM\xae~bg\xbf\xfc\xc8\xcb\xfc\xde\xfe\xeb\x97\xd3\x1f\xe1\xea\xa7\x03\x89\x9c\x9d}\xf5epW\x8f\xbc\xbf\xb5\x97\xe5w(9\xf5\xa9$\xaa\xef\x9c>\x1a=\x1b.\n\xb9(\xb9\xb6@]\xbbګ\x05aOrC\xd7&\xf9\xc4\xd6'\xe9\xb3e\xbb\x93\xeda\x99\x06\xb4a\x99\xdae\xfa\xce\xc9N{\xa1\x06\xf71w\x06Ԏ\x85\x1a\x05B2\xdb8\xe6\xc7U\xfdd\x8a\x88\x9ci\riT\xceQ#\xab\x1a\xc1\xadMgת\xacp\x9bofq\x99L5\xa1\x9e\x8d\f1\xa1\x17 \x1fXD*\xa3q\xbfx\x1d\xf1@\xd53Ja\xc68\xb8K\x9e\u007f\xb3j/\xea5\x05I)\x99^\xbd\x12\\\xc3cP\xa4\xa0\xbdln\x1d!\"\n\x8b?\xf6\xa80\v\xfe\x0fS\xdf%\xc7L\xbf\xe0C\x8dBd,Y]\xf8A\xe1\x1c\xc0\xa3\xbex\x02+USu_\xcb\x02\x8c\x8c\xbbRO\xf9F\x0f>\x84i\x8a\xfb\xfeD\xb2%\xcb`\x0eW*\xa1\x19\xcah?\x97\xf5r\v\xd5\xe0efX!E\xa6\xc8\xc3\x02\xcc\xfa'Ԍ\x14C)\t\xe5dN#@U\xb9\x99\xab\xc2w\xce\b\x9d\xd1\x0eZ\x91\x82J\xe0\xda\u007f \\'`9\x80\xa9\x10\x99\xcbx\xc8Vu\xffY\\\b\x89\x8b\x9f9<\xfclz\xab\xc8,\xa3\xf3*!J\x81\x8e\x85y\xd4'\x8c~\xa8\xe4h\x13\xc6\x14\ue984f\x0ft\x85Ӷ\x16\xf1\x8a\xa0\xf8\x92\xbc8\xc3\xf5M\x15\xa9\xfa\x98\x92?\x9c\xe1\t\xe9\xab\xcb\xc9Ϸ?\xdc\xfe|\xf9\xfa\xcd\xf5M\x9c\xda4s\x06\x811\xfb\x84\x16t\xca2\x16c\xeem \xbf\x9a\xc4p\xbbJӋT\x8ap\xc81\xf2[\x96\x1c\xeb\xa9Թ\xe6\xfd\"<͢,(v\xb3V\x87\x83I\xce%\xe5x\xa6\xbdZ;\xf0\x96%\xd7,\xff\xa0IY4ퟐu\x99\xa6\x90\xf6c\xc9\U00070b2f|7VuM\x98(\xaa\x84L\xde\xde^\xff\xbf5\xd9\\\x15}\x00v\x1f!\x97\x84\x10\xb3\x90z\xcf\xf1;\x9b\u007f:\xcc\xf2\xce\xf6\xf9e\fUv@?L\xc1\xbb\x92\xb7+\xca\xd5t#\xec\x93\x14\xc6db\xb7fPmj\r\xa5\x1e\xae\xeb$\x10C\x92kF\xb3lE\x8cg\xb8\xa4\x19\xd8\xe4\t)\x02KՑ\xf5\x1a1Ml\u008cf*X\x91\xc7\xef\xc6Ɛyc\x9c\xe6^\xb3XQ!)p\xa1]\xb8-j5\x88\x19R#֓o\x80\xfdZ;^\x94\x91Yo\xc6Ly\x9eO\xaa\x9e\xe3)O0\xd5R\x81\xeaތ\xe3o\x957\xa3\x95@S\xcc\t/\xa8^X\x9cIN\xd5=\xa4\xf6\x87H\x1b\xdb\xc54l\x8f\xab\xa1߭\n 3\xa0\xba\x8c8rB\xdbڢw\x80\xd3i\x16\x1e\n\xedQ\x9e\x83\xa6oy\xb6z'\x84\xfe\xbaJC\xee%\xc8\xdf;o\xa9}\x16\x13\x0e\xb0\xc0ʙ\xa6\u007f#\x9cDL\xbbnfJ;\xe9\x8b\xf1\x12>\xb0\x82\x90%\xbfT\xdfHQ\x06\x9b\x02\x1b\xc6\xfa7ׯQ_\x96V\xf7\x01\xd7r\x85\xa5%b\x94D{\xcdU\xfe\xd8\xdf\xcczt+0\xdc\xe0\xf1\xeaaFJ\xae@\x8f\xc9\x1b\xba\"4S\xc29\x8e\x11\a\xb2d\x82(\xc9f\xdcgL\xb0\x86\x13\xe8\x98̦\xa9\xd0\v\xb2F\x10\xd5\xc3\xe6w\xc2\v\x10\x18\xa6\xdaC\xe8*~h\x86\xb0F7\x9c,\xbd\aE\n\t\t\xa4\xc0\x93`\xe9m\x9c'\xff\xe9_?\xd0y\xb2\x93\xfc\x1b\xc1\x8dz\xe9%\xfb\xd7<e\t\xb5\xbb\"\xd5=\xbdzD\x988\x9f\x9eb\x86<*\x97R\x81\xc4H\xb5\x96%\xc4M\xfc\xb7\xe5\x142\xd06P\x82u樶%-XN\xe7\u1ac9\xeaj+Ԃ\x00W\xa5\x04\x17\xaa\xd6$\x15\x11n\x80\xab\x03a\x86\xfe\xb7\xeb\xd7\xe4995c?C\xf1\x9fQ\x96\xc5dm#zsM\x9b\xb0\x99\xef\xa2ai\xb8M\xc0\xd1ٗVU\x9f\x13.\x88*\x93\x85\xe7iLt\xc8\a\xaf\x1c\xc2\x19!\xe8\x83j\xfa\x04TSύ\xf5o\nd\xef}\xf5o\x1f`_\xed\x13\x063\xba\xa9=k\xa8PH\x0e\x9a\xa6TӘ#\xaf\x927\xaa\x1b\xad-\x85\x18\xd9ݽ\x14P\xb4\x83i\xfeƖ\xc2\xc7٥\x15|\xc7x\xf9h\xc1\xc8\xfd\x03ʷWH\x8e\xb8\xa3\xa4\x98\x1de\n\x84\x16E\xc6l\xf9\x9d\xb5*\xed\xd7-э\x9b\xfbMW\x13\xb7\a\x9ae\u0098\x19\x11\x87Ғ\xf2T\xe4\x1b\x837\x8e(\xd0\b\xaf\xb81\xe0\x8eŹm\xb1\x85\xef\xdd\xf5\xe2\xfc\xad-\xb6>\xa1\xfb\f\x96\x10Q(t\xfd\x1e\x03C\xc58\xa4^j\x90lT\xf43\xa3SȬihW\x8e\xda\\9\xd1Q\xd1Ƞ\xaa\x14Y\xff\x94\xd5w\"C</\xad\x98d\xc8\xfejx\x84/\xf7\xe5\x11F\x9fZ<\x8a\x8e\xa2\u007f\x8a<*#,<\xb2\xce#c&\xb6yd\xc8\xfeJx\x14}\x04\xa1 ID^L\xa4\x98\xb1\xf0ź\xb1\xf5;r5\xa6&|\xeb/\x15t!\xb9юD\xe2\xe1\x16\xb9\xeb\fE\xe8\x85X\xb2\x14R\xe3Y\xe3\x9e\xe7P?\xc1D\xffOÄ@\xad}\xbefW\xb8\xaf\x86\xf7v\tR\xfaB\x98\x85H=\xa1\x0f\xba\xbb\x89\x84fx)@\x9c\\\x90u\xd9X'H\x98\x8f\xe7D-@\x04\xe2 \x1d\x8f\xa4ê\xe8\xf8KDd\x80\xf8\xfbuD\n\x8dگ%^0sgsV\f\xed(\xc2>\x9d\xc9\xd8)\x1e|\x95\xfa\xb3+\xf3Ÿ\xee\nW\xea\xf2Mu\xad\x81a8\xf04\xb6\x92CA\xf5\xe2\x9cH\xc8(&%;\x85vo\x03Z'q\xf3\xd4\x18\xb0\xd7\f~\xe2\xd0\xcef\x82\xc7%\xa1\xe1\xa8\xf1X\xc0[\xc43ԁF\xc1?\xfb\xce\v[D\x9d\xf3Oa7\u007ffGRE<\xe3N\xd5L\xbbg<u\xb9[-\xe6\xbbPX\xdcڳ~٘\xbc\xc7k4\x1a\xca\xf5%\xf9{\xdcګ&\x8c\x8c6\x97v\x14Ŧ:\xe8X\xdaQ4\xad:xg\xddE_\x13a\xd43\x14Ml\x14\xaby\xd8Y1 \x02\x88\xea[\xa5\xbd\xfe\xc6q\r\x1a\x159\"\\\xf4\xd2d\xb5f\xf42\xf0\xecî\xaf\xf8\xf4\xcb\bs8ڤz`<\x15\x0f\xeaXє\xef-9\xef:'F\xddi\xc6\xe7\x11\x10\x8c:\xa2B\xb3\xac\x85\xd0;FH\xc5k\x82ꪲ\xcd\xd0At\xcc\xde\ns;\x96\xd8;~\xb0%\xbcQ\x87+\u0083);\xc2\x1b6\\\x11L\xf2\xe3\x847湢\xaf\xa4\xf9\xaef4\xbb-\xc2+\xf4\x93uY\xfe\xe6\xcd\xede\x9bd\x9cfG\\\xaf\xb4污Ih\x9a3\xa5\x98\xe0\xe4\x01\xa6\v!\xee\xa3\xe8\x9ez\xe8\xfc\x9c\xe9E9\x1d'\"o\xa0\xe8G\x8a\xcdՅ[\xd9#Ý\xb8\"\xe5\x8cg\x8c7\xd0.\xc0\xb5\xf2'\x06f0qVV\xc5U\x14@wY\x90\x03\xb8n\xb2\xfd&\xb6\xc8\x04ֆ\xfc\xe0&զ(\xdeD\x16\x04\xdd#\x8e\xd1|qw!4\xaa5X\xc1\xac\xe7%n\xbb5si\x8f~>|\xe4\xce\x1f\xac\x1d\x85\xd7x\xc0\xe6\x88\x19\xcd趴X\xc7g\xf3\x90n\xe3\xd0-\xce\x03Z?\xa8\xf3NPt\xa0hǁ\x1da\xe1\xb1zb\x0fƏzhG\xb6\x1c\xdc\xc5\x1fa\x93\xa7>\xc6&Ow\xa4@\x8ep\xac@>J(/.\x99Ζ\xdd\xeay\x17\xd3m\x83J\xc3m\xd5\v\x1a~\xc5\xf1D\xa4\xb6\xf4`U\xba\f/%ƒ\x89쟡\xc0\xc8\xf65\u007f\\\xd8<\xcef=Bw\xf9L\x98\x97e\xfc\xb5\xccG(\xf3\"3\x16B\xab\xc7\xc1\x90\x17\xa4ո\x14\xea\xbcbF}\a\x95\xab\xc6\x18\xb6^\xfe\xbbT\x9a\xd0\xea\xde)_tmR}ʆ\xb8\x02/\x1eq\xd7\xfcaeq-|P\x95\xa4l6\x03\x9f\xc6\x16\xe8e\x17T\xd2\x1c4HE\x1c\xbek\nsfs\x89ČPÌ\x93\xc00TU\x8d\xe5\xdc\xe6&1Mr6_XS\x9cP\x92\t>'\xc1(G-H&hJP\x8b\vI\x1e\xa8\xcc\t%\tM\x16pn\x13\xfa\xd22x\xe1c\xb9\xff\xd5Hi\xaa\x8d+\x056[\xd7]\xf3\x9b\xf82&ab\xeao\bC\x1ax\xf41\x05M=L\xd9c\x8d\x83h:\xab\xb2\xb5\xe4=\xbdYF\xe7\x11Ew>\x81\x82;\xc3\xd5e\x1dm\xb8\xbalk\x1b\xae.\x1b\xae.\x1b\xae.\x1b\xae.\xebn\x9f_\"\xe2pu\xd9pu\xd9pu\x99m\xc3\xd5e\x11m\xb8\xbal[\x1b\xae.;\xa8\rW\x97m\xb6\xe1\xea\xb2\xce6\\]\xd6ц\xab\xcb\x0em\xc3\xd5eU\xfb\xf5\x94\xcc\x1f\xae.\xfbTK\xe6\x0fW\x97\x1d\xd2>\x8f\x8b\x05\x86\xabˆ\xab\xcb<_\x86\xab˂\xdapu\xd9Z\x1b\xae.\xfb\\\x85j\xb8\xbal\xb8\xbal\xb8\xba\xac\xfb\xbb\xbfu?l\xb8\xba\xecS\xf5\xc3>\x0f\x0fc\xb8\xbal\xb8\xba\xec\xc3]]\xa6tʂ.\x1b8\xa0\xceeL֞\xaf:C(\x99\x96\xb3\x19H\xb42\xb1w^I\xc5!\xdb}Ms,j\xe7@\x80ƲQ\xa0ϱ\xb4\xa6\xcd\xd6\n\xf3):\xbb\xe5\xcb\xe7`\xe1}\t*\xb40'\xe3\xe4\xea\xed\u05f5\xc3\x16S\xa43\xae\x8e\x18\x8e\xe7-O\xe2\x01е t\xd4\x15\n\xe3\xad\x05\xef%\x99P\x16Mf\x99\x9d,(\xe7\x909φ\x85qvA\x15\x99\x02p\"\n\xe06/\x88\x12\xc5\xf8<\x03B\xb5\xa6\xc9blF\x10fy;!p\xf7-\xd4=UZ\x02ͭ0H\xc8Co\xc80]$4\x91B)\x92\x97\x99fE\xd5I\xa2\x00\x93\xbc\x02\x0f<\xafg\xf5\x04#\xc0\fЬ\xc0\n\x8f\xd5(\x82\xfbh\x13\xf8\x1bk\xde\xf8\x80\xe7x}P^\xe8\x95\x05\x88\x87m|\v 3&\x95&Iƀk7j[Y\x04\xfbyNBqu\x1a\xefc\xc0YP\x8e\xb5<E\x8b\xaa\xd0\xca\u0097\xe3:꺘2\xe5,wuN\xa8\xf6\x1be\xb0\xd0{YB\xb1O\xb1\x93\xbe\xd7\xee\xa7\xc8n\xd6\xc5(U\x8d\x9f\xaf\x95\xe1,\xa3a\x16\xafWJ\xe7\xcd;\xa8|ڐ/M\x8aj5\x88,&\xccZ.\xe0\xc2\xe1\xb04\xfa\x03\x12`K\xb4%\x8df\f\xa2\xb8\xaeE\x9f\\\x89j\x909\xe3\bY\u007f\x03J\xd19L\x02\x8f\xe7\xb69\x97xBW\vW\xa0;\x81y\xf4Z4l\xb8\x1a\xbcz\xa2\x9a\xdd\x0e\"\x9b\xdb1V\xc9\x19\x0f\x92i\r(\xc4Xs\x1dQ\v\x81\x18\xb2\x8d\xce5\xa1\xb5o\xfc\a\xed\x87B\xa5\xd6\xd8S<\xb5\x80\xd0)\x90\xa9d0#3\xc6i\xe60\x9ca`e\xac\xc4J\x95\x11M\xaa\x14H\x8c\xc08\x88\x9f\xe7M\x98\xc0~\xef\x18\xa9e\xc9\x13ڸ\x9f\x05\xeb6\xb0\x19\x99#N40P\xb5\xa0\x9c\xfc\xeb\xf3\xff\xf8\x13\x99\xae\x8c\x15\x8c`\x01-4ͪ\t̀\xcf\x03\xabR\xba\xed\xa9\x9dA_I\x02\u07b6\x1a\x88\xe81\xce\xc0\x1f\xee\xa7\xed \xe5E\nˋ\x86|\x8e21\x0f\xe3\xe9\xe6ݷ!\x86|TX\xa0C\r\xe0\x05gъ\xc0\x97}&\v\xf1`\xefC\xe8\xb9b\xeb\xf4\x88B\x14ef\x8fs\xbe\xf65Q\x82H\x96\n6\xf3\xb8;\xf5`\xa84\xf8\xae\xad\xedb\x0en\xed\x86\x12f\xb4\xb8\x92\t.\xac^U{.%\x8c\xc9\xd74˦4\xb9\xbf\x13߉\xb9z˯\xa4\f\xbc\x96\x11\xa5\xdf\xf3#\xa3ƊY\x94\xfc\xde^\"YU\xc9\x12a\xbb\xad(uQj\x9f\xb9\xd6\xe0l5\x99\xc1\x95L*\x03͌\xbf\xcd\\x4\xeb\x16o\xf1\vS\a\x9c\x80\xe1\x97\xd5.\x99\x98W\xfdV^\x19\x84\xa2\x89\xff\xf0\xfc_\xffݪ,\"$\xf9\xf7\xe7\x98n\xa2\xce\xed&\x86\xb6\x811ds\x9ae\xa1\x81\xb0\xa6\x821B\xdf\xf3\x82\xec8\x1d\xa1\xe3\xd5\xc1\x93\xb8\xdcww?\xa0\xbfʹ\x82lvn\x13\xaa\xfd-\x1fADOЈ;q\xbb\xac\xf11>\x86C\xbb\x14Y\x99\xc3kX\xb2>\x97\xb2\xb7\xa8\xf8\x9c\xa9\x8c)MDXj\xeb4\x13\xc9=I\x1d\xa1\x06\xaes\xfd\x06\xb6\x10\xceD X\xb7\x8e\xaeqonh\x19\xed\x9c\x16E\x95\xa0*\xe9Ck\xb0\xa8K\x82\xc1\xab46\xc8\x1d\u007f\"d\xbb\x1bj\xb0\xfbw\x1b\\\xad\ty\x81)Bw?\xdb\\\xd6\xd6F)u\u007f\xf7C\xd49\x85\x9b\x13k\xa7\x99\x99C\xcd\x1c\x1e\x9a\xefq`\x12\x87\an\xf1\x98W\xe7\f9\xd5Χ\x89<{C\xa9-@*\xa6\x8c\x01\xf3\x1e\xd7ī\x8c\xb2<>;0\xa6\x94f\x8fˋb\xce4F\r9\r|1\x98ѽ\x8a9\x84\xe1b\xadJ\xc3˨\xe2\xb5\xfeD\xa4\x8e\x10\xaaj{\x81\x98qe\x83\xc5a[\x92h/\x83\xa3\xaf\xda\u007f_\xf3\xa8\xa9\xf5\xed8×3. K\xd3)\xfb\x8f\xa1\xbe\xb1\xf3G\xd0ި\xb7\xdd0\xfa\x16\xd3i\x06l\x9c@5\\/\x17#\x19[\\M\x04y#A\xae{\xe4\xe4\xe5\xc9\a\xd5\xe1\x96\xddR\x14t\x1euY\xf5\x1a\xd7\xd7ɑ\x14\xac\xab\x13\x95\xb4b\xdcd\xa4XU;F\xba\x90VU\xf9\xa2\x88\xdad\xbcz\x1f\xf6\xee\x13\x96S\x89\xa0\xf8@W\x84JQ\xf2Ԟ=ԇRo\xd6\xd8q#xL\x97\x99\xaa\xaa\xf7\x1aÛA\x86y\xffX\xfe\x82q\xf2b\xfc\xe2\xf9\xe7\xb6\xf1\xe3H\xd66\xfeȒe\r\xbd\xf5A\xb9\xe0/\x1b\xecɉ7.\xc4Z\xdf\r\x18UK˞\x01!\x91\aɴ\x93\xe6\a\xa6\x80\x9c\x86F\xcd}\x13\xb2Y\xa0\xeb\xec\bג\xf6\xb9\x1e\x89\x10UN\x9f`g\xb0\n=\x82=\xa8\x84\xbab\xf1*\x9efǶ\xd2d\xfa\xb3\x98\x1a\xb5\xa7\xb67'\xb6b\xc6\xd9\a]$nʮ\x1e\x8b\x88;\x0fZ\xd3v\xf5XP\x8c\xfa\x17\xf5\xfcŬ\x93z\v\xdf>\u007f\x11t\xb7\x9b\x05\u007f\x81\x05]F\xed\u007f\x8a\xe5,\xa32[\x99\xa9\xbf\xb5\x9c$\xd3R\x13\xe0K&\x05\x8fBn\x12\xb2\xa4\x92\xd1i\x06D\x02\x16\xb8J@\x91/O\xdf_\xbeCtWL\xd1\x0f[{\xcc\xcdO\xa9\x18\x9f\x1f\x81\xa3\x8dA\xae/\x82Z\xa4#\xe8\xdaE\xe0\xf9i$\x13-\x00\xcf_\x1a\x01U\"$/uio\x82~L\xb2R\xb1\xe5\x87܋b=\xc7\xca\xd6\xfe\x159\x8e\xae\xdc\xd0k\x16\xa4o\xd6J+Պ|\xa3zQ0`\x03\x8d\xc1\xbal_'\xac&P\x8e}i\xf5f=i\x17Pw%\xe1l\xfa\x81\xbb\xad \x14\xba\xb4\x866\xc8\xe9\xfc#\x84\xd6Ce:H*\x83\xe51L\x12\x1d\xee\U000f0bb7\xcdb\xfb\xa6\xbb-\xc0F\x1ds\xfa\x88\x80J\x8a\xcb\xf5\xc0\x11\x8a\x19\xf6\x82\xbc\x87\f\xa4\xf0\xdb\xd2\x03e\xba\xcaUa\x9c\xe9W\xa1W\xe0\xa3\xe3d\x8bD\x1e&\x00AS\u007f\xf0\xbc\x1c\xf8\xe0\xfei\xdb'f;\xc5jo/v}\u007f\xc7ˌ'Y\x99«\xacT\x1a\xe4;P\xa2\x94\x9d\xa7\x1fk\xa7˝o5\x12R\x1e܁Sb\x1f\x19\xa9D\x14\x9d\xeaA\xd6/W\xf6\x8c\xebT\xea\x93U1\xb7\x89\xbat\x15W\x15Di!aK\xb9P^f\xd9ZB\x84,;\x84\xc8<g\xac\x93-\xb8\xf0]\xfe\x83\xef\xa2q$UA\x0ffY\xe3\x05[XRe,\xc1\x80=\xf7\u007f\xb0\xffez\xed>\xd21B;\x97\x16\x84\x8a\xb8,<\x9d=Gp\x05\xaf\xbf`\xb3/\xed\x877\x87\xbf5(\xb8s!\x1dĴ.9\xf4\x1d\t\x14\xb2\xfa\xf95\x86y\xc99\x84_\x9bb\xd3\xe4X-\x83\xee\xb9)M\xee\xcb\xe2\xd3b\x1fޝv\v\x19\xda\x06{X\xf7]\xf3Y˶\x1c4]\xbe\x18\xb7\xffb|k\x96iD!w\x9af\x0f\x16\ti\xb8fL\x13\xc6S\xb6diI\xb3\x96\x046xV\xb3\x16\x93YX\xd6\x05\x90\xc2rz\xee\xfd\x16\x8f\x89\x87\xaf\x05\xaf\xd5\xddQ`\f\xfc\x18\xf3\xdbAa\xbbUp;\xbc\xb8\xf6\x8a\xe5\xa2;\xc7u\x17\xd9)\xcfG\xa7ڍ\xff\xb0\x15f{\xe7\x8a\x19\xf9\xe7p\xe4\x977\xaf\xb7\x997;C\xf6\xad\xae^\xee\xe8\x8e[3Մ\xef*-\xed\f1e\xf3)\xd49\xa1\xe4\x1eV\xe7\x16\xfc\xca]m]G\xc4\xdew\xe5̆{\xd8n\xaa\x98\x97-\xbdm\x8c9$\x80\u007f\x0f;c_-v\xdcê:vG\xbe\x98\x1f\xaa\xcb\xef+V\xb8\v\bw\xef\xfd;O9\x0f\xb27<\xd7\x0e\xee~\xc5f\tF\xf8\xac\xa8\x981\x9c(wɘ\xe0j\xc1\x8a}\xe0\x18\x8a\x90m1\xf3ܯ\xae\x9d\xb2\xe4\xad\xfc]\xf3sr#\xb4\xf9\x9f\xabG\xa6\xf6$䘹|-@\xdd\b\x8dO\xf7f\x8e\xed\xda\xc1\xac\xb1\x8f\xa3Hs\xab#1I\t\xbfQ\r\xf3z\u007f\xee\\\xc5b\xa6\xc857\x8a\xca\xf1\xa0*\x8e\xaf\x1cy\x9f\x97\xc6\x05\x1f\xa1V\xdbm\x89\xdao\xb7\xe8[\xb6\x9ao49\xd7\xfc\xd4n\x96\xb7\xbaa\xbb`#\xda\xf6/\b\xd0.2\x9a@\xea\x8ag\x9b\x89גj\x98\xb3ݥ\x8bs\x90s\x04\x1a$\x8b]\xa3:\xe0\xe8\xf0@\xc3\xfb\x18&\xf2vU3\xaa\xd8\xfe\x14&\xb4\xdbCp\xfb\xdc\xc2\r\x9a\xfa긓\xbd\x1am/\xc76\xf7\"\xfbi\xb7\x99\xd3\xc2H\xfe\xff\x18\xf5\x8cB\xf4\xbf\xa4\xa0L\xaa1\xb9t\x19*[\xbe\xdb|\xc3\xd9:M\xe2\x86.S\xc4\xcc\u0092f`\x8b\x1cRN`g궘m\xec\x96\xe7\xe4a!\x14\xee\f\xf5!ҳ{X=;o\xad\x90-\x14\xcd\xc3\xd7\xfc\xd9yu^\xd6Z\x94\xd5>\x85G\x18\xcf\xf0o\xcf\xc6\x1b\x1b\xec\x16\xda{\xb6ݝR\xb2㏕\xd5\xfd\xc6B\x9b6g\xfeP\xf9\xd8)\x1b\x1b՛\x9a\xdfl\tG\xd38n\xb9\x15]\x9f\xa4r\x0e\xba\xcb\x05q\x163B\x19\xc6䒯6\xe8bb\\\xa7\xc9휸JΊ֝\fB:\xb0\u007f\x93\x94\x03.\xa9nG\xd8<\xb89k;&\x057P\xb9\x84\x1b\x91\xc2D\xc8.|G\xfb\xbcf\xfd\xf9\x0e\x8f\xb6\xc1\x14\x91\xa5\x88\xb3\xc7G;\xfa\x8b\xb6\xb1\xb3\x8b\x8f\xe9|\xba\xefO\xde\xef\x1bϻ\xea\xc1\xdd\x03\xa1X\x97\xdb\xceW\xc78\xcc\xfb6׆\xd3B-\x84&\xa7KF]F\x93(Sw\x8d\x85\xec8\xef\xe91J\x95, -3\xe8\xbeIi\xa3ĕ\u007f\xd4\xdb~%g\xff(ۗK\xf9\b\x95{\xbak!\xd4<\xa9\\\xebFDը\xa3\xbf\xe0|\xfa/9/\xd2Q\xde\x02\x85o\x92\xb4kA(\x8dyJ\\7\n\xb6x\xb73q\xa5\xbd\xdd\xe3\x9div~\fۖC\x87\xfa\xe8\xde\\G\xee\xab\x1b'\xe2[\x96\x95\xc5ҷit\xc9ܭ\xc5\xdc'\xb4Х\xf4\x17\xb7\x95\x12/9\xa9˟S\xcf9Ǣ\x16\xd9펁\x8b\v2\xc1\xefX\x0eJӼ\xd8#!\xaf6\xdf0\x13 dj\xbb\x86\x11\xccF\x88\xa0\xbeI\xa4\xcb+\xa6\xf5\xfd5\xe9\xb8AےA\x9b̐\x86\x94\xc0\x128qI>x\xf2n\x03\x10\x1dD\xed-\xe0r\x89g\x01\x9e\x0e\xe2}gB\xda{C\xaa\xaeoJ\x84O\x1dO\xa9\x86Qg&\xe1A+\xb1s\xd7A\x98\xfe>U\x83\xb9\x0f\xce<I\x10'f\xa67\xcb\xec\xdb>\xf3\xc0\xa5\xfa=\x80\x042\anXܩq\x9c)k\xaf\x890\x8cu+\xb8\n\xe0\xdcٛ5J\xea>`ݙjW\xd9\x1a2\xc3G:\xb3\xacv%л\x8c\x8fw@U\x17n\xa9ň\xaf\x9b\xcf:_\xc5\xf2\x00\x87\x9eP{\xf9\x8f\xbd\x86\x8dI\xe8\x14}\xd7%\x81_\x0eX\xe3\x84\x14\v\xaa\xf6\xa9ˉy\xa6\xbaΣ\xb1(+M\xf9nK\x9f\x80\x97\xf9&\xf1\x11\xb9\x81\x87\x8e_\xbfF\xa1G\xbf\xb3{)\x8d\xc85\x9fH1\x97]\x15\xe6F~auHȈL\xa8Ԍf\xd9\xea\xeb\xeeJ\xf6\xfe\xebA\xbcs]\xd9k%\xd8\xc7jC\x94q\xbb\xfe\x8c\xa4\xd2)\xde\x14Q\v뉪\xe5\xb8c\x92\xfdG\xc7\xc6\t\x05\x1f\xa8`m\xa2\b\xc1Rz\x04\xb3\x99\x90\xee\x02\xefш\xb0\x99ӟ]\xc6\ae\x19\x9ap\xf6\x10\x8d0];\x88Ն$$\xa1|E$\n+^\x1b\x92ӕ\r\xc1\xd3$)\xcd\xf2\xbcP\x9av\xed3\xbd\x02r\xe8T:!\xdb\xe2\xed\xb5c\xc1\xcd\xe7\xab{#\xab:%HβnA\x97^3d\xdb\\E\xcc\xea\xaflPeVY\xb7'\xb2\xaf\xa8\x06\xe6D^ow\x90\xdb\an\xd5\xc3~\x006\xa5rc\x18\xa2i\"o\x0f'2\xe5_5s\x96,\xb0\x1a\x8f^HQ\xce\x17^\x04\xb7)\xd0ma\xc5\x12\x93~\x8b\xac\x9c\x1b\xb1v\x81>]J\xde\xf0^\\\xe8/\xad\xbb\xbb\x8b\xe8n\x16\xee\xb0\xddUk\xc7\xdbg\x00\xb6\x1e\uedf3W\xe9\xee\x9f\ue3bc\xacT\xea\xd5!{\xf3\xfb\xb5\xc7\xd7\x0eR\xcc.]St\xfbi\asN\xd9\xccFM\x13\xd3\xebM\xf0\xce\a>\x10y\xa0\x923>\xdf7\xf8\xef\xddc\x1d\xa6\x89\xa3\xd0a\x9ct\f\xa22W\x82\x8c\x13\xdf\xc9-X\x9f\xca`\xe9a\x9et\xae\xa1\x8d\x1fQ\x90\xd3\x06\x93ݗ\xdc/\xb5Yo\xcb\\\xb8\x83J\xcb\xdb{\xc6ӗ\x1e\x10Xd\xa5\xa4\x99\xfbg\"\xb8U\v\xea%\xf9\xf1\xa7/\xfc\x80ރTՏ\xff?\x00\x00\xff\xffA@\xab\x06\x18\xcd\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<\xcdn\xe4\xb8\xd1w?E\xc1\xdfa\xbe\x00\xee\xf6\x0e\xf6\x12\xf86\xeb\xf1\"\xc6Nf\acǗ \a\xb6Tm1\x96H\x85\xa4\xda\xee\x04y\xf7\xa0\x8a\xa2\xfeZjQ=\x1ed\xb30/vKd\xb1\xfeXU,\x96x\xb6Z\xad\xceD)\x1f\xd0X\xa9\xd5\x15\x88R\xe2\x8bCE\xbf\xec\xfa\xe9\x8fv-\xf5\xe5\xee\xfdٓT\xe9\x15\\W\xd6\xe9\xe2+Z]\x99\x04?\xe2V*\xe9\xa4Vg\x05:\x91\n'\xae\xce\x00\x84R\xda\tzl\xe9'@\xa2\x953:\xcfѬ\x1eQ\xad\x9f\xaa\rn*\x99\xa7h\x18x\x98z\xf7\xc3\xfa\xc7\xf5\x0fg\x00\x89A\x1e~/\v\xb4N\x14\xe5\x15\xa8*\xcf\xcf\x00\x94(\xf0\nl\x92aZ\xe5h\xd7;\xcc\xd1\xe8\xb5\xd4g\xb6Ąf{4\xba*\xaf\xa0}\xe1\a\u0558x*\xee\xea\xf1\xfc(\x97\xd6\xfd\xd2{\xfcIZǯʼ2\"\xef\xcc\xc7O\xadT\x8fU.L\xfb\xfc\f\xc0&\xba\xc4+\xf8LS\x95\"\xc1\xf4\f\xa0&\x8c\xa7^ը\xef\xde{\x18I\x86\x85\xf08\x01\xe8\x12Շ/\xb7\x0f?\xde\xf5\x1e\x03\xa4h\x13#K\xc7\xec\t聴 \xe0\x81\t\x04S\x8b\x02\\&\x1c\x18,\rZT\x8ez\x94\x06W\x01ô\x01\t\xa0\r\x94h\xa4Ne\x02?\x89\xe4\xa9*\xfd`\x9b\xe9*Oa\x83`*\xb5n\x06\x94F\x97h\x9c\f,\xf4\xad\xa32\x9d\xa7\x03\x8c\xdf\x11Q\xbe\x17\xa4\xa4+h\xc1e\x18\x18\x83i\xcd\a\xd0[p\x99\xb4-\xfe,\xfe\x1e`\xa0NB\x81\xde\xfc\x1d\x13\xb7\x86;4\x04&`\x9dh\xb5CC\x1cH\xf4\xa3\x92\xffl`[p\x9a'ͅ\xc3Z\xaem\x93ʡQ\"\x87\x9d\xc8+\xbc\x00\xa1R(\xc4\x1e\f\xd2,P\xa9\x0e<\xeeb\xd7\xf0gm\x10\xa4\xda\xea+Ȝ+\xed\xd5\xe5\xe5\xa3ta\xa9$\xba(*%\xdd\xfe\x92\xb5^n*\xa7\x8d\xbdLq\x87\xf9\xa5\x95\x8f+a\x92L:L\\e\xf0R\x94rŨ+^.\xeb\"\xfd\xbf Q\xfb\xae\x87\xabۓ~Yg\xa4z\xec\xbc`\x85>\"\x01\xd2l\xaf0~\xa8\xa7\xa2e4=\"\xee|\xbd\xb9\xbb\xef*\x93\xb4C\xee3\xdf;\x1a֊\x80\x18&\xd5\x16\x8d\x17\xe2\xd6\xe8\x82a\xa2JK-\x95\xe3\x1fI.Q\r\xd9o\xabM!\x1d\xc9\xfd\x1f\x15ZG\xb2Z\xc35\xdb\x0f\xd2êL\x85\xc3t\r\xb7\n\xaeE\x81\xf9\xb5\xb0\xf8\xdd\x05@\x9c\xb6+bl\x9c\b\xba\xa6o\xd8\xd9s\xad\xf3\"\x98\xa9\ty\x855~Wb\xd2[24Nne\xc2\v\x03\xb6ڴ&\xc0v\xadYh\xe3\xab\x16j\xd3C݇ϏZ\x9bk\xa3\x15\xe0\vɾ]ͤ;\xcf\x19*Za\xa6R\x84\xe7\x01L\xa8M\xcc\xfa\xe0\xcd\x047\xf9\x15\x16%-\xd7\x19\x14\xef\xebn\x84\"\xf1(mܑ7(ؘ7][580*<]\x86į\x9dLk\xabq\xc0\xcd\xe3\x1c\xf5\x88mE\x95\xbb\a\x9dW\x05\xda{\xfd\x15\xad\x93\xc9X\xcf\x01\x11\x1fG\a\x06y\xa3%\x0e\xbb\f\r-N~\xc1\xf6n\x14.\U0001ac58\xb2\xc9\x13O\b\x026\x9e\x03d;\xf3\x1cJ\x9d\xc2\xce\xcf\x04\x9b}@\xfaP6\xad|6Z\xe7(Ƹ\x86/I^\xa5\x986.o\x94/\x03jo\x0e\x06qp \xa4\"-#WL\xa8\xaa\xe6\xed\x04\x9d쯄A C!\x95\x87\t\x92U\xb0&y\x9c(鰘\xc0\xf3\xa8F\xfaFA\x88\xd8\xe4x\x05\xceTc\xba\x1e`\bc\xc4\xfe\b\xcfB\x00\xb5\x84e͘ڜ\xe72AbVc\xb4\x99k̚\t\xfa\xfe\a\x19\x96i\xfd\x14ä?Q\xbf\xd69A\xc2q*l0\x13;\xa9\x8d\x1dF8\xf8\x82I\xe5pj\x1d\t\a\xa9\xdcn\xd1\x10\xac2\x13\x16m0)ǘu\xdcDP3\xc7\x05\u007f@W+t\x12\x1esc\x8a\x146œP\x81\x11'\x8b]\x95 U*w2\xadD\x0eRY'T\xe2\xe9\x13\r~\xe3\xf4\xc1\x9cB\x1c\xe0\xef\rp\xa0\x82\xa4\xd4\xf3lZ!\x85\xa3\x856\xe3\xca\x11\xda!\x98i6l\x04Y@=\xe5\x8e\xdaf(\xa2\xafQI٥\xb6v碕\x94\x0f\ns\xb1\xc1\x1c,\xe6\x988m\xa6\xd9\x13\xa3\x04\xbe\xc5\xda\xcf\tΎX\xd2\xd6g\x90\xa2\xce\x1aѶ9\rϙL2\x1f\xbf\x91\x961,H5Z\xb6\x18\xa2,\xf3\xfd1\xa2!F3\xea\xc9\xe6\x8cF\xdb\"\xcc\xc7\x10\xee\x94!i[\xa4\rnی5\xees\xbdQ\x9b7\xa6\xf7\xd0Tߤ\xec\xb7\a\xc3__ىݒ\xf6w\xb7[\xc0\xa2t\xfb\v\x90.<\x8d\x81J\x01V\x8b\xc7\xefLp\xa7\xad\x96\xdb\xe1\xe8W_-\xaf\"\xb5\x06\x8d߉\xd0\xd8Y\xddվj\x91\xc0>uG^\x80\xdc6\x02K/`+s\x87\x1cK\xcd!\xda\ttf%\xf7\x9a\f\x8a\xf5\xbd\xd4\n\xe1\x92\xec\xa6\xd9\xd2F\x8c\x18\xf0j\b\xc0\xc7\xe5a\x0f\xc32\x88\x00\tMP\xc1Y\x10i\xb0\xf0ٕ{^\x1f\xed\x13\x8e\x00?|\xfe\x88\xe9\x1c\xcb ^S\x0f\x88\xfa0\x88t\xba(0\x81Q ;Dq\x98\xd6\xec\xf1|\x0e\xed\x02\x04<\xe1\xdeGV\xa3\x9b˱F\xa2\x15\rH\x83\x9c\xd0c3\xf2\x84{\x06Ug\xe8\xa2\xe0-Q\x15ߞp\x1f\xdbu\xc0T¯\xceQx\xee\xd2\x03\xa6\"f)\xb5\xadaj\xbdv\xc0\xe98ba\x99Q\n-p\xfcD\xb2\x1b\x81\xf5\xd2\xd2O\xb8\u007fg\xbd\xf8h\xd5d\xb2\\\xc0\x012\xd8`\x91WX\xc8\xc7>\x88\\\xa6\xcdd\xbcN\x16@\xbcU\x17\xf0Y;\xfas\xf3\"-\xa1\xa8R\xf8\xa8\xd1~֎\x9f|W\x16{\"Nd\xb0\x1f\xcc\xcbRy\xb7@|Y4\u007f\x8b\x03\xbbPR\xd1Fl\xd2\u00ad\xa2\xfd\x99\xe7\xcf\x121e\x18\x90\xf3h\x15\x95匮\xd2j\xc5n:̶\x00h\x17\xafZT\xda\xf4$u\xb1\x10\xe2(\x8a5z\xf7\xe4\xad\xfc\x9b\x83\\\xf8\xb1f\xb0\xccE\x82)\xa4\x15\xe7\xdb9\xf1.\x1c>\xca\x04\n4\x8f\b%\xf9\x8dx\xa5Z`\xc9};A\v\xe3C\x8b\xd0j\xb7\x90\xc6!\xb6\xa2U\x1f\xd93\x889\xaa\xfbD\x96\xfdx\xf78*ٽs<\x14\xc5}\x91\xa6\x9cz\x16\xf9\x97\x85\x9ee\xa1\xbc\x0ec\x10\x8f\xa4\x0f?\n\xc1\xc9\xde\u007f\x91{e\xf5\xfew\x9c7\x14\xd2\xd85|\xe0\xc3\xcd\x1c\xbb\xe3C\x96\xb03U\x14H\xc2DZ =ى\x9c\xc2\a2\xde\n0\xf7\xc1\x84\xde\x1eDPq&\xe69\xd3\xd6\xfb\xfc\xadĜO\xb7Οp\u007f~q`\xbd\xceo\xd5y\x1cL\xb2\xf9\aF\xab\x89Z\xb4\xca\xf7p\xce\xef\xce90[\xb2DN\b\xde\x16hutW>~^\xb2\x15\xa0\xbdv\x88ZhpsHK!\xfc\x1c\x15\xd1:]j\xeb\x16\xa1\xf5E[\xe7\x13\x80\xbdp{$C\x18\xb3\xfb\xab\xb3\x86 \xb6\x0e\rX\xa7M8\x10%\xb3;H\x90\x93\xe4\xed\xbc\xecI\xd4M6\xd2\x03\xa6M\xe6yk!\xbcM?\xf7'\xa5\xf4\xff<̄\x83%\x86]\x1a\x9d\xa0\xb5\xf3\xaa\x14\xe99f\x12\xb6M\xb2V\xf8\xcd\xdb6\xca4Ǥ\x92C[\x16\x8a\x13kO\xd8\xd8ܼt\xf2\xced\x86\xe8w\x8c*\x9f\x82#p\xd1KQ\x88\xe1\xe1|4\xba\xd7~tX\x8050\xbfa2\x8f\x15\x1b\x95eqs\xad\x92\xbf\xb5\xc0\xa3\x90\xea\x96'\x82\xf7\xdf-X\x81`\xca\xf1ԭ\xccu\x18\xdf\n\xa4y\x10\xbb\u007f\x85pԬ\xf9\xac\xc6`O\xb2\x87'\x19\xf1\x92\x02\n\xa6\x95v\xdddM=\xd3;\v[i\xack\x11^\x00UZ>N\xfe\xbe{Luc\xcc\xc9[\xcc_\xfd\xe8NZ1\xd3\xcfuaĒ\x8du`~&v\br\v\xd2\x01\xaaDW\x8a\x13^d.h\x9a\x05\x10\xbd\x10\xbd3\x89\xf4\x99\x9d\xc1\xaa*\xe2\x19\xb2b\xed\x94j6;\xd6\x1d\xf2\xb3\x90q\xd9)8M\xacN\x16\xa8\xabY\xd7\u07b6~e\x87\x1fݫ\x80)ċ,\xaa\x02DAbY\xb2o\xdc26M\xb9\x8c\x97\xf5\xb3\x90\x8e\x0f\xfd\b6\x89g\x995MtQ\xe6\xe8\x106\xb8Ն큕)6\xe1C-\xff\xd1z\x93\xa9&`+d^\x99\x056z\xb1d\x96\xee\xdbj\xf3\xf4\xfa\x9b\xb1xDV\xcc\xccȤ\xfb\x82\xa0y\xde\u007f\x94fY\xc8\xfc\xc5\xe0뇦\xa5\x91\xa4\xa5z.:\x9d\x85\xc9\xd1k?:\xad\x95W\xa8\xfdTx:\v\x951y\vO\x9b\xf6\x16\x9e\xbe\x85\xa7o\xe1頽\x85\xa7o\xe1\xe9[x:\xde\xde\xc2\xd3N{\vO\xa3\xfdG\f\x86\xfe\xab\xa3#\x1d\xa2\xb0\x8a,\xc1\x98C{f\xae\xba\xd2\xe8:\xaf\xacC\xb3\xa4B\xfav|\xe4H\r}⻬\xf8k\xad)\xadiKWZ\xa7הLӒ\f\x8b\xc9\u007f\x8b\x11\x11\x85G\x97AOW\xdb\xc7\x16\xd0͕\xcd\xf5kǛr5\xff\xdf\x04C\x9c\x0e\xd3\xd7\xd2\xf3\xdf\xf8tk\xae\xfa\xb5o\xbc\x0f\b\x18\xff&\xeb\xca#\xcb\xdaf\x8aَ\x17\xe2Oy\xf8\xc0\xcb\xc1\xe1B\x9f\x99\xa6W\xf8\xfd\x9b\xe6eD\xb5\xd9t\x8dY}j\x89N\xecޯ\xfbo\x9c\xae+\xce&({\x96.\xf3\xdf\x1f\xd1\xd6U=v\xcbڃ\x9e\xd6\xdf\xfe\ry<\x01Q\x1bP2\xf7\x02\b\x10z\xec\x87_K\xbfE>y\x9d\xcfo\xd4\xe2\xebҖV\xa35\xf5C\xf3^\xe5\x1bjЖ} 0[o\x16\x834\xc4T\x99\x8d\u05cf\xcd@]R[\x16\xbb\a\x8f\xa8#\x8b\xaf\x1e\x8bc\x0f\xf0\x17\xbb\xb15c\xd1Q[l}\xd8\xf7\xa9\n\x8b\xac\x05\xebTx͂<\xb1\x02,\x9aaq\xd5^\xd15^\x9dʭyn\x1d\xa9\xec\x1a\xafך\x059V\xcf\x15S\xa5\x15\x85ktmVSq5\x9fI\xfc\xa6\x8a\xacׯ\xfd~\xcd8\xffx}UTUU\xd4^`\x1e稺\xa9\xa5\xd5RQ\\]Z\x19\xd5T=\x1d\x998\xaa\x1e\xea\xb0\xd6\xe9\x18)\xb3UP\xd3\x15N\xc7\xc0\x8e\xd5>E\xd45\x1d\x01٭xZ\x1c\x06\xccj\xd3L\x87\xf1\xaf\xeaC\x9b\xf7\xb5\xf9\u007fC\x03\xbf\x95hmR4\xb3\xbb\x92%\xa8Ϣ\xdd\xcfW\x0e\xe6\x1f|\xae\x13>&\xa7^\xdd\x1d\xcfT\x14\xa5\x9b\xcfG\x12\xf8E\xaa\xd4k\x12-\x96NL\xc37Tp\x81Y\x13fMWܶ\x11\xed`\xb7e\xb1\x14d\xd4S\xd8\xec}VȮ\xe1F$Y\xd3q\x02\"Ϝ\tK;\xfbB88o\xb6\xb1\x97a$=9_\x03\xfc\xac\x9b\fB\xe7\xf3\xd0\t\xb8V\x16e\xbe\x87\xca\"\x9c\xf7\x01}\xdb.lRw\xac\x12\xa5\xcdt\xb8I b#v\xd7\x1f1\x92/\t\xf7\b$\xb9\xae\xd2f\x86#\xe2\x16j\x0f_\x1e8\x92㯧\x93\xf6+\xf3:R\v\xfb\xaa\xc1G\xe8\x13 \xa7.\x8fXĲ鬊uڈG\xfc\xa4\xfd\xbd\x1a1<\xeb\x8f\xe8]\xadR\xdbՐc\xad\x8b&'5\xd9\xd36\x04\xd8\x1e\xbdԫ\xadMB\x11\xb6S&wf\x9d;\x97G\x10w\u007f\xff\xc9\x13\xe4d\x81돕\xcfp\xadJa,\x12\xa7\x03\xa1~\xd0f\xda\x12f\xfa\x19r]\xf3\xe1\xa7!\x1d\x06\xf9\xa8\x87\x93i'Q㯰\b\xea\x1bX\x17\xa3\xf2\x0f\xe3#;\x9b\xe5\x8e\x10\x8f\xe5\xc4\xf4v\x12\x96\xb0V'\x92m\x11\xa7(\xf8\x84\xe5\xfbݱp,\";b2*\x8b\xbf>+4_\xc3B\xb5\xb7\xcaKj\xe6֕\xbf\x1c\f\f\x02\x1e3\x1fd\xff\x06\xdd\xc7\xfc\x9e\xaa\x19d\xfd\xdd_ތ3\xe3\xc2=4\x87\xac\x9bY\xff\xd3k\u007f<\xa2^\x8d_\xfd\xb2jn\xa39\x8b\xe0\xacu\xc2U6\xe6\x82\x1f\xee\b\x89(]ej\xf7\x9aT\x86/\x9c  \xe8\xefc8튟\\X\x17%\xcbOM\xc76\xc3`\x9d?\x8f\n\x06\n\x9e\x85\xe5\xbbs\xfciԨkn.\v\x9a\xbc=\xc7{\xd7+H\x85\xc3\x15\xc1?M\x9c\xa3\xeb\x80/蘡\xf4\v\xf5iN\xc7kF\xf3\xc0p\xb1\xc7\xdd\x14\xea\xe3ǝ+\xf8\x8c\xcf#Oo\x14\x11q\xb8\x19\xf5g\x9a\x98r\x86b\xecz\xb3\xa3$\xee\x9aQ|\xa0<b-\xfafn\xd0}\x90\xa9\x16yށ\xe8\x0f\x8f\xc7\xc4\xfa\xffr\xeb\xd3F\t\xd1\xf4\x87\x83\x1e\x93\x86\xeb\xa8њ2X\xa3K\xea\xe0\xa1E\xb3ô\xa3$\xb5\x0f\xaf\x9f\xb4\vP$\t\x96\xae>\xfc\xe8\xde\xfew~\xce?\xc2\xe5~\xfc3\xd1\xca\xc7\xd4\xf6\n\xfe\xfa\xb73\xa8}\xedC\xb8\xc1\x8f\x1e\xfe'\x00\x00\xff\xffa\r\b,+Q\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe44\x10\xbe\xe7)J\xcba/$\xbd\xab\xbd\xa0\xdc\xd0\xc0a\x05\x8cFӫ\xb9 \x0en\xa7\xd2mƱCU\xb9\x87\x06\xf1\xee\xc8vҝN\xd2̀\x84o\xb1\xeb端~REY\x96\x85\xea\xcd\x13\x12\x1b\xefjP\xbd\xc1\xdf\x05]\xfc\xe2\xea\xf9\x1b\xae\x8c\xdf\x1c?\x16\xcf\xc655\xdc\x05\x16\xdf=\"\xfb@\x1a\xbf\xc3\xd68#ƻ\xa2CQ\x8d\x12U\x17\x00\xca9/*^s\xfc\x04\xd0\xde\tyk\x91\xca=\xba\xea9\xecp\x17\x8cm\x90\x92\xf1\xd1\xf5\xf1C\xf5\xa9\xfaP\x00h¤\xfe\xc5tȢ\xba\xbe\x06\x17\xac-\x00\x9c\xea\xb0\x06F\x8aJ\xa2$0\xe1o\x01Y\xb8:\xa2E\xf2\x95\xf1\x05\xf7\xa8\xa3\xe3=\xf9\xd0\xd7py\xc8\xfa\x03\xa8\x1c\xd06\x99\xda&S\x8f\xd9Tz\xb5\x86\xe5\x87[\x12?\x9aA\xaa\xb7\x81\x94]\a\x94\x04\xf8\xe0I\xee/NK`\xa6\xfcb\xdc>XE\xab\xca\x05\x00k\xdfc\rI\xb7W\x1a\x9b\x02``*\xd9*\a.\x8e\x1f\xb39}\xc0Ne'\x00\xbeG\xf7\xed\xc3\xe7\xa7O۫k\x80\x06Y\x93\xe9%\xf1\xbd\x12\x19\x18\x06\x05\x03\n\x10\x0fJkd\x06\x1d\x88\xd0\td\x94`\\\xeb\xa9K9:\x9b\x06P;\x1f\x04\xe4\x80\xf0\x94(\x1f\"\xab\xce\"=\xf9\x1eI\xcc\xc8Ơv\xa9\xbe\xc9\xed\f\xeb\xfb\x18N\x96\x82&\x96\x1dr\xf24P\x82\xcd\xc0\x00\xf8\x16\xe4`\x18\b{BF's\x94\x89\x9f\x16\x94\x03\xbf\xfb\x15\xb5T\x03\x0f\x1c\x93\x15l\x13\xab\xf5\x88$@\xa8\xfdޙ?ζ9\x12\x12\x9dZ%c\x9d\\\x8eq\x82䔅\xa3\xb2\x01\xbf\x06\xe5\x1a\xe8\xd4\t\b\xa3\x17\bnb/\x89p\x05?y\xc2Df\r\a\x91\x9e\xeb\xcdfod\xec:\xed\xbb.8#\xa7Mj \xb3\v\xe2\x897\r\x1e\xd1n\xd8\xecKE\xfa`\x04\xb5\x04\u008d\xeaM\x99\xa0\xbb\xd4yU\xd7|EC\x9f\xf2\xfb+\xacr\x8a\x95\xc5B\xc6\xed'\x0f\xa9!\xfe!\x03\xb1\x1dr}d\xd5\x1cŅ\xe8x\x15\xd9y\xfc~\xfb\x05F\xd7)\x19s\xf6\x13\xef\x17E\xbe\xa4 \x12f\\\x8b\x94\x93ؒ\xef\x92MtM\xef\x8d\xcbե\xadA7\xa7\x9fî3\xc2c\xed\xc6\\Up\x97F\x11\xec\x10B\xdf(\xc1\xa6\x82\xcf\x0e\xeeT\x87\xf6N1\xfe\xef\t\x88Ls\x19\x89}[\n\xa6St.\x9cY\x9b<\x8cc\xeeF\xbeV\xba{ۣ\x8e\x19\x8c$Fm\xd3\x1a\x9d\xda\x03ZO\xa0\xd6T\xaa7!I\x1a\xff\x12\xcb0I2\x9a\xd9|\x89\xfd\xf9:\x9a\xf5q\x92^\x0e\x8aq~9\xc3\xf4\x10e\xe6\xfe\xadiQ\x9f\xb4\xc5l\"O\x13|\x1dJ<\xe8B\xb7\xf4Y\xc2=\xbe\xac\xdc>\x90\x8f\x935\xcd\xf5\xebs\xa36 \xffo\xf6\xc6-\u009dG\x96\xa5\xd2?l:\xaa'\x03z0\x04\x14\x9c\x8b}\xbb\x98\x90\t\xc8|\x92/d\x8c`\xb7\x82f\x15\xcfg\xd7\xfa\xb4\t\xa8\xe8XI\xee'\x1c\x92=\xf8ɸV\f\xde\xceu>\xcb\xe1\xf5&B\xf3I\u007f\xd2\xff\xa6\x1cǍ!\\\xf5]&T\xab\x0f\xd1\xe3\x1a\xe3\xeb\xfd5\xa0\f֪\x9d\xc5\x1a\x84\xc2R;\xeb*\"u\x9aW\xcdXj\x97}\xea\x95\x02Z(\xc4>y9\xa0\xbb\xd5\r\xf0\xa2\xe6S\xfe\xca3\xecN\xb7T\xef\xce\xcbᲥr\xe9\xd6\x10gw)f\x85\xb37\x91\xb2\x9a\xbd\\ҫ\x9bǂ\x90\xedTv\x9c\x19W\xad1.\"\xcb\x18nBXM\xf6\xe22\x99o&\xe1\xb1xR\xfbi\xc0\x1cv\xe7?\xfd\x18\xc80\x92\xe1Ͽ\x8a\xcbt\x8e\xcb\\/\xd8\xdcϷ\xe0w\xef\xae\xd6\xd9\xf4\xa9\xbdkL^\xe2\xe1\xe7_\x8a\xec\x18\x9b\xa7q\a\x8d\x97\u007f\a\x00\x00\xff\xff!\xec@\xb2>\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xdb6\x10\xbd\xebW\f\xd2C.\x95\x9c \x97B\xb7\xc0\xed!h\x1a,\xe2ԗ\xa2\a\x9a\x1c\xd9ӥH\x963t\xbb\xfd\xf5\x05)j\xfd\xb1\xf26\v4\xbaq8|\xf3\xe6͇ݴmۨ@[\x8cL\xde\xf5\xa0\x02\xe1߂.\x9f\xb8\xbb\xff\x81;\xf2\xab\xe3\xdb果\xe9a\x9dX\xfc\xf8\x19٧\xa8\xf1G\x1cȑ\x90w͈\xa2\x8c\x12\xd57\x00\xca9/*\x9b9\x1f\x01\xb4w\x12\xbd\xb5\x18\xdb=\xba\xee>\xedp\x97\xc8\x1a\x8c\x05|\x0e}|ӽ\xeb\xde4\x00:by\xfe\x85FdQc\xe8\xc1%k\x1b\x00\xa7F\xec\xe1\xe8m\x1a\x91\x9d\n|\xf0b\xbd\x9e\x82uG\xb4\x18}G\xbe\xe1\x80:\xc7\xdeG\x9fB\x0f\xa7\x8b\t\xa2\xf2\x9ar\xda\x16\xb4ME\xfbXъ\x83%\x96\x9f\x9fq\xfaH,\xc51\xd8\x14\x95\xbdɬ\xf80\xb9}\xb2*\xde\xf2j\x00X\xfb\x80=|\xca\x14\x83\xd2h\x1a\x80*O\xa1\xdc\xce\x02\xbc\x9d\x10\xf5\x01G5\xe5\x02\xe0\x03\xba\xf7w\x1f\xb6\xef6\x17f\x00\x83\xac#\x05)\"/'\x02Ġ`f\x02\u007f\x1d0\"l\x8bj\xc0\xe2#r%\xfd\b\n0\xf3\xe7\xee\xd1\x18\xa2\x0f\x18\x85f\x81\xa7\ufb3dάW\xbc^g\xea\x93\x17\x98\xdcW\xc8 \a\x9c\xd3GS\xb3\x05?\x80\x1c\x88!b\x88\xc8\xe8\xe4T\xae\xd3\xe7\aP\x0e\xfc\xee\x0f\xd4\xd2\xc1\x06c\x86\x01>\xf8dMn\xc7#F\x81\x88\xda\xef\x1d\xfd\xf3\x88\xcd \xbe\x04\xb5J\xb0V\xf6\xf4\x91\x13\x8cNY8*\x9b\xf0{P\xce\xc0\xa8\x1e b\x8e\x02ɝ\xe1\x15\x17\xee\xe0\x17\x1f\x11\xc8\r\xbe\x87\x83H\xe0~\xb5ړ\xccc\xa5\xfd8&G\xf2\xb0*\x13B\xbb$>\xf2\xca\xe0\x11\xed\x8aiߪ\xa8\x0f$\xa8%E\\\xa9@m\xa1\xee\xa6n\x1f\xcdw\xb1\x0e\"\xbf\xbe\xe0*\x0f\xb9\x8bX\"\xb9\xfd\xd9Ei\xf7g*\x90;}j\x84\xe9\xe9\x94\xc5I\xe8l\xca\xea|\xfei\xf3\x05\xe6Х\x18\xd7\xea\x17\xddO\x0f\xf9T\x82,\x18\xb9\x01\xe3T\xc4!\xfa\xb1`\xa23\xc1\x93\x93rЖ\xd0]\xcb\xcfi7\x92\xe4\xba\xff\x99\x90%ת\x83u\xd95\xb0CH\xc1(A\xd3\xc1\a\ak5\xa2]+\xc6o^\x80\xac4\xb7Yد+\xc1\xf9\x9a\xbcv\x9eT;\x1f\xb0\xba\xc4n\xd4ky\x927\x01\xf5\xc5\x00e\x14\x1a\xa8N\xf6\xe0㕮j\x9e\xf3e\xbc\xee\xc2}y\xc0a\xda\xf1\x03\xed\xaf\xad\x00ʘ\xf2\v\xa1\xec\xddͷ\xcf\b\xb6\x90\xf7\xbaDʍ:\xf8\x98\x19\x1d\xc9`l\xe7<+\x93\x14k\u0084\xd6p\xf7\x04\xf2\x86\xe65\xc9\x02\xf9\x94\xe6\x05\x8f\xbbꖙd\xa1\xe7gӆº0\xcb\xfaT{\xbc\xc5`!\xe3\xdc\xe1\x14\xf1jV\xdb\xc7\x00_\xd5;\xa2$\xf1˻\xa7<\xab\x9e\xbb\xdaA:ňN*\xe6¦\xfd\u007f:(\x1c\x14\xe3\u007fh\xbe\x1c\xe1.\xbf\x9c\xcb`i@\xfd\xa0-N\x80\xe0\x87\x85n{\x11\xe5\xfc\xa1K\xe3Sn-\xbc?*\xb2jgq\xe1\xeeW\xa7n\xde\xde,\xfeb=\x9f\x189\xafSӃ\xc44a\xd7.\xab\x96S\xf5\x95\xd6\x18\x04ͧ\xeb\u007f=\xaf^]\xfcq)G\xed\xdd4\xac\xdc\xc3o\xbf7\x13*\x9a\xed\xfc\x0f$\x1b\xff\r\x00\x00\xff\xff2\x1e\xaa\xc01\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[\x8fܸ\xd1\xe8{\xff\x8a\x82\xcf\xc3$\xc0t\xdb{rpp08\b\xb0\xb1\xbd\xd9Iv\xed\x81=\xeb<\x04y`K\xecnf$RKRsɇ\xef\xbf\x7f(\xdetiQ\xa2zz6v\xa0i\x03\x9eiQ\xa5\xaab\xb1X\xac\x9bV\xeb\xf5zE*\xf6\x85J\xc5\x04\xbf\x02R1\xfa\xa8)ǿ\xd4\xe6\xee\xff\xa9\r\x13\xaf\xef\xbf[\xdd1\x9e_\xc1\xdbZiQ~\xa2J\xd42\xa3\xef\xe8\x8eq\xa6\x99\u0ad2j\x92\x13M\xaeV\x00\x84s\xa1\t~\xad\xf0O\x80Lp-EQP\xb9\xdeS\xbe\xb9\xab\xb7t[\xb3\"\xa7\xd2\x00\xf7\x8f\xbe\x7f\xb3\xf9\xc3\xe6\xcd\n \x93\xd4\xdc~\xcbJ\xaa4)\xab+\xe0uQ\xac\x008)\xe9\x15lIvWWjsO\v*ņ\x89\x95\xaah\x86\xcf\xdaKQWW\xd0\\\xb0\xb78<,\r\x7f2w\x9b/\n\xa6\xf4_[_\xfeĔ6\x17\xaa\xa2\x96\xa4\bO2\xdf)\xc6\xf7uA\xa4\xffv\x05\xa02Q\xd1+\xf8@J\xaa*\x92\xd1|\x05\xe0\xc81\x8f\\;\x84\ufff3\x10\xb2\x03-\r\x8b\xf0/QQ\xfe\xfd\xcd\xf5\x97?|\xee|\r\x90S\x95IV!\a<b\xc0\x14\x10\xf8b\xc8\x02\xe9\xd8\x0f\xfa@4HZI\xaa(\xd7\n\xf4\x81BF*]K\nb\a\x7f\xad\xb7Tr\xaa\xa9\n\xa0\x01\xb2\xa2V\x9aJP\x9ah\nD\x03\x81J0\xae\x81qЬ\xa4\xf0\xbb\xefo\xaeAl\xffI3\xad\x80\xf0\x1c\x88R\"cD\xd3\x1c\xeeEQ\x97\xd4\xde\xfb\xfbM\x80ZIQQ\xa9\x99\xe7\xb3\xfd\xb4\xa4\xaa\xf5m\x8f\xbc\v\xe4\x80\x1d\x059\x8a\x13\xb5d8.\xd2\xdc1\r\xe9\xd1\a\xa6\x1ar\x8d\x84t\x00\x03\x0e\"\xdc!\xbf\x81\xcfT\"\x18P\aQ\x179J\xe1=\x95ȰL\xec9\xfbW\x80\xad@\v\xf3Ђh\xea\x04\xa0\xf90\xae\xa9䤀{R\xd4\xf4Ұ\xa4$O )\xb2\bjނg\x86\xa8\r\xfc,$\x05\xc6w\xe2\n\x0eZW\xea\xea\xf5\xeb=\xd3~5e\xa2,k\xce\xf4\xd3k\xb30ض\xd6B\xaa\xd79\xbd\xa7\xc5k\xc5\xf6k\"\xb3\x03\xd34ӵ\xa4\xafI\xc5\xd6\x06u\x8e\x04\xabM\x99\xff//\x00ꢃ\xab~BaTZ2\xbeo]0R?2\x03\xb8\x00\xac|\xd9[-\xa1\r\xa3\x19\xdf\x1b\xee|z\xff\xf9\xb6-{\xac-V\xf8\xb1|onT\xcd\x14 \xc3\x18\xdfQi\ue0dd\x14\xa5\x81Iyn\xa5\x0f\xff\xc8\nFy\x9f\xfd\xaaޖL\xe3\xbc\xffZS\x85B.6\xf0֨\x18\xd8R\xa8\xab\x1c%s\x03\xd7\x1cޒ\x92\x16o\x89\xa2/>\x01\xc8i\xb5FƦMA[;6?\b\xe5\xcaq\xadu\xc1\xeb\xb2\xc8|Y\x85\xf0\xb9\xa2Yg\xc1\xe0]l\xc72\xb3,`'d\xa3/\xac\xbaj\x96k|\xc9\xe2'\xa7;R\x17\xfa\x8bY\xea\xeaV|\xa2J\xb3\x1eBGH\xbd\x1b\xbc\xc9#E\x15<\x1c\xa8>P\x89\xf2c.\x98%y\x04\x13̔*\x9a\x9b\x15I\xee(\x10\x87\xbdY\xdaE\x01\x95\xf0ZH\xc1\xf6\xc9#ۥ\xad\xe1\xedV\x88\x82\x12\u07bbJ\x1f\xb3\xa2\xcei\x1eԶ\x9a\xa0\xee\xfd\xd1\r\xa8L4a\x1cW\rn\"\x88\x1eo\xae\xa2b>\x02\t@$\x05\x94[\xc6-<\xa3s\x0ftp\x82\xf0\x1fӴ\x1c\xc0-*f\xf6\x1fn\x95d[\xd0+в\xa6G\x97\xed\xbdDJ\xf2\x14\xe1\x8b\xdf\xdeS\xd9\x12\xc6;-R\xb0\xcc\xec?AW\x18\xce\xd8݊\xc8c\x8c\xe0kf\xcaA\x88\xbb)F\xfc\x88c\x1a\xbd\a\x99\xb1\x92`K\x0f\xe4\x9e\t\x89;\x1a\xd1~\x1b\xdaR\xa0\x8f4\xab\xb5\xb1\x16\xfa\x1f\xa2!g\xbb\x1d\x95\x94k\xa8\x0eDQ\x85\xac\x1ccH|)\xe3\xc7\xdeu#\x94\x1e\xba\xda#\xe4Oa0\xb0\xb6h\x1b&\x04\xb4A\xf0\x8c^\xa2\xf0\n\x99Sy9\b\x17\x80\xec\xd0\xce Ea\xa7\xccH?bCs\xa8+\xb3\x8d\xea\x03e2,g\xbc\xae8\xa9\xd4AhT\xe9\x11\xb0\xb7\a\xfat!\x1b&\x02\xbd\xa7\x1cX\x9bG\xb0#\xacP\x0e\x01\xdc<*I-\r\x11\x98\x0f\xb4\x05p\xf8\xc1Q\xb1\x8b0\xf1o,\xa7(\x17AI\x13\x83A\x8362\x11\x88\x145\x1f\x92\x03\xc7Bx8\x88\"L=\xbc\x7f$\x99.\x9e@p\xb3\xc0\xde?\xd2\xcc0\xf2/b\ve\xad4l\xc3F\x10g\u0e3cxU\xd0߃F\b6h8\xbaPjp{E\xac\x18\aJ\xb2\x03Țs4\"*\x11\xa7\x14?\x8a\x164C\xd6l\x9f\xccd\"\xbfbD$\xac\xe9y\x14\xe3\xc7!>>\xa8G\xfc[O\xac3\xbfݟH?\x91\xfb\xba\xb4\x86\xb9\x98\x00\t^.\xc6\xe8\x9d\x14\xc3D]\xd8\xfd\x94\x8c_\x1bن\xef&Fƕd\xf7\xc7\xed\x8dT\xced\xa4\xbb\xabae\xf8\xc2n\x93\xb8\xf7?\x1c\xe8\xe0\x0e\xd2\xfd\xb4g\xe2X\xedn\xe0zg\xb6\x9c\xb0T.W\xa3\xe0\x1c\xc4J\xe4\x17\nvL*\xddFNA\xad\xe2\xabm\xf6\x8c\x14dK\x8b\xcff)\x88y\x1c\xfc\xa9}\xe7%\xaaĆ@\xb7\xb8\fg'`\x02\x1aY]if*0\x0f\x18\xdf\xc0G\xb4\xe5\x1e\x98\xa2\xc0\xf4Esm\x120j\x84{*\x9f\xda*\xc1\xcfn0\x9f\xa68\x99\xbc\xec\xe7,}\xfc\x94Dg\x87\xf7\x8fx\x9c\x0e'x\x80\x19\x13\xd0\a\xd0\xddD\xcd\xc4&\x80\xf4\x8aP\xa0\xb5\xfck\xcd$5Jd\x03\xb7\a\xda\xf9\xc6\xec\xa8\xdf\x7fx7-|34\xc7\x11Q\xdf[\xc4\a\x912\x04&\x81l\x11e\x8c!\xb7~\x94=l\xaaK pG\x9f\xec\xe9\xfa\xc8`\x8f}pjI\x00)\xa99\xbf\x1b\xc1\xbd\xa3O\x06\x94;\x90'\xc1\x9b#*\xeedM\x9fR\x87\xf6\x98\x8a\xf895g\xb9\x8b_\x18*R\xd6\xe7\x00SIU\x15\fO\x1e\"E\x16f\xaa\xa4c\x8e\x9fHv\x98\xb0\xc6G`'\xfe\x02\x0f\xf8\x859\xbb\xaa\x03\xab\x92\xa1\x03\x1e\x14\t(jV\x98w\xbf|!\x05\xcb\x03\xae*r\xe8\x88}\xae\xf9%|\x10\x1a\xff{\xffȔ\xf3\x82\xbd\x13T}\x10\xda|\xf3\xa2,\xb6D\x9c\xc8`{\xb3Y\x96\xdc\xee\xd4ȗY\xcfop0\xfb$\xae\xa60mL\xa1\x9fEHǟ\x19\x10\x11\x8cC\u03a2\xe5\xcdU.\xf8\x9a\x96\x95~\xf2O\x9b\x01\xb4\x8d\x97\x9b*!;3u9\x13\xe2 \x8a\x0e\xbd[\xf4\\Y\xe4\x8f\\_c\x1fI\xab\x02}Ð\xd78\r\xd6\xcfF4ݳ\fJ*\xf7\x14*\xdc7҅j\x86&?Y\nӭ=\xff㶅\x9e\xab1\xf6Y\xe3\xaaO\x1c\xe9\xa79ixĩv\x0e*\xcd\xf6n\x8c\xac$\xee\x93<7\xb1\x11R\xdc\xcc\xdcYf\xceWG\x03\xb4\x90\xc4eA\xa0$\x15\xea\x80\xff\xc2\xedՈ\xf7\x7f'\xe1P\x11&\xd5\x06\xbe7a\x8f\x82\xb6\xef\xf7\x16[\xebQI \x11\x13\xb4$\x7f\xad\xd9=)\xd0|@\xe5́\x16֘\x10\xbb#\x13l\xda0\xc7\xcf\xc3A(\x8a\x02\x05;F\vc\xae\xbe\xba\xa3O\xaf.\x8f\xb4\u05ebk\xfe*\r\xa6\xf3Ot\x95V\xb0Z\x04/\x9e\xe0\x95\xb9\xf6\xca\x18fs\x96\xc8\t\xc6\xdb\f\xa9N\x1e\x1a\f\xee\xab\xd5\f\xf9\n>Po\xbf\x040\xdeO\x85\xa7\x87Y'\xb4\xde\xe9bu\xa6\xc5!\xf8{)g\x1e\xa1>\xda{\xc2\xc1I\xc1A<x?z8I\x1e\xc8\xfd\xf4\xa6\xc2v\xc04P\x9e\x89\x1a#HfG\xa6\x06\xb8=.\xe1V`\x82!Sn\x0e\xfcP^\x97S\x84\xac\xcd\x11\x9a\xf1\xc93\xd1\x1a~ \xac8\x17\x9b%\xd52A\xb3u\xd8\xfc\xc9\xde\x13D\xa8.\xb7T\x1a\xf9\xc1\x88\xaf緃<O\x96\f\u05cd\xffo\xe3\xa3\x12h\x15Û)\x16\x97\x8c\xb3\xb2.\xaf\xe0\xcd\xc4@\xcb\x19\f\v\xee\xe9\xf8\x9e\x84\x04<\xa1OU\xecv\xb3\xf9\xe3oD&\xa1\x10\x16\x82\xef=g\x1e\bz5\xb7t'\x12\x9d!\xd6ia\xf0A6\x13\xe3!\xa5\xb9g\xdb\x06\xae5\xe4\xa2\xde\x16ԹM'\xa1Z\xcf\x1e\x02\xec\xf2\xf9;\xb59\x97da8Z\xd4\xfajtP\x8fs\x982 j\xdd\t\x8d\x95\xe4\x11g\x16H\x89Kы\xd9\x04T\xe8\xadzd\xb9\t\xaby\xcf$\x12\x9b\x89\xb2*\xa8\xa6\xa9S\x91\t\xaeXN\xa5\x0f\xa9:M \xb8\x9b\x91Z\xd23q/\xc5\x1a[\xfb\xe9\x1f\x1d\x13\xf4\xfb\xea\x99{\xce?\xc5\xf6j\x958\x8d\xe8\xd06Y (\x8f\xe6/gs\xb8\x88\x92\xcfb\x18G\x1e\xdcB\xc1ic\xba=a\x9b\xd5\x19\xfcK\xa9\x0e\x83\xc0\xc1Y\x92<\xb2Ѣ\b\x1a\x9e(Ǥq&\xe0\x87\xf1\xee2\x1dܹ\xf1`\x11<\xef\xd3 [\xdb\xf6N\xc8\r|r2g\x96\xc9\xd6DA\xd6\x0f,w\xa1\x97\xff\xa0}\xdd\xf3\x1f\x95\xa8\xfa\x86\xb7nM\xcb\n}f\xb3Xy\xebn\xf2b\xb9E\x93\xfd\xf5\xfdw\xb8J\xfd5\xccN\x98\x80\t\x03Rl\xf2B\x8c9\x8d\xc0.\x94\x11y|Ξr\xb4\xe1\xa7m\xe5$Ed\xff=\xae\xefB~\xd4\x1a\x0f\x1c\x98-\xb4\xae\xf9\x1d\x17\x0f|m\x0e\x12*\xc1\xc5\xfc\xf5nR\xc8\xdb3\xecQ\xb8x[\xdbSw\xb3\x7f\x03%\xe3\x18\xf6ۜG&Ӷ-/\xb7\xabg\n\x02\x8a\xd7\xd5*q\xd2>\x90\xb2\xa3\x8aCFڔ\xfd\x9e@\xfa\x14\xd96\x8fpu\"\xa9\t\x1bڸ\x1b\xc4\xe5\x10\xc8\b\xb3\x86R\b$\xed\x06?N\xca \xb0\x12\v\x84?\x99\x14\x02\x84\x18\x12\b6\xabٮ\xb1%J\xbfD\xe9\x97(\xfd\x12\xa5_\xa2\xf4K\x94~\x89\xd2/Q\xfa%J\xbfD\xe9\x97(\xfd\x12\xa5_\xa2\xf4K\x94~\x89\xd2/Q\xfa%J\xbfD\xe9\x97(\xfd\x12\xa5_\xa2\xf4K\x94~\x89\xd2/Q\xfa%J\xbfD\xe9\x97(\xfd\x12\xa5_\xa2\xf4K\x94\xfe\x1b\x88\xd2\xfbv\v\x91}\xaeæ\xa6e\x03\xf1\xa5\xf1\xb1&\x05غ#\xe6\xeaǈ7J 6(\xe29\xbbgyM\n`\\i\xc2\x11\xb8I5\xf5xmV\xb3\xddd\x1d\x9c\xd1D\xaf+\x8f9\xd6\xd6w\x9a\xa0\x98h\xbb\x84\x12[\xef\x1c\x0f\x8d\x9fObdo\t\xf6!\x11֠\x915f\xc6Zs57k7l\xcb#ޏ0#6bҍ\xd0lV\xa7\xdb+)\x1dL\"\\\x1c\xe8e\xd2l\xb7\x1d{c\xfcH\xa7\x05<\x1cXvhV\x97ٶ!\x17T\x99\xb0-\xc6:\x9e6\xabg9H\x13\xf5Q\xb2-\x98\xe2GL\xe8\x822\xc1\xdapgːA\xce\x06q\x98\xca4\xf8\xcfd,\xe3}\xc9K\xe6\xec\xf5ѭ\xe7\x15Z\x17\x973\xc1\r\x13G\xb8ē\x88\xfbv\n\"v8i\x9e\xff\rO\xcc|\x89\xbf\xee\xdfyV\x89\x1f\x9d\x95)\x888+\xe1\xf1\xdf\xe0\xa4$'\x98\xa4'\x97\xecXa\\\x9c\x9d\x99y\xd6z9\a3R\xcf\xe7\xfd\xa0\xc3\xf8\xe8\x1e_\x12r>\xc2\xc6<\x01\x17Ζ\xef\x91 y\xf3\xf3<\xd2ɀ\x94\x1c\x8f&.\x13\xe9i\xd6\xff<'\xbf#U\x14f\xe6u\xa4\xe7t\xcca\x1e~\x1a]4M\xdc\fE\xe2?\x9e\xf7'\x90\x19\xa6\xed<\x9d\x16\x12\xf37 =\xdd\xe0\x1c\xb9\x1b3\xd99'g\xa3\xc3̱|\x8dd\xe1vi+\xe3\xb9\x1aG\xc1\xccD\xb0\xd1<\x8dΓL\xb2\x85Z%\xc0C\a\xde@'\x85\xb1̋D\xb0\x9d\xfc\x8c鬋D\xa83r3\x12\xb5\xeeI\x12\x96\xb6\xb5\xfb\x9f)\x7f\xc2\xdc<\x8c\x199\x18I\x8e\x97y\x14\xb5\xf2\f\xaeV/\x91s1c.:\xab7!\xd7\xc2\xe5QL\xa2\x90\x98gq\x9cC1\ty:Ǣ\x9f?1\tr\"\xbfb0wb\x12h<\xb7\xe2D#(Q\x12\xbf-O!jϜ\xcad\\>b\x1fM\x8fL%\x94\xc9H\xea d\xecx`\xe39\xb7x\xb7\xa2\xbf\xd6\x14[K\x1e\x17\xdd8\x1d\xdb\xea%\n7SY V\xe8\xf0\xa4\xac\x00\xdb\x1e`\xa6\xb4x\xc0b}\x83r\xa7\xa7g\x10)6\xae\x80\xbah]:\x97\xb7\xc9H\x19zށ\xed\x0f\x13\xa1n\xc3mg\xb2\xba\x02\x80\xc04+\x8b\x81\t\x8c\xe3yWR\xa2\xa6Ԉ\x01:#=!)5!--\xa1\x8a\xb6k\x1d\x10\x1el\xd7j\\\x9c\xddC\xcd\x1c\x1f\xa8\xd3@\x8eI\xaeq\xaa\xd2\"\xc8\vn\x9a^\xd1yٹ=P5\xbe?w\x18ߴ\x83}\xd5\xe8\x7f\xeb\xabze\n?\xcd\xef@2\xbc2\x8e*\u00ad\xa4Ȩ\x9a(\xe9H\xd8\xeb;\xac<\xe6Y\xbf0\f]\xbfS.\xed\xe6g\xa0\x12\xec\x12~\xbc\xbd\xbd\x99_\x0f6\xf7\x844U\x1b6@\xfd\xfbǖ;\x1d\xbb\x95\xe0\xdfS\xdap.^3*\xb8N\xac\xe3J\x82ږ\xf7\xaf\xc1nL\xaf\xef\x9ag\x95ͬ\xf5\x1ad\xf9D\xc5W\x12Hh\xea\xc2:3w\x1cxA'l\"\xc8nu\xd8h\xf5W\"Ĕ\x1a\xb1\x93f81\x1dⴤ\x88$\xa0\xd8[\xdf\xec\xe0\xa9)\x8f\x89P\xd3\x14Dj\x8e\xc5\xccL\x8b\x19\xf9\x16'M[b\xda\xe4\xa9ɓI`\x03\x16I)\x94\x89 \xdflR\xd5RZ\xba\xe5\x1c\xeb\xe6\xb4\xd4\xcb\b\x8f'\x130\x93\xc0\x86Z\xf3\x944\xccD\x88\xfdd\xcdg$c\x9e$\xbb\x899/\x03|\x9dN\xcfL\x82\t^\xdc\xfd|\f\xe5\xbf\xf4\x934\xe7M\xd7\x19R5O\xe0\xed\x1cǍ\x13\x9aɑ\x89\xe7`\xfc\x87\xaf\x14\xbaZ͚Qcs\xb6L;\xf3\xf7K\x98v\xf4\xb12=\xfc?k\xa2\xebS\xf4\xe6\xfb\x0e\x00\xaf>\r\xbe\xf8ک:u[\xcaDnln\x02\xaa\xce𤰫M@\xb0\x12\\\xf5\xf2\xa3\xfe\xf7\x9b7\x9b\x17Qo%\xd5\aq\x8a\x99\xfb\xb3\xb9\xb1C\xbc\x85\xe5r@\x93 \x82\x7fgR\x97\xda?\xbf\xbf}\x81%1#\x7fv\x80\xde\x10\xd7\xf7$\xf7\x93^\x93@\x82y\xdd\x14\xcbz\xd3;\x04Ϝ5\x13\x81\x06-\xd5O\xa5}\t.~]v\xa2vn=\xaa\x9c\xa9\x81Q\x01,j\xf0\v)\x11\xe2\x81\x18\xadSs\xaf\x1e\xdcZ\xfe\x8f\xb5\x1b+\xa2\x0f'\xcc\xe1\r\xd1\a\xbf\x04\x10\x04\x88\xce\x1c\xa4\xb1\v:\xd2\xffz\xf3\"\xf4\ty\x8aaq#\xa4n/q\x14\xa7\x96e<w\x9d\x1b4:B\xca\x14`\xbd:\xb6\xc1w\xedC\x12!\xf6\x8e\x91\xee\x01\xe1(Y9\xc4_\xec|h\xderx\x8a\xea4/\x8f\f\x8el\v\xe6\fb\x836\xc6\xf9W'B\x9d1T\xbd\b\xa7\xedԞ\xc2j'u\x1d\x01\u07b5\xe5%\t&\xc4$\xf6%\xa8\xfd6\x0e\x013\xb7\x93\x88\xf1\x1fˁO\x84\xaa\x05\xfc\xe1\r(\x9a\t\x9e\xab\x7f\xf3\xa9\xc1\t\xe99O\r\x13\x85]\x03\x12\x80\xfd\xca\u0099\x01\v\xc3^\xc4\x19\x1cL\xb3\x13dt\xc4nD\xb1\xfa\x8b\xd8&\xc1\x84vu\xcbT\rV\"\xc4\x105\x89\x9a\x8f\xa1\x12+\x11\xe2I\xf5Z'\xc8\xe9\xd7h\x84&\xd7q}\xb3\x16cj\x95\xd7K\xd6z9L^\xa0\xe2k\xb6\xb6:k\xf5\u05f7\xb4\x15\xf6\xea\xc1\xfe\xdd;bz\xed\xd8\tR?gGL\xa8&\x9b)d\x89\x03S\"mU\xac5\xea\x800\xddH\x9a\x16\xad\x9fr\xe6\xbb\xcd\x04*ɰ\x96P\x9c;`\xefd\n\xbb\xaf.\x11\xfb%b\xbfD었\xfd\x12\xb1_\"\xf6K\xc4~\x89\xd8/\x11\xfb%b\xbfD었\xfd\x12\xb1_\"\xf6K\xc4~\x89\xd8/\x11\xfb%b\xbfD었\xfd\x12\xb1_\"\xf6K\xc4~\x89\xd8/\x11\xfb%b\xbfD었\xfd\x12\xb1_\"\xf6_c\xc4\xfe\xab\xee\xe4:\x02\xdfu\xea{k\xfb\xaa\xfb\xa8\xf7\xc0\xa6=ԥ\xaf\x7fWK\xe5?\x1c\xa8>P\xe9\x1b\xb6\xafU&\xaa\xc1]·ѕ_\t[\x1a\xda\a\x9a\x05\xe1\xe5\xd94\x98\xea% \xacf2\xca2b+DA\t\x1f\xe6\xc4h3ɩ\x16\x92\xa6]\x82*\xf0\x80 v-\x8b\xc1\xfc\x86K\xc9=\xe4\b0\xb8\xd9QN\xcd6\xfd\t\xbb\xbd Mڃ\xc7t\xb3J\x8eO\x8f.\xc9$\xa6\rI\x96Gd\xa6ش\x9a;v\x19\xe6e!\x85_\xbdL\x94.\xc3\x1a\xa1\xfa\xaa\xf85с1\xdew\x11\xf7Y\x82\xee_r\xffݦ{E\vׅ\x11\x1e\x98>\x1c\xc1\xc4~+\x94\x9bwR\xf1}\xbb\xa5\xb2\x977-\x06\xf9h\xbc2\xac0\xec\x1c\x91\xd6\x0e{\xe1\xa3\xc1\x9d\x14\x9b\xb9,\x1b?-\xf4\x1b\x17\r\x8d\xe9q\xaf\x7f\xcbXwF\xaf\xbbM\xe2\xc8f\x15k26\xaf\x1dQT\xb2\x9e\xd1\x7fq\xbca✮\x8b\xfd\x9e\x8aQ\xa0ӽ\x16S\x0ez\x13}\x15O\xe8\xa6\xe8\xfb$\x8e@\x85\tw\xca\xe8\x12\xf7\x1fϵd\xf4\x03\x9b'\xba$NE\xe9S{#\xfa.\x7f\t\x8d\xf8\xe6tDLb\xcet\xf7\xc3\x0ekRz\x1e\xba\x1e\x83\xab\x94\x1e\x96\x93\x9d\x0e\az\x18\x8e\x02\x8e\xf67\x1c\xeb\\8\nq\xfa}\x92c\xfd\nGA'\xbeArT\x0f͘\xeb\xb1m\xcd\xffL\xdb\xc0qU3\xd9ip\xd2F\x1eǯ\xd5Ko\x18\xbd9\x1d\x04'9֑\xfb\xf4n\x81ᭋ\x91\xe7\xce\xed\x11\xd8}\xcfb\x04hJg\xc0ț\x15#\x10G\xfb\x01\xa6\xf6\xfb\x8b\xc0\x9e\xd8vG\xa5d\xe4\"\x9aV9\xd1\xe4j5o\x7f+~+\x89:\x950\xd3rn\xd4BOEs\x14Ŏ\xc0\x7f\xec=\xb3u,lLM\xd7\xee\xafe\xf5\x0fM\xb9\b\xed\xc63\xf8+\xc3\x17֡\x9c`3̖\x9d\x80\x17̑\xa1i\r\xdd\xd8{\xc3@{'\rE+b|X\xb0ŗ}\x96%Q\x1bxo\x9b\xab\xb4\x06\x9a\x18\xf6N\xc8r\xd0\f{\x15\x8ei\xaf\xfd]\xf8ͫ\r\xc0\x0f\"\x9c\x84\x03Du\t\x8a\x95U\xf1\x84\x01Exսe\xae\x01=\"\x01\xb8\xc1\xb0\xcc\x18\x1e\xb7D\xee\xa9VW\xe3\xd3\xf7\xe9膮\xf5\x8c\x18\xaa\xa6\xc2\xe0\xb3\x16\x92\xec\xe9O\xc2\xde24\x8b\xadYo\x0e\xf9\x99\xa8\x18\xcdQE\tl.\xc9t\xf0w\xa9K<\xe6{\xb9\x1c\xb6\x94\x10d\x8b2\xd0\x0eS\x81)\x91\xca\x14.\x90=\x85\xc2a\xb5Y%o\x8c\xa3r\x9e4\rC{\x90\xe2\xa4R\a\xa1\xbf\x88\xa2.\xe9\xd4\x14|\xee\x8e\x1e\xf0\xab్\xdcQ\xc8\nQ\xe7\x01zd\ta\xc1\xc5\xcd\x17c\x81\uea24\x1c\xcd\r\xb7\x7f8+ӟ\xe7\xfcY\xce_\xfe\xd3\xf9\xfd,\xaa+/S\x9c\xe8\x8ev\a\"s.\xf7;\x89wt\xfaX,9\x82\bâ\xdaJE?\x92N\xc4rh\x93\x19\x91\x0e\xad\x8b\tbno\x7f\xb2\x04`,t\U000ee586\x03\xeb\x8aHE\x91\x9b\x9e0{\xd3\x16\x7f=\x88\x87#\x98`\x13\\\x9b\xf9i\xe1-)\xb2$\x96\x9c4\x82\xfd\xbd\x115/x\x9eES\x82\xfae\xf8\xae\x96\xc2hM\x92W\x1cG !\n\x87(%2f43\xba7LN\xbaS%\xe7Zұ5\x1bQ\xa9j \xa9\xb1\xc3\x12/j8\f2R\xe9Z\xba\x8d/\xab\xa5\xc4C\xbd\x05aD\xd5G\x01\x86H\x8a[\x1eNQ\xa2F\xc7\xf7\xe6jRV\x13\xf3\xf4\xf6\xf8\x0e\x904\x132\xb7\xa8\xa1@\x02qh\xc0\x03QA\x19\x0f\x1aZ\r8\x1b\xcb0\xe7\x18\x84Fs\xa0\xf7\x94\x83\xe0>aڂT\x9b\x16\n\xb17ܵ\xa1\xb8`F]\x15\x82\xe4~\x85;\xf4\xec\x9cXS\xc0D\x83\xe4\x85\x1a\x81\x89\x1d6p9\f1\xe1Xa\xda\xed\xfd\nr\xa2\xe9z\x10h\x92\xee\x1b\x146\xd3\x13QML\x95\xc9\xe0s'\x85̿\x13\x10\x9d\x9a\xe6n(\xa9Rdo$\x8ahx\xc0Z\x9a\x10\x81;\x02\f\xfeT\xd9\xe4D\xbbd\x15'pֱE2\x8d.A\xf3\x00\xef\xd3k\x8d\xba\x18\xdaV\n\xb1Gǣ\x19j'\xc4o\xba\x9b՜\x9cY\xfaX1\x99\xb2\x13\xbc\x0f\x03\x917ƫi\xb4\x81S\x81\x98\x98Y\xb0=C5\x8a\x93\xbd'rK\xf6t\x9d\x89\x02\xdd|\x836\xc0Kε\x85\xfd\x85J5M\xda\x0f\xed\xb1ުu\xc2n\xe1\xc0\xbd\xbdx\xe9v\xe8\xe3\xe7\xe1\xa7$\xff\xc4w\xf4\x94\x8c\xe3\x7fh\f\x1b\xff\x80\xbfy3\a\x7f\f\x1bZ%6i\xad\xfc\xd8\x1a\xda\x1c\xef\\N\x0f\x86\x1a\xbbBw\xa1\xa0\x1a|\x1f\xa5AX(\x1d\xcbj\x88\xea\xf7\x0e6V\x1e\x1a\x9c<?-.\x88\x8a[\f\xc6\xd9\xd2J\xa6\x18\x00l+\x9bP\x9ba\xf5\xa6\xb51\x8f\xf1\x9a:$&*\xec\x01Z\x064ֱ\xda\x0eAޠ\xb2#\xa0\xf14\xe8\xd4\xf2\x10\x11i+\"i]LJ\x97?u\x1b]\x96Ċ\x9f\xad\xde\xc3\xd9$\xed+^\xb6\x8c\xf2j\x97\xea\xacN)V\x9bDy\xec\x85\x00\t/\x03\xa0\xcf{zu *\xed\xf178\xb2\xa5(\x9d\x88<\x90&\x01i\xb3\x9a\x9f8\xb3\x8e,]wM(}*ivy&\xd1\xf6\xc9\f=^\xd7S\xec\x1d'\xec3\x96\x88\xd0<*8\xb6%0\xcdO%Pi\"\xf5\xbc\xe5\xff\xb9s\xcb\xc8\xca\xc7i5\U0003f595mUe\x12\x91\xd6\xc7\xe0g\xb3\x12\xf9%\x10\x05\xff?8S\xfe\xf8\xda\xfc\xfe\xc7K_\x8e\x1e\x01\n\xc7\x12\x0e\x8c_B\x93S\x13\a\x1c\x05\xe9\x13\x1e}\x16\xd4\xe64\x86\x8cyƣ\x99!k\xbb\xdc\a\xafH\xb3\x04\x06.\x8d8\x82\x9e\xe1\xbd@\x16\xa8\xef5v\xcc\xd0CTt&\xf4\xc7\xce`?\xb1ZhR\xb4\x12\xfd\xbb\xaf\xec\x88[\xae\x8d\xddpi\x8cx\xf3N\x0f\xb4\x14Z;\xb7=\n\x04\x1bך\xb09ձ\xf5ڳn\xad\xe0Hj*\t\x9ei\xce\"(e\x15E\n\x9b\xecHϣ\x18wZ\xbc8\x82\t\x8e\f{\x1e\x88\xed\xeec([a\xf9Idw\x13\x18\x7f\f\x03=\xc2\x05\xfen\\H=\x8e\xe2\xc9@5G\x83#\xb8\xe0\xb9{\xe9^\ayGie`\x96&\x03\x06\xb6\x14\tΩ\xb1g\xa0\xe6\x9aa\x9f\x10{Z\x18\nFO\x88\xf6\xb8\x81V\xd0=)~\x14\xc5\xc0\x94\x1d1\xe1'?\xd6$Sd!\x8an)F\xc1\xab\xb9y\x8f\x8d\x85\n\aQ\xe4\x8e\xc8A\xe0\xd0&\x1d\xf9\x19^l\xf2\t\x05\x98\xffbH\xb7\f@\x16#<d\xbf\xa4\xa5\xb8\x0f\x8e\xba\b\xe8\x96$\x0f\xc8\xf1\x94\xa7\x0e?\xa5\xc8i\x02W~\xc6JK'\x14\x92j\xca\xf1k(]\xfd\xa5\x17\x95\xcdj\u07b6\xbc\x86?\x8b{*9\xbe\xfa;2\xc0X\xc8,:`R/\a\x16'\x10ٞ\x10\xd6ۆ\x91\xbc\xb8t\xa6o\xc0\x13r<Iӈ\xfe\x8f\x18\x8f\xc3fc\xdf_\x15\xe61\xe6\x11\x1e\x9e\xc55|\xa0\x0f\xab\x98)e\xde\xf7h\x8e\xf4\x03C\xae\xf9\x8d\x14{L\xe0\x19\xb8\xf87°G\xc5\x0fB\xde\x14\xf5\x9e\xf1\x8f\x95K\x10\x1c\x1a\xecNQ\x03[\xc1\x1an\x88Ԍ\x14\xc5Sĸ\x8bZ}kx\x87\xca)>\a\x83\x13T\xf5\xb0\x9d\x9a\x8e\xdep\xe3R\xb5\x93C\xd4\x13\xcf\x0eRp\x81\xae\xc4f\x843\x031\x90e\xb5\xf1\xd1\x13\xa0\xddd\xc9a\xa4\x8e\xb7\xdbS\xcf\xdf=\x9c}\xbe\xc7 \xbav\xd3\n~\xc7HDYR\xdc,\xe8\x00ځZ\xb4\f\b7#|ؙh\x9b\xff\xb1c\x9c\xa9\x83s(\x0e\xc2ohFc1<\xad\xf1\x81\x9et\xe4\xb7\x06\xe3\xf0\xc5\x1e\xcb\u07ba\x14\xfbA\x03\xbfa\xd6Wg巉H\xa1\xf3]\xf3G\xf4\x1c?\xa4tV\xe3\xa9@\x83\xae\xadD\x12\xe8X\x91K\ay\xe3\x97\xf5\xaa\xd1\xdc\xe6LF/~\xce\xf8B\x19\xcapC2\xbf?\x1bA\x1e\xb4W\x12\x96\x1f\xc2\xf0c\xa3\xb2\xe6\x18\x1a\x15;x\x10\xf2\xce\xf3;\xb00\x02\x1d\xdc\x1a\x95\x14r\xc1\xe9\x94\xe01\xae\xff\xef\xff\x89\x8c\x193B\x1d\xb1\xb7xJH#\xd4\f\x8d\x9d.\xc6I\x8d\xbfQ\x98\xed\xc0ԕ\xbc0\x99\xcfu\"\x85\xa2\xb2@R[9\x8c5&\x98\x967\x7f<\xbeZ=\xaf\xf8n\x14\xd5\bl8\v\t\xe1I\xd7\uf488\b{\xd5\xf5;O\xc6\xf5;\x83\xbc\xdbe$յtA\xd5.-\xcf\xc7\xf1\x17\x94\xd4yh\x9a[<\xa6(\xe9A\xd0[\xab\x1f7A\xbbF\"\xb0moF\x13a2ǈ\xdf\xd2)\x19\xb5.'\x19\x1b?,L،~H\xe0PtD\xc4\xe0\v\x00\x9cn?\x99]F\xa60g)\x8dga\xb8g\xdc\x1d\xfe.v\xde\x042\xea\xd9/\x9b$\x1e\x02\\\xeb\ve\xeb%PW4w4*d\xfb\xd46\xb7\xd4\xe6y\xd4~HUx7axT\xed9\x03\xb0Ov\x04:L\xb3c\x92\x06\x9f\x9a\x95D\x81\xcfz\xf3\xf8泌\xab\xb5\aѡ\xa43Y\x11\xd8p6\xc5^Wy\xb2A\xfa\x8b\x1d\xdb\xf18\x17D\xe9N\xe5bv\xa0\xc6[\x81dT\xe3\xcb\x0e<ݓ\x93\xf1[\x1a\xb0'ze\x03\r\xd7\xef\x06\xaf7\"?xً\xc2o\xe6\xbc\xf5ss\xb5\x1a\x9ds\xaf9\x9b\b.\xe3v6p\xcf&[\xec\x03ќ\x94.\xbcwrXt\xfd37X\x9f@}\xfd\x06\xeb\xc2d\n\xb6T\xe95\xdd\xed\xd0\xf3j\xf2\x81\xd7k\xec9\x14팈&\xb6\xa9Y\xb2Ҍ.Awp\r\x87HTh\x98\xf0\x86/d6\x01s\r%y\u008cC\xc6I\x96a\xd2\x15}\xad4)\xe8f.\x8f\xc7\xcf|\xb8\xa6\x15\xbaGh\xfeK$\xbe\xd3a\xf8u{\xfc\xb1\xb5n\xc0YΙVL6I\xa3\xe8Ϯ\xff\xd9R\xca\xe1A2\xad)\xef\x16uan\xe4\x16\x13H\x94\x80\x1d\x89(\x90)\xa3\xd5\x18\xd8\xd71\a@\x8f\xb2\xdb08f\x9f;\xe2\x04N\xcbְl\x10*\x00\xe6\xa8\xdcb\x9a\x87\xbb\x17\xa72;\x10\xbeG\xa1\x92\xa2\xde\x1f\xbc\\\x06q\xf4\xba&\xea\xfe\xc0\x7fy\x8dH\xb9\xed\xc9%\xd3X3\xaf\x95\xb7\xedʤ\xf2\x16\xba$\xbb\x8bb\xea\xcaB\x8c\xecn\x98xM\x1f1U\x83\xaeѡ\xbdvsa\x12\xc6/]\xa2\xb2d\xc6\x1b\xa2\xe3/#\xb7\xa5}\x0e\xbf\x03\xa9*\xac\xe5S\x0e\x9f\x84\x16\xd8\xe3Ӛ\x965<0\xe3\xb1|\xe1^\xfaG\x93膌1\t\xbf\xfe\xafx\x1c\x03_\xa7\xaeU\x1b\x01\x17MT\xa7z\xa4|G\xef\x00p(9\xef\b\xd7\xe1\x85f\x12\f\x1aL\xc9\x00\x9e'y\x8b^:A\xc4\xec\xde\xc4\x06\xe5\"\x80q\x91\x1b\xc2ۺ\x83\xf2\x1c\xdb*\xfb\xb4c\xa6m+E\x8c\x93\x7fm\xd9$>\xd7;\x89q>\xc5t\xc8\xcc\x1cN\x16\x1e\xb31\xbd@\xa8&\xab}s*\x19/\x92\x14\x13D`\"7\xc6I\xc8\xc9ȏ\xc5\x12O\x8a(ڰ\x9a\xa3\x00\x85\xf3\x12X\xbc\xfd\xcepU\x80sA\xb3\xb2\xac5\x8a\x99\x9f\xae\xe9\"\x81\x19\xe29\xb5\xc2\x13⌿I\xb4\xf1\xc5c\x8e\xa9\x91Ǵ\xf8\xe3T\x14\xf2̱\xc8i'CR\\21:\x99\xb8\xa8\x92\"\x95g\x8fW\xceS\xe1\xc9\xeb$\x91\xe2ѣй\xbdN\xa8W6\xab\xf9\xb2\xf0[8\x9c\xfe]yem\x83\xc1\x1b\x06\x11؝\bZ\xcb]\xb1Y=O\xa6\x92\xe4\xe9Yg|\xaf\xf9W3\x05\xf0\xe4\xb3\xf8\xd4d͚&\x92\xc8\xf3Ϯ\xba\xd1f֘`c\xdb`\xc4:D\xac\xc03g\fS\x8e\xebNXX\xa2\xebá\x83J\xe2\xa8\x00\xa3Sn\xd1E_\xad\xe6\x8bA\x12\x9b\a\xa7\xdem\xed\x9fٿ\xe8$\x93\xc3H\xaf#\x14\xfbWP\r\xc7\x19M\xe8{\x8f\x1e-\xfb&\xc5%\x94\x94\xa8Z\xd2<$\xe2>]\x84\xba\x96\xa1隠y\xdc\xcc\xc0\xd3\x17\x16O\x0f]\xeb\xd1\xfd\xd6\r\x1d%\xday\x0e6\xab\xb1e\x1c\x8f\x85M9\x15\n\xb1O\xc0\xf4'\xb1\x1fE\xd2W\xa2\xbc\x14\x96\xf1\xda\xf0#T\x7fvCG\xf15\xe7}+O\x97x\x9a\x8aYjD\xf96ۦ^\xd8\xe4~Xǁ)\x96\vE\x9f悺\x04\xfa\x98Ѫi\"\xe7\x9f\x17\x81.\x1ex\xa0\xecE٧\xe3!\xdd\x0e\xef:\xf1\\\xcf8t[\xf5\xf9\xe7l߭\xb8\x7f!\x9cGT\xff}\xc8Xz\x9fR\xcb\xd5$8\xb5\xab\xbaB\xbf+\xa4\xae\x81\xe8ꯎ \x02\xfc\x8e\xedlÛ\f5\xc3\xefg\xf8AFwǓw1WO4A\xfc\xc5hA\x93\xa9U\n\x95I\xf0\x0e\xdb\xe5d\xb1\x00\xc5MAцS\x94vk\xa5.Vsf\xb6[⚜\xcf\xfc%r[̷錦A\x8b\xae\xb7t\x95[\xad\xcco)\x9b\xe7\x10\x14,\xcdy\x04\x85\xdbb\x045/\xcf\x18\xf4>\x87\xb2\xa33S\xf7@$\x96\rO\xad\xb1\xbf\xb9a\x03\x15\x93\x0e\xc2@\xcd\xe4\x11Hh\xaa(}D!\xe2P\u07b4K&=\x8e@\x06a2>\xbcU=\xafhrP?\x1d}i\f\xb3\xbc\xb5\xb6ݓ\xdc7M\x1d3\xc9p\xdfp}\f\xf1\v0!\xe6+x\xf5j\xe5B\xb8\x92\x14\xee\xcfLpۖA]\xc1\xdf\xff\xb1\u0084\r,\x94w\xebQ]\xc1\xdf\xff\xb1\xfa\x9f\x01\x00|\xf7߉\x02\xf2\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zm\x93۶\xf1\x7f\xafO\xb1\xe3\xfcgt\xf7ωr\x9aN\xa7\xd5\x1b\xcf\xdd9M=9\xc7W\xdf\xc5}\xe1\xb8\x13\x88XJ\x88@\x80\x01@\xc9j\xdd\xef\xdeY\x10\x10I\x11z8'\x99\x9a\x9a\xf1\x91\x00\x96\xfb\xbc?,8\x9aL&#V\x89wh\xac\xd0j\x06\xac\x12\xf8ѡ\xa2;\x9b\xad\xfel3\xa1\xa7\xeb\xafF+\xa1\xf8\fnk\xebt\xf9\x16\xad\xaeM\x8e/\xb1\x10J8\xa1ըD\xc78sl6\x02`Ji\xc7豥[\x80\\+g\xb4\x94h&\vT٪\x9e\xe3\xbc\x16\x92\xa3\xf1\xc4\xe3\xab\xd7ϳ\xaf\xb3\xe7#\x80ܠ_\xfe(J\xb4\x8e\x95\xd5\fT-\xe5\b@\xb1\x12g0g\xf9\xaa\xae\xacӆ-P\xea\xdcO\xb6\xd9\x1a%\x1a\x9d\t=\xb2\x15\xe6\xf4\xea\x85\xd1u5\x83v\xa0\xa1\x10\xd8jD\xba\xf1\xc4\x1e\x1abw\x81\x98\x1f\x97º\xef\x0eϹ\x13\xd6\xf9y\x95\xac\r\x93\x87\xd8\xf2S\xecR\x1b\xf7}\xfb\xea\t\xcc-\xc9\x03`\x85ZԒ\x99\x03\xcbG\x006\xd7\x15\xce\xc0\xaf\xaeX\x8e|\x04\x10t\xe6\x05\x99\x00\xe3\xdc[\x81\xc9{#\x94Cs\xabe]F\xedO\x80\xa3͍\xa8hJ\x94\x05\x820\x10\xa5\x01똫-\xd8:_\x02\xb3p\xbdfB\xb2\xb9\xc4\xe9\x0f\x8aſ=\xc7\x00?[\xad\xee\x99[\xce kVeՒ\xd98J\x1a\x9e\xc1}\xe7\x89ے\x00\xd6\x19\xa1\x16)\x96\xee\x98u\xef\x98\x14|gu\x10\x16\xdc\x12A2\xeb\xc0\xd1\x03\xbak4\x04\xa4\"\x84\xa8!\xd80\x1b\xde\x03\xb0n\xa8 ?ȩ\x1c\xbc+Lm\xd8&V\xe0\xdd\x1e\x95\x86\x7fz\x12\xb8\uf40d\x8e\x9f\r\x9c\xb6G\xf7z\x81\x87\x88\xf5T\xf1\x12\vVK\xd7\x15\x95-Za\x13bU\x98g\xbcY\x15F\x1bI^\xf6\x9e5o\x9dk-\x91\xa9Q;k\xfd\x95\xbf\xb1\xf9\x12K\x1f\xbct\xa7+T\xd7\xf7\xaf\xde}\xfd\xd0{\f)G\xda\v\n2\x1c\xeb\xd8f\x89\x06ᝏ\xbf\xc6n6\x88\xb6\xa3\t\xa0\xe7?c\xeeZ#VFWh\x9c\x88\xc1\xd2\\\x9d$\xd5y\xba\xc7Ә\xd8nf\x01\xa7섍\x1f\x85xA\x1e$\x05]\x80[\n\v\x06+\x83\x16\x95\xeb\xaa7^\xba\x00\xa6\x02{\x19<\xa0!2`\x97\xba\x96\x9c\x92\xda\x1a\x8d\x03\x83\xb9^(\xf1\xaf\x1dm\vN\a\xe7u\x18RD{\xf9\xf8TL\x92\xab\xd6x\x05Lq(\xd9\x16\f\x92\x12\xa0V\x1dz~\x8a\xcd\xe05\xf9\xbbP\x85\x9e\xc1ҹ\xcaΦӅp19\xe7\xba,k%\xdcv\xea\xf3\xac\x98\xd7N\x1b;\xe5\xb8F9\xb5b1a&_\n\x87\xb9\xab\rNY%&\x9euE\x02۬\xe4_\x98\x90\xce\xed\xb8\xc7\xeb j\x9b\x9fϚG,@\x19\xb3\xf1\x82fi#h\xabh\xa1\x16^;o\xbfyx\x84\xf8jo\x8c\x1e\xd1\xe8\x16\xedBۚ\x80\x14&T\x81Ư\x83\xc2\xe8\xd2\xd3D\xc5+-\x94\xf37\xb9\x14\xa8\xf6\xd5o\xeby)\x1c\xd9\xfd\x97\x1a\xad#[ep\xeb+\x16\xcc\x11\xea\x8a\x02\x93g\xf0J\xc1-+Q\xde2\x8b\xbf\xbb\x01H\xd3vB\x8a=\xcf\x04\xddb\xdb\xfe#*\xb3\xa0\xb5\xce@\xac\x85\a알\xe2\x87\n\xf3^\xfcp\xb4\u0090\x87;搂\x87\xf5(B\f\xf1$\xb5\xde\xd4tp\xd3\xc5\xf2\x1c\xad}\xad9\xee\x8f\xec\xb1|\xbd\x9b\xd8\xe3\xb1BS\nK\xa1o\xa1\xd0f\xbfb\xb0]\x06\xee^1Se\x831Tu9dd\x02o\x91\xf17Jn\x0f\f\xfdÈ\x90\xd9\xcf0$\xfd\x1a\x16\x1f\xb6*\xbfG#4?!\xfc\xcd\xde\xf4\x9d\n\x96z\x03\x85wk\xe5\xe4\x96r\x90ݪ<\x90\x1f\xd0\x04\xb8\xbe\x7f\x15\x9c%\x04P\x88\xb7\xa0\xab\f\xaeC\xe4\xea\x02\x9e\x03\x17\x96\x00\x80\xf5D\x87\xca\"xF\xe33p\xa6~\x92\xf8\xb9V\x85X\f\x85\xeeb\x9aC\x1es\x82\xf4\x9e\xe6n\xfd\x9b(5\x91wTF\xaf\x05G3\xa1\xf8\x10\x85\xc8)\xa1\x17bQ\x1b\xef\xb3P\b\x94\xdc\x0e%=\x10e\xf4\xcb\rrTN09;\xc1\xc9n\"\xbd\xd41\xa1\x9a*\xd5\x12\xf0\xc9Ɣ\xa1\xa4*\x87\x8a\xef\xd0H\xf7r\xdag-\x8b\x1c6\xc2-\x9bt\x18}z0\xffp\xecѵ\xc2m\xea\xf1\x1e\xef\x8fK\x84\x15n)\a\x10\xcb\x16s\x83\xce{\x1bJ*`\xe4J\x19\xc0\xeb\xda:bm?O\xc4\x7f\x1e\xa8\xc5\xd5+\xdc\x0e\x15}Ҹ\x01\u009cfyL\xd092l\xb0@\x83\xca%\x93:\xedL\x8cB\x87~\xd7\xc3un\xa9\xa6\xe6X9;\xd5k4k\x81\x9b\xe9F\x9b\x95P\x8b\t)|\x12\"hJ\xac\xd8\xe9\x17\xfe\xbf$G\x00\x8fo^\xbe\x99\xc15\xe7\xa0\xdd\x12\r\xd4\x16\x8bZFG\xeb\xe0\x9b+\xa0Rp\x05\xb5\xe0/ƣ\x04\xa5Sz\xd1\xdeVL\x9e\xa1\x1b\xca\xf4\xa2\xd8\xc2f\x89\x9e)R\xd1Cc\x15m\x80*%\x19\xbb\f\xd6lr\r?b\xab.\xc2\xec\xfe\xa3\xc4D\x15d\xc8҄\xdc\xe9)a\x16\xc0\xeeltT\xb0\b\xa4\x85\xe2\"g\x0em?6\xe2\x06#\x10;\x9c&C:\xdc-\xccFO\x11\\\x94e\xed\xd8\\H\xe1\xb6'\x18\x1e\xbf\xea̅\x92\xadBY\v\xdbB_Ð\x83P'\x82|\xf7R\x9f\x8d\x97(\f\x14\x82273~\x1f\xb1j\x88\xf4\xb3\xfd\x15X\x8fY\xb7\x9035\x1e\x93\xb1\x13\x849Jt\xc8A\x1b\xa0h\xd8\x18\xe1\x1c*\xa8\x95\x13\x92V{\xf2\x80\x1f+a\xd0f\xf0\xb8l\xd56\x1e۴5\xa3\x8e\x11*Y/\x84j|\xcd\xd6U\xa5\x8d\x8b\\\x12]\x9b\x8d\x9fZv\x8e\xe7;\x89\v&\xff\xa6e\xc2'\aƹ\x8bs\xa1\x92,'e6\xcba\xa9%\aM6\xc1\xa0f]t\xcdv\x95\xa4\r\xb0Y\x8a|\t+\xc4\xca[\xb9\x8c\x96iu\xe9)\xfb\x1dJ\xa9\xd7\xd1\xf0\x185\xe2Uv\x88\xb8\xc1\x053\\\xa2\x8d\xdc\b\x03\x06\x1d\x95\x16\xad\xa0\xf20#\xfb\x8c \x06(\x93\xe8l\xa0.\x02q1\xc2\xda\x17\xd3\xe2\xc0P\xb0hܤ\x12\fOR\x05\xf8\x96<M1\x95c\x9a\xe34L\xa3k\xd2Y{`\u00ad.+)\x0eN8\x91fw\x92\x1d\x02n\x03\xbd\xbc\xed\xaf \x15\x11l\x93Z-\xfa\x1eĂ\xff\x003i\xd6 :\f+\x1c\xf6\xb0nN2\xf9\x12\xf6t\x99\x8ee\xe9=i\x9f\x92\xb1\x1b\x9f\r\xbb\x82\xd9訊\xdet\xe7\xc6\x1d\x04\x04\x90\x16R\xa2E\xe7\x84ZXPH;\x01f\x86\xf5\xc3C\xa3\\+E\xc1\xe24\xb0\x1d\xe0\x1b۽ܗ=1o\xcc\xeb|\x85\xee\fk\xdf\xf8\x891\x0e\x9ae\xc4Vm\xd1oPN\xb1q\xd2\\\x009\xbbEs\x0e/\xb7\xd74q\xb7Y`p{\r\xf3Zq\x89\x91\xa3\xcd\x12\x15\xf5\x15E\xb1M\xbf\x8b\xaeǻ\x87\xa8U\xbf\xcf\n\x9d\x8e\xa8۴\f\r\x92\x9d\xc1|\xeb\xf0s\x84\xac\f\x16\xe2\xe3\x19B\xde\xfb\x89Q\xe1\x15sK\x10\xca\n\x8e\xc0\x12\xeao\xb6\xacI\xaa\xb03\n\xbc\tX\xea7\x8e\xa6\x86\x9d\xa7\x04QS\x1e\xa9\xbb\xa8\xeb\x84\xc5\xfb\x8a\xe8\xce\xede\x19d\xf9\x12r&\xe5\xaeI\x15\v\xf4\xd9\xf5\x99m\xc1\xb1\x15\xc2\x1c\v*\xdb\u008d-\xa1\x86\x1c%\xf2\f~\xa8\xa4f\xdc\xfa\xbe\x16\xd7\x1b\x15\xee\f\xee\xe6$^\xe0}\xcfÏ\x85\x8e\xf0F-\xfc\x96F\u05ceBwa\xd0\xf6\xeb\x85w\xbc\xd8Y\xf4}\xa1\xb1\xedd\x8f\x94\xa7\x05\t\xa8\x9f\xackw\x15\x8a\xb0\xb0\xa0\xb4B\xa8\x95\xaf\x98q\x1b\x88<{*\xde8\xe2\r1BN\x19.L\xdb\xf9p\xbc\uf84a\xc3)\xe3\b\a\xbf\xd4ڱ\x13\xaf\xff;\xcd\x01)|\x8b\x8c\xde\xef\x0f\x1c\xbc-U]\xce\x1b>\" \r\xba/Y*\xfbRF\t\x88%B\xc0\f\xbe\xc7M\x90`g\xc08\b\x05\x132\xb6\xef\t,\xe8tQ&\xc6j\xeb\x11+#lD(\xb1\x01G4\xd2\xf4\xf8\xaf\xc0\x90\x9b\x87b\xe1\xe5\xce~[\xe8\x18\x84H\r\xedi\xf4&\x88\x1b\xecYj\x1b{\xfb\xb6/?\xb54ɬ\a\xea\xc0\tv[\xdbS\x7fy\x81&1\x83\xd2oR\x1a:\xb1۾)\xd2C\x93\x93t\xdb9I\xbfK)\x858驤\xf1\xb3\xd6\xc3\xfb\u06ddVMI\xda\xd0椺\xfal\xf5U\xccQg~\x06\xff\xbc\xf8\xf1\xcbO\x93\xcb\x17\x17\x17\xef\x9fO\xfe\xf2\xe1ˋ\x1f3\xff\xc7\xff_\xbe\xb8\xfc\x14o\xbe\xbc\xbc\xbc\xb8x\xff\xdd\xebo\x1f\xef\xbf\xf9 .?\xbdWu\xb9j\xee>]\xbc\xc7o>\x9cI\xe4\xf2\xf2\xc5\xff%\xd9\xf98i\x9b\x11\x13\xa1\xdcD\x9bI\xa3\xdf\x032\x1c)\x1d\x06+I\x9b`:\\cf\x81.\xe1\x06=\x03\xbd\x1d,\b\xe7:\xc2:J\x01\xbe\xcdA\x7f$\xfb\xbdv\xb4G\x1b\xe0\xa0i\xbb[rڠ\xe6\xba\x12\xc8);P\x02\b[Ҁh\x87\xa6\x15\x0eˤK\x1f\xf5\xc7\x13\xceЬe\xc6\f\x12[\x9b\x9e\xfeJH\x19U~jS\xffn\xb8\xe2H\xc36\xd0\x1f\xb2\xd4\xe8/\xd7Ơ\xad\xb4\xe2t\x86r^\xbb\xb6e9\xfb<=$t\x98\xc65\x13\xd0]\xe8\xbe7\x16\xeb\xdf\xe8\f\x97m\xb2\xf8ltP\xabI\xaf{\xf0\xabv\xda%\x85\xe9\xb9\a\x06\xed\xb1E\x8f$\xa4\xbdwt^\x198\xfb\xb4\xe2Y縂\x82\x88\xfa%\xbea\xeb\x1b\x7f\x19\xfc\xa8\xe0%\x1dqQ\x93\x8a\xfb\x8eM\x12\xbbx\x94\xb2\xa1\xe5\x1dz\x9eDl?P+ϗj\x0f\xa0\x9a\xa1\x8d\x90\x92ڰ\xa1\x89\x90\xa0K\xfbQ\x83rKg\xfe\xba\x80\xf5\x1f\xb2\xe7ٳ\xd1y\xbb\xec\xdf\xeb0\xe4V\xd7\xea\x14Ľig\xc6J2\x84(\xe9\"\x92\x8d\x9eR:\xe9\x83\x01:nA\xfe\x16\xd7bx\xfe<4\xf8\xdd`E\xe4p\x17\xa1t\xf3S<ƛ\x9a0\xed\xa7\x01a\xf0-\x81(@\x1f\xfd\xb5\x89s\xf8\xa5\xc4\xcd\xc3\x1d\xa1rM'\a\x9d\x93\xf5\xf6\xdaй<\x9d\xe5x\xf5\x040\x96\xcb\xda:4\t\x9f\xdc9\x94wC\x8f\xce\a\x8a\xa2_8?\xa5\x0ea\xe3\xe3\xda\x00G:\xfa\xa4\x94\x95/\x99Z\xe0\x00\xfb\x1d甩\x81\x1b\xb7N+\xd4!\x8f=\xe2d\xadEi7u\u009a\xad1\x0f\x7f\x97\x12\xb9\x8f\x96\x8d\x82=U\xef\xa3C;gR\xeaĵߪ\xfc\xfa\x1c\xde\xf8u[\x9e\xce\xd4D\x7fAZ\x1b\x1d/=v\xe2J\xdf\xed\xc4\xf2\x84\xfc\x7f\xa7\a\xff\xe9\xd2\t\xd1\xfd\xc7LQڼ6t\x80Ԟ\x85\xd3\xc3d)\xc9\xceΣ\xbb\xaf\xad\x12c\xc3\xef\xafΒ\xcbi\xc7d\xc3\xd6M\x1a\xf9\xf7D|ܛ\x1e\xa5\xfd\x15\xc8< \xf2\xb0S˵\xe1\xbbe\u0084*\x7f\xd8\xd6B\xb9?\xfd\xf1\t\x99:\t&\x06\x0f\x1b@\xd0\xf1\x92\x90L\xbbO\xea\xf9\ue2d8٨\aI\xe0\xdf\xff\x19\xb5\xe8\x84 @\xe5\x90w\xbe룃\xbb\x19<{\xd6\xfb.\xd0\xdf\xe6\x04\xdbHQv\x06\xef?\xd0g}\x14\x1f<\x1c\xf9\xd9\x19\xbc\xff0\xfa\xef\x00\xb5\x8b\f\xa6\x8d)\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMs\xdc6\f\xbd\xebW`\xd2C.]m2\xb9ttk\x9d\x1c<m=\x1e;\x93K&\a.\x89\x95XS$\v\x80뺿\xbe\x03J\xca~:q\x0fY\xed\x85$\xf0\b\xbc\a@jV\xabUc\xb2\xff\x84\xc4>\xc5\x0eL\xf6\xf8\x8f`\xd4\x15\xb7\x0f\xbfp\xeb\xd3z\xf7\xb6y\xf0\xd1upUX\xd2x\x87\x9c\nY|\x8f[\x1f\xbd\xf8\x14\x9b\x11\xc58#\xa6k\x00L\x8cI\x8cn\xb3.\x01l\x8aB)\x04\xa4U\x8f\xb1}(\x1b\xdc\x14\x1f\x1cR\x05_\xae\u07bdiߵo\x1a\x00KX\xdd?\xfa\x11Y̘;\x88%\x84\x06 \x9a\x11;p\x18Ppc\xecCɄ\x7f\x17d\xe1v\x87\x01)\xb5>5\x9c\xd1\xea\xc5=\xa5\x92;\xd8\x1fL\xfesPSB\xef+\xd4o\x15\xean\x82\xaa\xa7\xc1\xb3\xfc\xfe\x9c\xc5\x1f~\xb6ʡ\x90\t\x97\x03\xaa\x06\xecc_\x82\xa1\x8b&\r\x00۔\xb1\x83\x1b3\"gc\xd15\x003\x1f5\xcc՜\xf1\xee\xed\x04g\a\x1c+ǺJ\x19㯷ן\xde\xdd\x1fm\x038dK>+\x85\x17\xe3\a\xcf``\x8e\x02$\xcd\xc1A\x8a\b\x89`L\x840E\xca\xedW\xd0L)#\x89_\xf8\x9b\x9e\x83\xd29\xd8=\t\xe1\xb5F9Y\x81ӚA\x06\x19p\xc9\x14ݜ\x18\xa4-\xc8\xe0\x19\b3!c\x9c\xaa\xe8\b\x18\xd4\xc8DH\x9b\xbf\xd0J\v\xf7H\n\x03<\xa4\x12\x9c\x96\xda\x0eI\x80Ц>\xfa\x7f\xbfb\xb3橗\x06#\x8b\xc8\xfb\x9f\x8f\x82\x14M\x80\x9d\t\x05\x7f\x06\x13\x1d\x8c\xe6\t\b\xf5\x16(\xf1\x00\xaf\x9ap\v\x7f*M>nS\a\x83H\xe6n\xbd\xee\xbd,-c\xd38\x96\xe8\xe5i]\xab\xdfo\x8a$\xe2\xb5\xc3\x1d\x865\xfb~e\xc8\x0e^\xd0J!\\\x9b\xecW5\xf4\xa8\ts;\xba\x9fhn2~}\x14\xab<i\xc1\xb0\x90\x8f\xfd\xc1A\xad\xe6o(\xa0\xb5<\xc9>\xb9N\x89\xee\x89\xf6\xb1\xaf\x92\xdc}\xb8\xff\b\xcb\xd5U\x8c#P\x98y\xdf;\xf2^\x02%\xcc\xc7-R\xf5\x83-\xa5\xb1bbt9\xf9(ua\x83\xc7xJ?\x97\xcd腗\x92T\xadZ\xb8\xaas\x046\b%;#\xe8Z\xb8\x8epeF\fW\x86\xf1\x87\v\xa0L\xf3J\x89}\x99\x04\x87#p\xffS\x94nf\xed\xe0`\x99Q\xcf\xe8u\xa1i\xef3ZUPITo\xbf\xf5\xb6\xb6\al\x13\xc1\xe3\xe0\xed\xb04\xed\x11.\xec\x1b|\xdf\xcc\xcf7\xb4>\x13\x8c\x0e\xa5ӓg\x93\x87\xaa\x9d'<\xa9\xc2\xd5\x01؋x\x11#\x85\xff'3\xd5g\xe1\xc6\x16\"\x8c2#\xd5iq\xc9\xe9\xa5\\ Q\xa2\xb3ݓ\xa0>T#\x1d>b|d0\xf1iv\x04\x19\x8c\xc0#\x12\x02F\x9b\x8a\xce\x19t\xe0\xca\x19\x7f3-\x03N\xd3X\x85͔,\xf2\xc1\f^\x1e/8^\x88\xe9\x1b\xea\xe8_ߡf\x13\xb0\x03\xa1\x82gǓ\xaf!2O'gy0\x8cߡ\xe0Vm.i\x80*\x81n~W\x04\xfdc,\xe3\xf9M+\xb8\xc1\xc7\v\xbb\xd7\xf1\x96ROȧ%\xaf.\xb7\x13{\xf5\x9d\xfaB\x96.\x16\xe5\xd9&\xeb+\xc7\x1d\xb0Ȓ\xc8\xf4\v\xaf\xfb\x126\xd6b\x16t7\xa7_\x1d\xaf^\x1d}>ԥM\xd1\xd5o)\xee\xe0\xf3\x17\xfd6\x90D\xe8\xe6\xf7&w\xf0\xf9K\xf3\xdf\x00~\x96\x80P\xae\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x10\xbd\xebW\f\xd2CZ \x92\x13\xe4R\xe8\xd6n\x02t\xd1\xed\"\xf0&\xb9\x049\xd0\xe4XbW\"Y\xce\xd0ζ\xe8\x7f/\x86\x92\xfcm\xafs\xa8\xb5\x87\x159\x1c\xbey\xf3fH\x15eY\x16*\xd8\xcf\x18\xc9zW\x83\n\x16\xbf1:y\xa3\xea\xf1g\xaa\xac\x9f\xad\xde\x14\x8f֙\x1an\x12\xb1\xef\xe7H>E\x8d\xefpi\x9de\xeb]\xd1#+\xa3X\xd5\x05\x80rγ\x92a\x92W\x00\xed\x1dG\xdfu\x18\xcb\x06]\xf5\x98\x16\xb8H\xb63\x18\xb3\xf3i\xeb\xd5\xeb\xeam\xf5\xba\x00\xd0\x11\xf3\xf2\x8f\xb6GbՇ\x1a\\\xea\xba\x02\xc0\xa9\x1ek0~\xed:\xafLĿ\x12\x12S\xb5\xc2\x0e\xa3\xaf\xac/(\xa0\x96M\x9b\xe8S\xa8a;1\xac\x1d\x01\r\xc1\xbc\x1b\xdd\xcc\a7y\xa6\xb3Ŀ\x9f\x9a\xbd\xb3\xa3E\xe8RT\xdd1\x88<I\xd65\xa9S\xf1h\xba\x00 \xed\x03\xd6p\xafz\xa4\xa04\x9a\x02`\x8c=\xc3*\xc7\xe8Vo\x06W\xba\xc5>\xf3)o>\xa0\xfb\xe5\xc3\xed\xe7\xb7\x0f{\xc3\x00\x06IG\x1b\x84\xae#\xcc`\t\x14\x8c\b\x80\xfd\x06\x14(\a*\xb2]*Ͱ\x8c\xbe\x87\x85ҏ)l\xbc\x02\xf8ş\xa8\x19\x88}T\r\xbe\x02J\xba\x05%\xfe\x06S\xe8|\x03K\xdba\xb5Y\x14\xa2\x0f\x18\xd9N,\x0fώ\xb8vF\x0f\x80\xbf\x94\xd8\x06+0\xa2*$\xe0\x16'~Ќt\x80_\x02\xb7\x96 b\x88H\xe8\x06\x9d\xed9\x061Rn\x8c\xa0\x82\a\x8c\xe2\x06\xa8\xf5\xa93\"\xc6\x15F\x86\x88\xda7\xce\xfe\xbd\xf1M\u0090l\xda)\x9e\xe4\xb0\xfdY\xc7\x18\x9d\xea`\xa5\xba\x84\xaf@9\x03\xbdz\x82\x88\x99\xa7\xe4v\xfce\x13\xaa\xe0\x0f\x1f\x11\xac[\xfa\x1aZ\xe6@\xf5l\xd6X\x9e\x8aJ\xfb\xbeO\xce\xf2\xd3,ׇ]$\xf6\x91f\x06W\xd8\xcd\xc86\xa5\x8a\xba\xb5\x8c\x9aSę\n\xb6\xccН\x04LUo~\x88c\x19\xd2\xcb=\xac\xfc$2#\x8e\xd65;\x13Y\xf3\x172 \xaa\x1f\x043,\x1d\x02\xdd\x12m]\x93S2\x7f\xff\xf0\x11\xa6\xads2\xf6\x9cn\x94\xb3YH\xdb\x14\ba\xd6-1\xe6u\x83\xf2\xc4':\x13\xbcu\x9c7НEwH?\xa5Eo\x99&1K\xae*\xb8ɝ\x06\x16\b)\x18\xc5h*\xb8up\xa3z\xecn\x14\xe1\xff\x9e\x00a\x9aJ!\xf6\xba\x14\xec6\xc9\xedO\xbc\xd4#k;\x13S';\x93\xaf\x83R\x7f\b\xa8%{B\xa0\xac\xb4K\xabsi\xc0\xd2GP\xdb\xca\x1f\t\xdcV\xed\xf9ʕ\x87Ul\x90\x0fG\x0f\xb0|\xccF\xb2\xfd\xbaU\xfb\x8d\xe6G\xac\x9aJz\x05\x8d@\x86\xee\xf1\xd3\xfe\xfe\x971\x9cV\xefI$\x93\x88\x85\x06\xe1UZ\x814\xa9]L\xc7[˃.\xf5\xa77(\xe1\u05cc\xf9\xce7\xc5\xd1\xe4\xce\xfc\x8dw,r\xbfh\xf4\xd9w\xa9\xc7\a\xa7\x02\xb5\xfe\x19\xdb\xe9\x98\xdd\x1c=\xe7\f\x7f\xf3\xfeq\x8e\xc1G\xbe\x06\xe0\xad3\xf8\xed\x8c\xe1\x1c\xa5\xe1\xe3\xf9PG\x839R\xea\x98.\x1b=\vk\xb4\xbbe\xec/؝)\xa6\xe9ɇ\xe6\xf3ʸW=Nʐ%\xa2\f\xf9_.#\xd1!#m\x9b\xda\xdar{\xd2#\xc0\xba\xb5\xba\xcdm*\xcbJ\xfa%\x91\xd76w\x9f\xef\x87/\xd5h#\x9e\x90v\x99%\x7fbX\xc0\x1f\r\x9f\xe9!\xe76(Ǻ.\xae\xf0A\xac8\x1d\xd4\xe4\xc5N\x94\xed'\xaau\x8a\x11\x1d\x8f^\x84tu\xb8\xa0*\xaek\x03S\xfd~\x9a\xdf\xd5\xc5\xc5\\O\x1b|\x9a\xdf\xc9q\xcfʺ\x01M\x88X\x92m\x1c\x1a\x909\xe9H2|\x82\x8c\xe1o\xff~sEF\xf1[\xb01\xf7\xddg \xbe\xdf\x18\nS\xeb\x16\xddp$\x1ep38D\xca\xd7\r\xad\x0e/:\xf2,\x10\fv\xc8h`\U000548e4'b\xec\x8fq/}\xec\x15\xd7 Ge\xc9\xf6\x84\x8c䖭\x16\x1d\xd6\xc01\xe1\xf7\x04\x1eZE\xf8L\xcc\x1f\xc4\xe6\x9406\xc5x\x10}U\\ץK\xb8\xc7\xf5\x89\xd1\x0f\xd1k$Bs}$'\x8b\xe0h\x90\xe4JivX\x1a\xafɻ#i1\xf5\x93\x8d\x92\xc7R\x82\x7f\xfe-\xb6U\xa5\xb4\xc6\xc0h\xee\x0f?O^\xbc\xd8\xfb\xdeȯ\xda;\x93?\xb8\xa8\x86/_\xe5\xa3B\x1a\xad\x19\xaf\xceT×\xaf\xc5\x7f\x03\x00E\xf12?\xd3\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W_o\xdc6\f\x7f\xf7\xa7 \xb2\x01y\x89}\xed\xda\x15\x9b߶t\x03\x82\xadš)\xfaRt\x00O\xe6\xf9\xd4Ȓ+Q\x97ފ~\xf7\x81\x92}\xe7\xfb\x93\xa4)\xb0\xf8\x80\xc0\x94H\x8a\xbf\x1fI\xd1EY\x96\x05\xf6\xfa\x1d\xf9\xa0\x9d\xad\x01{M\x9f\x99\xac\xbc\x85\xea\xe6\x97Pi7[?-n\xb4mj\xb8\x8c\x81]\xf7\x86\x82\x8b^\xd1KZj\xabY;[t\xc4\xd8 c]\x00\xa0\xb5\x8eQ\xc4A^\x01\x94\xb3\xec\x9d1\xe4˖lu\x13\x17\xb4\x88\xda4\xe4\x93\xf1\xd1\xf5\xfaI\xf5\xaczR\x00(OI\xfd\xad\xee(0v}\r6\x1aS\x00X쨆\xde\xc4V\xdbP\xadɐw\x95vE\xe8I\x89\xafֻ\xd8װ[\xc8*\xc39r\f\xf3\xa4\x9d\x04F\a\xfek\"\xfc[\aN\v\xbd\x89\x1e\xcd\xd6S\x92\x05m\xdbhЏ\xd2\x02 (\xd7S\r\xaf\xb1\xa3У\xa2\xa6\x00\x18\xc2I.K\xc0\xa6I\x00\xa1\x99{m\x99\xfc\xa53\xb1\x1b\x81)\xe1cpv\x8e\xbc\xaa\xa1\x92\x18*\xddaK\xc9\xdd\x18\xec\xd5D\xc2\x1bq\x17\xd8k۞0\xc0\xc81T\xfd\nþ\x89\xf9Drd\"oY?M/A\xad\xa8K$ʛ\xeb\xc9\xfe6\xbfz\xf7\xeczO\f\xd0PP^\xf7\x12\xd8\b\x1d\xe8\x00\b\xef\x12\xf0\x03@\xc0+\xe4\xf3\x00\xda\x06Fc\xa8\x81\xa5w\x1d\xa0\x85\x14%ܮ\xb4\x19\x8f%\x0f\xafh4\x10ȯɋM\x1f\xadն\xad\xb6\xfbz\xefz\xf2\xacGR\xf33I\xe1\x89\xf4\xe0\xa4\xe7\x12L\xde\x05\x8d\xe4.\x85\xe4t\xa0\x8c\x9a!~pK\xe0\x958\xa7\xdeS \x9b\xb3y\xcf0\xc8&\xb4\xe0\x16\x1fIq\x05\xd7\xe9\xc4\x01\xc2\xcaE\xd3Hʯ\xc93xR\xae\xb5\xfa߭\xed\x00\xec\x92S\x83LC\xb6힔\"\x16\r\xac\xd1D\xba\x00\xb4\rt\xb8\x01O\xe2\x05\xa2\x9d\xd8K[B\x05\xaf\x9c'\xd0v\xe9jX1\xf7\xa1\x9e\xcdZ\xcdc\xe9*\xd7u\xd1j\xde\xccR\x15\xeaEd\xe7ì\xa15\x99Y\xd0m\x89^\xad4\x93\xe2\xe8i\x86\xbd.\xd3ѭ\x04\x1c\xaa\xae\xf9\xc1\x0f\xc5\x1e\xce\xf7\xcez\x94G\xf9\x97J\xec\x1e\x06\xa4ڄV\x1cTs\xa0;\xa0E$\xe8\xbc\xf9\xe3\xfa-\x8c\xae\x13\x19{Fa\xc0}\xa7\x18v\x14\b`\xda.\xc9'\xbd\x9ctb\x93l\xd3;m9\xc1\xaf\x8c&{\b\x7f\x88\x8bN\xb3\xf0\xfe)R`᪂\xcb\xd4\xcf`A\x10\xfb\x06\x99\x9a\n\xae,\\bG\xe6\x12\x03\xfd\xef\x04\bҡ\x14`\xbf\x8d\x82i+\xde\xfd\x89\x95z@m\xb206\xce;\xf8ʵ}ݓ\x12\xd2\x047Q\xd0K\xadRE\xc0\xd2y\xc0\xa1\x03\xecJ\xf4\xee2\x95\xa7\xd1-\x05>\x94\x1e8~\x996\x8dN\xb3\x8aT\x9c\xbc\xa5\xeeq.<[\xbd\xa4\xc0\x17\xe0<\xb8\xe5\x91A\x00\xe1Rۆ>\x0f\a\xed\xa2a]\xf6\x06y\xe9|\x97\xdb\xd0\x05\x18}Cp\x16V\xf8\xd3\xcf/\xea\xe7\x8bgTU\xd5\xd9~4\xf2\xf4\xc8R\x9c5\xfc3l}\x8f\xe5\xf2I\xf9\xeb\x87//\x9e\x7f\xfd\xf1h\xfb\x1d\xec\xc8/\xf9}\x00\x80\xd4\xf6\xc7\xf8\x93Bj\xa7\xd2X\x18\xb5\xcd\xf2\xdcg\xcf\x03,\xb4E\xaf)\x1cٔ\x96\x92`\x98\rW\x194ړb\xe77\x17p\xaby\xe5\"\x03\x02c+ f\x9cGD\xf2-:\xcb\xffʬ_.\x9d/\xf16\x9cU\x8f\x0ex\x1e\x8d\xb9&\xe5\xe9!\xee\xaf\xf6w\x8f \xc8E%)\x80\x10\xb2\\\xd2a\xd3\x13\xc8<\xe1-1\xa5Y\xa5qꆼrv\xa9[\xb9]\x8f|%H&w\x8d\x1do\xef\fH\x02Vyj\xa4\x04\xd1H\x0f\x80>\x1a\xb3#\xe2\x11\xa1{\xfa\x14\xb5\xa7\x83\x96X\x0e@\x1f\b\xa7w\xff\xfdE\x9b.\xfa\xba\xb8\x13\xc1\\\x90W\xf9\u07bdN\xbbG\x14U\xf4\x9e,\x0f62\x9e\x8f\xab\xdf1\xd7\x1e`\xf1\xf7a[\x1a\xb2\xa6\xe9\xba5\x90\x13\xfa\x96<\xedF\x84#\x9b\xb0\xeb\xdfC\xb9z2\xc8zMB\xcc\xe9\xcc>&H3u'\x0e|\x0fs\xf2\x93\xa1\x13\x17\x86j`\x1f\xa98\xad\x8b\xde\xe3\xe6`m\x1b\xce\xcbo\xe9uW\xfb\xbb\xefizG\x13UU<\"\xa0\xad\xd6n\xae\xfeƃm\x15\xe4l\xb7+\xb2\x89\xce\xf9\xd8}\x86q\x0e\xef=\x98t\\\xe4\x1a\xe4\n-Yw\xf4}p\x9f\x8c\xac\xa3\x10\x1en\xa9\xaf\xf2\xae\x1c\xc3f\x12\x02(\x99\xd9\xecy\xba\xe4\xbf\x0f\xdb4t?\xe0?\x8d\xe1\x87uh\xf4\x92\xd4F\x19\xca&F\xbaO\x95\xa4<dcw즄\xd7t{B\xba%\xf0\xc4ڟ\xa8O-\xdc\x19\xe3\xc9^t$L\x83{3a0\xb0\xf3\xd8N9\rq\xb1\x9d)\xebb\xaf\xa3\xc1\x97\xafŮ\xb9\xa1R\xd435\xaf\x0f\xbf\xe0\xce\xce\xf6>\xd2ҫr6\x7fi\x85\x1a\xde\x7f\x90o3v\x9e\x9aa\xdc\x0f5\xbc\xffP\xfc7\x00\xe8\b)\x8c\xed\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\xf1\x7f\xf7_1\xd8{\xd8\xef\x01\x91|\x97\xfb\xa2(\xf4v\xd9\xf4\x8am\xef6\x8bx\x9b\x97 \x0fcqd\xb1+\x91,\x87\xb2\xe3\x16\xfdߋ!%۲\xb5\xf6\xee\x16\x97\xc6\x06\xb2\x12\xc9\x0fg>\xf3\x833\xf4,˲\x19:\xfd\x89<kk\n@\xa7\xe9k #O\x9c?\xfe\x91sm\xe7\xeb\x1fg\x8fڨ\x02n:\x0e\xb6\xfdHl;_\xd2{\xaa\xb4\xd1A[3k)\xa0\u0080\xc5\f\x00\x8d\xb1\x01\xe55\xcb#@iM\xf0\xb6i\xc8g+2\xf9c\xb7\xa4e\xa7\x1bE>\x82\x0f[\xaf\x7f\xc8\x7f\xca\x7f\x98\x01\x94\x9e\xe2\xf2\a\xdd\x12\al]\x01\xa6k\x9a\x19\x80\xc1\x96\npV\xadmӵ\xb4\xc4\xf2\xb1s\x9c\xaf\xa9!osmg쨔MW\xdev\xae\x80\xfd@Z\xdb\v\x94\x94\xb9\xb7\xeaS\x84y\x17a\xe2H\xa39\xfcuj\xf4W\xcd!\xcepM\xe7\xb19\x15\"\x0e\xb26\xab\xaeA\x7f2<\x03\xe0\xd2:*\xe0\x0e[b\x87%\xa9\x19@\xaf{\x14+\xeb\xb5[\xff\x98\xa0ʚ\xdaȧ<YG\xe6\xe7\xfb\xdbO?-F\xaf\x01\x9c\xb7\x8e|Ѓj\xe9s`у\xb7\x00\x8a\xb8\xf4\xda\t\xb9\x05\\\v`\x9a\x05JLI\f\xa1\xa6A(R\xbd\f`+\b\xb5f\xf0\xe4<1\x99d\xdc\x110\xc8$4`\x97\x7f\xa72\xe4\xb0 /0\xc0\xb5\xed\x1a%\x1e\xb0&\x1f\xc0SiWF\xffs\x87\xcd\x10lܴ\xc1@=\xc3\xfb\x8f6\x81\xbc\xc1\x06\xd6\xd8t\xf4\x06\xd0(hq\v\x9ed\x17\xe8\xcc\x01^\x9c\xc29\xfcf=\x816\x95-\xa0\x0e\xc1q1\x9f\xaft\x18<\xb9\xb4m\xdb\x19\x1d\xb6\xf3\xe8\x94z\xd9\x05\xeby\xaehM͜\xf5*C_\xd6:P\x19:Ost:\x8b\xa2\x1bQ\x98\xf3V}\xe7{\xdf\xe7둬a+\xb6\xe5\xe0\xb5Y\x1d\fDG;c\x01q5\xd0\f\xd8/M\x8a\ue256W\xc2\xce\xc7?-\x1e`\xd8:\x1ac\x04\n=\xef\xfb\x85\xbc7\x81\x10\xa6ME>\xae\x83\xca\xdb62NF9\xabM\x88\x0fe\xa3\xc9\x1c\xd3\xcfݲ\xd5A\xec\xfe\x8f\x8e8\x88\xadr\xb8\x89\xe1\rK\x82\xce)\f\xa4r\xb85p\x83-57\xc8\xf4\xbb\x1b@\x98\xe6L\x88}\x9e\t\x0e3\xd3\xfe\x9f\xa0\x14=k\a\x03C\xfax\xc2^G9a\xe1\xa8\x14\xeb\t\x81\xb2RW\xba\x8c\xa1\x01\x95\xf5\x80\xc7)$\x1f\x01O\a\xae|RV[\x04\xebqE\xbf\xda\x04y<\xe9H\xb2wSk\x06\xd9$\xafH|\xca\xdf\t\x1c8\xa1\x9f\x80\x024\xc3\xe2MM\x9e\xa2sx\xe2\xa0Kq.\xcb:X\xbf\x15`A 5\xd6\xe9\x8c\x19\xe4k\xac\xa2\vz\xdcYESb\xcbR\b5&o\xbd\xb7J&\xf9Θ\xd3]\xe4c͋\x04sV]\x90\xab\xdf\x11\xc1SE\x9e\x8cDaJ\\\xce\xc6\xf4\x16P\x9b!Z\xd3\xe1\x04\xc1\x9e`\x82č\x98\x80\x14\x1c;\xc4y\xa78\x97\xd5'%\xfe\xf9\xfev\xc8\xe4\x03\x89\xbd\xec\xe1t\xdf\v\xfcȷ\xd2Ԩ{\f\xf53\xf6\xbe\xbe\xad\x12Q\x82%D!8M%\x8d\x0e\tІ\x03\xa1\x02[M\"J!\x01\x12\xf8\x9e\xfa\x15oR\x06\xebS\xe5\xfeh\x11\xee\x01%wj\x05\x7fY|\xb8\x9b\xffy\x8a\xfa\x9d\x16\x80eI,@\x18\xa8%\x13\xde\x00we\r\xc8bt\xedI-\x02\x06\xca[4\xba\"\x0ey\xbf\ay\xfe\xfc\xf6\xcb4{\x00\xbfX\x0f\xf4\x15[\xd7\xd0\x1bЉ\xf1]Z\x1e\x9cF\\[\xe8\xd8!\xc2F\x87Z\x9b\xd9$$\xa0\xd4\x11\xbdڛ\xa8n\xc0G\x02۫\xdb\x114\xfa\x91\n\xb8\x92\xf4s \xe6\xbf$v\xfe}\xf5\x04\xea\xff\xa5о\x92IWI\xb8\xdd9|\x18t{!S\xe4y\xbdZ\x91\x8f\x85\xcb\xd4G\x96КL\xf8\x1e\xac\x17\x06\x8c=\x80\x88\xc0\x927R\xa2$u\"\xf4\xe7\xb7_\x9e\x94x\x8f#|\x816\x8a\xbe\xc2[\xd0&q\xe3\xac\xfa>\x87\a\xf9\x93\xb7&\xe0WI\x0fem\x99\x9eb֚f+:\u05f8&`\xdb\x12l\xa8i\xb2T\a)\xd8\xe0VX\x18\f'n\x8c\xe0Ї\xb3\xde:T?\x0f\x1f\xde\x7f(\x92d\xe2P+#\xe2ȩYi\xa9f\xa4\x8c\x89\x83\xc9\x1b5?\x81\xc8]\xc4\x131\xcb\x1a\xcdJ\xea\x9ah\xa4\xaa\x93\xf2$\xbf\x9eM,\xba\x14ǧ%\xc9t\b\xc7\xd2\xe48q\xfc\xcf\x0e\xf7g*'N\xf6\x1c\xe5\xee\x0e\xbc\xfc\xacrҫxC\x81\xa2~ʖ,\xaa\x95\xe4\x02\xcf\xed\x9a\xfcZ\xd3f\xbe\xb1\xfeQ\x9bU&\xae\x99%\x1f่\xc2\xf3\xef\xe2\x7f\xaf\xd6%6\n\xcfU(N\xfe\x16Z\xc9><\x7f\x95RC\r\xfb\xfcs\xecz\xd1WV\xc7k%,6\xb5.\xeb\xa19\xe9s\xec$$H\x04\xb6\xa8RjF\xb3\xfd\xdd]Y\b\xed\xbcH\xb4\xcd\xfa\x068C\xa3\xe4o\xd6\x1c\xe4\xfd\xab\x18\xec\xf4\xb3\xc2\xf7o\xb7ￍ\x83w\xfaU\xb1\xfaD\x01._\xa93o\x95PYi\xf2\xc5쬢\x1fG\x93\x87\xd2q\xa2b\xdd\xcd\xc9g/\x104\xe0j\xa2\x14C\xa5\xe2\xb5\a6\xf7g\v\xb6\xb3\f\x8c\xd4x\xc0\x15\x03z\x02\x84\x16\x9dX\ue476Y:\xe2\x1dj/ja\x18\xda\xe9%\x01:\xd7\xe8ɣ8\xd8\xc3\"\xb4\xaf\xf7\x91\xa3*\xf9K\xec\x90\xca\xd8\xe2\xbc\xe0\xa9\xc1\x99*\xd9{\x01\xc4g\xfacK\x8a\xe8`a9\xd5v\x9c)\x8a\x9fdQ\xfaR\xa9\xd6\xc6\"f\xb0\x9cj\x86\x8e\xe6HCq\xf4\xca\xd91\x9dّ'\x1e\r&\xfdf\xcf S\xea\xcc\xee\xc8A\xce\xf6\x95q\xfe\xc0i\xca\"\xa1G\x11v_\xddY\x96V\xaa\xd3\xf1\xd5\xday\xf3ޜ\xae\x88\x978^%\xe1\x82n\xc5g{/\xdb \x0f{L\xb5\x86p\x00\x97VJ\x13\x17\xd1H\xc5\xd2Q*\xdb\nuC\xaa\x87\xe4\xfcx\xcd\x04\xea!ʒ*)Q:\xd7XTCC\u058b\xb7+Ϥ_\x8f\xb7#\xd7|\x06\xb3cR\xb1\x93\x9f \xe1\xb4d\xab\xaco1\x14 w\"\xd9$\xa8\xdcaⲡ\x02\x82\xef\xe8\xf9n.w\x18̸\xba\x14\x8a\xbf\xa5Y\xe278,\x01\\\xda.\xec\x1a\xd5QR\xb8\xe6ާ\xf2\x97\xc8\xe2&[\xc0\x91 \xd2%\x0e\xde[uM\x13\xd7\xf4\x8dή\xb1H\x17\xc2\xd2\xdf\xc0\x92N\xb7ymN\x00p5\xf2%\xaa\xeee\xceT\x80\xed\xb2\xd7\xd9\b\x93/\x99\xae=\xdd%\x83;\xdaL\xbc\xbd5\xf7ޮ<\xf1\xa9\xe3d\x83\x87Od\xf3\f~\x89\xd1\xf0\"\xfd\xfb\x8d.Q\xd0O\x83\xda6C0ۀ\r\x98\xae]\x92\x17\x1e\x96\xdb@<N\xe7'\x98\xd0w3{\x1a\x0f\xd6\x0f\xf6KH}\x83V\xa2\x91[\x90\x18]\xc1\x82\xd2\xec\x1a\xdcN\x00\xbbAB\xe97$\xb8$\x05\xec\xfdy\bjG>\x0e\xbd\xf46%\xca\xf4ޚ\t_9\x8cgm\xc2\x1f\xfe\x7frF\n\x12\xb9\xa3^\x1d\x1d\x0e\xfd\xb8\xd0\xf9n\x1b\xa6\xb7\xff\xefw8st\xb3Aǵ\r\xb7\xef/x\xc1b7q\x88\x06\xbd;\xefD\xc0\xe8\x17\x03Z\xef\n'\x88p\x90[\xf2\x97\xb8*\a\xf4a\x97S/\x89:\x9a|\xe1\x14\x8a\xc8\xd3gЂ\x1cz\x89\xf4x\x13~s\xfc[\xd3\x1b`-75\xb1\xdeJ\x05Xj\xbeY\x0e'),\xad\xa7\x89\x94\t\xa7\xc7\xca\xe8\x10\x19\x8b\xff-ϏI?9y\x19%W\a\xd8\xfd\x15q\xfff_\xc3\xc8\xe5\x99\v\xa4\xee\x8e\x7fO\xbb\xba\x1a\xfd@\x16\x1fKkR\xa9\xcc\x05|\xfe\"\xbf\x82\xc5k㾅\xe3\x02>\x7f\x99\xfdg\x00~\xe4\xff\xab\x84\x1c\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\x11~\xf7_1\xd8{\xd8\x1e\x10\xc9w\xb9\xa2(\xf4v\x97\xed\x15\xdb\xdem\x16q\x9a\x97 \x0f\xb48\x92ؕH\x953\xb2\xe3\x16\xfdߋ!)\xff\xd4\xda\xce\x16\x97\x8b\x17\x88%\x92\x1f\xbf\xf9懆\xf2,˲\x99\xea\xcd\a\xf4d\x9c-@\xf5\x06?3Z\xb9\xa2\xfc\xe9ϔ\x1b7_}?{2V\x17\xf0f v\xdd;$7\xf8\x12\xef\xb02ְqv\xd6!+\xadX\x153\x00e\xadc%\xb7I.\x01Jgٻ\xb6E\x9f\xd5h\xf3\xa7a\x89\xcb\xc1\xb4\x1a}\x00\x1f\xb7^}\x97\xff\x90\x7f7\x03(=\x86\xe5\xefM\x87Ī\xeb\v\xb0C\xdb\xce\x00\xac가\xde\xe9\x95k\x87\x0e=\x12;\x8f\x94\xaf\xb0E\xefr\xe3f\xd4c)\xbb\xd6\xde\r}\x01\xbb\x81\xb881\x8a\xd6<:\xfd!་8a\xa85\xc4\x7f\x9f\x1c\xfe\xc5\x10\x87)};x\xd5N\xf0\b\xa3dl=\xb4ʟ\x8e\xcf\x00\xa8t=\x16\xf0\xa0:\xa4^\x95\xa8g\x00I\x80@-K&\xae\xbe\x8fXe\x83]\x10U\xae\\\x8f\xf6\xc7\xc7\xfb\x0f?,\x0en\x03\xf4\xde\xf5\xe8ٌ\xe6\xc5Ϟ[\xf7\xee\x02h\xa4қ^\x14.\xe0V\x00\xe3,\xd0\xe2O$\xe0\x06GR\xa8\x13\ap\x15pc\b<\xf6\x1e\tm\xf4\xf0\x010\xc8$e\xc1-\xff\x89%\xe7\xb0@/0@\x8d\x1bZ-a\xb0B\xcf\xe0\xb1t\xb55\xff\xdeb\x13\xb0\v\x9b\xb6\x8a1i\xbc\xfb\x18\xcb\xe8\xadja\xa5\xda\x01_\x81\xb2\x1a:\xb5\x01\x8f\xb2\v\fv\x0f/L\xa1\x1c~u\x1e\xc1\xd8\xca\x15\xd00\xf7T\xcc\xe7\xb5\xe11\x9cK\xd7u\x835\xbc\x99\x87\xc84ˁ\x9d\xa7\xb9\xc6\x15\xb6s2u\xa6|\xd9\x18ƒ\a\x8fs՛,P\xb7b0\xe5\x9d\xfeƧ\x04\xa0\xdb\x03\xae\xbc\x11\xdf\x12{c뽁\x10lg< \xd1\x06\x86@\xa5\xa5\xd1Н\xd0rK\xd4y\xf7\x97\xc5{\x18\xb7\x0e\xce8\x00\x85\xa4\xfbn!\xed\\ \x82\x19[\xa1\x0f\xeb\xa0\xf2\xae\v\x8a\xa3ս3\x96\xc3E\xd9\x1a\xb4\xc7\xf2Ӱ\xec\f\x8b\xdf\xff5 \xb1\xf8*\x877!\xc7a\x890\xf4Z1\xea\x1c\xee-\xbcQ\x1d\xb6o\x14\xe1o\xee\x00Q\x9a2\x11\xf6:\x17엧\xdd?A)\x92j{\x03c\ty\xc6_\xc7ea\xd1c)\xee\x13\x05e\xa9\xa9L\x19r\x03*\xe7A\x9d\x94\x91\xfc\x00z:u\xe5\xb3T\xe5\xd3\xd0/\xd8yU\xe3/.b\x1eO:\xe2\xf6\xd3Ԛ\x91\x9cT\x16\xc9P\xf9\x1e\xc1A\b\xa9\x1aO@\x01\xdaq\xf1\xbaA\x8f!<\xa4ښR\xc2ˑa\xe77\x02,\b\xa8\x0fm:\xe3\b\xf9띾`ƣK\t\xe1\xb1B\x8fV\xc2=V\x88ޅ:\xc2\xca\xd81-\xe2\xa3\x00؝`\x82\x04\xa8\xc7\xe7(>/\xfd\xb9\xea9I\xf8\xc7\xc7\xfb\xb1b\x8e\n'\xea|\xba\xef\x05y\xe4\xaf2\xd8\xeaG\xc5\xcd\x15{\xdf\xdeWq3\xc1\x12\x9d\x14\xf4\x06K<(\xc6`,1*\r\xae\x9aD\x94\xa76H\x82yL+^\xc5J\x91JҮ\x84\x8b\xf4\xa0\xa4F\x19\r\x7f[\xbc}\x98\xffuJ\xf9\xad\x15\xa0\xca\x12I\x80\x14c\x87\x96_\x01\re\x03\x8a\xc4\xe7ƣ^\xb0b\xcc;eM\x85\xc4y\xda\x03=}|\xfdiZ=\x80\x9f\x9d\a\xfc\xac\xba\xbe\xc5W`\xa2\xe2\xdb\xf27ƌĽȱE\x84\xb5\xe1\xc6\xd8\xd9$$(y`'\xb3\xd7\xc1\\VO\b.\x99; \xb4\xe6\t\v\xb8\x91,ߣ\xf9\x1fI\xac\xff\xde<\x83\xfa\x87\x98@72\xe9&\x92\xdb>\xef\xf63rG\x92\x1b\xc5\xc0\xde\xd45\xfa\xd0 L}d\t\xae\xd0\xf2\xb7\xe0\xbc(`\xdd\x1eD\x00\x96\xec\x8c\xf5\b\xf5\t鏯?=\xcbx\x87#z\x81\xb1\x1a?\xc3k06j\xd3;\xfdm\x0e\xef\xe5+m,\xabϒ\xabe\xe3\b\x9fS\xd6\xd9v#67j\x85@\xaeCXc\xdbf\xb1\xdfаV\x1bQat\x9c\x84\xb1\x82^y>\x1b\xadc\x97\xf1\xfe\xed\xdd\xdb\"2\x93\x80\xaa\xadБ\xa7Se\xa4k\x90v!\f\xc6h4\xf4\f\"\r\x01Oh\x96\x8d\xb2\xb5\xf4\x0f\xc1I\xd5 m@~;\x9bXt)\x8fO\x1f\xfd\xd3)\x1cZ\x80\xe3\xc2\xf1\xbb=D\xaf4N\x82\xec\x1a\xe3\x1e\xf6\xa2\xfc\xacqr0\xf0\x16\x19\x83}ڕ$\xa6\x95\xd83\xcd\xdd\n\xfd\xca\xe0z\xbev\xfe\xc9\xd8:\x93\xd0\xccb\f\xd0\\\xa8\xd0\xfc\x9b\xf0ߋm\t\r\xf9\xb5\x06\x85\xc9_\xc3*ه\xe6/2j\xec\x15\xaf\x7f\x8e\xdd.R\x03s\xbcV\xd2bݘ\xb2\x19\x0f\x01\xa9\xc6NB\x82d`\xa7t,\xcd\xcan~\xf3P\x16A\a/\x8c6Y:mf\xcaj\xf9N\x86X\xee\xbfH\xc1\xc1\\\x95\xbe\xff\xb8\xbf\xfb:\x01>\x98\x17\xe5\xea3\x8d\xae\xfcI7w\xafE\xcaʠ/fg\r}w0y\xec+'\xfa\xc2\xed\x9c|\xf6\x05Dɪ\x9e\x1a\xc7\xf7w\x17x,\xb6\x13G\x0e;\a\xa4vpĒ\xc0=\xdb\x05\x9e\xe1\x13\xa1.p\x89\xbd\xfdT\x8f\x9d\x98\x88\x1fӣD\xfa\xda\xc0\xe7\x04\x12^\xc2P\x8ed\xd2@\x1d2̦O\x0eGszw\xd8YdG\x91p4\xb8s\xcd\xd1@4rvE\xb4I\x038\x1c\xb5\xda\xe7\x0fVa\xc1\xa8l\xccoN0\xa2\xf1ˏV\xa5\x93\xc6\xf1\xf0\x15\xd3y/\xbf9]\x11\xdecx\x1dٱ\xe90\x9cW\x02sX+\x1a7\x99\xf2(\xec\xe1ť\xe1\xc5J\xe9\xbcF\x1d\xda:\xe9:+eZ\xd4#&I˅@\xe1@\x7f;\xd5Ō@\x03\xa1\x0eg\xcf\tҧ\xeb*\xe7;\xc5\x05\xc81>\x13\x88\x93\x19\xf2\xeeM-[,\x80\xfd\x80ׇ\xa7\x1c\xbb\x89T})\x83~\x8d\xb3\x84\xba\x1a\x97\x80Z\xba\x81\xb7G\xbe\x94JI\x8a[JQ\x90\x7f\t\x99\xbeQt\x89ʣ̙\x8a\xb8mR\x9f\x0f9\xf9\xa0\x1d\xba\xd3m2x\xc0\xf5\xc4\xdd{\xfb\xe8]\xed\x91N=\x93\x8dQ2q\b\xc8\xe0\xe7\x10\x1d_$@\xda\xe8\x92\x06i\x1a4\xae\x1d\xa3۱j\xc1\x0e\xdd\x12\xbd\b\xb1\xdc0Ҩ\xc8X\x1aNP!\xf5\xde;%w\bɓ:B\xa5\xd3D\xa9\xac\x9c\xd8C\xfc\xb2\x03m\xa8o\xd5f\x02\xb7\x1f)Js,\xe1+y\xb4\x8b\x98\x04\x0e\x92\xfea\xecK\xcf\xfe\x81ԝ\xb3\x13Ჟ2\xc6\xf2\x9f\xfe89#\x86\xa1\xbc\xb9\xac\x8fJi\x1a\x17A\x7f\xda\xf0\xf4\xf6\xff\xff\x0eg\x1e\xf8\xc4\xca\xf3\xb6\x1e\\\x88\x85\xc5\xc1\xe4K\x15/@O\u05fb\xfd\xd2uZ\xa8\x0e\xb7\xf9\x9a5jR\xa8\x93\x9b\x81\xb9\xde\xc3N\xef\xcdҝݓM\xdeu\xf4\x8c\xfa\xe1\xf8\xa7\x86\x9b\x9b\x83_\x0e\xc2e\xe9\xac\x0e\xbf\x9eP\x01\x1f?ɏ\x03RPt긩\x80\x8f\x9ff\xff\x1b\x00\xb9\xf7H\xe3\xa0\x19\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOoܶ\x13\xbd\xef\xa7\x18\xe4w\xc8\xe5'm\x82\\\n\xddZ\xb7\x05\x8c\xdaF\xb0\x0e|\tr\xe0R\xa3]\xd6\x14\xc9r\x86\xebn?}1\x94\xe4\xd5J\xb2\xd7\tP\xcb\a\x8b\x9cy|\xf3\xe6\x8f\xe8UQ\x14+\x15\xcc\x03F2\xdeU\xa0\x82\xc1\xbf\x19\x9d\xbcQ\xf9\xf8\x13\x95Ư\x0f\x1fW\x8f\xc6\xd5\x15\\%b\xdfn\x90|\x8a\x1a\x7f\xc5\xc68\xc3ƻU\x8b\xacjŪZ\x01(\xe7<+Y&y\x05\xd0\xdeq\xf4\xd6b,v\xe8\xcaǴ\xc5m2\xb6Ƙ\xc1\x87\xa3\x0f\x1f\xcaO\xe5\x87\x15\x80\x8e\x98ݿ\x98\x16\x89U\x1b*p\xc9\xda\x15\x80S-V\x10\x91\xd8\xe8\x88\xc1\x93a\x1f\rRy@\x8bїƯ(\xa0\x96cwѧP\xc1i\xa3\xf3\xee)u\xe1l2\xd0f\x00:\xe6-k\x88\xffXܾ1\xc4\xd9$\xd8\x14\x95]\"\x92\xb7ɸ]\xb2*\xce\f\xe4\x00\xd2>`\x05w\xaaE\nJc\xbd\x02\xe8%\xc8܊>\xc8\xc3\xc7\x0eK\xef\xb1Ͳʛ\x0f\xe8~\xfe|\xfd\xf0\xe9\xfel\x19 D\x1f0\xb2\x19\xe2\xeb\x9eQbG\xab\x005\x92\x8e&\x88\xc6\x15\xbc\x17\xc0\xce\nj\xc9(\x12\xf0\x1e\aRX\xf7\x1c\xc07\xc0{C\x101D$t]\x8eπA\x8c\x94\x03\xbf\xfd\x135\x97p\x8fQ`\x80\xf6>\xd9Z\nထ!\xa2\xf6;g\xfey\xc6&`\x9f\x0f\xb5\x8a\xb1\x17\xf9\xf4\x18\xc7\x18\x9d\xb2pP6\xe1\xffA\xb9\x1aZu\x84\x88r\n$7\xc2\xcb&T\u00ad\x8f\b\xc65\xbe\x82=s\xa0j\xbd\xde\x19\x1e\nZ\xfb\xb6M\xce\xf0q\x9dk\xd3l\x13\xfbH\xeb\x1a\x0fh\xd7dv\x85\x8azo\x185\xa7\x88k\x15L\x91\xa9;\t\x98ʶ\xfe_\xec[\x80ޟq\xe5\xa3\xe4\x968\x1a\xb7\x1bm\xe4j{%\x03Rn`\bT\xef\xda\x05z\x12Z\x96D\x9d\xcdo\xf7_`8:'\xe3\f\x14z\xddO\x8etJ\x81\bf\\\x831\xfbA\x13}\x9b\x15GW\ao\x1c\xe7\x17m\r\xba\xa9\xfc\x94\xb6\xada\xc9\xfb_\t\x89%W%\\\xe5.\x87-B\n\xb5b\xacK\xb8vp\xa5Z\xb4W\x8a\xf0?O\x80(M\x85\b\xfb\xb6\x14\x8c\a\xd4\xe9GP\xaa^\xb5\xd1\xc60C^\xc8\xd7t.\xdc\aԒ>QP\\Mct\xee\rh|\x045\x9b#\xe5\x19\xf4r\xebʳU\xfa1\x85{\xf6Q\xed\xf0\xc6w\x98S\xa3\t\xb7_\x96|\x06r2Y\xa4C\xe5\xefE\xc3\x196\x00\xef\x15\x8f\xfa\x97\x95q\xcfc`1\x9eW\x92 \xbf\xad\x92vv\xcai\xfc=W\x94\xd3\xc7\v1\xdd.\xb8HH{\xff\x04\xbeatcО\xeb\f\x11\xa4Vcr\xdfE\xb6\x9b\xdf\u05f5\x14^c0^ \xba\x99\x98\x0f\xba7\xc9\xda\xfe[Ph\xdf\x06\xc5fkq\xf9Hy\xa4lLw\xe8\xb1\xeb\xfd\x1f\xd7\xfb\xe0mj\xf1\xf9ss!\x82\x87s\xebq\xe1d\xf7\x81\x8a\xc49b4\x03\x85\xa1V\b\x82\xaf{\x12}A\x93\xb4\xc5w\xc4 Ub\"N&h\xb1\xdc\x1e\x13\x9b\xa5j\x9b\x98Ls<ٞ\xe8\xf7\xa6\xf1\xc1\x8aӤ\x9b_\x1f \xd9a\x10[\xa7\x18\xd1q\x0f#\xfd\xfa\xe3#\xc4*\xe2Q\xfbȍ\xeaB\x05\xdc\xcc=\x06b\x02\x06lZ<\xeb\xb7'E3DX\xee\xb4\xc6\xc7Vq\x05\xf2\xc1(\x04hf!\xf7<\xb5\xb5X\x01Ǆo\xaf\x11\x19\xf0Djw)\xba\xdb\xceJ\"R\x83\v\xa8\xadO\xfc\x82\xf4\xbc\x9f\xb3\x80\v\xe9\xb8\xc04\xec\x15]\xe2\xf9Yl\x96\n\xe2y~_\xa6\x80.\xb5\xf3c\n\xb8ç\x85\xd5\r\xaa\xfa\xb8d\xedyy\xeb\xc5\b\x17\xbbb\xb6Hr5\xacGy\xa6\xae\x91\xfb\x95S\x0f)\xad10\xd6w\xd3\xdb\xfb\xbbwg\x97\xf1\xfc\xaa\xbd\xab\xf3\x7f$T\xc1\xd7or\xddf\x1f\xb1\xee\xef\xb7T\xc1\xd7o\xab\x7f\a\x00\x1b\xa0}D\xf4\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}}o#7\xd2\xe7\xff\xfa\x14\x05'\x80fnmyf\x83]\xdc\x19\xc1\x05\xde\x19'1\x92\xf1\bc\xdf,\x16\xd9\\\x96\xea\xa6,\x9e[d\x87d\xcb\xd6^\x9e\xef\xfe\xa0\xf8\xd6ݲ^\x9aly<\xb3\x90\xdaH\xc6mu5Yo,V\xfdH\x92\x92}\xa4R1\xc1π\x94\x8c>h\xca\xf175\xba\xfb\x9fj\xc4\xc4\xe9\xe2\xf5\xe0\x8e\xf1\xfc\f\xdeTJ\x8b\xf9\a\xaaD%3\xfa\x96N\x19g\x9a\t>\x98SMr\xa2\xc9\xd9\x00\x80p.4\xc1\xdb\n\x7f\x05\xc8\x04\xd7R\x14\x05\x95'\xb7\x94\x8f\xee\xaa\t\x9dT\xacȩ4\xc4\xfd\xab\x17\xafFߌ^\r\x002I\xcd\xe37lN\x95&\xf3\xf2\fxU\x14\x03\x00N\xe6\xf4\f$UZH\xaaF\vZP)FL\fTI3|٭\x14Uy\x06\xf5\x1f\xec3\xae!\xb6\x13\x1f\xec\xe3\xe6N\xc1\x94\xfe\xa9y\xf7g\xa6\xb4\xf9KYT\x92\x14\xf5\xcb\xccM\xc5\xf8mU\x10\x19n\x0f\x00T&Jz\x06WdNUI2\x9a\x0f\x00\\\x9f\xcckO\\\xab\x17\xaf-\x89lF\xe7\x86O\xf8\x9b()?\x1f_~\xfc\xe6\xbau\x1b \xa7*\x93\xacD6\x84\xb6\x01S@\xe0\xa3\xe9\x1b6\xc0\b\x01\xf4\x8ch\x90\xb4\x94TQ\xae\x15\xe8\x19\x05R\x96\x05\xcb\f\x13\x03E\x001\rO)\x98J1\xaf\xa9MHvW\x95\xa0\x05\x10\xd0D\xdeR\r?U\x13*9\xd5TAVTJS9\n\xb4J)J*5\xf3\x8c\xb5WC\x8f\x1awW\xfa2\xc4\xee\xdaoA\x8e\nDm\x93\x1d\xcbh\xee8\x84\xad\xd53\xa6ꮭv\xc7u\x89p\x10\x93\xffG3=\x82k*\x91\f\xa8\x99\xa8\x8a\x1c\xf5nA%2'\x13\xb7\x9c\xfd;\xd0V\xd8Q|iA4u\xf2\xae/\xc65\x95\x9c\x14\xb0 EE\x8f\x81\xf0\x1c\xe6d\t\x92\xe2[\xa0\xe2\rz\xe6+j\x04\xef\x8cx\xf8T\x9c\xc1L\xebR\x9d\x9d\x9e\xde2\xed\xed'\x13\xf3yř^\x9e\x1aS`\x93J\v\xa9Ns\xba\xa0ũb\xb7'Df3\xa6i\xa6+IOI\xc9NL\xd39vX\x8d\xe6\xf9WAl\xc3V[\xf5\x125Oi\xc9\xf8m\xe3\x0fFͷH\x00\x15\xde\xea\x92}\xd4v\xb4f4\xe3\xb7F$\x1f.\xaeo\x9az\xc6T\x8b(8\xbe\xd7\x0f\xaaZ\x04\xc80ƧT\x9a笶!M\xca\xf3R0\xae\xcd\v\xb2\x82Q\xbe\xca~UM\xe6L\xa3\xdc\x7f\xaf\xa8B\x85\x16#xc\x9c\nL(TeN4\xcdGp\xc9\xe1\r\x99\xd3\xe2\rQ\xf4\xc9\x05\x80\x9cV'\xc8\xd8n\"h\xfa\xc3\xfac\xbfl\xb9\xd6\xf8\x83w^\x1b\xe4\xe5\xac\xff\xba\xa4Y\xcbb\xf016uf\x0eS![\xce\x01\x9dYm\xb0\x9b\x8d\x16/k\xfd\xe8\xc1V\xff\xb2Ҕ\xbf\x85/\xa2\xfe\xa0\b+\xce~\xaf\xa8qq\xd6b\xe9#\x97\xf2\x88$\xf8\xf6\x19\xb5h7r\vO\xf1\x87>dE\x95\xd3<x[\xb5\xa3\xc5\x17\x8f\x1e@\xb7\xa0\t\xe3\xa8\xff\xe8\xfe\xb1ټ\xfe+\xba\xd3G$\x01\x88\xa4\x80\x1aȸ\xa5\a\x8c\x1b!\xac\xe54\xfe0M\xe7k\x1a\xb7\xb5w`\xc692)\xe8\x19hY\xd1G\x7f\xb6\xcf\x12)\xc9r\x03c\xfc\xd8ܕ/\xe1\xfb\xce!\x14,\xa3́\xc2H\x16EM4\xf2\xe0\x11Q\xf8\xac\xb92\x13\xe2n\x17'~\xc4\xef\xd4>\f2\x13\xe3\xc0\x84\xceȂ\t\xe9\xfa\ue194\t\x05\xfa@\xb3J\x9ba~\xf5\xca+\x14*\b\t\xa5Pz3\x176[\xa23\x8eM\"\xdc\xca\xc2M\x8eË\x18;\xdar\"\x82Sl\xeb\x1cǮ\xfa\xbbRT\xf6\xbbj\xb0\xf6\x15\x00\x9b8\x02\x13\xa2h\x0e\xc2\xe9@UP\xe5ޕ\x1b\xf7T[\xd9\xf1Fҡ\xf3v\xdc-Ȅ\x16\xa0hA3-\x1a\x01H\f?\xbb{\x8e\r|\\\xe3C\x9c\xefu\x9e\xb8\xee\xd8\x16\x92\x80A\xc7\xfd\x8ce3;$\xa2n\x1a:\x90\v\xaa\x8c\x19aض\xdc\xd4ɝ\xb2\xef`H\x9dM\xaa\x8bq=\xe6mp&Ѭ\rO\xaep6\xa8\xc3\xfaq\xa4\xfe\xfcg2\x96\xf1U\xcd\xeb\xcc\xd9\xcbG\x8f\xeeWi\x91\xa5\x8c\xaa\x11\\N\x81\xceK\xbd<\x06\xa6\xfd\xdd]\x14IQ4\xde\xff\x05\v&^\xe3/W\x9fܫ\xc6o\x95\xca.\x8a(\x95\xf0\xfa/P(f\xb0\xb8vcEg\x81\xfc\xdc|\xea\x18\xd84\b$?\x86)+4\x95+\x92\xe9e/\xfb`F\x97\xf1\x0e\xaf9\xd1\xd9\xec\xe2\x01S\x03!\x1d\x01Б/\xab\x0f\x03kF\xcc\xed\x81y\a]\x8ci~\xaf\x98\xa4s\xccP\x8c\xe0fF[w0\xb2\x84\xf3\xab\xb74ߦu\x1d5\xefQG\xceW\x1a\xdb|\xb5\x8bz\xbbvÅ>a\x06a&\xce\xea\x18\b\xdcѥ\x8dX0\x1dQRI\xf0E\x1b\xe6\x12\xab\x97\xa4&\x0fa\xcc\xff\x8e.\r\x19\x97X\xd8\xf9tWUp\x99\x01\xba\xec\xf2\xb5\x15\x06b\x9b\xdct\xcfr\x12o`\xdf̭\xce:\xe0\x9cL\xf0E\xbbd\x1d\xe5H\xfc\xe5y\x9f\xd0\xcd \xb6:\x9fa\x05;\xc4dDa\xa6\xd9j\xc6\xcaN\x94\xcd\xc0\x89\x9ae\xacŧ\x89>\x92\x82塍V\xef/\xf9\xf1\xa0\x13A\xb8\x12\xfa\x92\x1f\xc3\xc5\x03ô\bj\xc9[AՕ\xd0\xe6Γ\xb0\xd36<\x81\x99\xf6Ac^ܺm\xe4C3\xdf\xd4A\xb9\xed\xcf\xe5\xd4\xe8Y\x10\x0fS\x98\xfb\x11\xd2\xf3\x03\xff\xe8^\xb7}|h\x7f\xe6\x95\xd28{႟\x98\xa1r\xb4\xeeM\x86\xb5jЁ\x1ef#eK\"\x8f\x9b\x16^j_ؑ\xec\rF^\xa6k\xc8OI\xcb\x02\xd3\xcc~\xb6i\xb2xD\xd3[\x96\xc1\x9c\xca[:\xd8I\xd0\xfc\x94\xe8\u07fb5\xa1\xa3\xd7MҰnC\xbb\xff8\u05fd\x92\xde\\w\x9d\xa0\xe5v\xf8\x96\x17\xf6ίnH\xde\xf5\xe9\x91\x19bM\xfc\xb1\x93\xbb$\xcfM\xa5\x85\x14\xe3\b\x8f\x1f!\x8b\x96\xf56\x1a\x86*G`NJ\xb4\xdf\xff\x8fÜQ\xe8\xff\x82\x920\xd9\xc1\x86\xcfMѤ\xa0\xadg]\x9a\xa8\xf9\x1a|\x03S\x80\xf2]\x90\xe2qZ\xf8\xf1\a\x1d,\aZ\x98\xa8\x02[\xb7\x1a\xb1\x1c\xc3\xfdL(\x8a\x8a\x00SF\x8b|\xb0\x83\"\xf6\xf5\xe8\x8e.\x8f\x8e\x1f\xf9\x81\xa3K~d\a\xf8hw\x13\xa2\x05\xc1\x8b%\x1c\x99g\x8f\xfa\x04A\x1d5\xb1\xd3\xd7\xf8ڤ\xef\x06\xb5h&~댯\vsG\x83\x9ez(dNe綼\xc7o\xfbƔB\x19\xebh5\xc8\xc4\xf1\xc0\xf8`-1w\xe1ӊ\xfe^Qn\xf3\x9e&q\x87\xb3\\U\xa7\xb5\x9c\xa3ݚ\xd8l^(YK\xc2\x10̕\xd3C{oF\x16\x14\b\xcc\xd8\xed\x8cJ\xdb\xe9\r\x99\xd5\xfa2\x9a\x13\xda#\xb0\xad\xba\xf9\x127s\xc3l_\xdex\xe3V\x9a\xad\xd6\x14\xe2\xbe\xd9\x18\xc8\x05w\x95/\x82\xf4\x86\r\x8en\x1f\f1\xa2\t\re\x1c'ʒ\x12t\x01\x96\xfa\b\xde\xd2)\xa9\nSׁW\xdb\x189g\x9cͫ\xf9\x19\xbc\xda\xf2%\xabYX\xb2\xbb\xa5\x9b}8J\xf5\xc7\xf5\xb9\xe0\r\xea5\xf6O\xb4\xa7=kR\xaa\xdb\xf9\xa1\x85gG\x18\xaf\x91\xa9SM\xa5\x93\x98\xb9\x17&\x97\xa3A\xafa\xb8Շ5\x8d\r\xb9_\xe2\xf5\xc5\bv+Mp\xb5\xa5.M\x8c\x99\x90 _v}g\xa5G\x17\x0f\x8d\xf45ᆵ\xad\x8e\xec{\u0084\x85C\xb2ZM\xed\xd4\xd47\xf6I\xef\xa1\x1c!kR\xf2\xb6\xc21K\r:\x10m\xeb\x10\x16\xcc\xe0\x9e\xe9\x19\xe3@|%\vm\xd6(\x94\xb1ՎDgD\xc1\x84R\xeeٷs\xd4鬃\x91n\xbfy\xcd\x19\xbf4\xb1&\xbc\xde{\xe8\x18\x06\xe2\xed\x83\xccFqzV\a\x81\x86\x1b&\x98\xe9D\x12=Q\x0e\xf73*iK+\x1e\xd7Rp2ґ$&\xb8\x1b)+\xa4k]\xf6\x94I\x15\x92\x15f\xb0\xecH\xb1R]\xd5!R\xc2\xd8;D\xf5\x88J'\xc8\xe0\xa2~:8\x01\xec\xed\x9c<\xe0@\x01d.\xaa\x0eq\xa3\x1bR\xa7\xa0\xd9<T\xab\x9d\x04\xee\t\xd3\xc6\xdd\xf9\xe1\x15\x8d/\x13\U000f283a\xeb\xc4jB\xa7XQ\xcb\x04W,\xa7!t\xc0\xbeW&H!0%\xac\xa8v\x85\x11\x89<\x16\xfcBʤ\x04\xc8{\xfbdP&\x1c\xf4\xef\xdb\f\xeaD\x14Y`\xe2\n6\xc5\xec3\xe5\x19\xca\x05Ө\xe8\xb2\xcd+\x1c3\xf8\xed:XɦO7\a\x8f\x17\xe5ռ\x1b\x03N\x8ce3\xbe5\xdfZ_'\xf0=a\xc5S\x88MR-;\x0eJ+b\xfb`\x9f\xf4\x8e\x89W\xf3\t\xc6rV\xbf\x95\x93_'\xb2\xa1\x15-\xe7d\xa5\x88*\xabZ\xd1[G\x92[c\xbc\xc8h/6\xee\xab?ر%\xc2W\xc4t\x9a\xc8c\xff82\x1a\r\xa3\x10\xfc\xd6[\a\xfa\r\x15\xe7\x1f\x90\xc5\xd6C\x9b\xb6\xa1\xc0\xacc\xa0\xb9g}7\xce\x01\\j\xc8E5\xc1z\xbb\r\x04(\xc9fF\x96\xcbv\xbc\xfdZ\x8d\x9eBw\xb1\xf7\xce1'\xf0\xf6\xef\xf5ӟĭ\x87\x01\xb1#I-p`\xfe@I\xbe\xf4\xb2#Zc\x06ϸv\x01\xb2\xe2M\x83y\x02\x16Ǥ\xbd\\+v~\xb3c\x16\x01\x7f\x10\xd2x6\x88\x12\xea\x8f77\xe3 M\xc2\xed\xef\xe8\xc0C\x84c\xa6\xb1;\x89\x82\x9b\xdf\xe7hu\xb2\xe2\x9c\xf1\xdb}\a\xf9\xf4\xa1\xa4\x99\xa6\xf9\xb5&\xbaJ\xf1\xc0\x17-\x02\xde\x11\x9b.+s\xab\x13I\fPs\x93\x84 \xa0\xaa,\xa3JM+3\xaf/\x05W\xb4m\xc9\x7f~\xf5j\xf4$\x8erN\xf5L\xa4Lxޙ\a[\x9d\xb7\xb4\x1c.\xb0\x13E\xf0\xb0\xcfvo\x7f\xb8\xb8y\x02\xabr\xd8p\xc4 $\xf47\x00+|\x97\x03\xb1\xb8\x0e#b\x96e+\xe2]GπT:\x12\r\xe1+k䱦\x9b\x11T\xbd\xb8\xf8yE\x9c\xda\xe5U\xa9rA\v\x96e,\xa0\xd8\x1aRG\x8a8A&\x1c*\xee݃\xb3\xe5\xff\xd8\b\xb4$z\x96 \xc31\xd13o\x02H\x02DK\x06\xdd\xd8\x05-\xed?\x1d=I\xff\x84L\x99u\x8e\x85\xd4M\x13Guj\xc4رvn\x9a\xd1RR\xa6\xc0\x00O\xb5\xc0I?\x02\x01;R\\\x99\xf4\xbb\x17\x84\x89\x7f\xe9\x1a\xfed\xb3y\xb3P#\xc5u\x9a\xc5.\xa1\x92`\xc9\xecAm0Lٿu\"Ո\xaf\xaa'\xe1\xb4\x15m\n\xab\x9dֵ\x14x\xdaԗN4a\x93\xc6>Eou\xf2T\xe2SN#\"\x87\x93\rY!3H\xd7\t\xa1\xa0\xcf\x1d\xa9j\x01\u07fc\x02E3\xc1s\xf5\xcc\x13\x0f\xa7\xa4\xfb\x9cx\xe0\xf2³A\x94\n\\rV˟`\x01\x8a\xe9'-\x11\xe0\vBv8e\xf6p\xd9\"\x80^\xd1W\x9b\x90t\x9d\xbf\xed\x9a_\xb0\xf3U\x92\xe3z\f\xac\x91\x9b\x9c\xb3+>\xb98hw\r3)\xdf\xdf\xeaV(\xfc7\x16#֝\xe9H\x11\xfd\x0eѰ\x14\x15\xdc\x133F\x9a\xd9v\xa8\x80\x94\xa2\xe3\xd0\x16+U_ͼ\x8d\xf8\xf6\n\x03\x86\xe7\xbe\xce\xe3#zʵ\\\x9a\xe5o]\x1b]\x17\x94s\x91\xdda\x0e\x7fNn\xe9p\xa8\xe0ͻ\xb7~p\xb7Qo\xe74\xaa\x13\xacEƗR,X\x8e\xf5\x86\x8fD2\x84₤S*\xb1\x1e\xae\xe0\xeb\x17\x1f\xcf?\xfcvu\xfe\xee\xe2e\x14q[\x05.\tG\x1d\xac\x94wvA\xfa\xd8\x01\xca\x17L\n>\xa7\xb1ܸ\xc4D\xd9·6\v+\x03\xb1>Y,\\4\x14E1\xf4د_b\xbc\xac\xb4\xf3\x91pϊ\x02&]\xfd\xbc\xab\xa0\xf0lF\xf8-\xf2\x15\x85\xd7\xe0#\xa8%\xd7\xe4\x012\xc2\a\x9də\xf1\x03\xa8\xcaHIsS\xff\x03\xe2R~\xf0\xf5\xd7\xc7\xc0\xe8\x19|\xddxI\x1cC/\x1c\xdd\xc0\x06e\xfb\xcc\xe9\x82J\x98Ԣ<\x8e\xe4\xea-\x91yA\x95\x81(\xdcϨF\xc0\x03\xb27\b\x8fƠ\xeb\xdc\xc8,Qo\u05ee\b\xad׀FQ\xf4\xebE\xef\u0082g\\2\x9a\x8bL\x9dj\xa2\xee\xd4)\xe3\x98#;\xc1\xf5\x9c'\rgvjG\x99\x13\x97p;\xf1eݓ\xa0\xe6\xa7_\xb9\x8c\xd5\t\t\xdfb\xfc\x84\x9c\xa8\x19-\x8a\xe1`c\x93\xfa\xb9\xe1\x84q>\xb5\xa4\x9aP%_\xe7)/\x82c\xb4\x98\xaa\x11b;C\xe2\"\x82,\xd4\xc5q\xc3\xe3\xd1Z\xdfyqu\xf3\xe1\x1f\xe3\xf7\x97W7Q\xa4W\xdc\xedf\x17\x9a\xe6|Z\xeev\x8d\v\x8d\xa2\xba\xd5ݶ]h\x14\xdd\r\xee\xf6\x91\v\x8d\"\xba\xce\xddnq\xa1Q\xb4kw\xbbՅƵw\xd5\xddnr\xa1QT\x1f\xbb\xdb\xf5.4\x8a\xe8\x1aw\xfb\u0605FQ\\\xe3n\x0f.\xb4\xb7\v\xa5|\x91\xec>\x7fvӅ\x86\x89\a\x99\xc7\r\xaeZ\x98\x15\v\x8c\xb7\xfdǺ\xd1\xf6i9\xdf\xea\xdf\x05_|$\xede\x19\xbc\xd9\xd9(\xcaP\x9b\x83#\x87\x1e\x8b\xd4\x00\x9f\xb8\xd8)eVQ\xd7\x1eb\x9fYa\xccU#\x9b\x93Ώ&OF\xf0έP \xf0\xe6\xb7˷\x17W7\x97\xdf_^|\x88cJ\x0f\xdb\t\x8bNz\xb2f\xb8f:\x13M\x11v\x8c\xc8\xd1\x03\x9d\xd7\x19\xba`\xa2\xaa\x17\xc77d\x97h\xb8\xce\xd0V\xec\xd6-H[vN\xcd<\xbe\xd66\xadO\x00\xd11\x8cH\xa0\xb9e\xee\xd6\b&\x12\bo\x9e\xc15B\x8a\x04\xba\xfb\x9e\xc7u\x9b\xcd%\x90\xdcg@\xb2;,\x89L\x816/-\xe0\xe8h4\x1cD?\xd7\xd3Y}/E\xc7z\xc2F\x87um0\xda!\xbbܰ\xbb\x1e\xee|薨\xb6\x06p\x95\xa4\xac̭b\xf4\xb3\x9e\xa8\x15l\xfb\x18/\x1d\x82w\xcanߑ\xf2'\xba\xfc@;\x02\xb9\xb6\xb3ݬ^u\v=AL\a\t\x041\xe1\x85\xf1\x83mZ\x8a\xcd\xf6\xe5K\xd4\xdaޝ<\xb9q\xeb\x90M4\x88\xecI\xebRO\xc3\xea\x17'\xad\xedذ\x110%S\f3v\xddu\n\x94!ҩ\xd4\xeaT,p\x1c\xa6\xf7\xa7\xf7B\xdeaZ\bG\x80\x13\v\xc1R\xa7\xd8Qu\xfa\x95\xf9_\x8f\xd6ݼ\x7f\xfb\xfe\f\xce\xf3\x1c\x04\xce\x161e\x810\"\xb3\x00\xaec\x89h\xfdUo\xefw\f\xb8\x13\xda1T,\xffn8H$\xb7\x0f\xdd\x10F\xb0\xa4ؓ~\xe0\xeeHl\xba\xec1\xae\xf9\vǷ\xe0\x11<\x00\xa5˂\xd4\xdd\xeb\x95]ИLɲ}\"DA#S\xd0\xf1E\xc1\xf4\x85\xb9=\v\x87\xeb.c\x01\xfb\x195\x86\xf5\xb0\xd1ma\xe9\xfa\x8f\x9b\xba\x95\"?\x03U\x95\b\xd8Pa\xeb\xc0\x11:\x82\xe3A\x02\xd9\xc6\xfe\x83\xa3\x00\";\x86\x7f\x85\x9bf\x17\a\xf5\xcbp\xf8\xedO\x17\xff\xf8\xdf\xc3\xe1\xaf\xffJ}OM\xb3\xb1\xeb\xeb>\b#\xb6e\xc4EN\xd1e\x1f\x9b%\t#7\x8b9\xcf\xccz\x82\xab\x1e\xecqH\xae\x99P\xfar|\xec\x7f-E~9\xeeI\xd2\xd0P\xa3\xe13\x05\x01\x9b\xb6`M\xd6tGͩj2M\xbf\xef\xad\xd1\xf7\xef\xd1d\x1cl\xac\a\xc5{ɴ\xa6X\xe1\aM\xe5\x1cS\xa4ǐ\xa7O\x1e\xfc\a'\x11\x8b\xd7G\xcf\x1a\xf4L=\x8b\xf6$\xc6q\x03\x98\xd7\xc7c9\xfe\xd8=_|\xbe!\xe0\xd0z\x10=\x1f_\xfa-\x80\x9f\x91\xf1}G\xb6 \xb6\xe7\x18\xdf\xfc\xfa\xdc\xef\x9fd\x9c\xf3\xd4\xfb\ru!5uf״{\xaa\xa9\xf6Z\xb09s{\xe18p\x9a\x82\x17\xf6\xe6(+\xabTg\xee(\xcc\xe9\\\xc8\xe5\xb1\xff\x95\x96\bT\x94\xa48A4\x11\xb9M\x1e~|SM\x13C\xc3\xdd\xeb\x12i6Y\xf0\xb8\xa5/\a\t$\x1d\x90#\xab$\xcev\x8a\xa5\x8fQh\xfel\xe3[П\xf5\x9b\x15\xa7)yH\xfd\xf7\x9ck\xd6\xfeäq\x16\xa2\xa8\xe6T\x1d\x87YJ\x0f\xc2H\x8f\xf2\x05&vV6\xa0\xfe\xa4\xfe\x11 g\v\xa6\xbab\xfd\xd7}\b_\xbeOtM\xf8s\x12\xbd\xa0e;\x9d^\xccXQ\xa4k7\x0e\xaa\x9e\xa1\x92\xa84\xd6çBΉ\xf6\x9e\x93>\x94\"-s\xe7?\xc1\xd7\xd6Q\x12\x02ӎ^\x1f%\x13-q!\x9c\xe4g\xf0\x7f_\xfc\xf3O\x7f\x9c\xbc\xfc\xeeŋ_^\x9d\xfc\xaf_\xff\xf4\xe2\x9f#\xf3\x8f\xff\xf1\xf2\xbb\x97\x7f\xf8_\xfe\xf4\xf2\xe5\x8b\x17\xbf\xfc\xf4\ue1db\xf1ů\xec\xe5\x1f\xbf\xf0j~g\x7f\xfb\xe3\xc5/\xf4\xe2\u05ceD^\xbe\xfc\xee\xeb\xe4&?\x9c\xd4\x19\x9a\x13\xc6\xf5\x89\x90'V\tvn\xbb\u0605\xb9g\xfbQ\xa5\xe1\a\x1f\x89\x04\xca\xfb\x88؆_nhՋ\r=#+E3I\xf5\xe7\x97s\xb6\xed\xf2a\xb8\xdd\xf4!L\xf8\x9fi\x84\xde\x7f\x1a\xba\xff\xd4Ӳ\xa9\x9e\xb7\xe0.*#0\xa5\xee\x1edM\x91|avtto\xb8\xa3\t\x15\x91\xbdY\xd8!U~H\x95\x7f\xa1\xa9\xf2kk?u\x9e\xdcl\x94ك\xe8!O\x9e\x9a'O~8\xad\xb7\xf6t\xac\xc1'ha\"*/\xb6\xb4\xbf\x16\x99\xe7\x02o\f\xc4JQV\xb8\xdd\xf3\xa07\nǏ\xfbaN\x1c\xe7\xb1\xdc\xf0Z\xa3\x90j\xe4\xb4im\xbc\t>F\x8d\xc1yQ\x00\xe3v\x904/\x8bFŚ\x85\x1d6\xeb\x00vE6] \x18\xe9~FW\xba\x1fE\x16\x970j\"5n'\x01\x7fGZ\x16\x01\xe0\xb0(\x8cü*4+#\xd1Ma\x86\x15v\t\x05\xa2\x94\xc8\x18\x9eYe\xb0\xe9\xd1\x03jA\x94\xf6\"A\xee\x81&w\x06\xbb\x98\xd1\x1cam\b;\xc7\xddH\xa3\x88z\x99O\x96\xc8\xd1\v\xbe\b\x88\xe8ʂsi\xb4\xf7Y߶\xe7\x06\x8e\xa2\xf9:hM\x8d\x1f\x8d\xa2h\x8b\xb9N\x00vs\x0ej\x8c:\xd4w\xd5\xe0ӄ\xd8\x01\xfd\x924\riq\xe6\xa6U\x9f\x0e\x91q4Q0Gx\r>\xed4#=\xcc\xdd\x18\xe2ցj\x12]\xf8\xec\xc2\xdb'\tm\xf7\x19\xd6\xf6\fi\xfb\x85\xb3\xdbB\xd9\x1e3\x9eڢ\xf6\x01\xd6\xe8\x17\x80&\xc7q\xe8\xa1\xe8\x94=\x9c\rzq\xf5\x9c\x87)\a\xb0\x1c\x8fR\x9c\xb2\xa4y\x02\xc6L\x92\x96\x94\x9b\xd5\xccfg3\x1c\xa8]\xf0\x13X\x9e\xa2ӟ\x01\xd6\xddf\x0e\xf6\xe3ЯW\xf2\x1c\ao~\xf0\xe6\ao\x9e\xec͝9}\xc1\xae\xfc\x13Δ\xcd\xdaڳA\xa2Іo\x1b+tMF\xa0\x990\xdc\xd7j\xee`\xafaʨN\xcd\x1b\xe3\xcc\xd2\x1c\xc7bL\x0f\xb1\xf0a\x90í6\x8aBܻ\x9d\xfd\xa3H\x16x\x10\xb1\x8b\xefaN8\xb95gB\xa0+w\xa5:\xe8|\xc0\x92\xb3\xa8\x05\x95\x92\xe5\x8d\xe9\xb1]\xfel\xb2\x06\xe8\xa6\nA\xe2t\xb9>\xc5\x1d7(\xb9\xa3\U00016585X\xba\xb3+x\x0e\xb8}\"\xba\xa5k\xaa\xe3\x00pI\xce\xc3\xf4f\\\x15\xc5X\x14,[\xa6\xab\xde%\x12\x82\xb2*\n(\r\xa9\x11\xbc\xe74\xb6,s^ܓ\xa5:\x86+\\\xc4{\f\x97\xd3+\xa1\xc7v}a\xe2\x8a\x16-\x1cQ\xdc\xde\xe3\fSFJ\x83&\xb7\xa8t\xf5\xce_Q$\x85l5\xcc\x02\xc4\xef\x99\xea;O\x8f\x1e0\x1f\x19\xe0W\xe6\xad8t\x1a\xb9\xaa'W\x9f\x82Mi\xb6̊t\x9fu\x9e\xe1\xff\xdd\xf1\xc0\x18t\xd4v\x1bA\x12@-\x95\xa6s\xbfŔI\xee0\x1ev\x97B\x17\x10\xb8\x15E7\xf4\xd0&\xccTO\x19\xa7\x06yx\xf4\xc65f\xda\xe2\x1e[\xb5ұ'\x83ꟑ\xa2\xc0mo\xe6s\x9acf\xad\x88\xcbT\xe1\xe5\x0fL\b\xbc5t%ug˧սf\x84\xe7\x05\x95f7/\x97\x03l\xd1G\x98*\xe3$vK\x8b\x1aޥ\x90\x91\x98\b\xcd2!s\xb7\xfd\xb0\xdfӉ\xc88\xc5\xc3+x<\xf4\x04͑GL\xdb͏\xa6<)Dv\xa7\xa0\xe2\x9a\x15\xf5Ng~+}e\xc7\xf7h\xaaI.&\xfc\xf3$\xd8\xc4\xc9\fOn9\xfd\xaa\xfe\x93\xb9\x11\xe3v\xfa\x18E\xf7\xe3Ov\xd8\x05\x8eT\xa8\x1a\x06L)\xe2\x87-\x7f\xa1\x80\xa6\x02\xc3\x17T*\xe7\x8b&\rh\xefh\x90@՜\xd8\x10h\xa0\xab\xa4@\x8c\xdbD\xb7\x86\xae.\x85l\x1f\xa6'\xeeV\xb3\x91\xff\xedS^\x12)\x86&A\xc18m\x1e\xf7\xc2\xcc\x11\x12\xc9d[\x16l\xfd\x91\x9b\xa1&\x93̙4G\xa5.\xc3JU\xdf\xf6>`~)\x84\x86\x17\xc3\xd3\xe1\xcbGE\xada:\xd5)+\xa8\x1d]\xed\x162\xbe\xa5=\x1a\xaaؼtGq\rss\xe2\xb5[\x0e++>H\xa4\xe9\xa4\xec\xb7,:\x06%@K\xe2\xcf\xfbKo+n\x80\x84ĵ\xac\\\xac\xf2b\xf8\xc7\xf0\x18\xa8\xceR\xf1\xc0\x00\xf7\x82\x0f\xb5Q\xa3\x11\xdc\b\\]\x18\x1a\x9eL\x13\xb7\xf7\xe3\xd4nWH\x1f\xb0\x00\xc5t\xb14\xc3|2M\xdc\x06\x14\x9d\f\x1eS붂\xbax`ڭ\xd3I';\x85W\x18*h\x1b*`I\xb2`\vz:\xa3\xa4г\xe5 \x91\xac٩\x01O\"\xfd7n7\x8a\x1bMqG1\xcd\xf1&\xd5\xcez\a\xd5\xfd\xd3\b\xbds\x17u\x12\xe0\a\xaa{\x0f\xaf?\xde܌\x7f\xa0\xf5\xf1J\xe9^\x1e[\xe4\xf1\xf9\xa8\xe6%\x95\x88\xef}\x8e\xf1\x0fW\xbd\xede\xf0\xfb\x11\xcfJ\xc4d\x8d\x9b\xa4\xf0\x14Q\xf9\x8f\x16mX\xb2C4\xc2\xe58\xd5\x02\x00\xfe!*,5NȤX\x86\xfdCq\x83\xa3#lz:\xec\x99q3\xcb\xfd\x91\x92\x1c\xb3!\xe8b)\x89\x9c1\xef\xd1\xd4\x1amً\\\xdfTJ\x8b9\xccl\xf7\x06\xbdP\xc7\x01\x9d\xeat\x7fdN\x02I\xa6I0D\xc5\xe9Niݯk\xe339ɶ5\xdc܌\xad\x14\x1c7'\xc9\xe9~\xfc!\x905\xc5\xe0v\xf5\xad\xfa-\x01`\xee \x164\x8a\x1e\xad\xeb\xeb\x81\xfa\x16~\xd6\xf2\x1f#<˫^4\xdd\xda\xcbxX\xda\xdeͺ\xb1\xbf\xcc\xe7\xcb&Ӽ\xe7\xe7S?\xa8e\"\x10\xb1y\x9d\xf4\xe4D\xafpg\x1f\xf1V\xcc\xf9\x1f;T\xcc,6\xc6r\x889\xc3(\x91\"\x1ez\xdc8F\x89\xcaE,\xc0q\x8f*\xd6\xfd\xe8\x90ǟ^\v\xde\xf6\xb3\xdcm/\x8b\xddZ\"\xbej\x1d\x84\x92H\xb1\xb1\x01\x864\xa1\x99U\x18'\xf8d\xa2!u0\x82+{N\x8b+\xe1&S\xf4!\f\xee\xe8\r\xaf\xb1\xa5\x7f\xfd\xcb_\xbe\xf9\xcb\b\xae\xfa\xb8\f_X&\x1c.ϯ\xce\x7f\xbb\xfe\xf8\xc6\xec\xfa6\x1a|F+\xdbb\xcex١3\xee\xd4\x17m\x93\x06\xd3Ȃf\xf3rs\r\x97\xffF'\x81s\x9a^;\xc79G!\x8c\xbby&?\xd3g\x10;1F4\xf8\xc4\x03\x8f\xce\xcak\xac\xdc'9ǖr\foތ-\xa9z\xb2\x9d@\x13ݭO13\xbe\x10\xc5\x02\x95\x84\xc0͛\xb1aP\x9ad\xf1iS\x1f0\xa9\xbe%\xd5\xf5Jx\v\xcdI\xa2\x8a\xa9D[l\xc1\xdd\x15\b\x1e\xfa\xc12\xd3\xd2P\xa6H\xa2\x8b-\x1d\x0e>}T\xbf\xb7\xbc\xc2\xf0\xbd\x87\x03\x01\xce\xd3\x13I\xc2jj\xa2\x95bH&\xdaNM\f\x9f\xc7S\x1c\"\x92\xc7\x11\x89;\x92M\xf6\x8b\xe3\x0f\x11\xc9\xe7\x1d\x91|icd\U000a3964\xd7Zt8vw\x8bM\fǖȞ0\x13\xfe\xf0\xe3M\xa0\x06\xc8\x13D\x8aF\xc6\xcd\xf6O>;.Z@\x04\x03^\x89\xa6\xaa\xaal\xe6k3\x9c*uj\xe0\x11Ui\xd2\xc1\xd4\x1f\xb7\x16\xbf\x7fO))n|kV@\xf8\x1d\t\f;\x10\xe0\x8e7\xa9\xce\xe2\xadŤ\xae\x1cv\xc4\xd5\x13\xbd\xb8\xfa\xc202IԌ\x9a͕\xe9\x03nbd\x12@\x92\x12%\xb8-\xe1:\xf11\x11_\xc0d\nJ\xa2\xf0H\x14\x1f\x86\xdbN\xd8r\xebX\xe4Ä\xeam\xa3Ap+\xf1\xf8ےJ&\xf0P\xf4\x8a\xeb\\\xdcǷsBo\x19W̓\u05fda`\xacD\x93*\xc2\xfep\x9a\x11|h퉍\xd4E\xa53\x91\xe0\x87Ŵ\xc9\xc5U\x00Q\xf4\xd2I\xfc1\xe6S\x91\xa2Xֆ\xeaWz\xea\xfd\v\xe91\x92(\x95\tu\xbfW\x91D\xd1\x14\xdb\xc8#4\x85\x1a\x95\xd4\xe8H4ݖv\xe2\xf1\xe4\xb88\xa5\xc7AT\xbe\x96s\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\ah\xd3\x01\xdat\x806\x1d\xa0M\x9f?\xb4)\xe91\x8f\xe3\x19cv\xe7l\x90hHñ\x01)\xb0\xcc\xc1\x80Ĵ\xd6\xdf\b\x9ausFP\x9f\x1d叚\x0f\xbb\xb4DQt@\x9f\x1a\x9e\xa4>\xf5\x9eL~S0uZ\n\xfb\x9f\x1aS\xd0\x00\x13\x98\x16F\xa1\tR\a\xdf\x14\x14\xc1.\x04A\x92\xafێ\x1e0H\x80h\x9a\xfbD\x0e\xf4\x89n\\\xe18\xfe\xc1\xadh\x01O6\x81*l@\n\xb4K\xe7i\x05\xd9\x06J\xe0q\xb5?\x89\xa2\xeb'\"\x04\x1eW\xfa\x13)\xba.\x0eզ*\x7f\x12]\xa6\xf6_\xe1\x7f\x82\xea\xfe\xfe+\xfb[\xaa\xfa\xb0\x14U\x12\xcd\r\x15}W\x99O\"\xb9\xa1\x9a\xef\xab\xf2i4\xd7W\xf2[\x15\xf9$\xc2}\xab\xf8=\x8aS=\x83\xeb\xf4Lrb\xb8\x03\x1el|3\x93T\xcdD\x91\xf7\x1a\xd3\xde1\xce\xe6\xd5\x1c݄B\xf7\xc8\x16\x01\xcd\x1c\xaf#\x1e\xe7d\xc6tW\x86C\xc2,\xa7\xe6\x10K\u008a\x84\x9a\x9c\xddZoF\xcc\xd2+Ue\x19\xa59\xcd\xeb\x14V\x8a\x85|3\n=7U#\xf4\\\xafc5\x0fQ\tD\x9b\xf9\xdd7\x7f\x8e|6}f\x98\b\xd8\xd8\r\xd60Q\xdd \xf1\xec\xd9\x1e@\x8d>\xe1Fj\"\xe5i\xc0\x19[\x80\x19\xb8wL\x12\xcd-\xa0\f`\xbc/\b\xa2\x0f \xa3\x97\xe7\xec\t\xc4\xd8\x02\xc2p<\x1a\xf4\xc9\x154\x01\x18\xab@\x8a$\xc2=\xc0\x17=ƶ\xa7\x02]l\x06\\\xa4\xaa$\xf4\x06[\xf4\xf1\"u\x0e4\xf5ٍȁާ\xe3\xf7J\xd1\xf5\fn\xf6\x00\xaax*\xb6\xec\x03BЃ/}rk\xbd\x00\x14}\xc0\x13\xc9\x11g\xdfP7\x1d0\xb1\x05,\xd1'\xd3\xdc\x13(\xd1K}R\xcb\x11ɫ\xac\xfb\x97!z\x97 \xb6\x00\"R\x93h\x9e\x95\x8f\x14\xa2\xcex\xa4\x88\x16V\xca\x0e!$\xb0\xe5\x83$\x8a\xed\x92\xc3^K\a{/\x1b\xa4\x83\x18\xb6\x03\x18|\\\x9d\xa6?\xb0\x1e\xbc\xd0\a\x84\xd0C\xa3S\x9d\x7fRQ%\xd9i3\xce4#\xc5[Z\x90\xe55\xcd\x04ϣ#\xa3\x96H\x87\xce0\xf0\xf8QK\xce\xce\xcc\a\xbd\x96Z\xc1\x8c\xb8\x933i\xee\x17\xd4\xfajH4e\x1b>\x021u\n\xec\xbdn\xaf\x9e|\u07ba\xc5\xf3\xa5\f\xec\x92\xd2}(\xc1\x8f\xe2\x1e\xc4TS\x0e/\x18\xf7z\x10\x9fG\xad\x93\x05u\xbe(\x985Z\xf5\xebW\xd14]c\xbe\xdcĎIm)\xf5ty=\xf7\x82\xfd'\xf6\x1c\xe1iU\xf4K\xeea\xe2q%\xb3\x17/\xbc\xfa\x18\xbeצ\xddޛ\x98,\xb5۶!\x81\xe6\x17\xaaTɰ\xb3\x9d\x903H8yl\x1bܬ\x86\x8eE\x93\xdd\x005\xabac\xf1\r\xdd\x043K\x82\x8c={\x86s\x05&\x96>\xfd\xdc\x00\x11s\xe1Y\x12\xc9\x1e\xf0\xb0\xc3<\xac\xd7<\xcc\xc5s\x16\x06v\x98\x87}F\xf3\xb0/c\x86\xa1ٜ\x8aJ\x7fV\x93\x8b\xfb\x19\xcbf\xcdX\x85\xcdq\x8b\x96\xaa\x0f\xe4\x1d\xe3Q\u05ec\xb5\xd1\xe5S\x1f=\xf5\x1f7#IҸ\xd8\xf4|\xdb\xd75\x0e\xf3\r\x1c\v\xb1L\\\"\x9a( \xf0\xf6\xea\xfa\xb7\x9f\xcf\xffv\xf1\xf3\b.\xf0\b\xe9\x9a(\xe3@\x10\xf3\x1cE\xd3\xf8\xa2\x19Y\xe0\xd6\x16\x15g\xbfW\xd4:\xe5\x17\xe1=/=~/\x8an\x1a\xd6/i\x94Aϣ\x92\x05\xf43S\xe6\x908C\x05=5}(\x05\xa6\x8eb\x0f\x90n\x8f<p\x81d\x108\x802\x91\x1afTR\xb8e\x8b\xc8I\x10Ru\a+\x92\xdc\x03\x92\f\x18\x12g\x8a\xb8\x84\x82LD\x15'\x1b\xa4ɩF\xeb\x0e\xd91<\x00\xb2\xb9\x1f^\xa5\xa8\x8aæM*\xb3\xd1J)ٜHV,\x9b\x8d$\xc5\b\xae\x84\x8f\xe1\x971\xd2ū\xc9·\xef/\xae\xe1\xea\xfd\r\x9e\xa5\x8e[\x82\xd9\xddC\xa2G\x9f\xa9\x14s\x98P\x14\x90\x15x>\x82s\xbe\xb4/\xb2\xbe<\x12\xab\x84A;\xe5HЅ!.F\x85\xa3W#s\x1d\x01\xc9s\x19\x9b^\nд\xec\x11@\xd7F=l\x12\xb9\x06\xc5t\xbd\xa1\x03=\xf1\xb9\te\xe2\x96\x01\x06\xe0\xf1\x18Y/ii\x0f\x9b\x8d\xe3\x12\xea\x88Wi#B\xe3\f\x15\xe3\xb7E\xd3*\a\x9ff\xf2\x14^8N\n\xf5[\xec\xa9\xe3\x13\x1f\xecZ}\x1d$/\xd6-E>Tp9\xf6ꈛ\x1c2e\xaa\x03\tD\xb1\x9e\x80\xc9\t\x96[۱\xabM\x8f\xe1\x15|\v\x0f\xf0m\x02E\f\x95\xff\x1a'\xaa\xbe\xf1DzD\xe1gʗ\xe3\x9er\xfe;\xba1\xa4\x84\x92A\\\x03K\xc2Ǣ\x80郦\x92\x93\xc2kL</{\xcc\xf6\xb0\v\x9f\xa5\xdac\xc3́\xb8!\xf8\xc2})\x93\x00\xa9a\x02\xb7A\xf1\x13H>\xc0\xb7\xa6V\xf7W\xd3DDY]9w\x96~J\xb6\xd7\bg\xdc0':\x9b\xd5\v=PJ\xb8\xc5c\x92\xd9\a\x17\xa7 \x17fw5\v%\x9e1\xf5%\x99n\x1a\xf4\xa6\xa5\xa9\x8f5\xaa\x8f+]I\t\x98ܱ\x8b\xcb\xedf\xa7\tt\x9d\xd3w\x13\x06\xec\xb2S٤\x19\xc3\xd6y\x83\xcbp\xa4-\x1c\xaf\x17\xf5\xa1/\xcc\bG\x1b\x93tJ%\xe6\xfa\x93\xe0蓥A[\xb0\x8c\xaaO\xea\x05K)\xb4\xc8D\xd1S\xb7Ǝ\fZ\x88KV\xbfK֭\xff\xf3v|\x8c9\xe5c\\\x80y\xfd\xe6fܪw$\xd0<\xbay3>\xfa\x84lݕ\x9c\xfao\xf6\xae\xbd7r\x1b\xc9\xffߟ\x82\x18,\xce\xf6\xad\xbbgf\x11,v\xfdO\xe0\x9dG\xe0\xec\x8cc\xd8\xce\xe4\x16I.`K\xec6c\x89Ԋ\x92\xdb}\x97\xfb\xee\x87*>$\xf5\xcb*\xb6\xedL\x12\xed\x04\xd8\xc4c\xfdD\x15\x8b\xf5b=6\xff۸\xb1\xff.\xa8^\xc28l\xe4\xe8\x19\x02[qyN\x9d\b 8!\xe3\x9c\x17\xe3[\xb1$\x99\xad\xf1T\x8a\xa2\xd1\xfa\xa2\xed\xc7\xe7\xbc\xe8\x8dR\n\x9e\xcaϨ\x96\xd2\t\x9af]\x9b\x8b*s}G\xcc\xe5E\x87ͣ\v\x95\x16Z\xaa\xcal\xaa\xb4$\xc1\xae{}C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi9TZ\x0e\x95\x96C\xa5\xe5Pi\x19SiY\n\xa3\xeb2\xa1\xe9\xd0.\x93\xbd\xd1y\x01\xbd\xd6/=T8h\x04HƦKLeo\t\xb8g\x1eb\x90h5\x93\xf3\xba\xc4\xf2\xb9\x979W|.Ɖ\xfd\xb8q\xa0\xd38\xac\xef\xe5\xc1\xe8鍕L\xe6\x92Vj\t\x7f\x9a\xbaŋ=\x8c\xa4H\x9d\xbc\xafF\xdeS\x1f\x17\xbc\x82Z\x9c\x13\xf6߇?\xfc\xf9\x97\xf1ї\x87\x87߿\x1a\xff\xfd\xc7?\x1f\xfe0\xc1\x7f\xf9ϣ/\x8f~\xf1\xff\xf1磣\xc3\xc3\xef\xff\xf9\xf1\xab\xeb\x8bw?ʣ_\xbeWu~k\xff\xeb\x97\xc3\xefŻ\x1f{\x82\x1c\x1d}\xf9\xa7ѯ\xacߺ\xc7\xf2\x03r\x8e\xfb\xe1\xd4%#\xe4\xfc\x1e\xe4,y\xa5<\u05f5¢]w \x1a\xc1aor\xa9g\xf3s;\x9f\xd1\x02\xd4\x1b\x16\xc2\f\xc7t8\xa6\xf4cz\xe9x\xa7{P\xc9k̝\x01\xb5㠒1\xbd\x1a\xc7\xfa\xb8\xb0Ni\x98\xcee\x05\x9d\x97bj\x8eZU\xd58F\xa4\xed\xecZ\x91E\x86Ĭ|\x8ey\xb2\xadTO\x1fRI\x8f\x99\xaenD\xb9\x90\x11\xa5\x8c\xe0~\xa9&\u2066\xc18\x153\xa9\x84\x1b\xf2\xfc\x87\x15{Q\x8f\xc1܉RVK\xa8\xd2\x10\xf7\xa4HA\xf7\xd8\\9 \xa6\xf1'&d\x85\xd9\xe4\x7f\x02.\x83\xc4j\xac\xf4#_j\x14:\x93\xc9\xf2\xa5\xff(4\r\xc5}\xf5r\xf4\xf8\xecPqs\xdb\xf0\x82\x18C\xab\xcaf\xcb\xd7V\xf0\x1c\xa6)\xea\xfd\x8bR\xde\xc9L\xcc\xc5;\x93\xf0\f\xcf\xc7\xc9^\xf2\xf0t\v*\x11\x14j&TU\xea̰ō\x80\xf3\x0fu\x97\xa5\x86\x88:\xd69\xceyDRU\x0e{U\xf8\xc5\x01\xd3q\xc5\xc0\xca*x\t\x8d1\xdc\v\xe82\x01\xdb\x01L\xb5\xce\\\xc5C\xb6l\xd6/\xe3BHJ\xff\xa4\xc4\xe2'X\xada\xb3\x8c\xcfCA\x14\xe4:F\xa6y\x04\x96\v\x9f\xca\x1emà(\xa5\xac\x05\xe3ق/q\xdbV\"^\x11\x88'\xec\xf5\x11\x9eonXXc\xca\xfer\x847\xa4oN/~\xba\xfa\xd7\xd5O\xa7o?\x9e\x9dǉM\xd83A\x8c\xd9'\xbc\xe0S\x99\xc9\x18s\xafsX !\xae\r\x06:\x94\xa7\xe9˴\xd4\xf4\x94c\xa4wY+\xec\xa7\x12hn\xf6\x8b\U000346f2 \xdb\xcd:\v&C\xceK\xae\xc0\xf2\x98.\xbb\xac\x01{\fA)\xeaɋ\x95}\xcez\xa7?\xb4\xb2\x83\xa7i*\xd2\xfdH\xf2x\xb9\xaco\xfc2\x96MO\x98(T\xc6.\xbe\xb9:\xfb\xaf\xcew\xa1\xb7\x10\x85\xb6\x97\x9b\xb1_\x82\x1d\x1c\xa4\xbd\xf7\xf8\xd2֟\x0e\xbb\xfcy\xeer\xa4\xf9\xcb\x1a;`\xbf\x9c\x82\xcbZ\xb5\xe4\x98T-\\\",c\xb9Nń]X\xd5,L\x17\xady\v\x9d\xfd \xf9\a\x92\x1c\x14\xb4\x99\x87\xea\xc4\x7f\xd7\xf2\x8eg`\xf3T\x1ak*ɐZm\xc9=\x9b\xf1̈ɳic0d>\x82Ӽ\xd7.\x06\x14\x96\n\xa5+\x17n\x8b:\rЀ\xa7\xd4\t\xb3\x9e|+ٯ\xa3\xf1\"\xaa\x1e\xae[\xcaX\x1aO\xf3\x8b\xb0r\xbc\xe5!\xa3Bۺ\xcd\xcaؿ\x8c\xcen\x90a\x025\xfdX\x13\x0e\xf3dl\x9eI\xceͭH1\xed9\xea\xf3\xa1\xfc\xd7\xc64\xec\xf6\x84O\xbf^\x16\x82\xcd\x04\xaf\xea\x88+'\xb4\xadm\xf6\x8eP|\x9a\xd1C\xa1Ѳ\x0fh\xf4\x8dʖ\x97ZW\xefC\x19\xf2^\x8c\xfc\x9d\xf3\x96\xbaw1DD\x86\xe65\xa6{\xa4c\xdcD\x10\x11\x9dJi\xc7}d`i\x9e[@\x94\xb5:5_\x95\xba.\xf6\",\x9c\xbe\xaf\xceނU\f\x0e\t\xf0\x9fPU\xb9\xc4\xd6\x12\xa3ȁ\xfc\x1b\xfc\xb1o\xe1<\xba\x13H\x86\r\xe2a\xc6je\x044\xbf\xe1K\xc63\xa3\x9d\xe3HF\x94\x8a]\xe0<\x8av\xdcg°\x87\x93\xa8b*\x9b\xa6\xba\xbaa+\x80(\x1e\xd6\xdfCo@\x00DŸ^Hɂ\xea\xab\xd5\xd7\xd1a\xf9\xad0\xd0?3\x11\xa9P\x89\x98\xc4\xdf'\xff\xf5\v\xe2\xb3\xf1a~\xe4\xfcs\xad@\xbc\xec\xc5\xfbg*\x95\t\xb7Z\x91W]\xce\x1dE\xf5\xc1r>=\xc7\ny\x14.\xb5\x81+\xe3\xb3\x19\xf6\xe0\x8e\xdb\xf8\x7f\xd6S\x91\x89\xca\x06J\xb0\xcf\x1c\xaf\x04\xaeV\xe6|N\xd7\f\xbc\n\xaa\x10:e(S\x97\u0085\xaa+\x96\xea\b7\xc0\xf5\x81\x80^\x01ߞ\xbde\xaf\xd8!|\xfb\x11\xb2?\x14\x82\xc7Tm㜌\x15i\"g~\x89@R2$\xca\x0e\xe8y\x85\xa2\xfa\x98)\r٬7\x9e\xa61\xd1!\x1f\xbcr\x19\xce\"\x1dD\xd3\xe7!\x9a\xf6T\xac\xdf\x1aQ\xee\xadW\xbf}\x06\xbd\xfa6֘\xb5\x16|\xd9\xdd5\x14(,\x17\x15Oy\xc5ɘV?{\xc0\xb5\xa3\x10û\xbb\x8f\x02\xb26\x19\xf3\x0fv\x14~\x1d-m\xc4\a\xa9\xea{;\xf6\xc5\xec}\x96\xae\xde!\x1csWI1\x1a\x05\xd2?\x8b\"\x83]\xa9t\xf7<\x81:i\xb3n\xdc\xde7\xc7\xd3\xebWT\x0fp#\x05f\x06\x19\x93ì\x91T\xe7k\x1f\x0f\x8e\xa8\xe0\x11^q\xeb\x837\x1c\xcem\x87\x8d\xfc\x9a\xd6\xe1\xfc\xa3\x1d\xb6}B\xf7\x99\xb8\x13\x11\x8dBWN\xcb\a@\x81\xac\x03\xcf5\b\x1b\x81\xcaXƧ\"\xb3\xa6\xa1=9\xa1\xd3I\xc3H\xa3g\x0e\xaa\x96:ۿd\xf5RgX\xd8\xc3\x03\x91\x00\xf6wC#|x_\x1a]/\x8b\x15\x1aEG\xd1?G\x1a\xd5\x11\x16\xde\x1a\x8d\xc0L\xec\xd2\b`\x7f'4\x8a\xbe\x820\"\x81L\xa0\x8bR\xcf$\xfd\xb0v\x99\x10\xa6\x9eX\xb8&\xa7\x86\xae\xfak#6er\xa3K\x85\xe0dD\xbf\x18\xb8\x82(J}'\xe1ƔWV繬\x1f2\xe8\x7f4\x8b\xb3R\xfb\xb8\xcb\x00\x9e\x04\xf4\xd5މ\xb2\xf4\x8d0!\x1f\xc9\x01=\xabv\xd3\tϠ\xf7~$_\xac\xf1\xc6* \x93>\x9e\x13\x81\f)\x80\x85\xc3\xf1\x99t\xd8\x15\x1d\x7f\x12\x11\x19\xf06\x8aҩh\xf5~\xadq\xc0\fX\xb4\xeemQ\xc0\xbe\x9c\t\xec\x14\x9f|\x95\xfaZ,xc\xdcr\xb5ku\xe9\x8bj9j\x04\xa1\xd2\x18\x01\xeb\xd2io\x8eY) \xf7\xe6Nx\x81\x06\x89d\x99\xa8\x0e\xe2\xf6\xa9\xf5\xc1^2\xf8\x8d\x03\x8e\x00\xbe\x8e\x11\x94\xae\x94\x18\xaf\x05\xbcE<C\x15\x03\x02\xfe\xc5\a\xcfl/\x9eY\n\xbb\x87\xf7=,/\x00\xa59!\x91\xb7j\xf0\xe7V\xaa\xd4\xd5nu\x88\xefBaQ\x98\xce/\x9b\xb0O\x10\x8a\xf3\xd2\t\xc6h\x9f\xb0\x1f\xe2\xce^\xd806^?\xdaQ\x88mq\xb0\xe1hGaZqpi\xddE\x17\xcba\xe3\xaeԏ\x02^\xb9\xec\f\x04\x88HD\xf5\x7f\x82\xf4\xfaV\xe1\x19\x04\x119\x86 \xaaÎ\x02m$\xa3\xe7\x81\x17\xcf{\xbe|:9U\x1d\x8dc\x92J\xa2M\xaa\x85T\xa9^\x98Ǌ\xa6|g\xe1\xbc뜀\xb8\xab\xa4\x9a\x9bQ\xe4\xc9\x05\xd1\x0eM\x8c\x03Ӛ\xc7\t\xa9xI\x10F\x95\xad\x87\x0eȸNP9f>\x9b\xed\nW\x90\xc1\xb7\x847\x9ap\x05\x19qWx\xc3\xc6\x06ɐ\xbfNxc\x9e\x1b\xfe\xa6\x84\xf7V\x92gW\x85H\xf6\xd6j_}\xbc:\xedBF 2P\xf0\v\x1c\xcb\b\xbb\x04\x98\x8c\xa7\xb94\x06\xa6*.\xc4\x14\x06nG\xe1\x1e\xfa\xd4\xf9\xb9\xacn\xea\xe9$\xd1y+\x8b~l\xe4ܼt'{\fԉkR.U\x06\xd3/\x82\xd2\x100\x13\xc2\xdd\x18\xc0\xc7D\x81&\x81\xaa($\xb0m@Hp]'\xfbyl\x93\t\xec\r\xf9\xec&\xd5:+\x9eG6\x04}\x80\x1d\xa3\xe9\xe2f!\xb4\xba5 zk_\xa2`q/\xed\xd5ϳ\x13=\\\xac=\n\xadA\x8dy0\x90\xdeN\xa5E\xc0\xb2͗t\x9e\xec\xfb\xd9ak\x17u\xde\t\x8a\x0e\x14\xed\xb8\xb0c\x92\x1e\xabw\x17\xe3\x8fzi\xb7\xed\xe2\xeel\xb6\x0f\xe2\x93^'<\xe1\x95\xc2c\\+\xfc:\xa1\xbc\xa8\xc7\\ۭ=g1]\xb5PZn+Đ\t\x98\xccی\x98\xf9״.á\xc4\xd040\x93\xffCM\x8c\xec\x8e\xf9S\xda\xd6q\xb6\xfb\x11\xba\xe134/\v\xfc\xb5\xccG(\xa1\xb2\xb3\x12\xdd\x15\x93S^\x10\xab5\x14\xea8\x10\xc3[\xc0\xa5p\xdd\x18i\xe7\xe5g\b\x0f\xf10w\xca7]\xbb\b\xaf\x021rM\x9d\xa8\xe9\xc6\xfc\x81U\x0e2\xd2\x05UY*g3\xe1\xcb؈^v\xc1K\x9e\x8b\nz\u07fb\xfc\xae\xa9\x98K[K\xa4g\x8c\x83\xe48 \x86\xa1B7\x96c[\v&+\x96\xcb\xf9\x8d5\xc5\x19g\x99VsF\xcer\xac4\x83\xae/\f\xd2. Ci\xc1\xcb\x1cZ\xaf\xf3\xe4F\xc0\xbeq\xc5Қ|\xf0\xb1\xdd\xffr\f\xd3`\xc0\x95\x12\xb6Z\u05cd\xf9M|\x1b\x13\x12d\x98\x10\x86\x18x\xf51\x15\x15\xf7i\xca>ט\x84\xe9\xac\xcaΑ\xf7x\x90\xc6\x1c\xd1t\xe73h\xb83\x8c.\x1bF\x97\r\xa3ˆ\xd1e\xc3\xe8\xb2at\xd90\xbal\x18]6\x8c.\x1bF\x97\r\xa3ˆ\xd1e\xc3\xe8\xb2at\xd90\xbal\x18]6\x8c.\x1bF\x97\r\xa3ˆ\xd1e\xc3\xe8\xb2at\xd90\xbal\x18]6\x8c.\x1bF\x97\r\xa3ˆ\xd1e\xc3\xe8\xb2at\xd90\xbal\x18]6\x8c.\x1bF\x97\r\xa3ˆ\xd1e\xc3\xe8\xb2\xdf\xd4\xe82S\xa5R\x9d\x8c\"\x19ls\x9fK\x97\x80E\x00\xb5s\x10\xa0\xeb\f$\xe8ՐB\t\x96\x9d]\x9d\x17R\x01\x7f\x14QY\b\xe9\xa86]\xd5e\xd3\x18QA\xa9/Om\xb5\x16\ts\xf3\xb2|\xfb\x1cl\xbc_\nCm\xcc)\x15{\xf7\xcd\xfbp\xa2\xa2\x9at\xc6\xf5\x11\xc3\xef\xf9F%\xe2\x11\x18\xa1M\x10G\xfbQD\x85e\x92ic\xeb\xffqq,\xb9\xe1J\x89\xccy6\x92FY\x88\x86L\x85P\x90T\ne\xa0\xd3%\xe3\xccH5\xcf\x04\xe3Uœ\x9b\t\xfb\xeeF\xa8\x18&p\xf3\x16\x9a\x95\x1a\xc8\xe7\xc9-3\x94\"\xa7NȀ%2\x9e\x94\xda\x18\x96\xd7Y%\x8b\xb0Hf\x04\x16y\x11/<\xcff\xcd\x06\x03SA\x95\x04X\x96\xd0\xe11|\x05y\x8d\xb6\x80\xbf\xd9k\xf4\x01\x8f\x01_\xe4E\xb5d\xb0\xf54\xc7\x15H8\x93\xa5\xa9X\x92IȠ\xb6[\x03i\x14ڮ\xf3\x98Q\xf3\xea*\xc8y\xb6\xbb`\x1ciU\x8aW\x1dEel\xfar\xdcB\xdd\x12Si\x9c\xe5n\x8e\x19wݟ\xe9\xf9ԁ\x97\x90\xedS\xf8̰j\xf7\xa3\xc8e\x86\xfd\x91\xa6ɟo\x84!\xe4+\x8fb:\a\x1f3\xbe\xde\xdfϷ&E\xb1J\x82\x05\x11쨀\aG\x89;h\x81-\x12\x01\xd3ṕ\x8c$\xc4U)\xfa\xe4B\xb4\x12e.\x15\xa6\xac\x7f\x14\xc6\xf0\xb9\xb8 ^\xcfms.\x01\xa7\xc5\\Dw\x02\xd2S\xe1\x04\x85\xa7\x9b};80\xede\x93`s\xfb\x8d\xa18cQ\xc283db칎Y\v\x95\x8e\xe7\u0603\x95\xd4ZGT\xff\"\x120\xe4\xfe\xabJ(\xe8zc\xd3*\xa6\xa5\x1436\x93\x8ag.\x87\x93\x96\xac\x8c\x9dX\xa1w.t\xd05\x10\x86\xd0ʧ\xf8y\xda\xd0\x18\xf6;GȪ\xacU\xc2[\xf3Y\xa0A\n\x14\xaf\xccK\xc1\xa9\xc6;\x96b|\xf1\xea\xef\x7fe\xd3%X\xc1\x98CQ\xe9\x8ag~\x91,\x13jN\xecJ\xe9\xd4S\xb7\x82>p\x02N[%f\xf4\xc0\x1c\xe3\xbf\xdcN\x1bw\x028\xf6e*\xee^\xb6\xf8s\x9c\xe99\x8d\xa6\xeb\xb3o\x0fFO\x1c\b\xd9 \x06p\xc0Y\xb4 \xf0m\x9fٍ^ ?\xb4\xde\x10ub\x9d\x855\x85,\xba\xa2\u0380\xd5&\xec\xbd\xef\x89B\x82\xac\x8dX\xaf\xe3^'\x00'\xf2W\xa5\xc3Һ2\xc1\xa7[\xbbO!\x81j\xd72\xc1\x85\xd5QǺ\x03;a\xefy\x96Myr{\xad?\xe8\xb9\xf9F\xbd+K\xe2XF\xe4~O\x8f\x8c\x83\x15sS\xab[\xa0H\xb3\xfcLӴ\xad\xae\xab\xa2\xae|\xe5Zk\xe3\xc3f\x92;\x99\x04\x03\r\xbe\xbfK\\q/A\xec\xc0\x14?\x12$WL\x00\xbdl\xf9C\xa6\xe7a\xdd\xc6\v\x03j6\xf1_^}\xf17+\xb2 \x92\xf6\xb7WXnb\xa0\x86M&7h\x1b\x80!\x9b\xf3,\x13e\x94]\x80F%0\xfdd\x83\x90xr\x19Q-\x1f\xc1\xd3zD\x97\xfb\xfa\xfa_\xe8o\xcbʈlvl\x1b\xad\xbap\x19-\xb2s\x80F܁Ӳ\xe0\x1a\xfd\x1a\x0e\xed\x9d\xcejhPt'\xf7\x19\xca\xdeA\xf15S\x99\x84\xb6[\xb4\xd2\xd6i\xa6\x93[\x96:\xa0V^\xa7\xd3\xf0a\x1b'\xa3'\xcd`\xdd\xfau\ueef1\"\x98\x84\xc8X\u038b\"\x14\xa8\x96|\xd1\xf9X\x9c\bJN^\xe5q\x04\xd9\xe7F\xc8\xee\r\xd5`\xdf@\xd5\x06\xc83LA\xd5~n{\xb1\xc0\xc3\xdd\x1f\xb4\x0e\xba\x9f\xfd\x10\x01\x19\xf6\xc4\x1a\x9a\xb0sh\x0fӈ\x1c-\xf5\x9a\xcc\xdb=i\xac\xc2=C\xce+\xe7\xd3D\u07bd!\xd7\x16\xa24\xd2TBU\x9f\xf0L\xbcɸ\xcc]x/\x023\xa6\x95f4A\xe3\xee4\xc6-\x86'>H&t\xe4EHL^\xac\x15\xd88\x8c\x8a$\x01:\xdc\x05\x1d\a,\x10\xda\b\xe8̂\xf7H\xbf\x8b\r\x87vœ\xdd\xcb\xe0\xd8W\xec\x7fjh\xe4\xfe\x02\xa5\xbe\x1d\x94F?\xcex\x80,\xa6\x13\xf6\xed\xc0\xd0s\x89o\\\xfc#Ho\x80\xf0\x9f\xd1\x11\xbbdX\xd6\t\xd88\x86\xf2\xc1\xed\xa9\xf01\x92\x89\xed\xe3\x19\x01\x0f&\xab[\x1e;89\xa0Qz/\x91\xe3\xc9]\xea\x82ϣ\x86U\xafP}\x15\x8e\xa5\xd0\x04#\a\x8b\x9f\f\f\xa9\x1d\v\xbb\xc0\xd0\xed\x18qE\x1a\xba\xf2E\x81\x9aʥi8=\xec\xdd'l\xa7\x12\x81\xb8\x80y\x06\xa5\xae\xe1\xf6\x13\xee\x1e\x9aK\xa9\x8f+\xe48\xd7J\xc4\x18\x10Ƶ\f\xc4\xd6\x17X0\x03&\t\xb6\xbf\x90\x8a\xbd\x9e\xbc~\xf5[S\xfc\xf8%+\x8a?\xb2eYKn=+\x15\xfc\xb0\xc1=)\xf1хX\x9bـQ\xbd\xb4\xc0?\xb3\xb7\xa0c\b\xab:n^H#\xd8!5j\xee\xff\xa7\xcbv\x83\xae\xa3nH\x8f\xec\xff\xed\xe3\x05\xfaH\xed\xf4\t4\x83\x15\xe8dLwӱ)\x16o\xe217\xa8\x956\xd1_\xc4\xf4\xa8=\xb4\xab9\xb0\x1d3\x8e\x9e\xf5\x90\xb8-{w_\x94{nۻ\xfb\x82cԿh\xf6o\x14\xd9j\r\xe9\xb1c\xff\"p\xb7\x9b\x05\xff\x107\xfc.J\xff\x19\x99ˌ\x97\xd9\x12\xb6\xfe\xcaR\x92M\xeb\x8a\tu'K\xad\xa227\xa1b\xb1\x940\x97\x95\x95\x02\x1b\\AH\xe4O\x87\x9fN/1\xbb+\xa6\xe9\ahg\xe1\xf7\xa7\x86\xeb\xf8G\xa0h\xeb#W\x0fA\xc3\xd2\x11\xb8\xf6\x10xz\x02gb\x00\xd9ӗG\xa4*1\x96\xd7Um'A\xdf'Ym\xe4\x9dx\xc6c\x16\xeb9\x06[\xfbw\xe48\xbavCo%I\xdet$͛\x86m\u05fb\x17Ѷ\xf5lf\x8dA\xafC\x8f7\xa7\xd5\x10\xf9\xd8e\x15\x87\xf0\x0f\x18\x87.\xa0\xeeZ\xc2MEkZ\x01\t{\xd5]\xb2\x8d>\x9f?\xb4N\xe5i\x12W\x92\xf9\x91Ɖ.\xef\xf3dDf\xbdk\xfb\xa4\x9b\x16`\xa3\x8e9\xbf\xc7\xca\n\x8eǵ\x17&\xc3`#t\xe1\xff$2Qj\xaf\x96\x16\\V\xa1VE*Y\x05V\xefˀ\xe88\xd9&\x91\x93ѣo=a_~\xd6ӓ\x11\x89\xb6_\xebi\xa0+g?\xeb)\x96*\x84\x9e\x99\f\xfa\xf2=\x88\b\xf7\xf0pۊ\x0eXYcG\xc7\xc9\xe8q\xc3!\xc0Ȧ\xe0\x89\x88`\xa0s\xff\xac\x8fY\a0\\\xf8\xd7z\xda\v\x13}\xce\xc4u\xb3\x92\xaa\xab\x7f\xbb\xb0\xbd/P\xe01\by\xb9:U}\v\xef\bԟ\xc1\xa5\xe3\xa5;\xf4=\x11\x81\x93᪷.\xc6\v\x88\xb0\xc3~\x9a'`Kƴ\xbdF\x8e\xd8\x10w\x01\x1dT\x80\xc1\xa8F\xe7X\xf6\x02\x85)\x18`G\xfa\x1bd\xe0f\xb8\xf3\xee\xf9\xbdB\xd5y\xbfՏ\x19\b\x05\xa9z\xe6\xe4\x8f\xd9{.\xb3\xa7\xa0y%\xf2\x02R\x1a\"\x88~\xed\x1e\xf5\x87`\n1\x86\x97w\xaf\xd9\xd7z\xea\xff\x8eг\xdfӻu&\xc0w\xb0\x97\xe4_\xeb\xe9\x81A\xe5\x03o\x9b\v%\xca\xfeꑬ\x88:u\x14E)\x8c(\xefĸV\xb7J/\xd4\x18CC\xa6wE\xc5oCO\x01\xe5[j\xa7'\xb0+\x95\xf6E\x98\xde%\x01\x11\xc1x\x93-\xe2\xe5ZOTHnz\xc5r\xa9\xeaJ<\x85\xa4\xe9o\xf5\x8c\xc3\xf9\x18=\"\x93\x95\xc2\xe8\xbaL\xc4%\xb4\xef=\x19\x91x\xe2\xb2\xfdlK\xd3v\xb4샐 k\x13\xe8\x18\t-\xa25\x14\xcf\xfa5\x01\xafp\xb5\xc41P\xc7M\xd6^\x0fķ\xa2\xc8\xf4\x12\xbce\xc8u\xbd\x82\xa6ĳ:\xbb\x12\x15d\xa4\x84^M\xfe=}\xb2\aA\xf9\xc3g>\xb6\xeaO\xb4\xb2\xdd<#\xce\xe4\x1b\xff\xac\x97z \\\x90h\r\xec\x88\xe4\x04x\x8a\x1c`\a\x82\xaa6\x93\x00d\xc2\x0e\xf4\x84<\xbd\xe32\x83\xa8\x038*P\x10\xe0\xf3\xbf\\\x91YX=lO\xdd\xf7<z\x19\xe1W\xea\x92Uz\xee\r\xf9x\xb66\xc8.s\x9fm\xb2\b~\xb3\x1c9\xc2ߎ\b\xf3\xf8\xd0.\xd8I\x8a\b9w\r\t?OA\xc2\xfem\xa6;t\x83\xaeϞX\xbe\v0\xd5v\xf7\xca<\xd0(\x9ccvV\x19\xf6\x1d\x97\x95\xd7l\x12\r\xb5\x9e\x98\xd8zݩ0\xf0\xb1\xccN\xc6\xec\t\n\x1f\b0\x9c\x19\x91\x89\x04\\\x14\xf0;\x1c\x90u=\xb0\x8c\x82\xc9\xde\xfb\xea\xadQ\xcc\n[\x99\xf6\x1a\x96\n\x11\x8b\xa9Ht\xdeo?\xfd]\bL6\xc0z\x89~\\C\x13\x8cQ\xad\xb3{4\xccF\xfa\xf1r^\x83v\xe8KEwB\x1c\xf7\xb98\xf2ڍ9\xb9@\x93\xa3_\x19*\x9c\xbc\x02\x9c\x8c\x9e,\xa1\x80xr\x9dȑ\xea\f3\x17\bU\xc6\xf4H^ c\xf4v\xfbm\b\x1b\x1e~@\xb9w\xf2\xee~3\xa6\xcbs\x8e\xb3U\xa7\xcd؍\xc9h\xdf\xc8`\xa1S\xcc0\xc1\xd2)O\x01\x02\xaa\xbf)\x1e=!\a\xc0\xd7:)\x19\xb97\xef\x1a\x84M\x1eDoP\xe6|\x8d\x1e^\x04\x01\x13\xedՖñŕ  \x02\xc5jP\xf9-\xcf\xe3)w\x88\x14\xad E,F\x117\x99>j!\xe1\xde*\x01\xdf\x10\x9a\xd0\xfaLs:\x19ѓC\xf5\xaco\xfb\x9f\xb8\xfe\x01\x90\x88 \b1\x10\x12\xb9\xad\xa5\xa8J\x82\xba\\\xd9\xd6K\xfb\xb4\x17\x87\xad\xe6\x82\xd0\x01\xa07&\xf3\x87̭\xa6#\x12\xed.\x13\xc2R\xeb\x89\xfc\x84L\x96ܶ\x1c:a\xafFOY\x90\x0f\x1f\xba\xfc\aOn\xf5l\xb6\a\xed=\x84\xb3,\xdb6coP\x862\xcdx\x89Ԕ\xd9\xe2\x1aa39\xbd\x89\xbcۼ\t;\xabX\xaa\xebi&\\o\t&xrc\xa1Ɂ\x12g\"\xbd6\x93\xa7<\x11\x8b\xc6`\x8fܙ\xb6\xc9\xff\xf9\xa9\xa2\xc6f\xb1V?\x86e\xfc\xee\xf3\n\"@\xc4D\xcf\xd6\xc0A\xbf\xefO\xb7A\xb4;\xbc\xb1\x17#O\x119\xc5\xc8h\xff\xe4\xa1\x0e\x8f\xbc\xf7\xcfzٙ\xeajl\x04\xcc\xf8\xaa\\>J/P\xe6\x0e(\xc0\xf9j\x00\xeft5\x01-\x17i)n\xb8\xe9\x15\x8a\x82\x7flD\xc5v\\\xf1\x01\x15\\\xf5'\xfcѪ3\xda\x13\xb5\xe5\xb2b\x99\xb9\x12\x122$\x9b\b\x06S\xbal\xa8\xd3\x13\x15rEV\xac^\xbf\xae\x10VC\x7f\x9e\xc9\xfe\x83\xfai\xbe\x12\x91\x91g\x81\x92\xb1\xcc\xf3\xc9\xf7\xc2i\x06=¿Qz|\xf3\xaa\xa1\xf4\x83Q\x9f\x9e\x98O\x17&+tz\x85A\x8b\xa8;\xb3\x8b\xe6i\x17\xfbp\xf57:5\xb4b\x0eO\x16wC\xe3nFU'\xeb\v\xa5 \xcb{{\xa6m\x87\xaf=%\xaas\x9dI[\xa5\r\xe6\xa0\xfb\x0f\x89\x89\xf0(\xcb\xf4B\xb8Nt1\xa3\\\xdaL\x01\xf1\xdbB$\x13\xe3\x88\xda\b\x1b\xf8-\xffӞ\xc0(Ú\x88\xba\r\xa1\xb7b\xea\xfd\xf8I\xd5\x19F\x83\tݿ\xe8\x01#\xac)\x82\x04/\xd7l\xa5\xefs+\xec\xb8\n\x03\x1b\xcd#\xaa\a3>\x15Y \xb7\u05ce@DW\xcd\xdf\xfe\t\x01\x17$\xea\xe9\xf9\xdb'\x8d\x14u\br\xba\xe3S\b\x98x\x8d⣛\xda\xd5\xc39\x93\xc7\xd8F\xa2Њ\x85\x84x+\x966&ʕ\x9b;\xe9\x81K\x91\xb9A\xae\x82\x8a\x88\x80v=\xfdI\x1cð፴\aV\xb6\aV\xect\x8d\xdd'\xf8\x01y~\xad\x17\"a{\x9ap1\x85\b\x11ʣ\xf9\xe3wp/r\x046(\x05H\x028\\\x8c\xb3[\xb1$f\xdd\xc1?\xc8D \x03nd\x01\xde*p/H\x01ǭ\x13\xf6\x89g2\x1d\x910[\xdfi\xeb\\\xce\xd41;\xd7\x15\xfc\u07fb{i*C\xee\x95\x04\xff\xbc\xd5\u009c\xeb\n\x11\x9em\xc3,\x19\xf6\xda.\v\x81\xa2A\xd9\xdcO\xa0o\xc4Z\x9a\xf5\xb8&I~\x12\xadƘ\xf0\x99\x02\xd5e\xe9<\x8a\x1e\xd8m\xdc\x12\xbd\xd5\rS\xfe\xc0+\xebiV\xb5\xfflX\xa3\xdb~]vv\xf3\x11\x97k\x97J\x1f\x99\f\x7f쇣\xc9^d<\x11\xa9\x1b\x14\xcc8؉\xbc\x12sI\x1fӚ\x8br\x8e\x85\xd6\xc9\r\x95\x82d\x9d\xb6'\xaf\xc7\xdcjнb\xef\x1b\xdf\n\xca;Ɓ\x8d\b\x0f\x11]\xeaX\x1a\xa09\xf5\x01\x14\x13a\xb7x\ua9dc^Diը]\xeeȥֲA|ph\x97\x00\x92\xe9\x7f\xc1\xe4\xc0\xc3\xf5\x7f\x84\xf5\x14\\\x96f\xc2N}\xef\xc2\x16\x8aϾh\xbd\x90\x00\f\xab\x02G\xe4ߵ\xbc\xe3\x19\xf4\xde\x035\xa5\x98\xc8Ю\x84\x15\xafڳ\x14yb#\r`P\x842\xc4\x17\xb7b\xf9\xe2xUv\x110_\x9c\xa9\x17\xd6h[\x93S\xc1\x1a\xd4*\xa3\xf0\xff\vDy\xb1nV\xc7\x18\xcb\xe4sAz\xa0\xbf8\x18\xa3\xdf9z\xb4\xb7\xf7\xfcŇO\xf8C\x9f\xb037\xff\xc1U\xecz\xff\x8e\x87\xa5J\xb2:\x15o\xb2\xdaT\xa2\xf4\xc9i\x1b\x84F生m~\xaau\x19\xb6p]{\xa0̧\x12\xe5\xd8$\xba\xd8\x18o\xf7\u07b7i\xae\xc2\xfc\xa2R?\xf1\a\x1a\x83\xd8\xd9\xf8\xfeԻH\x16\x98,\x1b0\xc1m^\xe9*\xbf1M\xe7\x01\xf7zW\x11\xa6_b\xc8$\xefI\xb2\xd6\x03ίˠm\x8c\x9e\xb5\"/\xf8o\xb0j\xf7\x925`\xe6\xf6\xd2v\xf2\x05\"\xd8\x16W\xc7\xd0U\xb8\x01\xf2#l\x10d\xc3I\xdej\n\xecT\x03\xbd\x88\xb6\x89\x0f\xfdB\x88L\xd6\xfc\xfe\n\xc1<\xe7\xf4\xa1\xd7:۴)\xd6\xf0\xa0\xfb=\x9b.\xffy\x91\x0f\x9d\xd4\xed\xe1\xc2\x0e\xe9>\xb4\x7fג-\x17\x15\xbf{=\xe9\xfeM\xa5\xa1O\a\xccE\xde\xd2\x03mq\x13Rl\xc0f\x95*\x95w2\xady\xd6\xe1\xc0\x16\xcd\x1a҂ߠd\xb6Im\xf2\xacy\xbeCc\xe6{\x80N\xa8t\xdb\x1dEX\xd5\xe5\x9b~g\x85\x84;\xc3Y;\";[c\x1fT\x1d\xbb\x95\xbdH\x81&wf\xfc\xdf\xec\x8co\xac\a\x95\xfa\x05\x8avZ\xdd\x0f\a\x85\xfa\x04\x80\x1e\b\xf6\xf4\v\xecl\f\xd4\xec@e\x0f\x04qv\x9es\xff\xc7S\xad\xf7\xf2w\x04bV\x03+; ٖ\xa0\xcbCA\x94ݐ*\xed\xb8\xd8{\x13\xe7\xe1@H\x874\xbb\x82\x1e\xe13\xcf\x1e\x1e@\xb2-\xc0\xb1w\xc0\xa2\x7fp\xa2\xf5\xaa\x9d\x88[\x03\x11\xfb\a\x16z\x06\x11v\xca!\xc2^\xef\xd2m\xfe\x7f\x0f\x9b\xc8\xdbE̓\xce\xfc^&t\x0f\x87\x9c\xe2|?H\xb1\x0e߷^\xbdͩ^u\x95\xb7\xbcw\xb7\x03\xfd\x90[\xbc\x05t\x93\xb3\xdc\xd3\x05ނ(Moww\xbb\x13\xbb\x05\xfb\x01\xb5\xbb\x93Kv\xfce\xb0\xba?\xda\xfe\x90'\xa3X\xfe\xd8\xc9\x1b\x1d\xbe8_yg\x879\xda\xc6qǭ\xd8\xf4J^\xceE\xb5\xe1w\xbdŌ\xfd\xe0&\xecT-\xd7pq\xba\xc8\x06Lo\xd45|V\x84R|\x87j;\xa6\xb7\xa1\\\xbe\x87\xd9\xec\b\xc3/N(\x9b\xe2K\xff\xceu*.tY\x99\x93\xdd\x04\xbdX\xfd\xfd\r\x1em\x8b(:K\x99\xf2\xbf:\xda\xd2\xfa\xc6\xd9\xc5T\x83v\x97\xf3\t\x19\t\x90\x0el\xbe\xe2\xd5&\x1e\xea|\xd3e\xe7\x97Q\xeb7\xf5Im\xaf\xc8éϽ\xa5P\xc4\xd7&\x1b\xd7\xfc\xd4;`\xbc\f \xe9\xb1O\xbcj\xbc\x86\xf0\xd8\x06H\xb7\xe1J\xdcW\x01o\x83\xa5\xb8U\t\xad|*\xbcRt\xbe\x98\xe5\xfc\x16>\xdb/\x10\xbf\xf2ئ\xcel\xb3\x13\x1e\xfeF\x1cN\xd1&^\x1a\x1eڈht\xde$\x1a\x18\a\xb6%\xad\xe4!{\x18\v&\xbcS\xbc\xf9WV\xe8r\xda~\xc2\xdb\xc6\xeb9U\xb6\xc2\xcf\xff\x1c\xf3\xfc\\\x15\xdbh\xf7\xdcM+\xeb-\x01\xdatj\x13\x88-\xb8\xac\xccd\xc4\x18\xfb\x7f掦7n\\w\x9f_\xa1\xdb\xf4\x01\x99\xbc\xcb\xc3;\xe4\xd6m\xb6\xd8\xc1\x02mЯ=\x14=(\xb6\x921:\x91g-;A\xfe\xfd\x82\x14\xa9\x8f\xb1$k&\xdd\xddޒ\xb1L\x8b\x14ER\xfc\x10\xf9\xe5Z\x99WU\xf2\x17!\xecr\xaf. \xa5\x9a\xf7p{q\\\xf3G\x15\x0f\v\xb5d$\xc0\x10\xbf5g\x9e\xb9׀\x9art&\x1a\x14\x84q\xbe\xd1ê\"i\xecBtڌJ\xbad7\xbe\xb7\xc4\xedr\xd1\xecT\xf3\xfd|\xdau3\xd7S\x15\x11\x13\x1e+Xء\xe3D#$\x88\xc7>\x03S8L\xb9\x84\xaa\xd7\xca\x1c\xa9\x80q\xa7L\xa8S\xbc\x1b!\xefv\xb7\x9f\x8f\xe1@嫃bO\xae\xb1\a\xd2n\xd3<P^\xc6b\xd2ׂI\xbc\xb8 \x8b¿\xc6\x1e-_0\x18\xad$\xac \xb3\xbeo\xd2\bu\xf8rTg\xb3\xd5B\xfdD4\x83u\xba\\\"\x94\x0f\xd0Ej4YEA\x93\x02f\x93\x83\xd2\xeb\xf5\x18\x97\xd3]\xb9Z\x04\xe8\xa2\xd3\x0f\xd0\xfaO<\xc9\x01S\xc6J\x99\x01\xe1\x1c\xee{\xb8\x7f_[ƁB\x05\x0f\x8b\n1\xa8\xfdo\x7f(\xa7\xc80@҅s\x15\x17\xc9Iw+\x11\xa8\xaa\xaa\x89\xde\xc8\x01\xba\xb2\xef\x9f\xdf&z\x1d3!\xb2\xbd?JU\x1e\x8b5\x1d\xc5\n\x8eE\xa6q\x04\xa8b\x1b\xd6YV\xad\x10\x01\xe8\x17\xabt\x98\x84\v|\x13o\xeb\x88Z\x91r\xbc\x14oP\t\xf2\xff\xd7^\tfa;en\x8d\n\xe89u\xbb\xef\xcc\x0e\xec\x84\xd77ۏj\x80[\xc8\rU\xec\xfb\x02\uf6fe\xd03\n\a\x93~\x00\x96\xf3\xa9\x84\xe6\"\xcc#\x04WH+\xae\xa5z\xe85\xfe[\xac\xf1\x05\xc7\xeb\xb8S\x1d\xb8\b\x0f\xfb\xae\x91̇H\x95\xf7h߆j\xdc!\x85/e\xc1\x82\xc1\xf5\fn\x14W\xbe\xee\xb5\xea\x05]\xc5\xf2\fݹ\x95\xb0\xc9Ѩ\x88K\xa5\xd3?\x89\x84]\xb8\xfa#\xe2U\xae\x8f\b\vW\xd2ֵӝ5\xe6A̬\xcb\xd7j,\x90\xa6\xe4a\xd9Ħ\xe5\xea\x84\x10e\xe1\fV\xa22\xd1\xe6\xe6Kb\xad\x8f\x05\x01\r,\x9fȀ\xc1\xd9ܙA\x14\x02އ\x90\x990Z\x1e̮\x1fū\xc7NR\x7f\xbb~j\x0fC\xff\b\xb1\xc6\xc4\xed\x9f/:\xae\x8dC\xb2\xd9\xf3\x11\x8a8\xeaXO\xcbcY\xefp\xcdvZ\x86\xd33\x1d;\xc9\rc\xab\x9d\x9c\xc1F\xad\xf1\x80`T\x9f\x86\xf6\x16S\xb6M\x99G\x90Z\x10X}V/\xaa\x16\xce\r\f\x1d\xccd\x8c\f\xe0Z\xac\xad.\aI\x93n&\x1cj\xc0\xcb\xd5\t|l\x9a\x9dj\xa7\xbdz\x97\xb4\x7f\"\xa2~\f\x862e'\xdd\xfd9\x85\x86\x90/C\xa2\xd13\x98\"\xe41\x17s\xf5\xf4B!\f\x95kӁ\xbfD\xe1E\x82\x9ci4\x15\x82D\xe2>\xf4\x06\b\xd8@\xe4\x86\xfa6\xddM{^\xcc\xf0Z%\\\xc6\x04H\xc6\xe1\x04\x8a\xa6e\u0086\xbe:\xbbo:\xb3\xd7M⪏#\x06Gd\xe9B\x8fF\x1e\xc6i\xa0\x00R3\r\x03\xa2l\x9f\xe1ɛ(G$Z՝\x90\xa9\x1a\xb9\xeb5\x88c3ʇ\xc3\x02\x87\xbc\x99\xbf\xe1\x8c>X\x11P\x01\x91\x1c\x87\xc3y\xae\x17ٓ4\xae \xba\xbd\f`\xa3\x89*\xba`ߨGhϪy\xf3\x10\xf4\xf9\xaa\tL\xceA\xaf\x14ܴ\xc9p\xa0~\x1d\x8f\x99\x1f\xa1å\x9b\xbaY\xe5\x1a3\xc3m\x94\x9bd\x9f\xce*ɖ܈h\x11\x9b\x05\x02\xa3\xddO\xe1ӆ\x8b\xff@N\xe3\xdb\xdc\u05cbN\xd1Op}\x80\xbb\x1dl\x06Xp\x8c\xc3\u05ca\xf7w\xe1\xeaX/\xb0l\xe0\x9aYk\xb0[SƝ\x11\x13 -'\x83Q?${\x18\x96\xaaa\xa9N\xfd\x83\x92\xa6\xd7\v\x84x\x1b\x8e\xa5 \x16N\x91R\xfa%\xae)\xb0\x9a\xd2c\xe7\x85\xe3\f*J#\xf8\xf2\xe5)\x8b\x05\x05\xfb浭\x86T\xed\xc2\\\x7f\x8b\x06\xb3\xe4\xb4}\x14}M4\x82t\xd5<3\x88\xc8|P\xaecx\xd9f+\xe5\xd6\xd1.S\xab\xc6@\x97\xa40\xa7\xb7\xd7`d\xf5\xdfI\xb9\x80\xeay\xc9*\x02(\x03\xcbSG\x17\xd2ó\x12\xf1\x98\x1c\x0e\xf9<\x17\a\xe4\xb0<\x00\x8b\xaa\xda\xd3&\x8f%\x90\vӾ\x811\xa2\x9b\v[\xa7\x01I8\xafꎊ\x1b\xf1N=%~\xb5\x94\xc1\xec\xfe\xb4\x88܈\xad\xbe\x19\xfa{ȪH<\x84:\xe3N߿퇛\xfdt\xdf\xe9\xf7,kS\x83I\xba&\x18osl3%Fd\x1e\x146\xd0\xe1hJK4?\x1a\x8e\xa5Pv\x05\xa4y\xd6\xcdn\xe8u?\x19\n\x85\xe1\x16\xa0n\xc5\xe2\xf6\x99\xd9b\xf6\t{4\x12\xb2\x01\x984\xa5\xe4\xf6ZU\x1f\xa9J\xb3\xe6X{r\xc2\xe4\x8da\xeb$\x13ͣ\t%\xe6\xed\xf0\x85\x9d\"5\x8e\xe0\x90\x1f{T\xe1\xf8mv\xe4GI\xc2\x0f\xf2\xb5\xfa\xc1\x7f\xcd+\xe2s|\xecdi\xa5\x1f\x1e\x91\xec\rYeI\xab\xc1\x13\xebI\xba\x05NͨNWWi\xecEN\x9e#Q\x83\xe7\xb5\xff\a\xc8+ç,FR\xb2eUN\xc3\xe8z}y.\n\xaa\xda\x01\x89V\bK@|\x8dt\a\xb3\x1f\x1bb\xfd \x1a\xa9\x1b\x85\x7f\xbfx\x82\xda\t\xa9\xaaY\xbes\xc3\xe7\xdae\xd2\xe0?\xe8\xef\xf0\xb2u\xa6\xb7#\xe1\xa2\xc7T\xb4\xbdVK\x8c\xd7\xe9\xf1\xff\xffˌ)\xe9 B\xf6\x13\xd8\au\x88\xe2М]QF5\xef\xbc\xef\xee\x04\xde\xf0\xfa7\xa3\xf92\xb7{p\xbd\xb3C)\x14\x0e\xb0\x18\xe7\xf3\x1b\xc7>\xaeV/\xbb\x06\xbb8\xd5\fl\xf1CPp_\xda^W!\xe1t\xd5\xf6\x9a\xd1\xd8^\xe3\xe4I\xcb\fj\x9c\x06M\xfb<\xc2\xe5\xe5s\xfc\f\x9cz\xda4\xf1\x15\x9e)p\xbac\xf4`\xf7\x83\x12\xb4{$\x03[P \x9f\x1bϟ\x8dJ\xc6|<ˈ\\$l9\xf0P0\ry\x88\xa3PvDƮs\x00H\xb6\x9fM.\xe4\xa9\xdf;\xdd\xd6\xd1\xcc\rg\xc2\xc1\xbd\xbc\xb0\xe2Ĝh\xe9\U00036a62\xa1\x10\xdbq\xcd=\xad\xc1rrox\x11r\xfb\x1c\x9a[\xe7G\x8e\xed$\xd3~\xb6,\xb6E\xb1G\x06\xe01\xda\x19\xe8b\x99\x1c\xd5\x11\xa7*\f\xd8\xf7\xcd\xf3\xbf\x1f\xfa\xe9\xb0a\x10\x11&\xd1be`[\x8fЏ\x90\x8a\xd4N\xa4\n\x89\xcfvl\xe4\xc6\xc2\x06\xed\xe1\x95K\x98S`\x89/\x0e\xe5m\xe7\xb2t\x16\x17\xe3\x9f4`ˑ\x8cL\x9c\x82\x93DQ]$\x9f{\x96O>fV85\x06\xb2\x80q>D\xc2ks\xb5*\xae9KN\x9f\x1c\xd9i\xbb\x1a\xa0\xb3\xe5-D\xa6\x82\xe3\xe1\x9a\xfd\x14i\xde\xe5\x8f^Br\xb8\xe2\xe4\xf9.\x06\x8a\xbd\x15\u0378Qww\xe0\x83\xc1\x00\xc1f\x03\xe1\xbe\xec}m`cc\xd0ղ3\\\x83GGW\x9f\x9f\x05\"\r\xd28\x06\xf4\x93]\xc0\x98\a\xf9\f\xc1\xdbN˦\x99\xc03\xf8_3ʔ\x8b{\x81\xca\xe5S\x1f\xecjC~\x90\x8ct\x8fH\xbe\r\xc7\xcf\xed\xf5 ;\x06#\x9f\xd6)\x99\xadό.\xb3\x15\x06:\a\x0e\xabs\fT4\xa6\xb7\xf9\xf8i\x84\xc3'78g\x8b\x13\x1a\x14\x90,\xdf\x1f\x05\x05\xf2\xfc*\xacY\xb3\x93\xfa\x1e\xd8g\xe8\xa7\xfb\x1d\xb3\xa0c<\x16+\x046\x03\xb4\x9d`R\xa4\x8a\x88\xa0֤\v2j\xa9\x1cŅ\xb8\xd2q\x83:\x12\x16\xf6\xb1\x8d\x96\xb5\xbf$\x8aQ\"\xb2~\xe0q\x81Ӈ&\xe41\x18:\xd5F\xc1\xb8\xe4M\x1bt,E\xbcNp\xea\x14\x85\xe7\xd9\u0088f\x9d\xe1\xae\x14\t\"\xde\xf2\\\x15b\xb5\xcc\x06 \xccѨ\xe1\x18\xa9\x17Hq\x1c\xf3Dש\x89b'\v\bŁ\x96\xb4\xb7\x87g\xe3\x94̪h\x11\\\xfeı\x9dG\xe7\xc4\xfd\xb5&\xca\xe3}\xbea\xbc\xc7\xd5jB\xbc\xc7C\xa4\xc8\xcc\f\xa2\x10\xaf\xba;[\x98\xd5\xc0\xac\xff\xf3o\xf3;\xe5\x8f-!\xff\a\rK\x04\xb98\x03m\x1e暁\x14>\xf0\xc5Z\xb1*\xccœ\xcc\xf4dd\xfd\xf4\x92\x10IR$\xce~DFn\x03\"ӗ\xe8\x17\x1f \x96M\xa3 \b\x05V\x16\xd1\x16\x8eEWܸ\xf5\xb0\x9f\x06\xb9\xa7\x7f}\xa2\xed\x95\xf8\xfam\xc5\b}Q\x83\xe9zm\xae\xc4\xd7o\xab\xbf\x06\x00\x17\x991\x8eP,\x02\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec]K\x93\xdc8r\xbe\xf3Wd\xb4\x0f\xb2#\xbaJ\x92\xf7\xe2\xa8ی\xa6\xd7۳ZI!\xb5\xe5\xc3\xc6\x1ePdV\x17\xb6I\x80\x03\x80\xfd\xb0\xc3\xffݑ \xc0G5\x1f\x00Umk\xb4\xacR\xc4L\xb3\x88$\x90/$\x12\x1f\x92\xc9f\xb3IXɿ\xa2\xd2\\\x8a\x1d\xb0\x92\xe3\xa3AA\x7f\xe9\xedݿ\xe9-\x97\xaf\xef\xdf&w\\d;xWi#\x8bϨe\xa5R\xfc\x05\x0f\\påH\n4,c\x86\xed\x12\x00&\x844\x8c.k\xfa\x13 \x95\xc2(\x99\xe7\xa86\xb7(\xb6w\xd5\x1e\xf7\x15\xcf3T\x96\xb8\x7f\xf4\xfd\x9b\xed\x1f\xb6o\x12\x80T\xa1m~\xc3\vԆ\x15\xe5\x0eD\x95\xe7\t\x80`\x05\xee@\xa7G̪\x1c\xf5\xf6\x1esTr\xcbe\xa2KL\xe9i\xb7JV\xe5\x0e\xda\x1f\xeaF\xae'\xf5(\xbe\xb8\xf6\xf6Rε\xf9s\xef\xf2{\xae\x8d\xfd\xa9\xcc+\xc5\xf2\xce\xf3\xecU\xcd\xc5m\x953\xd5^O\x00t*K\xdc\xc1\aV\xa0.Y\x8aY\x02\xe0\x06f\x1f\xbdq]\xbf\x7f[\xd3H\x8fXXf\xd1_\xb2D\xf1ӧ\xeb\xaf\x7f\xf8һ\f\x90\xa1N\x15/\x89\x17m\xf7\x80k`\xf0\xd5\x0e\x10\x94\x13\x05\x98#3\xa0\xb0T\xa8Q\x18\xba\xa3T\xb8\xf1=\xcc\x1a\x92\x00RA\x89\x8aˌ\xa7\xf03K者n\xac\x8f\xb2\xca3\xd8#\xa8Jl\x9b\x06\xa5\x92%*\xc3=\v\xeboGe:WOz\xfc\x8a\x06U\xdf\x05\x19\xe9\nj0G\xf4\x8c\xc1\xcc\xf1\x01\xe4\x01̑\xeb\xb6\xffV\xfc=\xc2@71\x01r\xffwL\xcd\x16\xbe\xa0\"2\xbeש\x14\xf7\xa8\x88\x03\xa9\xbc\x15\xfc\xbf\x1a\xda\x1a\x8c\xb4\x0f͙A'\xd7\xf6˅A%X\x0e\xf7,\xaf\xf0\x12\x98Ƞ`O\xa0\x90\x9e\x02\x95\xe8г\xb7\xe8-\xfcE*\x04.\x0er\aGcJ\xbd{\xfd\xfa\x96\x1bo*\xa9,\x8aJp\xf3\xf4\xdaj=\xdfWF*\xfd:\xc3{\xcc_k~\xbba*=r\x83\xa9\xa9\x14\xbef%\xdfخ\v\x1a\xb0\xde\x16\xd9?y\x89\xeaW\xbd\xbe\x9a'\xd2/m\x14\x17\xb7\x9d\x1f\xacBOH\x804\xbbV\x98\xbai=Ж\xd1\\\xdcZ\xee|\xbe\xfar\xd3U&\xae{D\xc1\xf1\xbdm\xa8[\x11\x10ø8\xa0\xaa\x85xP\xb2\xb04Qd\xa5\xe4\xc2\xd8?Ҝ\xa38e\xbf\xae\xf6\x057$\xf7\xdf*Ԇd\xb5\x85w\xd6\x7f\x90\x1eVe\xc6\ff[\xb8\x16\xf0\x8e\x15\x98\xbfc\x1a_\\\x00\xc4i\xbd!Ɔ\x89\xa0\xeb\xfa\xda\x0fQ\xd99\xaeu~\xf0njD^\xdeƿ\x94\x98\xf6L\x86\xda\xf1\x03O\xada\xc0A\xaa\xd6\x05t\xbc\x10\xc0\xb4\xd5z\xd7C\xb7\x9f^\x1f\xe9I\xad<\xef\x94\x14\x80\x8f\xe4]Zk&\xddy8\xa2 \vS\x95\xa0~>\xa3\t\xce\xc5l\x93\x93\xcbcܤ\xaf\xc1\xa2$s\x9d\xe9⍻\x8d\xbaH*\x965\xd3\x11\xf9\n\xba\xe2ݛt^\r\x9e9\x15\xfaGw\x96J\xde\xf3\f\xb3anNs\x94\xbe\x19\x1eX\x95\x9b\xaf2\xaf\n\xd47\xf23j\xc3O$=8\x88_\x06\x1bzy\xa3\x86\x87#\x9a#*2N\xfb\x83\xf5w\x83t\x81FYi\xcch\xc0\x86\xdd!0\xd8\xd7\x1c ߙ\xe7P\xca\f\xee\xeb.\xc2\xfe\xc9w\xfa\xb9lZ\xf9\xec\xa5̑\rq\r\x1fӼ\xca0k\xa6<\x1d0ګg\x8dlp\xc0\xb8 -\xa3\xa9\x98D'\x9a_\a)\x92Ę\x01\xa6\x10\xc8QpQ\xd3\x04nU\x10\xf6#\nG\xff\xb8\xc1b\xa4\x9f\x93\x1aY\xff\xa3 \x84\xeds܁Q\x15&\xe34\x98R\xeci\x82g>\x80\x8aaY\xd3ƹ\xf3\x9c\xa7H\xccj\x9c\xb6\xe5\x9ae\xcd Q\xf8=2\xec(\xe5]\b\x93\xfeD\xf7\xb5\x93\x13\xa46N\x85=\x1e\xd9=\x97J\x9fF8\xf8\x88iezaQ\xf7\xcb\fd\xfcp@\x85\xc2@yd\x1a\xb5w)S̚v\x11\xf4\xad[\x7f\x92ڌ\xddq2\xb0\x9f\x9b\x06\xc0\xbb&b\x19\xd3\f\x03\xa4H\xf1r\x94\"\xc59 U\x86\xea\x12\xd8\xc1\xa0\xb2\xce\xc0ڂU\n\xea\x15fP\x956\xfe1G\xe4ʻ\x89\t\x9a\xd4R\vV\xea\xa34v\x96\xbe9\xe2\xd3+\xd52\x17\xf0\x1e\x05\xf0.\xdf\xe0\xc0x>I\xd4v\x8fb\x82R\xa1\x1b\xe5\x03v\x88\x0es~VUG\x18\xfb\x9f<Cҝf\xaee\xf6\x99\xed\x10\x88\xb1\x13$ɨd%2`\xf0p\x94y\xa3\x1ep\xf5\xc8R\x93?\x81\x14\xd6H\xaf\x1e1\xb5\xcc\xfdU\ue868\x9eš\xfdﾙ\xef\xa7\xc6\x1b\xa2o\xde\xed\x9c\x06\x1d3̱\xddu< \xad\xa3\x98\x8az\xcf\x05 K\x8f\xb4@\x10c6\xdf\xfd\xd0|\xa31ǔX\xb9\x7f\xb2\x8a@\xfc\x9d\x1aT\xa0߈\xe7\x02}\xdd@\xe6o<a\xc8;\xcf\x00\xf2$\xd8\xf0\x83x\xc2\xd4mUВ+\x80&\xd0\xcc\xec\xf8:ǃ \x95\x8e\xf0\xc5\xfdo\xc1\xc55\xd9\xff\x0e\xde\x06\xdc=\xed\xa4\xfb\x1f7\x9f\xa3Z\xc0dײess\xa1\x9e\xdaK\x99%\x93\xf4\xdc\xf7\xe1H.\xa3+\xa9\xe7\xae\x7f\v\xd7\a;\x1d6\xa6v\x99\xcc\x12\xf6Ѣ\xcc^i8p\xa5M\xb7\x93\xdaF_\xdb\xe4\xcc\xd2\xca\xd9\x1e\xf3/\u058cd<W\xdfw[_\x92;n\a\xec\x8c3Pu\xeb\x81\xf7-\x80놡\xc0\xc5\x16>R\xac\xfa\xc0\xf5\x9c\xd1z\xfd~\xd5kO3\x86z\xf2\ue15e\xe6%߄\x84!܍r\x1f\xb1.\x84\xbe\x053\xe9\xf1\xaaY\x0e\x05\xb6:\x11\xcc)\x91\xfe\x04o\x85\x1eH\x16\x9c\x1c%\xad\x12~\xab\xb8B됶ps\xc4\xde\x15\x9a\xed\x83i\xfe\xf4\xe1\x970e\x8e\xf4T\xcf\x18\xf1S=\xd8\xc1A\x04S\x04\x17\x16{\x1a6\xe0s\xb6\xa9묇\xbe\x04\x06w\xf8\x14f\xe7nz'\x0f/\x80ԃ5d\x15\xd2\xea\xb46\x84;|\xa2\x89=\x82\xa4\xcb#\x05\xb7\x88UN\x97\x18§\x98\xdbODB\xa3rN\xb8\x96\r]\x98XZ\x8c}\x89C\x8dXYY\xe6\x9c\xf2\x19r\x9bD\x11\x89\x9b\xda\xfc\xc7\xcb\xec\x1b\xd8Ј\xbdM{\xd5*\xf4J'\x114\x01j\x95!+?\xf2\x92\x82\x00\xd2Tk\xe7>\xab\xf8\x95\xe5<F\x8b\xba#\xb4v\r\xd7\xe2\x12>HC\xff\xb9z\xe4\x94M\x8b\xd3K\xfa\xfe\"Q\x7f\x90ƶ\xff?\x11R=\xfco\x10QM\xc0\x1a\xbf\xa8#\x14\xe2jt?:\x86Iq\x01\xe9m#|\xae)\x01)\x95\xe3n$U\"\xe5:Yw\x8f\xc2\x7f\nD\x84\x14\x1b,J\xf3\x14\xc7h\x18\xea\x9f\x13\xb8T=\t\x9e\xad\xabu7\xe1\xe6yZx\xeeS\x0f\xb9N\xed\xe7\xb4/\x02YE\xa2\xa9\x13\xd2\xcc\xe0-O#I\x16\xa8n\x11J\x9a=\xe38\x179G}\x93^\xc7\xc5\xcc\xfe\xe3&\xbe\x93\x8c\xfe\xd4wC\xde(\xe2n\xaf4\xc1MF\xf2\xd8\xe7\x1c\xb9\r\x84l\x98\x1a,\x1d\x96evߑ\xe5\x9f\x16̎\vd\xda\xf39\x9d\x0e\x93\xf11(\x98M\xb1\xfe7\x05\x17ր\xfe'\xb8/%\xe3Jo\xe1'\xbb\xad\x98c\x97\x86\x8f};\x8f\v&K=\xa2\xd8\xfc\xb7\x8a߳\x9c\xd2X4\xe9\b\xc0܆U\xd4\xdb\xd3\xf83\xdc[<\x1c\xa5\xae#\x9f\x03\xc7\xdc.\x02.\xee\xf0\xe9\xe2\xf2\xd4/\x05S\xbc\xb8\x16\x17\x97>\xfb\xd4\xf7AM\f'E\xfe\x04\x17\xf6\xb7\x8bp\xc3\x1f\n\x81\xe3B\xdbH\v\x88\xba\xbdY\xd6\xec\x92H\x1dl2\xe8>NkH\xf9Le)\xb30\x01L\xac\xe7\x923\x1b\x93\x14WJ-X\xc4~\xac\xdb5KW\rG\xf9\xd0l\x80Mm\x89\xf4?6!\x8c\xb4\b\xe6\x06P\xa4\xb2\xa2\r`\x1b;\xa0}@\xbd\x18\xa5\tj`\x0ft\xf8\x1b\x92Т/\x8a\xaa\b\x19\xf8\xc6&B\xb8\bZ\xb9n\xe0\x8f\x8c\xe7\xe7\x16\x93B\xa3\x02=jOL\x9f\xebv\x8dJV\xc5\x1e\x95\xd5GBr8y\x05\x10mz\xd0\xd7M+5\x9bA\xde\xfa}3ZM\xc0\x9b\x10\xf6\x17\\\xf0\xa2*v\xf0&\xe0\xe6\x9a[\x84\x0e\xb8\xc5\xf9\xb9\x92:\xfbD\x99zy8,\xe2\x99oL\x8c#\xc5Υ\xb8\xf5\xda\xfd\xc0x`nq\x8f\a\xe9\xd2^uj\xca\xf6\x8b\xd8\xcfl\xde\x1d3\xcf\xca-\\\x87\xb82\x80LV\xfb\x9c\xc2A\x9b\x96\xafs\xbfD\xb4\xcf\xff\xb7z{n\r4\xbc@Y\x99\xdd\xec\x8d'\xdc$Ȑ\xacLo\xef\xbc`\x8f$y`\x05\x99{\x00E\xf0*\xebeಇ$\n\xbb\xf7\xee\xf3\xd84\xf8T\x16e\x8e\x06cD\x94J\xa1y\x86ʣ/\x9cב\xc2I\xaaRxf\x8e\x86F\x96\x1b\xaf\"\xb3\xf75\xf3Mr\xa6\xf9\xf0\xefr\xbfK\"DM[)\x16)F\xfai\xffr\xf1\x92\xdb\x0fM\xf3J\x9b\x00\xeb\xa5i\x8f$\xab\xadh\xb9\xe9\nu\x9b\x9c1\xd3\x18\x93\xc8i\xb8\x1bm\x01\x13\x81\x01\xa9\xed\xafr\x1f@\xd1f\xd3j\xe6\xda8\xa0g\xeeC\xd1F\x18M\x83E\xb3\a\xd4\v5\x0eRm\xe1\xb3\xd3Q+\x87\xbdݟ\xdb<\xf0,\x8c6\x91\xd4?p\xbc\xe2dg݃\xfe\xc1\u008dq\xbc\xcf\f\x9bO\x11@{ZҼ\xbe\x7fK\xde\xc0\xffFP\xaa\x00\xba\xd0p\xb8\xa3\xf9\x04e\xab\xb3\xe8\xbf\xca\xfd+mM\x89\x9eu\x8b\x82\x96\xd1ak\x88`\aX\xff{\xdc\x10>V\t4\xa876\xeb\xa8\xeeqS\x89;!\x1f\xc4\xc6.\xb8t\xe0\xc6\xc6\xf7?\x89\x12\xbf\xcf4\x87\x92\x03\xe8L\x9f\x8d\xc7\n\xa2i$\xbc}\x03\x05\x17\xb4\xe1\xbd=\xaf~\x87O\xbd\xde\x0e\x923)\x14\xa9\xeb.\x89\x10\xfc\aV\xf4\xa6\x8d\x06\x98\x1b\xb2\xc6\tdI\b;j\xb4t\xf2\x8d,\b\x9c\x9c\xe7\xd3U\x0e\xb5\xa3&\x989\x04\xdaQ\xd8\xdf\xd2\x1b\xc2\xec\x00\x1f\xc2\xd6\xf9\x8f\xc3\xec8\x8dg⩞B\xb9n!;\xdbdq\xbas\xc5ìx\x98\x15\x0f\xb3\xe2aV<̊\x87Y\xf10+\x1ef\xc5ìx\x98\x15\x0f\xb3\xe2aV<̊\x87Y\xf10+\x1ef\xc5ìx\x98\x15\x0f\xb3\xe2aV<̊\x87Y\xf10+\x1ef\xc5ìx\x98\x15\x0f\xb3\xe2aV<̊\x87Y\xf10+\x1ef\xc5\xc3\xfc\x83\xe2a|ɡ\x89y\xbb\xc7ƶt\x11k\x8a\xba\x8c\x14\xe4\xa1rWS\x88\x18\u0093\x90\x86W%p\x91\xf1{\x9eU,\a.\xb4a\x82\x1e`\xc1\xee\xbe\x7f\xdbdq\xea\xb3\xd7\x7fZ\xcaT\xa5\x1f\x05Ջ\xe9\xd5g\xb3\x98\x16\x05\x85\x9c\xd9L|Nf\x9c\r{Fu\xbc\xe4XQ\xb5\xf6\xa3\xa8\x0e\xa6\xebJf\xfdH\x13\x8a\xe8˦8\x14m\xf5\x89\xecd_q\x9b|{l\x16Z\x05l\x84\xb3\x03\xf5\xc0\xda\x10\xa1\x17W\xcd\xfb-#\xe1\xe1\xc8\xd3ck\xa16܀L\xa2\xb6\xc0\x06\xdao\x9b\xdd\\\bL\x8aG\xf8\xbb\xa8\x9884W\x1cXIl\x86\xedM\xebN`F\\o\xd4fez\x97\xe9\\\x9cjk\x14ׯ\x9f5?\xbf\xb2\xbb=e\xbbigw\xa9.i\x05箆P\xa5\xca`m?~0\xc1-\xb3\x96\xeb\xd3\xd6g\xb7\x96\xb3H\xad\xe9\xc6\x0f\"\xb4(\x98W8\xc4\xeb\xc0s\x9b\xe2\x0eY\xa47,\x9d\x95\xdc9\x19\x14\x93\x179ݴ\x9aoq«s\xa1\xae\x1aT\xcb<\xe2*|\xbb)PS\xa3\x10Tv\x80I$\x8cl\n=\xe50Q\x81$g\x91S\x8ez\b{\xe2Te\x01\x0e*\f\x03\x15\x9c\xef:a\xea\x12\xfcS\x84S:\xe5\xf8\xc2a7\x02{^\x03\xa8\x87b\n\xa6\x0e\xe3x\xa7\xa6\xafq\xc8D\x18\xc6:\xf5\xb0//\xca\xe2X\xd4R\x8f\xc1gB,\x9d\x1f\xad\x14\x80TrO\x8b \x1a\x80R\x8a\xa48\x87Pr\xbfD\xa0\x0f`\n\x9d\xb4\fo\x14\xe1\xc9\x17kaxh\xe1?!\xb9\x97%آH\\Qp\x02+~\x94\x1d\xac\xcc.yI\x1cQ\xa4\xbcz\x1e\xe0\\\xf8\xa1\x17\xc0\x0e\xbd\x18n(\x183Tc\x81\x82hF\xe0\x85\b\a\x14c\"\v\x82\xb7\b\xad\xfe}gp\xdd9\xbd\xa8~}\xa4\x93}\xbec\xa5ԝ\xd7\x16t\xd7\x173$\xa1\xd9\xe2\xc5\xdf*\x14)\x0e\x1c3t\x1e|\xaevy\xf7\xf3\x89N\xb5\xd4\nZ\x13\xb3\x87\xd0\x19\xe4\xf2\x81ʹخ\xf7\xea\x86_&\x81\xaa\xc9\xd5I\xf7.\xfd\xf6Ey\xf2\xccY\x8a\xaeOG~{\xf4\x9dr\xa1\xb8;\x8e\xd40\xb1\xd6[\xff\xc4Y\xc2\\P\xbe@!#\x04b=\xdaHxM0\xb4&\x1cVSN\x96\x8c\x1fP0*\x19_\xa7\x98{\v\xba\x81\x1ct\x12~F\xcf\xe1^\xb4\x91\x8dN\xd1\xc4\xee\xb5\xd0\x1f\xf6\xbc9b\xc0\x81\xa7\xaeP\x1caJ>]\xb4sP\x9d\x17\xbc\xb0ێ\xf6\xff\xe7i\xa6Բ\x16x\xa9d\x8a:\xe0\x00Z`l\xd2c\xefs>\x9e\x1e\x99=\x04M\xfe\x9d͊\xa13\xb2\x97𧛛O\xe1'e\xfd\x8e`\x9b\xf0\xd8&\xe7]C\x86\x9c\x9d\x1d\xe0\x17\r\xa6\xe5\x10\xbdK\x06\xd3 \x1f\xbc\xa4\x8f\x91'[_\xf2|\xeb\xa9\x05}o\x11sܹ\xd7\xf8\xf8s\xc1\x19\xd8AqL\x9c\x84\r&\xd9\x1c\xd9\f;\x0f\x1bA\xf7\xd9\xc9\xd9\xf1S\xb1\x11T#\xce\xcf.ր\b@O$\xac'\x98\"\xb4̟\x06#GP\xecÖ#\x1cM\fRh\x01^(\x125\xb4X\xac\x11\x80\xe5h\xd8r0M\xf0H\x979\xf0r\x04Ũ8lAD\xb6$6[\x0e|\x1e\xe1\xfd\x04\xfc9\x98(8\f\xe7<\b:\x82\xa4\x13\x1e\xc1\xa5\x03\xa0\xd0\x11\x84\x83AӋ-\"\x02\xfb5 \x953\xc1\xa8\x83q`\xe4\xb0\"(v c\xb3\x90\xea\b\xb2Q\xe0녒\x89M\xc19\x15\f\xba;\"\x03A\xff\xe8m\x9e\xbb$Z7l\x84\xde\tm\xed\xdf/\x19\xda\xe2ciߨ\xf4\xc50S-\xf5\xf1W=\"\xde\xd5۾k{)\x98,\x85w\x99\xcd<0\xd0UJk\xaeC\x95Ӻ\xa6\x94B\xc7\"\x0f\x9d\xe4$\xfc\xeb\x9b7\xdb\x17u\xd6\x05\x9a\xa3\\\xba@\xf8\x8bm\xdcc[M\x0f\xe4!\x98\"8\xf8\x80}\xf9i\xcb'0\x12\xfe\xfd\xea\xe6\x05\x8d.\x12O?0\xfe\x06\xc3\xe2Y\xd0\x10\x8cg\x00\xbdG\x96\xa78\r\xaa\x8f\xa49\r\xad\x7fI\xce~\x9f\x11uGѬS\xb7g\xe3C\x0fRyO]\x1b4\x1c\x99\xf5s\x95\xf0\x8e\xc8y\x8c\x7f\xa8\b\xbbd\xe6\xb8PƟ\x989z\xb3!2 {\xf2Y\x1a\x0e\xbf\u07be\xe8x\xa5Z\x1a<}\x92\xcat\xdd\x04\xa9^\xb3\xa6X\xe6+lwzJ\xcd5P=\x92\xc8b*\xc3\vw\xf7\x90f\xf1N\x0f\xfb\xce\xd6\xed\xf4\x16\xe7\xf9\xad\x90\x11\x81\xd0[\xa6\xdbm\x91\x9aԙ\x94\x90b\xa8\x97\xf3\x03D=\xf2v\xfd\xa2R\xa8\x15e\xa9\x18\x9c.\xf7L\xe3\xd0\xd5\xc0e\x91\xc4\"[\xf8\xe1\x97T~\xfa\x8a\xa0\xfa\x12'k\x1c\xeb$\xfc\xe1\rhL\xa5\xc8\xf4w\xb4\xbar\n\xfd\x12\xab\xab\x80\xe3\xac\x03ZB\xf5A\x9b\xb5\x15\x1d\x89}\xd1M\x83&\xe0\\\xa8\xd3\x13Qq\x1c\xa6\xe7\xe4\xdc\xdd\xe4\x89\xd3\b\xb2\xf2\x10\x10\x1c7\xe7N#\b\x9f\x9cP\r?}\xfa\x03\x86\xd9Q\xa7R\x7f\x98x8\xe6\xcc\xeaK\x9f\\u=:\xe2\xa9\x1d\r\x9d_\x8d\xa0\x18{\xd2u\x91\x9f<\xfb\xa9\xd7\xdf\xe3dM6db,q.\xfdy2gGP\xee\xfaݸ3\xb1\vm)v\xce\x0e<%\xbb@\x19#n\x0e\xdd\x13.\xa7\x8a\xa8\x0f(\xde'\x85\xe7G\xb1\x94\x8aSP(\xe7\x80,\xb34-Х\x87.\xf2\xfaG\xb5\xdaG\x90,\xb3T\xe9\xde\x15ɲ\"YV$ˊdY\x91,+\x92eE\xb2\xacH\x96\x15ɲ\"YV$ˊdY\x91,+\x92eE\xb2\xacH\x96\x15ɲ\"YV$ˊdY\x91,+\x92eE\xb2\xacH\x96\x15ɲ\"YV$ˊdY\x91,+\x92eE\xb2\xacH\x96\x15\xc9\xf2\xb2H\x96\xdf]\xd5\xf6\x99g\xb9\n\xb9\xef\xeaw\xc4x4\xc8H\xb81T\x1d\xf7\xb4egBz8\xa2\xa1\x1a=\xee\x054\x1b\x9d\xcartN\xf6 \x12\xddNIM\xf9^kT\xde\x1el\xe1\xc5\x10\xc0N\x00\x03k\xe6\xec\xa5̑\x89q\xee\xcc\x16~\x9e+\xf7lK\xf1蜖J\xf2\xd0\t\xa9\xec\xff\rR\xb4;\"\xee\xf1Nz\xda9\xff\xb6Vp\xbff\xb3\x85\f\xf9\x1eo\x93hDƬ\x99\a3tL\x1b}\xe7\x16\xa8Y\xa7\bs\x9f\x99^oj\xae\x8e\xbb\\\xf7\xec\x13\xc5\xe9\x14^\xee\x95R\xfe\xfey\x19P%y\xbc62\xc5\x01\x8c\xd2\xfd\xec\xfe\xed\xb6\xff\x8b\x91\xaeR\xf2 I\x80\an\x8eTGE\xd8w\x8f\x8a\xdb\xee\xeb\x18\xbc\x9e\x1a9\xc8\xe3\x11\x8at*\x8c\xe7\xb56{\n=\xf6\xc3G;\x06\x96o\x97\xb2r~\x1duZ\xcco\xec\xbe\x13\xae\x9e6\xeb\xc3\x15\xfbň\xe7g\x95o\xa8\x9d<\xa9\x8d\xf1u\x92C:\r!Ց\x87\xeb\x1e\xcfP\x8d\xa9\x89\x1c\xbaD\x0e\xa8\x7f\x1c^\xf58\x8c=\xf4\r\xafu<\xeb2\xfc\xd7s4j8\x8d\x18\xbe\xb5\x9aq`\r\xe3Ne\xe2Y\x92\v+\x17\a3,\xacJq\x8f]S\xb5\x89\x9ba_ϧ\xfe\xa7*\x12\x0f\xd7\x19\x9e%9T\x878\xa4\xbapP_\x83k\n7\x95\x82g\xc9~[%\xe1Y\xbf\x16\xa9\vsӪ\xff\x84\xc5\xf9\xd3u\x81\x83\xaa\x01\a\xad\x05\xe6\xfbܩo;\xde\xe5\xd8*\xbfA\\\xed\xd9M\xa7\x1bc\x15}\x9bj\xbd\x13\x0f\x0e\xaa\xe3\xfb\xfc\xdd\xde\x13\x14\xe7\xab\xf7\x8eW\xe6M\xc2\xed;\xf4\xfd\xdd\x13$\xbb\x95z\xa3ÀYm\x9a\xb9\x81B\u008c\x19\xb6K\x96͵\xf9\xff\x87\x06~\xeb\xa0m\xa9\xd7\xd9UIL\xd7g\xbb\xdd3\x9a\x8f'\xcf\xef,\xa1\xdb0ڕ\xdf\xed\xacxƢ(ټ\xf6$\x85?sz\xc91\xa5\v\xc9X:1\r\xfd`\x97Lm\x985\xfe\x9e\xfa6\xa2=Ymi,\x99\xcd%\u009e^P_\x14Lo\xe1\xaa.\tV\xdf8B\xd1>\x99P\x18\a\xa9\nf\xe0\xa2Yƾ\xf6-\xe9\xca\xc5\x16\xe0\x8f\xb2\xc9 4TGkmk^\x94\xf9\x13m^\xc3E\x9f\xd0ҥÌ\xee\xd04\xc8S\x1b>\xdd0u\x8bF\xef\xe6\x05\xfe\xf9Y\xa3\xfe\xba\x81z\xac\xdb\xf3N_\x8cT\xec\x16\xdf˺ɘ\x94:\xbaҦPRYr\xcc(\x99 \xa9L47M\x9eQ_\x92O\xf5Z=\xbep&\xb2\x9dQ\x82q=\x96\x04\x7f\xd6\xf6(\x15\xbbE\xc8]\xef\xb6I\xf44>k-\xc1b\x1a\x9b!\xb5`\xa5>J\xf3U\xe6U\x81!\"\xfa\xd2o1\x90բE.\xbbCHsYe\xcd\x13ƄC\xd8B\xf1\x04\x9f\xbe\xdax\xfb\x80\nE\x8aY[\xc6\xdb\xceI~\xf5\xebW\xbe\xee\xe7\x11\x92?\xbfl\xeeK\xf7\xb5.\x84g\xfd\x16n!i\xf7\xe3\xfd\xec\xe7\x93\xd9\x0e)0H\x93\xfc͠\xe2w\x0e\xbd<\xd3s\xea\xed\xd8\xc48\xa3_\xc6\xe4\x01\x83\xbb\xb9y_\x0f\x88v鷿T\xcaviS2\xa5\x918\xed\aZ7\xda\x0f?\x8a\xbe\r\x1c\x9e\xf8\xf0\xf3\xe98\x14\x12\x9b\xa6@~3\xa3\xb9\xb7\n\xeb\xd5׳.D\xe5\xbf\x0e\xb7츦\x8e\x10\xa72\x97\xf20J\x8bi-Sng\f\x9bH\xb2UZ]\x9e\xe8\x05\x1cǔW\x98p\xec\x95Ə\x0f\x82\xd2\xe1\xceP\xf5\xb5\xa8%\xb5K&Y\xf8\x1f\xcf\x1az\x01\x0f\xb9\x0f\x9a\xa5Nn\x7fF\x9eИΫ\xb7\x9b\x80{\xcaDpmQPY\x95\x0fl\x06\xcd\xd8\xff\xb8\xed\x0f\xaf{6\x16bE\x8fJ\x02vnF8\xab\a\xc0\xde=\xee\xf9\xe18@w\xcaJS)\x17\x04\xa5\x95R\x14\xb9\x13\x11\x87\xd4\xf6\x9boC=\x1b\x8fTs\xa6M\x90,\xdf77\xb6y m\xea}?\xef\xa0\xe0\x81iP\x95p\xbb~\x83\x01\x94\x1f\xd5pG\x1d\xf4\xa0`f\a\x193\xb8!\xfa\xcb\xc49h\a\xe5\x91i\x9c\x19\xe9'\xba\ax\x9fѶa\x03\xe6\x1a\xeb\xfa\xf0\xb6\xff\x06>\xe0\xc3\xc0\xd5+A:\xf9|\a\xa8\xae\x8b\x8c\x99\xcd#\xb1\xc1#\x1e\x13C\xbcoZY\u0c5e\x19m\xfb\x90\xfa\xf6\x93\xfd\x04\xcaB\xb7\x14\xebc{Cb\xfdg~\xa8_\x02\x98Ҙ\xfe%\tv\\\x13#\x19wX\x83&\xf5\xec\xa2\xddb\xcf:J\xe2\xe6pw\xa55@\x96\xa6X\x1a\xb7EE\x17\x00\xee\xb8\xc8vpqa\xff(\xf3J\xb1\xdc\xfd\x99JQǈz\a\x7f\xfd[\x026\xe4\xc3\xec+*ͥ\xd0;\xf8\xebߒ\xff\x1d\x00\xde\xeekvO\xcc\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x13\xbe\xebW\f\xf2\x1ery\xadM\x90K\xa1[\xb1MѠi\xb0\xd8\rr\tr\xa0\xa9\x91\xc5.E\xaa3C\xa7ۢ\xff\xbd\x18JZ˶\x94u\x16\xa8\xe5\x8b\xc4\xf9|\xe6\x99!Yl6\x9b\xc2\xf4\xee\x13\x12\xbb\x18*0\xbd\xc3?\x05\x83\xbeqy\xff\x03\x97.^\xed_\x17\xf7.\xd4\x15\\'\x96\xd8\xdd\"\xc7D\x16\x7f\xc2\xc6\x05'.\x86\xa2C1\xb5\x11S\x15\x00&\x84(F?\xb3\xbe\x02\xd8\x18\x84\xa2\xf7H\x9b\x1d\x86\xf2>mq\x9b\x9c\xaf\x91\xb2\xf1\xc9\xf5\xfeU\xf9\xa6|U\x00X¬\xfe\xd1u\xc8b\xba\xbe\x82\x90\xbc/\x00\x82\xe9\xb0\x02F\xda#\xb1\x18IL\xf8GB\x16.\xf7\xe8\x91b\xe9b\xc1=Zu\xbc\xa3\x98\xfa\n\x0e\v\x83\xfe\x18Ԑ\xd0]6u\x97M\xdd\x0e\xa6\xf2\xaaw,\xbf\xaeI\xbcw\xa3T\xef\x13\x19\xbf\x1cP\x16\xe06\x92|88\xdd\x003\r+.\xec\x927\xb4\xa8\\\x00\xb0\x8d=V\x90u{c\xb1.\x004\xe9\t\xd5͈\xc5\xfe\xf5`ζ\xd8e\xf4\xf5-\xf6\x18~\xbcy\xf7\xe9\xcd\xdd\xd1g\x80\x1aْ\xeb\x15\xdc\xc5\xcc\xc01\x18\x18\xa3\x00\x89`\xacEf\xb0\x89\b\x83\xc0\x10%\xb8\xd0D\xear\x8d\x1eM\x03\x98mL\x02\xd2\"|ʐ\x8f\x99\x95\x8f\"=\xc5\x1eI܄ƨv`\xdf\xec\xebI\xac/5\x9d!}\xa8\x95v\xc8\xd9\xd3\b\t\xd6#\x02\x10\x1b\x90\xd61\x10\xf6\x84\x8cAN\xa3\xd4\x7fl\xc0\x04\x88\xdb\xdf\xd1J9\xe2\xc0\xc0mL\xbeV\xb6\xee\x91\x04\bm\xdc\x05\xf7ףmV@ԩ72\xf1\xe4\xf0sA\x90\x82\xf1\xb07>\xe1\xff\xc1\x84\x1a:\xf3\x00\x84\xea\x05R\x98\xd9\xcb\"\\\xc2o\x910\x83YA+\xd2suu\xb5s2u\x9d\x8d]\x97\x82\x93\x87\xab\xdc@n\x9b$\x12_ոG\x7f\xc5n\xb71d['h%\x11^\x99\xdemr\xe8A\x13沫\xffGc\x9f\xf2ˣX\xe5A\x99\xc5B.\xecf\v\xb9!\xbeQ\x01m\x87\x81\x1f\x83\xea\x90\xe8\x01h\x17v\xb9$\xb7o\xef>\xc2\xe4:\x17\xe3\xc8(\x8c\xb8\x1f\x14\xf9P\x02\x05̅\x06)\xebAC\xb1\xcb61\xd4}ta`\x97\xf5\x0e\xc3)\xfc\x9c\xb6\x9d\x13\x9e\xb8\xab\xb5*\xe1:\x8f\"\xd8\"\xa4\xbe6\x82u\t\xef\x02\\\x9b\x0e\xfd\xb5a\xfc\xcf\v\xa0H\xf3F\x81\xbd\xac\x04\xf3)z\xf8\xa9\x95jDm\xb60\x8d\xb9\x95z-t\xf7]\x8fV+\xa8 \xaa\xb6k\x9c\xcd\xed\x01M$0K*\xe5E\x91d\x8d\xef\x8ce\x9c$C4'\xf3%6\x97D\xb3<N\xf4\xe9[\xc3x\xfa\xf1$\xa6\x1b\x959\xf5\xef]\x83\xf6\xc1z\x1cL\f\xd3\x04\x9f\x0eE\x1f\f\xa9;\xf7\xb9\x81\x0f\xf8u\xe1\xeb\rE\x9d\xacy\xae\x03\\\xc0\x8dq\xbfٹiW]\xcfl\x90\xca{\xd8|T\xcf\x06\xf4h\b(\x85\xa0}{6!\xf5\x7f6\xc9\xcfd\x9c`\xb7\x10\xcdb<\xefB\x13u\xb6\x8aQ\xc7F\x86~±أ\x9f!\xae\x05\x83\xeb\xb5\x1e\x1ekz\xb3uޭK\x9c\x04u=S\xc8H\rD\x88y\xd9xh\xd0\xe8X\xe5\x19\\+fA'Y$\xc1\x1a\xa45\x02N\x80S\xdfG\x12>'\xc9\x13\xb8=ɀ\xe9\xd1\xf3\x90\xd9z\xac@(\xe1\x8a\xd0`\xc7\x10\x99\x87E\x89\xf3\x89\xff\x1d1x\xc3\xf2\x96(\xd2Ep\xbf\x9f\xa4\xa7\x8e#4\x1c\xc3\fݗ\f\xfd\xd0\x13\xf0\xd5p6\xaf\xbb\x88\x18Ev\xc5\x05@$h\x8c\xf3X\x97\xcf\xcd#\x1f\xa3\x9e\xab<\x06x\x19\xe5nG\xe1\t\x82\x90\xba-\x92\xf2_\\wĴ\x13,\x9e\x86\xc14\x82\xa4̳d\xb8\xc5\xfa\x80\v\x18h\xd1xi\xc1\xb6h\xef\xbf\r\x93\x9eav\v}\xbe6\xe4W\x12=\x9e\xed\xa3\xfb\xd8,&\xb8\x16\xd0\xf24\x9d\xa6\xe7/\xd9\xe62\xabu}\x84z\xadl*\xf2s\xa6\xcd\xf3\n\xaf\x87\fG\xb8\xd8<\x9b\xdcV\x8b\vJ\xb5\x85\x85\x95]\xf5\xa2F_o\xf1\x11_\xac\x0f\xb7\xa8\xe2\x9bU\xbb9S\xd0\n~m1\xac\xed\x81J\xce3\x9b3ϰ}XS\xbd~\xbc\x12\x9e\x13`\xb8[T\xa0'\xb6\x8d\xb6\xc6\xf3@Y\xac\xdep%Y\xbco\x9c\xd3x.;\xb1\xf9hC\x9cnd\xe5\xe5!,\x16\xfb\xecc\x0e\xb3\x9e\xa5\xc7\x12\xc9\xec\xe6\ts\xda>\x9e\xef\xab\xe2\xa8G\xe1\xef\x7f\x8aC\xbb\xea\x15\xae\x17\xacg\xd7Peh\x05/^\x1c]b\U000eb361\xce7z\xae\xe0\xf3\x17\xbd\x87J$\xacG\x10\xb8\x82\xcf_\x8a\x7f\a\x00\xa5\xe0\x93O4\x10\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VO\x8f\x1b\xb7\x0f\xbdϧ \xf2;\xec%\x1e'\xc8凹\x05\x9b\x1e\x82\xa6\xc1\"N\xf7\x12\xe4 K\x9c\x19v5\x92*RN\xb7\x9f\xbe\x90F\xe3\x7fko\x12\xa0\xf5\xf8\"\x89z\"\xdf#)5\xabժQ\x81\xee12yׁ\n\x84\x7f\t\xba<\xe2\xf6\xe1\xffܒ_\xef^7\x0f\xe4L\a\xb7\x89\xc5O\x9f\x90}\x8a\x1a\xdfaO\x8e\x84\xbck&\x14e\x94\xa8\xae\x01P\xceyQy\x9a\xf3\x10@{'\xd1[\x8bq5\xa0k\x1f\xd2\x16\xb7\x89\xac\xc1X\xc0\x97\xa3w\xaf\xda7\xed\xab\x06@G,\xdb?ӄ,j\n\x1d\xb8dm\x03\xe0Ԅ\x1d\xec\xbcM\x13\xb2S\x81G/\xd6\xebb\xcd\xed\x0e-Fߒo8\xa0\xceg\x0fѧ\xd0\xc1aa\x86\xa8~\xcd1\xdd\x17\xb4ME\xfbPъ\x81%\x96_\x9f1\xfa@,\xc50\xd8\x14\x95\xbd\xeaY\xb1arC\xb2*^\xb3j\x00X\xfb\x80\x1d|T\x13rP\x1aM\x03P\xe9).\xaf\x16\x02^ψzĩP\x9eG>\xa0{{\xf7\xfe\xfe\xcd\xe6d\x1a\xc0 \xebH!\x9fq-\x10 \x06\x05\x8b'\xf0mĈp_X\x03\x16\x1f\x91\xab\xd3{P\x80\xc5\x7fn\xf7\x93!\xfa\x80Qh!x\xfe\x8e\xd2\xebh\xf6̯\x9b\xec\xfal\x05&\xe7\x152ȈK\xf8hj\xb4\xe0{\x90\x91\x18\"\x86\x88\x8cN\x0er\x1d>߃r\xe0\xb7\x7f\xa0\x96\x166\x183\f\xf0\xe8\x9359\x1dw\x18\x05\"j?8\xfa{\x8f\xcd \xbe\x1cj\x95`U\xf6\xf0\x91\x13\x8cNY\xd8)\x9b\xf0%(g`R\x8f\x101\x9f\x02\xc9\x1d\xe1\x15\x13n\xe17\x1f\x11\xc8\xf5\xbe\x83Q$p\xb7^\x0f$KYi?Mɑ<\xaeK\x85\xd06\x89\x8f\xbc6\xb8C\xbbf\x1aV*\xea\x91\x04\xb5\xa4\x88k\x15hU\\w9`n'\xf3\xbfX\v\x91oN|\x95ǜE,\x91\xdcp\xb4P\xd2\xfd\x19\x05r\xa6ω0o\x9d\x03=\x10Mn(\xec|\xfae\xf3\x19\x96\xa3\x8b\x18'\xa0Py?l\xe4\x83\x04\x990r=Ʋ\x0f\xfa观\x89\xce\x04ON\xca@[BwN?\xa7\xedD\x92u\xff3!K֪\x85\xdb\xd2k`\x8b\x90\x82Q\x82\xa6\x85\xf7\x0enՄ\xf6V1\xfe\xe7\x02d\xa6y\x95\x89\xfd1\t\x8e\xdb\xe4\xe1\x97Q\xba\xca\xda\xd1\xc2\xd2Į\xe8u\xb9\x927\x01\xf5I\x01e\x14\xea\xa9Vv\xef\xe3\t\"\x80Z\xea\xfc2ޡ\xb8\xaf\x17x\xed\xf1=\r\xe7\xb3\x00ʘrC({wu\xef3\x84]\x88\xfbֻ\x9e\x86\x9c\xa8\xbd\x8f\x10\xa2ߑ\xc1\xb8Z⬞\xa4X\x03&\xb4\x86\xdb'\x90W8\xaf\xcd|\xa0r\xf9\xf8$\xdd\xf3\xce\xdc\x1d\xdbf\x9fF\xff\r\xacw\x03\xa0\xd2#he\xed\xbe\xa9TFo.\xf4\xd2\xf3\x9e*\x18\xab\x1b\xa5ňz@\xd8b_\xba\x89\xdc0h\xe54ڜ\xee\xef\xb0W\xc9ʾu\xcdb^\x82.M\xf0\x86\xcft>:I\xe6(^·\x91\xf4\x98\x83q\xde!$g\x91yO+\x9a\xa7d\xe6\xdbYm-v 1a\xf3\x13\xda.\xf2}\x8f\xe6j\x96\x9d\xcaQ.\xdb\xe6\xdb\x00+\xa1\xe5\xaaR\x03\xb6?\xeeA\xee&\x14\xf1\xac/\xae\xf6\a4?\x903,J\xd2YR\x9fx\x7f\xb9\xb26e[\x8ds[\xabU\xa7\x18\xd1I\xc5<\x81\x84\x1c\xec\xbfT\xadaT\x8c\xdf\xe1\xfc\xf2\twy\xe7\"\x83\xa5\x1e\xf5\xa3\xb68\x03\x82\xef\x9f@\xfed\x83\xc9\x7ftiz\xea\xdb\n\xde\xee\x14\x954\xbb\xb0\xf6\xbbSWW\xaf\x8a\x7fQ\xcf'\x93\xa5p\xccQj\xd7,\xab3\a\xf5\x95\xd6\x18\x04\xcd\xc7\xf3\x17\xe6\x8b\x17'\x8f\xc42\xd4\xde͍\x91;\xf8\xf25\xbf\xfd\xf23\xcb\xd4'\x10w\xf0\xe5k\xf3\xcf\x00c\x9a\xb1\xef\x9d\v\x00\x00"),
 }
 
 var CRDs = crds()
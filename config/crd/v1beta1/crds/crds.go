@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Mo#7\xb2w\xff\x8a\x82\xdf\xc1\t`\xc9\x19\xe4\xf2\xe0\xdb\xc4\xe3\xc1\x132obĎ\xf7\xb0\xd8\x03\xd5]-1f\x93\xbd$[\xb6v\xb1\xff}\xc1\"\xd9\xdf_r\x9c\f\x82\fOv\x8b,\x16\xab\x8a\xf5\xc5\"\xcfV\xab\xd5\x19+\xf8#jÕ\xbc\x06Vp|\xb1(\xdd\u007ff\xfd\xf4\xbff\xcd\xd5\xd5\xe1\xdd\x16-{w\xf6\xc4ez\r7\xa5\xb1*\xff\x19\x8d*u\x82\x1f0\xe3\x92[\xae\xe4Y\x8e\x96\xa5̲\xeb3\x00&\xa5\xb2\xcc}6\xee_\x80DI\xab\x95\x10\xa8W;\x94\xeb\xa7r\x8bے\x8b\x145\xcd\x10\xe7?|\xb7\xfe~\xfd\xdd\x19@\xa2\x91\x86?\xf0\x1c\x8deyq\r\xb2\x14\xe2\f@\xb2\x1c\xafa˒\xa7\xb20\xeb\x03\n\xd4j\xcdՙ)0qs\xed\xb4*\x8bk\xa8\u007f\xf0C\x02\x1e~\r?\xd0h\xfa \xb8\xb1?6>~\xe2\xc6\xd2\x0f\x85(5\x13\xd5L\xf4\xcdp\xb9+\x05\xd3\xf1\xeb\x19@\xa1Ѡ>\xe0/\xf2I\xaag\xf9\x91\xa3H\xcd5dL\x18<\x030\x89*\xf0\x1a>;\f\n\x96`z\x06p`\x82\xa7\xb4:\x8f\x93*P\xbe\xbf\xdb<~\u007f\x9f\xec1g\xfe#@\x8a&Ѽ\xa0~\x019\xe0\x06\x18<\xd2\xd2@\a\x16\x80\xdd3\v\x1a\t\x13i\r\xd8=B\xc2\n[j\x04\x95\xc1\x8f\xe5\x16\xb5D\x8b&\x00\x06HDi,j0\x96Y\x04f\x81A\xa1\xb8\xb4\xc0%X\x9e#|\xf3\xfen\x03j\xfb+&\xd6\x00\x93)0cT\u0099\xc5\x14\x0eJ\x949\xfa\xb1߮\x03\xccB\xab\x02\xb5\xe5\x91ή5\x04\xab\xfa\xd6Yօ[\xb7\xef\x03\xa9\x13%\xf4\xe8\a\x81\xc0\x14\f\xd1ĭ\xc3\uee69\x97I\xf4k\x80\x05ׅɀ\xf4\x1a\xee\x1dS\xb4\x01\xb3W\xa5H\x9d\xfc\x1dP;2%j'\xf9\xbf*\xc8\x06\xac\xa2)\x05\xb3\x18X\x1f\x1b\x97\x16\xb5d\xc2q\xac\xc4K\"DΎ\xa0\xd1\xcd\x01\xa5l@\xa3.f\r\xff\xaf4\x02\x97\x99\xba\x86\xbd\xb5\x85\xb9\xbe\xba\xdaq\x1b\xb7R\xa2\xf2\xbc\x94\xdc\x1e\xafhC\xf0mi\x956W)\x1eP\\\x19\xbe[1\x9d\xec\xb9\xc5\xc41\xef\x8a\x15|E\x88K\xdaI\xeb<\xfd\x9f\xc8ts\xd1\xc0\xd4\x1e\x9d\x8c\x19\xab\xb9\xdcU\x9fI\xd2G\xe9\xeeD\xdeK\x93\x1f\xe6\xf1\xaf\xc9\xeb>9\xaa\xfc|{\xffД4n\xda4'j7\x84\xaf&\xbc#\x14\x97\x19jϸL\xab\x9c \xa2L\xbd\xac\x91\x98\n\x8e\xb2MtSnsn\x1d\xa7\xffY\xa2q\xe2\xac\xd6pC\n\x05\xb6\be\x91:)\\\xc3F\xc2\r\xcbQ\xdc0\x83\xbf;\xd9\x1d\x85\xcdʑt\x9e\xf0M=\xd8\xee\xe8\xa9U}\x8e\x1ak\x90C~\xc3\xdf\x17\x98\xb46\x86\x1b\xc33\x9e\x90\xf8C\xa6t\xad\x0f\xbcJZ7\x00\x0emJ?Q\xc6Ja\x1fi#\x9b\a\xf53\x1a˓v\x9f\x0e:\x1f\x06\x87Dt\xd0\xc0\xf3\x1e\xed\x1e\xb5\x93\x15\xfa\x81\xb6]\a\"\x10\x03\r\xa6\xb4\xe7\xd8\x13\x02\vX\xd3\xe6\x15\x02\n\x15\xf5\x8b\x81\xed1\"\xba\xee\xc0\xf1\xd4\xdc*%\x90\xb5u\x00\xbe$\xa2L1\xad\xf4\xad\x99\\\xd5m\xaf;\x19*ƥ\xdb\x19\xce48\xc4d\xfd+\xa9Z\xa6\xb1\xb70'\x9d\\zh\xa4E\xf78\xc0\x10\u05f8ż\x87Ո(\x05إ\x10l+\xf0\x1a\xac.\xbbS\xfbqLkv\x1c\xa4D4\xd4\xcb\bQ\xf5\x0e\xbaA\xf0\x84lH\xa5\x01\x88\x16\u007f\"2\xec\x95z\x9a^\xfa\xff\xb9\x1e\xb5\x06\x83\x84\xfc\x1b\xd8\xe2\x9e\x1d\xb8\xd2a\xb1\xc1\x8cl\x11\xf0\x05\x93\xd2b_\xb6\x99\x85\x94g\x19j\a\xa5\xd83\x83ƛ\xad1\x12\x8cmO\xd7\xf4\x18\xdbz\xf8\xd7,c\x1a\xfdz\xc7Pv\x9bT\x122}\xea\xfa\xe6\\\f\x99\xf2\x03OK&\x80Kc\x99L\xfc:X\x85Sw\x1d0\xce\xce\x1e\xb6^\xadE\x9c\x1d\xed[*NI\x04\xa5!wڼ\xdf\xd5\f\u0087\xd1\xe5n\x99\xd35ʋ\xa1.\x05\x9a0QJ\x9a\xb3\xdeח#\x80+.x\xdb/\xd8\x16\x05\x18\x14\x98X\xa5\x87\xc80\xcdT\xdf\xe6u\xd4\b\xed\x06\xb4U\xad\u007f\xdd\x12\x9b\x8aJ\x8d\xc2\x04x\xde\xf3d\xefͲ\x93\x17\x82\x02\xa9BC\xfb\x97\x15\x858\x0e/\x0e\xa69\xed\xdb\xc4\x16\xae\xdb\xe4f\xee\xc2\xeao\xeb\xba\xcd깺\xcdh\xbc6-+\xd6\xffuH\x19\x15\xf7ɂ\xb9\xe9\r|K\xc1tD\xe4ε\xded\x80ya\x8f\x97\xc0m\xfc\xea<\tFq\xe1(y\xaa\xb9\xfft\x8c8U\xa67\xddqo(ӿ\x91\v\xd5\xd4\u007f\x1a&\x90\xb2\xbf\x0f\xba~!\x03>5\xc7\\\x02\xcf*\x06\xa4\x97\x90qaQw81\xb5\\5͉\xdfJ\x82yK\xe5Z\xcel\xb2\xbf}qޑ\xa9\xd39\x8b\xa8\xd1\x1d\xea}\xca\xe8U\xb7\x8d\xe9$T\xa0`\x90k\xcc}\x88\xf9@\x14\xac\xbf\x90\xe7\xf3\xfe\xf3\aLǉ\x02K$\xac\xb7\x84\xf7\x1d4\x9b\xd3\x06\x17y\xd9\x02\x82\x93RE\x17>]p\t\f\x9e\xf0\xe8\xbd\v&\xc11\x84\xb9i\\\xe7Y\x88\x1a)gA\x02\xf5\x84G\x02\x12\xd2\x103c\x97\xb1\u07b7'<\xcew\xea\x90\xcda\xc3MH\xab8\xfa\xb9\x0fD\x00\x8aa\x97\x92\f(\x89\x145\xccܢ`\xa9\x8a\x88-R\xfb\xe4\xe5Ulj$݈\x91\x17\xc63\xc5I\xfb\x9e\x17\x8b\x16\xe8T'\x18\xa4=\x11\x93H\x8fL\xf0\xb4\x9a\xc6\xcb\xf7F^\xc2ge7r\xccYm\xb7\xdb\x17nB\xee\xee\x83B\xf3YY\xfa\xf2\xe6D\xf4(\x9fLB?\x8c\xb6\x90\xf4jح\xbf\x99\x8b\x9a\x15b\xdf6>ªX\xc2\rl\xa4\x8b!<\xad|6\xd1O6\xa5\xed\xdb-/\r%\x9b\xa4\x92+2v\xeb\xa1y\x02\x89\x17\nr\x93\v}\xb4\xaa)\xfdt\x8b >8\xbb\xe0G\xfb̨`\t\xa6\x90\x96DD\xca\xec1\x8b;\x9e@\x8ez7n\b\x9a\xadp:{\xc9\xf4\x8bt\xa9o'\xc9\xd3\x12\xd3\x1c[P\xc6\xe9\x1c\x1a+\xb77g\xfbD\xd6\xcet\x1cL\xe5\x8dw\x9c[\a\x19I\xf2\x1bf\xa8\xc9Ҕ\xceY\x98\xb8[\xac\xbd\x17S\xbeo\xb7=J\xde\xc6\xe5\x8c\x12t\xffv\xa6\x8a\x84\xf6?P0\xaegw\xe8{:0\x11\xd8\x1a\x19\xb2B\xcdI\x1c|n\xc0q\xf3\xc0D7!<\xb0,\xe5\xb4\x06\no\x86U\xd6\xf34.\xe1y\xaf\x8c\xb7\x8a\x19G\x91\x02\x9f\xf2\xb4\\;\u007f\xc2\xe3\xf9eo\x8f\x9fo\xe4\xb97Ͻ\x1d\x1bm\xf9\f`%\xc5\x11\xcei\xe4\xf9\xeb]\x97ER\xb7\xa0\x13\x9d\x9e-sf]4\x17\xad\xb8\x1bV\x9d\xc18Wt\x1c\xdb\x052W(c\x17\"q\xa7\x8c\xf5\x19\xba\x96\xf38\x90\x1b\x9a\x8eiBN\bX\xe6Ͻ\x94\x8e'\x1cN\x91uR\x95\x8eK\x06\a\x13\x9c=\x88i\x00Ʉ\x80\xf3z\x8fz\xfdx\xee\x8f=h\n\x96\x90[0\x01щB\xa1U\x82\xc6L\x89ì\xe6\x9dI\xb8U\xc96\xe6\x83\n\u007f\x880\x95܋m\xa9\xdb\xe8Hs\x92\x9b}\xfb\xd2\xc8\x01\xba\xad\xed\xfe\x9f\x16\xb3\xd30\x02:\x82\xces&g\x8dE\x0f\xb9\x1b?.n\x85\x00ƻ\xeczW\xd26^\xea\xe9\x05\xa1\xf9\xb2\x066\xe7rC\xc0\xe1ݛ\x9ac\x88*\x11Ow\xa9o\xe2Ț\xcc\xd5\a\xbf7\v\xd5O\xb9\x0f\xb5\xe7=jlq\xaa\x9f\x19&wN*\xdb\bϗ\x11\xda\xe3qa \xe3\xda\xd8&\x92\x86\x0e\xb6\xde>F\x91\xb7Z\xbf\"D\xf9ɏk$\x80\xf6\xea9\x9e\x14\x8e\x1c\xce\r5:\x06A\xe0\x19p\v(\x13UJJb\xb8MJ\x13x\x92ze:kd}[\xb2\xb1]CY\xe6K\x16\xbe\"\xe9\xe1r\"\xd7\xd1\xec\xfc\x91\xf1\xa9LUl'\xb1\xc9\xf2\x1cU9a\xd4\xea\xd6bӃ\x1f\xd7:\xe2\xcd\xd9\v\xcf\xcb\x1cX\ue23d\x88\xa2\xce2\xf3\x1c\xdb\xfc\x85g\xc6-iw\a\x95T\xbdUnS\x14\x02\xed\xb2h`\x8b\x99Ҵ\x17\rO\xb12\x99\x81\xe7J\x02\x83\x8cqQ\xeaE\x1a\xed\x04\x8a.\xf7\xec\xc3&\u007f\x1b\xa7}ɴ+Z\xfel\x9ar\x91\xab6\xa5U\v\xbd\xd4Q\xbb\xd3\xf8\x96.R\xa1\xb9\x93\x19\xf5\xb6^R\x10%&\x8f_ݤ\x06m\xbe\xbaI\xbd\xf6\xd5Mj\xb5\xafn\xd2W7i\xb2}u\x93\xbe\xbaI\u007fU7i\x1a\x93\x15\xe5\xad\x06\u007f\x9a\x99}\xf6\bu\x1c\xb1Q\xc8\xe1T\xff\xc6\xd7^/\xab\xcb\xdb\f\x8f\x19\xa8\xbb\f%\xdd+*8\xef\xf3\xb9>\xfa\xaf\xd5|U\xa8\xe7\x84?\n\xaf/,\x9d,\xdd[P\x887T\x9b9_^2WTҮI\xac\n;bQ\xa2\x8aS\xf4V\x1f+ٝ\x9b٬``B4kS\x98\xae\x89\xf2\x85\xea\x15gK?f\n>\xa6\xcb6\xc7)\xd4q\xed\xdb$ҭ\x12\xc3/L\xa1ɺ\x8c\xf1j\x8cp\x92\x81\x96\x1dޭۿX\x15j3\xe0\x99\xdb}o\x01T4\xe9B\x16\xb9k\x16GF\x99\n\xd7\a\xba\x94\x03\xa5Arq9X\x17Sݬh\x92\x13~*|Pt\xd2~\x9br\xed\x97\xd4n\xbc\xbab\xa3]\x931\xa8dO;\xecXZB\xba\xbc&\xa3]s1bd\x16Tb\x9c\\i1\x1foMVU\xbc\xa2\x96\"\xd6IL\x19܉\n\x8a\x05>\xc7|\xb5īj$\xe80o\x02\xeb\x93*#\x1aU\x0f\x13 \x97\xd5C, \xc9\\\xed\xc3\xc9\x15\x0f\xdd*\x83\x89E\xcc\xd59\x8c\xd70L\x00\x1d\xacnXR\xb90\x01\xb3\xaaix\xc3z\x85\x99*\x85\xb7\xa9$\xfc\xad\xbe\xe7X\xcd\xc1L\xa5\xc1\x8cg:\x85\xd5L-\xc1\xf2\n\x82\x19\xfa\xbc\xb2Z\xa0\xaa\a\x18\x9c\xf3\xd4\x1a\x81v\x15\xc0 ȅ\x95\x01#g\xff\x83 \x17\xd4\x03̜\xf8\x0f\x82\x9d4\x8c\x13\x121\xfa\xd3\xd0%4\x98\xb1L\xe2\xf7\x97\x9c\xd7,E\xe9\x14\xf5\x84G\xbc\f\xb9\t\xc4\xda\x19\xa1\xcel\x9d\x12\xeax]\xcd\xf5jz\xd8}\xb6\xaa\xaa\xf87\x81\x1f\xb9L\xbd$81nXt\xba\x86I\xc5\x15\x95KQ\xfb\\C ;\x1e\xbd\xc1\x82i\xba\x95\xbb=\xfa\x18߬\xe1\x96%\xfbvG\xd83㢼|\xa0\xaa\xf4\xbc\n\x80\xae\xe2\x18\xf7\xe5|\r\xf0QUqe\xf3*\x8c\xe1y!\x8eP\x1a\x84\xf3\xf6\x90\xd3\xfd\xfb\x01~\x1b\xc9\n\xb3W\xf1\xaeᤋ\u007f\xdf\xee;\x10\x17Ǜ\x86\x89PeZ\xc1\x1ed\x17\x93G\xb8{$\xff\x84nq%\xf5\x1d\xb6\xe0\x85D\xbf\xbd{\xc5퇷\x8c\x93\x8dU\x9a\xed\xf0\x93J\x1aw\xc4\xc7\xd6\xdf\xeeۺ\xd1\x1b\xf4Q\xccF\xc5\x12\x1e\x16\xaf\x96\xb6\x87\x0e\x85=!A\x1cd\xbeN\x1c8\f\xfb\xaajt\x87Y+&\x17\xf1\xf0\xf0\xc9#ny\x8e\xeb\x0f\xa5\xcfA\xac\n\xa6\r:\xfa\xc5\x05\xf9A[\xf7\xe7^=\xf7\u0557\n+\xfd\xa1\x8b\xafFJ?S\xa2c1\xd6\xfe\x1aj\x14\xb0H\xa6iq|\x1c\x1e\xd3\b\xa3\x1aL\xf1;Xec\xa3z\vl\xdc\xc1w\x81\xaa/\xc6z\xabە\xc3~\xc5\xf0\xbde\xcbli\xe6n.S\xa7\xf8\x0eA8\xac(5]\x8e\xf4\x00\xbc0\x9e|y9df[oCL\xf1\xe4\xa6ߟ^\x01ЩG\x8a2\xc2\xd5=\xe4gf\xaa\xdc\xef\x801\xae\x81\xf9q\xe4\xc7:X\x98\x02\x1eP\x82\x92\x94\xea\xa5ˇ\xfe\x85\x8a\xee\x98~\xea\xa5\x01#d\x92\xcbB(\x96Ɲ\x1bmNx\xd9\xe0\x81\xf4\x91>\xa0\xbe0\xa3\x10\xe9\x96u\xa6\xf4\xd0\xf2\xbb\x92\xe5\r\xc35\xa4\xcc\xe2j\x00\xe0\x02=6 Rt\xee1s똺\xf8\xddAG&\xf1\x1a\xb8?3\xc9\xd1\x18\xb6\x8b\u05cd\x9f\x9d:ڡD\xb2w\xbd5\x87(\xa2ι\xb7\xaf\xde\xfad\x04Kl\xc9\x02\xf8\x98}i\xf4\xba\xe8\xef9\xa1v\x90qA\x1d\xc3c\aA?\x0f+\x12.-\xee\xb0\xed\xd9\xe3K\xc1\xf5\xbc.\xbf\xad\xba9\x8aP։vx\xfd\xf4\a\n\xbe\xe3N!:\xc6\xee\x98\u07b2\x1d\xae\x12%\x04ҡy\x17\xa3߇\xaf\x1e\xea\xc0\xc3\x1e\xbd\x05}l\xf6\x8c\x1eO\x10f\x0f%\xbe\xf3q\x19,\xaa\xe3`\xce~U\xba\u007fΘs\xa9\xb4\xf7\xbc)\xfa\x8bC\x17\xebs\xba\x91=\x89\xef\x9d\xebQ\x1d\xac6t\x15Fa\x1a\xb6\xf3C\ap+\xf8\x8c]\x13\xe5\xcf\xd40}\xac\xde\u007f\xe9u\xd8\xc8;\xadv.X\xe9\xfdt\x13\xb5R\xef\x97;\xa6-gB\x1c=\xf8\x91Y{\x9f?\xa0\xd3\v#\x86`\x88\x80\x01\xb3i\x1a\x86Nu4ĥ\xe75\x9d\x83mUi[\x1b\xaeް=\x8e\xc7\xf9\xd6\xf0YY\x8c)/ކ\xe8, \x1a\xbb\xc2,S\xda\xfa\xd0k\xb5\x02\x9e\x05\xc3҃\xea\xb43%m\xfdC\"\xc0m\x9d\x80\xa8e\x93|A\x8d̐lZz̄NLX\x928\xff\x04\xaf\x8ce\xa2\xa7\x03^\x9d\xa9%{\xed\xa4\v\xd3_z\xe6\xacG\xe4M\xb3wU\xa2]\xe6[\x1f\x93\x100O/:\x95\xf6ZO\fg#\xb6\x88\x12\x9e5\xb7\xd6\xe9\x9bf.\x1b\xac\xd30B\x80Q\x90\xb1\xc1\xeb\xee\xe3:\x8f~U\x96\x89\xcdX.\xa6\xed\x02V]\xe3rhp\u007fQʱaKK\x1f\\\x8e\xafK\xe2&\x8et\x8cK\xf6L\xee\x9c\x00iU\xee\xf6Q\x02G,\xc5p\xfe\xb9t\bA!ʝ\x13\xe9\x90\x13\xb6\xa5\x96\x8d\xe83d\x89\xd3\x06\xaa,y\x82\xb2\x18.\x9a\xf0\xcf\x1c\x85G\xaa\xae\xc25\xf6U\xa6U\xbe\n\xf4\xa7x\xfc2D\x86\x9a+\xe72QL\x13n\x92\x8e\x80%\xb6\x17\x05J`&\xe02[25\xc5\xc8\xf1@\xcd2m\x979a\xf7\xad\xae3\xfe\x17\xc1\xc5t\r\xf7!\xba\xedob\xadr\xb8\xe9>\x11\xe6\"S\x19\xdf\xc3\xf2\xb1\xb4g\xbdqn\x99F\n[\xfc\xf5\xd1\x1eĖC\xd5r\xa0ڨ\xff1\xbeS\xfdB\xd8\xed\xbc\x17\xf5\xd8\xe9\xdc9\x03t;\xb8\x86\x17}\x9fox֏/\x8aB\xf0\xc4a\xfb\xed\x17:\xdb;,\xf0*.&\x1d\n\xf2\x1e*\xdf\x00>`\xa11q\xbb\xb2\x8f\xfc\x9d@g\xef\rb\xdbS\xb9X\xecصCD\xf3\xdeZ̋\x81\xb9&b\xc4zИ\xe2c\xb1Co\x01\xf1!\xb6\b*\x14\xb1\x8c\x06\x85\x8b\x17R\xb9\x1a\xa7,\xa4\x1a4\xb6\x10S&N\x01e\xe5\x90)\xaab\xae7\\\xd53\xd3.О\xde=\u007f\v\x9d\x06\xa2\x900\xfem\xe3\x90F\x18\x12\xf1\xfb\x83\x02\x91\x01=\xde\xf9T?\xc3\xf8\xae\xfe\x8fȷ\n\xcf.\x1e|)$i˴\xb1\xb5\x03*\xe1K\x9d `I\x82Nv?w_`<?\xa7\u007f\xe2#\x8b\xf4o\xa2\xa4\xb7\xa5\xe6\x1a\xfe\xfe\x8f3\by\xa6ǈ\x87\xfb\xf8\xdf\x00\x00\x00\xff\xff?7>\x9a\xb2R\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKs\xe3\xb8\x11\xbe\xebWt\xcd\x1e|\x19Q3\xd9KJ\x97\x94F\xceVM\xe2\x19\xbbF^琤j!\xa2)!\x02\x01\x06\x0fi\x95T\xfe{\xaa\xf1\x10)\x92\x92\xec\xad\xdd\xe5\xc5&\xd9\x04\xbe\xfe\xfa\rM\xa6\xd3\xe9\x845\xe2\x05\x8d\x15Ź5\x02\u007fv\xa8\xe8\xce\x16\xbb?\xdaB\xe8\xd9\xfe\xe3\x1a\x1d\xfb8\xd9\t\xc5\xe7\xb0\xf4\xd6\xe9\xfa\x1bZ\xedM\x89\xf7X\t%\x9c\xd0jR\xa3c\x9c96\x9f\x000\xa5\xb4c\xf4\xd8\xd2-@\xa9\x953ZJ4\xd3\r\xaab\xe7\u05f8\xf6Br4a\x87\xbc\xff\xfeC\xf1}\xf1a\x02P\x1a\f\x9f?\x8b\x1a\xadcu3\a奜\x00(V\xe3\x1c֬\xdc\xf9\xc6:m\xd8\x06\xa5.\xe3^\xc5\x1e%\x1a]\b=\xb1\r\x96\x01\t\xe7\x01\x1e\x93OF(\x87f\xa9\xa5\xaf#\xac)\xfce\xf5\xf8\xf5\x89\xb9\xed\x1c\n\xeb\x98\xf3\xb6h\xb6\xccb\x80\xccіF4.\x00\xfb\x14\xf6\x83U\xdc\x10\x1eҎ\x10\xbf\x02\xeb\xcb-0\v\x8b=\x13\x92\xad%\xce~T,\xff\x1fV\x8b\xb0\x9fN\xab\xbbc\x83s\xb0\xce\b\xb5\xb9\x00E2\xeb^\x98\x14\xfc\xc4\xc4\x10\xd7\xc3@\x06\x84\x05\xb7E\xa0\xaf\xc1\xd1\x03\xba\x8b|\x01\x11\x86\x90\xf9\x82\x03\xb3aI\x80}\\\x03y\a,\xad\r/g/\"j\xba\xefc\xce\xd6/\x06\x96문\xd8\xe0\x8de\xc8l\x05Ǌy\xe9\x86\xda\xde\xc7\x17]m\xc8\x1aY\x9f\xceN\xf7\x9d%\xe2nk\xad%2\x92\xd9\x18\xed\x9b9\xb4\xbe\x12?J\x9e\x1a\xbd<\xda;\x99\xfb\xa1\xbb\xbe\x14\xd6\xfd\xf5\xb2̃\xb0q\xd7Fz\xc3\xe4%O\r\"v\xab\x8d\xfb\xdan=\x85\xb5\x95\xf1\x8dP\x1b/\x99\xb9\xf0\xf9\x04\xa01h\xd1\xec\xf1G\xb5S\xfa\xa0~\x10(\xb9\x9dC\xc5dp0[jR:,ް2\x98\xcf\xfa\xb5Ia\x9b6\x8c\x8e6\x87\xff\xfeorr\x01\":\xbc\xd4\r\xaa\xc5\xd3\xe7\x97\xefW\xe5\x16k6O\x9e2\x12\x16=\n\xc8\x03Y\xc7ɶh\x10^\x02\xdb\xd1\x01m\xd2*\xad\b\xa0\xd7\xff\xc2\xd2e_l\x8cn\xd08\x91Q\xd2\xd5IR\xa7g=,w\x046\xca\x00\xa7\xb4\x841\x10RrA\x0e6(\x02\xba\x02\xb7\x15\x16\f\x06\x12\x95k\x8d{\x02T\x01S\tV\x01+\"\xdaX\xb2\x97\x97\x9cr\xd9\x1e\x8d\x03\x83\xa5\xde(\xf1\x9f\xd3\xca\x16\x9cN\xb1\xe70\xb9A\xbeB\xeeQL\x12\xcd\x1e\xdf\x03S\x1cjv\x04\x83\xb4\ax\xd5Y-\x88\xd8\x02\xbeP\xb0\nU\xe99l\x9dk\xec|6\xdb\b\x97\xd3r\xa9\xeb\xda+Ꮃ\x90\\\xc5\xda;m\xec\x8c\xe3\x1e\xe5͔̊\x99r+\x1c\x96\xce\x1b\x9c\xb1FL\x03p\x153eͿ;9\xc3]\ai//\xc5+\xc4\xc4E\xde)\x1a\xa2\xcd\xe3g\x11\u007fK/=\"V\xbe\xfdy\xf5\fy\xd3`\x82s\xce\x03\xdb\xedg\xb6%\x9e\x88\x12\xaaB\x13\rW\x19]\x87\x15Q\xf1F\v\xe5\xc2M)\x05\xaasҭ_\xd7\u0091\xa5\xff\xed\xd1:\xb2O\x01\xcbP\x9c`\x8d\xe0\x9b\x90\xd9\n\xf8\xac`\xc9j\x94Kf\xf17\xa7\x9d\x18\xb6S\xa2\xf46\xf1ݚz.\x18\xd9:=\xce\xe5n\xd4B\xa3Q\xbaj\xb0<\x8b\x13\x8eV\x18\xf2e\xc7\x1c\x86\bHA{F\xe9\xe5\xc4x9xC\x00\x97%Z\xfbEs<\u007fރ\xba8\x89\x9dak\xd0\xd4\u0086\xae\x04*m\xfa%\x8d\xa5\xbaҽr\xfe)zoP\xf9\xba\x0fa\nߐ\xf1G%\x8f\xa3/\xfef\x84\xebo0j.\xba\"\xac\xd5Q\x95Oh\x84\xe6W\xd5\xfd\xd4\x13>)\xbd\xd5\a\xa8\x82\xdb*'\x8f\x94W\xecQ\x95\xfd\xbc\x99\xaf\xc5\xd3\xe7\x9cCcp\xa4XJ\xdc\x14\xb0H1\xa9+\xf8\x00\\XjKlX\xb2O\x0fuY\xf4v\x0e\xce\xf8W+]jU\x89M_\xd5n\xef5\xee\x15W\x17\xedq\xb5\f{P\xa2!\x0fh\x8c\xde\v\x8efJ\x9e/*Q&\f\xdeĪS\x85\x82\xd8\xd7n4v\x82\x02\x069\xc5(\x93W\xed\xb5<\x89\x85\x8e\x96\t\x15\xfd\xb3\xfd<$\x0eS\xa7B\xa8\x1c*\x9ez\xa73\x1c:\xe4\x1f\x8b\x1c\x0e\xc2mcZ\x93\xc3h\x82+\x11E\xd7\x0e\x8fÇ=\xcc\xcf[$\xb9X\xf6\x10,\x96\x06]\xf0(\x94\xe4$\xe40\x05\xc0\x17oCRd\xb1\x11\x18Y\x15\xf2\xb7;<\xf6\x89\xbda\xc8Ԗ݂zG\xfdJ\x06j\xb0B\x83ʍ&d\x1a \x8cB\x87!'s]ZJ\xc7%6\xce\xce\xf4\x1e\xcd^\xe0av\xd0f'\xd4fJ\x14OS|\xccB\xab7\xfb.\xfc\x19U\xf2\xf9\xf1\xfeq\x0e\v\xceA\xbb-\x1a\xb2R\xe5ev\xa8N'\xf2>\xd4\xc5\xf7\xe0\x05\xff\xd3\xdd[\xf9\xd0M\x8c\x8c\x9b\x9c\xac\x82\u007f\x1f\xa9\x8d\np\x88\x9aU\xb4\x836@Ս\x8c['\xeb\xc5\xfc1f\xbd~\x17ܽ(\xd1P\xee\x1f\xe6\xc5\x1d\xf6S\xe2\xc5\x10J]\xfb\xd5\xf8\xc9\r\xbcP\\\x94\xd4$\x9d{~\x9e]\xf8h\x9f\xff\xea\x14\u007fY\xd5\b;U\xaf\xabH\x1f\xbb\x92\xed\xb8\x17\x93M\xaaJ\x16\x1d57\x16\x14R\xd5b\xa6\xcfU\b\xf4R+Eq\xe64\xb0Sں\xb3\xfd\x1c\xfd\x86\xa8_\xfbr\x87\x03\xa2\a*|\nb\x99\xd3\xf8\x11\xa1\xf0\x16C\n\xbd\x0e\x00nypɖhn\xa3X.H\xecT\xd8\x18,\x17\xb0\xf6\x8aK\xccX\x0e[Tԥ\x8b\xeaH\xad\xe2\xf3\xc3j4.\x13\x8f\xa1\aH}vfs\f{\xcc\xc2sX\x1f\a\xb5\xfb\xa6j\x8d\xc1J\xfc|S\xb5\xa7 \x96\tn\x98ۂPVpJ\xa2C\xbaG\x9a\xa9|\x9d\xea\xf4c\xca\no4\xc6\xe5\xf8\x8d0^\x1b\u0099ϫ\x91\xf1\x94\x84Nz\xe7\xfb\x94\xb7σv<6G\xb4h\xc7\xcf\x1fb\xdfS\x0eJ\xdb\x19\x8c\x97\xa1\xfc\x95\xee)\x9fo\f\x03\x94\xaa\xb76\x06m\xa3\x15'\xff{]\xef\xd4\xc2\xfd5:\xa81\x03Nϳ\xd5ٛ\xcc\xf9ͱ \x0e\xf8o\x1b\f\xe2\x91V\xb7\xfd\xd6\xebp\xd6Й\r~\xe31\xe0]g\x0e\xa0\xc9R\x81W\xa1[\nU\xb8\x80\u007f(\xb8\xa79\x91j\b\x9f\x13F\xea\x10\x86\xf5\\\xe9\x03}\xdcY-,\x00Z\xc5:J3\x10M\xe2q\xac\f\xaf\x0eBJ\xaa\xa4\x06k\xbd\x1f\xa9\xa4\xd4\xe6\x19\x94G`\x96\x88\xd8\xff\xa1\xf8P\xbc\xfb\x9dg\fɬ\xa3\xa1\x01\xf97܋\xfe\xa9Ȑ͇\x81|\x0eޓk\xd3\xcdOyܜ\x99$\xf6\xd3@\xfdJH\xea\xc5F\"\xbd\xad\xe2\xc3\xe3\xc7O\xab\x87;\x1bZf\x1a\xec\a\x8b\x1e\xc8|6\x00\xa4\x9eY\xa7y\xde[\x87f\xc4\xd8'[\t\vJ\x83\xd4js\x16\n\xf1J\xd3=uI\xd1u\xb4\x01\x8e4\x98S\x94\x97[\xa66؞\xd8$\xec\x1d\x94\xe4\x18C\xa4\xe7\xde\xd1z\x83P\xe3\xae\xf0\n\x1b>\x8bak<8\xe4mE\xc7\x0fxO\xa8\x93-/\f\x137\xb8\xeeI\xe7\x1aJDN]>\x80n\xaf_6,\x0eϵoj\xff\x8b\x8f\xb8\x87\xea3\xdb\x1ev\xff\xfe\xba\x87\x9f\x17\xae\x97W\x92\xc8\x1a\x96\xde\xd0\b\xd4\xe6\xdd\x10Lc\xb9\xf7u\xc7\x1c\x8b\xb3\xdf$\xbao\xfa\xbfW\xdc\xd4e\xa4\xde\xf4\x1e\xb5\xbf\xea|l\xef\xd2\x0f/\xf1\x94>\xbc\xa0\xb1\x92\x8aK\x87ȔQғ\xb6\x88Q\xf5h\x1c\xf2\xaf\xfd\xe3\xfaw1\xec\xf2\x99{\xb8-\xa9\x9eǟ\xa0\xe0\xef\xff\x9c\xc4U\x91\xbfd\x1c\xf4\xf0\xff\x01\x00\x00\xff\xff\xed\x93\x00\x8d\x01\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96\xcdn\xe46\f\x80\xef~\nb{\xd8Kǳ\xc1^\n\xdf\xda\xec\x16\b\xda\x06A\xb2ͥ\xe8A#q\xc6ldI%\xa9Iӧ/$ۙ\x9f8\xc8\xf6\xb0\xbe\x89\xa2\xf8\xf3\x91\x94լV\xab\xc6$\xbaG\x16\x8a\xa1\x03\x93\b\xffQ\fe%\xed\xc3\x0f\xd2R\\\xef/6\xa8\xe6\xa2y\xa0\xe0:\xb8̢q\xb8E\x89\x99-~\xc2-\x05R\x8a\xa1\x19P\x8d3j\xba\x06\xc0\x84\x10\xd5\x14\xb1\x94%\x80\x8dA9z\x8f\xbc\xdaah\x1f\xf2\x067\x99\xbcC\xae\x1ef\xff\xfb\x0f\xed\xc7\xf6C\x03`\x19\xeb\xf1/4\xa0\xa8\x19R\a!{\xdf\x00\x043`\a\x0e=*n\x8c}ȉ\xf1\uf322\xd2\xee\xd1#ǖb#\tmq\xbc\xe3\x98S\a\x87\x8d\xf1\xfc\x14ԘЧj\xea\xa7j\xeav4Uw=\x89\xfe\xf2\x9aƯ4i%\x9f\xd9\xf8倪\x82P\xd8eoxQ\xa5\x01H\x8c\x82\xbc\xc7\xdf\xc3C\x88\x8f\xe1gB賈\xad\xf1\x82\r\x80ؘ\xb0\x83\xeb\x12u2\x16]\x03\xb07\x9e\\\xc53\xe6\x11\x13\x86\x1fo\xae\xee?\xde\xd9\x1e\a3\n\x01\x1c\x8aeJUo)\a \x01\x03S$\xa0q\n\x10b@\x88\fCd\x841Zi'\x93\x89cBV\x9a\t\x96\xef\xa8\u007f\x9eeg\xceߗ\xe8F\x1dp\xa5cP@{\x84\xa9\xee\xe8@j\xe4\x10\xb7\xa0=\t0V,a\xec\xa1#\xb3PTL\x80\xb8\xf9\v\xad\xb6pWб\x80\xf41{W\xdal\x8f\xac\xc0h\xe3.пϖ\xa5\xe4W\\z\xa3s\x81珂\"\a\xe3\v\u05cc߃\t\x0e\x06\xf3\x04\x8c\xc5\a\xe4pd\xad\xaaH\v\xbf\x158\x14\xb6\xb1\x83^5I\xb7^\xefH牱q\x18r }Z\u05fe\xa7M\xd6Ȳv\xb8G\xbf\x16ڭ\f۞\x14\xadfƵI\xb4\xaa\x81\x87:0\xed\xe0\xbe\xe3i\xbc\xe4\xfdQ\xa4\xfaT:A\x94)\xec\x9eŵ\x87_\xe5^\xfaw,\xf3xl\x8c\xff\x80\xb7\x88\n\x95\xdb\xcfw_`vZKpʼ\xd2>\x1c\x93\x03\xf8\x02\x8a\xc2\x16y,ܖ\xe3P-bp)Rк\xb0\x9e0\x9cB\x97\xbc\x19Hen\xbfR\x9f\x16.\xeb\xbd\x01\x1b\x84\x9c\x9cQt-\\\x05\xb84\x03\xfaK#\xf8ͱ\x17²*H\xdf\x06\u007f|ݝ*\x8e\xb4\x9e\xc5\xf3]\xb4X\xa1\x85\xb1\xbcKhK\xcd\n\xb8r\x96\xb6d\xeb\x18\xc062<\xf6d\xfby,O\x88>\x0fp{$^\x1a\xd8\xf2\x8d\x06ʭr*\u007f%Y\xa8u\"Ɠ^[\x1d\x99y\x93\x82\x1a\xcd\xf2\xbf8\xd4\x133\t\x9b\x991\xe8d\xa7\xde\x02K\x87\xbe&wd\x8e,\xe7y\x9f\x84\xf3\xb9\xaaԿ\x96\xa1 `\xc2\xd3t\f\xb47\n\x8fȥ\xc5m\xcc\xe5\xee@\a.\x9f\xf1\x9aP\xf48\x16\xa5\x94/q\xb4(Ҟi\x91\xe2\xf0\"\x9aW\xebP\xbe\xf2'4\x1b\x8f\x1d(g\\\xac\x9fa6O';\xa97\xf2\xa2\xd8'I\xdf\x14\x8d%\xde8\xde\xcb\xf8\x16\xf0\n7\xe4\xe1\xdc\xcb\n\xae\xf1\xf1\x85\xec*\xdcp\xdc1\x8a\xbcغ\x19I՟\xddW0Yh\xb83\xd1\xe1\x81qqXU\xe8\xab\xe9AQ7\x00\xea\xaf\xd8\x1d\x81\x15\x8dlv3\xeaC\x17\x1bk1)\xba\xeb\xf3\xe7Ļw'\uf0ba\xb418\x1a_C\xf0ǟ\xcdh\x15\xdd\xfd\x1cG\x11\xfe\x17\x00\x00\xff\xff\"\xf7\xf4 \x8c\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOoܶ\x13\xbd\xebS\f\xf2;\xe4W \xd2&ȥЭuR h\x1a\x04\xb6\xe3K\xd1\x03E\xceJ\xac)\x92\xe5\x90\xeb\xb8E\xbf{1\xa4\xb4\u007f\xb4Z;=to\x1a\x0e\x87\x8f\x8f\xef\r\xb9U]ו\xf0\xfa\x0e\x03ig[\x10^\xe3\u05c8\x96\xbf\xa8\xb9\xff\x9e\x1a\xed6\xbb7\x1dF\xf1\xa6\xba\xd7V\xb5p\x95(\xba\xf1\x1aɥ \xf1\x1dn\xb5\xd5Q;[\x8d\x18\x85\x12Q\xb4\x15\x80\xb0\xd6E\xc1a\xe2O\x00\xe9l\f\xce\x18\fu\x8f\xb6\xb9O\x1dvI\x1b\x85!\xaf0\xaf\xbf{ݼm^W\x002`\x9e~\xabG\xa4(F߂M\xc6T\x00V\x8c\u0602r\x0f\xd68\xa1\x02\xfe\x91\x90\"5;4\x18\\\xa3]E\x1e%/\xda\a\x97|\v\x87\x812w\x02T6\xf3n*s]\xca\xe4\x11\xa3)\xfe\xbc6\xfaQO\x19ޤ \xcc9\x88<H\xda\xf6Ɉp6\\\x01\xf8\x80\x84a\x87_\xec\xbdu\x0f\xf6'\x8dFQ\v[a\b+\x00\x92\xcec\v\x9f\x18\xa5\x17\x12\x15\xc7R\x17&\xae'\xe4\x14EL\xd4\xc2_\u007fW\x00;a\xb4\xcaL\x95A\xe7\xd1\xfe\xf0\xf9\xc3\xdd\xdb\x1b9\xe0(J\x10@!ɠ}\xce[n\v4\x81\x80\t$D\xb7\xc7\r\u0082\bQo\x85\x8c\xb0\rn\x84N\xc8\xfb䧚\x00\xae\xfb\x1de\x04\x8a.\x88\x1e_\x01%9\x80\xe0j%\x11\x8c\xeba\xab\r6\xd3\x14\x1f\x9c\xc7\x10\xf5\xbc\x15\xfe\x1d\xc9o\x1f[\x00~\xc9;*9\xa0XpH\x10\a\x84I6\xa8\x80\xf2n\xc1m!\x0e\x9a `f\xda\x16\t\x1e\x95\x05N\x11vB\xde\xc0\r\x9fF \xa0\xc1%\xa3X\xa5;\f\x11\x02J\xd7[\xfd\xe7\xbe21/\xbc\xa4\x11q\xd6\xc9\xfc\xd36b\xb0\xc2\xf0Y$|\x05\xc2*\x18\xc5#\x04\xcc\xec${T-\xa7P\x03\xbf\xb8\x80\xa0\xedֵ0\xc4\xe8\xa9\xddlz\x1dg\xc3I7\x8e\xc9\xea\xf8\xb8ɶ\xd1]\x8a.\xd0F\xe1\x0e͆t_\x8b \a\x1dQ\xc6\x14p#\xbc\xae3p\x9b\xfd\u058c\xea\u007f{ż<B\x1a\x1fY\\\x14\x83\xb6\xfd>\x9cmp\x91w\xb6A\x91G\x99V\xf0\x1f\xe8\xe5\x10\xb3r\xfd\xfe\xe6\x16\xe6E\xf3\x11\x9cr^t\xb2\x9fF\a\xe2\x99(m\xb7\x18\xca\xc1e\x95qE\xb4\xca;mc\xfe\x90F\xa3=%\x9dR7\xeaH\xb3l\xf9|\x1a\xb8\xcam\a:\x84䕈\xa8\x1a\xf8`\xe1J\x8ch\xae\x04\xe1\u007fN;3L5S\xfa<\xf1\xc7\xdd\xf24\xb1\xb0\xb5\x0f\xcf\xedl\xf5\x84\x16V\xbe\xf1(\xf9\xbc\x984\x9e\xa7\xb7Zf\v\xc0\xd6\x05\x10\agO\xb45Gu\u05fc\x99A\x89\xd0c<\x8d-P\xdc\xe6\x14^\xf8a\x10\xa7-\xe4\xff\xd8\xf4\r\xf7\x01\x9a \x94\xce\xf0]\xb3\xa8wi\xf55\x8d\xaeb\x98\xa5\xca[g\x1e\xd9\xe8\xdcz\x8e\xd1,\x17\xe5\x1f\xda4\xae\x15\xaf\xe1ǌ\xf4\xa3\xeb\x9f\x18\xbdr6\xb2\xa0\x9fH\xb9s&\x8dxc\x85\xa7\xc1=\x999ߩ\xfb{f\x99v\x8d\xdcj\xf1\x12\xa4i\xf8\x1a)\x99ՅV\x858\xff\xf2\xbd\xfa\x1c\xcb|5\xcd,\xf3\x84\xd2q\x11\xf8>\x0f\x16#ҡ\r<\xe88\xc0à\xe5\xb0R\x15\xf2\xb4|@\xdc_\x88\x9c\xd4ٱ\xff\x0e6\xebX\a<\x93G\x9dEs\x16d\xc8\xd5Z\xf1\x85\xe7\xd6\vד\x17\x9eul\xb9\xa0\xbfճ9{&U\xa6\x10\xd0ƩF\xbe\xad\x96\x13\xbeŴ\xb3\xe2\xbf\\\u007f|ҹ\xef\x0ey\xf9\x89&\xb4-8|\xc0\x9at\xcfw+\x8f\xb1w\xb3\xb3\x96\x04\x94\xdf\xf1\x1d\xff\xec\xa9\xe1W\xaf\xc3ѓ\xe5\x02\xb4\xf7\xfb\xb4\xd2XЖ+b\xf9z\xc9\xe5\x90\xf2\xb5+\x85=\xc3\xd6!(4\x18QA\xf7X:\xe3#E\x1c\x97x\xb7.\x8c\"\xb6\xc0\x17G\x1d\xf5\x99P\xf8\xf9):\x83-Đ\xd6U\xb4\xb2Y?\b:\xb3\xd5\xc9>?s\xc6\xda\xf1\xef\xcd\xf5\xc4\xf9Å\x0eV\xc3'|8\x8b}\x0eN\"\x11.\x8dq\x01\xfd\x8a\xb8\x17\xa1û\xfd\xcd\xe1+K\xb1\x9e\xde\xe9y\x00 \xbfz\xd5\x11uӛq\x8a\x1c\x1c#\xa4D\x1fQ}Z\xbe\xd4_\xbc8yz\xe7O\xe9\xac\xd2\xe5O\x06\xfc\xfa[U\xaa\xa2\xba\x9bqp\xf0\x9f\x00\x00\x00\xff\xff]]l+\xe3\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdfs۸\xf1\u007f\xd7_\xb1\x93{\xf0\xf7fB\xea\x92\xfbN\xa7\xa3\xb7\x8b\xddt\xdc\xde9\x9eȗ\x97L\x1eV\xc4JD\r\x02(\x16\x94\xa2v\xfa\xbfw\x16 %Q\xa2e\xf9\xdaK\xf9b\x13\\,\xf6\xf7~\x16\x9a\x14E1A\xaf?Q`\xed\xec\f\xd0k\xfa\x1a\xc9\xca\x1b\x97\x8f\u007f\xe4R\xbb\xe9\xfa͂\"\xbe\x99<j\xabfp\xddrt\xcdGb׆\x8anh\xa9\xad\x8e\xda\xd9IC\x11\x15F\x9cM\x00\xd0Z\x17Q\x96Y^\x01*gcp\xc6P(Vd\xcb\xc7vA\x8bV\x1bE!\x9dП\xbf\xfe\xa1\xfc\xb1\xfca\x02P\x05J\xdb\x1ftC\x1c\xb1\xf13\xb0\xad1\x13\x00\x8b\r\xcd\xc0;\xb5v\xa6mh\x81\xd5c\xeb\xb9\\\x93\xa1\xe0J\xed&쩒CW\xc1\xb5~\x06\xfb\x0fyo'PV\xe6ީO\x89ͻ\xc4&}1\x9a\xe3_Ǿ\xfe\xac9&\noڀ\xe6T\x88\xf4\x91\xb5]\xb5\x06\xc3\xc9\xe7\t\x80\x0f\xc4\x14\xd6\xf4\xab}\xb4nc\xdfk2\x8ag\xb0D\xc34\x01\xe0\xcay\x9a\xc1\x9dH\xe9\xb1\"5\x01X\xa3\xd1*\x99\"\xcb\xed<ٟ\xeeo?\xfd8\xafjj0/\ng\xe7)Dݫ'ρcwk\x00\x8a\xb8\n\xda'\x8ep%\xac2\r(q%1Ě\xa0s\b)\xe0t\f\xb8%\xc4Z3\x04J:\xd8\xec\xdc\x03\xb6 $h\xc1-\xfeFU,a.z\x06\x06\xae]k\x94\xf8\u007fM!B\xa0ʭ\xac\xfeǎ3Ct\xe9H\x83\x91:\xfb\xf6\x8f\xb6\x91\x82E#Fh\xe95\xa0U\xd0\xe0\x16\x02\xc9\x19\xd0\xda\x03n\x89\x84K\xf8\xc5\x05\x02m\x97n\x06u\x8c\x9eg\xd3\xe9J\xc7>\x94+\xd74\xad\xd5q;M\x01\xa9\x17mt\x81\xa7\x8a\xd6d\xa6\xacW\x05\x86\xaa֑\xaa\xd8\x06\x9a\xa2\xd7E\x12ܦH.\x1b\xf5]\xe8➯\x0e$\x8d[q\x1bǠ\xedj\xb7\x9c\x02\xecI\xbbK\x80\x81f\xc0n[\x96\u007fo^Y\x12\xab|\xfc\xd3\xfc\x01\xfaC\x93\v\x866O\xd6\xdeo\xe3\xbd\xe1\xc5P\xda.)d\xc7-\x83k\x12G\xb2\xca;mcz\xa9\x8c&;4:\xb7\x8bFG\xf1\xf4\xdf[\xe2(\xfe)\xe1:%4,\bZ\xaf0\x92*\xe1\xd6\xc256d\xae\x91\xe9w7\xbbX\x98\v1\xe9\xf3\x86?\xacCC\xc2l\xad\xddr_(F=t\x94\xfbsO\x95\xf8K\x8c&\xfb\xf4RW)\x05`\xe9\x02\xe01yy\xc0v,5\xe5\xc9Ua\x1e]\xc0\x15\xfd쪃$\u007fB\xa6wc;z\xa9\xa4\xb6\xe54\xa5\x8e5p\xa6<b\t`\xfa\xad\x9b\x9a\x02\xa5\x1d\x818\xeaJ\x02ɱ\x8e.l\x85\xad\xec'U\x1e\xed\x1f5\xba<\xd6):+\xff\x9dS4&\xael\x84Xc\x8e\xc9{\x972#\xb4\xd6J\x168{\xb1\x00ީ\xb3\xe7w\x9c\x11\x02-)\x90\x95\x8c\xca\xc5ǻT\xa2\"j\xdbg^.\xdd\x10݉\xf9\x16\xd9\xc0\xa4`\xe8\xe8sΆ'\xeb\xf1\xa8\xa4?\xdd\xdf\xf65\xb87R's<>\xf1\xacE\xe4YJ\x97\xb9\xc7X?{\xea\xd5\xed2\x1f\x93*Rt\x80\xe05U4(\xed\xa0-GB\x95\x17GX\x02H\xe2\x06\xea\xe8_\xe7\xfaӕ\xb9};\x10[\x03\xe6\xfe\x06\u007f\x99\u007f\xb8\x9b\xfe\xd9eYGybU\x11\v\x1b\x8cԐ\x8d\xaf\x81۪\x06dQA\aRs\xf9R6h\xf5\x928\x96\xdd\t\x14\xf8\xf3\xdb/c6\x03x\xef\x02\xd0Wl\xbc\xa1נ\xb3\x95w\x05\xb5\x0f\x10\xcd\xd9\x10;~\xb0ѱ\xd6㊣\x04R\xa7\xf0&)\x1a\xf1\x91\xc0u\x8a\xb6\x04F?\xd2\f^I\t9\x10\xf1\x9f\x92\r\xffz5\xca\xf3\xffr\x92\xbe\x12\x92WY\xb0]\xcf<L\xa2\xbd\x809\x93\x82^\xad(и5S#\x90\x02\xfb=\xb8 \xba[w\xc0 \xb1\x15\x9f\xe5BG\xeaD\xe0\xcfo\xbf<!\xed\xd0N\xa0\xad\xa2\xaf\xf0\x16\xb4\xcdV\xf1N}_\xc2C\x8a\x88\xad\x8d\xf8UΩj\xc7d\xc1Y\xb3\x1d\x97\xd6A\x8dk\x02v\r\xc1\x86\x8c)2VQ\xb0\xc1\xad\xe8\u07fbK\"\f\xc1c\x88C42\xca\xf5\xe1\xc3͇Y\x96JBh\x95*\xa9t\xb9\xa5\x16\xcc!`#wN\x89\xc9d\x8e6\aGtP\xd5hG\n+$В\xac\xbbl\xa5\x97\x95W/\xcd\xd6c\xd8\xd0?#\xf0\xe1\xb80\xfc\x8f\x9a\xf0Ej%\xd4\xfe\xacZw\a\xf1|V-\x99\x1f\x82\xa5HI3\xe5*\x16\xa5*\xf2\x91\xa7nMa\xadi3ݸ\xf0\xa8\xed\xaa\x90@,r$\xf04\x8d\x00\xd3\xefҟߤEB早\x92H\xbf\x85>r\x0eO_\xacN\x8f+/\xedJW\xf3\x0e\xf9\x1c\uf514\xd8Ժ\xaa\xfb!a_=Gs\xa4A\x95K.\xda\xed\xef\x1e\xb6b\xc86\x88<ۢ\x1bC\v\xb4J\xfeg\xcdQ\xd6_l\xb9V_\x90\xa4\xbf\xde\xde|\x9b`n\xf5\x8b3r\x14\x10\xe7\x98\xf0\xeeV\x89\xf9\x96\x9a\xc2Y8\xf5q@\xda\x03\xbb\x11$\xb9\xa3\xb9\x18\xc9E\\\x9d\x00(T*]4\xa0\xb9?\x03\xb2\xce\xe8<\x10\xfe\x01W\f\x18\b\x10\x1a\xf4\xe2\xa7G\xda\x16\xb9I{\xd4\xd2c\xa5\x8dvxeA\x80\xde\x1b=\xd2N\xbbV\xdc\xc1\xc5\x0ey\xcbX\x8b+\x1e\xd7w\xc4\xeay\xf7Yk\xe7\xf1b\f>wGg\\\xb2\x83\xd0\xd1\xed\x81\xeai\xfc\x9e\x00\xd7'\xec&S\xa0\xa0\xabCъ\xf1\xd1e@!\x90~\xb0\xe0\x9d\x1a\xbc\x0f\xe3l\xf0)\xeb\xf3\xec\xf4\x161\xb6|\xf1\xfc\x96\xa8{\xeb\xe5z\x10;\x1e\t+\xfc\x96\t\xaer\x82\x1d\x87\xd7T\xe7\\x}J\x9f.D\x82\xcabE\xddH<v1\xb4A\xeeO8\x1d\xc2\xe0\x80Yޗ\xea\xae\xf0\"\x95\xa0\x9d\xa0\xce%jC\n\xfa;\xb2\xe3='<\x0fy,h)\xa5\xaa\xf5ơꇢN\xb4\xfe\x92\xe7A\xa6\xe1t\xdfp\xc5Orl\x99T\x9a\x92G\xd4?n\x0fK\x17\x1a\x8c3P\x18\xa9\x18ah[cpah\x061\xb4\xc7\x1f\x9fL\xfd\x86\x98qu>\xbd~\xc94y>\xec6\x00.\\\x1bw\x03\xe2 ů\xb8\x8b\x9e˧ӑ\x11l\x18\xb2(\x80\x99;\xf8hL\xdaq\x98\xd6\xfbK\xd4$ς\xc4-\xffi\x86\x03\xf8\x1a\xf9\xbcq\xee\x85b,yv5\xe8L\xf6@\x9a\b\xdb\xe6\xf8\x84\x02\xeehs\xb2vk\xef\x83[\x05\xe2\xe3\xd0(\xfa\xf89Q\xb6\x80\xf7)\xce/ַ;\xe0\xbc\xca\x1d\x11\xd4\xce\xf4\xe9\xe9\"\x1a\xb0m\xb3\xa0 z/\xb6\x91xX\x84Og\xfe4E\xec\x8dv\xb0\xbb\xbfB\xc8|\xba\xa1\xa8B\x9bn\xd9$g\xa2\x03\xa5\xd9\x1b<\x9d\x8az\x15\x12\x92\x90\x94\x91\x94\xdeGk\x9f\xa6\x9eB\xfa\xf4\x92[\x8a$͍\xb3\xa3\x18\xb7\xcfOm\xe3\x1f\xfe\xffIġm\xa4ՠ\xa8w_ŀ\xef\x84\xff\u007f\x9b\xf7\x93\x8d\x95-z\xae]\xbc\xbd9\xeb\xed\xf9\x8e\xac\x8f\xf2=hI\xb5+\xdd\xfbuD\xbdˇ--?9\f.N=\x8e\x18\xe2e\xcdc> }\xa6o$\xbe\xa4J\x98\x93ǀ\xf140\xd3}\xf0\xf5\xf1\xaf,\xaf\x81u\xba\x16\x13\xec\x93\xc1P\x1euYډ@;\x17r\xac\x9er\x1c4\x82A\xe1\x1f\x8a\xfe-j\xfeH<\x1c-\xed\u007frz\xb3\u007fKqYt?1\xa5\x0f\x9dZ\xea\xe0\xf0\xeeV\xb5[\xd9\xc3\x10\xac\x04\xb2\x93\xba;\xfe\x91\xe9U\xbe*\xe9\u007f5J\xaf\x95\xb3\x19\xcd\xf2\f>\u007f\x99@w\xd7\xfa\xa9\x97C\x16\xff\x1d\x00\x00\xff\xff\x81\x16-\x05\x9e\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s۸\x11\u007fק\xd8\xf1=\xb87\x13R\x97\\\xa7\xd3\xd1\u06dd\xddt\xdc\xde9\x9eȗ\x97L\x1e b)\xa2&\x01\x16\xbb\x90\xacv\xfa\xdd;\v\x90\x92(Q\xb2\x9c\xe9\xa5zI\b,\x16\xbf\xfd\xed\x1f,\xe0I\x96e\x13՚O\xe8\xc98;\x03\xd5\x1a|f\xb4\xf2E\xf9ӟ)7n\xbaz\xbb@Vo'O\xc6\xea\x19\xdc\x04b\xd7|Dr\xc1\x17x\x8b\xa5\xb1\x86\x8d\xb3\x93\x06Yi\xc5j6\x01P\xd6:V2L\xf2\tP8\xcb\xde\xd55\xfal\x896\u007f\n\v\\\x04Sk\xf4q\x87~\xff\xd5\x0f\xf9\x8f\xf9\x0f\x13\x80\xc2c\\\xfeh\x1a$VM;\x03\x1b\xeaz\x02`U\x833h\x9d^\xb9:4\xe8\x91\xd8y\xa4|\x855z\x97\x1b7\xa1\x16\v\xd9u\xe9]hg\xb0\x9bH\x8b;Dɚ\a\xa7?E=\x1f\x93\x9e8U\x1b⿏N\xffb\x88\xa3H[\a\xaf\xea\x11\x1cq\x96\x8c]\x86Z\xf9\xe3\xf9\t@\xeb\x91Я\xf07\xfbd\xddھ7Xk\x9aA\xa9j\x92i*\\\x8b3\xb8\x17\xa4\xad*PO\x00V\xaa6:\U00091c3b\x16\xedO\x0fw\x9f~\x9c\x17\x156*\r\x8afעgӛ(\xbf=\xefn\xc7\x004R\xe1M\x1b5µ\xa8J2\xa0şH\xc0\x15B\xe7\x15\xd4@q\x1bp%pe\b<F\x1bl\xf2\xf0\x9eZ\x10\x11e\xc1-\xfe\x81\x05\xe70\x17;=\x01U.\xd4Z\x82`\x85\x9e\xc1c\xe1\x96\xd6\xfck\xab\x99\x80]ܲV\x8c\x1d\xc3\xfd\xcfXFoU-$\x04|\x03\xcajh\xd4\x06<\xca\x1e\x10잶(B9\xfc\xea<\x82\xb1\xa5\x9bA\xc5\xdc\xd2l:]\x1a\xee\xe3\xb9pM\x13\xac\xe1\xcd4F\xa5Y\x04v\x9e\xa6\x1aWXO\xc9,3\xe5\x8b\xca0\x16\x1c<NUk\xb2\b\xdc\xc6p\xce\x1b\xfd\x9d\uf09f\xae\xf7\x90\xf2F\xdcF\xec\x8d]n\x87c\x90\x9d\xe4]b\f\f\x81\xea\x96%\xfc;zeHX\xf9\xf8\x97\xf9#\xf4\x9bF\x17\f9\x8fl\xef\x96юx!\xca\xd8\x12}r\\\xe9]\x135\xa2խ3\x96\xe3GQ\x1b\xb4C\xd2),\x1a\xc3\xe2\xe9\u007f\x06$\x16\xff\xe4p\x13\xb3\x1a\x16\b\xa1ՊQ\xe7pg\xe1F5X\xdf(\xc2ߝva\x982\xa1\xf4e\xe2\xf7\x8b\xd1P0\xb1\xb5\x1d\xee\x8bŨ\x87\x0e\xd3\u007f\xdeb!\x0e\x13\xd6d\xa1)M\x11s\x00J\xe7A\x1d\xc9\xe7{\x8aǒS~\vU<\x85v\xceΫ%\xfe⊽4?\x81\xea\xe7\xb1\x15=,\xa9p)Q\xb1S\r\x94$\x0fT\x02\xd4\xfd\xd2u\x85\x1e\xe3\n\xa9R\xa6\x90Prd\xd8\xf9\x8d\xa8\x8d\xa6\xe8\xfc`\xfd(\xed\xd1P\xa7\xcf\xc2\u007fp]\xd0{,ѣ\x95\x90N\xd9ߺX#X\x19ۇ~*\x9e\xc0\xee\b\xfd\"\xa1\x1d\x83v\x8aj8Y\x0fG\x81\xfe\xf4p\xd7\xd7\xc0\x9e\xd1\x0e2\x1f\xeex\x96\x10\xf9\x95R\xe5\x1f\x14W/\xeez}W\xa6mbE`\a\nZ\x83\x05\x0eJ+\x18K\x8cJ\xa7\xc1\x11\x95\x00\x928\x1e;\xf97)\xff\xbb2\xb3+\xc7B5\xa8t\xbe\xc0\xdf\xe6\x1f\xee\xa7\u007fu\t\xeb\xa8NU\x14H\xa2F16h\xf9\rP(*P$&\x18\x8fz.3y\xa3\xac)\x918\xefv@O\x9f\xdf}\x19\xe3\f\xe0\xbd\xf3\x80Ϫik|\x03&\xb1\xbc-h}|\x18JDl\xf5\xc1\xdape\xc6\rW\x12G\x9d\xc1\xebh(\xab'\x04\xd7\x19\x1a\x10j\xf3\x843\xb8\x92\fރ\xf8oI\x9d\xff\\\x8d\xea\xfcCJ\x91+\x11\xb9J\xc0\xb6g\xd6~\xc6\xed\x00r\xa5\x18؛\xe5\x12=\x8e\xb3\x19\v\xb1\x14\xb8\xef\xc1y\xb1ݺ=\x05Q\xad\xf8,\xd5\x19\xd4G\x80?\xbf\xfbr\x02\xed\x90'0V\xe33\xbc\x03c\x13+\xad\xd3\xdf\xe7\xf0\x18#bcY=\xcb>E\xe5\b-8[o\xc6\xd1:\xa8\xd4\n\x81\\\x83\xb0ƺ\xceR\xaf\xa0a\xad6b\u007f\xef.\x890\x05\xad\xf2<\xec\x06F\xb5>~\xb8\xfd0K\xa8$\x84\x96\xb1\x8e\xc9)S\x1a9\xf3\xe5\xb0O'\x97\xc4d\xa4#\xa4\xe0`\aE\xa5\xecHY\x83\xd84Dv\xcb gI~\xfd\xdal=<\xb6\xfb\xdf\xc8\xf1}X\x18\xfeO\x87\xe0Ef\xc5\xd6\xf9E\xb3\xee\xf7\xe2\xf9\xacY\xd2\xc4{\x8b\x8c\xd12\xed\n\x12\xa3\nl\x99\xa6n\x85~ep=];\xffd\xec2\x93@\xccR$\xd04\xb6\xe1\xd3\xef\xe2?_eE\xec\x8c/3%\x8a~\v{d\x1f\x9a\xbeڜ\xbe\xaf\xbb\xf4T\xba\x9ew\x8d\xc7\xe1JI\x89ue\x8a\xaao\xd2w\xd5s4G\x1a\xa5S\xc9Uv\U000fb1ed\x10\x19\xbc\xe0\xd9d\xdd]0SV\xcb\xff\xc9\x10\xcb\xf8\xab\x99\v\xe6\x82$\xfd\xed\xee\xf6\xdb\x04s0\xaf\xce\xc8ц4\xc5D\xeb\xee\xb4\xd0W\x1a\xf4g\xbb\xa9\x8f\x03Ѿ\v\x1c\xe9\xe3\xb62\x177rdUK\x95\xe3\xbb۳\b\xe6[\xb1~\xf7\x1d\xe5]\xfb\xd6k\x92\x10=ӷ\x9dD\x92ԜE\x91\xfa\xee\xb1.\xb8Ð:\x868\"\x1d\xe8W!\x91됴9\xfbH\xb2\xf1\x0e~ \xd1:=\xf8\x1e\xfaw0\xb5#}0\x9c\x8cx\xf12Ê\x03]~\x9d\x89\xe2=g)?\xb9S\x12\xcf\uebfa\xd0\x14N\x9a\xb9\xe1\xe3\xcd9\xcf\xdd\x1c\xcb\xc7\x17\x02\xaf\x13.6\r\xc6\xdbBD\x00kE\xfd\x16\xc7~\x83=mia\xac\x84\xa2\ful\xb6\xa4\x0f,\x95\xa9Q\xc3\xf6\xe9\b\x1e\xe5>\x17\xaf\xcc\xd7ǵ\xb2W\x13\bu\xbc\xe7\x8d\x00>\\U:\xdf(\x9e\x81\\\x933Qp0oC]\xabE\x8d3`\x1f\x0e'O\xa6A\x83Djy>\x0f~M2\xe9\x86\xd5-\x00\xb5p\x81\xb7W\xac.!:\xf3\xaf\xa9\xf3\xf8\xe5\x17\xbcJ\xd1y\x10\x0f\"1\x16Wۤ<\x17X\x10o/\xa19\xdc\"\x83{\\\x1f\x8d\xdd\xd9\a\xef\x96\x1e\xe9\xd0\aY﨣\xf6;\x83\xf71\x02.6\xb8\xdb\xe0\xbc͝\x10T\xae\xee#ױ\xaa\xc1\x86f\x81^\f_l\x18\xa9g\xa0O\xf4\xe3\x1bj\xecyw\xbc\xed\xd6\xf7\xd5*)\xea:\xf8B\xd9\xf8$#\xd1\xc9\x0e\xb4\xa1\xb6V\xc7-|oC<\xf6$8%Cvq\xd1g\x97\xa4t\x9c{͝:¹uv\xb4#\xebS\xc1X\xfe\xd3\x1fO\x9e\x8f\xc62.\a\xa5\xb0\x9b\x15\n\u007f\x16\xfd\xffk\xdd'\x0f_b\xe5\xf9\xb2\xd25\x1f\x88\xbeT\xb5\xa2ⱚ\xb5_~\x8e\xcb\xcdp\x93oQiF\xa89\x18\xda=ؿ\xdd}E\x17e\xdd\x03}\x9c\x80d\x96\xdeۼ{\x8c\xeaFv\a\x96*\xa4\xd7B}\u007f\xf8B\u007fu5xp\x8f\x9f\x85\xb3ڤ\xbf.\xc0\xe7/\x13螨>\xf58d\xf0\xbf\x01\x00\x00\xff\xff\x98\xaaEc\xdc\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xcdn\xe36\x10\xbe\xfb)\x06\xdb\xc3^*y\x83\xbd\x14\xba\xb5i\x17\b\x9a\x04\vg\x9bK\xd1\x03E\x8d\xeci(\x92\xe5\f\x9d\xbaO_\x90\x92lٖ\xbd\xc1\x02\xab\x1b\x87Ùo\xbe\xf9!\xb5(\x8ab\xa1<=c`r\xb6\x02\xe5\t\xff\x15\xb4i\xc5\xe5\xcbO\\\x92[noj\x14u\xb3x!\xdbTp\x1bY\\\xb7Bv1h\xfc\x15[\xb2$\xe4\xec\xa2CQ\x8d\x12U-\x00\x94\xb5NT\x12sZ\x02hg%8c0\x14k\xb4\xe5K\xac\xb1\x8ed\x1a\f\xd9\xc3\xe8\u007f\xfb\xa1\xfcX~X\x00\xe8\x80\xf9\xf8\x17\xea\x90Eu\xbe\x02\x1b\x8dY\x00X\xd5a\x05\x01YH\a\xf4\x8eI\\ \xe4r\x8b\x06\x83+\xc9-أNn\xd7\xc1E_\xc1a\xa3?=@\xea\xc3YeC\xab\xd1\xd0.o\x19b\xf9}v\xfb\x9eX\xb2\x8a71(3\a$o3\xd9u4*\x9c)$\a> c\xd8\xe2\x1f\xf6źW\xfb\x89\xd04\\A\xab\f\xe3\x02\x80\xb5\xf3X\xc1c\x82\xea\x95\xc6f\x01\xb0U\x86\x9a\xccH\x0f\xdey\xb4?\u007f\xbe{\xfe\xf8\xa47ة^\x98,;\x8fAh\x8c1}\x93\xfc\xeee\x00\r\xb2\x0e\xe4\xb3Ex\x9fL\xf5:Ф\x8c\"\x83l\x10\x86\xbc`\x03\x9c݀kA6\xc4\x100\xc7`\xfb\x1cO\xccBRQ\x16\\\xfd7j)\xe1)\xc5\x19\x18x\xe3\xa2iR\x19l1\b\x04\xd4nm\u9ffde\x06q٥Q\x82\x03\xc5\xe3GV0Xe\x12\t\x11\u007f\x04e\x1b\xe8\xd4\x0e\x02&\x1f\x10\xed\xc4ZV\xe1\x12\x1e\\@ ۺ\n6\"\x9e\xab\xe5rM2V\xb4v]\x17-\xc9n\x99\xeb\x92\xea(.\xf0\xb2\xc1-\x9a%ӺPAoHPK\f\xb8T\x9e\x8a\f\xdc\xe6\x82.\xbb\xe6\x870\x94?\xbf\x9f \x95]J\x1bK \xbbދs\x95]\xe4=\x15\x19\x10\x83\x1a\x8e\xf5\xf8\x0f\xf4&Qbe\xf5\xdb\xd3\x17\x18\x9d\xe6\x14\x1cs\x9e\xd9>\x1c\xe3\x03\xf1\x89(\xb2-\x86>qmp]\xb6\x88\xb6\xf1\x8e\xac\xe4\x856\x84\xf6\x98t\x8euG\x922\xfdOD\x96\x94\x9f\x12ns_C\x8d\x10}\xa3\x04\x9b\x12\xee,ܪ\x0eͭb\xfc\xee\xb4'\x86\xb9H\x94~\x9d\xf8\xe98:V\xec\xd9ڋ\xc7i1\x9b\xa1\xd3\xfe\u007f\xf2\xa8S\xc2\x12k\xe9 \xb5\xa4s\x0f@\xeb\x02\xa83\xfdrbx\xae9\xd3W+\xfd\x12\xfd\x93\xb8\xa0\xd6x\xef\xf4\xa4\xcd/\xa0\xfae\xee\xc4\b+\x8d\xb8\xbeQq^\xf1\xc42\x80l\x94L:T\x14\xd9}\x9b\xcf\xc4q\x91\xf2L\xbbJ\xedj\x95\xd5\xf8)\u05ceջ\xab\xb1<\xcc\x1cH\xa1l\xdc+\xb8V\xd0NM\x8e(k<\v\"D\xfbf\x90\xfdL\xbekRi\xb5\x84\xe1*\xc0Չ\xf2\xc8s\x1b\x8d\x19,\x15\xdau^\t\xd5\x06\xc7Fn]8\x83H\xbd\x8d]\xdf\xd5\xdf\xc6\xef֙\xd8\xe1\xfen\xb8\x8a\xfc\xf9XwZ \xbd`\x00\x91B\x80p|\x05N\xbf\xa1&\x18\xbck\x06\x00C\xd1r\x8a\xf3\x8d\xd8Sr)\xe0\xd14,\xe6\x8b\xffHc\xae\xa2\x8e\x14N\xb3y\xb4y\xc2\xd7W\x87\x81(\x89\xfc\xf6q\x90\xd5Gbu\f\x01\xad\fF\xf2M\xf8M\x03\xc1(\x96I[\xa47\xd0\xd5<ߟ돐\x92)\x90$\x98vѫ\xe2\xb9~i]\xe8\x94T\x90F{\x91\x0e\x9d\xec\xa7\x17\x98\xaa\rV !\x9en^\x9e\bȬ\xd6\xd7#x\xe8u\xfa\xabp8\x00\xaavQ.\x10\x9b/\xc5+\xd4^E\xe47\x8a\xaf\xe3\xf9\x9c4\xe6Ҋou\x8e6v\xa7.\nx\xc4\xd73\xd9\nUs\xdas\x05<:\x99۸\x10\xd3L-\x9f\x88\x0eO\xec\x9b\xc3*\xd7]1<\xa9\xf3\x06@~\x996\x93\x14sߛ\x83\xe4\xd0 Jk\xf4\x82\xcd\xe3\xe9\x93\xfaݻ\xa3\x17r^jg\x1b\xea\xff\a\xe0Ͽ\x16\xbdUl\x9eG\x1cI\xf8\u007f\x00\x00\x00\xff\xfflC\xbf\xee\x8e\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfbs\xdc6\x92\xf0\xef\xf9+P\xda\xd4'\xe9\xa2\x19\xd9\xfb\xaa]\xd7֗\xd2\xdarV\x95XVY\x8a\U000e5cb9\x1c\x86\xec\x99\xc1\x89\x04\xb8\x008\xd2\xec\xe5\xfe\xf7\xaf\xd0\x00\xf8\x98\xe1<\x00J\xb2\x92\x10Wu\x1b\x8d\xc9&\xd0h\xf4\xbb\x1b\xb4`\x1fA*&\xf8+B\v\x06\xf7\x1a\xb8\xf9K\x8do\xff\xa2\xc6L\x9c.^N@ӗ\x9f\xdd2\x9e\xbe\"\xafK\xa5E\xfe\x01\x94(e\x02o`\xca8\xd3L\xf0\xcfr\xd04\xa5\x9a\xbe\xfa\x8c\x10ʹ\xd0\xd4\xfc\xac̟\x84$\x82k)\xb2\f\xe4h\x06||[N`R\xb2,\x05\x89_\xf0\xdf_\xbc\x18\xffa\xfc\xe23B\x12\t\xf8\xfa\r\xcbAi\x9a\x17\xaf\b/\xb3\xec3B8\xcd\xe1\x15\x91\xa0\xb4\x90\xa0\xc6\v\xc8@\x8a1\x13\x9f\xa9\x02\x12\xf3\xb1\x99\x14e\xf1\x8a\xd4\xff`\xdfq\x13\xb1\x8b\xf8`_\xc7_2\xa6\xf4\xd7\xcd_\xbfaJ\xe3\xbf\x14Y)iV\u007f\f\u007fT\x8c\xcfʌ\xca\xea\xe7\xcf\b)$(\x90\v\xf8\x96\xdfrq\xc7\xdf2\xc8R\xf5\x8aLi\xa6\xcc?\xabD\x14\xf0\x8a\\\x9aY\x144\x81\xf43B\x164c).\xd1\xceK\x14\xc0Ϯ.>\xfe\xe1:\x99CN폄\xa4\xa0\x12\xc9\n|\xceϏ0E(\xf9\x88\xeb3\x93\xc0\x8d zN5\x91\x80S\xe1Z\x11=\aB\x8b\"c\t~\x85\x88\xa9\x03I\xaaw\x14\x99J\x91װ&4\xb9-\v\xa2\x05\xa1DS9\x03M\xbe.' 9hP$\xc9J\xa5A\x8e\x1d\x98B\x8a\x02\xa4f\x1e\xb1f4H\xa9\xfame\r\x87f\x91\xf6\x19\x92\x1a\xe2\x01;UG\x02\x90\x12\x85\b bJ\xf4\x9c\xa9zI\xb8\x8c\x06Xb\x1e\xa1\x9c\x88\xc9\u007fC\xa2\xc7\xe4\xda\xec\x80TD\xcdE\x99\xa5\x86\xe2\x16 \rJ\x121\xe3\xec\xdf\x15de\x16h>\x99Q\rn\xa7\xfd`\\\x83\xe443\xdbS\xc2\t\xa1<%9]\x12\t\xe6\x1b\xa4\xe4\rh\xf8\x88\x1a\x93w\xb8%|*^\x91\xb9օzuz:c\xda\x1f\x9eD\xe4yə^\x9e\xe2\x11`\x93R\v\xa9NSX@v\xaa\xd8lDe2g\x1a\x12]J8\xa5\x05\x1b\xe1\xc49\x9e\x9dq\x9e\xfe\xaeڬ\xc3\xc6L\xf5\xd2\x10\x94Ғ\xf1Y\xf53\x92\xf6F\xbc\x1b\x12\xb7\x94c_\xb3\xf3\xaf\xd1k~2X\xf9p~}Ӥ*\xa6\xda8Gl7\b\xadF\xbcA\x14\xe3S\x90v㐶\fD\xe0i!\x18\xd7\xf8G\x921\xe0m\xa4\xabr\x923mv\xfa_%(C\xbabL^#\v!\x13 e\x91R\r\xe9\x98\\p\xf2\x9a搽\xa6\n\x1e\x1d\xed\x06\xc3jdP\xba\x1b\xf1M\xce\xd7~\xd0b\xab\xfaٳ\xa8\xce\x1dr\xa7\xfb\xba\x80\xa4u2\xccKl\xea\x8f\xf1T\xc8\xd6\xe17\xaf\x8c\x1b \xbb\x8e\xa5\x19\xf6l\x1b\x16\xd4\xfe}e\x12\u007f\xaf\x1e3\xb4b>_r\xf6\xaf\x12\x90\x85\xda3\t\xeb\xecB6\xd8is\x18\x12\x18\xaf\xfcډA3\xe0>\xc9\xca\x14ҊM\xaa\xad3=_{\x1c\x85\fe\xdcиa\xeaf\xba\xbc\xfeWd\x90\xb4c\x96\x86\xce\x18\xb7\xd0\b\xe3\xb8\xc4\x0e̚\xc14\xe4k\xd3ڲ&\x82R\x8bN2xE\xb4,W\xbfmߣR\xd2e'*\xbc\x94\xdd\x0f\x13\xd5\xd3\xee\x98g,\xc1-\xab\x0e3\"㗄\x87\xb9\x10\xb7\xdb\xd7\xfe\x0f\xf3D͍H\x82\xda\t\x99\xc0\x9c.\x98\x90n\xb5N$L\x80\xc0=$\xa5F\t\xbc\x02\xb5D\xa6($)\x84қֽ\xe9t\x91\xa6T]\xff\xa7\x8d\b[[\x8fc\x02~+\xcd\xf2Z\fAp0s\xcc\r\U000eb7d5\xa2\xb4Ϫ\xce/\x90MX \x13\xaa %\xc2\xedu\x99\x81r_J\x91\xd1ԧ\xe7d\x03\xe0j\xd1VVft\x02\x19Q\x90A\xa2\x85\\\xc5\xden\x1cڱ\x9b\x13l\xc0^\aOp\xdc\xd3\xf1\xd2&;\x10\x1ba\x12r7g\xc9܊1C\x83\b\x85\xa4\x02\x14\x1e\x12\xa3V-\xbb\x17G\xb6\xef\xb5\x1d[\x8eI=\xb6\x1e\x98UX\xebG\xa7\x1e;\x99I=v\xb0\x956.k-\xf27\x83J\xcf\x1d\x83\t\xf3b\xedŇ$LT\xf3\x8d*z1%\x90\x17zyB\x98\xf6\xbf\xa2:\x8f\x96\xd3F\xf4T\xdf\xfe\xc5mD(M_\xac\xbe\xf7\x804\xdds\x17\xaaO\xffb6\x01\x99\xfd\xb5\xe3\xf5{n\xc07\xcdwN\b\x9bV\x1b\x90\x9e\x90)\xcb4ȕ\x9dض\\\xb1}'\xfa\xa2`\xb7\xa42#\xa7:\x99\x9f\xdf\x1b\rD\xd5\x0e\x8f\xbd\xb0\xb1\xfa\xaaUܼ\xee\xda\x16\xa6[\xa1\x124\x9e\x98\x84ܚd7\x88\xc1\xfa\x17\xa3\uf473\xcb7\x90nF\nه\xc2֖p\xb62\xcd\xe6g\x9d\x1e\xba\xdf\x02\x9c\x92R\xe9\xf0ּ>!\x94\xdc\xc2\xd2j\x17\xc6\xd8/@R\xf3\x19\xf3\xf0N\x88\x12\xd0\xc6G\x82\xba\x85%\x02qf\xfb\x8ew\xf7\xdbz;na\xb9\xfb\xa1\x15\xb4\x99\xd98\x03\xcb\xe2\xcf\xfc\x80\b@\x93o_\x94\x11t\xbax\x0e\xb3kQd_\x16\xe1\x87\xc7v\xf0\xf2\xaamj8\xa4p#\x0f\x95\xdd\x14C\xedsV\xec\xb5@\xf4G)\xc03\xe1\x9d.\x1fi\xc6\xd2\xea3\x96\xbe/\xf8\t\xb9\x14\xfa\x82oRV\xdb\xe3\xfc\x9e)3-\x9e\x927\x02ԥ\xd0\xf8˃#\xd1N9\x18\x85\xf65<Bܲa\xb3\xfe\xa6\xeff'\x11\xdbqa\x8d\xf6jK\x98\"\x17\xdc\x18\x11\x16W\xd6\xfbf?\xb6\x8d۷G^*t\xcep\xc1G(\xec\xc6]\xdfq(ޓ\x90\x9b\xbb\xb0>\xad\xea\x93\xf6s{A\xbc1r\xc1\xbem=\x89\x19M \xf5\xb6\x1ez¨\x86\x19KH\x0er\xb6Y\x104Gax\xf6>\x9fߋ\x97\xda\x11DO\xfb\x88f?\x1c3NwMcd\xce\xe6\xceg\xfc\xd6\xeex\xb0\xd3\xf5\xb5\xf9\xc1]\xeb@!\x89z\xc3\x0el\xd24\xc5H\x04ͮ\xf6\xe6\xde{c~]n\xdb)Y\x19\x97\xd3\u009c\xce\xff1\xa2\n\x89\xf6\u007fIA\x99\xdcyB\xcf0\x9c\x90A\xebM\xe7zi~\xc4\xc0g\x8a\x98\xdd\\\xd0lՁڱ,a\xb8\x06dV\f\x8b隦qB\xee\xe6BY\xa98e\x90\xa5\x84mӴ\xcc8\xb8\x85\xe5\xc1\xc9\xda\x19?\xb8\xe0\aV<\xaf\x9dX/\xcbw\x00\x16<[\x92\x03|\xf3 ^uً\xea\xf6x\x88w\xb8H\xeb\xd1\"\x83\xa6\x9b\xb4\xf6\x8f:Ut\xf3l\xf7\xa0\xb9B(\xfd\x8f.\xe7׆\x99\\\xf9\xe7\xdb\x1ad\x877i\x87e\xe3<C\x15\x8b4Z\xd7T\x83t\x0e1\xcb6\xbdn\xde\xc3R\xd9\xe5\xf4\xaa\x1c^Ի\xe2\x10\xa9[)\xc0\xba\xc6wOn\u007f\xed\xce`#H\x1b>\xbfo\xf8\xea\xcc\t4\u007f7\x17\xf0\x90zg\"\xf2\x9c\xf2\x9d\xbc}m\x92\xaf\xed{\x9er\x1d\x18\xbb\xd7rV\xe2\xa9\xdbW1\xf3\xf4\x82\xc1\x9e;\xa6\xe7\x8c\x13\xea\x0f>HG<\x94\x14b\xdd\xe5\xda5\xe6T\x91\t\x00\xf7H\xdbq\xe8\xedx<I\x9b3~\x81\xc0\xc9\xcb\a\x95ˤFQ\xc4\xf6y\xe4V\x1bX\xfd`%ǾȾ\x9b\x83\x84\x16\r\xac\xbb\x88Q\xaf\xe3B7\xec\xf4\xfd\x10m\xe7q\xa8ȔI\xa5\x9b\x93T\xa4T\xfbml\xd0n\x99\x19߰\x1cD\xa9\x83qz^\xbfۊ\xbd\xe5\xf4\x9e\xe5eNh.ʝB\xd7\x0e#\x03X^\x05\xc9\x1cF\xef(\xd3Ƞ\fT\xf4Tha\xb0^d\xa0\xf7\xd3;'05L$\x11\\\xb1\x14\xa4\x0f\xd7\xda}b\xc2\x1c\xbb)eY\xb9\x1e\xb4\xe8\x1aaf ?\x972\xc2\n|o\xdfk\xf8\xd8\xe6⮍\x98=\x97>\xa7\v lJ\x98&\xc0\x13\xb3\x17 -\x83\xc5\x0f8$ Jv\xea1v\xecÌ\xcd\x00^\xe6\xfb,|\x84\xe7\x92\xf1-\xee\xa4\xe6\xc3o)\xdb\xe6\f\xf4#h\x9b\f\x8d\xc5\x1e\x80\xef\xeaw\x9f\xe0\x00\xd4\xcc`\xab2R\x8f\t\x90\x0f@ӥ?\x05Tkc\x06\xe2\x8e\v\"K\xde\xe4b\x0fL\xff\xfb\xdbP\xee\xfb\x0fe\x1e1\xcevn\xe4\x8aw\x9b\xe9\xa6\xf6a\x00<\x9a\xf6a\x80W\xa2(ܽq\xd1z\xdd\b\x05\xaf\xb4\xe2\xac+\n\xd9[\x13\x99\x801\x00!\xb5\xee\xa2BTf\xbeM-\xe9\f\xe7v\xaek\u007feb\xc5\x11\xeaL\xb9f\xd2U\x83\xd0\xf7\xf1Wڱ\x14%\xb9\xa3\\{ҮԪB\xecE\xdba\xfbh\a\x95\xb3\xbd\x9f]\xcb\xe8\xf2J\xa3O\xac\x02\xae\xe5\x12S~\xf6\x9b\xae\x1d\xc6\xf0KErkT\x84\x9c\xce\xe0\xf0P\x91\xd7\xef\xdex}\xc1\xb0\xff\xbd\xb9\xbb\x1d\xcc\xc6\x18\v)\x16,5\xaa\xccG*\x19\x9dd\xc6\xc0\x9c\x82\x04\x9e\x80\"\x9f\x1f}<\xfb\xf0\xd3\xe5ٻ\xf3\xe3\x00\xd0\xc6(\x85\xfb\x82rCq\xa5\xf2Ҹ\xdao3y\xe0\v&\x057\xa8\t\xc1\xc3ŔP\xb2\xf03M\xaa<(c\xd8d\vHO\\|ĭ \x04\x1f\x96M2^\x94\xda{\x12\xefX\x96a\x96\x15O\xe6\x94\xcf\f\x96n\xe6!@\x1b\xf8#j\xc95\xbd7sF\x15R%\xb4\x80\x14\xe9\x97\xd0\x00\x90\xa9(\xcd\xd2?\xff\xfc\x840xE>o|bL\xce\x1d\xd4z\v\x03 \xe3j9,@Z\x1d\xd7n\xe0\t\x910\xa32\xcd@)Á\xee\xe6\xa0簟\xd3\xd2\x0e\xeb\xfap[\x06\xde\xebi\xa8\xaf+\x93-\x00pG\x96\xdbm\x95\x929f\xe24\x15\x89:\xd5TݪSƍH\x19\xa5T\xd3Q\x83\t\x9dZ\x890r\xd2i\xe4m\xbcQE\xac\xa7\xbf\x93%\xe7\x8c\xcfF\xb4z\x8a\xf1\x11\x1d\xa99d\xd9\xe1\xde\xd3\r`\x9d\x0ema\xd6X\xf3\xa5\xfd]\xd5A\x86\xb2\x1dm\xfev^\xb13\xfb\xd51\xb9\x14zs&\xd1\xe6Q1r\xc4븓\xe3\x9d_\xde|\xf8\xfe\xea\xfd\xc5\xe5M\x18\xa3k\xb2\xc8͌/\x00f7\x8b\xec`|\x81\xc7d#\x8bl3\xbe\x00\xa8;Y\xa4c|A\x9cr'\x8b\x8c\x14\x1c\xdbXd\x83\xf1\x85\xccu\x0f\x16\x89k\b\x809\xb0\xc8\xdf\x18\x8b\x04\xbe\x88d\x8f\xdf8\xb5\xbdq\x94\xab}\x0e\x11\xcdZ`\x8c\x97\xf16\x97\xe8E\x1c\xc1\xd8n;\x85\xf8\xe2#m\x87\xb0ys\x99\x01pIM\xfa>S\x15\x05Ae\x01\x85\x10|\xb8vo\xc7\xf6\xc8F\xf7X\x8fw\xb8\x1c\xf0X<\x90\x06.\xc6䝋\xe9R\xf2\xfa\xa7\x8b7\xe7\x977\x17o/\xce?\x84 \x83Ğ\x11\xe2C\xf3\xbdPr\xf8p&\x85\x1d\x1b\f\x8bB\u0082\x89\xb2J\xcf\r\x86\xdby<\xd7N[\xf8t1p\xb0$\n\xe4\x82%\xd0\xfd\x99\xd0\xfd\xdc\xc3\x06\n\x86إ\x10\xb4\xc4|0\xc4\aU\v\xec\xd8C9\b\x86\xf9\bV\x94\x1d\xbbm\xa9`\x90\xb5b\xb1A]\b\x86\x88\xea\xc5\x1b\x98\xd22\xb3\xfe\x89\x83\x83\xf1\xfe\xd2ڎ~\xec\xe5\xad\x14{9\x90\x9b\xa3\xc5b\xaem\xf1\x86\xf7\x9d>\x04\xe3=t\xe9u-\xe1j\r\x88\b\x98Y\t\xde\xe2\b\xc8ͩG\xac<#6\x8c6e\xb3w\xb4\xf8\x1a\x96\x1f`\x1a\x0e`\x15٘y\xe7\x92հ\xc00\x02\"1r\xddN+\x9c\xf5\xf5\xc3\a\xd9?\x1f\xb1k\xb4pq\xe3\xb2&Q33h\x89Y\f\xe9s\x80\xfc\x88\xd1\\\xfch\x8b\xeb\xa6\n\xe3x_\xf4\xb2\xf65=\x12\xc1\x13(\xb4:\x15\v#%\xe1\xee\xf4N\xc8[cK\x18\xce>\xb2\x91\x00u\x8ai\xf8\xa7\xbf\xc3\xff\x89\x9e\xd1\xcd\xfb7\xef_\x91\xb34%\x02\xd9h\xa9`Zf6\xc5'B\x0e\xfbQ\x17\xf6\x9e`\x99\xe9\t)Y\xfae(#\xf5\xa37=\x88\xc2\xe6y=\bM\\ctr\x19aҶ\x87!\xa9\xea\xdc\x1bӖi\x85\xe7'/U8\xab\xf6c\x02\xd1*\x9f\x9b\x16\"{\"D\x06\x94G\xc0\xd87\xfc\xd55\xf6I+\xec\x1a{\x87Ⱥ\x06\xd2\xfaCȂ\xc3Z\x18\xd8\x149\x11.\x1dI\x9d\n\U0004aa32(\x84Ԫ*\x18\x1e\x9b\xc3\x1e\xae˒F\xcd\xf1\xb8\xaa\xde9!\xffU\xfd\x889\xe5\xea\x87\xc3ÿ}}\xfe\xfd\xff=<\xfc\xf1\xbf\xe2\xbeRCltt\xe8\x0fV\x15\x90\x8c\xb9H\xc1\xb0\xe3\x13\xfb\xa7\xb3 \xce\x12\f\xef_F#Fi\xaaK5\x9e\v\xa5/\xaeN\xfc\x9f\x85HW\xffR\xc1\x8a y\x00\xe1\xdc\xdd\"!d\xb4hԷR\xb0\"-\x9a˸\x9e\v\x86R\xb1y\xc5\x15\xd5s\xa3\xd3\xddI\xa65İ\r;\x8c\x9d\x052WDLO\f\x1f\xab\xd5\xf0\xc5˃O\xa6NL\xfd\x12\x1fd\v\x10WN\xa5@\xc8\xf1\x12\xc4+Z\xde>\xadr\xae\xa2A\x9e]]\xf8\xd6\x1a\x9f\b\xdd\xfd\xe4G\xb5UO-E|\x1a\xe9\xdbG\x90&\x1ev\x9c\xee3m\xbbl^\xd9\xfc\xe9}\xea\xe56\x8f\x8ca\a\x0e\xcaӺ\vǑ\xfdq\x9c\x14e\x1c'v\xef\xe7\x90\v\xb9<\xf1\u007fB1\x87\x1c$\xcdFJ\vIg\x91l\xdeO\x13\xa7W\xffe?\x16Ǚ\x1b\x8b_\x9fe\xb83\x878o^RJcedK/\xff!\xfd$\x92\xa7\xa2\x98\xae& \xfb\x8e6Iש\xa8},\xb4\x9aG\xa0\x93c!\xb22\auRi\xf9\xd1`\r4\xe0\v\xb2\xa0R}2[%e\v\xa6\xf6K\x9e\xec\x1a\x94/\xdfG1\x1f\x82\xfc\xd3N\x9fq\r\xb3h\xd3f\xd4\x1f\t\x9d&\x97/\xba\x16\xa5.\xcax\vi*dNu\x15\x97\xb8/\x84BǦoL\x11\r\xb8\xa5\xaf\xbc<\x88\x84SP\xadA\xf2W\xe4?\x8f\xfe\xf9\xc5ϣ\xe3/\x8f\x8e~x1\xfa\xeb\x8f_\x1c\xfds\x8c\xff\xf1\x1f\xc7_\x1e\xff\xec\xff\xf8\xe2\xf8\xf8\xe8臯\xdf}usu\xfe#;\xfe\xf9\a^\xe6\xb7\xf6\xaf\x9f\x8f~\x80\xf3\x1f\xf7\x04r|\xfc\xe5\xe7\x91\x13\xbe\x1f\xd5>\x8c\x11\xe3z$\xe4\xc8n\xfd\x8er\xe9m\xc3o\xc7\xc3\xf0\x9d\x0f^\xa7\xe8'JIS\xe7\xfaD\f\xa2\x9fz\xd4c\xf9\xbd\xb4#\x05\x89\x04\xfd\xbc|\xaevN\x8d\x1a\x88CU\xb7\xb6\xf8\x15\xb8a\xfb\x9ax\x16=\xb5\x8d\x81\u0379\b\x86`\xfbx\xa7\xa8me\xe8\xe1\xdfB\xb0\xffߏ\xc1M<\xb8\x89\x9b\xe3\xd7\xeb&\xbe\xb6ge\xf0\x11\u007f\x1a\x1fq\xe4\xab1\xab\x1c!S\nI\x83\x8a\x99[T\xbeWX`\xba3\xe7\xabn\x96D\nQ\x94\x19ձ\xf1\xe9\xcd))c/\x00cr_\xea\x8c[\x1bD\xcf{\xe7\x1b\x9de\x19a܊<\x9c\x94O\x03\x91`m{B\x15\t:D\xb0\x00\xae\r[\xe1\xab՜\x8a(M\xa5f|6&\xdf̓ܰV\x97ry\x13\x8c\x93\xbc\xcc4+2 U\xbb\xbe\xaaZ?\x04\xaaR\"aT\xfb\xa4\x14۾Fi\x8f^ą\xa6\xb7!0\v\t\t\xa4\xc0\x13\xc0\xae.e\xa3\x05\xe1dI('\xe7|\x81_\vZ}Z\xda\xe4N\xab:U\xf3j}\xcd\xe6>\x04\x80\xfd$)\x88昺\x14\x90vw\xe7 \xa6\xe76\xc8(\u05fe\x95N\x15\xab\fQ#b\x95\xe2*O#\xc2`Xӆ\xeb(k\xa5͆G\t\xa5ȟ0O%V5},\xb5\xf4y\xa9\xa4\x8f\xa0\x8e>\x9c*\xdaK\r\xed\xa3\x82nS?\xa3M\xc1\xfa\xecxY\x18\xaf:\xf6Q\x1b\xa3շB\u0094\xdd\xf7\xe2!g\xbc\xda\x17\xc2R\xe0\x9aMY\x84Fo\xb4\x1e\t\x05p\xac9\x05\x9a\xccm[7\xdeN\x05\t\xa7\xdfO\x9c\x15m-\xf9\x87`\xd4\xd7]>\x87\x81\xeb\x0e\\w\xf7\xf8uq]w\x10~\x91,\xf7\x89,R\xac\x80\x8c-\xd1|Ө\xa2\xc4S\u07fc\x1f\"`\xad\xfb\x9cʺ5\xc1)~/\xe4\xf0aCB\xdfo\xad\x16B\xb69\xb0\xb8#s63d\x96\xc1\x02B\u009eV\xbb&9\xe5tf[\xbei\xe1\xc3WDHb\x18\x89diPQem\x86\xe2\"\x8dX3l(\x134m\xdc\xe6\x13\xb2\xf8\x8c\xdd\x02y\x03E&\x96\xae\xb3\x1bOɵ\xa6ڰ\x9dk\xd0!\tY\x11\xec\x01\xd7qUfٕ\xc8X\x12\xe0\x9bo\x93\xda\x05\xd2XQf\x19)\x10И\xbc\xe7(\x1fβ;\xba\f\x8a7^\xc2\x02\xe4\t\xb9\x98^\n}eM\xbbv\xb5\x82\x05\x19\x00\x91M\xc9+{\xaf\r\xd1t\x86.\x84\xba\xbf\xb2\x90\xadO\x05\x80E\x01q\xc7\x14t^\xcc\xf2tG\xedw\xf8M#\n\xedߏJ0\x19\x9bB\xb2L\xb2X\xaet\x96`\xbad\xddַq>\xd5Ri\bQ\x85\\\x1b\x1dtb0l\x8fV\b\xae\xc0\xb6\x91\xf2G\xb5\x9aq\xa8\xfbI\xf5*\xb3\x8cS\xd1\n\xa1\xf4\xb5\xa6r\xaffE\xf5h\x9f\xc6+\x0fĐzB\xb3\fR\xc2\xf2\x1cRF5d\xa1~e߭\xae\xe5\x83ë\xc8\\#\xb4p\xf9?\xa7<\xcd@bo.\xe7ukA\xd7 s\xc6iX#\x01R\xa5+\xa1\x83\x10RB\x93D\xc8\xd4\xf5C\xf2\x1do\xa8\f\xf5\x8bT\x1c\r\xb5\x9d\x06\xbd\xaef\x9d\x05\u009dd\"\xb9U\xa4\xe4\x9aeu\v4\xdf\xff\xcc]\xa2\x15\bs\u007f=\xba\xc1F\xaa\xff\x1cUge\x84\xb7̜\xfe\xae\xfe'\xfc!Li\x8d\xb7R\xf6\xe91\xb9>V\xfa\xac\x01\x92\x03&\x02\n\x0e\xf1\xa1\xe2\xa90j\x88!\xa3\xba\x13`%@\xc6\xd8&/\x02j\xfb\x8e\x05\x8al\x11{\x05\xd1۽Z2\xb5G\xbf\xb8|p/\x90\xe6أ\x8dfd\x04.c\x1c\x9a\xfd4\x19v\xf9k\x9f\xb9\xd8L&\x03\xc4Y\x90$e\x12;\xcb/}=a$L\xdf0\x12\xfbj\v\xa1\xc9\xd1\xe1\xe9\xe1qx\xa3\x8d6L\xdf\x19\xc4\xe8\xc8\x19X\x19\x19ڏ\xa8k\x96F\rby\x91-\x11\xbf\x87\xe9\ta\xb1\xd1VW\xe8(K\xee\xf7ȵs9!j\xbf^v\xebCK\xea;W[X\x06\xb4\x96\xa5\xd5\x1f\"\x81\x1e\x1d\xfe|xB@'\xc7\xe4N\xf0C\x8d$0&7\xc2\xd8\xf9\x910\xab\xa5.EI8\xd8fkp_d,a:X\xda\xfaa\xc46\x11\xa5\xb6\xed\xc3\xf0\xa2*l\x8fs~\x1f\xbdK\xb6\xce\xc3\xf0\xc1\x17x>\xad\b'T\x91\x8c-\xe0t\x0e4\xd3\xf3\xd8\xf9\x1a\x8a\u208f\xfe\rR`\xeb\x1d\xee\xe0\xc5\xf9L\x82#D\xcd\xd1;G\"\xdcP_}7*\x04o\xc4\xf6W\x10\xa8\xfa\x91\xb5\xdb\xdfnn\xae\xbe\x02\xdd\x160\x11h0\xb3\xf1\xb9\xdf\xe8\xd6\x059\x15r\xed\xea\xc2ݣ\x9fl\x9a\v\x15\x81\x11\xb2~'\x9eҶ\x1f\xb95\x0exL|\xcc\x0e-\xdae;.\xb3\x8e\\\\\xc5&\t}/J\x83\xa5\t\x9dd˪ˡ\x02M\x0e̴c\x93l\x19\xc7=\xfc\a\xd0\x14\xbbIr\xa5\x81\x06u\x11\xaaG\xcf#\u0558\xc7C(\x19\xf6>ù[؞\xedR\xd7G\xa3\xb5\x8e\xa3\xf31\x9e\x1e\xebw\x8a\x951\x12\n\xcbX\xdd\xfc>\x01\x03\\\xe3\a\x16\xf7\xee\xf7I\x8f\x1c9\xea/\x93\xb4\x8bs=FKգ\x1a\x8bq\x8bts\x00\xa2g\xd67/\x95\xf4̔$]\x91\x1e\x8b\xa3\x1e\x10]U^h\xba\xd4\xeax\x80J\x85ȶ@\xcd\xf1x\xe8\t\xcd\xd8Y\x1d\x0f\x80\x9f>\xc9~$&%\xae\xfdr\x1f\f\xf4\xcay'=\xb5%,\x05\x89,9]/8Ղ\xd0$\xc1n|\xb1\xe5\xb9F\x18 ;»\xeb\x83Z\x905\x80\xf4#\xa8B\x84\xfa\xff\xfc\xe8Q\x18\xf5\x10eQ\x0fP\x14\xd5\xd1[M\x12^\xe6\x13\x90\xb1\xad\x06|\xb3\x01\xa9[\x04\xb2\x92Q\x19\t\xfa\xd2N\xcd\a1\xbd:A\xf9\x9e7g\xad\x8f\x97f\x96\u007f\xfeӟ\xfe\xf0\xa7\xb1E@\x95\x9f\x19K\xd3\x17g\x97g?]\u007f|\x8d}\xae\xe2\x16\xfa\b\xf5OX^\x1f)Q\xda\xf1h\x04d\xb0V*l\t\x15\xefj1V\x81\xf3\x17[\x87\xacjĞ\xa2\xcd\x05d(\x9f\x80\x93\xc4\v\xa5\x11\x1e\x97\xa7\xb4}uR\\\x8b\u4db7\xf5{x\xf3\xfa\xca\x02\xaa\r\xe0\b\xccS\xee]\xb2\x8c/D\xb6\xb0w<ݼ\xbeB\xc4\xc4\xec\xa5y\x17}\xe8\xe8*[\x9a\xf9\xf9\xcag\x9bt\x12\x01\x93养͌\x12\t4cJ\xb3\x04\xbf\x14\x13\xf4\xf2\xc3\xcc2<;\xe5YX\xf9\x87\xef}\x92Km\xf0\xc7\x1f[\xc7\x10\xba\f\xfeX3ź\t\xe2\x8a\u007f\x06\xad⁴\n\xa7MH\u007f?ݠUČ\xe7\xa8U\xfcr$^䋅\x84k-\x8a^\xd9\x01\x16ă\xe4\x06\xf8\x9b\x876\x85\xefI\x1a\xbc\x89\xf6\x96γ\xab\x8b\xca\xf7,ZAwL\xcd\b\x84\xa9\xcad\xee\xe3\x1c\x1c\x94:\xc54\x80\xb2\xb0>'\u007fEXh(\xb1\x90\x80\xf7-\t~R՜#\"\x80\xdb\x1fA'\xa1\xe7\x02\xfd\".;\xc2E\xd5\xfc&\xf5K6H$Us\xc0\xee\xf2p\xcf\xea\xebЩ\x12܆=ݦ\xb1`ә)RP\xa5l\xe0K\xd7\v\xb0\x9f\xb8\x12\xe9\xe1a\xa8\n֘\f\x99I\x9a\x00)@2\x91\x12샖\x8a;N&0\xdb}\x8b\xea\xeap\xf4j&鏁\xd1v\x00\xa3\xa1\xd5\xed~\x81@?\xb4.\x01p\xcd;\x12Q\xe7G;|\x84\xd2W;-\x06˵\x90\xf8K\x9ae\xcb\xfa\x90\x05Bu\xd5\u007f\xbaښud\x87\x9e\x03ܚ'Ϗ1\xa4\x8c\xff\x16\x81֍\xf4\x857b\xd3d\x1eN\x05\x81i\xecC\xfa;cH\xbf\xd9:\x86\xf4\x1b?\x86\xf4\x9b!\xfdfH\xbf\x19\xd2o\x86\xf4\x9b\xd6x\x16\x8e\xb9!\xfdfH\xbfY\x1dC\xfaM\xf0\x18\xd2o6\x8f!\xfdf\xeb\x18\xd2o\xb6\x8c!\xfd&|\f\xe97k\xe3\xd7\x16(\x1b\xd2o~\xad\x81\xb2!\xfdf\xbf\x97\x87\xf4\x9b\x9dcH\xbf\x19\xd2o\x86\xf4\x9b=\xbe=h\x15C\xfaͯ[\xab\xf8\xe5H\xbc\x1e\xfd\x9b\x82^\xf2\x19'WRL\xa2\x1b9]al\x9a%.]EL\xa3B\xea~*\xe3\xfa\x82\xf5F\x9f^\xdf3#\xe8\xb2[{\t\xb7O\xa1\xe9\xec\x97\x12\xda\xc4b\xff\b\xbao\xbc\xa4N\va\xff_\x1d?o\x04έ_k\u007f\x96\x1f'H\xc3#\xe6\xfbD\xcb\xeb\xd8wh\xc2ӦHy\xb4V\xd67J\x1e\xaf\x9fDG\xc7\x1f'2\xfeXQ\xf1\xad\x11\xf1fl;\x02\xf6Z4|S\\;F\xb1n\xcc\xee\x81b\xda[\xe3\xd9\xcd\xc8t\x8cٻ\x16\xcb^\x8bJG@mƱ;#\xd2\x110\xeb\x18\xf6\xa6ht\x04\xd0\xf3{\xa6\x1f/\x12\xfd\x80Q\xe8\xe8\x00L/e5֗\x1a\xa9\x87\xb8\xc4ӛ\xb9\x045\x17Y \x8fk\xf1\xb7w\x8c\xb3\xbc\xcc\xcd\xc1V\x861\xb1E\x95\xd7\x1a\xca1<ϱ\x92݆\x98\fX\x96\x02^GGY\x16ޘ\v\x9b\x88\xcd)Z\xf2\xaaL\x12\x80\xd4ȤF_\xbf@\x88\u007f\x18Wk\xaen\xdb\u007f\x19Fg\xf6\x924\xb4\x8e\xfe\xf0\xfb\x88\xfd\x0e\xb7\xaa\xa2R\fv\xa7\x17 \xdc@\xfc\xf5M-\x88\x17\xe8qΆ\xc7H'ؒJ@\xbe\x17e\x8c\x95\xbf9\x8d`%! F.Ʀ\x10\xf4\xe0\x89\xbdR\a\xb6\xa7\r\x18\xdcDaac\xca@\x15\xfc\x8fq\x81Ŧ\vDK\xaa\xc7I\x13\u061c\"@X\x9c\xaf\xa1_z@\xdfԀ\a\xbb\xbf\xac\x8ey\xf7\xbc\x91\xba\x8fW\xb3\xaf'\xadW\x1a\xc0㠣\u007f\xf0\xfb\x13\xdd\x13\x19\xb9\x8f\xf1\xe1\xfe^\xa1\xfe\xf80\u007f\\\x88\u007f{x?\xd2\t\xdf+\xb4߃X\xe2\x9c\uf44e\xf7\xbeN\xf7\x9e\x0e\xf7\xed!\xfcȍ{\x04G\xfb\x16';\xba\xcb#@v;\xd8\xfb\xba\xca\x1f\xd8M\x1e\x1bx\xdf\x1eto\x84ϣ\x14ᎀ{|\xe8<\x9a~\xe3\x18zD\xf0 \x92\x153\xce4\xa3\xd9\x1b\xc8\xe8\xf2\x1a\x12\xc1\xd3@\xadf\xe5\x12\x95\xeaT*\v\xcc\xda\xc9\x11\xaeٺNpN\xdd\ry\x90\xfarG\xef\xf9\x0fe\x9a\xa8\xf2\xe1u\xfdv\xdd+}\xed?\xa5\x97\x9e|\x12\xf3\xdd\x16\t\xf6\xdf\xf8\u007f\x88;\"\xa6\x1a89b\xdc\xef\xfdq8\xcfs\x86{\xed\xad\xa9\x0e\xaf9\xbb/_x\xd0\xc1\xb5\x8c\xbf8\xc7\n\xba\x94\x94z,O\x9a\x03\xffЮ4\avZ\x86z\xb2[\xee4\xeb\x90k\xf3\xed\xc0\r\xab\xaf\xd7z\x89s\xf6\x1c\x03=\xba\xaeX\xfe\xd7OD\x91IP;\x13\xa0\xeat\xa6@\x14v&?\xb5S\x99\x02!v$>u\xa71\x05\xc2m%=E\xa40}Ro\xe2\x03\xa5-mOY\"\x85\x88\xb1\xb1\xa3ҕ\x06Ki\xaf\xb1=-i\xb0\x94>\xad\xa5\xf4\xdcm\x01\xcdr\x10\xa5~6f\xc0ݜ%\xf3\xa6\xb6\xc1rPD\x94\xf1)\xd4F\x8fpS\xea\f\xb6=\xee\x055\xbf\"\xcb!\x82\xc2\xc2\xdc\xde\x1d>\x9f\x95\xde+u\"P\xc0z\xa9\"\x94\xbc\xb9\xbc\xfe雳\xbf\x9f\u007f3&\xe74\x997[=qB\x03\xc5\x1a\xf2\x9a9]\x00\xa1\xa4\xe4\xec_\xa5\xbd\x99\x90\x1cU_9~\xa2;\xc8#$\x87\xe1,\x01\a\xbd\xb5)\xdf0\x85\rq\x10\x86kQ \x14\x84^\xfeږ%\xe4\xdc\x00\xb1\xfa!ʝ9H 3\xb6\b2T\fL\x9b\xffChZ5}0\a՜\x12&8\xa1\x13Q\x06\xb1\xc69\x10\x0eڜ\xe0\xca/%\xb8j\xf5\t+\x15\x04]\v8)\xf1:\xb3B\xb2\x9cJ\x96-\x9b\x13\xa4٘\\\n\xafq/\xc3t\x81&\xea\u07bc?\xbf&\x97\xefoH!\xb1ՒͶ\xc1\u007f\x0fܨ\t\x98m\xb1\x9b\x9c\x8e\xc9\x19_Z0\x96K3E\x8c\x9a\r<l\xaaN\x99\xf0\x97X\x1e\xbc\x18\xe3\xff\x1d\x98}\x93F۰\xe9RA\x8bO֒A\xad\xe6\xc2&\x99\xa5\xce@=\xc8\xed{\xaf\xbb\xf3\x82C\xaa+\xa9~nEW\x06\xe1\x12\n{\xb3\xa3\"4\x88\xd5{\x02\xc6mCVgNZ\x16\xa9\xcb\xc5\x1a8Is1\xbd\ue7ae\xb5\f\xaf\xa2Z\xea\f\xd6\xf2\x1c\x15\x16\"=T\xe4\xe2\xca\x13\xdf\xd8^\xe4j8|0H\xbc\xd7{A3\x96\xda\xc9\xd9p\xc5\tyA\xfeF\xee\xc9\xdfP]\xfds\xa8>\x1a/\xe5\xe3]\b\xd6\x1e\xbd\xb8\xea\xb5S\xdf\x19\xa6c\xe0\x18\xecjA&\x8c\xa7Q\xd6\b\xdck\x90\x86\x99\xbb\x1d\u007f\xb2\xdb\xd2\xcd\xe4\x9f\x1d\xc1\xda\xe8\xc6Ŵy\xfb\xab~^$K\xcc\xf4\xfe!\x94\xbeţ}W\xad\x99m0DT\xb9r\xaa\x93y\x9b3\x1a\xf5]\xe9\x9a\xc1\x84CN\x05\xe6\xe9\xda\x14\xd79\vv3\u007f\x9a\x03\x1a\x93PҢˇ\xa4\xa0\x15\x93\x1b\xfd\xadN/\xb6\x8d\x1a\xc3}?\x965;e\xdd,6m\x88\xb0\x18'\xd4\x06\x9d\xddy\x0fb\n~\xeb\xd2-\xc3\xe9\x12\xcam\r\xca\x14\xa4\xb4\xfd\xbb&\xe1\xd9\xc7\n\xe4\x82%\x10L\x84\xd1<\xae\x90B\x8bD\x04ߧ\xdfN\xacp@\xd0\xebnݻ\xef\"i\xe9\xdb7W'\xe4\xe6\xf5\x15^i}\xfd\xfa\xe6\xaaOv-!\a7\xaf\xaf\x0e\x9e\b\x991\xae\x9eQ[5\nz\xd3o]\x88I\xf34\x17\xfe\xaf\xf8Ќ\x910\xcai1\xba\x85e\x80\xe2\x18\x8b\x9b\b̬O\xd7.:\xa7\xfb&$K\xa0){&5r\x8e\x89\xd4s\xea.\x96\xcb\xc5\"ȏ\x82f\x94\x87\r<-\x043\xf6\x88k\xe9ܬ\xa0\v\x00\xba\xf5\xce\xf9\xa1\x82n\xa8\xa0\xab\xc6PA7T\xd0\r\x15tC\x05ݞc\xa8\xa0\x1b*\xe8\xf6_\xe8PA7T\xd0\r\x15t[\xc6PA\xb7s>C\x05ݶ1T\xd05\xc6PA\xd7\x1eC\x05]\xe0\xcbC\x05ݐ\x17\xbac\f\x15t\xcf9/t\xa8\xa0\xdb6\x9e{\xd6\xecPA\xf7L\xbc\xf4d\xa8\xa0\x1b*\xe8\x1ac\xa8\xa0\x1b*\xe8\xaa1T\xd0m\x1cC\x05\x9d\x1dC\x05݆\xf1۵\x94\x86\n\xba\xe7e)=w[`\xa8\xa0\x1b*\xe8\x82\xde\n\xa20\u007f%\u007fl\xc5\xd6\xe1k\x91\x17\xa5\x06\xf2\xc1\x03\xaa\x0eTX~*f\b7\x8a\xb6\x9e\xb2Iz\"\xf8\x94\xcdJ\x89eR\xa7\xf6n\xf6Qb\x176\xaa04\xaafw\xfa\xd8i^\x19\xcbYH\x11\x9d\x19uU\xdaU\xb4\x92\x13%_\xfbI\xd7^\xb2\xb5\xa0Z\x83\xe4\xaf\xc8\u007f\x1e\xfd\xf3\x8b\x9fG\xc7_\x1e\x1d\xfd\xf0b\xf4\xd7\x1f\xbf8\xfa\xe7\x18\xff\xe3?\x8e\xbf<\xfe\xd9\xff\xf1\xc5\xf1\xf1\xd1\xd1\x0f_\xbf\xfb\xea\xe6\xea\xfcGv\xfc\xf3\x0f\xbc\xcco\xed_?\x1f\xfd\x00\xe7?\xee\t\xe4\xf8\xf8\xcb\xcf\x03'\xfa\xa0\x12\xab}\x00\xbfAZ\xa9\xa3yȚszo\xb8h\xe8\xf6\xe7\xa2\xe4ڦ\x85\xdaS]\x11\xbf\x8d|>Ņ\xff\x8fu\x12I\xbc\bv1\xe0\xe1@\xee\x1cÁ$\x87\x1f\x1c\xb5\xac\x1eI\xab\xd8<\xe0\x91\xf4\x826\xf4L^LI5G\xa6\x88ș6V\xfaT\xc8f\xa5khr)\xd3-SԱ%\xccަX\x94\x1c}\xdd|\xa3\x8eH\xe89\xc8;\xa6\xd0\xc9Ey\xedS@\x861Ja\xcaxpZ\x06\xaa\x9a\xc1\x1e\xe7\xe7Ȫ\"^R\x90\x94\x92\xe9\xe5k\xc15\xdc\a\xd8\xe4m\xa2\xbfv`\x88(l\xb6\xab\xcfq\xb2)\xe2!̶\xe4X\xd5\x15\xbc!\x85\xc8X\xb2<\xf5\vB\xccý>\r\xf8\xf6~_\xd4T\xdd\xd6\xfb\x0f#c2\xd4ۼ\xf6\xfd\xc7V\x16Q2_I\xb6`\x19\xcc\xe0\\%4C\x9a\xecc*\x9em\x80\x19x\xb2\f\n\xa4\xc8\x14\xb9\x9b\x839\xb9\x84\x9a5\xa2\xc3\"\xa1\x9c\xcchp\xaaPnv\xa8\xf0\x133df\xb8\x80V\xa4\xa0\x12\xb8\xf6\xe0CY\"\x16eO\x84\xc8\\N|\xb6\xac\xe7\xee\nP\xb8\xf8\x89\xc3\xddO\xe6\xdb\xc1\xee\xf9\x8cΪ\xc2\x18\x05z\xcd[\x13;\xedM\xdbdӭK 4\xbb\xa3\xcb\xd0\xe9\xde\xcdau~L\xbd\"/\x8f\xf1lRE\xaa/\x86r\xda\xdf\x1fc\xdc\xf0\xf5\xd9\xd5O\xd7\xdf_\xfft\xf6\xe6\xdd\xc5e\f[4;\x05A\x97\xc2%\xb4\xa0\x13\x96\xb1p%l-\x9b\xa9\t\n\xc5P\x9a\x9e\xa6R\x84&\xc6\"\x96e\xc99\xe3\xb3F}q\x9f\\\xe5f\xdb\v$\xb3i{\xb23Iyx\xd6\xe2d\xb9B\f\xb2\xe4\x9a\xe5OV\x98CӾE9gi\ni\v\x15\xc1\xf0\x1e&\xfb\xf2\xb5\x9f²\xee\xb8\x11\x01\x93\x90\xab\xf7\xd7\x17\xffo\x85\x12\x97E|\xb2\xd8\x13\xd71\x10b\x0eL\xcf]\xfd`+\f\x87}\xed\x1c\xbf\xa4\xfa\x94J\x9e\xf7\x89\xa7\u007f(y\xbb\xebV\x11+\xa5r\x91\u0098\\Y\x91\f\xaa\r+\xbe\x15\x04\x95@\f@\xae\x19Ͳ%1\xd6ۂf`\x13\xf8\xb1v.X\xc1\xeaΦ\x9a\xd2L\x05\xb2\xe7X\xb9j\x14\x97w\xc6D\xed\xb1s\x15\f\x92\x02\x17\xda\xd9\xcb\x11t/\xa6\b\x8bX\x9b\xb9\x91\xb4֒_\x11\xcaa-V\x99\U00098faaf\x8d\x11\x91@\x98\xa5\x02\xd5-V++:\"\aD\x02M\xb1\xb6\xb7\xa0zn\xb3*r\xaan!\xb5?Di\xc5\xce\xcb`g[-\xfafY\x00\x99\x02\xd5eph\x06\xb5a\x9b\xa3\x02\x9cN\xb2P\aFt\xfb\x04\x9a\xbe\xe7\xd9\xf2\x83\x10\xfamU\x8aڃl\xbfs6M;ra\x14\xdcPƀs\x1b\xe1\xc6!\x1bhT\xcazj\vuƨ\xa7d\x02\xb2\xe4g\xea+)\xca@\x91\xbe\xa6Z\u007fu\xf1\x06yai\xed\x0f\xe0Z.\xb1\r@8#趯ȷ\xe6ܹ\x93\x16\xaa\xb2x\x160%%W\xa0\xc7\xe4\x1d]\x12\x9a)\xe1ͺ`k\xf6\n\xb3\xfc\x9a\xfe\x971\xba\xe7,02\x11:\x94\xaf\xac\x80C\x16\xb0\xfe\x95PߞA\xa6\r\xc8V\xbe83\xbf\x15\xa8\xa1@\xe9-(RHH \x05\x9e\x04\xd2j#\xb6\xfa\xe7?>I\xda\x16R\xf9\xa5\xe0\x86\x81\xf4\xa0\xf3\v\x9e\xb2\x84Z)Gu\x9bNC\x15\x95Rio\x93S\xac\x88F\xf6Q*\x90\xd8\xc2K\xcb\x12b\xb6\xfa\xebr\x02\x19h\xeb\xb2\xc0\xee]T\xdb\xd6\x03,\xa7\xc1\xb7\xbbS]\x896-\bpUJpNaMR\x011\xf9en\xd1\xdf^\xbc!/ȑY\xf51\x92\xfa\x94\xb2\fK\xfe4\r\xbe(}\xc5\xe31\xf5\xd3CT\xe2\x89'\xc1]\x9c\x90\t\x9f\x10.\x88*\x93\xb9\xc7%\x13\xbcr\a\xb9\xdcڈ\xc8\xda\x1a\xf3\xd9\xc4NB\xdd\xed5\xf3\xf9\xed\xb0\x93^\xa2\xef[\x05\xb2\xa7\xe4\xfb\xf6\xd1%_\xbc[\xc9\xf0\x93\xf6N!\x1b 9h\x9aRMî\xc3G\x88\xbc\xd1/f \xe4\x15\xa0\xbf0\xb9\xa8\xe0\x1b\xc6\xcb{\x9b\xdc\xda\u05f9z}\x8e\xc0\x88\v\x9eX;!T\xe0\x14E\xc6l\x8b\xbc\x95NЖ\x91W\xe1\xc4^\x02\xc2\xcb4d\xe44˄\x11\xea\xe1\x9a?\xe5\xa9\xc8זm\x8c9h\xf5\x11\x1f#\xc7\x0f\x85?\x1c\xab\x1ah\xafc\x15\xef\xbe\xce`\x01\xc1\xed\x0fW\xfb\xa2\x1b\x18ƨ\xf3t\x82@#\xbc\x82\x19\x9d@f\x95/{J\xd4\xfa)\x89\xf4\x16F\xb9\x1a\xa5\xc8\xfa\x96(~\x10\x19\xe6\x89\xd2\n9\x06\xe8\xaf\x007\xf8j?ܠ\x97\xa6\x85\x9bHo\xf2s\xc3M\x19\xacq\x91U\xdc\x18\xa5\xad\x8d\x1b\x03\xf4\x17\x8f\x9bH\x17\xbc\x82$\x11yq%Ŕ\x85\x1e\xc95!\xee\x80չ 艍\t;\xb6s\x82\xd13\xd2\x02\x1d\xe1\x82/\xa4X\xb0\x14Rc\x97\xa2\f\xf3\x99*\xff'\xda\x0eGn|\xb2\xa2\x1f\xf8ŋ\x05H\x19v\xdf\x00\xa9;\x14{0O&\xadDB3lL\x1eC\td\x95\x1aV\xc1\x11\xe6\xbd\x1f\x11\a\r\x93G\x10\x8a\xcb\xf3\xb2ݚ\xf1\x97\xe8V\x11\\\xa4\xd0\xe8cY\xe2E\x147\xb6\xba\xc1@\x8e\x00\xe9\v]\x8c\xaeᓄR\x9f\xf3a\xbe\x17\x13\xce\x13\xae\xf9\x9f/\xa0\xa4\x88h\xe0)\xe33\xf4\xee\xc74\x83\x94\x90Q,<u\f\xeb\xd6:~\x0e\xf1Թ\x89G\x80\xf5\x87\xd4o\x17\xea\xc6Lp7{\xc1\x83S\x00\x89\xedތ\xaa\xe7\x14y\x9ca\xdd\a\xdfx\xf2\n\xee\xc1\xfc\xa9%\xf3\x81]C\xe5\v\x8c\x89!\x99q\xcbx\xea*zZ(w\ue958Sf\xad\xa71\xf9\x88m\xfb=\x1b\xa3\x12^\x91\u007frR\xa1<\x02\xf4h\xc7\x11\x8e9\x17\xeeH\xad\x1d\xe1\x0f\xd6<\x8b\v\x9f\xd8\xc9n\r\xcfE@\xf4K_\x9d\xea\xb7\x1cO[x⪝&\x17\x1d\x90\xfd.\x1e<ݹ\x88-\xa4\vVI#U\x9c;\xc6Sq\xa7\x1e\xc6O\xf1\x9d\x05\xe6\r\xd4İ&\xcd\xf8,X#\xa9}\x154\xcbZy`\xfd\x9d\x15\xfe\xec\xfa\v\x8a:L\xf3@\xa8\xdekm\t\xb7\x1d\xa9\xeai\x9fop\x1dt9\x03B\x83\xa1k\xae\x83O\xe6\f\x98劾\x96曚\xd1\xec\xba\b\xed\xe6MVi\xf1\xabw\xd7gm\x80q\xad\x9b\xef\xf0f/\x83k\x03\x91\xd04gJa\x9c\x02&s!n#@\x1e\xf9\x14\xea\x19\xd3\xf3r2ND\xdeȦ\x1e)6S\xa7\xeeL\x8e\f^\x8e#\xbe\xc1x\xc6x#\x93\x02o\xb0r>p\xb3\x90\b\x90I\x85M$8w9\x88K\x82\\G\xf7e\\\x11?\xf6\xc2{R\xa5e\x9d\xf4.\xa3Z\x1e\xee \xbfH|\xb8~鍚xK\x88\xf5n\xc4\xe8\xa1f\xff\xac\xf1\xf9\xb4^-\x1f\x14z\x00\fcpȁ2\x9c\xcc\t\x9e8\x13b=\xbc\xb4\x160\x8a\xd3k\xd6BL\b\xb4\x1d8\x8a:\xde롦V\xf0(\x02\xe6~\xe1\xa6\b\xc0ۥ!\x89\xbb\x06\xe0q$\"y\f\xa9H\x9e\xdcm\x15S\xeed\x9b\f\xf5\xbaE\xe5\xba\x01\xa3a\xc2\xe99\r\xbd\xfe\xf3J\xa4\xb6\x91Zՠ\t\xaf\xec\xc4\xe6o\xec\xdfV\xc5\nq\xe2Յr\\\xd8Z\xb9fw5w\x95D\b\xb1\x18\x9b'\xf3~\xb8\xbcȌ\xe4n\xcd\xd6晄ݸָ\xca\xe5\xa4BC}o\x8c\xeb*\x17\xa2\xf0\xfew\xa94\xa1U\xa9\x8eo+uU}\xc8:u\x82f\xe9.\xdc\u008e\xc6Zx\xb7!I\xd9t\n\xbe\xd4h\x02\xa4\xa0\x92\xe6\xa0\xc3ҁ]\xde\xcf\x04f\xcc\xd6\u007f\x88)\xa1\x06\r\x87\x87\xaa\xeeo\x14\x82\x01\xac&a\x9a\xe4l6\xb7\a\x99P\x92\t>#>\xf1&\x134%\x86\x87\x06@\x15\x92\xdcQ\x99\x13J\x12\x9a\xcc\xe1Ė[\xa5\xa5\xc4\xf6\xfa\x1ah\xba\x1c)\x1d\x16\xf74\xaa\xb3\xf3\x06a~i\xb2\xde\xe8!p\xa7Љ?\x01M}B\xaa\xcf+\xf5Z[\xf3\xc0\x06\xc0\xf5Ц\x19\x9d=\x97\x86\x84õA\x9dc\xb86ȍ\xe1ڠ\xf6\x18\xae\r\x1a\xae\r\xf2c\xb86h\xb86\xa8{\f\xd7\x06\xe1\x18\xae\r\x1a\xae\r\x1a\xae\r\x1a\xae\r\xc21\\\x1b\xb4\xcf\x18\xae\rj\x8e\xe1ڠ\xe6\x18\xae\r\xdag\f\xd7\x06\xfd\x86\x9ba\x0f\xd7\x06=\xaff\xd8õA\xdb\xc6so\x15>\\\x1b\xf4L\xbc\xf4d\xb86h\xb86\xa81\x86k\x83\x86k\x83\xaa1\\\x1b\xb4q\f\xd7\x06\xd91\\\x1b\xb4a\xfcv-\xa5\xe1ڠ\xe7e)=w[`\xb86h\xb86(\xe8\xad\xc04ʔ\x054\x18ߧo^p\xa3x\xdfs\x83P2)\xa7S\x90\xa8\x1b\xe2\xcc\xd6\xf2H\x02\xc0\xfa\xee\xc6>\xb1\xd1\xe7{(\xd0'ب\xcf\xd6ӄh\xff\x9dS\xf2\x8dC\xee\xe8R\x11\t*\xac\xa6\x8cqr\xfe\xfemmP\x857\xfc\x8b\xe9x\x84+yϓ\xd8\xd4\xd9z\xeb;*\xebB0j\x13ȒL(\x9b\xdbdQ\x9c\xcc)\xe7\x909\xfb#(\xb9gN\x15\x99\x00p\"\n\xe06s\x90\x12\xc5\xf8,\x03B\xb5\xa6\xc9|lf\x1f\xa2\"\xbbmw\x9d\xd8\xebY*-\x81\xe6v\xfb%\xe4a=\xf0\xcd\xf4\bM\xa4P\x8a\xe4e\xa6YQM\x90(\xc0\x92\x1d\x15\x9a5\xec7\x15\x13\xa4\xc0\xa6\xf1\xc8\x12N\xea\x15X\xa4\x84L\xb3ً\x17-\xb4\x13\xbc\x02$/\xf4\xb2J*\x062e2\xa8\x904\xc9\x18\x1a\x02\xb8^\xdbg\x01\xe7x\x82\x96\xa0Ǝ\xea\x88\xd1\x10YbQ\xcaSԉ\n\xad0I\xb61I\xf7є)\xa7?\xab\x90\x04:\xaa\xbd\xe8c9\xd4\x18E\xd2M\xf1\xb3\xe13v/7\xa6\xd8h\xd4_gP\x87hH\x9e\xd9asV\xcfLN\x9a\xf7\xc1\xf82\x8f /\x03\xa6\x83\xd5Lӭ\x1fI\x9f\xc3\u009c}H\x80-B\xce>\xdd\xc0\xf9\x1e\x95\xf1i\x909㘶\xfc\x0e\x94\xa23\xb8\n\n[m2\xe80rU\x93H\x90J\x8f\x15\xbfZ44\xab:m\xf2P5\xa7\x1c\x004\xb7\xab\xab\xd2\xf1\xef$\xd3\x1a\x90d\xb1\xab2\xc6\xe9\x83t\xfa\xb5\x895\xbb۾\U000dfcdf\t\x11\x80\n\xf5\x1c\x9e\xda\xf4\xfc\t\x90\x89d0%S\xc6i\xe6r\bO\xb0\xebb\bmYg\x88R\xc6\xd8\x17ܧ\xa8y\xac\x8c\xc9w\xc1e\xf5Z\x96<\xc1\x04F\x97\x8c\x8e\xd5\xealJf\x98\xd7(mJ\xfd\x1f_\xfc\xf5\xcf\x01@'K\xa3\x93b\x90\\\vM\xb3j\xdb2\xe03CQV@\xd0,\xc4sW\xd7\x1eW\xbb\x8f\xf7\x10Z\x04\xbf\xfc\xfd\xed$JUׂ\x9c\xa6\xb08m\xd0\xe3(\x13\xb3\xae\x1b\x1e\xf7W\x93#\f\xeb\x8e#\x8c\x17\x06E\x1eb\xdfƕ\xccŝ\xedW\xde\xeb\xbc\xd5)\xf1\x85(\xca\xcc\x063\xdeV\x9d\x1c\xc2\xda\xe7\xacU\xc3vr\xaf0\xd3\xdcOkE\u07b8d]\xbf\x8c\xa0\xb5c\x99\x9cs2W\xdd[K\tc\xf2\x96fل&\xb77\xe2\x1b1S\xef\xf9\xb9\x94A\xadW=\xcel5\x10U\x9a$\xf3\x92\xdf\xdak\xd4\xfc\xd43\x11\xe2\x93\x11\xa5.J\xed+\x8c\x1a\x18\xad\xd6n\xfbۄ\x1c\r\xab\x0e9ե13\xb8\xc7Sw\xc7\xccQ\xe6\x04\xcc\xeaC\x84\xb9\xe1\v\x99\x98UsV̓\xfc\xfb\x17\u007f\xfc\x8be !\xab\x97\xe4//\xb0\xb8@\x9dX\x81\x83\xd2\xdb(\x8c9\xcd2\x90\xb1\xac\xc1\x90x\x17+xTN\xa0c\x0f\xfd#\x98\xae77ߣ\xddʴ\x82lzbKS}\xcf\xfd\x00\x90\x87\xa8Z\x1d:Yh\xf4\xf7\xa76\x0e\x17\"+sx\x03\v\x16\u007f\x9dp\v\x86\xaf\x86ɘ\xd2D\x84\x984\x93L$\xb7$u`\x1a9\x86\xabw\x19폑\xe0<ʍ\xebj\xdc\vIIN\x8b\"\xd49\x8cł\x92\u07b5\x96\x89\xdc\x02\x9b\xe9D9sc#\x1c\xf6\xe3aʰ\u007f\xb3\x81\x9f\x1a\x8c\xdf\xf4\x82\x06\xf7\xbe'\xbe\x1eg\xad\tr\xd5i\xdd~'\x18\xaeׇ\xccn!\x17\ru>G\a\x02b\xf2K[\x98\xe5\x95\x0f=\xa7\xda\xd9\tQ\x11$\xa4\xba\x02\xa4b\xca(\x16\x1f\x91\xa2_g\x94\xe5ε\x15\f1<\xe4\x14}\xf5G\xb8\xaf~Ԡɠ\xd7\x02\x91ۣ\xf0=$\xdb\xd22 \xbc\xba%\x967_\x89ԁA\x96j/\xd91\xc6`\xe0\xe6o(\xee\xeb\xa3\x04\xf4c\xce\x1fkܴy\xb3\xf9%\x8a9[\x88\x9f\x88%\xe3\xb4{sd\xe4\xc5n\x01\xfd\x1a\x844\xdd\x1b\x8e\x80\x1a\xe6\x8e\xf3*\x8cm\x8eG0pC1nj\xe4\xf0\xd5\xe1\x93\xf1e\x8bd)\n:\x8b\xb8lu\x05\u05eb\xc0H\n\xd6\xc0\x88(i0\xe6(\xc2S\xbe\xdb)B\x85\xb4\xea\x02\x16\x01\xd2\x16b\xd5\xf2ԛ,\xb6\xc5\xc4]p\xce7!T\x8a\x92\xa7֧^\x87Wޭ \xe2R\xf0\xf0\xe92Uu\xf14\x8a/\x83\fK\u007f\xb1A\x00\xe3\xe4\xe5\xf8\xe5\x8b_\x8e\xf8\xc65\xac\x88\xef\xa8\x16K\r\xbe\xf4d\xab\xf7Wn\xf5\xc2\xc0;\xe7v\xac\xef\xc8bq7\xdb\xd8\xf9\x8c\xee$\xd3иH\xfc\bM#c\xe16\x1a\v\x1d\x87g\x17\xf4\xbc\x80/\xfe\n\x12BT9yp~o\x19u0\x16\x90\xc9ty\xa4U,\xc4\x0eQ\xd1D\xf5Ax\x87\xcb#;\x93C\x85\x9d\a\x82\xb7:\xfa8\xb8m:\xbf/\x82{\x97\xb7\xb6\xea\xfc\xbe\xa0\xe8\xf7.\xda{\x16\x8c\b'\x8c7\xefY,Ď=\xfb;\xcc\xe9\"B\x9e)\x96\xb3\x8c\xcali6\xfb\xdab\x90LJM\x80/\x98\x14<\x8f\xb9juA%\xa3\x93\f\x88\x04l擀\"\x9f\x1f}<\xfb\x80\x99E\xc7Fr\x06\xc3\x04\xbf+\xa5b|\xb6F\xfd\x8d\xe9\xf6\xe3-\a\ak\x04\xec\xf1b(+\\\x12\xf3\xb4«\xd1\x18\xf2R\x97\xf6~\xd2\xfb$+\x15[<\x95\xbc\x88\xb3\xd2*m\xf7W`\xa4\xb9\x06+oX\x00\u007fXi#S\x13\xdcZ\xb7\x96\xc0p0*euC\xb1Δ\x8d \x0e\xe1\xefOl\xf6\x90u\xced\u05f6ʦ\x9f\x87\xf7\x1d_K\xad\xc1\xa6\x81O\xebV\x0e\xa3\xde\x00\n\f\xa4\xbd\x10\xaas9\x82\xfbL\xb9\xad\x94\xda\xf7\\\x0fo\xeb\xaf\xcb\xe9=&\xe0Q<\x90{\xadLLmR\xc4G\xc8@\n/4\xee(\xd3Ue\x02\xe3L\xbf\x0e\xbbp\x19\r\x15۪n\x9f\xed\x0e\xd8\xe8=wb\xaf\xc7vm\xd3vr\xdaB>;\xbe\xbe\xf9\xbb\x1b_d<\xc9\xca\x14^g\xa5\xd2 ?\x80\x12\xa5\xec\xf0\xf0\xafDG;\xdfi\x14\x1dܹPJb\x1f\x19\xa9D\x14\x1d\x87^֯V:\x85\x9bP\xea\v\v\xb1^\xc5\x1a\xceU\xf7\x05\xa5\x85\x84\xceD(^f\xd9J\xfa\xbb,\xd7H\xc5<e4\x84\xce\xcc\xe0͚\xba\x9f\x9a1\xd1TA\xf7DS\xe3q\xdb\xccNe,A76\xf7\xff`\xff\xcb\xcc\xd6}bm]v\xe7l\x9e\r&/bt\xf1\x04ۊ\xf3\x1a\xbe\xad\x97\xb3\x9f]]\xf4\x067ږ#\xb2\a\x9a\xd6i\xcd\u007f>\x88\x94\xea\xa7WP\xe4)d7\x86։\xa3\x89\xa3\x9a\xd2\xdcs\x13\x9aܖ\xc5s@\x18\xde0t\r\x19\xca\xf1\xad\xc8\xfa\xa6\xf9\xa4ET\x0e\x9a.^\x8e\xdb\xffblT\x96i\xccB\xedP\x9d\xeel&\x9d\xc1\x93Q!\x18Oق\xa5%\xcdZT\xd6\xc0R\x8dL,Q`ٺq\x8eM\xc2\xdc\xdb-\x9c\x12\x9f\x0e\x15t\x06\xb7yG\xd1Ub\x94a\x97\x10\xd9\xc5D\xdb\x0e\xb8\x95\x17,\xe6\\\xdc\xd1]\xf0\xa4<\xee\x1ck6\x9a\xfc\x86\xd2\xc5\x1b\xd7\x00\xc6?\x85\xeb=\xbb|ӭ\x80lq^\xb7&y\xb6e\"\xeeLT\xdb;\xa7\x95[t\x93\xd4\xc4LyuB(\xb9\x85\xa5M\xa0\xa4\xdcu\xe7\xf4 \xf0~\x18'\xe0o\xc1\xa6*\xd8\xf7\xba\x17\xbe\xdbe}\v[\xbcA\xad\xe5\x9a\xef\xf9\x000\xae\xdb\xfcP\x05\U000aa97a+\xb7\xb6\xc9\xe3-Ѻ=\xa4\xbf\xc7ȞӮ\x10(\xc1\x90\x93\xdd~3wc\xad\xe1u;\x82\xab9+\xb0\bgˬ\xf1\xba\xe1\xa9\xc7vu\x19\x8b\x05n)ꂟ\x90K\xa1\xcd\xff\x9c\xdf3\xa5Վ\x1e\xd3o\x04\xa8K\xa1\xf1\xd9^(\xb1\x93\xda\x13!\xf6a$Pny\x1b\x96\x92 \xfcjy\x17Swa\x85]ߖE0E.\xb8a2n\xe5U3l\xe5\x80\xfbz!.\xf8\b9\x92\x87\xbe\x05h\xb5iLyT\n\xd9\xc2׆\x0fm\x819\x01\xe2>\x8f>\\\xfb\x0e\xa6\xe7\x16\x19M \xf5mt\xa9\xc1\x05\xd50c\t\xc9An\xbd^\xbb0|j\xf3\xd6\xed\f\x83\xed\xa5\xec\xf6UMo\xa1\xfb\xbd\xd1\xf6\xed\x8dV\\\x1d\xbfG\x01\u05f9z\x9a\xfa\x8e\x9cW;\xf8\xd3\x0e\xfc\xac\xcb\f\xfbQ'hia(\xfb\u007f\f;EB\xf9_RP&\u0558\x9c\xb9J\x82\xceo6\x9fw\x9aG\x13\xb4\x81\xca\x1418_\xd0\fl\xdb6\xca\tآ\xd8N\x90b\xba&ь\xa1-\x94\xe5\xe2UH\xe4\xe0\x16\x96\a'\xad\x93\xb7)\x81\xed\xe0\x82\x1fTY\xf6\xeds\xe0\xe5\x8cm\x0f|\x80\xffv0^\x13\x82\x9d`\xb7\n\xc6-\x14\xb1\xf1\x9f*M\xf7\x9dM\xacY\xdd\xe7\xfdha\v\x1d\xac\xf5\xafi~\xadE\bM\xb5\xb4\xa5¯\u007f\x8e\xca\x19\xe8.e\xdf\xe9\xaa\x18f\x1f\x933\xbe\\\x83\xda]f]\x99H\x15E\x15\xad\x0e\xebB\xbaD\xee& \x976\xa3hn\xe1\xaf\xee\xc9F\xa4\xa3\xa0\x93\v\xb8\x14)\\\t\xb9\x9eaЎ4\xac>\xdda\x156\x96.\xb2\x14s\xa6\xf1ѵ\x85\xa2\x1e\xeatЇ1\xe1\xdcw\xaf>n_Ň\xea\xb1\xedӧ\xd8\xcf\xd7\xed\xc6\xd5\xc7\r+P\x9c\x16j.49Z0\xea\xaaND\x99\xba\xa6\xf3r-F\x11\xb96\x95\xcc!-3躗d\xad\x81\x8f\u007f\xd0ka%g\xff*\xdbW\xb4xύ{z\x9d\xb0k<Tfiçh\xd8\xc9\xdfq\xef\xfcw\x9c=\xe6\xe0\x1a\x8a\xed\xf2\bT\x00-m\v\xa5\xb1\x8e\x84\xebF\xcb\no\xbe%\xae}\xb0{\x9c\xa9j\xb6\xdd\xe4\xbdv\xe8\xbb\xc4\xdd\xc8A_\x89\xc4v\x1e\x10\x9b!\xdd|\xbb\x8b\x8e\xaem\x1euB\v]J\u007f\xa1Q)\U0006207a!2\xf5\x98qHh\x00ݤz;_\x18\x13\xfc\x86\xe5\xa04͋\xad;\xffz\xfdy\x83\\!S;){\x9d@mF\xd7\xfd\xfc\u05edHZ\xdf\x1a\x91\x8e\x1b\x90-\x10ԅ\f`H\t,\x80\x13Wp\x81\xb1^k\xa2\xaf\x81\xb4\xb7\xcb\xca\x05z\xb8=\x14\xcc\xec\x9c\ni\xfb\xfcW\xd3^=j\xbe\xac6\xa5\x1aF\x1d\x05\x87{\x9c\xa9\x0e\x89\x80\xc9\xd9\xdbY\x05f\xaf;%!\xc1,#\xb3\x95Yf\xdf\xf5\xf9\xe3\xf6\x02\x11r\a\x12\xc8\f\xb8Aj\x87?\xcc)\x8d\xb6\xbd\xbbA\xa5;\x89\x95S\xe3\xc6v\xc27\xb6\xba\x9d\x1a\xca؊\xe3oR\xb0\xcc\x03t\xb6\xe1Lt\x15\x14\xbb\\\xfd\x0f@\xd5z\xf6Kk\xf9o\x9bO:;\xc0\xaeܚ\xa9\xd4^\xb1a\xaf%b\x12:\x88\xdbMF\xe0W\xf7<\xb7\x84\x14s\xaa\xb6\xb3\xb9+\xf3D\xd5t\xbfq\xdc*\x0e\xf7\xa1s.\xc0\xcb|\x15\xf0\x88\\\xc2\xdd\xdaoo\x91\xa0\xd1z\xeb:$#r\xc1\xaf\xa4\x98\xc9\xf5\xfeW#\u007f`֨`D\xae\xa8Ԍf\xd9\xf2mW\xb7k\xffս\xf1\xe4&\xb0Cjۇju\x8fq{\xa2\f\x15\xd2\t\xf6\u007f\xaf\t\xf1P\xd54\xba\xb6\x91\xfe\x83ccā7\xeaY\x1b$\xa6\xec(=\x82\xe9TH{i,\x19\x8d\b\x9b:\x1e\xb8.H)\xcb\xec]\xd3\x18\xec!L\xd7&V%4\x84$\x94/\x89Db<\xc1\x1bS\xe8\xd2:\x95i\x92\x94\xe6Н*MץC\xb43\n\x8d2GF\x9d6S\xdb\xdb\xd9|\xba\xba\x15\xad\xea\xc0\x80\xc0,\xc2\xe6t\xe1O\xfa\x86\xebt\xb1\x9e\xb9\xd2\xf6\x949=]\xa6\xd7\xf6\xa6\x01X_v\xb1ɰl\x87\x85\xaaG\xfd\xc4mq\xda\xda\xf4ES\r\xdd\xe4Bcʿhv(\x99c?\x11=\x97\xa2\x9c\xcd=\xb1mb\x83\xddδ\x12\xcb%\x8b\xac\x9c\x19\xf2u\x0e.]Jް\n\x9c\xcb+\xad\xa7\xba\x19\xe46\xc4mԕUKFmW\xc2Z\x8f\xee)\x85\x8d\xd4\xed\xe0\xee\xb6 \xf8\x19\xca\xcfE\xc5\x1a\xcfwKҏ+\x0f\xaf\x84\x02\x8cL\xad\xe1y\xf9w\xc4փ@\xe85L\xcclWo\x9d|\"\x97\xfe\x1d\x95\x9c\xf1\xd9\xf6\xe5~\xe7\x1e\xeaP\x1d\xdc\xfb\x8f\xa7<\xf8\t\xb6Շ5\x90\x96\xc2CՇ\x8eӱ\xf2\xd3\x02\xa4\xb2\xee×\xf5_\x88-\x1b\xfbt\xff@,5\xa7\rܻ\xa9\xb8_j\xed\xdbV\xf7\xbbX\x9bE\xfb-\xe3\xe9+\x9fAVd\xa5\xa4\x99\xfb3\x11\xdcr\x04\xf5\x8a\xfc\xf0\xe3g\xc4aࣟ\x87\xf9\xf1\xff\a\x00\x00\xff\xff\xdfޱ\xd6\xfe\xba\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko$\xb7\xd1w\xfd\x8a\x82\xbe\xc3~\x014#/|\tt[ked\xe0\xcdz\xb1+\xeb\x12\xe4\xc0\xe9\xae\xd10\xea&;${\xa4I\x90\xff\x1eT\x91\xfd\x9c~\xb0e\xc9p\f\xf1$q\xc8b\xb1\xde,V\xf3l\xb5Z\x9d\x89Bޡ\xb1R\xab+\x10\x85\xc4'\x87\x8a\xfe\xb3\xeb\x87?۵ԗ\x87\xf7[t\xe2\xfdكT\xe9\x15\\\x97\xd6\xe9\xfc+Z]\x9a\x04?\xe2N*\xe9\xa4Vg9:\x91\n'\xae\xce\x00\x84R\xda\t\xea\xb6\xf4/@\xa2\x953:\xcbЬ\xeeQ\xad\x1f\xca-nK\x99\xa5hx\x85j\xfd\xc3w\xeb\xef\xd7ߝ\x01$\x06y\xfa\xad\xcc\xd1:\x91\x17W\xa0\xca,;\x03P\"\xc7+\xb0\xc9\x1e\xd32C\xbb>`\x86F\xaf\xa5>\xb3\x05&\xb4ڽ\xd1eq\x05\xcd\x0f~R\xc0\xc4\xef\xe2[\x98\xcf]\x99\xb4\xee\xa7N\xf7'i\x1d\xffTd\xa5\x11Yk=\xee\xb5Rݗ\x990M\xff\x19@aТ9\xe0/\xeaA\xe9G\xf5\xa3\xc4,\xb5W\xb0\x13\x99\xa5\x9fm\xa2\v\xbc\x82τI!\x12L\xcf\x00\x0e\"\x93)\xef\xd3\xe3\xa6\vT\x1f\xbel\xee\xbe'<r\xe1;\x01R\xb4\x89\x91\x05\x8f\xabQ\x04iA\xc0\x1do\x12L`\a\xb8\xbdp`\x90qQ\x8eF\x14\x06W\x15\x96)h\x13`\x02\x14h\xa4Ne\x02?\x88\xe4\xa1,\xfcT\xbb\xd7e\x96\xc2\x16\xc1\x94j\x1d\xc6\x16F\x17h\x9c\xacHH\xad%5u_\x0f\xd3w\xb4\x15?\x06R\x92\x13\xb4\xe0\xf6\b\x81ۘ2\xf5r\x01z\an/m\x837\x93\xa4\x05\x16h\x88P\xa0\xb7\xff\xc0ĭ\xe1\x1b\xd1\xd9\xd8\n\xdbD\xab\x03\x1a\xdaw\xa2\xef\x95\xfcW\rقӼd&\x1c\x06\x8eVM*\x87F\x89\x8c\x98P\xe2\x05\b\x95B.\x8e`\x90րR\xb5\xa0\xf1\x10\xbb\x86\xbfj\x83 \xd5N_\xc1\u07b9\xc2^]^\xdeKW\xe9I\xa2\xf3\xbcT\xd2\x1d/Y\xda\xe5\xb6t\xda\xd8\xcb\x14\x0f\x98]Zy\xbf\x12&\xd9K\x87\x89+\r^\x8aB\xae\x18q\xc5j\xb2\xce\xd3\xff\xab\xb8hߵ0uG\x12\x1b\xeb\x8cT\xf7u7\v\xf1(\xddI\x96\xbdx\xf8i\x1e\xff\x86\xbc\xd4ET\xf9z\xf3\xed\xb6-:\xd2vi\xce\xd4nISCx\"\x94T;4\x9eq;\xa3s\x86\x88*-\xb4T\x8e\xffI2\x89\xaaKt[ns\xe9\x88\xd3\xff,\xd1:\xe2\xcf\x1a\xae\xd9Z\x90̕E*\x1c\xa6k\xd8(\xb8\x169f\xd7\xc2⫓\x9d(lWD\xd2y·\x8d\\w\xa0\xa7V\xdd]\x19\xa3A\x0eU:\xfc\xad\xc0\xa4\xa3\x1a4K\xeed\xc2\n\x00;m\x1a\x15\xb7m\x8b5\xae\x97\xbcv\x18\xda흴#\xd7F+\xc0'\xe2t\xa3\xaf$'\x8f{T\xa4E\xa6T\x84a\x0f\"\x04\xe3\xb1\xee\xf5\x0fҎ\u007f\xc0\xbc e\x9cD\xed6\f\"Ԉ*i\xedd\xbc\xa9\xc0\xdad\xe9`\xa9@\x0fcW\x18}\x90i\xb0\a=\xeaMQУ\xb4\x13e\xe6\xeetV\xe6ho\xf5W\xb4N&\xa7\xe3z\xc8\u007f\x1c\x9cVq\x16-Q\xd4\xedѐ\xe2\xf1\x0fl\xc3\x06\xa0\x02k\x84Ŕ\x8d\x98x@\x10\xb0\xf5\xfb&k\x98eP\xe8\x14\x0e~\x1d\xd8\x1e+\x84\xfb\xbch\xf8\xb1\xd5:C\xa1N~ǧ$+SLk\xdf4@\x8d\xde.oN\xa6\xb0\x8b\x17R\x914\x91C%$U\xf3+y\x97\xc1M\n\x83@\xea/\x95\x87\b\x92Y\x19\xb6:\xb4\x19\xe90\x1f\xc4pB\xee|\xa3\x10Bl3\xbc\x02g\xcaSi\xa9\xe6\vc\xc4q\x94JU\xe8\x13O\xa4zF0ʙL\x90\xc8S\x9b^\xa6\xd3\x1f\x80D{\xad\x1f\xe6\xc9\xf2\x17\x1aո\x15H8\xa2\x84-\xee\xc5Ajc\xfb\x91\b>aR:\x1c\xd6\x11\xe1 \x95\xbb\x1d\x1a\x82T\xec\x85E[\x19\x89q\xf2L\xa9=53\xc5\xe2\x93\xfd4\xec%F1\rƶ\xc0\xe6t\x04&0\xc2ds\xcb\x02\xa4J\xe5A\xa6\xa5\xc8@*\xeb\x84J\xfc\xbeD\x8d\xdbо`\x9a\xf5'\x98{3Z\xe1O|\xe9x$\xad\x10\xb4\x81\x9c\xdc\xef\xe9P;\xba\x06\x8cn\u007f+Ȟyc\r\x86\xe2\xe9\xb0X\xcaή\xb1\x17\x17\x13\xc0k\xee\xf8\xa0-\x13[\xcc\xc0b\x86\x89\xd3f\x8c,\xf3L\xf7-\xce\x16\x8e\xd0s\xc0*6v\x9f\xb6\xdclp\x12(\x90\xc9\u007f\xdc\xcbd\xef\xe3+\x92)\x86\x04\xa9F˶@\x14Ev\x1c\xdf,\xccKBXh\xda\x1c4m\xd60\xf4a\x0e\x9b\x88\xa6E\xd9Ӧ\xcdX\xd6.\x9dk\x11y#s\xe54\x9e%Л\x93\xc9/-\xd0D`Ig\xab\xcd\x0e0/\xdc\xf1\x02\xa4\xabz\xe7aR\x18\xd4\xe0\xf0\x87`\xd4s\xf4aӟ\xfb\xc2\xfa\xf0\x02\\\xaaQ\xf8\x9ff\x12;\x9bo\xc1\xd7,`Ч\xf6\xbc\v\x90\xbb\x9aA\xe9\x05\xecd\xe6\x90\xe3\x9ei\x14[\xaeo\x96S/E\x968\xafI-\x17.\xd9\xdf\xd4G\xc8\xd9\xf1=\n\xf5\xa7\xfbX\xb9:It\x9d\xfc,d\xe0\f\x834\x98\xfb\xbc\xc5-\xeb@\xd3Ñڇ\xcf\x1f1\x9d&\x14\xc4J\xe4\xc9v>\xf4Pn/\x1f\x8e\x01\xf1\x9b\t\x01U}\xc2\xf2\xf9\xa8\v\x10\xf0\x80G\x1f\x05\t\x05\xc4(AK\x8d\x1e$N\x89ĉ16\x11\x0fxd@!\xd7\x151?^4|{\xc0c\xdc\xc0\x1e)\t\xb3\x90\t\xf04\xa5\x0e&\b\xa7L\x96\x90\x118s\xc9\x1a\x02N\xc7l\x12\x96\x98\x9b\xaaU\x9cx\xd6vk6vҸ\x0fx|g=\xc3H;\xf6\xb2\x88\xde0\x19`\xb0\xc8zTe2\xefD&\xd3z)\xaf\x0f\x1b5\x15uw\xdbg\xed6\xea\x02n\x9e\xa4%\xf4T\n\x1f5\xda\xcf\xdaqϫ\x11֣\xff,\xb2\xfa\xa9\xaczʛy\xa2G;A\x1a%\xf4\xbem\xfc\t\xb3f\x95\xb4\xb0QtV\nt\xe147Ì\x17KF)/-gB\x95V+v\xb4끵\xa2a\x06\xf6h\xd3\xe1N\x1b\xbdֲ\xd1P\xe9@\xe7Q\xbb%\xdf\xe3!\xf8\xf4}&\x12L!-\x99\xa8\"\x1a\xa2uF8\xbc\x97\t\xe4h\xee\x11\n\xf2\x05\xb1܈\xb6Ͼ-\x96\xb9\xd8Рj\xc1Ч1(\xadH\xaf\xa3\xc6U\xec\x8f\x18<\x98\x8f\x9e\x1e\x1c\xb37v\xd0\x1c\xc7DP[\xa4)'lE\xf6e\x91\x97Xĝ\xd3\xf8\xc1\xa3\xe7\xfdk.8Q\xfaor\x91,\xec\xff\x81BH\x13\xa5\xe5\x1f\xf8\x8a/\xc3\xce\xec\x90uk/DkH\v\xc4\xf1\x83\xc8\xfa\xb7\x1d#[\xd4d\x810\xf3\xa1\x80ޝD>\x17\xf0\xb8\xd7\xd6{\xe4\x9dđ\xe4p\xb7I\v\xe7\x0fx<\xbf8\xb1K\xe7\x1bu\xeeC\x84\xbe\xd6G\x80\xad#\x0e\xad\xb2#\x9c\xf3\xec\xf3_\x17NEKg\xe4@\xbe\x0f\x8e\x0f\xc4\xe9$[E\x134\xb5\xbe|\xa4\x10z\x1a\xfbH\xd9,\xb4u\v\x10\xfa\xa2\xad\xf3\x19\xd1N\xc0\xbb,\xdf\x06^\xaeB\x9e\r\xc4Ρ\x01봩\xae\xfa\xc8H\xf6\xd2\xc6\xc4E;w\xe0 \xc6\xd6\xd9;\x0f\x96\x0es\xe7\x8d~{\xfb{\xee\xef\x00\xe9\xef9\x88\t\a0\f\xb90:Ak\xe7\xc4&\xca\xc2\xcf$6뤦\xf0\x87%\xbe[\x9b\x15ֹdkՖ\x84\xc2D\xce\xc5ǉ\x9b\xa7V^\x96\xcc\a\xfd?/\xb2˱\x03\xd6\xfa<\x17*ʁ\x9d z\xed\xe7V*\x16@\xf9#\x8a\xb9/\xd9\\,\x89\\\x83\xf0\xfd~\x82\x81\\\xaa\r/\x02\xef_%|\xa8\x8d.>\xef\xf8p]\xcdnXPw\f_\x92\x8e\xb5B\xf3}\x85\xc1\x0e'O\xb3\xfa\x8b\xc2f\xa5];\xf5A\x90\v\x9d\xbe\xb3\xb0\x93ƺ\x06\xd9h\x98\xd2\xf2%\xe9\xeb\x9d\xe5ԍ1\xcf<\xca\xfd\xec綒q{\xfdX_\xe8\x8f_\xfc\x0e5\xbe\x1eC\x90;\x90\x0eP%\xbaT\x9c4\"c\xc0\x8bxv\xc4\v2\xc4\xfa\xbd\xa6\xa1*\xf3XB\xacX\x12\xa5\x9a\xc9/\xb5'\xfc(d\xf6Zlt2G]\xce8\xe6\xa6u\xeb\x11\xfc\xdcN\xa5F.\x9ed^\xe6 rbD4\xc9)ڐ9ve\x00\x1e\x85t\xec\x91\b2\xbb'\xa7\xa3A&:/2t\b[\xdci\xc3\xfane\x8a\xb5\xeb\x0fr\xa1\xc7\xef\x1d\xfbM\xc0NȬ4\xd1Vw!7\x96\x9d\x90\x82\xe1yكO,\n+&_T:::\xa4\x9d\xf3\x04\x85Y\x12\xd0~1\xf8\xd2\xe1ca$ɢ\x9e\x8b g r|ٍ \x83\x88\nu\x1c\v!g`2\x16o!\xe4[\b\x19\a\xf7-\x84|\v!\xe7\xdb[\b\xf9\x16B\xc6Lx\v!\xdfB\xc8\xe5(\xfc\xf6!\xe4<f+\xce}\x8e\xfe\x1c\x81MT\t\xc14\xb2\x93\xab\x84j\x98무\x0eM|\xcd\xedfx\xde@\xfdu⇬\xf8Üa\xd9h\xca-\x1a\x97U\x17ᒲU\x8a\xe2\xeb\xf5g\xa3\xe3\xc8\xe2ڱ:\xed\xb8R\xae\xb9\x02\xaen\rr]<U\x15!\x0f[\x8d\xb0t\xe0\x96\xff\xe2\xa3]\rԭ\xc3\xe2ȼ\xc2\xf6wT\x9f\x1cUb5SX5]\u00ad\xab5F\xa8\xd8I\xc0w\xc9g:eĿC\xea\xcd\xd6>\x8dW<\x85\x1b9t\xe2\xf0~\xdd\xfd\xc5\xe9P\xff\x04\x8f\xd2\xed\a7\xc5ߠ\xd0qQݷ\v\xa3+Y\f\xdfx\xf5\xa9\nڀ\x92\xd9pM\x03\x11\xbc\x9a\xdf!7\xfc\\\xf8C\xe9\xb3\xf4w\xee\x98\x14[#\xf5\xecʨn\xddӨ\x81_~\x81\xb7\xa4\xac<\xbe\xf6i\xaeTiI\xc5S\xbb\x9ai\x02dl\x9dS܉w\xb6\xa6\xe9\x19\x95L\xd1u\x95/r]\x19W\xab\xf4\x1a\x15J\v꒺\xf5F3p\x97U#E\x92)\xa6\xf2hq\xbdQ\xa8\xed\x99\xd9OD\x95\xd1h\xf5\xd0\f\xe8\x81:\xa6\xf9\x9a\xa19\xf2wPy\x91J\xa1g\xd4\a\xbdl\x1d\xf1KE\xddS\xd5>\x115>\x11q\xf9\x1c\xa6\x11U<\xcbjw\"h\xf8\xcc:\x9d\xba\ngt\xed\xa5\xd59\xddڛQ\xb0159#\x157\xa30'+qb\xeblF\xa1Ϻ\xef\x19ə\xfcy\xe8\x9b\xe7\xaa\xcdy\xc9췕\xb4_\xb3MmR43g\x83x\x84g\x90\xed\xe6\xf8z+\xf7>ި>\xfa\xa5Q\xed3ǰ8\xe8\xfaӂ\x04~\x92*\xf5RD\xaaЊ>\xf8\x85\x00.\u007f\xaaC!V\xc6a\x1e\x86H\xb3wֱX\b2\xcb)l\x8f>\xc3b\xd7p#\x92}w\xe0 Ƚ\xb0t~΅\x83\xf3\xfa\xd8xYͣ\x9e\xf35\xc0\x8f\xba>\xa5\xb7?\xfa\xb32/\xb2a\xebVZ\x84\xf3.\x98矂F\xe4\xc4*Qؽ\xae\xbe\xf0\x9e=\b}\xeb\x8e\x1f\xc8DT\xdfw'\x99.\xd3\x1a\xfe({\x85:\u0097;\x8e\xb5\xf8\xbbפ\xf9\"8DS\xd5ɦ\xff\xc1\xf0\xf0\xc7\xfa\vH2\x96\x99\xb0N\x1bq\x8f\x9ft\xd2z\xcdd\x8a&\xdd\xf1\x9d\xc7*\x82-\xacr\x8f\xa1Ho\xf8\x14\x17v\xd4\a\xd7\\9\x04\xddi\xd27\x84鰙\x9c\xd4X\xe7\xb2\xd9M\xdd\xde~\xf2\x1bq2\xc7\xf5\xc7\xd2g\x85V\x850\x16\x89\xb6\xd5\x06\xfd\xa4\xed\x98\x15\xdb\xebG\xc8t\xd8\xfd\x0f}\xfc\r\xf2\xe5\x06\xa7\x9f\x16\xef\xc2?\x16P\tdE\xaey\x11\xbe\x1b\x9e\xd7:\x88\xb6\x98\xc6\xfa<&\xbbc\x90\x84\xb5:\x91lM\x1e\xa5\v_5\xbd\xc6\xf7\xed\xe3\xf1Ѩҗ\x16\u007f~Th\xbeV\xeaf7\xca\xf3e\xf2\x15\x8b_N\xa6U\xcc\x1c2\x00d\xb9z\xc3O=\x94\n$\xb1\xfe}$oz\x99T\xd5k\x1e}bM\xea\xf5\x98N\x0fE\xb2\xab\xa1'4V\xf5{\x1eg3t\xb4N\xb8\xd2\xce?\x88\xc2\xc3 \x11\x85+Mp\u007fIi\xf8\xe3~\x02\x81\xfe\x1b\xf8\xe5O\xa2dº\b\x9e}\xaa\x875\xe7t\xeb\xfc}Kel\xe0QX~s\xc4߶\xb4\x88\u007fj\x16\x87\u007f\xf0\x9e\xef\nR\xe1pE\xb0\x973m@\xbe\xf9\xf1\x83\xc9\xdd}\xa1\x11\xf5\x9dn +O\xab\x9eL\x18\xd9\xc9Х\xdd\n>\xe3\xe3Iߍ\"\xc4\xfb\xd9t\u007f/\x87\xe9]\xfd\xdeU즚\x17\xb2\xf8\x1a\xf4D\xe7\xbbf\xaa7\xb8\x97\xab\x15Yւ\xe7\xaf<-\xfc\xbf<\xf5-\x9c\x80Ih'\u007f\xea\xfd6bx&\x8cΰ\xc1\x19P\x92^W\xf3&\xda\xfb\xe6?^z\x15\xde@;\xf8\xea\x01~t,m\xc9Jpơ\xa7\xd1<\x91$X\xb8p\x17\xd0~\f\xed\xfc\x9c\xff\xa9\xde:\xe3\u007f\x13\xad|\xa8k\xaf\xe0o\u007f?\x83\xe08\xef*<\xa8\xf3\xbf\x01\x00\x00\xff\xff*\xdd\xe8w?N\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xe44\x13\xbe\xe7W\x94\xf6=\xec\xe5MzG{A\xb9\xa1\x06\xa4\x110\x1aM/sA\x1c\x1c\xa7\xd2mƱCU\xb9\x87\x06\xf1ߑ\xed\xa4?\xd2\xe9\x9d\xdd\x03\xbe\xa5\\\x1f\x8f\x9f\xfaH\x15eY\x16j0\xcfHl\xbc\xabA\r\x06\xff\x14t\U0004bad7o\xb82~\xb5\xbfkP\xd4]\xf1b\\[\xc3:\xb0\xf8\xfe\t\xd9\a\xd2\xf8\x1dv\xc6\x191\xde\x15=\x8aj\x95\xa8\xba\x00P\xceyQQ\xcc\xf1\x13@{'\xe4\xadE*\xb7誗\xd0`\x13\x8cm\x91R\x84)\xfe\xfeC\xf5\xb1\xfaP\x00h\xc2d\xfe\xc9\xf4Ȣ\xfa\xa1\x06\x17\xac-\x00\x9c\xea\xb1\x06F\x8aF\xa2$0\xe1\x1f\x01Y\xb8ڣE\xf2\x95\xf1\x05\x0f\xa8c\xe0-\xf90\xd4p\xba\xc8\xf6#\xa8\xfc\xa0Mr\xb5I\xae\x9e\xb2\xabtk\rˏ\xb74~2\xa3\xd6`\x03)\xbb\f()\xf0Γ<\x9c\x82\x96\xc0L\xf9Ƹm\xb0\x8a\x16\x8d\v\x80\x810]\xfc\xe2^\x9c\u007fu?\x18\xb4-\xd7\xd0)\xcbX\x00\xb0\xf6\x03\u0590\\\x0fJc\x1be\xa1\xa113c\xb8촆\xbf\xff)\x00\xf6ʚ6\xf1\x9a/\xfd\x80\xee\xdb\xc7\xfb\xe7\x8f\x1b\xbd\xc3^e!@\x8b\xac\xc9\fIo\xe9\xf1`\x18\x14\x8c@A<(\xad\x91\x19t B'cL0\xae\xf3ԧp\xa3c\x00\xd5\xf8  ;\x84甓\xf1\xe9ը0\x90\x1f\x90\xc4L\xe8\x93ɩ>\x8f\xb2\x19\xc6\xf7\xf1\x11Y\a\xdaX\x91\xc8)\xc6XW\xd8\x02\xa7\a\x82\xef@v\x86\x810\x91\xeb\xe4\x12]\xe2\xa4\x03\xe5\xc07\xbf\xa3\x96j|=\xc7,\x06\xdb\xc62\xde#\t\x10j\xbfu毣g\x8e4ĐV\xc9T@\xd31N\x90\x9c\xb2\x91\xfe\x80\xff\a\xe5Z\xe8\xd5\x01\bc\f\b\xee\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1av\"\x03\u05eb\xd5\xd6\xc8ԑ\xda\xf7}pF\x0e\xab\xd4W\xa6\t\xe2\x89W-\xeeѮ\xd8lKEzg\x04\xb5\x04\u0095\x1aL\x99\x80\xbbԐU\xdf\xfe\xefX$\xefϐ\xca!\xd6\x13\v\x19\xb7=\x8aS\x8f\xdc\xe4=\xf6G\xae\x86l\x96\xf1\x9f荢\xc8\xca\xd3\xf7\x9bO0\x05M)\xb8\xe4<\xb1}2\xe3\x13\xf1\x91(\xe3:\xa4\x9c\xb8\x8e|\x9f<\xa2k\ao\\\xae%m\r\xbaK\xd294\xbd\x11\x9e\xaa4槂u\x9aK\xd0 \x84\xa1U\x82m\x05\xf7\x0e֪G\xbbV\x8c\xff9\xed\x91a.#\xa5o\x13\u007f>N/\x153[G\xf14\xeb\x163\xb4н\x9b\x01u\xccY$.ښ\xce\xe8\xd4\x06\xd0y\x02\xb5dR\xbd\x89!O\x99\xafA1Έ\x8cc69b\x0f\xbe\x85ciT$\xf9N1^\x8afh\x1e\xa3\xc6<\xb25\x1dꃶ\x98\x1d\xe4I\x81o\x81\x88\a]\xe8\xe7\xf1Jx\xc0\xd7+\xd9#\xf98'Ӥ>?\x8b\xf9\x87\xfcs\xd9\x1aǟ\u007fM\xd6I\xbf\xab\xf3\x91{6jG7@\xc1\xb9ؑ\xdeE\xf1\xcc)\\N\xe4٭\x11\xec\xafp,\"\xb9w\x9dO\xbf{\x15C*\xc9}\x82cR\xc7\x18\x19ѕ\xbb[9\xcdg>\x8a\xbe\x80\xc0|\xd2\xca\xf0\xf5\x86qt\x18\u0085\x98e² \x8e\x91\xaeċ\x1d3\"\v֪\xc6b\rBan\x99\xed\x14\x91:\\V\xc5TF\xa7\xe5\xe8\xb3\x05r\xa5\x1ek\xffu\x87\xeeV\x85ë\xe2\xa5\xdcd7\xd0\x1cn\x19\xae\x8f[\u07bcIrY\xd6\x10\xa7n)报/ b!K\xb9T\x17\xb6\x83+\x126\xe7\x9aS\xef_\x14\xfc\xb4,̑\xdf\b\xbe\x90ԙ\xe8\xb4\xd4ޝ\xbeRa\x97\xe3\x12\x9b.\xc6W\xb4g/g\xf1\xa4\xb6\x13\x17\xa7\xd9\x1a\u05ecA\xb0}\x98\xaf\xb0\xef\xde]\xec\xa2\xe9S{ך\xbc\x81ï\xbf\x15\xd9+\xb6\xcf\x13\x8e(\xfc7\x00\x00\xff\xff\xad\x01\x9a\xeb\x00\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xdc6\f\xbd\xfb+\x88\xf4\x90K\xed\xc9\"\x97·`\xdb\x02A\xd3`\x91M\xe6R\xf4\xa0\x91\xe8\x19veI\x15)\xa7ۯ/$\xcb;\xe3ٙ\xa4E\x11\xdfDS\xe4\xe3\xe3#\xa1\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x12t\xf9\xc4\xdd\xc3\x0fܑ\xdfL7;\x14u\xd3<\x903=\xdc&\x16?~@\xf6)j\xfc\x11\ar$\xe4]3\xa2(\xa3D\xf5\r\x80r\u038b\xcaf\xceG\x00\xed\x9dDo-\xc6v\x8f\xae{H;\xdc%\xb2\x06cɰ\xe4\x9f^u\xaf\xbbW\r\x80\x8eX\xae\u007f\xa4\x11Y\xd4\x18zp\xc9\xda\x06\xc0\xa9\x11{\x98\xbcM#\xb2S\x81\x0f^\xac\xd7s\xb2nB\x8b\xd1w\xe4\x1b\x0e\xa8s\xee}\xf4)\xf4p\xfc1\x87\xa8\xb8暶%\xda}\x8d\xf6\xaeF+\x0e\x96X~\xf9\x82\xd3;b)\x8e\xc1\xa6\xa8\xecUdŇ\xc9\xed\x93U\xf1\x9aW\x03\x10\"2\xc6\t?\xb9\a\xe7?\xbb\x9f\t\xad\xe1\x1e\x06e\x19\x1b\x00\xd6>`\x0f\xefs\x05Ai4\r\xc0\xa4,\x99r\u007f\xae\xc9\ato\xee\xden_\xdf\xeb\x03\x8ej6\x02\x18d\x1d)\x14\xbf+\xc5\x001(X\xd0\xc0\xe7\x03F\x84ma\x0eX|D\xae\xc0kH\x80\xa5\x02\xee\xaa)D\x1f0\n-\x04\xe7\xefDaO\xb63</3\xe0\xd9\aL\xd6\x142\xc8\x01\xa1*\x03\rp)\x06\xfc\x00r \x86\x88\x85)'\xc7V-\x9f\x1f@9\xf0\xbb?PK\a\xf7\x99\xcd\xc8\xc0\a\x9f\xac\xc9B\x9c0\nD\xd4~\xef\xe8\xef\xa7\xc8\f\xe2KJ\xab\x04kO\x97\x8f\x9c`t\xcaf\xaa\x13~\x0f\xca\x19\x18\xd5#D\xcc9 \xb9\x93hŅ;\xf8\xd5G\x04r\x83\xef\xe1 \x12\xb8\xdfl\xf6$\xcbLi?\x8eɑ<n\xcad\xd0.\x89\x8f\xbc18\xa1\xdd0\xed[\x15\xf5\x81\x04\xb5\xa4\x88\x1b\x15\xa8-\xc0ݬ\xf2\xd1|\x17\xeb\x00\xf2\xcb\x13\xa4\xf2\x98\xc5\xc1\x12\xc9\xed\x9f\xccE\xe2Wy\xcfڞ\xdb>_\x9b\xf1\x1f\xe9ͦ\xccʇ\x9f\xee?\u0092\xb4\xb4`\xcdya\xfbx\x8d\x8f\xc4g\xa2\xc8\r\x18\xe7\xc6\rя%\":\x13<9)\am\tݚtN\xbb\x91$w\xfaτ,\xb9?\x1dܖ\xcd\x02;\x84\x14\x8c\x124\x1d\xbcup\xabF\xb4\xb7\x8a\xf1\x9bӞ\x19\xe66S\xfau\xe2O\x17\xe2\xdaqf\xeb8DuU]\xec\xd0\xe5I\xbd\x0f\xa8W\x83\x92c\xd0@ur\a\x1fA\xadجS|9Zw\xe2zi\x80a\xde\xe0\x03\xed\xd76\x00eL\xd9\xfe\xca\xde]\xb9w\x95\x9e\v\xb5ޖ\x1cY\x8e\xb9\x80\x10\xfdD\x06c\xbb\xd4V1\xa4X\x8b,\xbb\xb1k.\xe5:c\xb8\x16V\u009d\xc3[!\xb8\xabN\x19C\xa6u\xb94\xef\x1d\xac\xeb\xaf,C\xb5\xc7˹\x9fՙ\x15L\x11WS\xd8>\x85\xfe\xaa:DI\xe2\xff\xaa\x8fr\xa9z\xee\xaaFt\x8a\x11\x9dԈ\xe0\x87\x15|\xf5\xff5\x12\x0e\x8a\xf1\x8b\xfc^\x8e}\x97\xef-\x94[\x1aP?j\x8bs\xb8\xb2Ο)\xea_C\xcd\x1f\xba4\x9e\xa3j\xe1ͤȪ\x9d\xc5g\u007f>9u\xe5ߕ\x06_\xe8ۙ\xe9\xf8¹9\x9e\n{\xed\U000a2e59\x9f\byk\x9a\x1e$\xa69y\x95Z\xb5\x1cŠ\xb4\xc6 hޟ?f^\xbcX\xbdG\xcaQ{7\xcf)\xf7\xf0\xdb\xef\xcd\x1c\x15\xcdv\xc1\x91\x8d\xff\x04\x00\x00\xff\xffJ\xbeWz\r\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[o丱\xf0\xbb~Ea\xbe\a'\x80\xbbg\xf7ˇ\x0f\a\xc6A\x80\xcd\xccl\xd6\xc9\xec\x8c1\xe3\x9d<\x04y`K\xecn\xc6jR!)_rp\xfe\xfbA\xf1\xa6KS\x12\xd5nofr\xe4\xf6\x83ݢJuc\xb1XU,e\xab\xd5*#\x15\xfbB\xa5b\x82_\x01\xa9\x18}Ԕ\xe3\x7fj}\xf7\x1fj\xcd\xc4\xeb\xfb\xef7T\x93\xef\xb3;Ƌ+xS+-\x0e\x9f\xa8\x12\xb5\xcc\xe9[\xbae\x9ci&xv\xa0\x9a\x14D\x93\xab\f\x80p.4\xc1\xaf\x15\xfe\v\x90\v\xae\xa5(K*W;\xca\xd7w\xf5\x86njV\x16T\x9a'\xf8\xe7\xdf\x7f\xb7\xfe\xdd\xfa\xbb\f \x97\xd4\xdc~\xcb\x0eTir\xa8\xae\x80\xd7e\x99\x01pr\xa0W\xb0!\xf9]]\xa9\xf5=-\xa9\x14k&2U\xd1\x1c\x9f\xb5\x93\xa2\xae\xae\xa0\xb9`oqxX\x1a\xfe`\xee6_\x94L\xe9?\xb7\xbe|ϔ6\x17\xaa\xb2\x96\xa4\fO2\xdf)\xc6wuI\xa4\xff6\x03\xa8$UT\xde\xd3_\xf8\x1d\x17\x0f\xfcGF\xcbB]\xc1\x96\x94\x8af\x00*\x17\x15\xbd\x82\x0f\xe4@UErZd\x00\xf7\xa4d\x85\xa1\xce\xe2$*\xca\x7f\xb8\xb9\xfe\xf2\xbb\xcf\xf9\x9e\x1e\f\xff\xf0낪\\\xb2ʌs\xc8\x01S@\xe0\x8b!\r\xa4\x13\x01\xe8=\xd1 \xa9\xc1\x84k\x05zO!'\x95\xae%\x05\xb1\x85?\xd7\x1b*9\xd5T9\xc0\x00yY+M%(M4\x05\xa2\x81@%\x18\xd7\xc08hv\xa0\xf0\x9b\x1fn\xaeAl\xfeNs\xad\x80\xf0\x02\x88R\"gD\xd3\x02\xeeEY\x1f\xa8\xbd\xf7\xb7k\a\xb3\x92\xa2\xa2R3\xcfg\xfc\xb4\x14+|\xd7#\xeb\x02\xe9\xb6c\xa0@U\xa2\x16\xfd{\xfb\x1d-@\x19\x9e \x1dz\xcfTC\xa6\xe1_\v,\xe0\x10\xc2\x1d\xd2k\xf8\x8cB\x91\n\xd4^\xd4e\x81\xfawO%\xb2)\x17;\xce\xfe\x19 +\xd0\xc2<\xb2$\x9a*݁ȸ\xa6\x92\x93\x12%V\xd3KÈ\x03y\x02I\x911P\xf3\x1643D\xad\xe1g!)0\xbe\x15W\xb0\u05faRW\xaf_\xef\x98\xf6S)\x17\x87C͙~zm&\x04\xdb\xd4ZH\xf5\xba\xa0\xf7\xb4|\xad\xd8nEd\xbeg\x9a\xe6(\xbcפb+\x838Gb\xd5\xfaP\xfc\x1f/tu\xd1\xc2T?\xa1\x8e)-\x19߅\xaf\x8d\xa6\x0f\xf2\x1dU\xdej\x93\xbd͒ذ\x97\xf1\x9d\xe1ʧw\x9foۚ\xc6\x1a%\u008f\xe5vs\x9bj\x18\x8f\x8cb|K\xa5\xb9\v\xb6R\x1c\fD\xca\v\xabk\xf8O^2ʻLW\xf5\xe6\xc04J\xfa\x1f5U\xa8\xceb\ro\x8cA\x81\r\x85\xba*P\v\xd7p\xcd\xe1\r9\xd0\xf2\rQ\xf4\xc5َ\x1cV+d\xe94\xe3\xdbv\xd0\xff\xe0\xfdW\x8e[\xe1ko\xb1\xa2\x12\xb2\x13\xfesE\xf3\xce\xc4\xc0{ؖ\xe5F\xfda+dc\x0f\xacI\xf2\x13rhR⧠[R\x97\xfa\x8b\x99\xc8\xeaV|\xa2J\xb3\x0e*G輍\xde\xe2ѡ\n\x1e\xf6T\xef\xa9D]1\x17̴\xebA\x04#@E\v3\xe7\xc8\x1d\x05\xe2\xb06\x93\xb7,\xa1\x12\u07be(\xd8<yD\xdb45\xdc\xdc\bQR\xc2;\xd7\xe8c^\xd6\x05-\x82\xbdU\xa3T\xbd;\x1a\x8e\x86B\x13\xc6qf\xe0Ҁ\x88\xf1\xe6\xaa1\xb5D\xd2\x1eP\x00\xd4N\xc6-4cE\xf74\"\x10\xfce\x9a\x1e\x8e\xb0\x1aP%\a\xbb.K\xb2)\xe9\x15hY\xf7\x1fm\xef#R\x92\xa7('\xfcB\x9dƈ0\xdaن\x92\xe5f\r\t\x16\xc0\xf0\xe2\x1bb\xc3^\x88\xbbq\xd2\x7f\xc2\x11\x8d\x05\x83\xdc\xf87\xb0\xa1{rτt2w\xcbȆ\x02}\xa4y\xad\xcdB\xde\xfd\x10\r\x05\xdbn\xa9\xa4\\C\xb5'\x8a*d\xdd0\v\x86\xa6'~\xec\x1d7B\xe9\xe3k=\x02\xfe\x10\x86\x02k\xab\xad!=\xa0\v\x82\xe7\xf4\x12e\"dA\xe5e\x04*\x00٢W@\xcaҊ\a\x88\xb4\xb8\xd3\x02\xea\xca,\x7fzO\x99\fS\x14\xaf+N*\xb5\x17\x1a\x8dr\x14\xe8\xed\x9e>]Ȇq@\xef)\a\xd6\xe6\fl\t+\x95{<\x1a\xffJR\x8b\x7f\x14\xe2\x03m\x81\x8b=t@\xb9\x06X\xf7\x17VPԂ`h\x89yv\x830\xb2\x0e\x88\x145?\x96\xbac\x1c<\xecE\x19\x04\r\xef\x1eI\xae\xcb'\x10\xdcL\x9fw\x8f47\xec\xfb\x93\xd8\xc0\xa1V\x1a6\xc1\x94\x0f\xb1mL;\xfc\x14\xef\xae\x1f#\x84\x1a\x04\x1c=\xa8#\xb8 \">\x8c\x03%\xf9\x1ed\xcd9.\xf9h\x7f\x15-i\x1eS\xf0\xe6g\xf3d\x84\x87\\\x8a#?9_\xe7Љ\x1f\x87\xf0ؐ\x1e\xc9o<\x89\xce!v\xff\"\xd5D\xee\xea\x83u\x95\x85\x97\xf20\x1d\x13\n\x95d\xbb\xba\x9f\x03\xe3\xd7FC\xe1\xfb\xd1qCF\xad\xfb\xe3V,*g1\xc7\xddӰ'|a\xad6j\xc2ÞFl|\xf7\xd3\xe6\xed\xb1\x91\\\xc3\xf5֬\x8dA\xd9/\x11\xfa\x04\xccJ\x14\x17\n\xb6L*\xddFLA\xad\x86f\xcbL\x19\x94dC\xcb\xcfF\xd1\xc5\x1c\xbe\xbdo\xdfw\x89F\xacE\x98\x9d8*\x8d\xc0\xaeV2\x15X\x06\x8c\xaf\xe1#\xfaR\x0fLQ`\xfa\xa2\xb96\x01\x16g\xf3=\x95O\x9d\xe9\xecV\xe1\xe0Č\xf3/qҦO\\\xfc\x1c\x88\xce\xf7\xef\x1eq\x83\xaa\x9a\x90@2\xd3\xfb\xb7w\x979#Jg\xb4\x84\x9c\x84\ffc\xc1$5\x06`\r\xb7{\xda\xf9Ƭy?|x;\xa5h\xc9Vሜ\x1fz(\xb7\x1f\xefܮtbp\x02\x920K\x94\xddƩK pG\x9f\xec\x8e\x157\xc5\x15\x95\x04\x1f\x85\x83\x93\xa0Jj\xf6\xc3Fu\xee\xe8\x93\x01䶸\t\xf7\xa7\xab\x86۫ҧ\xb4\x81=V\"f\u0380Y\x9e\xe2\x17H\xa3\xf9j\x86N\xb8U\xbc\xaaJ\x86^\xbe\x98\x96\xfd,s\xd3|\xbc$N\"7\x88\xb1\xd9o[A_\xe0v\xb94{B\xb5gU\"l\xc0m\x18j\x9b\x99G>\x80\xf1\x05\xa3S\x01O;\x1f\xae\xf9e6\t\xcc}>\b}\xcd/\xe1\xdd#S.v\xf4VP\xf5Ah\xf3͋1֢\x7f\x12[\xed\xadf\xeaq\xbb\x85@~\xb4\xe3\"IJo\x7f\xaf\xad_\x1bD\xc5\x14F*\x84\xf4|\xc1\x8b\xf6\x81\xc9 -J\xdem䂯\xe8\xa1\xd2O\xebȳ\x92a:\xf1\bّN\x1b=\xc7\t|l2T\xdc\x1eY\xd4n1\xe6c!ب]\x89\xc1O(j\xc3T\x92\fQiI4ݱ\x1c\x0eT\xee(T\xb8\x16\xa4J#\xd9>\x9f\xa8s\xa9\x1e\x9a\xffq\x86\xbe\x13\x96\x1b\xfa\xacp^'\x8d\xf3\xe2O\x18\x1c\rC=\x9f6\xb3@\x1b\xd7(\x81ۤ(L\xbe\x80\x947\xb3V\x89Y\xd2\xe9\xcc\xef\x16zf\x92ÁT8\xc3\xff\v\x97H\xa3\xec\xff\r\x15a2i\x96\xff`\x92\x00%\xed\xdc\xed|\xac\xf6\x83\xf0\x19\xe8\xd5\xfd\xa3f\xf7\xa4\xec\a9\xe3?h\x8e9\xd0\xd2\xf8&\x88a\xdf\xf3\xb9\xc4m\xa6\xa2\xa8\x1a\xb0\xc5LC\x02P\xa6\xe0\xd5\x1d}zuyd\x97^]\xf3W\x97~Wߙ\xf5\t`\x83\xc7!x\xf9\x04\xaf\xccݯ\x9e\xe7N%kg\xe2\xc0\xe0\xf2^eɺ\x12\"\x81ޯ\b@|,\xc7\xfb\xee\xa30aȳ\xcfΠ₿\x93rֶ壽#lV\x14\xecŃ\x8f\x1b\x87]۞\xdcOQŶ\xc04P\x9e\x8b\x1as#fŤ\x06\xb4ݢ\xa0q7\xe1\xfe\xf1\xd0\x00~(\xaf\x0f\xe3$\xac\xcc6\x95\xf1\x89}\xc8\n~$\xac<\ac%\xd5r\xd2\x1au\x18\xfb\xc9\xde\x11\x94\xa5>l\xa84\x9a\x82yK\xcfa\aw\xc6N\xda\xf2\xd9D\xc5\xd6>\xf6\x8e~)|7\xce\xd4\x03\xe3\xecP\x1f\xae\xe0\xbb\xd1a\x96\x1f\x98\xde\xdaѱu\x03\x11\x7f\xc2\xf8\xa2\xd8ngr\xc5߆\xacAe+\x05\xdfy~<\x10\x8c\xf1m\xe8V$\x85\x17l \xc0\xe0\x82\xac%&ZH\vϬ5\\k(D\xbd)\xa9\v!N\xc0\xb4\x11/\x04\xd7\xe5\xed\xf7j}\x0e-\xc2\x04\xaa\xa8\xf5\xd5Ȑ\x1e\xbf0\xc9-j\xddI\xf4\x1c\xc8#J\x12\xc8\x01\xa7\x9aW\xa9Q\x98Л\xd1\xc8f\x93\"\xf2\xb1:$2\x17\x87\xaa\xa4\x9a:\xf6\xe3\xceQ\xb1\x82N\xd0\xe4E\xe1f\xb9\xe0N\n\xb5\xa4g\xe0ٴ_\xb4\xf2\xc2\x1e\x19\x11,u\xf6\x8cu\xe3\xefbs\x95%\t\rC\xb9\xa6J\x01\xb5\xce\xfc\xe7\xbc\x00\x97)\xf19v\\\x04P\x12c\xbe9\n\x89\xe9\xb6x\xd6\xd93#4i[\xf0\xc0\xb3\x19\xda:\xb2D\xa2\x8a\x18N(ǚ)\a\x85\xf1\xee\x14\x8c\xae\xb8\xe8Ƈx\xf3\xf4&',\xb7[!\xd7\xf0\xc9閙\b\x1b\x13\xef_=\xb0¥\x17\xfe\r\xd6c\xcfs4\x8a\xea\x9b[r5=T\x18i\x9a\xc1\xbc[w\x8bW\xbf\r:ɯ\xef\xbf\xc79\xe8\xafa\xde|\x14\"Dt\xd5\xd4(X\x17\xf6Obs\xa1\x8cb\xe3Sv\x94\xa3\xd7<\xe5\xc3&\x98\x17\xfb\xfb\xb8\xba\v59+_@\xb4\xaam\x05\xd1j\xebJ\x88&\x02\xb0_\xe72\x83\x1c}\xf6*\x83S\xb3\xb5\xc0t\x17\xe9\xef\xe0\xc08\xa6\xb1\xd6\xcf\u05ff\x94\x85\xc7kh\xf6\f\xb1\xa3\"]eIB\xfa@\x0e\x1d\xc3\x1a*\x9f\xc6=\xeaIr\xc7I]\x19]\xcfN or9\x1a\v#\xb8,\xb7\x8c2'\x96䖴\x1b\xfc?!\xc7\xed4\x92\xf0'\x93\xe4Fx!Ž\xcef\x05\x91\x96\\\xf2\x92K^r\xc9K.y\xc9%/\xb9\xe4%\x97\xbc䒗\\\xf2\x92K^r\xc9K.y\xc9%/\xb9\xe4%\x97\xbc䒗\\\xf2\x92K^r\xc9K.y\xc9%/\xb9\xe4%\x97\xbc䒗\\\xf2\x92K^r\xc9K.y\xc9%\xbf@.\xd9\x1fQ\x8f\xaeQ\x1d\xb64\x87܉?f<t\xc8\x1b\xdb\x1apc\xea\x87xQW\xc0x\xc1\xeeYQ\x93\x12\x18W\x9ap\x04m\n\x15=N\xeblV@\xa9\x83-\xba\xccu\xe5q\xc6sʝ\xa6\x10&+,\xe1\x803\xe3x\xe8\xd0\xfa6D\xee\x86`w\x06a\x9d\x0fYcE\xa5u$\v3+\xc3r\xaa\x86b\xb8A\n6_\xd0\xcdN\xac\xb3Ӽ\x8b\xe9\xae\x0e\x03\xbc\x8b\xf4wh\x96Ɏ\x7f\x80N\xfb L\x80\x87=\xcb\xf7\xcd\xdc1\x8b-\x14\x82*\x93\x90\xc4X\xff\xd3:;9t\x98d_\x12}\xb5\xe9H\xdbdg\x88\tf\x86\xfbZ.\a\xf22\x88\xfe\x7f\x0f+\x19\xef\xebW\"/\xaf\x8fn<\xa7b\xbaܓ\t\xea\x9b\x18\xfa%\xee\b\x9a\x8c\x14\x90r\xcc\xe1j\x9e\xfd\xcd\tb\xaeN_\xf7\xef;\xa3N?S\n\xe1\xd1ߌ\x10\x12\v!ҋ \xb6\xac4\x81\xc1\x8e$\x06ᚘ\xf7\xa8$\x9e˂\xb4}p?\xd0>6\xb6Ǎ3V'\x9c\xa72aR\xc3^\xb2\"\xe1\xfc\xd5\b\xa7W\"\xa4\x89~V\x05\xc2KU\x1f4\x16f\x8a\xa8d\x13\xe1?\x9e۳\xc9;k\xb5\xc1\x8cJ\x03\x97)\xcff\xa4\xb1O\xa92\x98\xc5\xc4\xf4\xea\x82\x0e\v\xcfVY\x90^U0j\xed\xbb\x9f\xa4\x8a\x82\xa6R \tfB5AS%\x90\x04q\xa2\x92\xa0_!\x90\x043\xb9\x8a ɖ\x9e\xa0O)K\xb3\xff\x19߹ϫ\x18H\xae\x16H\bj̡\xa3\x95\x19\xbf\xca\xce]\x1d\x90\xcc\xf9\xce\xdc<[U\xc0KU\x04̯\x06\x98\x8e\x8dϮ\x04\bk\xf9\x04\xe0\xf3T\x01$iݷ\x11o\x03{\x9e!\x11\x8b\x8f8֣Q\te*d:\xa8\x18\x1f۩\xd5 L\x00E\xffQSl}w|\xd0\xc2Y\xccVoC\xb8\xc1Z\x06Ӟ`\x04$\xeeQ\x15\xe0As\xac\xba\x15\x0fxXڠ\xdb\xe94\x18ԇɑ.\x80>\x9aa#u\x97><\\\x05<\xbaO۳\xdd\xde?\xce<`\x04(qe\xe3\x81Y\xa8\xc1\xad0\x19\xe3\xb8㔔\xe0l\xb5\x144\x91\xe5\x11\xb8\xe3I\xf7\x84\x84{J\xb2\xbd\x1ah\x1a\x19Q\x15l\x1ai\u0084ݍF$\x8e8\xbe\xffu\x8cq\r\x1c\x95\x16A;p\xd1\xf3\x06\xcck\xca\xed\x9e*\x1am\x1fz\x04\xb1p \xb1%\xe5\xabƞ\xdb8\xd0+s`\xcf\xfc\r$\xc7+c\xbcG\x01VR\xe4T\x8d\x16\xfeO\xae\xd2\x1d\x06\x1es\xaa\x7f\xf0\aæ\xe3\x81\xe0\xe6'r\xd2\xe7\x12~\xba\xbd\xbd\x99{\xdegޞe\xfc\xecO\x84\xeaw\x8f\xad\x004v\x80\xc0\xff\xc7m\xdc<\x8c\x92O\xe9\x9cxV'\x01&$\x9f\xe7yi\xef.\xf5\x84\xcf\x1c\x1fʳ؝\x879\x81\xc9\tg~\x12\x80b\xe8\f\x9b\xb9\xb6%\x95t\xf2'\t\xb6\xc3\xe3\xd4\xf3?'H+)}\x7fZ\x12?\x01$\xf6\xd76kkji]\x12̔\x89\x9dV\x110\xab. \xb9:\xe0\x041%\x15\xe7\x9dZ\xa2\x97\x004`p\x96B\xbdd\xefa\x9e\x1fqJ\xe9\xde\x00\xcf&\v\xf8\x12\x80\x86\x93\xbb\x89e|I ;\xa5~'\x17\U000dd801I\x15\x17\x11nN\x97\xf7%@\x04\xaf\xb2^\x06\xb1\xea\x8b~\x91\xdf\x1c\x11\xb5J4N+\xf5\x9b\xcd\xd1\xf4\xc0\x86S\x91\x89qI\xbbG\xfcŗ|\\e3$h\xfc\xb9\x96\xf3d\xfe?\xb7\xf3D\x1f+\xd3\xc3\xfb\xb3&\xba\x9eo\xe7\xdeun\xf7\xe6\xce`\x8a\xaf}\xa9\x15\xe4\xa2H\xd3\b3;U\x9d\xa3߽\xadK\xf4\x84+\xc1U\xaf\x12\xe7\xff~\xf7\xdd\xfa\xecf\xeb@\xf5^\xccw \x7f6\xb7u\x88\xb6\x90|\r\xa1{3I\n\xc2С\xf2\x8f\xefnϬ\xf6\xc9U\x97\x11:C\xeeٓzT,\x89\xafraS\x9b\x96\x18\xa1ӥ\x97I \xdb\xe7\x1d\xb6C\xe5#\xcf\xe0\xdd\xd7㭵\xd4ʽ\v\x01\x03\xe6\xe6-8v\xba\xc0\x9e\xa4yk\x84C\xcd\xfd\xf4w\xb3\xf5\xdf\xcc{\xab\x88\xdeϖ\xd9\r\xd1{\xaf\xe8\b\x00D\x87\xeb\x8d9J\x00l\xf6\x86\xafϮ\x8e\x95\x90\xf3\x1d\x82\x1b!u{\x02\x83\x90m\xbf\xb4\x99\xc5\t\x801v$uG\x19\x99\x02\xcc\xd6aK\xecgl\xc2\x1c\na#V9\xa4_d\x0ff\xde\x186\xdf\x1c\x9a\x97\xaf\x85\xf0\xad\x05\xd2W\x92ن\x10\xbd\x83\xf3\xce>\x84\x98<P\x9d\x9d\xb7V\x90\xf3\x99k\xef\xeb*\xea|\xf5\x1c\xd0\xccsS\xf9\xf5;\xe7aYH:\xf8\x14\xa2=\x1d\xa7|\xac2\xfaw)\x9bH\xcc\x10\xe4\x82\x17\xea_\xe6\xcb;u<\x97/?z`'\"o\xec\xc0\x14<y<\xees\xf6 hp\xa2f\xeb\xe2\x88w\x87\n\xf4'\xb1I\x80\b\xed\x13\r)\xe7k\xd2`\xf6\xce\xe0$\x9e\xb2I\x82\x9dp\x12\xe7\x9bw\x14\x13O\xe8|s~]\xda\xf9\x9d\x97;\xc5\xe3\xb08\xfbY\x9e\x99V\xe8\x8c\xe7z\xbe\x95\xe5\xacw\xd2\xe79\xa1\xa6\xa1U-\t\xe6\x8c3A\xb3\xf5;}U\x9b<%4K\xa1\x92\x86Mg\x8d\xaax\x93ƈ\xda\xdcHzΜr%\x19\x96w\x8a\U000e655d\xf6`\x17\xc8%\xaf\xbc䕗\xbc\xf2\x92W^\xf2\xcaK^y\xc9+/y\xe5%\xaf\xbc䕗\xbc\xf2\x92W^\xf2\xcaK^y\xc9+/y\xe5%\xaf\xbc䕗\xbc\xf2\x92W^\xf2\xcaK^y\xc9+/y\xe5%\xaf\xbc䕗\xbc\xf2\x92W^\xf2\xca\xcf\xcf+\x7f\x85\x9d$\a!\xbb\x1ecol/f\x9f\x9b=Zlc\xfd\xc5\xfa\xf7\xb4\f\xf7Þj<\x97\xeeZ<\xafT.\xaaH\xabc\x9f\xe8U^\xcf744=3\xea\xee\xf5\x95\xa0\xe9\xea\xa5Ƴ\x19̱\xe4o\x84()\xe11\xfaG\x9a\xddM\xb5\xb83\x87\xccU\x89\x0e\xbbض\xd6x\xf3\x17N\x12\xf7\x88\x1eXp\xd2P\xce`6\xfd\xd4\xf0Xx\x00c\xcf\xe8{,\xd7YR\x1eud\xa2%\xb0\xe9X\x7f\xfc\xe3g\xa9G\xab\xfd\\\x97E^\xea\xd3\x1c\xea\n\xbc\xd5r\x0eY\xd4(\xcfW\xc0\xa1\xd1.qý\xe1p]$\x180%\xf7߯\xbbW\xb4p\x9d\xe2\xe0\x81\xe9}\x0f\xa2\xc9\x04s\xf3\xa6\x18\xbek\xb7j\xf5:\xa5E\x94s&\xde\xc1\xca\xcbh\x97>\x7fo\x87\x9d\xf0\xd1\xe0M\xca\xf5\x1c6\x8dy\xed\xfd&-\xc7#z\x1c\xeb\xdf0\xd6?\xce\xdb^S\xb8\xb0\xce\xe2\xed\x92\xe6\xb4^\x19Пgt\x88\xebv\x80\xcb\xc6\xdai\x8d\xf6\x85\x9b\xdd\xf7mz+5\xda\xe3\xed\x84\xcen\xbek\xdb L\x18\rH\x8cLR\xff\xf1\x1cID;0p\xa2c\x1b\x1a\xa5\xb1Wp\xcd\xea\xd3\xd6\xea\xc1\x96\xa5\xf5\x05{\x16K\xa6:\xb1u\x18\x92\xd2\x7f\xad\xdf\xf3l\x102Lv]\x1b\xee\xa86\x024\xdak-\xa5\x8fZv\x86\xf7\xb0\xcd\xe8\x9e6\xd13mĒ$\xcbvx\x01\xf2?S\xbe\xe7P\a\xb4\x89\xbeg\x13\x9e\xe9\x18V\xad\x0e_1\xa4\xd2\xfb\x99M\xf0\xa7\xa3\xd7\xe9\xbd\xcb\xc2\xfbʢϜ۱\xacۓ,\n2\xb1O\xd9@'\xb2(Ȅ\xeed\x13o!\x8b\x82\x1d]\x18G4b\xf0\x12::\x05\xd1\xe4*K_\x99ʗלSH1\xed\xb1F<\xe24\xe4F\x10\xeb\xa8\xf3\xc7\xde\xd3Z[\xad\xc6\xcdsM\xc7Z\x1e\xf6\xf1\xc2+B+\xe2\x1c\xfe\xcc\xf0\xd5P\xe8\x0ea\xe3\xbd֊\x8e\x17\x8cs\u07b8\x14\x8d\xcf\x15\x03\xd9\xf3\xe8\x15\xad\x88\x89\xf4\xe0k\xd0M\x91\x91Z\xc3;ۆ\xa25\x10O\xff\xe2.\xef\x10\xe9q\xfb*l\x80^\xfb{\xf0\x9bWk\x80\x1fE\xd8W\x06x\xea\x12\x14;T\xe5\x13&\xc9\xe0U\xf7\x969\x8e렼\xb1\xad&\xcb\xcd>\xf4\x96\xc8\x1d\xd5\xeajL`\x9f\x8e\x86w\xbdV\xc4L5\x15䟵\x90dG\xdf\v{˱\xdcZRn\xb6ʹ\xa8\x18-\xd0\xe4\x98\xf7\xef3\x1dbB\xea\x127\xcb^\ac%\xe9\b\xb0E\x13h\x87\xa5\xc0\xb2:e\xca\xd2ɎB\xe90ZgI\x8bو>'\xb0\xfdx\xfdP\x9cTj/\xf4\x17Q\xd6\a:\xce\xf2\xcfݱ\x91h\x04n\x8a\xc8\x1d\x85\xbc\x14u\x11`G'\t\x96\xd0\xdf|1^\xe1\x96J\xca\xd1%p\xf6\xdf\xf9~~\xb7\xe4wJ\xfe\xf2\x1f\xce\x19\x9dP]\xbd\x18\xa7\xbf;\xd6m:\xcc\x0eׯ\x02>\xec\xe73\x8a$\xae~\xd9p\xd9\xf1\x91\x0e\"\x86\xc7\vĠ\x1eh]\x8e\x12q{\xfb\xde\"\x8e9\xbd\xf5\xdbZ\x1a\xbaW\x15\x91\x8a\"\xff<A\xf6\xa6\r\xfe\xb9\x17\x0f=\x88`K&\x1bi\xb4\xf0\x95\x14\x19\x11/\x91\x19\xc4\xfaި\x94W0Ϧqu\xfc\x12\xbf\xa7e\x06ZB\t\xe6`\xe0\xaeރ\x00\x88R\"g\xc6\xc6bx\xc0\xd4';\x03\xf1\xfc\xa9\x1a\x9f\x8dQӨ\x8eJ\xe6:L\xf0ꅃ '\x95\xae\xa5[\xb2\xf2ZJ\xdc\x1a\xbb\n9\x9cr>\xe6}LƐ\x7f\xe0\xcc\x1d\xdad|\x9b\xa4&\x87jT&o\x8eǃ\xa4\xb9\x90\x85E\n\x95\x0e\x88C\x00\x1e\x88\n\x065\xe2\x025\xc0l\xc4\xde\xec\x1e\x10\x16-\x80\xdeS\x0e\x82\xfbB[\vP\xad[\b\xc4\xdf\"Ն\xe1B\xf6uU\nR\xf8\x99\xebP\xb3R\xb0\x8b\xb7\xc9u\xc8\v5\b\x11\xfb\x11\xa0\xba\xc7\xc8\xef\x1b?\xbb\x1c_AA4]E\x00&ر\x88J\x99.mjT4\xa6F\xcc\xf9\xea\xb9\x7f\xd3\x16\x86\xf9̽p\xa0J\x91\x9d\xd1\x1d\xa2\xe1\x01OA\x84|R\x0f,\xf8\xbd[SG\xeb\x8a'\x9cb\xd9\x10\x10\xc95\x06\xcc\fx\x1f\xf3j\x8d\xba8^\x16J\xb1Ð\x9c\x19h\x05\xe0\x97\xc9u\x96ZyI\x1f+&\xa7m\xf9\xbb0\f9bb}f\x86;s\x86\xa5~%\xdb14\x88(\xd8\x1d\x91\x1b\xb2\xa3\xab\\\x94\x18\x06\x8b\xac\xd7/#W\v\xf5\v\x95j\x8a\xa0\x1f\xdb#\xbd\x9f\xe9\x94\xd9B\x81{{\xf1ҭ\xa8(\xc1\x03\xf9\xbb\x90ǅS\a\xc6\xf1\xb5\x1a蜚=\xb7\xbfu\x9d\x8a7\xa6\xbd\xacQ\x9a\xf0)~j\rl\xb6R\xae\xa6\x04\x13e]պPP\x99\xf3wǩ\x0e\xec_\x1bϷ\x0f\xd8\xe7\x0e\x1eV\xee\r6\x9e\x83\x16\vD\xc2)\xbb\tY\x8c\x97\x01 \x12h\x9b\xf0ܜ\xf5\xfa\xfa\x18\x8doȒ\xccn\x84\x86\x88\xf596\xbe!)\x19\fo\x140\uefdcq=F>E\xdf\x13\xb4~B\x87\xfc\x8e\xd6إ\x04\xf2\x7f\xb6\x16\f%G\xdaW\xbc\x06\x19C\xd4>\x9e\x91\xcd=`4\x81\xeap\xcb\xef\x84v\xdf\xf4\xf4\xe7V{\xa2R\x1e|\x83\xe3Z\xe6\xce)\xc2\x03iJ^\xd6ټ\xa2\x8d\x15Nǡ+B\xe9SȱS.\x81\x9eOf\xe0\xf1L\x1dg\xe6\x181\x9f\xf1`\x00-\x06T\xc36\x15\xa5\xc5)D)M\xa4\x9e3\x99?wn\x18\x99\xc7(>\x03\xfd_=S\xad\xa9K \xcd\xee\u05fd\xdc*Q\\\x02Q\xf0\x9f! \xf1\xfb\xd7\xe6\xef\xdf_\xfaüQ\x90p\xac\xbd\xc0\xf8%45\x1c\xc3`\xb1\xe9\xca\x18PWi\xb3\x9eφ\xe1\xe8\xf0@U\xc2\xcaN\xdf\xc8\xf7Ҩ\xf7х\xc1\x00ʉ\x11\x00\xe4\xa2\xfaAc\xf7\x00}\x8cwGp?u\x86z\x01j\xa1I\xd9*\xf2\x8e\xf6\xfc\x1f\x89\xb7\\\x1a\xd7\xd9\xf4\xdfG\x87\xa3\xb5\xb6Z\xf7;x\x99։,p\x7f\x89>?\xe3#@}\x17~IM\r\xf93\x1cJC\x8e\x9d\xf8\xd3\xcc\xf9ѡ\xe6\xac\xfb8O\xa2Y\x19粙L_|\xfd\x1dF\xd5*\xc5{\x91ߍb\xfa1\f\xf3\x88\x96\xf8\xb7\t\xbbt=t㍫\xc6\x1d\xefA\x05\xcf\xcdK\xf7*\xbb;J+\x03\xf1`j.`C\xd1\xc9,\xa8\xf13\xa0\xe6\x9aa\xaf\x04\xeb\xa3\x1f'QG\xd5w\xcca*鎔?\x89\xf2H@G\xa4\xbf\xf7#MJ?\x0f\xd9]K'\xaaW\xcd\xcd{%,L؋\xb2p\xc4E@C\x9b`\xe4ax\xf1\xc0'TR\xfe\x8b!ؒ\x8dlEh\xc8rI\x0f\xe2>\x04\xb4\xa2\x80[\xdaz\xa4\xab\xe3\x11-\xfc\x1cDA'y\xf1\xb3(\x82\x1f\"\xa9\xa6\x1c\xbf6\xb7\xfaE\x14IZg\xe9K\xe8\n\xfe(\xee\xa9\xe4\xf8*\xdb\xe8e㡲\x81\xcb\x13v50t\x92\xb06\xf3Yo\xc9D\x92\x86\xf4/u\xb1\x1c\xd5\xd3\tJ\x06\xadwԉ\x8b\xbbo\xfd\xe8N\x90W<2\x1a\x93\xd6\n>Ї,\xeeޘw\xa2\xc5\u0094+\xb8\xe67R\xec\xb0L\xe4\xe8\xd2_\b\xc3s\xfc?\nyS\xd6;\xc6?V\xae\xa8\xecx\xa8ۧ\x1c9R+\xb8!R3R\x96OQGk\xc0\xffZ\xc1[40C\xbc\x8e\x88\xa1\xeaa8\xce\xf6\xde`\x13Z\xb4B \xea\x89\xe7{)\xb8\xc0\x00[3¹c\x98\x9c\xb1\x964\x8b֜\x13\x13Hpب֢\x00\x9b\xa8 S\xf6\xb1=l}\xddA\x14Q\x1b\xea\tѸhbZR4\xf14\x82p\xa0\x12Wo\xc2\xcd\b\x9f\"%\xda\xd6!l\x19gj\xef\x02m\x11\xe8\r\xad超g5Q\xc1\xd9\x1bg\xeb\xb8\xc5.\xf5\x18\xf5\xc6\x15SG\x1d\xeb\x86E_\x8dw\xddF~\x9a\xba\xb7\xcd?\x83\xfb\xe1\x98\t\xc9\xc6\nD\"\x01\xa0$\xd4\xe9\xf0a\x85\x0e\xd2&B\xe9\r\x9c\xb9ɹo^\xc9ܾ\x1du%\xc7E\xc4\xfc\xfd,\xd4x\xb0E\t\xf8}\b\x83=\x92\x8d\xd3[sL\xea\x89-<\by\xe79\x1c\xd8\x16\x85\rn\xfeI\n\x85\xe0t\\\xbd\x18\xd7\xff\xff\xffEG\f\xbb\x83\x8e\xc4[\xf4\xcfS\xc83\x03\x87\xbc\xfaq\x02\x87\xce^\xb2-\x98\x13\x02/F\xdc\xf3B.\xe1\xe8O \xa4=\xe1\x87\x0fyOi\x95\xdfr^e\xcf9\x185\x8ad\x142<\x1b\xf5\xf0\x8c\xeb\xb7\tȇu\xe6\xfa\xadG\xff\xfa\xadAڭ\x11\x92\xeaZ\xbad`\x97\x86\xe7a\xf7\vj\xe3\x1c\x04\xcd\r\x1eG\xd4\xe5\xa0ʭY\x8d\x8b\x97\x9d\x05Q\xc8\xf6e`&wb\x9c\xf7_'X7\xe8\xedM\xb0r\xc8I\x1f\xf5\xe3\xfc\x80\xc0\x93\x81\xebQG,\xdc\xecl\xf3I\xec1z\x83\xf51)<\n\x83=\xa3\xee\xf0o\xb1\xf5.\x8a1\xb0~J$\xf0\f\xe0Z_({L\x00g\x7f3\xbe1\n\x9b\xa7\xb63\xa4֧S\xf9!\xcdx݄\xc1\x83&\xcc9f}r\xa3\xb0a\x8a\t\x13\xb8\xfbҟ\x04\xcc}5\x95\xc7{'E]\xad<\x80\x0e\x05\x1d\xe1D!\xc3YLs]\x15\x89\x0e\xe2/vd'\xf2Z\x12\xa5;\xa7\xc7\xf2=5\xbb~D\xbf\x1a\x9bR\u0a5d`\xfe\xaf\xe3N\x9e\x10\xa5\fx_\xbf\x8d\\m\x14:r\xd1\v\xfc\xc5C\x99^\x02Wو\\\xbd\xe5k\xb2\x8d\x8c[\xae\xe3\xcaJ6xb\xbeٗ\\\xf8\xa8]L-\xfd\xf3\xd6X\x8dM}u>\xebBd\n6T\xe9\x15\xddn1\x12i\xaaDW+\xec\xb22П\r\x9d\\s\xbe\xc4\xea*\x06\xcc\xdc\xd60l\xd5\xd04a\x01\x15\xbeJ\xd4$t5\x1c\xc8\x13V\xac1N\xf2\x1c\x8bz\xe8k\xa5II\xd7s\xf8:\xb6\xb7\xc2Y\xaa0\xc0@\x8b_\xa2\xf9\x8b\x0e\x93\xafۣ\x8f\xbde\x03\xcc\xf2˴\x9c\xb1\xa5\x02eW\x9a\xfegC)\x87\aɴ\xa6\xbc{\xec\x06k\xea6X\u00a0\x04lI\xd4 \x8c;\x90\xc6ɽ\x8eo\xab{\x14݆\xa1C\x1e\xb2#J\xa0\x186\x86Q\x11\x98\x00X\x1fa^(\xee\xeeD\xc1\xe5{\xc2w\xa8@RԻ\xbd\xd7\xc0\xa0x\xdev\f\x04\x13\xf0\xb7\xa8\x11!\xb7\xb0\xb8\"\x0e\xeb|\xb5\xaax݁\x96\xa2\x85*\xc9\xef\xf0\u0379Q\x98\xf8\xc8{\xa3\xa3k&^\xd3G,\x1a\xa0+\f\xec\xae\x1c\xffM\xe9\xf0\xa5+b\x95\xccD\x17L!\xa0=X\x157\x85N\xecUE9&h,.\x93\xedp\xc7\x04\x99RSz$\xe1\xa1j\xd2^\x19BS>\x85\xec0\x05\xa1\xfe\xbf\xa1\xd8=\xbe\xe6W\xab\xf6\xc3]nL\x9d\x12\xd1\xf1=}\x03\xb0X\xb1\xd7\x11\x96\xb1\xa9dR\xdf\r\x8e$\x82\xe1\xecx\xcb\xcb\x16*\x98\xf5\x96\u0604S\x14,NaCn\xdb*P^\xe0\x8b\x9d}Q*Ӷ\xe1\x1b\xe6u\xbf\x96\xaa\x06_\xf5\x9b\xc0,_\xa2\x18s\xfe\xa2ť#\x9e\x9f\x17\xbej*\x9bק\xa0\xff\x02E\x19Aܣ\xb5\x19N\x17NBz8;vR\x8e̦\x8c\x1c樂\x97\xc0\xb6\xd9X\xa3\xa0\xfeds\xc1Yv8\xd4\x1a\xd5\xc9\xeb\xefTix\xb2\x12\x8e\xcf\xdd\xc9\xccٯ\x90?{\xe1,ZZ.-%\xa36\x9eW;kvmj\xfb\x9e\x90iKʷ%M\x9a\x84\xdcۙ3ps\fq\xe2<H\xa2sd3r\u07b8\rڊu6O\xe6/\x1b\xb2\xf9W\xd4.\xb5\x97v\xbf\x88G!w\xb2D\xad\x10\xc0:;]o\x12t\xe6\xe4\xfd\xb3\xb7\xda\xd9\f\x05;i\xb7;.\x96Y\x02!1\xfe\xc2gwʬ\a\x19lm\x87I\xa0\xb5]8<!\x86\xa7\xa4\x8c\x7fo\x8eH\xba}\x8d\xc2bX\x97\xdcS\xb8\xf99\xe6x\xa7ľSR\xdfE]e\xf3ĝ\xc0؈\x88\xdd\x02\xfc\x99\xfd\x93N\xb05\x8c\xf33^\xb1\x7f\x86\x89~\\E\x831\xe8\x81M\\\x7fѿ\x84\x03%\xaa\xc6\x17Z\xf9rͧ\x8bpZ\xe1X\xfdG)\x1ds\x04p\xb7\x83]\x1a\x8e\xaf\xf4\xa8}\xe3\x06\x8e\x92\xeav\xe4\xeblxj\x0ee{Ʒ\xea\xa5\xd8Mb\xf8^\xecF\x91\xf3\xe7\vΏ\xddй\xdb#\x14\x7fv\x03G\xf1\f{hs\xec\xb2\x1e\xf0\x9d\xcc\xdeYA\b\xa7b]\x82݊\x9b#N\xe1\xf8\x9d-X\xb8\x04\xfa\x98Ӫir\xe5\x9f\x16\x85-\x1ex\xa0\xe9\x85X\xa6\x87Ғ\x1d~ur\x92\x9eY\x18\xf2\xe9\xf3\xccy\xa0\x1bq\x7fv\\\a\r\xf7}\xa8\x94y7}\xfe\xa6)\xabi\x9f\xc4\t={\x90\xa6\x06\x9e?5\xf3\x9bȖ´\xfc\xc8q\xae\xff61\x9e0\xb2\x96\x9d\xb4\xf2\xb8\xd3 \xa3\xe4^\x8c\x1eE1\xe7N©\x12x\x8b\xcdB\xf2x`\xfe\xa6\xa4\xe8Q)J\xbbg\\.\xb2T\tv\x0f\x17&ֺ~\x19\xb8i(\xfa\xe7\\\x99\x88\x87՛\x8e\xca\xcd@旂\xf5\xa9\x84\x04\x8fo\x0e!\xe1\xa6!B\x9a\xe6\xf8\x91xl84rF\xaa\x1e\x88\xc4#\x9a\xe3\xb3\xe7/nP\xe4\xfc\x9a\xbb\xff\xbc'\xd8Z\a\xd8<~\xbf\xd2\x11\xb6\x88\xb5\xe9}\xe5\xa7\x1f\xdc\x7f\xdf\xfcg\xd8g\xb37\xee\x82\xf3\xaa\x8a\xd6\xd4v\xa8\xb8o\x9a\xa3\xa5$ǥ\xc15]\xc3/\xc0dR\xaf\xe0ի\xcc\xe5+%)ݿ\xb9\xe0\xf6Ի\xba\x82\xbf\xfe-Ú\x03<\xa1즥\xba\x82\xbf\xfe-\xfb\x9f\x01\x00h\xa52\xa9\"\xe8\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcZ\xebs\x1b\xb7\xb5\xffο\xe2\x8csg(݈K\xe7\xe6N\xa7\xe5\x17\x8f$'\xa9\x1b9R-\xc5\xfd\xe0\xb8\x13pq\x96D\x89\x056\x00\x964[\xf7\x7f\xef\x1c,\xb0o>\x94\xa6\xf5j\xc6\xdc]\xe0\xe0<~灃\x9d\xccf\xb3\t+\xc4{4Vh\xb5\x00V\b\xfc\xe4PѝM6\xbf\xb7\x89\xd0\xf3\xedWKt\xec\xab\xc9F(\xbe\x80\xdb\xd2:\x9d\xbfC\xabK\x93\xe2k̄\x12Nh5\xc9\xd11\xce\x1c[L\x00\x98R\xda1zl\xe9\x16 \xd5\xca\x19-%\x9a\xd9\nU\xb2)\x97\xb8,\x85\xe4h\xfc\nq\xfd\xed\xcb\xe4\xeb\xe4\xe5\x04 5\xe8\xa7?\x89\x1c\xadcy\xb1\x00UJ9\x01P,\xc7\x05,Y\xba)\v\xeb\xb4a+\x94:\xf5\x83m\xb2E\x89F'BOl\x81)-\xcd8\xf7\xec1\xf9`\x84rhn\xb5,\xf3\x8a\xad\x19\xfc\xe9\xf1\xfe\x87\a\xe6\xd6\vH\xacc\xae\xb4I\xb1f\x16=\xcb\x1cmjDA\x93\x17p\xe3׃\xc7jA\xb8\v+B5\vl\x99\xae\x81Y\xb8\xde2!\xd9R\xe2\xfcG\xc5\xe2oO\xadb\xfb\xa1\xa6\xee\xf6\x05.\xc0:#\xd4\xea\x00+\x92Y\xf7\x9eI\xc1kM\f\xf9\xba\x1b\x8c\x01a\xc1\xad\x11h68z@w\x95\xbe\x80\x14\x86\x10\xf5\x05;f=I\x80mE\x03y\x8bY\xa2\r\xef;/*\xae\xe9\xbe\xcfs\xb4~2\xb0\\\x8b\xe2\xf5\nO\x90!\xb3%\x1c3VJ7\x94\xf6u\xf5\xa2-\r[5\xf2\xb4V\n#[\xab-\xb5\x96\xc8\xd4\x04`etY,\xa0\xc1J\x05\xaa\x80\xd4\n啽\x83\xb9\xa3\xb5\xfd{)\xac\xfb\xfe\xf0\x98;a+\xc6\vY\x1a&\x0f!\xd5\x0f\xb1km\xdc\x0f\xcd\xd23XZ\x828\x80\x15jUJf\x0eL\x9f\x00\x14\x06-\x9a-\xfe\xa86J\xefԷ\x02%\xb7\vȘ\xf4\x00\xb3\xa9&\x15{\xe2\x05K\xbd]m\xb94\xc1mÂ\x15\xd0\x16\xf0\x8f\x7fNj\b\x10\xdc\xfdK]\xa0\xba~x\xf3\xfe\xeb\xc7t\x8d\xb9w\xeb\x81AFU@\bd-\x90\xad\xd1 \xbc\xf7ڮ\x00h\x83T\x81\"\x80^\xfe\rS\x17\xb1X\x18]\xa0q\"\xaa\x85\xaeV\x90\xaa\x9f\xf5x\x99\x12\xb3\xd5\x18\xe0\x14\x96\xb0r\x84m\xf5\f9X/\b\xe8\f\xdcZX0蕨\\c\xdcx\xe9\f\x98\nl%\xf0H\x8a6\x16\xecZ\x97\x92S,ۢq`0\xd5+%\xfe^S\xb6\xe0t\xf0=\x87\xd6u(\xfaأ\x98$5\x97x\x05Lq\xc8\xd9\x1e\f\x92\xe8P\xaa\x165?\xc4&\xf0\x96\x9cU\xa8L/`\xed\\a\x17\xf3\xf9J\xb8\x18\x96S\x9d\xe7\xa5\x12n?\xf7\xc1U,K\xa7\x8d\x9dsܢ\x9c[\xb1\x9a1\x93\xae\x85\xc3ԕ\x06\xe7\xac\x103ϸ\"am\x92\xf3/j0L[\x9c\xf6\xe2\x92\x7fV\xf9\xc4A\xbd\x937T6\xaf\xa6U\"6\xea\x15j\xe5\xb5\xf2\xee\x9b\xc7'\x88\x8bz\x13\xb4HF\x104\xd3l\xa3xR\x94P\x19\x1a?\v2\xa3sO\x11\x15/\xb4P\xceߤR\xa0\xea*ݖ\xcb\\8\xb2\xf4/%ZG\xf6I\xe0\xd6''X\"\x94\x05\x85 \x9e\xc0\x1b\x05\xb7,Gy\xcb,\xfe\xc7\xd5N\x1a\xb63R\xe9iŷsj\xfcW\r\xac\xb4U?\x8e\xe9n\xd4B\xa3^\xfaX`\xda\xf1\x13\x8eV\x18²c\x0e\xc9IXp\xda\x16Y8\x12\x18\x0f;/],M\xd1ڷ\x9ac\xf7y\x8f\xd5\xebzX\x87\xb7\x02M.,\xb9\xb1\x85L\x9b~Jc!\xaf\xb4\xaf\x18\x7f\x92\xde\x1bTe\xdega\x06\xef\x90\xf1{%\xf7\xa3/\xfeb\x84\xeb/0j.\xfa\xab\xd8zܫ\xf4\x01\x8d\xd0\xfc\xa8\xb87\xbd\xc1\xb5\xd0k\xbd\x83\xcc\xc3V9\xb9\a\xa7\xc1\xeeU\x1a\x88\xf7(\x02\\?\xbc\t\x80\b\xce\x11|)\xe8&\x81\xeb\xe0\x93:\x83\x97\xc0\x85\xa5\xb2\xc4z\x92}\xf5P\x95Eo\x17\xe0Ly\xb6ЩV\x99X\xf5Em\xd7^\xe3\xa88J\xb4\xa7\xab[\xbf\x06\x05\x1aB@a\xf4Vp43B\xbe\xc8DJa9\x13\xab\xd2xtC\xe6\x13b_\xbaQߡ\xbf\xd4 '\x1ferq\x94\x87z\x18-\xe7\x98PU\x8ei\xa6\xfb\xc0a\xf2\x90\b\x95C\xc5C\xedԾ\x9c\xf6\xf1\xc7\"\x87\x9dp\xeb*\xacE\xc4\xf6F\x1f\xf2(\xba6\xb8\x1f>\xec\xf1\xfc\xb4F\xd8\xe0\x9e<\x9aX\xb5\x98\x1at\x1eQ()\xf5\x10`\x12\x80\xb7\xa5u\xc4\x14#\xa8\x88!\xcbt\x85\xb9\x1b\xdc\xf7\x15{\u0090\xa1,;\xc5\xea\x94\xea\x95Ȩ\xc1\f\r*7\x1a\x90i\x03a\x14:\xf4;\x14\xaeSKY0\xc5\xc2ٹޢ\xd9\n\xdc\xcdw\xdal\x84Z\xcdHų\xe0\x1fsb\xc4ο\xf0\xff\x8d\xf0\x03\xf0t\xff\xfa~\x01ל\x83vk4PZ\xccJ\x19\x01ժD\xae|^\xbc\x82R\xf0W\xd3ɀ\xceq}ho\x1d&O\xea\x84\xe2\xb4\xc8\xf6\xb0[\xa3g\x87T\xf3X\xd9A\x1b\xa0\xecF\xc6̓\xf5\xaa\xf81f\xbd~\x15\xdc\xfeG\x81\x86b\x7f\x9f\x99\x19\x01\xe7\\\x17\nU\xfbbrD\x98X\xc0\v\xc5E\xca\x1c\xda.\xf2\xe3\xde%\x90\xfa\xb5!\xfe\xb0\xa8\"\xcfKǖB\n\xb7?\xca\xe8\xf4Mk$\xe4l\x13\x12Q(\xc7}\xd6A\x0eB\x1du\xddzA\x1fO\xd7(\fd\x82\"/3~ײ\xa9Ht\xa3\xf5\x15X_E\xee!ej:%\xb3\x0e\xc8r\x94萃6@h\xdf\x19\xe1\x1c*(\x95\x13\x92\xe6z‟\na\xd0&>\x04D\x16\xa7S;f=\xba\xbcPP\xc8r%T\x85([\x16\x856.rHTm2}N\xca8\x16\xbd$\xae\x98\xfc\xa3\x96\x03\xdc\r\xccq\x17GB!YJ\n\xac&\xc3ZK\x0e\x9a\xac\x80A\xb5:k\x1b\xeaj\x842\xc0n-\xd25l\x10\vo\xd5<ڢџ\xa7\xeb\xf7\b\xb9\xdeFCcԃW\xd48i\x83+f\xb8D\x1b9\x11\x06\f:J\x0eZA\xe1K\x82\xe4\x99\xee\t\x90\x8f\xd4M\x03%Qq\x15=\xa8Y\x92\xa6\x06V\x82\xfd\xe26\x9a\xca\xe1\x11\x9a\x00\xdf\x11\xa6\x14S)\x8eq:V@\xd15k\xcd\x1b}}\xab\xf3B\x8a\x03\xaf\x8f\x06\xcbZ\x9a\xf1\x92j\xa0\x89w\xdd\xf1\xa4\x14*\xa8\xa4V\xab.RX\f1̌1\x05\x11\x18,s!\xf4\x86\xf1)\xc9\xe2\xd3\xcf\xf3d9\x1ci{2\x9e\x1bu+D\x86z|19\xa2\x94\xfb\xf6\xc8X\xb9C(\x9fBx\xb3\xe8\x9cP+\v\n\xa9\x0eg\xa6\x1f\xfd}\xe9\x92j\xa5\xc8\r\x9c\x06V\x17bSۋc\xc93\"\xc1\xb2L7\xe8N\xda\xf5\xc6\x0f\x8b\x18\xaf&\x11C\xa5E\xbf-8\xce\xc0\t\xd3\x00\xa4\xec\x16\xcdi.n\xafiX]\xaa3\xb8\xbd\x86e\xa9\xb8\xc4\xc8\xcbn\x8d\n\xb6hD\xb6\xa7\xcd\xef\xd3\xdd\xe3\bM\x88z\xf4\xbb\x9a\xd09\x88\xda\x1c㽪+\x17\xb0\xdc;|\xaeh\x85\xc1L|:)ڃ\x1f\x16\x15\\0\xb7\x06\xa1\xac\xe0T\x16\x0e\xd5=\xb2=\x8cW4\x01܇:\xe77\U000d328dsݣJfԋ\xd4\xe5\xc0\xb2]\xd1\xdb#;\x11\x03Y\xba\x86\x94IY\xb7wb*=3\x93\xb2=8\xb6AXbF\tV\xb8\xa9\xa5ܞ\xa2D\x9e\xc0\x8f\x85Ԍ[\xdf\x11\xe2z\xa7\u009d\xc1z̀\xbcǗ/\x11V:\x16 j\xe5\xb7\x12\xbat\xe4\x92+\x83\xb6\x1b\xe9=\xbcb\x17\xce\xf7U\xa6\xb6\x15\x13\x86\x88\n\xdcS\vY\x97\xee*$MaAi\x85P*\x9f\xe3\xe2\xa6\vy\xf2\x9c\xaa\xe0\xa0\xe5#\xfe\x8f\x9b*\f\xaaq\x1a\xef;\xb9\xffP\x188\xb8\xf6/\xa5v\xec\xe8\xc2\x7f\xa6\x11 \x85o*\xd1ʾ\xb7\xea-\xa7\xca|Yq\x10KĠ\xeb\x9c\rc(9P\xa8(bY\x96\xc0\x0f\xb8\v\x9c\xd7\xe6\x8a/!cB\xb6\x1a\xb4\xa0ǒ(1UZ_A2\xaa\\\xa8r\xabJ\x17zS\xf5z\xaf\xc0\x10\x9cC\xb8\xf7\x12'\xbfU9\x17\x98\x1f\xbe\xe8i\xf1&\b\x19\xac\x97k\x1b;\xfa\xb6+5\xb5\xfdȈ\xa3\x91\xfc(\x9b\x8d\x9d\xa9\xef\xbaB3xOAtD\x06:\xba\xda\xdfgc/f'(6#F\xb05\xa6\x06⠣\x84\nM\r\x82\xbbیF1#\x94\xa1\x892e\xf1\xab\xd4U0G\x1d\xea\x05\xfc\xf5\xe2\xa7/?\xcf._]\\|x9\xfb\xc3\xc7//~J\xfc\x8f\xff\xbd|u\xf99\xde|yyyq\xf1\xe1\xfb\xb7\xdf==|\xf3Q\\~\xfe\xa0\xca|S\xdd}\xbe\xf8\x80\xdf|<\x93\xc8\xe5\xe5\xab\xff\x19a\xe6Ӭ\xd9\xe0τr3mf\x95VG\xf9?\x18\xfe\r\x16\x926\x9at6\xc6\xcc\n\xdd\xc0\xe4\x1d\x93\xbc\x1b\f\x0fg\x19\xc2:rm\xdf4\xa0\x1f\xa3]P;\xe9P\x068h\xca\xf6\x96\x97\xb6\x82\xa9.\x04r\xf2zr\xec\xb0\xf9\v\x15fߘ\xc2a>\x02\xdc#\xc8;j\xfaj\x1e3\xa6\x17\xa6\x9a`\xf3-լ\xa8\xd2\xe3\x1b\xe6\xf7\xc3\xf1G\x9a\x99\x81z\x9f\x99Jc\xa96\x06m\xa1\x15\xa7\xe2\xe9\xbcVf\xc3n\xf2|\xe9\aZ\x1b\xab>f\xa0\xdb\x05t\xe7M\xccZ\x93\x13\x90\f\xe7m\x93\x03:\x1cEգ\x9fS\xeb\x92\x14\xa4\x97\xfe\xe8\xafժ\x1f\x9d99\x1d\xb6\xcf\xecʿh\xb5\xe5\xc9!\xa8\xd3\xe0\x9b\x97\xbe)\x96\xc0O\n^ӱ\r\xb5t\xb8\xeftP\x993\xf4\a\xa5w4\xb9E\xcd\x13\x88\x1bxju\xf9d\xeaK\x9a\xea\xd5NHImɰ\x11\x1f\x90\xa4}\x9eA\xb9\xa7\xd3w\x9d\xc1\xf6\xff\x92\x97ɋ\xc9\xe9\x1d\xebo\xdf\xf2\xbfե:^b\xde4\xe3b\xdc\x1f\x96\r\xe3!?\x99\x9c\x9b\xdc\xe8ܟ\x0e\x14\x90\xbfí蟘\x0eM{7\x18\x1fy\xab\xfd\x8cn~\x8eGQs\x13\x86\xfd\xdc#\v~[\x1d\x19\xef\xd6`M\xb8\x1b~\x9ap\xf3xG\xf5\xb0\xa6^y}\x06\xdc\\;:=\xa6\x93\n\xaf\x94P\x18\xa5\xb2\xb4\x0e\xcd\b\xf2j\xe0\xf8B\xd5\xd7\xc5=\x05\xd1_8\xf9\xa3\xfeY\x85cm\x80#\x1d\xdaQ\xc8I\xd7L\xadpP\x85\xb5\xb8$\x94\x0e9\xedB\xb5\x81\xa6P\xe3\xb8<\b\xa8Ɔ\xb4s9j\xbf\xc6|\x87?\xfe\xa8\xb9\xd6YG\xa0\xe7\xe9z2\xbe\x1b%E\xce\\\xfc8\xe5ߋ\xbb\x15z\x9bTr\x96\xf4\xdd\xe1\xe3\x1ah\xa1\xf1\x98\xf8\xacN$\xc8\xff\xfb\xb2\xfbO\x8f\x8e\x8a\xeb?\x1f\x8a\x12\xa6\xa5\xa1\xe3\x91&\t\xd0\xc3\xd1D\x90\x9c\x15\x0f\xebo\x97\x06o\xfa\xdf2\x9d!\x8bӎɊ\x99\x9b\xb1J\xbb#\xd6Sop\x94\xf0W\xd7á\x0e\x0e\xfb\xa0T\x1b^O\x12&d\xe1C6\x15\xca\xfd\xee\xffό\xb6#I\xbe\xf7(||\xb2\x80\xedW\xcd]\xf8\xf8\x8c\xaa\xc9\xf0\x82\x8e\xd6(\xa3\xb7\x00\x13\"gx\xd2T\x0e\x94\xb2\v\x87\xbc\xf5\xdd\x10\x1dC-\xe0ŋ\xcewG\xfe6\xa5\"\x8aTd\x17\xf0\xe1#}\x03D\x1e\xc0\xc3\x01\x96]\xc0\x87\x8f\x93\x7f\r\x00W*m\x06\x05(\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VKo\xdc6\x10\xbe\xebW\f\xd2C.]m\x82\\\n\xddZ'\x05\x8c\xb6\x86a\xa7\xb9\x049p\xc9Y\x8955dg\x86뺿\xbe %y\x1f٭\xd3CE]8\x9c\xe77\x0f\xb2Y\xadV\x8dI\xfe\x13\xb2\xf8H\x1d\x98\xe4\xf1/E*;i\x1f~\x90\xd6\xc7\xf5\xee\xed\x06ռm\x1e<\xb9\x0e\xae\xb2h\x1c\xefPbf\x8b\xefq\xebɫ\x8fԌ\xa8\xc6\x195]\x03`\x88\xa2\x9aB\x96\xb2\x05\xb0\x91\x94c\bȫ\x1e\xa9}\xc8\x1b\xdcd\x1f\x1cr\xb5\xb0\xd8߽iߵo\x1a\x00\xcbX\xc5?\xfa\x11E͘:\xa0\x1cB\x03@f\xc4\x0e\x1c\x06T\xdc\x18\xfb\x90\x13\xe3\x9f\x19E\xa5\xdda@\x8e\xad\x8f\x8d$\xb4\xc5p\xcf1\xa7\x0e\xf6\a\x93\xfc\xec\xd4\x14\xd0\xfb\xaaꧪ\xeanRUO\x83\x17\xfd\xe5\x12ǯ~\xe6J!\xb3\t\xe7\x1d\xaa\f\xe2\xa9\xcf\xc1\xf0Y\x96\x06 1\n\xf2\x0e\x7f\xa7\a\x8a\x8f\xf4\xb3\xc7ः\xad\t\x82\r\x80ؘ\xb0\x83\x1b3\xa2$c\xd15\x00;\x13\xbc\xab\xf0LqĄ\xf4\xe3\xed\xf5\xa7w\xf7v\xc0\xb1&\xa0\x90\x1d\x8ae\x9f*߹\x18\xc0\v\x18\x98=\x01\x8d\xb3\x83\x10\t!2\x8c\x91\x11&o\xa5\x9dU&\x8e\tY\xfd\x82`Y\a\xf5\xf3L;1\xfe\xbax7\xf1\x80+\x15\x83\x02: \xec&\x1a:\x90\xea9\xc4-\xe8\xe0\x05\x18+,4\xd5ЁZ(,\x86 n\xfe@\xab-\xdc\x17\xe8X@\x86\x98\x83+e\xb6CV`\xb4\xb1'\xff\xf7\xb3f)\xf1\x15\x93\xc1\xe8\x92\xe0\xe5\xf3\xa4\xc8dB\xc15\xe3\xf7`\xc8\xc1h\x9e\x80\xb1\u0600L\a\xda*\x8b\xb4\xf0[\x01\xc7\xd36v0\xa8&\xe9\xd6\xeb\xde\xeb\xd216\x8ec&\xafO\xebZ\xf7~\x935\xb2\xac\x1d\xee0\xac\xc5\xf7+\xc3v\xf0\x8aV3\xe3\xda$\xbf\xaa\x8eS\tV\xda\xd1}\xc7s{\xc9\xeb\x03O\xf5\xa9T\x82({\xea\x9fɵ\x86/\xe2^\xeawJ\xf3$6\x85\xb8\x87\xd7S_\x13q\xf7\xe1\xfe#,Fk\n\x0eT\u008c\xf6^L\xf6\xc0\x17\xa0<m\x91\xab\x14l9\x8eU#\x92Kѓ֍\r\x1e\xe9\x18tɛѫ,\xe5W\xf2\xd3\xc2U\x9d\x1b\xb0A\xc8\xc9\x19E\xd7\xc25\xc1\x95\x191\\\x19\xc1\xff\x1d\xf6\x82\xb0\xac\n\xa4/\x03\x7f8\ue5af\xc8w3Z\xcf\xe4e\x16\x9d\xcdЙ\xb6\xbcOhK\xce\npE\xd6o\xbd\xadm\x00\xdb\xc8\xf08x;,my\xa0\x15\xf6\r\xbc4륆-kRP\xa6\xca1\xfdB\xb0P\xf3\xe4\x19\x8fjmu\xa0\xe6E\x14\xd4h\x96\xff\x84C\x95X\x90\xb0\x99\x19Ig=u\n\x9c\x13\xfa\x96ؑ9\xf2\t\xedĝ\x0f\x95\xa5\x8c\x135\x9e\x04\f=\xcdb\xa0\x83QxDF@\xb21\x97ف\x0e\\>\xc1k\x86b\xc0i\xaa\x96\xf4%\x8e\x16\xe5y\x96.\xcb+\x8e_ys1\x0f\xe5/7\xa1\xd9\x04\xec@9\xe3\xc9\xe1$g\x98\xcd\xd3\xd1I\x1a\x8c\xe0\xbf\x06}[8\xce\xe1\x8d\x05\xeeB|\x01\xf0\xf2#\xe5\xf1\xd4\xca\nn\xf0\xf1+\xda5\xddr\xec\x19希\v\xfb\xed\x84T\xbd\xec\xbe\x01\x933\x05wB\x9a/\x9a\x0evo\xf7\xbb\n\xfaj~P\xd4\x03\x80z\x15\xbb\x03`E#\x9b~\x81z_\xc5\xc6ZL\x8a\xee\xe6\xf49\xf1\xea\xd5ѻ\xa0nm$W\x1fI\xd2\xc1\xe7/\xe5V\xd7\xc8\xe8\xe6+Q:\xf8\xfc\xa5\xf9g\x00\"\xf7\xf4 \x8c\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOs۶\x12\xbf\xf3S\xec\xe4\x1d\xf2\xdeLH%\x93\xcb\x1b\xdeZ'\x9dz\xeaz2r\x92K&\a\bX\x91\xa8A\x80\xc5.\xa4\xb8\x9d~\xf7\u0382\xa4$S\xb4\x95\x1e*\xfa`.\x16\x8b\xdf\xfe\xf6\x0f\x96EY\x96\x85\xea\xedg\x8cd\x83\xafA\xf5\x16\xbf1zy\xa3\xea\xfe\xffTٰڽ\xd9 \xab7Ž\xf5\xa6\x86\xabD\x1c\xba5RHQ\xe3;\xdcZo\xd9\x06_t\xc8\xca(Vu\x01\xa0\xbc\x0f\xacDL\xf2\n\xa0\x83\xe7\x18\x9c\xc3X6\xe8\xab\xfb\xb4\xc1M\xb2\xce`\xcc'L\xe7\xef^Wo\xab\xd7\x05\x80\x8e\x98\xb7\x7f\xb4\x1d\x12\xab\xae\xaf\xc1'\xe7\n\x00\xaf:\xac\xc1\x84\xbdwA\x99\x88\xbf'$\xa6j\x87\x0ec\xa8l(\xa8G-\x8761\xa4\xbe\x86\xe3°w\x0448\xf3n4\xb3\x1e\xcc\xe4\x15g\x89\x7fYZ\xbd\xb1\xa3F\xefRT\xee\x1cD^$\xeb\x9b\xe4T<[.\x00\xfa\x88\x84q\x87\x9f\xfc\xbd\x0f{\xff\x93Eg\xa8\x86\xadr\x84\x05\x00\xe9\xd0c\r\xb7\xaaC\xea\x95F#\xb2\xb4\x89#\xd7#rbŉj\xf8\xf3\xaf\x02`\xa7\x9c5\x99\xa9a1\xf4\xe8\x7f\xf8p\xfd\xf9\xed\x9dn\xb1˱\x10\xb1A\xd2\xd1\xf6Yo\xee\x16X\x02\x05#H\xe0p\xc0\rʃ\x8al\xb7J3lc\xe8`\xa3\xf4}\xeaG\x9b\x00a\xf3\x1bj\x06\xe2\x10U\x83\xaf\x80\x92nA\x89\xb5A\x11\\h`k\x1dV\xe3\x96>\x86\x1e#\xdb)\b\xf2\x9c\xa4\xdfA6\x03\xfcR<\x1at\xc0H\xc2!\x01\xb7\b\xbbA\x86\x06({\va\v\xdcZ\x82\x88\x99i?\xa4\xe0\x89Y\x10\x15\xe5G\xe4\x15\xdcI4\"\x01\xb5!9#Y\xba\xc3\xc8\x10Q\x87\xc6\xdb?\x0e\x96Ix\x91#\x9d\xe2)O\xa6\x9f\xf5\x8c\xd1+'\xb1H\xf8\n\x947Щ\a\x88\x98\xd9I\xfe\xc4ZV\xa1\n~\r\x11\xc1\xfam\xa8\xa1e\xee\xa9^\xad\x1a\xcbS\xc1\xe9\xd0u\xc9[~X岱\x9b\xc4!\xd2\xca\xe0\x0e݊lS\xaa\xa8[˨9E\\\xa9ޖ\x19\xb8\x17g\xa9\xea\xcc\x7f\x0e\x19\xf3\xf2\x04)?Hr\x11G뛃8\x97\xc1\x93\xbcK\x19\f\xe91l\x1b\\<\xd2k}\x93\x03\xb1~\x7f\xf7\x11\xa6Cs\bNL\x1e\xf2䰍\x8e\xc4\vQ\xd6o1\xe6]C\x96\x89E\xf4\xa6\x0f\xd6s6\xaf\x9dE\xff\x98tJ\x9b\xce2Mi+\xf1\xa9\xe0*\xb7\x1d\xd8 \xa4\xde(FS\xc1\xb5\x87+ա\xbbR\x84\xff:\xed\xc20\x95B\xe9e\xe2O\xbb\xe5\xf4\x1b\x14\a\xb6\x0e⩝-FhV\xcaw=j\x89\x97\x90&\xfb\xec\xd6\xea\\\x02\xb0\r\x11Ա\xb2Gڦ\xba|\xaa6\xe5a\x15\x1b\xe4ǲ\x19\x8a\x8fYE\x0e\u07b7\xeaq\v\xf9/VM%}\x80F\bCg\xf8\xdf\xe9\xc9ϝ\xbe\x94\xa3\x8b\x18\xa6T\x15ׅG)ti=\xa7h\xe6\x87ʃ>uK\xc6K\xf81#\xbd\tM1[:Y\xbd\n\x9e%\xa1\x9fQ\xf9\x1c\\\xea\xf0Ϋ\x9e\xda\xf0\xac\xe6t\xa7\x1e\xee\x99e\xb5\x9fC\xb8_c\x1f\"_\x06v\xed\r~[T[\xa3\xb4m|ʽqy\x8d\x94\x1c\xd3s*\x17\xe0\x8cZ\u05ccݓZ\x8b\x052=rg_\x8c\xfe\xad\xeap\x8a\xbel\x90\xe8\xcb\xff2gD\x8f\x8ctlO{\xcb-\xec[\xab\xdb\x05\xab\x90\x1bNN\x1c\xe9{DA\xdb\xdcI\xfe\x19l\xa9/\x1b\xf1,m˜\xccgB\x81<\x13.\xf6\x82e\xc3\xe5X\xa3Ņ\xdd\xe3\xe0P<\xc1\u1f17d\xed\x89T\x9dbDϣ\r\xa1W\xcd7T\xc5\xe5r\x9e*\xf1\xd3\xfa\xa6.\x9e\x89\xe7d\xfa\xd3\xfaF.eV\xd6\x0f8\xfa\x88%\xd9ƣ\x01Y\x93\x9e\"\xe23\x02\x86\xbf\xd3\xd9\xe3b\xd4\xf0[o\xe3\xc9(\xf5\x04\xb4\xf7\a5\xe1fߢ\x1f\xae\xae\x19\x1b\x839\xa4<\x0eh\xf5x\b\x91g\x83`\xd0!\xa3\x81\xcdC\xf6\x8d\x1e\x88\xb1\x9b\xe3݆\xd8)\xaeA.\xb4\x92\xedY\xa2\xc8X\xac6\x0ek\xe0\x98\xf0{\x9d\xed[E\xf8\xac\x9f\x1fDc)\xfc\x87\xe2\x9ay\\\x15\x97;k\t\xb7\xb8?\x93}\x88A#\x11\x9a\xefC\xbf\x90\xdc3\xd18\x18ְ{s|\xcb3g9~?\xe4\x05\x80<\x8d\x9b\x13\xea\xc6Yv\x94\x1c+Fi\x8d=\xa3\xb9\x9d\x7fA\xbcx\xf1\xe8\x93 \xbf\xea\xe0M\xfe&\xa2\x1a\xbe|\x95!^\x1a\xa5\x19GX\xaa\xe1\xcb\xd7\xe2\xef\x01\x00\xf16#2{\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W[oܶ\x12~ׯ\x18\xf8\x1c\xc0/\x9669\xc9\tZ\xbd\xb5N\x03\xb8m\\#N\xf3\x12\xa4\xc0\xac4ڝ\x98\"\x15r\xb8\xce6\xc8\x7f/\x86\x94\xf6f{\xe3<\xd4Z\xc0\xe0h\xae\xdf\\8*ʲ,p\xe0w\xe4\x03;[\x03\x0eL\x9f\x85\xac\x9eBu\xf3C\xa8\xd8\xcdVO\xe7$\xf8\xb4\xb8a\xdb\xd6p\x1e\x83\xb8\xfe\r\x05\x17}C/\xa9c\xcb\xc2\xce\x16=\t\xb6(X\x17\x00h\xad\x13Tr\xd0#@\xe3\xacxg\f\xf9rA\xb6\xba\x89s\x9aG6-\xf9da\xb2\xbfzR=\xab\x9e\x14\x00\x8d\xa7$\xfe\x96{\n\x82\xfdP\x83\x8d\xc6\x14\x00\x16{\xaaa0q\xc16T+2\xe4]Ů\b\x035j\v\xdb6\xf9\x83\xe6ʳ\x15\xf2\xe7\xce\xc4>\xfbQ¯\xd7\x7f\\^\xa1,k\xa8T\xa0\xe2\x1e\x17\x94<\xccz/6gY\x0fTC\x10\xcfvqGTPb\xa8\x86%\x86]\xe1\xab\xcd\xf9@x\xe1]\x1cj\xd8:\x9b%Fl2\xaeW)\xa2D0\x1c\xe4\xb7\x1d\xe2\xef\x1c$\xbd\x18L\xf4h6\xd1'Z`\xbb\x88\x06\xfdD-\x00\x06O\x81\xfc\x8a\xfe\xb47\xd6\xdd\xdaWL\xa6\r5th\x92{\xa1q\xea\xdd%\xf6\x14\x06l\xa8UZ\x9c\xfb1\xa5\xa3W9\xc6\x1a\xbe|-\x00Vh\xb8M\t\xc9/\xdd@\xf6\xa7\xab\x8bwϮ\x9b%\xf5)\xe5Jn)4\x9e\x87\xc47\xfa\x0e\x1c\x00\xe1]\x8a|\xf4\x10d\x89r\x1a\x80m\x104\x86Z\xe8\xbc\xeb\x01-\xa4l\xc0\xed\x92\r\x8d\x1a\x01dI\x93x\nʫF\x1f\xade\xbb\xa8F\xae\xc1\xbb\x81\xbc\xf0\x84\xa8>;e\xbd\xa1\x1dxx\xaa!d\x1eh\xb5\x90)$s\xabL\xa3\x16B\n\x0f\\\a\xb2T\xb3\x94\xa0\xb5\xb9\xb4wԂ\xb2\xa0\x057\xffH\x8dTp\x9d<\r\x10\x96.\x9aV\xab\x7fE^\xc0S\xe3\x16\x96\xff\xdeh\x0e .\x994(\x14dOc*^\x8bF\xc1\x8ft\x06h[\xe8q\r\x9e\xd4\x06D\xbb\xa3-\xb1\x84\n^;O\xc0\xb6s5,E\x86P\xcff\v\x96\xa9\x91\x1b\xd7\xf7Ѳ\xacg\xa9\x1dy\x1e\xc5\xf90kiEf\x16xQ\xa2o\x96,\xd4H\xf44Á\xcb\xe4\xb8\xd5`Cշ\xffٔ\xc8鎧\a\xb5\x9eh\xb9\xa6\x1f\xc4]\x8b[ӈ\xa3X\x0eq\v\xaf\x92\x14\x957\xbf\\\xbf\x85\xc9hJ\xc1\x8eJ\x18\xd1ފ\x85-\xf0\n\x14ێ|\x92\xca\x05\xa6\x1aɶ\x83c+\t\xf4\xc60\xd9}\xd0C\x9c\xf7,\x9a\xe9O\x91\x82h~*8O\xe3\f\xe6\x04qhQ\xa8\xad\xe0\xc2\xc29\xf6d\xce1п\x0e\xbb\"\x1cJ\x85\xf4\xdb\xc0\xefN\xe1\xe9/3f\xb46\xe4i^ޛ\xa1ܻ\xd7\x035\x9a&\xc5Jٹ\xe3&U>t\xce\x03\x8e\x1d>5\xe1C\x8d\xa8O\xcb\v\n\xb2O;0\xf92\xb1L沀v\x95\x9e\xd2\\8լZ\xee(\xc8\x198\x0f\xae;P\a\xa0\x99c\xdb\xd2\xe7\xd1\xc1>\x1a\xe1r0(\x9d\xf3}\x1e/g`\xf8\x86\xe0$,\xf1\x7f\xff\x7fQ?\x9f?\xa3\xaa\xaaNv\xa3\xd0g@\xd1\xf6\xabᯑ\xf1=\x96ݓ\xf2\xc7\x0f_^<\xff\xfa\xdf\x03\xe6{3\xa1\xbfd\xf1h\xd8\xe9\u0099\xa2N\xeci<\xea\xc8\x10d\x9b\xe9yn\x9e\x06\x98\xb3E\xcf\x14\x0e4\x02\xb0M\xc1\xcfƛ\x01Z\xf6Ԉ\xf3\xeb3\xb8eY\xba(\x80 \xb8P\xe02\xb6\x13\x0e\xf9R\x9a\xe5\x7fe\x96/;\xe7K\xbc\r'\xd5w\x05z\x15\x8d\xb9\xa6\xc6\xd3\xf1L_\xec\xf3N\xc1덨\tG\b\x99\xae\xc9_\x0f\x04\xba,xKBi\x1bi]sC\xbeq\xb6\xe3\xc5\xc7\xe0\xec}P\xec\xdc\x19v\xba\xe52\x10\t\xce\xc6S\xab\xed\x85F\xfb\x1b\x86h\xcc\x16\xfeG\x86\xec\xe9SdO{C\xae\x1c\xa1\xdd#m7\x8cc͘/\xdb\xe2\x01\xc4r\xa3]\xe4\xfb\xf2:\xf1N\xa85\xd1{\xb22j\xc8\xf8=\xbe/\xa7z:\x9a\xaf\x9fG\xa6\xb4\x97\xec\x16\xe4F<\x97\xec-y\xda^\xea\a\x1aa;\x83\xc76\xf4dPxE\x9a\x82\xfbk\xf70\x15,\xd4\xdfq\xf5\xc1\f\xe9OwF\x9c\x1b\xaaA|\xa4\xe2>9\xf4\x1e\xd7{o6!\xbc\xfc\xf6Ժ\xd8\xe7=2\xbe\xeel=U\xf1\xc806\x12\xdbe\xf8Q.m\xd8ի\xdb%徸\x9afɸl\xe1\x11\x97tj\xa2Ԡ\x97^)\xdc\xd3\xf7\xc3{O<=\x85\xf0\xad\xb1\xf8:\xf3d\xcf\xd7;\x8eC\xa3\x1b\x95=M\xd7\xf1\xf7c\x99V\xf6\xa3\x96\xd3\x12\x7f\xd8]\x86;j֍\xa1\xac`J\xec\xddFӇl\xec\x0fM\x94pI\xb7wh\x9bT\xddy\xf3\n\xf9.\xf9\x81\xa8\xee\x99(\a\xa4q\xa1\xada\xf5t{\x1a\xbf\x88t@\x8e/ o\xd8\xedN:\x838\xaf\xb9ʔ\xed\x98¦\xa1A\xa8\xbd<\xfc\x8c99\xd9\xfbRI\xc7\xc6\xd9\xfcM\x16jx\xffA\xbf6\xc4yj\xc7\xd5;\xd4\xf0\xfeC\xf1\xcf\x00ncK\xb9\x8b\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\xf1\x7f\xf7_1\xd8{\xd8\xef\x01\x91|\xc9}Q\x14z\xbb\xec6Ŷw\x9bE\xbc\x97\x97 \x0fcql\xb1+\x91,\x87\xb2\xe3\x16\xfdߋ!%[\xb2\xb5^\xe7\x0e\x97f\x05\xc4\xe2\x8f\x0fg>\x9c\x19\xceP\xb3,\xcbf\xe8\xf4G\xf2\xac\xad)\x00\x9d\xa6/\x81\x8c\xbcq\xfe\xf4gε\x9do^/)\xe0\xebٓ6\xaa\x80\x9b\x96\x83m>\x10\xdb֗tK+mt\xd0\xd6\xcc\x1a\n\xa80`1\x03@cl@ify\x05(\xad\t\xde\xd65\xf9lM&\x7fj\x97\xb4lu\xad\xc8\xc7\x15\xfa\xf57?\xe4?\xe6?\xcc\x00JOq\xfa\xa3n\x88\x036\xae\x00\xd3\xd6\xf5\f\xc0`C\x058\xab6\xb6n\x1bZb\xf9\xd4:\xce7T\x93\xb7\xb9\xb63vTʢko[W\xc0\xa1#\xcd\xed\x04J\xca<X\xf51¼\x8d0\xb1\xa7\xd6\x1c\xfe>\xd5\xfb\xb3\xe6\x10G\xb8\xba\xf5X\x9f\n\x11;Y\x9bu[\xa3?\xe9\x9e\x018OL~C\xbf\x9a'c\xb7杦Zq\x01+\xac\x99f\x00\\ZG\x05\xdccC\xec\xb0$5\x03\xd8`\xadU\xa4\"\xc9m\x1d\x99\x9f\x1e\xee>\xfe\xb8(+j\"\xd9\xd2\xec\xbcu\xe4\x83\xeeՓ\xbf\xc1\xc6\xee\xdb\x00\x14q鵋\x88p-Pi\f(\xd9Jb\b\x15\xc1&\xb5\x91\x02\x8eˀ]A\xa84\x83\xa7\xa8\x83I\x9b;\x80\x05\x19\x82\x06\xec\xf2\x1fT\x86\x1c\x16\xa2\xa7g\xe0ʶ\xb5\x92\xfdߐ\x0fੴk\xa3\xff\xb5Gf\b6.Yc \x0e#Dm\x02y\x83\xb5\x90\xd0\xd2+@\xa3\xa0\xc1\x1dx\x925\xa05\x03\xb48\x84s\xf8\xc5z\x02mV\xb6\x80*\x04\xc7\xc5|\xbe֡7\xe5\xd26Mkt\xd8ͣA\xeae\x1b\xac繢\r\xd5s\xd6\xeb\f}Y\xe9@eh=\xcd\xd1\xe9,\nnDY\xce\x1b\xf5\x9d\xef잯\a\x92\x86\x9dl\x1b\a\xaf\xcdz\xdf\x1c\r\xecY\xde\xc5\xc0@3`7-\xa9x\xa0W\x9a\x84\x95\x0f\x7fY<B\xbfh܂\x01$tl\x1f\xa6\xf1\x81x!J\x9b\x15\xf98\vV\xde6\x91g2\xcaYmB|)kMfL:\xb7\xcbF\a\xd9\xe9\x7f\xb6\xc4A\xf6'\x87\x9b\xe8а$h\x9d\xc2@*\x87;\x037\xd8P}\x83L\x7f8\xed\xc20gB\xe9\xcb\xc4\x0f\xe3P\xffO\xe6\x17\x1d[\xfb\xe6>PL\xeeБ\xef/\x1c\x95\xb2_B\x9a\xcc\xd3+]F\x17\x80\x95\xf5\x80ǡ\"\x1f\xc0N\xb9\xa6\xfc\xa5ȵ\b\xd6\xe3\x9a~\xb6\xe5\xc0ɟ\x91\xe9\xedԌ^*\x89m\xe2\x83\xf2;A\x03'\xec#H\x80\xba\x9f\xba\xad\xc8S4\x04O\x1ct)\x86dY\a\xebw\x02+\xf3I\ruy\x96ty\x8cUtV\xfe{\xabhJ\\\x99\b\xa1\xc2d\x93\x0fV\xc9 \xdf\x1a#^`\xcd\xc5\x028\xabή\xdf!#xZ\x91'#\x1e\x95\x82\x8f\xb31D\x05Ԧ\xf7\xbct\xbc@\xb0G\x88 ^ \x04\x93\x82\xf1F\x9f\xdb\xec\xe7\xe3\xf1\xa4\xa4?=\xdc\xf51\xb8'\xa9\x939\x1c\xafx\x96\x11yVr\xca<`\xa8^\\\xf5\xfan\x95\xa8\x11\x1c\xa1\x06\xc1i*i\x14\xdaA\x1b\x0e\x84*5N@\x02\x88\xe3z\xeaƿJ\xf1\xa7\vs\x87\xe3@\xb8\x06\x94\xb8\xa7\x15\xfcm\xf1\xfe~\xfeW\x9bd\x9d\xc4Ĳ$\x16\x18\fԐ\t\xaf\x80۲\x02d\xd9b\xedI-\x02\x06\xca\x1b4zE\x1c\xf2n\x05\xf2\xfc\xe9\xcd\xe7)\xce\x00\xdeY\x0f\xf4\x05\x1bW\xd3+Љ\xe5}@\xed\rD\xccU\x88\xd8\xe3\xc1V\x87JO+\x8er\xe6w\no\xa3\xa2\x01\x9f\bl\xa7hKP\xeb'*\xe0JB\xc8@\xc4\x7f\x8b7\xfc\xe7j\x12\xf3\xff\x92\x93^ɐ\xab$\xd8\xfe\xcc\x1c:\xd1A\xc0\xe4I^\xaf\xd7\xe4c\x0eq\xfa'\x13hC&|\x0f\u058b\xee\xc6\x0e\x00\"\xac\xf8\x7f\nt\xa4N\x04\xfe\xf4\xe6\xf33\xd2\x1eP\x84'\xd0F\xd1\x17x\x03\xda$V\x9cU\xdf\xe7\xf0(?yg\x02~\x11W/+\xcbd\xc0\x9az7-\xad\x85\n7\x04l\x1b\x82-\xd5u\x96r\x15\x05[܉\xfe\xfdv\x89\xd9\"8\xf4a\x9c\x8dL\xa2>\xbe\xbf}_$\xa9Ą\xd6FD\x91Sn\xa5%\xe7\x90d#vF\x9b\x94>n#\x9a\x88SVh&\x02\xab<QS\x82U+)D~=;\x19p\xde[\x8fӆiG\x8d\xe9\xc3q`\xf8\x1f\x1d\xc2\x17\xa9%&\xf5\xb2Z\xf7\x03{>\xab\x96\xd4\x0f\xdeP\xa0\xa8\x99\xb2%\x8bR%\xb9\xc0s\xbb!\xbfѴ\x9do\xad\x7f\xd2f\x9d\x89!fɱy.\x82\xf0\xfc\xbb\xf8\xdfo\xd2\"f早\x12\x87~\v}d\x1d\x9e\x7f\xb5:}^y\xe9\xa9t\xbd\xe82\x9f\xe3\x99\xe2\x12\xdbJ\x97U_$\x1c\xa2\xe7\x04&@\x83*\x85\\4\xbb?\xdcl\x85\xc8\u058b<\xbb\xac+C34J~\xb3\xe6 \xed_\xcd\\\xab/p\xd2_\xefn\xbf\x8d1\xb7\xfa\xab=r2!\x96G2\xc0;%\xf4\xad4\xf9bvF\xc1\x0f\xa3\xa1}b7\x91I\xee\xc7\xe4\xb3\v\x05\f\xb8>I\xa0P\xa9xр\xf5Ù$\xeb\x8c\xce#\xe1\x1fq̀\x9e\x00\xa1A'\xfb\xf4D\xbb,\x1d\xd2\x0e\xb5\x17e0\xf4\xe5\xeb\x92\x00\x9d\xab\xf5\xc4q\x1a\xec0]\xec2o\xe4\xa8B~)\xeb)\xd9,\xce\t\x9cʋ\xa9\xf4\xb9[Z,\xa3;|$\xd1\r\xf6\x90\xa8\x1e\xe1\xc2D\xe2\xfa\foR\x05Jv5\x14-\x83\xe5T!2\x1a!)\xfd\xa8\xc1١\x14ّ\x9d\x8d\xba\x92>\xb3\x17h\x93L\xb0\x1d\x19\xc0\xd9\xfa-\x8e\xee\xd9K\xf1 t\x18\xc2\xe3o\xaa\xe0J+\xb9\xe3\xf8\x9a\xea\xdc\x16ޜ\x8e\x8f\x17\"^%\xb1\x82n\xc4\x1e;\x1b\xda\"\xf7+\x9c\x16a0\x00K\xf3\xa4d\x8aX\xa4bj'Y\xe7\nuM\xaa\x03\xe4\xfcx\xce\t\xe6\x10cI+I'ZW[T}Qԉ\xd6_\xf2<J5\x1c\xef\x1b\xae\xf9YĖI\xc5*yB\xfd\xe3\xe3ae}\x83\xa1\x00\xb9c\xc8&\x00\xe5\x0e\x10\x975\x15\x10|K\x97\x99\xb0\xdc\b0\xe3\xfa\xbc{\xfd\x92ƈ\x85`?\x01pi۰/\x10G.~͝\xf5\xe4\x97J\xe1&J\xb0\x91\bR\xa3\xf5\x16\xbaj\xeb:\xce\xe8ʍ}\x8a\x9f.Q\xa5\u0380%ɶ\xfc^\x0f\ap\x15\xf2yr\x1edĔ\xf3\xecc\xd0\x19\uf447L\xdb\x1c\xaf\x90\xc1=mO\xda\xeẽ\xb7kO|l\x1aYo\xbd'\xcaf\xf0.\xda\xf9\xc5\xfav\v\x9cW\xb9\x1b\x04\x95\xad{\xf7\xb4\x01k0m\xb3$/z/w\x81x\x1c\x84\x8f\x10\xa1\xab\"\x0e\xa4\rf\xf7W\b\t\xa7+\x8aJ4\x12\xb6\xa3\xcf\x04\vJ\xb3\xab\xf1\xb4*r\xbdt\x92\xed\x8bˈK\x1f\xac\xb5wSG>v}\xcd-E\x94\xe6֚\x13\x8b\x18\xfa\xa76\xe1O\xff?џ\x8c_\xeemף\xa0\xde\xf5\n\x81owaj\xd9߇\xfd\xec\xc1\xca\x06\x1dW6\xdcݞ\xdd\xed\xc5~Xo\xe5z\x7f6\x89`q\xff{\xac~\xcb\xc7G\xda\xf0 \xcf/5E\x0e\xe8\xc3>\x1a\x9e\x17q4\xf4\x85s#\xe2\xca-\xed\x82\x1cz\f\xa7\x86\x19\xef\x83o\x8e\xbf\xb2\xbc\x02֒\xb7\xc7\xdc'%C\xa9\xd4e9N$\xb5\xb3>\xd9\xea)\xe2\xe8 \x18\x05\xfe\xb1\xe8\xdf\"\xe6O\xd8\xc3QSw\xbbV\xc0\xe6\xf5\xe1-\x9e\xefY\xf7\x89)vtj\xa9\xc1\xe2ݭj\xd7rHC\xe4\x86\xca\x05R\xf7\xc7\x1f\x99\xae\xaeF_\x8d\xe2kiM\xcaf\xb9\x80O\x9f\xe5\xdbO\xbck\xed\xea).\xe0\xd3\xe7\xd9\x7f\a\x00\x81\x16-\x05\x9e\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\x11~\xf7_1\xd8{\xd8\x1e\x10ɗ\\Q\x14z\xbb\xcb6Ŷw\x9bE\x9c\xcbK\x90\x87\xb18\xb6ؕH\x953\xb2\xe3\x16\xfdߋ!%[\xb6\xb5\xdeM\x8aKc\x03\xb1\xf8\xe3\xe37\x1fg\x86#\xee,˲\x19\xb6\xf6\x03\x05\xb6\xde\x15\x80\xad\xa5\xcfBN\x9f8\x7f\xf83\xe7\xd6\xcf7/\x97$\xf8r\xf6`\x9d)\xe0u\xc7\xe2\x9bwľ\v%\xdd\xd0\xca:+ֻYC\x82\x06\x05\x8b\x19\x00:\xe7\x05\xb5\x99\xf5\x11\xa0\xf4N\x82\xafk\nٚ\\\xfe\xd0-i\xd9\xd9\xdaP\x88+\f\xebo~\xc8\x7f\xcc\x7f\x98\x01\x94\x81\xe2\xf4\xf7\xb6!\x16l\xda\x02\\W\xd73\x00\x87\r\x15\xd0z\xb3\xf1u\xd7P \x16\x1f\x88\xf3\r\xd5\x14|n\xfd\x8c[*u\xd5u\xf0][\xc0\xa1#M\xee\x19%k\xee\xbd\xf9\x10q\xde%\x9c\xd8U[\x96\xbfOv\xffbY\u2436\xee\x02\xd6\x13<b/[\xb7\xeej\f\xe7\xfd3\x806\x10S\xd8\xd0o\xee\xc1\xf9\xad{c\xa96\\\xc0\nk\xa6\x19\x00\x97\xbe\xa5\x02\xee\xb0!n\xb1$3\x03\xd8`mM\xd4#q\xf7-\xb9\x9f\xeeo?\xfc\xb8(+j\xa2\xe2\xda\xdc\x06\xdfR\x10;\x98\xa8\x9f\xd1\xee\xee\xdb\x00\fq\x19l\x1b\x11\xe1Z\xa1\xd2\x180\xba\x9f\xc4 \x15\xc1&\xb5\x91\x01\x8eˀ_\x81T\x96!P\xb4\xc1\xa5\x1d\x1e\xc1\x82\x0eA\a~\xf9\x0f*%\x87\x85\xda\x19\x18\xb8\xf2]m\xd4\t6\x14\x04\x02\x95~\xed\xec\xbf\xf6\xc8\f\xe2\xe3\x925\n\xb1\x1c!Z'\x14\x1c\xd6*BG/\x00\x9d\x81\x06w\x10H׀\u038d\xd0\xe2\x10\xce\xe1W\x1f\b\xac[\xf9\x02*\x91\x96\x8b\xf9|me\xf0\xe7\xd27M\xe7\xac\xec\xe6\xd1+\xed\xb2\x13\x1fxnhC\xf5\x9c\xed:\xc3PVV\xa8\x94.\xd0\x1c[\x9bE\xe2N\x8d\xe5\xbc1߅\xde\xf9\xf9z\xc4Tv\xbam,\xc1\xba\xf5\xbe9:٣\xba\xab\x8f\x81e\xc0~Z2\xf1 \xaf6\xa9*\xef\xfe\xb2x\x0fâq\vF\x90Ы}\x98\xc6\a\xe1U(\xebV\x14\xe2,X\x05\xdfD\x9də\xd6['\xf1\xa1\xac-\xb9cѹ[6Vt\xa7\xff\xd9\x11\x8b\xeeO\x0e\xafcTÒ\xa0k\r\n\x99\x1cn\x1d\xbcƆ\xea\xd7\xc8\xf4\xbbˮ\ns\xa6\x92>-\xfc8\x19\r\xfft~ѫ\xb5o\x1e\x92\xc5\xe4\x0e\x9d\x86\xff\xa2\xa5R7LUӉve\xcb\x18\x03\xb0\xf2\x01\xf0,]\xe4#\xe0\xa9\xe0\xd4\xcf\x12ˇ\xae]\x88\x0f\xb8\xa6_|9\n\xf3GX\xfd<5c\xa0\xa5\x19N\xa3P\x7f'hP*\xb8\xa6\x13H\x80z\x98\xba\xad(Pt\x05ͦ\xb6TW\xf2lŇ\x9d\xc2\xea|2c[\x1e\x95]\xbf\xad7\x17\xe9\xdf\xfb\xde\xe9\x03\xad(\x90S\x97N\xd1\xdf\xfa\x98#\x04\xad\x1b\\?%y\x10\x7f\x82\bꆁ\xa6\xa9=&\xf5\xe3\xf9p\x92\xe8O\xf7\xb7C\x0e\x1c\x14\xed)\xcb\xe9\x8a\x17\x05\xd1\xefJ\xb3\xfc=J\xf5\xe4\xaa\u05f7\xab\xb4\x8c\xe2\xa82\b\xad\xa5\x92\x8eR+X\xc7BhR\xe3\x04$\x80\x06N\xa0~\xfc\x8b\x14\xff}\x9a9\xa4c\x95\x1aP\xf3\x8e5\xf0\xb7\xc5ۻ\xf9_}\xe2:\x89\x89eI\xac0(Ԑ\x93\x17\xc0]Y\x01\xb2\xee\xb0\rd\x16\x82By\x83ή\x88%\xefW\xa0\xc0\x1f_}\x9a\xd2\f\xe0\x8d\x0f@\x9f\xb1ikz\x016\xa9\xbcOh\x83\x7f\xa8o\xab\x10{<\xd8Z\xa9\xec\xb4ᨇno\xf06\x1a*\xf8@\xe0{C;\x82\xda>P\x01W\x1a\xc1#\x8a\xff\xd6\xd0\xf9\xcf\xd5$\xe6\x1fR\x88\\鐫Dl\x7ff\x8d#\xee@P*\x14\x90`\xd7k\n\xf1\f?\xff\xe8\x04ڐ\x93\xef\xc1\a\xb5\xdd\xf9\x11@\x84\xd5\xe8Ky\x86\xcc\x19Ꮿ>=\xc2\xf6\x80\xa2:\x81u\x86>\xc3+\xb0.\xa9\xd2z\xf3}\x0e\xef\xf5'\xef\x9c\xe0g\x8dǲ\xf2L\x0e\xbc\xabw\xd3l=T\xb8!`\xdf\x10l\xa9\xae\xb3T+\x18\xd8\xe2N\xed\x1f\xb6K\xdd\x16\xa1\xc5 \xc7\xd5\xc0$\xea\xfb\xb77o\x8b\xc4J]h픊\x9e2+\xabg\xbe\x1e\xf6\xb13\xfa\xa4\xf6q\x17єNY\xa1\x9bHk\xfa\x8d\x96\x12\xac:=\xc2\xf3\xeb\xd9ـ\xcb\xd1zzlO\aj<\xbeO\x13\xc3\xff\xe9\x10|\x96Y\xeaRO\x9bu7\xf2\xe7\x8bfi\x11\x1f\x1c\tEˌ/Y\x8d*\xa9\x15\x9e\xfb\r\x85\x8d\xa5\xed|\xebÃu\xebL\x1d1K\x81\xcds%\xc2\xf3\xef\xe2\x7f_eE\xac\x8c\x9fgJ\x1c\xfa-\xec\xd1ux\xfe\xc5\xe6\fu\xddsO\xa5\xebE_x\x9c\xceԐ\xd8V\xb6\xac\x86\"\xfd\x90='0\x01\x1a4)\xe5\xa2\xdb\xfd\xeen\xabBvA\xf9\xec\xb2\xfe]0Cg\xf47[\x16m\xffb\xe5:\xfb\x8c \xfd\xed\xf6\xe6\xdb8sg\xbf8\"'\vR\xfdj\xfdukT\xbe\x95\xa5P\xcc.\x18\xf8\xeeh\xe8P\x05N\xd4q\xfb1\xf9\xec\x99\x04\xd9a˕\x97ۛ\x8b\f\x16\xfba\xc3\xea\a\xc9\xfb\xf2m@R\x17\xbdP\xb7=\xca$\xc1\\d\x91\xea\xee\xa9*\xb8\xe7\xa0{\xd6\x1f\vZ\x81~\x15\x13}\x1d\xd22g\xcc$\x9b\xae\xe0\x8fF\xb4~\\\x01d'\xfb{\xd4u\x10\xfd\xa89\x191{\xc2w\xb40뎊\xde˯3q\xf8\xa0Y\x8aO\xe9AT\xbd\xaf{\xa1)\xbd\x16sǗ7\x97v\xee\xf5\xf9\xf8xC\x10L\xe2%\xb6\xa1\xf8\xb6\x109\xc3\x16yX\xe2|\xdf`\x84\x96&\xc6\xeb\x8a\xd2\aC&\x16[Z\a\xae\xd0\xd6d\x06D\xd6R\x88 \xdeɄ\xeb\xf3\\9\xc0tL&\xbe\xe7M\x10>\x9d\xb5\xf2\xa1A)@_\x933\x058\xe9\u05fb,\\\xd6T\x80\x84\x8e\x9e\xe7|\xfaRˌ\xeb\xcbq\xf0k\x1a\xa3\x84q\x98\x00\xb8\xf4\x9d\xec_\xb1\xfa\x80\xe8Ϳ\xe6~\xc7\xf3\xe7\xd2h+\xe4\xcb$\xeeuĔ_\xed\x83\xf2\x92c\xe9\x87\\ל.\x91\xc1\x1dm\xcf\xdan\xdd}\xf0\xeb@|\xba\a\xd9\xe0\vg\xe5w\x06o\xa2\a<\xdb\xe0~\x81\xcb6\xf7\x83\xa0\xf2\xf5\xe0\xb9^\xb0\x06\xd75K\nj\xf8r'ă\x02C\xa0\x9f`B_\xf3\x1et;\xcc\xefw\xcc$\xa0\xbe\x82/\xd1i&\x8b\xde)\x1e\x8c\xe5\xb6\xc6\xf3\x12\xbe\x1d\xe8ii\xaaΩ\x11r\xf0\x8b\x1e\x1a4\xa4cߗ\xbcSG:7ޝ9\xc58\x14\xac\x93?\xfdq\xa2?\xb9\x99\xde\xf2\xad\x8fRa߫\x12\xfe\xbc\x93\xa9e\xff7\xecG\x0f_\x16\f\xb2\x8f\xec\x8b{\xbe8\x1a\xfaT֊\xc0S9k\x9c~\xce\xd3\xcd\xf1\"\xdf\"\xd3LHs\xd2\xd4_\x8b\x14\xb0yyx\x8a\aO\xd6_\xd0\xc7\x0eHYՌ\x16\xef/\xa3\xfa\x96Á\xa5W\v\xad\x90\xb9;\xbd\xa1\xbf\xba:\xbap\x8f\x8f\xa5w&\xfeс\v\xf8\xf8I/\xcd5\x87\x98\xbe\x10\xe6\x02>~\x9a\xfdw\x00\x98\xaaEc\xdc\x18\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMo\x1b7\x13\xbe\xebW\f\xf2\x1eryw\x95 \x97bo\xad\xdb\x00A\x13#\x90S_\x82\x1c\xb8\xe4\xacĚK\xb2\x9c\xa1\\\xf5\xd7\x17C\xedJ\xab\xf5Z1\x02\xd4\xf2\xc1\x1c\xce\xc73\xcf|\x98ZUU\xb5R\xd1\xdec\"\x1b|\x03*Z\xfc\x9b\xd1ˉꇟ\xa8\xb6a\xbd\x7f\xdb\"\xab\xb7\xab\a\xebM\x037\x998\xf4\x1b\xa4\x90\x93\xc6_\xb1\xb3\u07b2\r~\xd5#+\xa3X5+\x00\xe5}`%b\x92#\x80\x0e\x9eSp\x0eS\xb5E_?\xe4\x16\xdbl\x9d\xc1T\"\x8c\xf1\xf7o\xeaw\xf5\x9b\x15\x80NX̿\xd8\x1e\x89U\x1f\x1b\xf0ٹ\x15\x80W=6\x90\x90\xd8\xea\x841\x90\xe5\x90,R\xbdG\x87)\xd46\xac(\xa2\x96\xb0\xdb\x14rl\xe0|q\xb4\x1e \x1d\xd3\xd9\x14G\x9b\xd1ѡ\\9K\xfc\xfb\xe2\xf5GK\\T\xa2\xcbI\xb9% 嚬\xdff\xa7\xd2\x13\x05\t\x10\x13\x12\xa6=\xfe\xe1\x1f|x\xf4\xef-:C\rt\xca\x11\xae\x00H\x87\x88\rܪ\x1e)*\x8df\x05\xb0WΚ\xc2\xc8\x11|\x88\xe8\x7f\xfe\xfc\xe1\xfeݝ\xdea_8\x17qL!bb;\xe6(\x9fI}O2\x00\x83\xa4\x93\x8d\xc5#\xbc\x16WG\x1d0RQ$\xe0\x1d\xc2\xfe(C\x03T\xc2@\xe8\x80w\x96 a\xc9\xc1\x1fk<q\v\xa2\xa2<\x84\xf6O\xd4\\Ý\xe4\x99\bh\x17\xb23\xd2\x06{L\f\tu\xd8z\xfb\xcf\xc93\x01\x87\x12\xd2)F\xe2\v\x8f\xd63&\xaf\x9c\x90\x90\xf1\xff\xa0\xbc\x81^\x1d \xa1Ā\xec'ފ\n\xd5\xf0)$\x04\xeb\xbb\xd0\xc0\x8e9R\xb3^o-\x8f\x1d\xadC\xdfgo\xf9\xb0.}i\xdb\xcc!\xd1\xda\xe0\x1eݚ\xec\xb6RI\xef,\xa3\xe6\x9cp\xad\xa2\xad\np/\xc9Rݛ\xff\xa5\xa1\xfd\xe9\xf5\x04)\x1f\xa4l\xc4\xc9\xfa\xedI\\\xba\xecYޥ\xc9\xc0\x12\xa8\xc1\xec\x98\xe2\x99^\x11\t+\x9b\xdf\xee\xbe\xc0\x18\xb4\x94`\xe2\x12\x06\xb6\xcfft&^\x88\xb2\xbe\xc3T\xac\xa0K\xa1/<\xa371X\xcf堝E\x7fI:嶷,\x95\xfe+#\xb1ԧ\x86\x9b2\xd7\xd0\"\xe4h\x14\xa3\xa9Ⴧ\x1bգ\xbbQ\x84\xff9\xed\xc20UB\xe9\xf7\x89\x9f\xae\xa3\xf1G웁\xad\x93x\xdc\x16\x8b\x15\x9a\xcf\xff]D-\x05\x13\xd6\xc4\xd0vV\x97\x19\x80.$PO\xf6E=q\xbc4\x9c\xf2i\x95~\xc8\xf1\x8eCR[\xfc\x18\xf4d̟A\xf5˒\xc5\bKV\x9cL\xa1\xfc\xbd\xa88\xf3\f\xc0;œ\tee\xfdi\xcc\x17\xf2x\x96r\xf9핌\xabW^\xe3\xfb\xd2;^\x1f\xae\xe6\xf2i\xc1@RمG\b\x1d\xa3\x9f\xba\x1cQ\xb68s\t\x90\xb2\x7f1\xc8\xe3N\xfe`\xa4\xb5:\x8b\xe9*\xc0\xcdLy\xe4\xb9\xcb\xce\r۽ҡ\x8f\x8am\xebp\b'\xed0s\n`\x8f\x01\x0fr\xff\xa3\xfc\xee\x83\xcb=\x9e\xfe7\\E~\x7f\xa9;m\x90b<\x82\x90\xfc&Xf.a\xec\t\x82\x18\xcc\x00`hZ\x92<_\x88]\xba\xc1&\xbc؆\xd5r\xf3_h,uԅ¼\x9a\x17\x973\xbe\xbe\xbb\fXq\xbe\x98\xcf\xeb련\x8f\xc4\xea\x9c\x12z\x1e\x9c\xc8\f\xfe\xd8Bp\x8ax2\x16\xf2\x06\xbaZ\xe7\x8fO\xf5GH\xe2\n\xd8\xf6x1E\x8f\x8a\x96\xe6\xa5\v\xa9W܀\xac\xf6J\x8cf\xf7\xf2\x02S\xad\xc3\x068e|Y\xd5e\x11\x13\xa9\xed\xf5\f>\x1du\x04\xb5\x1a\r@\xb5!\xf33Ċ\xf4\x1a\xb5W\x11ŝ\xa2\xebx>\x8b\xc6RY\xf1\xa5\xc1\xd1\xe7~\x1e\xa2\x82[||\"۠2\x87\xa7\x9a\x81\x97.\x9e\xc9i\xa1\x97g\xa2\xe1)\xd7\xc0\xfe\xed\xf9T\x1a\xbd\x1a\x9e\xd4\xe5\x02\xa0\xbcLͤ\xc4t\x9c\xcdAr\x1e\x10\xa55FFs;\x7fR\xbfzu\xf1B.G\x1d\xbc)_\x13\xa8\x81\xaf\xdf\xe4\x91\xcb!\xa1\x19\x1e\x9d\xd4\xc0\xd7o\xab\x7f\a\x00lC\xbf\xee\x8e\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}ks\x1b7\xb6\xe0w\xfe\n\x94\x92*\xda;\"e\xdf\xd4\xdc\xdaUMmJ\xd7V\x12Ub\x99ei<5\x95\xc9f\xc0\xeeC\x12\xabn\xa0\x03\xa0)q6\xfb\xdfo\x1d<\xfaA\xf1\xd1@S\x96\x9d!\xa9J\xacV\xf7i\xe0\xbc_\x00h\xc1>\x82TL\xf0sB\v\x06\x0f\x1a8\xfe\xa6\xc6w\xffS\x8d\x998[\xbe\x9e\x82\xa6\xaf\aw\x8c\xa7\xe7\xe4M\xa9\xb4\xc8?\x80\x12\xa5L\xe0-\xcc\x18g\x9a\t>\xc8AӔjz> \x84r.4\xc5\xcb\n\x7f%$\x11\\K\x91e Gs\xe0\xe3\xbbr\nӒe)H\xf3\x06\xff\xfe\xe5\xab\xf17\xe3W\x03B\x12\t\xe6\xf1[\x96\x83\xd24/\xce\t/\xb3l@\b\xa79\x9c\x13\tJ\v\tj\xbc\x84\f\xa4\x1831P\x05$\xf8\xb2\xb9\x14eqN\xea?\xd8g\xdc@\xec$>\xd8\xc7͕\x8c)\xfdc\xf3\xeaOLi\xf3\x97\"+%\xcdꗙ\x8b\x8a\xf1y\x99QY]\x1e\x10RHP \x97\xf0W~\xc7\xc5=\xff\x8eA\x96\xaas2\xa3\x99\x82\x01!*\x11\x05\x9c\x93k\x9a\x83*h\x02逐%\xcdXj\xa6h\xc7%\n\xe0\x17\x93\xab\x8f\xdf\xdc$\v\xc8\r\x12\xf1r\n*\x91\xac0\xf7\xf9\xf1\x11\xa6\b%\x1f\xcd\xfcp\x10\x86\x10D/\xa8&\x12\xccP\xb8VD/\x80Т\xc8Xb\xdeB\xc4́$\xd53\x8a̤\xc8kXS\x9aܕ\x05тP\xa2\xa9\x9c\x83&?\x96S\x90\x1c4(\x92d\xa5\xd2 \xc7\x0eL!E\x01R3\x8fX\xfc6X\xa9\xba\xb66\x87!N\xd2\xdeCRd\x1e\xb0C]\xdak\x90\x12e\x10@Č\xe8\x05S\xf5\x94\xcc4\x1a`\t\xdeB9\x11\xd3\xff\v\x89\x1e\x93\x1b\xa4\x80TD-D\x99\xa5\xc8qK\x90\x88\x92D\xcc9\xfbW\x05Y\xe1\x04\xf1\x95\x19ՠt\v\"\xe3\x1a$\xa7\x19\x92\xa7\x84SByJr\xba\"\x12\xf0\x1d\xa4\xe4\rh\xe6\x165&\xef\fI\xf8L\x9c\x93\x85օ:?;\x9b3\xed\x85'\x11y^r\xa6WgF\x04ش\xd4B\xaa\xb3\x14\x96\x90\x9d)6\x1fQ\x99,\x98\x86D\x97\x12\xceh\xc1Ff\xe0\x1c'\xab\xc6y\xfaUE\xacac\xa4z\x85\f\xa5\xb4d|^]6\xac\xbd\x15\xef\xc8\xe2\x96s\xeccv\x8a5z\x19\x9f\x1bB|\xb8\xbc\xb9mr\x15S\r\x90\xc4a\xbb~LՈGD1>\x03i\tgx\v!\x02O\v\xc1\xb86\xe0\x93\x8c\x01o#]\x95Ӝi\xa4\xf4o%(d]1&o\x8c\n!S e\x91R\r\xe9\x98\\q\xf2\x86搽\xa1\n\x9e\x1c\xed\x88a5B\x94\xeeG|S\xf3\xf9\x8f\xbd\xd1b\xab\xba\xecU\xd4F\n9\xe9\xbe) iI\x06>\xc4f^\x8cgB\xb6\x84\x1f\x15\x82\x17\xc9mb\x89_+ۨ\x82\xda\xd7\xd7\x06\xf1_\xd5m\xc8+H\xb0\x92\xb3\xdfJ0*\x14\x05\x0e/=R\x17\xb5&l\x7f\x90\x05\x9a\x83ۊA\xfc\x81\x87$+SH+5\xa9v\x8e\xf4\xf2\xd1\xed(\xf2\x9a2\x8e<\x8eJ\x1d\x87\xcb\xeb\xbf\x1a\x05I7\x8c\x12\xf9\x8cq\v\x8d0n\x90\xbe\x01\xb3\xf8\xc34䏆\xb5cN\xc4X-:\xcd\xe0\x9chY\xae\xbf\xdb>G\xa5\xa4\xab\x8d\xa8\xf0V\xb6\x1b&\xaa\xbb\x9d\x98g,\x01\xc4A%\xcc\x06\x19_\x12\x1e\x16B\xdc\xed\x9e\xfb\x0fxG\xad\x8dHb\xbc\x132\x85\x05]2!\x1d՝I\x98\x02\x81\aHJm,p\xfb\x9b\x968h\"$)\x84\xd2\xdb\xe6\xbdM\xbaZV\xf5\xf1\x9f\xb6\"l\x9b\x12\xf0\xa4\xc4\xe9\xb5\x14\x82\xe0\x80c\xcc\xd1\xe6\xd4\xf7JQ\xda{\xd5`\xc3\v\bن\x052\xa5\nR\"\x1c\xad\xcb\f\x94{Sj\x14M-=\xa7[\x00W\x93\xb6\xb62\xa3SȈ\x82\f\x12-\xe4:\xf6\xf6㰫&\u0602\xbd\r:\xc1iO\xa7K\x9b\xea@l\x85I\xc8\xfd\x82%\vkƐ\a\r\x14\x92\nPFHЭZm\x9e\xdc\x1eZ\xef\x15\x93\x8e\x02\xb3_t\x1ec\xb3R\x0f\x81Ȭ\x9e[\xc3eE\xfa\x7f\x1fT2\xbe\xce_\x1dqy\xf5\xe8\xc1C2&\"\x91\x81\x1a\x93\xab\x19\x81\xbcЫS´\xbf\x8a\xde.5\x91Ӷo\xfd\xee/\x8e\x10\xa1<}\xb5\xfe\xdc\x01y\xba'\x15\xaaW\x7f1D0\xca\xfe\xc6\xe9\xfa\x8e\x04\xf8\xa9\xf9\xcc)a\xb3\x8a\x00\xe9)\x99\xb1L\x83\\\xa3\xc4V\xb8\x049{'%\xfa\xa2`\xbf\xa5\xc2oNu\xb2\xb8|\xc0\xc0[\xd5\t\x8fN\xd8X\x7f\x94\xb0\xa6\xef\xda6\xa6;\xa1\xa2\xf7\xf1[\xc9$\xe46$\xbb]@\xeb\n\xa1\x12\xc8\xc5\xf5[H\xb7sW'\x0e{4\x85\x8b\xb5a6_\xeb\xfc\xd0n\x13pNJ\xe5Û\xf0T\x9d\x12J\xee`e\xbd\v\f\xf6\v\x90\x14_\x837\xef\x85(\xc1\xc4\xf8F\xb4\xef`e\x80\xb8\xb0}ϳ\xddH\xef\xe2nX\xed\xbfi\rm8\x1a\x17`Y\xfc\xe1\x05\x9c\x93\xb9ԑ\xe6>\xe9\xe25\xccn\xda\x06\xa8\b\xff\xf5\xd8\x0e\x9e^E\xa6:O`\t9\xc40?3\xa1\xacZ\xb0\xa2\x03\\#\xe6\xc8EF&|\xd2\xe5#\xa6Ϫ\xf1Y\xfe\xbe\xe2\xa7\xe4Z\xe8+~:\xe8\x00\x95\\>0L6 O\xbc\x15\xa0\xae\x856W\x0e\x8eD;\xe4`\x14\xdaǌ\bq\xab\x86q\xfe\xcd\xdc\xcd^&\xb6?W3\xc3S\x15I\x98\xc2L\x8a\x90\x0eW\xe6\x8f\xeee\xbb\xb4}\xfb\x93\x97Jc$\xc1\x05\x1f\x19c7\xde\xf4\x1e\x87⎌ܤ\xc2\xe3aU\xaf\xb4\xaf\xeb\x04\xf1\x16\xfd$\xfb\xb4\xcd$f\x98}\xf5\xb1\x9eɄQ\rs\x96\x90\x1c\xe4\x1c\x06{\xc0\x99\x9f\x02uv\x97\xd7wҥ\x11\xfc\xd4\xc54\xfb\x8fSƭ\xb4\xe0\xa6\xef\bes\xef=\x9e\xb4{nܘ\xfa\x8a\x9f\x871\x92\xc6o\u0603M\x9a\xa6\xa6\x12A\xb3Ig\xed\xdd\x19\xf3-\xd9l\f\xc9\b(\xc9i\x81\xd2\xf9\xff\xd0T\x19Y\xfa\xff\xa4\xa0L\xee\x95\xd0\vSNȠ\xf5\xa4K\xbd4_\x82\xf0\x99\"H\xcd%\xcd\xd6\x13\xa8\x8f?\xa829\x81\xcc\xf8\x038\xb2uO\xe3\x94\xdc/\x84\x02$;\x99a\xb9\x82\xac\xe5y\x1f\x7fO\xee`ur\xfaH\xc6O\xae\xf8\x895Ϗ$\xd6\xdb\xf2=\x80\x05\xcfV\xe4\xc4<y\x12\xef\xbat\xe2\xba\x0e7\xf1\r)\xd2-l\xd0L\x93\xd6\xf9Q犎\a=xN\xc8\x14d\xc7Q\xbc\xc7{\xfd0\n\xa1\x98+\xf7\xac{Ŗ\xad\xb6\xc2$D\xc1o%p\x9b34I0|V\xd5\xc9\"\xa76\x9dw\x8ei*C\xad\x1d\x10-\x00\x03.U\x8e\xe3\xec\xb5\x05]\x02\xa1d\xc1\xe6\v\x90v\xba\x86І\x17\xfc\x1bw@\x168N\x1cI\xf5\n\x9ae~X\xe9\xd6\xf7e\xe2\x1ev\xa9\xafz \xa9\xe0\xae\xfeC\x11ڰ\x81I4)\x8d\x1c\x1a\xe3\x18\x8eJ\xa0j\xb7\x0e1\xb0\xc7\xe4-\xcch\x99\x99*\ay\xb5\x9dIr\xc6Y^\xe6\xe7\xe4\xd5\xd6[,\x1fa\xc9j\xbeuRH\xc7\x1f6eQ\xb70\xd3\xc4\xdf\xdf\x0eE6\xa4%\xf7\x84\xc8\x0e=\x95\xadED\xce4HG#s\xad\n\xf2ƃh#\xda\x1a\xfd\x86aV\x99S\xea\xb9\xc3\x10r\aD\xe2j,\xfb\a\xd7=L@l\xec\xbecm&\x97\x0f\x8d\xa4/\xe5\x86\xdbZ\x138d\x00\x83\xc52ڮ\x1dv\x1a\xe4\x1b\xfb\x9c\xd7=\x0e\x8c\x15\x1a9/\xd1\xf6\xec\xd3\xfd\x8e\x93\x85\x17'[\x91\xbegz\xc18\xa1\xbe\xa2\x03\xd21\x8f\x91\xc5N \x17T\x91)\x00\xf7HK\x9f\xd7e\xcb\x19\xbf2\xc0\xc9\xeb\x83:x\xa4FQ\x04\xf9<r+\x02V\x17\xb8\xb3&\xe9`/L\x93\x95\x04\t-\x1ex\\k0\x01\x02\xa6|\xeb\x84O'\xd8n\x1cCEfL\xaa*A`M^\xa9\xba\x116\x88Z8b\xec;\x11\xa5\x0e\xc6\xe9e\xfdl%\xbe8\x83\x9c>\xa0:'4\x17\xe5^\xef\xcdً\x19\xd1,\xaf\xaa\xad\x0e\xa3\xf7\x94i\xa3\xa0\xbc\xf1C;\x92\x88\xbc\xc8@w\v`\xa60\xc3\xeaQ\"\xb8b)T&\x1dg]\x1aׁ\x92\x19eY\xf9\xb8\xfa\xd5\x1b\xb3\x82_J\x19\x91Nxo\x9f\xabX\a\x8d\xf1}\x1b1\x1d@\xe2ԍ\x1f\xc0f\x98\x99\x05\x9e -0\xe1\x88\nּ\xc0!\x81\xcf\x1f7>l\xfbtQ\xc6\xf8\x05^\xe6]&>2r\xc9\xf8\x8e\xbcd\xfd\x1d\x91\xef(\xcb\x06{\xef\v#\x93\x04-;\x19\x8e52}\xb0\xcfyu\xc2\xcb|\x8aΝ\xe5c\xe5\xe8\xd5\x01h5\x82\x96J\xb1TC\xd6T\x9d\xbd\xa8 \x7f*̳\xaa?8\xd8\x156N\x88\xd9,\ng\xfeaD\x1c2v&\xf8\xdcs7ʻ\n\x91kD\x99Քf\\\x88~+АzT\x8e\xc9U7\x15\x94\x8ar\x8a5ak|\x81&\vC\x99U\x1b\xff\xaf\xd5\xf8\xd0\x1c\x88\xb3vj4\x18\xa3\x7f\xab\x9f\xfd\x04*\xb86G;\xdd\xe1\xfa;\xc5\xc2=MW\x9e^Tk\xcch\x195,\x88,y\x93\xe9\x0f\x8c\xd8\xee\xe9 \xf7\xfe=\xf7u\x8a\xb9\xf1\a\x1b\xe3\xce\a\x01D\xfc\xe1\xf6vRQ\x8fr\xfb;\xaa\xdaʟ0!ࠣ\xf7\x80\x92%K\xce\x19\x9f\x1f\xd2q\x86\x87\x02\x12\r鍦\xba\fח\x97\xadǽ\xda4SU\xf6R\"\xd2.f\xc0p*%\xaaL\x12PjV\x9aH\xb8\x10\\A[R\xff\xe3ի\xf1\xc1\xd5_\x0ez!\u0083\x87w\xe6\xb1֤-$\xdfe\xe6\xda\x01\xbb\f\x98\xb4f\xf9\xfd\xe5\xed\x81\xe5\xa6Q#\x0f\x9egU\xf2\xf7S\xad@\xf9\x89b\xff$\xdb\x1dgn\x9e\xe8Fh\x181W\x99\x91N Y#\xc73\xdbֵ\xd3\x03w\x9f\x8f\xd7\xd7`+c\x12\x15\xf6R\xd9\xd6S+.dA\xbb\x19[\xcaIɽ\xf8;i\xfd\x83y\x81\x05Ջ`\x9aM\xa8^xFG\x00D\xb4\xb0^\xab\xa3\x0e\x80M^\xe0\xec\xe0\xecX\b\x19\x1e\xd7M\x84\xd4M\x01F\xc6i\xf8\xb7\xb5\x14w\x00l\x87\xd0bF\xa6\x88i_ԢO\x98\xec\x86P\x85ʅ\x1b\xf4\x93DɦM\x1f\xce\xf7\u07b7\x86H\xb3\xbc\xa1ʚ[ \xebL\x12\xac\bѽ8\xac\xf4!\xc4\xce7\xaa\x83\xe3\xd6\x122\x1c\xb9\xf6\xb96\xa3\x86\xb3\xe7\x16\xce<\xf4,u\xa4\x83\xff\xe9\x9c\xfb\xca,X_\xbd\x13\xd8\xf5\xbc\x8aqZ\xeb\x94J\x8bo\xbf\xe9\x12\x8cba&\x11<U\xcf\x16\f8v<T0\x80\v\xc6\xce\a\x01\x04\xbf⬦6\xc5r\v\xd3O\x96\fG\xe0Uf4ܧ\xbfj=\x8e\x92\xe8k(\b\xb8\xce^v6\x80S 4M!Ech\xd2߾\xa4\xe2\xfc\x96Mm\xea=sۭ\tU%\xea\xe6b\xb2F\xd4ۥ\x0f\xcb~W\xa2$\xf7\xd4X9\x13\xe7VY\xfeBt2Oatt~\x9a\x9cw\xbewm\xe2\xc3\v_\xc3\xf0\xbe6p-Wf)S\xb7\xe1\xfa&\x14\xac&&w\x98\xb1\xce\xe9\x1c\x86CE\u07bc{\xeb\r\xb3\xf5K;&\x1b\x1d)m\xeft!Œ\xa5\x98Y\xffH%ÖN\"a\x06\x12k\xb8\x8a|\xfd\xe2\xe3Ň_\xaf/\xde]\xbe\f\x00m+\x9b\x05\xe5\xc8q\xa5\xf2J\xac\xa27\x0e\x1e\xf8\x92I\xc1s\b\xc3\xc3\x15&\xa2\x96~\xa4I\xb5\xbe\v\xebl\xd9\x12\xd2S\xa7r\xdd\f\x02 \xbb\x86\tƋR;\xddG\xeeY\x96a\xf9\xa1\xe4ɂ\xf29\xa4\xfbj\xd5\xeb\x9f\x06\xfe\x88ZqM\x1fHB9\x82\x04\x95\xd0\x02Rÿ\x84\x06\x80\xb4\xe94\xf2\xf5ק\x84\xc19\xf9\xba\xf1\x8a1\xb9tP+\x04\x84p\x84\x99-\x87%H2\xad\txJ$̩L3P\xa6D~\xbf\x00\xbd\xe8\x10\xd1\xd7_\xa4HE2\xf0\xdd\\\xc8}\x9bV\xe8\x05\x00ްz\xef\xaeZj\x8a\v\xf8R\x91\xa83M՝:c\x1c\xf3K#\\a7j(\xa13k\x11F.U5\xf2%\xc7QŬg_\xb9\x9cψVw1>\xa2#\xb5\x80,\x1b\x0e\xb6\x8c\xad\x8f\xea\f\xb6\xc2qE\xbf\xe0\xba\xed&\xfdvY\xa93[j\x1ccG`\x95\n\xe8\f\x94\xd4\xe5Z\x83\xd7\xf1F\x8dwy}\xfb\xe1\xef\x93\xf7W\u05f7\x01\x80\xd7T\xe4v\xc5\x17\x00s\xb3\x8aܠ\xf8\x02`\xeeT\x91m\xc5\x17\x00u\xaf\x8a\xdc\xdfҳ\xfe\xe9\xa0\"\x9bX\t\x80\xbcKE\xba:\x02*\xbe\x90\xb1vP\x91f\x0e\x010\x8f*\xf2\xdfLE\x02_F\xaaǟ\x9c\xdb\xde\x10\xe5\x8a\xce!\xa6Y\vӻ\xcex[K\xf4b\x8e`l\xb7fvɗ\x1fi\xbb5\x9f7\xa7\x19\x00\x97Ԭ\uf021N\xa2ukI\bÇ{\xf7un>\xec\x895\x84\\7\xf2$\xb1xh\xe2bL\u07b9^uJ\xde\xfcz\xf5\xf6\xf2\xfa\xf6껫\xcb\x0f!Ȉ\x96\x91j\xc9A/\x94\f\x0f\x17R\xec\f,\n\tK&\xcaj\xd9q0\xdc\x06\xbd*\xfc\xabG\xd2\x16>\\\xeca\xe3+\x9f\xfa\xd8\xfc\x9aPzv\x88\x81\x82!nr\bZf>\x18\xe2A݂\xce\xceA0\xcc'\x88\xa2\xba\xc6R\xc1 k\xc7b\x8b\xbb\x10\fѸ\x17\xcdT\xe2\xc9\xc9x8\bd\x9d^\xea\xe5;):\xe5ҷ\xaa\x98\x1bӣ[\xe5Z\x1b\x12\x16\xadx\x87n\xd9`˸\xda\x00\"\x02fV\x82\x8f8\x02\xd6\x1c\xf5\xb7g.\x8b<c\xf3w\xb4\xf8\x11V\x1f\xa0S[\xd1nd\x9b\x15\x85n\x11\x1e\xda::\b\x06H\b\xdau;\xacp\xd5\xd7\x0f\x1f\x01\xeb,\xf7\xe2\xe2֭\x065\x9e\x19\xa2%f2\xbd\x04\xa8\x8f\xe7\xb2qJæ\v\xe3t_\xf4\xb4\xba\x86\x1e\tv\xe0\x14Z\x9d\x89%ZI\xb8?\xbb\x17\xf2\x0e\xd3-\xa8\xd9G\xb6-H\x9d\xe1$\xd5\xd9W\xe6\x7f\xd1#\xba}\xff\xf6\xfd9\xb9HS\"\x8c\x1a-\x15`\x9b\x8bY\xba\xa4\xc6\xd1`\xeb\r\xcbN\t\xee\xf5tJJ\x96~;\x1cD\x01\xeb\xcf\x0f\u0090\x93f\a\xe1\t\xdc7\x86\xcdV\x11!m\xfb\x8b,Uɽo\x98@\xf9\xc1\x05\x99\xd1P\xa7\x10\xed\xf25\x91=\x15\"\x03\xca#`t-\x7f\xc5.\x97\xecU\"\xdb\xf45\xbc~\b[0\xac\x8d\x81\x81\xd9\xdc\x1a0\xe4\xe3z\xebΉ*\vl5P\xd5Fhc\x14\xf6\xd3A0\xc4\xc6^j㪱\xe9\x94\xfc\xb3\xbah\xd6ʫ\x9f\x87ÿ\xfcx\xf9\xf7\xff=\x1c\xfe\xf2ϸ\xb7\xd4\x10\x1b;U\xf6\a\x8b\x9dJc.R@u|jZ8\xc6.\x82\xb8HL\xb7\xf9u4b\\\x8f\xd1B(}59\xf5\xbf\x16\"]\xffM\x8d\x87\xcf`\x9c7o\xfd\x18ͣ\x0e\x963i\x91\x10]\x93\n5\xfe\xa5ٔӷ'\xddK\xa65Ĩ\r\x97\x80\xe1D\x83\xcc1exJҦ\x1b\xbe|}2~.\xf31\xf3S<\b\t&\x8d\xf6-\x039\x12\xa8K\x81\xa1\xca\xf1\xf1i\xd5\xdb\x14\r\xf2br\xe5\xb7\f}&t\xf7\xb3\x1f\x15\xa9>\xb5\x15\xf1\xab\x1a\xbf{\x02k\xe2aG\x80$\x9eӪ\x94\u0379]\v\xeca\x86\a\xdd\xf8\xcdX\xce\xdc\x1e\x1f\xae\xa1I\x91\x17\xf6\xe28)\xca8M\xec\x9e\xcf!\x17ru\xea\x7f\x85\x02[\xd9$\xcdFؗB\xe7\x91j\xde\x0f\xd3\f\xaf\x1a\xb4{Y\x14\xc4\xe6\xe4\x1f\x8f2<\x99\xe3\xb3yI)1\xca\xc8V\xde\xfeC\xfa,\x96\xa7\xe2\x98M\x9b\x9bƱt\x95\xbe\xee\x15\xa1\xd5:\xc2$9\x96\"+sP\xa7\x95\x97\x1f\r\x16\xa1\x01_bڣ\xb59\xed'\xd4~\x84\xa4l\xc9T\xb7\xae\xeeM\x1f\xcaW\uf8d4\x0f\xfe\x8c\x02\x97(\xec\x86\xd2\x03\tk\x8cs\xe3\xec\x9a\xedI\x14\xa5.\xcap\r\xed?3!s\xaa\xbd^\x84\x87B`&\xab҇q\xea\x05\xbf-\x7f\xe5\xf5I$\x9c\x02\x17.I~N\xfeϋ\x7f\xfc\xe9\xf7\xd1\xcbo_\xbc\xf8\xf9\xd5\xe8\x7f\xfd\xf2\xa7\x17\xff\x18\x9b\x7f\xfc\x8f\x97߾\xfc\xdd\xff\xf2\xa7\x97/_\xbc\xf8\xf9\xc7w\xdf\xdfN.\x7fa/\x7f\xff\x99\x97\xf9\x9d\xfd\xed\xf7\x17?\xc3\xe5/\x1d\x81\xbc|\xf9\xedב\x03~\x18\xd59\x8c\x11\xe3z$\xe4Ȓ~\xcf6p\xbb\xbe\x9e\x1c\xe7\x87`\x9f\xe1\a\xefSTp\xfb\xfb\\\xc3/\xd1=\xea1\xfd^ޑ\x82D\x82\xfe\xbcr\xaevL\xdeu\xb6K\xe1\xab\xe0\xf8\x19\xec\xed\xa1Ӱ}C<\x8b\x9e:\xc6\xc0\x1d$\xc6Ĕ`\xa3\x81\x9aҭ9\xa2\xc1ÿ\x83\xe0\xfc\xff\x81$\xe9\x98&>\xa6\x89\xbf\x904\U0004d555c\x8e\xf8yrđ\x8f\xc6\xccrd\x94\xd2\xe0\x89\xc7\x16\xd5\xef\x15V\x98\xde\xd8\xf3\xe5\\lt\xa2\nQ\x94\xb8\x89ldc\xd0\xf6\x96\x94\xb17\x801\xbd/uǭ\x19)\xc9{\xf7\x1b]d\x19aܚ<3(\xdf\x06\"\xc1\xc6\xf6\x84b\x1e%\x00\",\xb1'\xe7~\x01k\x13\xc7\xfc\xab\xd2Tj\\\x8cO\xfe\xb6\bJ\xc3\xda\xfa\xb5\xeb\x9b`\x9c\xe4e\xa6Y\x91\x81C\x84j\xec\x1b\x1a\x02U)\x910l\xd04\xbd\xccn[^\xa5=z\r.4\xbd\v\xf1R\n\t\t\xa4\xd88\x85m\xcafWDGg2]\x11\xca\xc9%_\x9a\xb7\x85\x8c\x93\xa4\xa5m\xee4\x9cS\x8f\xab\xf56\xdb\xfb\x10\x00\xf6YZ\x10QL]\vH\xa3\x131\xd4\x13t\x04\x12\xb3z\x8b\xe0\xaaV\xa9\x06O\xef\x14W}\x1a\x11\x01C\v#\xb7\xad*k\xe5\xcd\x06\x82\xb4G\xee\f>]@\x10\xeb\x9a>\x95[\xfay\xb9\xa4O\xe0\x8e\x1e\xce\x15\xed\xe5\x86\xf6qAw\xb9\x9fѡ`-;\xde\x16\x86[\xd5C\xb8\x8d\x91>\x18j \x98\xb1\x87\xf3A\x0f\\^\xf0*4 ,\x05\xae1\x17\x19\xeeѣ\xd7#\xa1\x00n֜\x9a]\x9f\xd0\xd88\a\xa6Bt8\xff>sW\xb4\x8d\xe4\x0f\xa1\xa8o6\xe5\x1c\x8eZ\xf7\xa8u\xffݴ\xae\x13\x84/R\xe5~\xa2\x88Ԭ\x80<\x1fD\x91i\xf8\xb6\xb1\x8a\xd2H}\xf3\xdc\xcb\xce0I'\xa9\xac\x024uf\xde\x17\"|\xe6\xa0\x05\xbf\x8f|m\x84p˂,\x13\xf7~\x9f\xef\f\x8f\xdf\f\x00k\xbdk\x92SN\xe7f7xT\xb9\xae|\x85\x9d\x88\xa8H$K!\f\xa4\x0fC\xcd$1\xaf\x8e\xce_&h\xda8\xa58d\xf2\x19\xbb\x03\xf2\x16\x8aL\xac\u070e\xf5<%\xb8%\x1c:{7\xa0C\x1a\xb2\"ԃ!֤̲\x89\xc8X\xb2\x8ae\xb5+\x04C\x8a2\xcbHa\x00\x8d\xc9{<lpF.\xb2{\xba\xdaz\x02\xe0\xa6\xef5\xae\x9e8%W\xb3k\xa1'v]X{\xb5\x82\x05\x19\x00\x91\xcd\xc89\xa6a\x94&\x9a\xceM\n\xc1\xf7\x10\x9d\"'4_\x15\x00ָ\xe5\xf7L\xc1\xa6\xe5x\x9fPԾ2\xef\xc4\x00\xc4PS=)\xc3dl\x06\xc9*\xc9b\xb5\xd2E\x82\xffwGkb\xc8\u0590O\xb5R\x1aB\x02P\xb7\xed\x8eIb0^︣ECT\xab\x11\a\x006\xe9'\xb5\x89\xae\x83\xa7u\xd1p\xcb\xfd\x1b\xcco\x85<\xb4.\x8d\x13\x0f\x04Y=\xa1Y\x86\x8bX\xf2\x1cR\xccRe]m\x8f\xff\xf8\xcd\xd3k\x8c\"T<b\xdd\xed\xcb\x1dn\xff\x17\x94\xa7\x19H\xb3\xaf\x91˺\xb5\xa0c{$\xe34l#\x81\xba]\xc9$\b1\xe9\x98$B\xa6nsT\xbf\xe3\r\x95!2\x8e\xdfJ\xa3\xa1\xbc7\xf9U\xcc\xdaC\x0f\x84;\xcdDr\xa7H\xc95\xcb\xeaݞ\xfcv\xdc\xeep\xf0@\x98\xdd\xfd\xe8jԍ\x7f\x8e*Y\x19-𔆳\xaf\xea?\x99\v\xddUK\xbc\bt=\xf2`\x8f\x14\xa0\xfdAv0\x8d\x80\xe6\xe4\xdb\xd8R\xf1L\xa0\x1b\x82l\xe4\xf4ʹф:6\xbb\xb6G@\xf5\x10\xdca\xfbF-\xa2uCe\x16\x1egģ:j/\x90\xadX\xdf|\xaaC\x14\\\xb45\x1c\x9a\xc7;0\xb39Z[\xe6b;\x99\x10\x88\x8b Iʤ9dp\xe5\xd7\x13F\xc2t\xb35{,I!4y1<\x1b\xbet\xb9\x8fh\x98n\xa2fc\xc6\f\xac\x8d\fݏh\xd3(\xd1\rby\xe1\x8e\xcc\x19\xa6x\xeek$H\xb7\xd0\x11\xf7\xe5r4r۹\x9c\x12%\x06\xc1\xe0̏\x96ԟ\xc8ea\x11ƕ\x96\xa5\x11\x145\b\x86g~^\f\x7f\x1f\x9e\x12\xd0\xc9Kr/\xf8P\x1b\x16\x18\x93[\x81q~$\xccj\xaa\xb8E\x19\a\xbb\xd9\x1a<`\xa9\x85\xe9l\x15\t\x15\xcd6\xc1\x8d\nQ%\xe0юn{\x9cˇh*\xb9m\xa1Ō\xbcB\x0e\xd5քci.cK8[\x00\xcd\xf4\"v\xbc\xc8Qx\x9e߿p;D\xdcz\x87;x\xe1\xba,\xaaB\xd4ӭ\xed\x1b\xa8\xf7\xcc\f\xd4\xde\xff\xf7\xa0{\x1a\xbe\x1fno'\xdfC\xbd\alx]\xac\x1e\x8d\xef\xfdF\x96.@bW駶M\xb8f\xe9\x00\x86\xe9\a<\x93\f\x93 8\x95Dp\x1eN\x1e\xffѢ\xbdl\xc7u֑\xabI\x1c\xaf\x13\xf2wQb\xbc0\xa5\xd3lU\xedr\x88\x1b\xbf\x9c\xe0\xb0c\x9bl\x197\xa9\x9b\x1f\x80\xa6xN\t\xaaO\xa0\x01\x11\xcc\x01E\xaa1\x8e\x03\xd0\xf2M\xa9\xb4\xc8\xc9\xc2M\xac\xe3\xd9\t\x8f\xbf\x8d\xadu\x1c\x9f\x8f\xedY\x01\x14\x1d\xbeX\x1b\x83\xd5\x0f\xa3X\xdd\xf8\x9eA\x01\xb69\xff\xf6vbq\xef\xb08\x8dL\x8d\xe3\x0f%I\x13\xf9n\x8fQ܊2\x1a$s\a4\xa0\x00D\x8f\xac\x9f\x8e\xe9W\x18وu\xac\xf4X\x1c\xf5\x80\xe8V其K\x1dXx\x1b[Z|\x9e\xe8\t\xed\xd8y\x02\xfc\xf4i\xf6\x8bj\x89k~G\xbd0\xd0\xc3a\xe9\xef-u?)`\x0fC\x99\x05\xa7X20\xa7\x98\x10\x11Z\a\xf2\x9f\xfa\x10\x15\x90˰-\xc8\x0e\xc6P]\x0f\x19x\xfc\xe9\xb10\xea\x10ˢ\x0e\xb0(\xaaE\xd4\xeb\xf5\xc3\x12\xa2@\xfa\xcd\x06\xa4n1H;\x8f\x10GhB\xae\xed9\x0e\xbe\x88\xe9\xdd\t\xdc\xfb*\x12\xe2k\x1c\xe5\x7f\xfe\xf9\xcf\xdf\xfcyl\x11\xe0aS\x1e\t\xf1\xea\xe2\xfa\xe2כ\x8fo\xcc>W\xe3\xc1g\xb2\xfe\xa9\xfb\x19\x10{\xb8ĝ\n\xa1M\x10\x8f)\x9c(\x90\xc4G\x05._\x8c܁\xb1G]{\x8a\x04\xab\x85\xf1o\x9eA\x93\xc4\x1b\xa5\x919ed\xf0\tM\x89N\x8a\x1b\xacWG(\xbe\x163\fo\xdfL,\xa0:\x00\x0e\x86\x88\x8a\x94P\x93i¾f\x91-\x91)(\xb9}31\x88\x89\xa1%>kr\xe8&U\xb6\x02]\xaf|\xb6M'\x1101}gK\x11\xb8~\x9e\xe2a\x01,1\xa3\x8c)z\xf9\x0f\x8er8\xf8\xb4\x1e\xf8\x81\xa2\xfc\xe1{\xdf\xe4R\a\xfcQPI#M\xb0)\xe0\x8f\x04\xea\xd2\x04\xc3O\xaf\v\x8e^E\xedU8oB\xfa3n\x8e^\xc5\x1fū\xf8r,^䃅\x84\x1b-\xf6\x1e\x87\xb9\x83\xfb\x87\x13\v\xe2 \xbd\x01\xfe\x18\xd2m\xe5{\x92\x06\x13\x11\x85\x89\x9b-z|\xeeY\xb4\x8a\xee\xa65#\x10\xa6*\x93\x85\xafspP\xea̴\x01\x94\x85I\xba\x82?^)\xb4\x94XH\xc0\xad=M_\xa7_sn\x10\x81\xcd\xd3x\x11t\x12*\x17&m\xe4\xba#\\U\xcd\x13\xa9_\xb3A\"\xa9Z\x809\x80\x03\x1ep\xcb\x19\x93\x82\x91@\x95\xe0\xe83WDc\xc1\xa13S\xa4\xa0J\xd9\u0097\xae'`\x8a\x94d\"\xd2\xe10\xd4\x05k\f\x86\xcc%\x1ePY\x80d\x02\x9b\xecJ\xaeSq\x8fg\xa9\xcc\x19Wq\xfc\x8a\x83\xf4b\x80\xde\x0e\xa4\xcds,Ci\xf6\xa1\xda\xdb\xd7w\x84\x88R'\xa2\xee\x8fv\xf8\b\xe5\xaf\x16\xb9\xedr-\xc3\xfc%ͲU\x85\xa2P\xf9r\xab\xfftE\x9a\xc7\xc8\x0e\x84hI\xf3\xc9\xfbc\x90\x95M\xefL X\x1c\xd2V\xfe\xc2\xca=.Z\b為\xdf\xef\xd8~sl\xbf9\xb6\xdf\x1c\xdbo\x8e\xed7\xc7\xf6\x9bc\xfbͱ\xfd\xe6\xd8~sl\xbf9\xb6\xdf\x1c\xdbo\x8e\xed7\xc7\xf6\x9bc\xfbͱ\xfd\xe6\xd8~sl\xbf9\xb6\xdf\x1c\xdbo\x8e\xed7\xc7\xf6\x9bc\xfbͱ\xfd\xe6\xd8~sl\xbf9\xb6\xdf\x1c\xdbo\x8e\xed7\x9fy\xfbM\xc4C\xbe\xe3d\x82\x8d&\xe7\x83(\x81\x19NL\x81\x9d%\xae]E\xccj\x0e\xef\f\xb1\x1eʸ>`\xbd\xb1O\xaf\xdf3#\xe8\xb0[\x94\x8a\xba\x85f\xe3~)\xa1\x9bXt\xaf\xa0\xfb\x8d\x97\xd4Y!\xec\x7f\xea\xfay\xa3pn\xc6\x17P9\x8f3\xa4\xe1\x15\xf3.\xd5\xf2\xba\xf6\x1d\x04\x9al\xaf\x94G{e}\xab\xe4\xf1\xfe\x89+\x98\x86>\xf6T\x95\U00067a8a\ufb08\xfb\xf1\xe2\xd6\x12\x11\xb0\x1fU\xc3롶\xb7\x95\x88\x80}\xbb\x80C״wֳ\x9b\x95\xe9\b؏kُ\xaa\xd2\x11P\x9bu\xec\x8d\x15\xe9\b\x98u\r{[5:\x02(֯\x9f\xae\x12}\xc0*tt\x01\xa6\x97\xb3\x1a\x9bK\x8dr'\x88o<\xbd]HP\v\x91\xa5=,\xc8;\xc6Y^\xe6(\xd8\n\x15\x13[V}\xad\xa1\x1a\xc3\xeb\x1cc9]\x89\t\xc1\xb2\x14\xccqt\x94e\xc1\xf5&\xbb\x89\u0602\x9aH^\x95I\x02\x90BZ'w\xc2E\xe4\x9bq5\xe7\xea\xb4\xfd\xd7a|\x86\xdbYPm\x96<~\xf3\x1fAO\xc6FUQ-\x06\xfb\xdb\vL\xc7\xe1 \xea\xac\xc8\xe8ւx\x83\x1e\x97lx\x8av\x82\x1d\xad\x04\xd8\x14\x10\x01qG\x1b\xc1ZC@\x04\xf0\xe8\x16\x82\x1e:\xb1W\xeb\xc0\xee\xb6\x01\xc4M0H\xb2\xabe\xa0*\xfeG\x80\x8dn\x17\x88\xb6TO\xd3&\xb0\xbdE\x80\xb0\xb8\\C\xbf\xf6\x80x=ѿ-`Kͻ\xe7\x89\xd4}\xb2\x9a}\x9c\x93\xdem\x00O\x83\x8e\xfe\xc5\xefh|\xc4\xe7\x9bz\x94\xfc\xe3\xcb\xfd\x91^b?\xd74\xb6Ŀ\xbb\xbc\x1f\x99\x84\xefU\xda\xef\xc1,q\xc9\xf7\xc8\xc4{ߤ{τ\xfb\xee\x12~$\xe1\x9e Ѿ#\xc9N^ǅ̛\x13\xec}S\xe5\aN\x93\xc7\x16\xdew\x17ݽ\x17\x1c\xc31ds\xc1=\xbet\x1eͿq\n=\xa2x\x10\xa9\x8a\x19g\x9a\xd1\xec-dtu\x03\x89\xe0i\xa0W\xd3\"\xe2Љ\x00\x1e\x1ah\x81\xd98\xb9\xd7:\xc1\x05u'\xe4A\xea\x97;\xfa\xcc\x7f \\\x8ce@\x99\xe3\xfa\xed\xbc\xd7\xf6\xb5\x7f\xce,\xfd\xf3\x84\xefv\x91`\x7f\xc2\xff \ue258i\xe0\xe4\x05\xe3\x9e\xf6/\xc3u\x9e\v\xdc\xeblM%\xbc(\xbb\xaf_yС\x12\xfc\xe5%VLJI\xa9\xa7ʤ9\xf0\x87N\xa59\xb0\xb32\xeb\x93N\xc34\xdfZ.-\x94`\xf5\xf1Z\xaf͘\xbd\xc60E)\xb7X\xfe\x8f\xcfD\x91MP{\x1b\xa0\xeav\xa6 \xb8ds\xf3S\xbb\x95)\x10\xe2\x86Ƨ\xcdmL\x81p[MO\x11-LϚM<P\xdb\xd2\xee\x96%\\\xa3\x14\x014\xaa]\xe9\x18)EDJ\xebmI\xc7H\xe9y#\xa5\xcf=\x16\xd0,\aQ\xea\xcf&\f\xb8_\xb0d\xd1\xf46X\x8e\xfb\xbd\x94\xf1-\xd4\xe8C\xba!m,\xb6=\xed\x015\x7f\xa0\xc8!\x82\xc3\xc2\xd2\xdemM\xd68\x9a\xb3\xc2S卄\x18!<\xb5\x9d\xbc\xbd\xbe\xf9\xf5\xa7\x8b\xff\xba\xfciL.\xf18\xd7\x1a\xa49D>̬\x99\xaĉ.\xb1\xa5\xa3\xe4\xec\xb7\x12\xac\xba}Q\xbd\xe5\xa5\xef\"\v\x80\x1as>W\x84\xe5@͢\"\x89\xf2\x13S\xe6\xc0(\x03\x03=tx(\x04\xa6n\xc2\x0e\x7fm\xdb\x12r\x89@\xb0\xa4N\xad\xddY\x80\x042gˠ@\x05a\xda}-\bM\xabM\x1fPP\xd1\x01\xc7}Q\xe8T\x94!\xf4@\x88\x1c4Jp\x95\x97\xc2Cߚ\xfb\x84\x95\n\x82\x8e\x05\x9c\x96\x1a[J\n\xc9r*Y\xb6j\x0e\x90fcr-\xbcǽ\xeaNQ\xfc6Q\xf7\xf6\xfd\xe5\r\xb9~\x7f\x8bg\x18\xe3VK\xf6\xe8\x15\xf3\xf7@BM\x01\xc9b\x89\x9c\x8e\xc9\x05_\xd9\xd7X-\xcdp/2\xa5\x81\x87\r\xd59\x13γ$'\xaf\xc6\xe6{\x82t\x93\xe8m\xd8f\xb4\x00\x88M\x8a\xf8fP\x9b\xe3e\xd3\xccrg\xa0\x1f\xe4辩\x17t\xf0d%Ֆ\xa8U\xed\xad\x13D\xb8\x84\u009e\xec\xa8\b\r\x80XMĒͨ:\xc5\xf8<k\xca\xdf\xe0\xe9\x03\x9c\xeae\x93\bǼ\x85\x96\xda\xcb\xf0.\xaa\xe5\xce@\x98\x15\x17\x16\"\x1d*r5\xf1̇\x9b\xe20e\xbc\xc9`\x90\xe8}bY\x8d\xa5\x16\xddv\xc3\xefS\xf2\x8a\xfc\x85<\x90\xbf\x18w\xf5?C\xd0\xdd\xcf\xca\xc7\xday\x1f\x8f^MzQ\xeao\xa8t\x10\x0eb\x17\xeb\xf7\x8c\xa7\x81R\xe8[\b5H<K\xd7Q<\x14\x83\xd1\xd1\x15\x0e\xfe\xb3cX\x1c\x949\xb0\xb2r\x85\xf0\xe8\xc9ϊe\t\x0e\x0f\xbb\x85\xae\x9d\xf2i\x9fU\x8b\xa3\r\x86\x88\x02Ir\xaa\x93E\xdd\xf8\x8f\xb4\xc1\xf3%\x95\xae\xb5Y8\xe4T`\x06ʵ\xb8.\x98\xfa2\x044\xa6\xa1\xa4ŗ\x87䠵\x90\xdb\xe4[\x9d_l7j\f\x86\xeaT\xb3s\xd6q\xb2\x8eA#\xbc\xf5\x9d>\xbb\xcb\x1e\xc4,\xf8\xad\x97n\xa1\xa6K(\xee\xe6I$\xcc@bV\x1c5^h\x8f\x03\xee&#\x97,\x01\xf5\xc9t\\!\x85\x16\x89\xc8z\xf1\xd2\xc4\x01AYp\xe9\xddw\x91\xbc\xf4\u05f7\x93S\xcc\r\x9b#\xado\xde\xdcNZ\x15\x81`\x88'\xb7o&'\x9f\b\x991\xa9\x9eQ\xad\xb9&a\x19\x9fQE\xba\xc1\x13'\x89bzvZ94\f\x12F9-Fw\xb0\np\x1ccq\x13\x81\x99\xc7õ\x93\xcei\xd1\x11\x86\x04\x9a\xb2\xcfd\x8d\x9cS\"\xf5\x986/\x96\xcb\xc52\xa8\xc7ԄQ\x1e6\xf0\xb4\x10\f\xe3\x116{\xb4\x82.\x00薵vϟa;\xae\xa0;\xae\xa0;\xae\xa0;\xae\xa0;\xae\xa0\xfbï\xa0\xfbo\xf6\xbe\xbd\xb9\x8d\xdcH\xfc\x7f~\n\x94+\xf5\x93\xf4\x8bH۩T*\xd1?)ŏ=%\xb6\xac\x92\xbc\xdeKmr[\xe0\fH\"\x1a\x02\xcc`F2\xef\xf6\xbe\xfbU7\x1e\xf3$\x85\x1eJ\xda\xcdfbWe-\xcd\xf4\x00ݍ~\xa1\x1f\x8fc\xf2\f\xb6\xaf\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\xb1\x82n\xac\xa0\x1b+\xe8\xc6\n\xba\x7f\x83\n:?\x92\x9f\xc0XM\xa6z\xa3\xd7\x1b\xc8O\xb9\xf6\x80\u0081\xa2\xe5\xa7b\x86p%\xbev%nM\x9e\x82\x05\x12\xad\x16rY\xe6X&\xf5\xd2\xcef\x9f&vcӀ\xa1iX\xddˣ\xc9\xd3\x1a\x1c\x99\\KJ\x11\x1d\xfc\xa9\xaaҮ\x06\x1b9\x83\xf4\xeba\xda\xf5 ݺ\xe1\x05\xd4n\x9c\xb1\xff:\xfeۯ\x7f\x9c\x9e\xfc\xf1\xf8\xf8\xfbW\xd3?\xfc\xfd\xd7\xc7\x7f\x9b\xe1\x7f\xfc\xff\x93?\x9e\xfc\xe8\xff\xf1듓\xe3\xe3\xef\xff\xf2\xf1\x9b\xcfW\xef\xfe.O~\xfc^\x95\xeb[\xfb\xaf\x1f\x8f\xbf\x17\xef\xfe\x1e\t\xe4\xe4䏿\x9a\xfc\x84\x1a\xaby\x00? \xaf\xb8\x1f\xce\xddE\xfd\x9a\x7f\x05\xa7\x88\xb8J\xbe֥\xc2\x02L\xc7\xfc,0\xbf\xed\x1d*R\xb2wF\v\xe3<\xe1I\x1c( \xbd\x89 \xccx \xc7\x03\x19s \xaf\x1d\xb7\xb4\x8f\xa4\x8dS<\xe2\x91\xf4\x8a\x96z&/\x16,\xacQ\x1a\xa6ײ\x00/\x1d\xa2\xfb|xr\xa9,\x1a\xae\xa8\x13K\x98\xbdͱ(y\xf0\xb8y\x1f\xe0HO\x99.V\"\xbf\x97\x06\xf3Ÿ\xaab\n(0\xa6\xa9XHEN\xcb@Ss\xf6K\x10U\x03^\x82\xd8c.\x8b-d\xf0\x8b\xaf\x04\x9f\xbc\xc9\xf47\x0e\f\xd3\xf8\x13\x13r\x9c쐕h\xa8\f\aZ@U\x17\x99 \x1b\x9d\xc9d\xfb\xd2o\b\x95\x84\xf8Z\xbc$|;\xee\x8b\x057\xb7\x15\xfd\xc5\x14\\\x86\x8a̝\xef?\xb5\xb1\x88\x9a\xf9*\x97w2\x13K\xf1\xce$<\xc3\xd3pv\x80\f;\xdf\x01\x93\x04\x12\xa6Ҩ\"יa\xf7+\x01'\x17j\xebr\x8d\x01\v\xa8g[rr\xaa\xd0\x1a(\xb4\xf1\v\x036\x03)P\x18\xb6\xe19\x84\x16\x1dx\xaaHĢ\xec\xb9֙\x9b*\x93m\xab\xb5\xbb\x02\x14\xa5\x7fP\xe2\xfe\a\xf869<\x9f\xf1e(\x8c\x81\x81\xee\xedh\xcd\xd0e\xef\"\x13\x88[\b\x840\x9e\xdd\xf3-u\xb9\xf7+\xd1^\x9f4g\xec\xf5\t\x9eMnX\xf8\"U\xd2\xfe\xe6\x04\xef\rߜ_\xfdp\xf3כ\x1f\xce\xdf~\xbc\xb8\x1c\"\x16\x81R\x824\x14.\xe1\x1b>\x97\x99\xa4\x1ba\x8d\x83\x01\xd9LuP\xa8\x86\xd2\xf4e\x9akjb,b9/\x15t\xb7\xa80m\x1a\xf7+D\x90\xf5\xb6\x17\xc8f\x8b\xe6b\x979W\xf4\xac\xc5\xf9\xb6\xc5\fy\xa9\xa0\xad\x13\x8dY\x87\xc96gGS_iQ\xed<ME\xda@\xc5O\x94}\xf9\xc6/a[u\xdc\x18\x00\x93\xb1\xabO7\x17\xff\xd9$.\x9c\x8c\x01\xb0\x0e0\xf6\x0fI\x16\x83\x03s U\xafm\x85\xe1Hן\x0f]\a\x19\xad\xac\xd2\xe7\x87ܧ_\x97\xaa&\xa3\xa4\xaaA%\x01el\xadS1cWV%\vӄU}\x83\xcal\xd0\"\x1a\xda\xe3*H\xedɶ\f\xbc\xb7;\x9e\x81\xd5Rh[;G6\xb0\xfa\xb3\xa9\x16<3b\xf6,z\x15\f\x97\x8f\x105:\x80r\x01\x06K\x85҅\xf3\x97\a\xf0=4A\xc9u¬\xcf\\KZk\xe8/\xb2\x95\xf5\xb9\xa6V\xa5\xf1\x98\xbe\n\xab\xc6nUD\x98\xd0ث_\xad\xfaOQ\xd9\v\xdcw\xa8\xc8\xc6\xda^\xc8Ņ|\x80\x94\xad\xb9\xb9\x15)\x8e\xb7\x18\xb0q\x19\xa2\f\x96(aӟ\xb7\x1b\xc1\x16\x82\x17%\xf9j\x06\xada[. \x14\x9fg\xd4\x00\xc6@\xc9\x06\xb8\xf9\xa4\xb2\xed\xb5\xd6\xc5\xfb0\xcc\xf1\x00\xb6\xfd\xce\xf94͛\v0pI0\xa1\x94\x02\xd66E¡\x18\xa8U\xcazn#\x82\x94\xe69\x85@^\xaas\xf3M\xae\xcb\xcd\x01\xe8\x84S\xf6\xcd\xc5[\x90_\xe0f\x00\xb7\tU\xe4[l\x03@\x02˘^\xb4Ζ\xf7\xafطp\xee\xdcI#\x02\r\"`\xc1Je\x044!\xe1[\xc63\xa3\xbd[G\xf6f\xaf0˯\x1e\x7f\x99ax\x0e\x8cw\xa9\xd8\\\x17+\"\xc4\x168\x14\x01ݯPc{\x80L\x8c\x92\x85d\xa3\x14\xb4b\v*\x15(\xbf\x15ЪP$\"\x15*\x11\xb3\xa1w\xab\xbf\xfb-\xe9͡\xc1q\xe4\xf2K\xad@\x80\x1c\xc0\xe7\x17*\x95\t\xb7Z\x8e\x17M>\x9d\f\xe89\xe4|r\x8e\x15\xd1(>J#rl\xe1\x05!\x80!\xa4\xfeK9\x17\x99(l\xc8\x02\x1b\xce\xf1B\xe0J嚓\xa7\xbb\xf3\"\xa86\xe8N\xa6L\x99\v\x17\x14.X\xaaŐ\xfc2\xb7\xe9o/\u07b2W\xec\x18v}\x82\xac\x0e9\x8a A0\x97\x90\b\xb3)1\xe4\xc2/\x0fQ\x89'\x9e\x91\xbb8\xa1\x10>eJCj\xe7\xca\xe3\x12\xba[\xf8p\x90˭\xa5G\xf1\xbb\xc2g\x978!\x02\xae\t\x9f\x7f\x1fqr\x90\xea\xfbֈ\xfc@\xcd\xf7\xed\x93k\xbe\xe1a%\x90'MJ\xa1\x18`kQ\xf0\x94\x17\x9c6\x0e\x1f\xfe\x94*\x80\x9b\x8d\x8c\xfc\xa8\x8c\xfc\xfczш\x0fR\x95_mr\xab9\xf0\x1cܼC`\xcc]\x9e\x80,\x9f\x93\x15\xcef\x93I\xdb\"\xafq\x16\xbc \xf7\xa4\x1aB\xed\xea`y\x9d\x86\x82\x1c\xee`@\xa9SW\nٕ\xa9^w\xb6\rΜh\xf4\x11\x9f\xa1ħ\xc2\x1f\x8f\xd5#\x1d\xab\xe1\xe1\xebL\xdc\tr\xfb\xc3\xd6\xc9\xf8\x000\xe0R\xc7\xf3\t\x02%\xc3d,\xe3s\x91Y\xe3˞\x92\x906^1\xda\xe4\x19C\x8d\xb9\xce\x0e-Q\xbc\xd6\x19\xe6\x89\xf2\x80\x1c\x00\xfa\v\xc0\r\xbez\x18n>o7-\xdc\f\x8c&\xff\xdcpS\x92-\xae\x0en\xc0hk\xe2\x06\x80\xfe\xcb\xe3f`\bވ\x04rW\xaer\xbd\x90\xd4#\xd9d9\x98\x93`\x81U\xb9 \x18\x89\x1dr\xed\xd8\xcc\t\xbeX\xb4A\x13aB\b~\x93\xeb;\t\xf7\x81\xbc\xb0:\xccg\xaa\xfc\xbf\xeaSD\xb0(\x8dO\x9b$\x0f\x9b\xd7w\"\xcfi\xf3\x06\xbc\x0e\x84U90Ϧ\xadt\xc23\xb8Q\x18\xc4\t\x1dnh\x83c\xd2G?\xc8p!N\xbaqP\\\x9e\x17\xd84\x9c\xe1O\x06\xb7\x8aP:\x15\xb5>\x96\xd0\xc0\x06z\xf4\v\xff\xad\x01 }\xa1\v\x98\xf0>I(\xf59\x1f\xf0\xbd\x010\v\xed\x9a\xff\xf9\x02J\x8e\x92^\xa8\x14\xd2\a \xbaO5\xb2\xe0O. _\xe4Nx\x81\x05\xa9\xb9\x99(\x8e\f\xab\x16>\x00\xac?\xa4\x9e\\\xc0\x05\xc0\xc5n\xf5\x10\xe8\x1e\x00\xd5۱\vT\x1c \xba_|\xf0\xec\xf5\xe2\x19%\xac{\xf5\xb0\x83\xf1\x02`T\xa7a\xd0\x1d\x12\xfc\xbd\x85\xa9\az\xd1A\xb9\v/\r\x80huX:c_ X\x15\xc4\x18\xcf\xc5\x19\xfb\x9bb\x01\xe5\x03@O\x1f8\xc2\x03@\xfa#\xd59\xc2\xd7\xd6=\x1bv}\xe2\xf2\xa0{\xfd\xbdt0D\xbf\xf5\xf6R\xbfUx\xda艫\xae\xbf\x90\xee\x81\xec\xa9\xf8\xe2\xf9΅OG\xa6\xa9\x8c)=\xc1a\xa0\x89s/U\xaa\xef\xcd\xe3\xc4)\xbe\xb3\xc0\xbc\x83\x9a\x80h\x82\xa6(fx\xac\x82gY\xc5n\xe61\x82\x15\xfe\xec\xfa\x01E=\xae9\x11\xaa\x13+\x8eq/\x16\xfb\x82\x01D\xd0;B\a}\xc1\x00\"\xe4n\xe8\xe0'\v\x06,׆\xbf\xc9!\xaeWH\x9e\xddlDr\xa0\x1e\xf9\xe6\xe3\xcdy\x13\xe0\xb0\xd6\xcd\xf78\x14\rp\r\x10\x19O\xd7\xd2\x18\xbc\xa7\x10s(\xb3\x1f\x00\xf2\xd8\x17\xfc,e\xb1*\xe7\xb3D\xafk\xd9\xd4S#\x97\xe6\xa5;\x93S\xc0\xcbɀoH\x05}\xb2\xabL\n\x01\x1d\xe3]\f\x1c62\x00d\x12\xb0\x89\f\x87U\xfa\xa9O\x82\xec\xa2\xfbrX\x11?\xb6\x06|V\xa3\xa5\xcbz\x97\x83Z\x1e>\xc0~\x03\xf1\x01\t\xcb+7\xe6\xb0F\xbf\x1a5\x06\x00E\xfa\xd94\xa0gEu\xb8\x14z\x04\f\x83\xb2\xf1\xa0@\xd2:\xc5C\x06\xca\xfa\xaf\x97<\xb2\x83\xe2\x19\x00\xb8\xef\x8a\t?Ӽ8\x1a\x00\xb9睊\xae\x14\xe9T\x8d\xbd7\x1d\x00x\xbf6d\xc3\xc6\x00<\x8dF|\x12\xad\xf8\xfca\xab\x01/\xb9&C\aMQ\xb9\xa9\xc1\xa8\xb9p\x10\x1d\x8d\x86ȼ=\x06\xf9b\xb5\x06M8\xb2S\x82\xbc\x93\xff\r\xbe\x01\xe9v&\xb0\x03f\x1c`\xad\\\xbd\xbb\x9a\x1b%Aa\x16\xf0y2\x1f\x87\x83Z\xbbB4W\v+\xa4N\\\xab\x8dr9\rh\xf0\x96e.\\W9\x8a\xc1\xfb\x0f\b\x8a\xf0P\xaa\xe3\xdbJ]\x85\x0f\x01*?\xd3V\xe9\x06n\x81\xa5\v\xa2Ӆ\rY*\x17\v\xe1K\x8d\xe6\x02\xea\x8e\xf8Z\x14\xb4t`\x97\xf73\x17Ki\xeb?\xf4\x82q\x10CGG\xa6\xeaoD\xc1\x00V\x93Ȃ\xad\xe5re\x0f2\xe3,\xd3j\xc9|\xe2\rL\x89fp]O\x80\xaasv\xcf\xf35\xe3,\xe1\xc9J\x00\xb5\xb8bi\tǛa\x93\xf0\xed\xd4\x14\xb4{O\x88L\xbah\x10P\x84%\xddF\x0fDJa\x10\x7f.\n\xee\x13R}^\xa9\xb7\xda\xea\a\x96\x00\xd7C\x83\x84՟KC\xc2ql\xd086h\x1c\x1b4\x8e\r\x1a\xc7\x06\x8dc\x83ƱA\xe3ؠql\xd086h\x1c\x1b4\x8e\r\x1a\xc7\x06\x8dc\x83ƱA\xe3ؠql\xd086h\x1c\x1b4\x8e\r\x1a\xc7\x06\x8dc\x83ƱA\xe3ؠql\xd086h\x1c\x1b4\x8e\r\x1a\xc7\x06\x8dc\x83ƱA\xe3ؠql\xd086h\x1c\x1b4\x8e\r:pl\x90)R\xa9\xce&\x83\x18jG\xdf<r\xa3x\xdfs\x03\x92\xbfJH\xca\x03\x9b̮\xcc\v\xa1\x00\x9d\x00\xd6\xd5y\x85\xc4F\x9f\xefaDq\x8a\x8d\xfal=\r\x01b\xff\x92|\xe3\x10h\xd0\rC\x1dh5eR\xb1w\x9fއ\xb33\xa0\xe1ߐ\x8eG\xb8\x93O*\x11\a\x93\xbe\xa7\xb2nBN K2\r\x93 \xa0\xe2\x1c\x16ƒ\x15WJd\xce\xff %\xf7@\\b.\x84bz#\xa0\xb2x\xbee\x9c\x19\xa9\x96\x99`\xbc(x\xb2\x9a\xb1\xefVB\xd1\xc9\xee:\xb1W\xab4\x90Ѳ\xb6\xe4\xcfŚ\xd6\x03\x1f\x96\xc7x\x92kcغ\xcc\n\xb9\t\vdF`Ɏ\xa1f\r{\xa2\x02\x13AF<X\x84\xd09\xae\xda\x01|\x95tm\xa9\xeb\xbdx\xd1C;\x058b\xbd)\xb6!\xa9X\xb0\x85\xccI\x85\xa4I&\xd1\x11\xc0\xfdBr\x01tzK\xa5:\xc5\xf4\xc4\x02r`-F)\xba\x046\x87\xef\x83M\xb4)\f&\xc9\xd6\x16\xe9>\x9aJ\xe3\xecgCI\xa0\xe3\xae?,*\xbc\n\xa3Ⱥ)~\x96\xbeb\xf7rm\x89\x01\xd7\xd2T\x19\xd4\x14\v\xc9\v;\xc8u\r\xc2\xe4\x94\xf1n'1R\x94\x01\xd3\xc1*\xa1\xe9\xf6\x8f\xac\xaf\xc4\x1dTՊD\xc8;\x8a\x9a\xe6;$ߓ\n\xbeB\xe4k\xa90m\xf9\xa30\x86/\xc5\x15\xe9\xdaj\x97C\aPj,B2\xe9!1\x12N@x\xb7\xa2\x15\xa4\x91זL\x00\xba\xb6\xbb\v\xe9\xf8\xf79\f\aB1\x86]\x95\xf1\x9e\x9ed\xd3w\x16V\xefn\xeb\x90\xe9?C\x00+\xa1/w!\x14t\xf2\xb0I\x04\xf3\\\x8a\x05[H\xc53\x97Cx\n\x911JU=\xf4фƒ\x06\x9c}\xad|\x8a\x9a\xc7ʌ}G.\xab/\xf2R\x81\x95\x12\x92ѱZ].\xd82\x87\\\x10Ѕ\\\xb1߾\xfa\xc3\xef\b@\xe7[\xb0I1g\xa0\xd0\x05\xcf\xfc\x02Y&\xd4\x128\xca*\b\x9eQ\"w\x81H&P\x1f\xe7\x10Z\x04\xbf\xfe\xcd\xed<\x1c:\x92\b\xd0\xece*\xee^\xd6\xf8q\x9a\xe9e߄ǣ\xc9\x13\x86\x10z\x8e0\x0e\f\x1ax\x88}\x1bW\xb6\xd2\xf7H\xd7\x1a\xfc\x01\xe7\xcdY4PP\xa27e\x06\f3c\xefC'\aZ\xfb\x9cN5lw\xeb wH\xc7\xd8/\xab)h|\xb2\xae\xdf\x06i\xefX&\xe7\x82̨\t\xddq\x9b\xb1\xf7<\xcb\xe6<\xb9\xfd\xac?\xe8\xa5\xf9\xa4\xde\xe59\xa9\xf5\xaa\xc7\x19.6\xe3\xa6`ɪT\xb7\x80\x8bj陦\xc4dtYl\xca\xc2W\x18Ո\x1d\xf6\x0er\x8d\x96\x00o\xcd!g\xba\xd4V&\xbeJ\x10\x180\x05\v䑀\xddS\x949ȅL/ÚM\xfd \xff\xe6\xd5o\x7fo\x05\b\x01\xa2\xce\xd9\xef_aq\x819\xb5\xf6\fjo0\x18\xd7<\xcbD>T4\x00\x8b\xf7\x89\x82'\x95\x04\xc5\xf6`\xff\xe5\xd1\\\xd7ϟ\xff\x8a~\xab,\x8c\xc8\x16\xa7\xb6e\xa3\v.Qpy\x84\xa6ՑӅ\xe0rtM\xa4ٓ\xdaHw:+\xa1\xe1ʝ\x1c>N\xb8\x01\xc3W\xc3d\x12\x9a\x06Q\\\x9ay\xa6\x93[\x96:0\xb5\x1cC\xa7\x83\x03\xe9f\x93'ˣܹ/\xb7c\xac\xcadk\xbe\xd9\xc4s\xae;\x8cP,\x98\xf3\xfb\xc66QZ`?\xac\x01\x9b\x1b~\xc3aqL3\x86{\xf0S\x81\xf1D\x87\xb40\"D\xe6\xebq\xf4\xa2I\xe5\xaaӺ\xfd\x0e\x19\xae\xb7\x87\x80Zh\x0eQP;PJ\r\xcf/m`V\x85\x18\xfa\x9a\x17\xceO\x18t\x83\x84%\xaa\x1b\x91\x1bi\n\xa1\x8a/\xc8\xd1o2.\xd7.\xb4E\x86H\xbfr\x1a\x88\xc6!\xb1\xfai\x8d\xb5I\xaf\x11\x91;(\xbcO϶\xb4\x82\x15G\xb7\x10Nx\x83\x93\xa0Jۂ\xc1\xc0\v\xba\x83\xe0\x83i\"\xf1ñl\xf9\x82\a\x18\x01\x87\t\xe7/\x15n\x9a\xb2\x19vH=\xb0xL,ğH$#a\x0e\x96\xc8\x00\xc0o\xa0!L\x89@\xeb\x110\xe8\xe4d1S\xb9;.\xaa\x00\xed\xad\xcb\x01M\xe5 2\xef\x96ƎΎ(\xf8=@\xa0x$\xe7z×\x03\x86\xad\xb6p\xdd\x06\xc6Rh(\xb0\x06k\x9b\b\x16\x12\x0e\xee\xed\xe2lχ\x8d\x83*\xd2\xd0\x05l\x00HS\xb8\xf4\x01\xa7O\xbd\xcbb[Lܓs\xbea\x18\x9a.\xe1\xde\x0eb\xea\xd5\xf5\xca\xc7\x16\".\xb5\x12t#\xc0\xb8\xf6d\xd0F\xc0V\x0f\x80Q\x81\r\x02\xa4b\xafg\xaf_\xfd\xeb\xa8o\xdcCK}\x0fj\xb1T\x93K϶{?r\xeb \f|ta\xc7jF\x96\x1c6\xd9\x06\n2x:\x85P\xa3\xe3\\\x1c$~\x8c\xd1cȬ\xa85\x16:\xa1\xe2\x88\x1d:\x80o\x98\xcf\xe5np\xca\xf9\xa3\xcb{\xab\xe9\x89\x10\x99\x152}\x11i3\x14b\x8f\xaa\xa8\xa3\xfa\x05\xbd\xc3\xe5\xb1]ɑ\xc1\xa1\x8b'\xcfv\x1c\x1c\x99\xde}\xdd\xe4\a\x91\xea\xdd\xd7\rǸ\xf7\xa6I3\"Lo\x14\xee\xa1\xd9P\x88=4\xfb\x93X\xf1\xbb\x01\xfa\xccȵ\xccx\x9em\x81\xd87\x16\x83l^\x16L\xa8;\x99k\xb5\x1e2j\xf5\x8e\xe7\x12&\x0f\xb2\\`3\x1f\b6\xfc\xea\xf8\xcb\xf95f\x16\x9d\x80\xe6$\xc3\x14\x9e*%\\\x1bw\xb8\xbf\xb6\xdc\xc3dˋ\x17\x1d\x06\xf6x\x01\xce\"\xc3\x06]\xee\xf1\n\x16ú,J;\x9f\xf4k\x92\x95Fމg: ü\xb4`\xed\xfe\x02\x9c4\xd7`\xe5\xad$ȇ\x86dxSc\xb8N\xb7\x16\n\x19/\x16\xd6(\xf3\xfa\xf0\xb4?e\x83$!\\\xc6i\xb8\\\x02#\xcd\x05\x93]۪\xb9\x18\xd6w\xbc\xed\xa2ئ\x81\xcf\x1bV\xa6q/\x81\x03\x89\xbcG\xe1:\x97#x6!\xb2\xd9g\xfb\x9e\xeb\xe1m\xe3uk\xfe\x15\xf3\xe99\x1e\xc8\b\x88\fnc`\x05\xec\x8b\xc8D\xae\xbdҸ\xe7\xb2\b\x95\tR\xc9\"0u\x1c\xb3\xa1\xa3b[\xd5\xcd&\x8fJ\xe8hJ\xfcC\xcf\xcf&\x04|\xfeY\xcf\x03.9\xfb\x87\x9ec\x8az\xe8\xd7ǴJD\x94\x82\x87\xee\x10\x90\x01RbW\xb9\xd9\xe4\xf1\x02\f\xc0\xaef\xc3\x13Af\x96K\xff\xa6\x8f\xec\x06P\xb0%\xf6g=\x8f\x80\x88^]\xe2:\x01I\xd54\x01\x9b@\t\xa9\xd1\x106r\x95\x85\xfa\x16\xbe\x100\xbe\xd0\xf9\x8c]\xbb#\x8d\xdc\bב\xe5fz\x1f;\xc9\x03(h\x1e\x99\x01\x19\xd3\xf6*\x94L\x04w\x85\x1a\x04\xba\xc18A\xe3\xe0E\x80dl\x8eV\x9c\xbf\x05\x05\xbe\x85;ۨ}\nU\xaec\xd6=epॊ\xcaǞ\xb2\xf7\\f\x8f\x8d\xe5B\xac7p\xd7MF\xf3g\xf7\xa2g\xf59\xf8\xec/\xef^\x03\x97\xfb\xdfA\xe7\xe5\b\xb8,`\xb8\xc6\xf9`\xa9C{D\xfcőA%\x02\xdfZ\n%\xf2X\x05\x17-\xc6z\xb2\xe67\xb90\"\xbf\x13\xd3R\xdd*}\xaf\xa6\x18^1\x91\xf9\xf3?\x7f}\x03\xf8\xae)\x90(\xb0\xae\x8c\xd5\x17\xcey\xe3\x1f\x04\x00\xe3>\x85\xa8\x92XQ0m}\xe4Z\xaa\xb2\x10\x8f-Eb-\x95i8\a\x93Gb\xa8\\\x18]扸\x86ơg\x13\x02\a\\\xd7߬\xe9ʮ\x9e|\x00*t\xa0\x81\x16\xb3\x1a\xe4\xba_\x0fp\x06W[\x1c\xa6bs\xc60\x13\x8c\xbd\x15\x9bLo\xc1\xc3|8\xd6\xc4\xd9\r4B]\x94ٍ( \x9a\x14:\xdd\xf8\xaf`\x06,\x84\xa0\xb6\x8f\xa9\x98\x13\xadl\xbfA\xf2\xa9z\xe3\xdf\xf4\xd2\n\x04\x03\xa2\xa2\x02:!\x98\xdf~\x9fGX\xe1]\x94f\x16\xc0\x98\n\xab\xe7w\\f\xe0{ǅ\xef\xeeW\x90\xe2\xed\xb3\x8c\\)PX9\xa0\xbc4\xe1\xf4\xfa\x15DA\xb6Y\x01Q\xf4 \x1e\xb0\x1aQ\xec\x02\x87\x93\xc6m\xd0\x11ȡ!\xfcv\x12=\x8d\n\xb5v\x1bM.WĢ\x80,\x9f>C\x8a\xc9c#.\xb6Qm\x03[\xd89V\x9aF'R\xba\xed\\\x17P\xd5Ie\x17\x85a\xdfqYx\x1d$\xad\xe9\x04\xad\x95\xa3@:e\x03^\x8d\xd9K\x81\xe8\x06\xf1\x00\x843#2\x91\x80\xd0\x03\x9b߁\xb1f\xbfM\x86\x87\xcfQ\x12\xe70\xef\xa85\xbf0,\x13b\x01s\x91\xe85(7DL\x14L\xf0\xcbe\xdc}\x03E\xe4\rh\xbb\x1b\xd1l\x17\xb1\xc6\xf3e\t\xf2>\xee\x14\xb8\x93\xe0x\xcd\xc5Q;w\xbe\xae\x1c%\xbe\xef\xf6\xc6u\x85\xc7z\x14\xaf\xacf\x93'\xb9\n'\x9dN'P\xa4\xba\xc0\xfbv\xf6z\xf24\x91\xb0\x80\xba\x81\xe4\xf5\x88\x0f\x04\x0e?\xa0\xc5Ɂ\x10\xd5`\x1c\xcf'\xcen\x9cW\r\xf5g\x93\xc3\xe2j\x1b\x9db.Dn\x8a\x01\xf7\xec\xfe\xbes\xf2D\x14\x87]:\xe97\x88\x1e\xef\xaa\xf7\xfb,\xf8H\x90\xccY\xfa\x11V|4D\xb4\x1ek\xe6\xfe.S\x1e0PF+\\\xf8[\xd9\xfdOE\x15B$\xe0ɢ\x01͈\x80\x84;\x99\x04\xbc1h\xa0\xe9\xf3\x96=\xf2\"\xb7\x05\x7f\xb10\x14h\x13\x8b\xbc\xd8\xd0\x029\xbc@\n1\f\"c.\x8a<Z\xe9\xb5\xc8xm\xdf\xf5b\xae\xd6D\rj\xab#!\x06\vŭ\xa4!\xea,U\x81\x95k\xd3}\xa2\x01\x17\x9aEgZ\xacm;\x963\xf6j\xf2T\xa5ΰ\xc1\xed\x9fxr\xab\x17\x8b\xc1\xf8\xf6\x00\xeav\xa0\xc3`$H\x86\xb2\xcaxY\x13\xca \xed\xfa\x80|\xdc\xf5\xcc\xf1d\x88\xc5!c\x17\x05Ku9τ\xab\xd1g\x82'+\v\xb8\xa2\x1f\x06\x17̌\x84g\x02G\xdfW\xc6\xf2 ,\u05cdퟗ\xba\xa8\xec\bkoc\x18\xc3ӑ\x17\x10-\x019G\xa9\xab\xf1\x03\xb9\x88\x94&\x12\x85r75\xf5ky\xecH\"F\nc\xd3T\x1a\x1c\xf1\u07bf\xe9%]\xaa\x8b\xa9\x110y\xa7pY\x10\x11 \x99;Z\x00\xcc]\x12\x04?\xac\nO\xb8\xc8\xc5f\xc5\r)Ba\xfbN\xf8\x00\x05\xae\xf8\v\xfe\xa8\xed\xf2E\xc1\xac\xb9\x85P\xcc͔\x90\x98i\x17<\x7f\xa6t^\xe1%\n&\xdc\xed\xb7\xecΎ\xbbk\xbdeYD\xde\xebR|\x13\x12\xcb.\x02\xfe\x861\xcb\x17\xdf\x05\xa4\x1a\x95\x06\xff\x85`Y䔮\x8a\xeb\"#(\x8f\x8d\x84\x8dNo\xd0\xc9\x1fp\xdfsU\xbd\xeb\"\x05\xae\xaaB\xa7\x86\xe2\x05\xf9\x8d\xba\xbb\x06w\x8f\xa7\x1a\xd9B(\xb9\xd8:ҷ\xab\xbbM\xed\xe9,\xfe\x17n\x85>\xa0\x11\x05\x17}5\xe9\xc6\xf9g\xfa^\xb8NYM\xbdW'^$\x93\x9b\x8dHf\xc6!\xb3\x12\x14\x00\xc9\xff\x14\xc4I=Z\x1c\x05W\xe7͘q\xcc!Re\x86\xb1\xd3\xe8~E\u0530\nֈ@\x1a\x91k%\x11\xf7V\x8b\xf9\xda@\x80\xb4\xdcWq\xb1\x8c\xcfE\xac9\xcd*\x14;\x1d\x06\xe8u\x95\xcf\xf5\x9f\x902\xb6\xce/\xdf>Y<\xa5\x81\x88s\xbb\xd9\xdeMP\xe7\x9b\x05\x18X\xcb\xe4L\x11c\xdb\x17\x1ah\xd4p+\xb6q\n˩\x03\x88v)7\x8d̓\xcdE\xe6F\x19\nv+\xe2C\x92>\xab.+\xe3n\xab\x861\xa7\x9bx/\x88\xf9\xc4\r\x92\xc0\xae\x9c^\xb0\xb4\x81\x1f\x10'7\xfa\xcb\xd9@\x92*l\x1a\xbfy\xb2:\xa8\xfex\x9a\x1d\x80\x86@\xf6\\\xc0Y\xb7G\bH~dH@!d\x9ea\x01\x84Y\xc9\rHX\xe0T<\xe7\x8e\x1b\xd8\x17\x9eI\n\x17\xd5whk\x18.\xd4)\xbb\xd4\x05\xfc\u07fb\xaf\xd2\x14\x86ȗ\xf0\xf7\xad\x16\xe6R\x17\xf8\xfe\xb3\x10\xc9n\xff\x00\x12Y\x00x\xf8\x95\x8d\x9d\x02V\xc9\xeb\xa8\x1dL\xb0$\x81o\x03\xf1\xa5a\x17\n\xee*-v\x89P\x83me\xdc\xf2\xbc\xf5\v3\xb6\xc0\x17\x8a2\x89\xea\x7fz\xd6\xe7\b\xae\xf3\x06\x05\x1fm\xa9v\x99ԁ\x93\xf0\xc7n\x19\xef\x896\x19OD\xea\xc7br\xb0\xefx!\x96\xe4.\xa5k\x91/\xb1\xf05Y\xd10G\xd4Q\a\xf15=\x9aO\xf5@\xbd\x1fz+\xe2\xe1O\x03\xd3D\xbfBr^\x87\xed\x1c\r\xa1\x0f\xa0f\xa2\xa9\xc3S?=\xf0j\x80v\x1c@ӆ̩-\x18\x05\x0f\x94\xa7\x83\xd4\xf9\x1f0.\xf0\x00\xfdo\xf4Z6\\\xe6f\xc6\xce}\x17\xb5\x1a\fo\xdf\xd7>\x17\r\x16V\x04\x99z\xff,\xe5\x1dϠ\x1b\x18(\x1dńk\x12\xac\x17\x1d#6^Z\xd8l\x030\tB\xd9؋[\xb1}qږK\xd1\x10_\\\xa8\x17\xa1;YS\x06\x05\x1b\x0e\x8b\x9a^\xe0\xef^\xc4\x1f\xfc>\x13\x98f\xda\x12O\x00\xe1\xf1\xd8\xe3>E_p\xf2(ߍz\xec\xa1\x13\xbc\x7f\xe1{\xb2\xb6\x1f\xf8\xfa\xee\xef\xee|Q\xaa$+S\xf1&+M!r\x9f\x00\xd5\x11\x06\x8d\xf3{\xd1\xffN\xed\xa2\xe7\xdeu0\x81ҎB\xe4S\x93\xe8MO\xae\xbd\xf7\x91\x8d\x0f\x98\xce\xc1\xedG\u0a5f\xe7\x01\xad\x16r\xb4\xfd\x82\xbfn\xe3A\xbd\xfd\a\xc1umu\x9d\xeeI \xd9\xeb\xe0\xee.\x90\xf3K\v\xb9\xc6Qh\xaa=\xee|\xac\f\x1ai\xe8E-ց\xff\x05\xabu\x9fh\x81e\x8er\xb6\xbd\x1dlܚ\x14\xa7\x8cgY\x05Ə\xa9@\x10\x9d\xf3\xb9C}\xef\x11\xe6\x11h\xea\xf2\x9a\xff<\x89\x95\xaa\xa7[(\xf2\x1c\xf20\x86\xba\xccQ\xc7Q\xc5i\xee9\x9bh\xfds@\x18:\x88\xbbBp\rd}\xa8?i\x11\xb5\x16\x05\xbf{=k\xfe\x06JCe\x06]ߠ\xd2r\xd2;\xc4\xdd\x06U\xc1\x92\x94*\x95w2-y\xd6\xe0\xb2\x1a\x96*d\x82\x15\xafd\xd6Uu<\xab\xden\xe0\x94\xf9.\x843\n\xae\xf6\xf9\xecm\xbd\xdb}\xa2\x85\xb6\x88@Q\xe5d;\xd1\fJ~G\x8c\x81\xa6\x11w0\x11)\x88\xe3\xceDohf\xb2\xcf\x17\xf3\x01\x9b\xe80\x8c{o6\x19\x16H\xd9\x1b4\x89\v\x90\xf4\x86<v\xc2d{\x83!{N\xaa\xff\xe31\x12\xb9\xec=\x01\x8dn\x88b'H\xd6\x1b\xbc80\x1c\x11\x17z\x88@\xc9C!\x85\x06B\xf6\x85\x0fv\x05\x04vBf\xedPA\x8c\xf3\xef\xa0\xef\x01\x1a\xe1\xe8\xf7\x7fh\x0f̦S\x1f\xe5\xa6[\xc7{\x0f\xcc\a\\\xf2=\x92$\x9a\xb6\xbb\xb5\x90\xff\xdfC\xa6\xe9.w\xf9\x01\xd7x\xb0\xe1\xfa\xa0{\x1b\xef\xca>\x80\x9f\x06_\xd7>\xba\xcbEm\xbb\x9e\xbd\xdf\xdc\xef\x8e>\xe4f\xf6\x82\xecs=\x0ft)\xc9\xeec\xc7-\xec\x05\xbbW1\xeeም\xbf\n\x96\xeeG\xdb\xcf\xeel2\x84\x17\xf6\xf0A\x83\a.[_k0B\xdd,m\x98\xf0\xdd\xcf\xf1|)\x8a\x9e'\xbd\xad\n\xe9Ez\xc6\xceն\x03\xb5\x7f\xba\x917\xae*\x8eڄrg\a\xd3\xf6O\xae\x03r\xb9\b\x06j\xb9\xe0ǳX\xa4\xfbb\xacK\x9d\x8a+\x9d\x17\xe6l\x1fҮ\xdaO\xf7x\x85\xb5\xad\xeb,e\xca?:\xe9m\xf3\xe1lP\x8a\xf9\xb8ۅ\x83\xfc\x03H\r5\xdf\xf0\xa2\xcb\x1b\x8d\x9d\\7\x1eE\x9d\\U\x9a\xd4}\r\x97i\x05\xfejoi\x10<\x8a\xc2۵T\xf4.\r\xcf\x03\x88\xf4\xd4'\xf8Tvy\xfd\xb5^\x98J|-\x02\xb4\x8e\xb1\xbaC]\xb4\xb6\b\x1f\x13\x8d\x9d\xb25\xbf\x85\xed\xfa\xa5\xe1\xeeNC\x81\x83\xf5\x8a&;\xd2^v\xec\xadj!\xef\x7fV!\xc4\xe8uuY\xde\a\x98\xe7;S\x1f\xf6[\xa4\x98\x10\xef\x1d˾\aZ\xd88\xaf?\xef\xad\xd3n\ue3ad\xb7\xf2?\xc7\xdc1W}4\xd97\xa7\xceJh\xbb\xed:v\xeahA\\\x9b\xd9\x10\xed\x15X3b\xa3!\xbf\xe7\x14\x12j\xfd\xf9LO\xdb\x15Z.\xab}o\x15\x90\x13A\xb8\xaf#\x9f\xd7\x14^\x02\x1c\xf2\"XmP\xcc\xe3\xb3[ֻ\x13\xd8|\xae˩\x1f\xf6\xef\x17\xe3{;\x84S̒\x95Hn\x87aLv\xc23\x11\xa8\xeb\x89\xe9\x00!s\xe9S_\x10\x11ծ\x1fءO\x16\xd1J\x98\x96\xe8.V\xc2\xd45A\xe5\x84\xef\n4\xdbO7\xa1@\xbda\x80aU|3&g\x0f\xe2.\x90\x9ep{R\x8f\xf6\x9a\xa5\x0f\x90\xe0\x011\xfe\xb0m\xb8\xaf\xf5Y\x83n@/\xcf\xde\xd5h3\xa8i\xe6\x85\x18\xc4>{3\xe3\x1b\xdf>\xeaO\x84\xaf\x9f{\x98\xd5R\x98\x1a\xf3\xf4BEY\xa8\x8e\x8e\n\xc7<\xae\xf0\xe9,\xe4\x9aÜ\v\x9d\xc3\xe8,v\xcfsHj\nF\x9d\xfb\xd2\x0e\xb8K\rS\xb2܀\x13HD\xaf \xf9\x04{\x00d\n\xbdi\xaa>\xab\xb5v@\xed*\xa9\x86\xe4\v]YP\xe1ԡ^\xf1\x1c&\fg[XJ\xbf\xf9\xd8\xeeW\xe4Q0;\x9a\xd0r\xf7\x1f\xc8\xd4ߓ\x97\xff\x00\x83\x84MG\xb0\x88\xd7:V58T\xb8\x9fX\xc5ᑶS\xffv\x8fk\x03?\r\xe56coP\x89\xf9\x7f\xbf\xad\x94\xd8\x0e\xc8A\t[C\x00f\xbf\xcc3iVP[t~uq#r\xe8x\f<\x04\xca?\x14߲+\x9d\xee\x02\x89\x8f:9\x0f\xccU%\xb6\x99\xd3z\xc6\x1a\x84\x16R\xf6\x96\x8b\xb5V\xf8\xcf=\xf5\xd7\x10\x86,VBB\xf8l\x93Ʉ{\x8eC||B볮\x82Æ\xf0\xa5\x1d@\xc1<\xdaB\xc8 \x14\x15WZ\xf1\xd45\xa9\xd8\xda\xccM\x9b@\x8b\x8atw\xe1\xeaO,1\xf7\xb6GhpeO1\xea\x0e\xbb7h\xbeID\xa6q\x9d-\x1fj?\xb0\x17\x1d\xbbc\x15Ӧ\xf17\x89\xbcb\xdb\xe9\x03\xedƩ\xc3\xc5\u0557\x0eE\xdb\a\xdc=\xb6\xdf\x1f\x02\x06\x0e\xee\xdd\u0557\xeeف\v f\x14ߘ\x95.\xd8\xf1\x9d\xe4nz\x94.\xd3M\xae\xefඬ\xd3kp\xb0\xb3T\xe4=#M[\x1b\xc3gں\x95\xb7ex\xd8\xe1\x8e\xc69藺K\x19+\xf1\\u\x8a\u05c9n\xe4\x14 \xc9\xd5\x10\xa1E䱙vM\x18\x8cXT6\x99\xd5h\"\x05\x1b\xde\xc3\x06\xe3\x15GG!\xf6\x8f\xac\x06\x06١\xf3\xa27\xd9\xd3=\xd9QI;\xf9\xd4$+\x91\x96\x99\xb8\xec\xb1S\x1a\x88\xbc\xa9=\xe8\xb1Y*\xf9ϲn\xb0T\x05&\xee\xe9\x16DV\xe7\xa5pWX\xe1\bE)\xd4\x16\x95\x1b\xff\x1dwI\xe6\xe0B\x18aצ\xabk\xa1\xb56\x80\xb4\x04\xee&\xaa\xf1\xdd\xceSo\xb4\x91\xb13,\xfcj#\xb1\xd6w\xae\xa7\x0ez\xab+m\xef\x895\x9dv\b-\x96E\x1a\xba\xa6\a\t\xdf\x14e\xee\f\x90\xa4\xccsܔ\xfd\x1d\xfa\xeb\x0e3\x0e\t\x93\x87\xbdOW\xe7)\xb5\x02\x01j\n\xbe\xde\xec\xa5\xfc\x9b\xee\xf3\xc1\xfc\x02l\x83\xc0n\xc8]pz\xfb'\x00\xdds\x13\xcaL\xd3Y\r2\x1a\x8aL\xd6N\x81\xb8\x831\x84\xca\x1f\x05\a\xbbM!\x9b\x00\x82\x11\x1d\xe8\xf6\xe7\xa1@%0\n\xfe\x1b\x98\x01\x17\x96m&\xfd#F\xa1+\u07b4g\xf8b\x84\\\xea9Rh\x8f\x9a\xbd(E{\xdb]\xf4%\xbe@\vd+\xbe\xebg\xe98\xef\xf4\x1eJ\xafCo\xa3\x16X\xe6#\xf9\xa1:ןD\x8f1\xc4\x10O\xa0\x9d\xa55\x95\xadq\x11\xbc\xb0~}\x02\x03\x11u\x0eӾ&\xb1\x15\x87\xae\xd6\xf7Zp\xa3\xd5\xde\xed\xbf\xaf?\xe9.gpi\xee\xee\x90#\xfd`\x13B\x15\xb22\xc9[0Q\x9a\xc0Wg\xb1\xa4\x81rZsnk\xd4D\xbaw\x8d\xff\xd1x\xd4K<;g\xac\xaa1E\x80U\xc5Ƣ\xe7z\x01\x8bM\x1c\x91:t\tT\xb3DIEQ\x93\xfc\xe1ٞtd\xf8\xb0W\x04\x81\x86t\x9a\x01\x18\x03\xe4\x88\xc1\x86Ӕ\x9dB\xdb.\x12\xea\x9bn\x81e\xf5\x8dYz/z\xbd\xa8\u074bƲ\xb4\xbd˽\x82'\x98\xec\n͠\xa7\x9c\x90\x9d<\xec\x86M٥\xb8\xef\xfc\xcc\xe2\x02\xb3\xba\xfbDݔ]\xa8\xab\\/\xe1N\xbf\xf3+\xa8\xed\x94j\xf9^\xe7WY\xb9\x94ꓗ\x97\xddG\x9d\x84\xec\x1c\xfbi\xdb~\xe9\xfc\xbe\xf7\xc7;\x0fƦ\xb5\x90\xfd\xd8m=\x8cE-\x16\xd7\xdclU\xb2ʵҥq\xd7:R+\xc3L\xc1s7\xfdϑ~\xd2ۆ\x90'\x00\xd1-\xa7\xf7\xd8L\xa2\x9c\x95}\xeb\xf5\xb7\xc1\xbdK\xb5\x1cɽ\xad\xd0{]\xe8\x96ҳb\xe6f\x8e\xe2\x19\xe0\n\x9f\xf0\x17W>\xce\b\xae\xacY\xed\x8eLԲ\x7ft^}\xabR\x9c\xd48\xb3\xb3w\xfa~\xd5B\xd4\x1bg\x19\xf5j\xf7\nE\xa0\xc5\xf7\x0eW}H\xafFh\xd7\a\xf8\xb5\xbb\xf8\x87w\xf7\xb6\xfa\a\xa0\x93\xd7\x7f\xeb\xc5B\x9f\xac\x98컶\x97Z͆,]D\x06\xe7\xd0R\xf0\x92\f_rR\xdf3\x997\x90 \x1b\x91\xabD\xe0\x7f\x1f\xb44\x15\x84N\xc4\xfa.\xc3\xc3]\xbdP*h\xbf\xa4\x17\u0604\xd9c8\xa0m\xb2gV.\xeaB\xad\xc4~\xf6\x92\xaa\xf8\xddo{\x9fح;\xdc\x16?\x83\x1e\x8f\xd9\x1e>\xb8K\xfb\xef\xdf\u0ba0\xb5\\0\xec\x1a\xf9d\x9b;$\xe4l\xbd\xd1\xe6F\xea\a\x1e\x90?\x8c\xab|\x94\xfflrH\xb3ܽ\x8b\xec\x85\xcc\x0e^z\xf8\xc6\xc5ۈ\xc5\a=s\xf1\xd6/\xff\xe2-.\xda\xe9\x88\\\x14e\xae\xdc\xf9m\xec\xe1\xb0\xd5}\v\xdcHY \xbe\xe0\xd7\b\xbc\x1cX\xb9v\xaaAy\xd9S\xd0\v\x99\xb9\x8bg?\x14y\xd0\x16z\r\xbaAf\xdd\x03\xa8\xdc\x17f\xdfi\xb0\xf9\a\x02Nv\xfc\xbe\xd7\xe2\n/;\xd9<\b=\xc87\x7f\x91*\x8d\xc1Qx\xd8#\n\xfay\x02e\x1d\xfb\xa1%\xe2\x8fD\x04Π\x9fʑ\x1fZ\x0f,\x1b\x9e\xaf\x84\xc2|[7\x86\x86\xddw\xda\xe5\xf5šv\xeer\xaf\bs\x86Y{\xbb\xbd\xb0\xd9CH\x88\xbcK\x89X\xb9\x8f\xf9\xfau/s]n\xa6\x1e@c\a\r\xe2\xf4B\xb6\x11\x95C\xe5\x9b\x1b\v\x10\xb1\xf8o퓍\xf0\x0f\x0e\x16\xae7\x97\xc1;o\x8bl\xb6\xd9w\xa4\x98\xdf\xed\x03\xc8\x7f\x1esr_\xb4\xbe7\x1a\xefS\nQ\xd4\xf7\xfc\xb6b\xe8\x9e_z\x82Sb\xfc{w\xb8\xeb\x02\xc0S\xe0l\xb2\x87\xae^\xf2U\xe9tRY\xac\x83f\xe5s\xb8e\xa99aG\xde\xdb\xef\xe3K\xff\xc1\x19$\xc9\n\x9f4-\x9b q\x12\x99)\xa6b\xb1\x80\b\x06\x86§S\xb8\xac\xda\xd1E\n\xac\\\xbc(\xb4\xcc\n\r\xb9\x9csXe\x02\x81p\x82\x84\x82\x1c\xe3J\xa7\xf0̚o\xe1\xc2Q*\x9e$%\xc4\xcf^\x9a\x82w\x03\xbd{1\xbbϻ\x82sj\\,\xa1W:7\xd0|Q\x7f\xbak/W\xd7\xeb\xf6\xb6\xce\x06\xed\xb2&=\xfd\xff\x1am1\x99\xd1l\xc1\xf3\t\xd5XD\x83\xf6b\xd7}_c\xed\x9fã\xbb\xaca\xb7|w\x95\xb6\xaf'\x0e\xe6$\xbb\x17\x81BɊ\xab%\xb0J\xae\xcb\xe5\xca3[`1/&\x1c\xd0^\x90i\t\vrJ\xc4!\xd1\x1aZ\xb5\xacKWR\x10.m\xfa\xa2\xe61\x88\xdbyF\xed\xcdO\xfa\xa7N\x99A\x03\x95\xd7\xfe\xa9Z\xe0\xc4-\xa4Zy.EڸV\xea\t\x02:\xd7\x0fw\x13\x19\x18\xd9#\x02\a\x89\x17\xb7\xd2^\x1e\xea\xdbt\x83\x83*ީ\xef\xe4!r\x830F\x93\xc3\xdf\xedU\"\xa6y\x03\xd7\xe1\xbe\xdd45\x8d{\x82\xbd\x1bi^)\xf4\xc7J\xfc:\xf6{'No\xcf~\x86w\x18w!\xb0\xf9\xee\xe1ی*\nZ\xbf\xd7\b5rp\xafQ\xc1\xf3w\x10ǲ\x9b5\x8a\xe54\t\xac\xf6\xe4\xa7\xe1f\x97\x95\xb4\x7f\xbb߹\x87z\xaeo|Vӓ]\xe0\xf8\x056\xafp: \x87]\a\xf4\x88\xb5֏\xee`\xf05\xe0\xe0\xeeu\xf5/Ė\xb5\x91\xdc/\x98\xe5洆{\xb7\x14\xf7\x93\xea\x06\x94'\x89\x80\xbb\x180\x93\x1c\xda\xc1_9\xf3\x13\r7Y\x99\xf3\xcc\xfd\xb3\xca\xdb<c\xdf\xff}\xc2\x1c\x06\xbe\xf8u\xb0\xef\xff>\xf9\xbf\x01\x00\xacՄ!f\x16\x02\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec]_s\xdc8r\x7f\xe7\xa7\xe8R\x1e\x9cTi\xc6v\xee%5o\xbb^m\xcew{\xb6\xca\xd69\x0fW\xf7\x80!{D\x9cH\x80\v\x80#)\xa9|\xf7T\x83\x00\xff\r\xff\x80\xa3Qj\xbdG\xd1U\xbb\xc3!\x9a\x8dFw\xa3\xd1\xf8\xa1'\xdal6\x11+\xf87T\x9aK\xb1\x03Vp|2(\xe8\x93\xde>\xfc\x87\xder\xf9\xf6\xf8~\x8f\x86\xbd\x8f\x1e\xb8Hv\xf0\xa1\xd4F\xe6_P\xcbR\xc5\xf8\x13\x1e\xb8\xe0\x86K\x11\xe5hX\xc2\f\xdbE\x00L\bi\x18\xdd\xd6\xf4\x11 \x96\xc2(\x99e\xa86\xf7(\xb6\x0f\xe5\x1e\xf7%\xcf\x12T\xf6\r\xfe\xfd\xc7w\xdb?l\xdfE\x00\xb1B\xdb\xfc\x8e\xe7\xa8\rˋ\x1d\x882\xcb\"\x00\xc1r܁\x8eSL\xca\f\xf5\xf6\x88\x19*\xb9\xe52\xd2\x05\xc6\xf4\xb6{%\xcbb\a\xcd\x17U#\xc7IՋ\xaf\xae\xbd\xbd\x95qm\xfeܹ\xfd\v\xd7\xc6~Ud\xa5bY\xeb}\xf6\xae\xe6\xe2\xbe̘j\xeeG\x00\x85B\x8d\xea\x88\x7f\x15\x0fB>\x8a\x9f9f\x89\xde\xc1\x81e\x1a#\x00\x1d\xcb\x02w\xf0\x89\xe5\xa8\v\x16c\x12\x01\x1cY\xc6\x13\xdbϊ7Y\xa0\xf8\xe1\xf6\xe3\xb7?\x10{\xb9\x95$\xddNPǊ\x17\xf6\xb9\x9aE\xe0\x1a\x18|\xb3\x9d\x04\xe5\x86\x03L\xca\f(\xb4\xbc\bCO\x14\n7\x9e\xcb\x04\xa4r4\x01\nT\\&<\x86\x1fY\xfcP\x16US\x9d\xca2K`\x8f\xa0J\xb1u\xcf\x16J\x16\xa8\f\xf7\"\xa4\xab\xa55\xf5\xbd\x1e\xa7o\xa8+\xd53\x90\x90\x9e\xa0\x06\x93\"\x1c\xab{\x98X\xe9\xe5\f\xe4\x01L\xcau÷\x15I\x8b,\xd0#L\x80\xdc\xff\x03c\xb3\x85\xaf$g\xa5=\xb7\xb1\x14GT\xd4\xefX\xde\v\xfe\xdf5e\rF\xdaWf̠6\x1d\x8a\\\x18T\x82e4\b%^\x03\x13\t\xe4\xec\x19\x14\xd2;\xa0\x14-j\xf6\x11\xbd\x85\xbfH\x85\xc0\xc5A\xee 5\xa6л\xb7o\xef\xb9\xf1v\x12\xcb</\x057\xcfo\xad\xb6\xf3}i\xa4\xd2o\x13<b\xf6V\xf3\xfb\rSq\xca\rƦT\xf8\x96\x15|c\x19\x17\xd4Y\xbd͓\x7f\xf1\xa3\xa8ߴ85Ϥ6\xda(.\xee\xeb\xdbV\x89G\xe5N\xba\\\xa9Gլ\xeab#^.\xee\xadT\xbe\xdc|\xbdk\xab\x0e\xd7-\x92\xe0\xa4\xdd4Ӎ\xe0IP\\\x1cPU\x03wP2\xb7\x14Q$\x85\xe4\xc2\xd8\x0fq\xc6Qt\x85\xae\xcb}\xce\r\x8d\xf4\xaf%jC㳅\x0f\xd6[\x90ΕE\xc2\f&[\xf8(\xe0\x03\xcb1\xfb\xc04\xbe\xba\xd8I\xc2zC\"\x9d\x17|\xdb\xc9\xf9?j\xbfsҪo{g48Bކ\xbf\x16\x18wL\x83Z\xf1\x03\x8f\xad\x01\xc0A\xaa\xc6\xc4[\x9e\x06`\xdc.\xe9\xf2\x8fv\xef\x8e\xf0P)\xca\a%\x05\xe0\x13\xf9\x8d\xc6^IO\x1eS\x14dE\xaa\x14\xc4a\x8f\"8籍:7\x87eG\x97\xc1\xbc c\x9cd\xed\xce=D\xac\x91\"%\xf5$C~\x80\xeex\x97%\x9d\xa7\x029\xcc]\xa1\xe4\x91'\x98\fIoJ\x82t%x`ef\xbeɬ\xccQ\xdf\xc9/\xa8\r\xef\x8c\xe9 \xf3?\r6\xf3#\x8b\x1a\x1eS4)*2<\xfb\x85\xf5a\x03T\x81\xfaVjL\xa8\x9b\x86= 0\xd8W\xfd&o\x98eP\xc8\x04\x8e\x15{\xb0\x7f\xf6\f\xf7Ǣ\x19\x8f\xbd\x94\x192q\xf2=>\xc5Y\x99`R\xcfMz\xb6\x977'M\xec\x14ϸ m\xa2\t\x95\x86J4\xdf\xd2\xec2@\x14\x80)\x042\x7f.*\x8a\xc0\xedP\xc2~P\xb1\xe8\x1f7\x98\x0fr8\xa1w\xd5?\n!\xd8>\xc3\x1d\x18Ub4֞)ŞG\xa5\xe4C\x9fp!\xd5-\x9cS\xcex\x8c$\x9e\xda\xf5Z9\xfd\x0eD\x94J\xf90/\x96?\xd2Sʹ\x02\xb1\x8d(a\x8f);r\xa9t?\x12\xc1'\x8cKc\x03\xa6Ӌ\x19H\xf8\xe1\x80\n\x85\x81\"e\x1a\xb5w\x12\xe3\xe2\x992{\xba\xaa\x96\xb7R\x9b\xe1\xef{\x1d\xfa\xb1~\x1cx[\xfd\xad8j\xf6A\x8a\x18\xaf\x81\x9fڟ\xff\x93*Au\r\xec`PY\x03\xb7\xc3h-\x848\xc2\x04\xca\xc2\xc6)&E\xaej\xd3gjh\x9c\xdcT X\xa1Si\xec\xacz\x97\xe2\xf3\x1b\xd5\b\x14\xf0\x88\x02x[Zp`<\xd3\x15\v\xa3Di\x06/\x14\xba\xfe=b\x8b䐴g\x14rD\x9c\xff\xc5\x13$M\xa9\xe7Gf\xdf\xd70O\xe2\x04\xa6d)\x865\xc3\xe9\a<\xa62\xab\x95\x01n\x9eXl\xb2g\x90\u009a\xe0\xcd\x13\xc6V\xa4\x7f\x92{\xc8Km`_\xcf\xc2㽙\xd7 \xef2\xbaA\xc0L\xc7-3\xae\x7f\xa4G\x14\xd9\x10o\\\x00\xb28\xa5\x90\xdc\xceɅ\x9c\xea1]\x1a3\x8cIH\xfbg;\xb8$\xb9\xf1\xce\x04\xd9\xfdҾ\xd3\xe5:0\xf7XO\f\x1f|\xb7\xc9\x13`-\x05\x92\x04S\xf7e\x8e\xc2\xe8h\x94T}\x19\xe9ue\xba\xe7\x01\xea\x19\xec=\xbbW\xce\xc5G\xb2\xe0\x1d\xbc\x9f}vʭv\xff\xdc<\x8bj\xb1X]\xbbF\xb0\xf5\x8dj>\x99\xd7+\xba\x1eS2\xf8\xf6Ȝ\xba\xea-|<\xd8Y\xbd6\xa5\xeb\x00ʎ\x877\x1a\x0e\\i\xd3fP\xdbHh\x1b]p|2\xb6\xc7\xec\xab5\x14\xb9T\x96\xbf\xb4\xdb^\x93\vm:\xea\x8c/HE\xab\x0ew\xf5\x9c\xebZ\x8c\xc0\xc5\x16>S\xb4\xf8\xc85\x027oB\xa8\xb6Z\x93\x87W\xcfm\xc7\xe1Ǻ\x0e\xcd\xe6e\xba\xc05,s\x0ft\xe5\xcc\xc4\xe9M\xbd\xf0\bj\xd3\x1b\x8c>\x89\xee$l\x879\x88\xa8w\x9b\x92b\xf3_K\xaeк\x9a-ܥع39\xe3v\xaf\x1f>\xfd\x14\xa2\xb6\x8b|Љ\x00~\xa8:9\xc8>\xf4\x16\xf5S\x17k(\xd8\x10\xccٟ\xae\xf2\a\xfa\x1a\x18<\xe0\xb3M\x95\x84\xd3\x14@\xea\xc0j\xa2\ni\xfdW\xa9\xfc\x03>\x131G?LLK\x15\xcc%L\xf09\xfc\xe1\x9ex\x89K\xe72+9\xd3\rۗ\xa1%\xe7\xd4_-^V\x14\x19\xa7U\x91\f\xed\xf4B\xe7\xd6\\^\xfagw\xbf\x1e\xbe&\x11T)\xc2\x1b\xbd\x80$T\x03O\xf6\x99\U000825b4\xa4m\xd6B}v\xed\x1beC\x17\x91\xf4\xacY\x8b\x84\x8f\xe2\x1a>IC\xff\xb9y\xe2\x94]\"\xdd\xfaI\xa2\xfe$\x8d\xbd\xf3\xea®\xbar\xb6\xa8\xab\xe6\xd6\x7f\x89j\x89E\xf2Y\xc8CÇ\x9d\x85\xc9\xce\xea!\xe4\x9aRkR99-\xa2I\x84\x1c\x83\x15k>\\\x16Rl0/\xcc3\xbdo1\xc96onؤ\xea\x8c\xdau\x14Lp\x8aM\xc7\xe2\x1d%,C\xe2\xac\xee_\x95\x9e\xce(q\x0fII\x03R\xa5W\x99\xc1{\x1eC\x8e\xea\x1e\xa1\xa0\x99h\x89\x92-\xf2\xf9/\xd0\xcb%\xf1\xa5\xffs\x93H'\xcf<um\xc8'\x04?\xeb\x87=\xb0\xc1`\x8e\xf5r\xfd\xb5!\x84\r\xea\x02G\x83%\x89\xdd\xf5b\xd9\xed\xe2\xd9h\xf1\bv|D\x8bU2\x19\x069\xb3\t\xc1\xff\xa1\xa9\xd9\xda\xe7\xff\x06\xf2Q0\xae\xf4\x16~\xb0\xdbY\x19\xb6)\xf8(\xb1\xf5\xb2@\xa2\xc4\rE\xb0\xbf\x96\xfc\xc82\n@\xc8\xd5\v\xc0\xac\nG\xe4\xe1$\xe4\v\xb5\xef\xc7T\xea*f8\xd0\xde\x1a\xf5\xfe\xea\x01\x9f\xaf\xaeO\xfc\xc8\xd5Gq\x15J\xd5\xe5S\xba>\xa3\x8e|\xa4Ȟ\xe1\xca~w\x15j\xd8C\xe1\xe2\x92@p\x91\xae/x\xb8\x0e\xf9w\xd1\"\x8d\xabs\xbc>\n\xaa\t\xf9L[!\x93\x90X`b\x95\x13]\xd0h\xa4\xb8Qj\xf1\x92\xeesժ^\xc8iH\xe5c\xbd\xf9\xe2W\xb8);\x86\x84|\xfc\x00\xdc\x00\x8aX\x96\xb4\xcdh\xe7s\xb4\xe4\xab\xc5\x19M\x1fv\xe7l>1C\x17\x8a2\x9f\xef\xce\xc6.\xf1\xb9\bX\xa3m\xe0gƳK\n]\xa1QA>\xb0#\xf4/U\xabZ\xb1\xca|\x8f\xcaj\x15\xed\xfb;\xe9ϒ\xac\xdf\xde\xd50;\x066\x9f\xb9\xf5\xbb2\x14qûy\x81\xe7\\\xf0\xbc\xccw\xf0n\xf6\xd1JF\xb4\x9b|\x8f*@Hϔ-\x96\x87\xc3\x19\x92\xf2MI\\\xa4\x9c\x99\x14\xf7^C\x1f\x19\x0fʊ\xed\xf1 ]\xfa\xa6J\xb2\x90\xe8\x9eI\xe4\xcc\xe6~1\xf1\x02\xdc\xc2G\x03\x89,\xf7\x1e\xf50\xfdgS\xc6.OI$\xbb2\x7f\xaf\xb7\x97\xd46\xc3s\x94\xa5\xd9\xcd<֓!\x81Idi:;\xae9{\xa2\x91\x06\x96\x93\xa9\x82\f\t`\xe9\xed^\xee.\xf7E\xe2\xb7\xfb\xb5>\xdbJ\x9d\x8ee^dh0|Xb)4OP\xf9\x1dz\xe7-\xa4p\xa3S*\xbc\xa0\x1c\xc3\"\xbc\x8dW\x88\x99\xa7\xeaY!\xba\xc0|\xf5\x0f\xb9\xdfE\xc1\x03K\xc9{\x8b\x18\xa2\xb5\x89\xfd\xe4\xe2\x17\xb7\xbf\x16g\xa5&\xed\x9cO\\\xd08j;\x90ܴ\x87p\x1b](C\x16\x9e\xbc\xa8\xe5\xb9P\xcb'\xa6kRN+\x9d\x10\x83\xae\x04jg\xe7\x8e)\x0fF\x00\xb4\x90\t\xa0Y[G{\xfa?H\xb5\x85/N\x17\xad\xec\xf7v\ah\xf3ȓ\x10\xdb!\x82\xfaw\x18A\xf8\xd1\"\xb7\xac\x7f\x17A\xc2\x18\xfacF\xb0}<Ȟ\x16\x10o\x8f\xef\xc9\xd2\xfdw\x04\xa5\x99\xa5\n\xb5L\xb9\xae\xf5\x9b\xc0KU\x8e\xf7Or\xffF[s\xa17ݣ\xa0%jH\xd4\x1e\xe8Ԫ\x7fO\x1bB?*\x81\x06\xf5ƃ\x067e\x85\x1a\xdc\x1c\x1cl0 \xd5\xfe۞\x04I\xca\x17\x99\x03ɼ[\xd3_\xed\x8bBx\x94\xf0\xfe\x1d\xe4\\І\xea\xf6r\x9a\x1c:qz}\x8f.\xa0<\xa4\x96\xbb(x\x98?\xb1\xbc3\x01\xd4 \xcb\xf9\x15G\x90\x18\xe6E\xb0\xb1\x96\x14\xbd\xa0\xdbA\xd3\xea\\\xa2\xc7a9Ԩ\xf0\x86\xa0\x1c\n\xbb\x9bHg\"9\x9cF3\xf1l'H\xa2Y\x039\xb6\xd1Y)\xc1\x15)\xb1\"%V\xa4Ċ\x94X\x91\x12+RbEJ\xacH\x89\x15)\xb1\"%V\xa4Ċ\x94X\x91\x12+RbEJ\xacH\x89\x15)\xb1\"%V\xa4Ċ\x94X\x91\x12+RbEJ\xacH\x89\x15)\xb1\"%V\xa4Ċ\x94X\x91\x12+RbEJ\xacH\x89\t\xa4\x84/G2:\xdfv\xc4\xd6\x145au)\x88\x91\xc2\x1dT\xf2f\x1c+Ax\x03\xd2\xe0\xb2\x00.\x12~\xe4I\xc92\xe0B\x1b&\x88\xbc\x85&{\u07b6\xd1Y\xe9\xc1\x0e紬(\v\xcf?\u0558\xe8\xd4a\xb2x\a\x059Y\xd8\xe9\xa3S\xc64\xd6\xfd=\xa3*>U\x89\"PT\xb5ν\xccb\x1f\x9b\x10a2\xbfS\x8f\x8e\xddU\xea\xedcm\xa3\x97EQa\x15\x80F\xe49P\v\xa8\x99\xd8;1М'2\x12\x1eS\x1e\xa7\x8d\xfd\xd9\x10\x01\x12\x89\xdan\x92\xd3n\xd0\xf36zq\xa28\xd8\x7f-\x88W\xc3\xf2\xa8AU\x84f\x04]\xb7m\x05P$\xe7ZEV1s\xd1\xd7\xc9\x05r\xfex\xd2\xf8\xd2\n\xedv5i\x1b\xa8\xdam\xb9\xa6U\x94\xbb;O\x93j\x035<\xfc.\x06\xea\x1c{\xf8\xd8o{a{\xb8\xc0(\xd5,|׃\xb4\x00\x00\x14\x0e\xfe9\xf0\xcc&|\xe7\xa1\x01\xb5\x10gG\xeaRb\t\xcf=\xf4\xb7h\xe6\x9e\xefI(\x00\x91SO\xf2\xb3\x94!\x00\x8d\x13\xba\xbd\x12\xa4\x91K\xf15-\xdcL\x00e\x98\xc5֜`f\x82\xa8\xbe\fW\x13\xae\x1a\x8b\xf14\xe1X\x9aeb\xa4k9\x8e&\xd8\xdd\xf8ˏ\xc4Yݭ\x87\xf1\xb4\xcaH\a\x0f\x13H\x1b\xc6q3\xa7X\x98`\x9a\x97\xc1\xcc,\x16\xec2\xacLG\xacS8\x99\x05J_\xfd\x9b\xc1ȴ6\x87\x83IN\xe0c\xfa\xef\n\xa69\x82\x8d9ݻ\xa6\xd7\x06S\x1d\xc2\xc5\fb]\x82)\xbe\b\x13\x13\xec\x9f\xcfԹ\xd0\xd0\xc0\xff\xcdg9\x96\xe3_\x16a_\x02\x93CK\xfb\xd6\xc2u\xec\xa2\xd7º,\x1a\x9d\x8e}\a`\\\x1cz%\x80\x8d@|\xcb)r%\x80\xf6<\xb6\xa5\x8fZ\t :\x83k\x19D\xac\x04\x90\x1dƴ\xbc(\x9c\n\xd6\xce\xef3\xc7\xe9N<-\xe0\xe83պ\xf5,\x15R\xb7\xca{w\"\xfb\x89CV\x8e?\x1b\r\xfdZ\"\x95\x83==\xaa\xe5\xbcr\xab\x1e0\xdc\xcecp*U\xa4\xf5\xba\x06:\xb0K(y\xf9H\xa5),\u06dd\x8a\xbc\xb5\x9a\xf197\xd5e\xedڧ\xf1\xa9z\xca\xd0\x1bS~\x9f\xce\x02%|\xcd`\n\x86ݑ\x90Zx\x95\x86֢\xe0\x82V\xdb\n\x99\x9ew3\x96\xec\"0H \x10$\x14\x04RL\x14_\x1eP'*\xbelӳ\xdd\x05Բ\xfc\xad\xf3SN`\x0e\xa7\xa1\x8d\xac5\x88&]\xef\x10\xbd6ݥ\xa8\xe7\x16\xb0\x9dah\xca;_5\xf3E\x95O\xbb\xb2[l![\xc4,\xa6v\xd5\x00\x17Jƨg\x0f\xfd\x04E\f\x1d\xa1\x9eJ\xaf\x7fĐ\xd2\xd7l\x86d\xb3~\x1f<Sx\r\x7f\xbc\xbb\xbb\xed\x9f,\x9c\xa5Y'\x12\xb6\xd1\xe5\xd6k\xf3\xa7\x10\a\xa4t\xf3\xd4\xda<\xa0:?\xf4yޯ.\xe7n\xd19\xc1\xd7;-ط\x93\xdfNĺ\xe4\x14\xe1\xd28p\xf1\x89\xc2\xc1\x01\x989W\x18H\x94\xbcc\x12v\xba0\x98\xe2\xc9)\xc4\xf13\x86\xc14\x83\xcf\"\x9e9\xe2\xc1\xe0\x93\xf3 (\x81d\t)hc\x84\x00(k0\xc9\xc0\xe0l)\xa6e1\xb2e\x11\xbe\xe5\xcca\f\x06\xc4.\x86\xc5\x06R\x04?\xee\xc1\xe0\xd8`\xc2a \xdaE\x11\xd4\xf2X\xea\\X툼'\xc0\xb5\x81$=zp\x01\xc46\x98r\x03Ž\x00\xd0\xf6L\x8d\x0e\xc6\x1b\rHx\x1ez\x1bH\x15\xbc\x19x\xed\x1e\xc2\x1e9\x00n0I\x0fR\x1a\x83 \xd50\xdc`\x8a\v\xe0\xbag\x8dƲĔS\xb7\x80g\x83W\xf4\xf4\x8f~-n\x17-\xd4\x04\x1b\x17\xb7\x02K\xfb\xf9\xb5\x02K|*\xec/\x86|5̔\xe7\xf9\xe2\x9b\x0e\t\xef\x92-\xd7\xda\xde\n$JaVb\xd7\a\ft\x19\xd3\xda\xe6Pf\xb4\x8a(\xa4\xd0-\\\xdb\x12\xc5\xfd\xf7wﶯ\xe6Vs4\xa9</(\xff\x8bm\xda\x11VE\xcd\xe1\x85\x03i\x82\xffI\xbd\xae\x8f\xfbϛ\xbbW2\xabE\xa8\xeb\x81~\xd7\xd8\n\xdf\xf5>P:\x90(\xd8\xdf$\xe4qW-^F\x91V*\xe3\x00\xecג\xe7o/\x9a5.\xe1\x89\xda\x05@\xb4\xa7B\xf3\xb67\xc5`\x9a)\xb3\x1e\xac\x14\xde\xcd8\x8f\xf0O\x10\xdd\x16̤g\x8d\xe9-3\xa97\x0e\"\x02\xb23\"\xa1\xa2\x83\x8e]\xbcݾZ?\xa5:/๕ʴ\x9d\x00)Y+\x8e_\xee\t,+\x1d\xe5\xe5\x1a\xa8\x1a\x03\xfd܆+\xb7\x13L\xb3\xb7 v\xaf\xa8\x17Ņc\xfeU\u05f9\xf4{\x9es\xe9\xfe\x11\xe1\xd2/\x8d6\xa9\xff\x8a\xd0E\x14\x89\"\x9aױ^\xa2\xbc\xe8a\xfdjr\xaf\x06\xfb<\xc1;]\xec(\xf6\xa1\xadC\x81TaL\x93_\xab\xd7\xdf\xcf\xe2e\xf14t\xf9s\x13Nd\x12\xfe\xf0\x0e4\xc6R$\xfa7\xb1\x8eq\xaa{\xe9u\xcc\xeca\xc3\x01\x9d\xa0z\x81\xf5*\x86\x8e+\xbeZr\xbc\x0e\xf8\xce\xd2݉\x88tI\xf0\xd8;35y&0\x98\xa8\xf3\xd8Ӂi}20\x98l\xef\x04a\xe8\xf9\xc0\xdfU\x80\xbb\xe0\xdc\xe0w\x1f\x8b\x86\x9f*|ݳ\x85\x8e\x9b\x14\xfb\xd62r\xc20\x98䂓\x88gx\xbf\v\x9fJ\xfcަ[\xb2\x94\v\xa6\n{\xb3n0ݶ7]rf\xf1,\x9bY6\xeb\x06\x9db\\\xacx\xc1\x8f\x86\xedf\x16㥐\aT\xecV\xe1\xa5q\x15\x85\xe2\x04זsЊ\x19\x8a\x16x\xd1E\xb88M\xa3z\xcb#؊\x19\x9a\xf4䊭X\xb1\x15+\xb6b\xc5V\xac؊\x15[\xb1b+VlŊ\xadX\xb1\x15+\xb6b\xc5V\xac؊\x15[\xb1b+VlŊ\xadX\xb1\x15+\xb6b\xc5V\xac؊\x15[\xb1b+VlŊ\xadX\xb1\x15+\xb6b\xc5V\xac؊\x15[\xb1b+\xfe\x99\xb1\x15\xdfI\xa5\xe7ɷ\xb8z\x9c\x1f\xaa߁\xf0\xf8\x84\xc1 a\xa8\x16g\xbf]kRyLѤ\xa8\xfcOLlt,\x8b\x91\xf9ԃ\x1a\xb4\xb7\x95=օB\xad\xc9x}\xb7e\xe1\xe6a#\xb3B\xabD\xb2\x972C&\xc6d2SLv\xae\x84\xac-L\xa23Z\xb8\xc8C+\x00\xb2\xff7f\xfc\xee\xd5n\xb4\xb4s\xe2M=\xd2n%X\vY\xf1\xdcn\xa3E8\x81\x19\xe3\r\x14\xe1\xb0\xcey\x96\x16\xabS\xab\xb4kW|^Cj\xe9\xf9w\f\x10\x86\x9e\x82\xb4ʹvJ\xb4\xfeV\xa57[}u\xbc\xe6*\xcd\xff\x8c\x92\xde\xec\xf8~\xdb\xfd\xc6HW\x81\x15\x1e\xb9I\a\xa8R\x15$\x14\xf6\xf7\xfb\xc4}\xbb4\xbb\xd7E#\a\xa5jsL<\x1b\xae\xaaȲ\xa6}G\xdc\xf0\xd9\xf2ϲ\xed9\xe2\x9b[\xcd\xf4\x8b\x8d\r?Փd\xbf\xd1TmV\xef\xfb-\xd0g\x1bM`\xd6\x16\x96\x10\x9bй\x17T_\x9d+\x96\xba\xa4\xe6j\xfbw\x8a'H\x86VZ\r[\x98\xceVU=\xa3\x96\xaa\xaf\x91:I\x17f\xd3C3\xae\xc0_^\x86\v\xbaQ\x8b\xfde5R\x17TF\xf5\x95=\xab\x92\x9a3t\x97\xd5C\r\x14SH\xedӎ\x90B*\x9e\xba\xea\xa2QX=ۉ:\xa7\xee\x05\xa7\xf5KgH\x9fV7\r\xa8Z:C\xb3\xcb\xcaEj\x95\x9eQ\xa1t\xc6_-\x1a\xfb\xe9i\xd1\xff\x85D\xddS\xf5F\x03\xaa\x8c\x06\xc4\xe5s\x9c\xb6\xeag\x8e1\xba\xaczh\x80\f;v\x11^)\xb4\xfe\x9d\xdb\xd1w/\xad\x0f\xda\xfde\xdbQ\xb2!UAG~\xcbv\x94\xe6d-\xd0\xd0J\x9f\xa3\xd4g\xa7\xef\x19͙\xfc\x9a¶\x84\x19\xb6\x8b\x96ϒ\xd9\xff\xaf\xa6\xbd\xa4\x9b\xb6\xd4\xe4\xcc\xda \x9c\xe1\x19f;&\xf1\xb9\xf7\xe6\xd6b\xb5\tl]\xc9\xcf֚cX\x1dd\xfd\xe3\x061\xfc\x99ӏ\x82\x92\x16Q\xa9\xdcV\xf4A_\xd8%K\x13\n\x91B\x0f\xfba\x1fi\xf6\xd6:\x1a\vFn9\x81=\xfd\xfcr\x9e3\xbd\x85\x9b\xaa\xc8Q\xeb\xc1A\x92\x84\x158H\x953\x03W\xf5\xb2\xf1\xadoGw\xae\xb6\x00?\xcbz\x95^\xd3\xd4נy^d\xc3ޭ\xd4\bW]2\xe7\x84\xf1\x93zB\x85\xb6yl\x83\x9c;\xa6\xee\xd1\xe8\xdd\xdc\x00\x7f9iҍ\xe1\x89Sݜg\xf9j\xa4b\xf7\xf8\x8b\xac\x9a\f\x8fsK3\x9a\xe4D,\v\x8e\t\xb99Ieh\xb9\xa93t\xfa\x9aV\x9f^\x7fǖ\xf9D\xb4\xd5?0\x8e[I\x00Ym\x0fʰ{\x84\xccq\xb6\x8d\x16M\xbc36\x118,\xc3s\x9b\x16\xacЩ4\xdfdV\xe68?$_\xbb\xcf\x0f\xe4\x87hY\xc9\x1e\x10\xe2L\x96IM\x7f\xd4\xe8\xe8\xb0\xcf\xed7\x1b\x01\x1fP\xa1\xa0\xf0\xc6\xcdI.\xc6\xf5\xebM\xbf\xd6\xf4_\xff\xf8Z\xf9\"\xddգy\x99t\x9fwK5\xbb\xab\xecg(\x9f\xd8u{\xdd\x03\x14\x01ذ\x1a\xb7\x0e3\x9c\xe8-q:<yM\xea\x8c1\xd9l\xa7\xee\xee~\xa9:B;\xcd۟Jee\xb1)\x98\xd2H\xb2\xf5\x1d\xac\x1a\xed\x87^CW\r\x82nF\xacſB\x12\xce8xl\xb2\x17G\xab\x82^!\xbd\xb8\xe6U\xf8\xdbp\xbb\x96ki\r\x1a\rب\xee\x8eQbZ˘[\x1fOə\xeaĂ˳\\\xd4\xf4\xc7-{\xd4\x15\x97\x1a??\nJ\r;s\xd3\x1fE5.\xbbhBh\x7f=i\xe6\as\xc8\x01\xd0|\xd2{\xbcG\x9c0}N$\xcd\x16מV\xf5\\[dNRf'\x1b\x1f\x93v=f\xd3C닍\x85\xfb\xd0+\xa2\x99=\x8aA9\xea\x13 pGV\x9e}\a\xf6\x8dYaJ傒\xb8T\x8a\xd29D\xc2\xe1x\xfd\xb6\xd2)GcQbƴ\t\x18\xb3_\xeaǚ\xec\x896\xd5^\x96w6\xf0\xc84\xa8R\xb8\x9d\xac\x96\xf0{\x94a\x88A\xb7Q\x9e3\xb3\x83\x84\x19\xdc\x10\xed\xe5\x836\xa0\xdfE\xca4N\xf6\ue59e\x00\xde\x15\xabmV\x83\x89\x86\x19\x1eڤ\xde\xc0'|<\xb9w#H\xdb\xfa{\x1cU5ULl΅\r\x00\xfaG;u\xac[XH\xaa\x9e\xec_C\xbez\xb8\x97A\xa7LlC\xaf:L\xa5\xe1_\xf9\xe9/\xdaڴXL=\xf9\xb7(\xc8\xf1\x8c\xf2?\xe6p\x06\x8c\xa4w눊փ;8\xbeo>\xd9\xfeW[j\xee\x8b\nW\x86IKW\xdcd\xec\xee4\x96\xc7\xe2\x18\v\xe3vh\xe8\x06\xc0\x03\x17\xc9\x0e\xae\xae\xec\x87\"+\x15\xcb\xdc\xc7X\x8a*\x80\xd3;\xf8\xdb\xdf#\xb0\x11\x19&\xdf<\x1f\xf0\xb7\xbfG\xff7\x00\xef\xf6k@M\xc3\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WMo\xdc6\x13\xbe\xebW\f\xf2\x1eryW\x9b \x97B\xb7\xc2MРi`\xd8i.A\x0e\x944Z\xb1\xa6Huf\xb8\xae[\xf4\xbf\x17CI^\xadV\x9b\xb5\x03\xd4\xf2e\x87\xc3\xf9x\xe6\x99!\x99m6\x9b\xcc\xf4\xf63\x12\xdb\xe0\v0\xbd\xc5?\x05\xbd\xfe\xe2\xfc\xee\a\xcem\xd8\xee_\x97(\xe6uvg}]\xc0Ud\t\xdd\rr\x88T\xe1O\xd8Xo\xc5\x06\x9fu(\xa66b\x8a\f\xc0x\x1fĨ\x98\xf5'@\x15\xbcPp\x0ei\xb3C\x9f\xdf\xc5\x12\xcbh]\x8d\x94<L\xfe\xf7\xaf\xf27\xf9\xab\f\xa0\"L\xdb?\xd9\x0eYL\xd7\x17\xe0\xa3s\x19\x807\x1d\x16\xc0H{$\x16#\x91\t\xff\x88\xc8\xc2\xf9\x1e\x1dR\xc8mȸ\xc7J\x1d\xef(ľ\x80\xc3°\x7f\fjH\xe86\x99\xbaM\xa6n\x06Si\xd5Y\x96_\xcei|\xb0\xa3V\xef\"\x19\xb7\x1ePR\xe06\x90|<8\xdd\x003\r+\xd6\xef\xa23\xb4\xba9\x03\xe8\t\xd3\xc2o\xfe·{\xff\u03a2\xab\xb9\x80\xc68\xc6\f\x80\xab\xd0c\x01\xc9to*\xacU\x16K\x1a+3\xba\x1b\x8c\x16\xf0\xf7?\x19\xc0\xde8['\\\x87\xc5У\xff\xf1\xfa\xfd\xe77\xb7U\x8b]\xaa\x9c\x8ak\xe4\x8al\x9f\xf4֒\a\xcb``\f\x14$\x80\xa9*d\x86*\x12\xa1\x97\xd1'X\xdf\x04꒻\xd10\x80)C\x14\x90\x16\xe1s\xaaɘz>*\xf4\x14z$\xb1\x13X\xfa\xcd\xf8\xf9([\xc4\xf8R\x93\x18t\xa0VF\"'\x1fJ\x11\x1b<\xd6\xc0)A\b\rHk\x19\b\x13\xb8^\x8e\xa3\xd3\xffЀ\xf1\x10\xca߱\x92|̞\x81\xdb\x10]\xad4\xde#\t\x10Va\xe7\xed_\x8f\x96YaP\x97\xce\xc8D\xa0\xe9\xcfzA\xf2\xc6)\xfc\x11\xff\x0f\xc6\xd7Й\a T\x1f\x10\xfd\xccZR\xe1\x1c~\r\x84\t\xc0\x02Z\x91\x9e\x8b\xedvge\xea\xc8*t]\xf4V\x1e\xb6\xa9\xafl\x19%\x10okܣ۲\xddm\fU\xad\x15\xac$\x12nMo7)p\xaf\xc9r\xde\xd5\xff{$\xc9\xcbY\xa4\xf2\xa0|b!\xebw\x8f\xe2\xd4#gq\xd7\xfe\x18\xd80l\x1bR<\xc0k\xfd.\x15\xe2\xe6\xed\xed'\x98\x9c\xa6\x12\xccL\u0088\xf6a\x1b\x1f\x80W\xa0\xaco\x90\xd2.h(t\xc9\"\xfa\xba\x0f\xd6\x0f\\\xaa\x9cE\x7f\f:ǲ\xb3\xc2\x13K\xb5>9\\\xa5\xb9\x04%B\xeck#X\xe7\xf0\xdeÕ\xe9\xd0]\x19\xc6\xff\x1cvE\x987\n\xe9e\xe0\xe7\xe3t\xfa\x1b\x14\a\xb4\x1e\xc5Ӭ[\xad\xd0J\xf7\xde\xf6Xi\xcd\x148\xddk\x1b[\xa56\x80&\x10\x98\xb5-\xf9\xc5\x18\x92\xf6\xb3\xa2\x18g\xc4\x10\xc7br\x84\xe6r\x1ck\xa3B\xbf\xbe5\x8cǢE4ת\xb1\xf4\xecl\x83\xd5C\xe5p00L\n\xbc\x14\x84~\xe8c\xb7\xf4\xb7\x81\x8fx\x7f\"\xbb\xa6\xa0s2Mj\x80\v\xf5\x1f\x0f\x97\x9d\x9d\x8e\xd0s\xd9\f:鸚\x8f\xdc٨\x1d\xcd\x00E\xef\xb5#\x83W\xf1\xc2(\x1cO\xe4Ū\x15\xecN\xe2X\x8d\xe4\xbdo\x82\xceI1\xea\xd2\xc8\xd0'8\x16u\xf41Dtb\xee\\M\x87\xaf2\xbd)\xad\xb3\xe7\xd6\x17\xe1\\\xcd\xd4\x13:C\xc1CZ6\x0e\x1a4:\x1ey\x06ѪQй\x14H\xb0\x06i\x8d\x80\x15\xe0\xd8\xf7\x81\x84\x97T\xf8&V\x17j=}z\xcd1\xa5\xc3\x02\x84\"f\xe7m\x18\"\U000f0cbe\x9c\xd8O\xf6\xed\f\xcb[\xa2@O\x00\xf7ä;\xf5\x11\xa1\xe1\xe0gX\xbed\xe8\a\xbeý\xe1d\\O\x001\x8a\xe4\xaa\x03\x80@\xd0\x18\xeb\xb0ο'~\xbd\xd9}W\xe2cXO!\xd5ͨ:\xa5\xedcW\")\xb7\xc5vG\\Z\xe4\x7f)u\xd3\b\x92r\xab\"\xc3-\xd6\a,\xc0@\x8b\xc6I\vU\x8b\xd5ݷ\xa0ћ\xc6\xee\xa4w\xd7\a\xf4\x99\x04\x8f\xe7\xf2\xe884\xab\x89\xad\x87\xb26\x0f\xa7\t\xf8s\xb2\xb7\xc6Z]\x1d\xc1]/\x92*\xbcK\xe4x~\x89\xf5\x12`\tW\xdab\x93\xdaeE\xacd:\x11\xaf\x9e}Oh\xdbs\r;\xe2\x88\xf5ᙓ}\xa36\xd7'\xeaZ\xa7\xfb\x16\xfd\xb9\xb3J\xa9\xb7\xb08\xf3\n\xe5ù\x8dW\x8f\xef\xb5e\x91\x87;}\x01z\x7f\xda(\xe9\x9f\x0f\xc4J\x95\x86Cg\xe5\x9e\x7fJй\xe6\xc4ӣ\xa3k\xba\xf6\xe7Os\xbeRԅh\xb4W\xc0\xfe\xf5\xe1W\x9a\x16\x9b\xf19\x9a\x16\xc6,\xeaY\xe6,\x81\xccn\xc2\xe2Є\xfa`\xea\x05\xebٻPyX\xc0\x8b\x17G\xaf\xca\xf4\xb3\n\xbeNOl.\xe0\xcbW}\xe5I \xacG\b\xb8\x80/_\xb3\x7f\a\x00\xff\\\xdd0\xca\x0f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xcfo\xdd6\f\xbe\xfb\xaf \xbaC.\xb5_\x83^\x06ߊt\x03\x8auEд\xb9\x14=\xe8I\xb4\xcdE\x96<\x91r\x96\xfd\xf5\x83d\xf9\xfd\xea{i\x87ay\xb9\x98\xa6>\x92\x1f\xc9Ϫ꺮\xd4D\xf7\x18\x98\xbckAM\x84\x7f\t\xba\xf4\xc4\xcd\xc3\xcfܐ\xdf\xcc\xd7[\x14u]=\x903-\xdcD\x16?~D\xf61h|\x8b\x1d9\x12\xf2\xae\x1aQ\x94Q\xa2\xda\n@9\xe7E%3\xa7G\x00\xed\x9d\x04o-\x86\xbaG\xd7<\xc4-n#Y\x83!GX\xe3ϯ\x9a\xd7ͫ\n@\a\xcc\xc7?ш,j\x9cZp\xd1\xda\n\xc0\xa9\x11[\x98\xbd\x8d#\xb2S\x13\x0f^\xac\xd7ٛ\x9b\x19-\x06ߐ\xafxB\x9db\xf7\xc1ǩ\x85\xfd\x8b\x05\xa2\xe4\xb5\xd4t\x9f\xd1\xee\n\xda\xfb\x82\x96\x1d,\xb1\xfc\xf6\x8c\xd3{bɎ\x93\x8dAً\x99e\x1f&\xd7G\xab\xc2%\xaf\n`\n\xc8\x18f\xfc\xec\x1e\x9c\x7ft\xbf\x12Z\xc3-t\xca2V\x00\xac\xfd\x84-|P#\xf2\xa44\x9a\n`V\x96LNf\xa9\xc9O\xe8\xdeܾ\xbb\x7f}\xa7\a\x1cs?\x92\xd9 \xeb@S\xf6\xbbP\f\x10\x83\x825\x1bx\x1c0 \xdcg\xe6\x80\xc5\a\xe4\x92x\x81\x04X+ঘ\xa6\xe0'\fB+\xc1\xe9w0a;\xdbI>W)\xe1\xc5\aL\x9a)d\x90\x01a^lh\x80s1\xe0;\x90\x81\x18\x02f\xa6\x9c\xec[\xb5\xfe|\aʁ\xdf\xfe\x81Z\x1a\xb8Kl\x06\x06\x1e|\xb4&\r\xe2\x8cA \xa0\xf6\xbd\xa3\xbfw\xc8\f\xe2sH\xab\x04Y\x8e\x10\xc9\t\x06\xa7l\xa2:\xe2KP\xce\xc0\xa8\x9e `\x8a\x01\xd1\x1d\xa0e\x17n\xe0w\x1f\x10\xc8u\xbe\x85Ad\xe2v\xb3\xe9I֝\xd2~\x1c\xa3#y\xda\xe4͠m\x14\x1fxcpF\xbba\xeak\x15\xf4@\x82Zb\xc0\x8d\x9a\xa8Ή\xbbT,7\xa3\xf9)\x94\x05䫃L\xe5)\r\aK \xd7\xef\xccy\xc4/\xf2\x9ef{i\xfbrl)qO/\xb9>\xb3\xf2\xf1\x97\xbbO\xb0\x06\xcd-8\x80\x84\xc2\xf6\xfe\x18\xef\x89OD\x91\xeb0\xe4S\xd0\x05?fDtf\xf2\xe4$?hK\xe8\x8eI\xe7\xb8\x1dIR\xa7\xff\x8cȒ\xfa\xd3\xc0MV\x16\xd8\"\xc4\xc9(A\xd3\xc0;\a7jD{\xa3\x18\xffw\xda\x13\xc3\\'J\xbfO\xfc\xa1 \xae\x7f\xe9|[\xd8ڙW\xa9:ۡ\xf3\x9bz7\xa1>Z\x94\x84A\x1d\x95\xcd\xed|\x00u\x80\b\xeb\x16\x9fG[\x97\xf7\xd2\x02\x17\x05\xef\xa8?\xb6\x01(c\xb2\xfa+{{\xe1\xdcEz\xce\xd4z\xe3]G}\x1a\xc7T\xc0\x14\xfcL\x06C\xbd\xd6Vr\x88\xa1\x14\x99\xb5\xb1\xa9\xce\xc5:a\xb8\btO\xf9\x83⣴ϥq{虲\x19\xfc#X\xefz@\xa5\a\xd0\xcaڝX\x14\x06\xaf\xbeQ\xc6S\x85\x14\f%\x85,\x1d\xa2\x1e\x10\xb6\xd8e\x95\x90+\x06\xad\x9cF\x9b\x06\xfa-v*Z\xd9\t\xd2Һo\x81\xb3\xb0]\xf1IO\x0f\xe2\xc8R\xc1Kx\x1cH\x0f\xa9\x10\xe7\x1dBt\x16\x99wd\xa29\xa50}i\xd5\xd6b\v\x12\"V?\xd8˵]\xcfS[\x9cR2\xa9\xb6\xf5Т\xebXH\xcc\x1f\x1b\xd5c\xf3c\xb1\x93BP\xc0#\x95\xabw\xd0\xd5wf\x83EI<\x1aڣ\x8c\xcfo\xcc]>Tjۖ\x1d\xd41\x04tR\x10\xc1w\a\x98\x00\xea\xbf\xef\xe04(\xc6g\xf9=\x8f}\x9bέ\x94[\xeaP?i\x8b\v\\b\xfeX*\xfe\x95\\\xa4\x7ftq<ͪ\x867\xb3\xa2<F\u07fc\xf9\xecԅw\x17\x1a|\xa6o'\xa6rOha\xbe\xde?\xe5\xa6\xd6\xeb\x8d\xf1\xba\xdam\x8d9\x98\xec2jŲ\x1f\x06\xa55N\x82\xe6\xc3\xe9e\xf1ŋ\xa3\xfb^~\xd4\xde-:\xc8-|\xf9\x9a\xeei\xe9\xb6dʍ\x86[\xf8\xf2\xb5\xfag\x00}\xa2\x11\\m\v\x00\x00"),
 }
 
 var CRDs = crds()
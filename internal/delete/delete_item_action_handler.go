@@ -27,6 +27,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -41,6 +42,8 @@ type Context struct {
 	Filesystem      filesystem.Interface
 	Log             logrus.FieldLogger
 	DiscoveryHelper discovery.Helper
+	// Cluster is the cluster the backup was taken from.
+	Cluster client.ClusterContextProvider
 
 	resolvedActions []resolvedAction
 }
@@ -118,9 +121,14 @@ func InvokeDeleteActions(ctx *Context) error {
 					if !action.selector.Matches(labels.Set(obj.GetLabels())) {
 						continue
 					}
+					cluster, err := ctx.Cluster.ClusterContext()
+					if err != nil {
+						return errors.Wrap(err, "error getting the cluster the backup was taken from")
+					}
 					err = action.Execute(&velero.DeleteItemActionExecuteInput{
-						Item:   obj,
-						Backup: ctx.Backup,
+						Item:    obj,
+						Backup:  ctx.Backup,
+						Cluster: cluster,
 					})
 					// Since we want to keep looping even on errors, log them instead of just returning.
 					if err != nil {
@@ -32,6 +32,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -161,6 +162,7 @@ func TestInvokeDeleteItemActionsRunForCorrectItems(t *testing.T) {
 				DiscoveryHelper: h.discoveryHelper,
 				Actions:         actions,
 				Log:             log,
+				Cluster:         client.LocalClusterContextProvider{},
 			}
 
 			err := InvokeDeleteActions(c)
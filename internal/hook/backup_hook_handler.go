@@ -0,0 +1,201 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// BackupHookHandler executes the backup-wide hooks of a backup.
+type BackupHookHandler struct {
+	PodCommandExecutor podexec.PodCommandExecutor
+	DynamicFactory     client.DynamicFactory
+
+	// Report, if set, records each execution of the hooks, in addition to their statuses.
+	Report *ExecutionReport
+}
+
+// HandleHooks executes the backup's backup-wide hooks for the given phase, in order, and
+// returns the result of each execution. Pre hooks stop at the first failed hook whose
+// OnError is Fail, post hooks are all executed. The returned error is the first failure of
+// a hook whose OnError is Fail.
+func (h *BackupHookHandler) HandleHooks(log logrus.FieldLogger, backup *velerov1api.Backup, phase velerov1api.BackupHookPhase) ([]velerov1api.BackupHookStatus, error) {
+	hooks := backup.Spec.Hooks.BackupPre
+	if phase == velerov1api.BackupHookPhasePost {
+		hooks = backup.Spec.Hooks.BackupPost
+	}
+
+	var (
+		statuses []velerov1api.BackupHookStatus
+		hookErr  error
+	)
+	for _, hook := range hooks {
+		hookLog := log.WithFields(logrus.Fields{
+			"hookSource": "backupSpec",
+			"hookName":   hook.Name,
+			"hookPhase":  phase,
+		})
+
+		var (
+			results []velerov1api.BackupHookStatus
+			onError velerov1api.HookErrorMode
+		)
+		switch {
+		case hook.Exec != nil:
+			hookLog = hookLog.WithField("hookType", "exec")
+			onError = hook.Exec.OnError
+			results = h.executeExecHook(hookLog, hook.Name, phase, hook.Exec)
+		case hook.Job != nil:
+			hookLog = hookLog.WithField("hookType", "job")
+			onError = hook.Job.OnError
+			results = []velerov1api.BackupHookStatus{h.executeJobHook(hookLog, backup, hook.Name, phase, hook.Job)}
+		default:
+			results = []velerov1api.BackupHookStatus{h.failed(hook.Name, phase, "", "hook must specify exactly one of exec or job")}
+			onError = velerov1api.HookErrorModeFail
+		}
+		statuses = append(statuses, results...)
+		if onError == "" {
+			onError = velerov1api.HookErrorModeFail
+		}
+
+		for _, result := range results {
+			if result.Result != velerov1api.HookResultFailed {
+				continue
+			}
+			hookLog.WithField("target", result.Target).Error(result.Message)
+			if onError == velerov1api.HookErrorModeFail && hookErr == nil {
+				hookErr = errors.Errorf("backup %s hook %s failed: %s", phase, hook.Name, result.Message)
+			}
+		}
+
+		if hookErr != nil && phase == velerov1api.BackupHookPhasePre {
+			break
+		}
+	}
+
+	return statuses, hookErr
+}
+
+func (h *BackupHookHandler) executeExecHook(log logrus.FieldLogger, name string, phase velerov1api.BackupHookPhase, hook *velerov1api.BackupWideExecHook) []velerov1api.BackupHookStatus {
+	selector, err := labelSelectorString(hook.LabelSelector)
+	if err != nil {
+		return []velerov1api.BackupHookStatus{h.failed(name, phase, "exec", err.Error())}
+	}
+	podClient, err := h.DynamicFactory.ClientForGroupVersionResource(
+		schema.GroupVersion{Version: "v1"},
+		metav1.APIResource{Name: "pods", Namespaced: true, Kind: "Pod"},
+		hook.Namespace,
+	)
+	if err != nil {
+		return []velerov1api.BackupHookStatus{h.failed(name, phase, "exec", errors.Wrap(err, "error getting client for pods").Error())}
+	}
+	list, err := podClient.List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return []velerov1api.BackupHookStatus{h.failed(name, phase, "exec", errors.Wrapf(err, "error listing pods in namespace %s", hook.Namespace).Error())}
+	}
+
+	var statuses []velerov1api.BackupHookStatus
+	for i := range list.Items {
+		item := &list.Items[i]
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+			return []velerov1api.BackupHookStatus{h.failed(name, phase, "exec", errors.Wrap(err, "error converting pod").Error())}
+		}
+		if pod.Status.Phase != corev1api.PodRunning {
+			log.Debugf("Skipping pod %s/%s in phase %s", pod.Namespace, pod.Name, pod.Status.Phase)
+			continue
+		}
+
+		execHook := hook.ExecHook
+		execution, start, err := runPodCommand(h.PodCommandExecutor, log.WithField("target", fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)), item.UnstructuredContent(), pod.Namespace, pod.Name, name, hookPhase(phase), &execHook)
+		statuses = append(statuses, h.record(execution, start, err))
+	}
+
+	if len(statuses) == 0 {
+		return []velerov1api.BackupHookStatus{h.failed(name, phase, "exec", fmt.Sprintf("no running pods in namespace %s match the hook's label selector", hook.Namespace))}
+	}
+	return statuses
+}
+
+func (h *BackupHookHandler) executeJobHook(log logrus.FieldLogger, backup *velerov1api.Backup, name string, phase velerov1api.BackupHookPhase, hook *velerov1api.JobHook) velerov1api.BackupHookStatus {
+	start := time.Now()
+	target, err := executeJobHook(log, h.DynamicFactory, "", name, map[string]string{velerov1api.BackupNameLabel: backup.Name}, hook)
+
+	return h.record(HookExecution{
+		Pod:      target,
+		Phase:    string(phase),
+		HookName: name,
+		Type:     "job",
+		Command:  target,
+	}, start, err)
+}
+
+// record records the execution of a backup-wide hook, started at start, that returned err,
+// and returns its status.
+func (h *BackupHookHandler) record(execution HookExecution, start time.Time, err error) velerov1api.BackupHookStatus {
+	execution.BackupWide = true
+	h.Report.record(execution, start, err)
+
+	started := metav1.NewTime(start)
+	completed := metav1.Now()
+	status := velerov1api.BackupHookStatus{
+		Name:                execution.HookName,
+		Phase:               velerov1api.BackupHookPhase(execution.Phase),
+		Target:              execution.Pod,
+		Result:              velerov1api.HookResultSucceeded,
+		StartTimestamp:      &started,
+		CompletionTimestamp: &completed,
+	}
+	if err != nil {
+		status.Result = velerov1api.HookResultFailed
+		status.Message = err.Error()
+	}
+	return status
+}
+
+// failed records a backup-wide hook of the given type that failed before it had a target,
+// and returns its status.
+func (h *BackupHookHandler) failed(name string, phase velerov1api.BackupHookPhase, hookType, message string) velerov1api.BackupHookStatus {
+	return h.record(HookExecution{
+		Phase:    string(phase),
+		HookName: name,
+		Type:     hookType,
+	}, time.Now(), errors.New(message))
+}
+
+func labelSelectorString(selector *metav1.LabelSelector) (string, error) {
+	if selector == nil {
+		return "", nil
+	}
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing label selector")
+	}
+	return parsed.String(), nil
+}
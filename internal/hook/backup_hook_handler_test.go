@@ -0,0 +1,262 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func toUnstructuredOrDie(t *testing.T, obj runtime.Object) unstructured.Unstructured {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return unstructured.Unstructured{Object: res}
+}
+
+func TestBackupHookHandlerExecHooks(t *testing.T) {
+	running := builder.ForPod("ns-1", "running").Phase(corev1api.PodRunning).Result()
+	pending := builder.ForPod("ns-1", "pending").Phase(corev1api.PodPending).Result()
+
+	tests := []struct {
+		name            string
+		pods            []*corev1api.Pod
+		onError         velerov1api.HookErrorMode
+		execErr         error
+		expectedResults []velerov1api.HookResult
+		expectedErr     bool
+	}{
+		{
+			name:            "hook is executed in running pods only",
+			pods:            []*corev1api.Pod{running, pending},
+			expectedResults: []velerov1api.HookResult{velerov1api.HookResultSucceeded},
+		},
+		{
+			name:            "failed hook with onError continue doesn't return an error",
+			pods:            []*corev1api.Pod{running},
+			onError:         velerov1api.HookErrorModeContinue,
+			execErr:         errors.New("exec failed"),
+			expectedResults: []velerov1api.HookResult{velerov1api.HookResultFailed},
+		},
+		{
+			name:            "failed hook with onError fail returns an error",
+			pods:            []*corev1api.Pod{running},
+			onError:         velerov1api.HookErrorModeFail,
+			execErr:         errors.New("exec failed"),
+			expectedResults: []velerov1api.HookResult{velerov1api.HookResultFailed},
+			expectedErr:     true,
+		},
+		{
+			name:            "hook without running pods fails",
+			pods:            []*corev1api.Pod{pending},
+			onError:         velerov1api.HookErrorModeFail,
+			expectedResults: []velerov1api.HookResult{velerov1api.HookResultFailed},
+			expectedErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			execHook := velerov1api.ExecHook{Command: []string{"sync"}, OnError: test.onError}
+			backup := builder.ForBackup("velero", "backup-1").Hooks(velerov1api.BackupHooks{
+				BackupPre: []velerov1api.BackupWideHook{
+					{
+						Name: "freeze",
+						Exec: &velerov1api.BackupWideExecHook{
+							Namespace:     "ns-1",
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+							ExecHook:      execHook,
+						},
+					},
+					{
+						Name: "never-executed-on-fatal-error",
+						Exec: &velerov1api.BackupWideExecHook{Namespace: "ns-1", ExecHook: execHook},
+					},
+				},
+			}).Result()
+
+			list := &unstructured.UnstructuredList{}
+			for _, pod := range test.pods {
+				list.Items = append(list.Items, toUnstructuredOrDie(t, pod))
+			}
+
+			podClient := &velerotest.FakeDynamicClient{}
+			podClient.On("List", metav1.ListOptions{LabelSelector: "app=db"}).Return(list, nil)
+			podClient.On("List", metav1.ListOptions{}).Return(list, nil)
+			dynamicFactory := &velerotest.FakeDynamicFactory{}
+			dynamicFactory.On("ClientForGroupVersionResource", schema.GroupVersion{Version: "v1"}, mock.Anything, "ns-1").Return(podClient, nil)
+
+			podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+			defer podCommandExecutor.AssertExpectations(t)
+			if test.pods[0] == running {
+				podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "running", "freeze", &execHook).Return(test.execErr)
+			}
+			podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "running", "never-executed-on-fatal-error", &execHook).Return(test.execErr).Maybe()
+
+			report := new(ExecutionReport)
+			h := &BackupHookHandler{
+				PodCommandExecutor: podCommandExecutor,
+				DynamicFactory:     dynamicFactory,
+				Report:             report,
+			}
+			statuses, err := h.HandleHooks(logrus.StandardLogger(), backup, velerov1api.BackupHookPhasePre)
+
+			if test.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var results []velerov1api.HookResult
+			for _, status := range statuses {
+				assert.Equal(t, velerov1api.BackupHookPhasePre, status.Phase)
+				if status.Name != "freeze" {
+					continue
+				}
+				results = append(results, status.Result)
+				if status.Result == velerov1api.HookResultSucceeded {
+					assert.Equal(t, "ns-1/running", status.Target)
+				}
+			}
+			assert.Equal(t, test.expectedResults, results)
+
+			if test.expectedErr {
+				assert.Len(t, statuses, len(test.expectedResults))
+			}
+
+			executions := report.Executions()
+			require.Len(t, executions, len(statuses))
+			for i, execution := range executions {
+				assert.True(t, execution.BackupWide)
+				assert.Equal(t, "pre", execution.Phase)
+				assert.Equal(t, "exec", execution.Type)
+				assert.Equal(t, statuses[i].Name, execution.HookName)
+				assert.Equal(t, statuses[i].Target, execution.Pod)
+				assert.Equal(t, statuses[i].Message, execution.Error)
+			}
+		})
+	}
+}
+
+func TestBackupHookHandlerJobHooks(t *testing.T) {
+	defer func(interval time.Duration) { jobHookPollInterval = interval }(jobHookPollInterval)
+	jobHookPollInterval = time.Millisecond
+
+	tests := []struct {
+		name            string
+		condition       batchv1api.JobConditionType
+		expectedResult  velerov1api.HookResult
+		expectedErr     bool
+		expectJobDelete bool
+	}{
+		{
+			name:            "completed job is deleted",
+			condition:       batchv1api.JobComplete,
+			expectedResult:  velerov1api.HookResultSucceeded,
+			expectJobDelete: true,
+		},
+		{
+			name:           "failed job is kept and fails the hook",
+			condition:      batchv1api.JobFailed,
+			expectedResult: velerov1api.HookResultFailed,
+			expectedErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").Hooks(velerov1api.BackupHooks{
+				BackupPost: []velerov1api.BackupWideHook{
+					{
+						Name: "flush",
+						Job: &velerov1api.JobHook{
+							Namespace: "ns-1",
+							Template:  runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"flush","image":"busybox"}]}}}}`)},
+							OnError:   velerov1api.HookErrorModeFail,
+						},
+					},
+				},
+			}).Result()
+
+			created := &batchv1api.Job{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "velero-hook-abcde"},
+			}
+			finished := created.DeepCopy()
+			finished.Status.Conditions = []batchv1api.JobCondition{{Type: test.condition, Status: corev1api.ConditionTrue}}
+			createdObj := toUnstructuredOrDie(t, created)
+			finishedObj := toUnstructuredOrDie(t, finished)
+
+			jobClient := &velerotest.FakeDynamicClient{}
+			defer jobClient.AssertExpectations(t)
+			jobClient.On("Create", mock.MatchedBy(func(obj *unstructured.Unstructured) bool {
+				return obj.GetGenerateName() == "velero-hook-" &&
+					obj.GetNamespace() == "ns-1" &&
+					obj.GetLabels()[velerov1api.BackupNameLabel] == "backup-1" &&
+					obj.GetLabels()["velero.io/hook-name"] == "flush"
+			})).Return(&createdObj, nil)
+			jobClient.On("Get", "velero-hook-abcde", metav1.GetOptions{}).Return(&createdObj, nil).Once()
+			jobClient.On("Get", "velero-hook-abcde", metav1.GetOptions{}).Return(&finishedObj, nil)
+			if test.expectJobDelete {
+				jobClient.On("Delete", "velero-hook-abcde", mock.Anything).Return(nil, nil)
+			}
+			dynamicFactory := &velerotest.FakeDynamicFactory{}
+			dynamicFactory.On("ClientForGroupVersionResource", batchv1api.SchemeGroupVersion, mock.Anything, "ns-1").Return(jobClient, nil)
+
+			report := new(ExecutionReport)
+			h := &BackupHookHandler{DynamicFactory: dynamicFactory, Report: report}
+			statuses, err := h.HandleHooks(logrus.StandardLogger(), backup, velerov1api.BackupHookPhasePost)
+
+			if test.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.Len(t, statuses, 1)
+			assert.Equal(t, "flush", statuses[0].Name)
+			assert.Equal(t, velerov1api.BackupHookPhasePost, statuses[0].Phase)
+			assert.Equal(t, "ns-1/velero-hook-abcde", statuses[0].Target)
+			assert.Equal(t, test.expectedResult, statuses[0].Result)
+
+			executions := report.Executions()
+			require.Len(t, executions, 1)
+			assert.True(t, executions[0].BackupWide)
+			assert.Equal(t, "post", executions[0].Phase)
+			assert.Equal(t, "job", executions[0].Type)
+			assert.Equal(t, "flush", executions[0].HookName)
+			assert.Equal(t, "ns-1/velero-hook-abcde", executions[0].Pod)
+			assert.Equal(t, statuses[0].Message, executions[0].Error)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// defaultHookRetryBackoff is how long to wait before the first retry of a failed exec hook
+// that doesn't specify a retry backoff.
+const defaultHookRetryBackoff = time.Second
+
+// execWithRetries executes an exec hook's command in a pod, retrying it up to hook.Retries
+// times if it fails, and returns the output of the last attempt, if executor returns output,
+// and the number of attempts.
+func execWithRetries(
+	executor podexec.PodCommandExecutor,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName string,
+	hook *velerov1api.ExecHook,
+) (*podexec.PodCommandOutput, int, error) {
+	backoff := hook.RetryBackoff.Duration
+	if backoff <= 0 {
+		backoff = defaultHookRetryBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		var (
+			output *podexec.PodCommandOutput
+			err    error
+		)
+		if outputExecutor, ok := executor.(podexec.PodCommandOutputExecutor); ok {
+			output, err = outputExecutor.ExecutePodCommandWithOutput(log, item, namespace, name, hookName, hook)
+		} else {
+			err = executor.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+		}
+		if err == nil || attempt > hook.Retries {
+			return output, attempt, err
+		}
+
+		log.WithError(err).Warnf("Hook failed, retrying in %s (attempt %d of %d)", backoff, attempt, hook.Retries+1)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExecWithRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		retries          int
+		errs             []error
+		expectedAttempts int
+		expectedErr      string
+	}{
+		{
+			name:             "successful command isn't retried",
+			retries:          2,
+			errs:             []error{nil},
+			expectedAttempts: 1,
+		},
+		{
+			name:             "failed command is retried until it succeeds",
+			retries:          2,
+			errs:             []error{errors.New("busy"), nil},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "failed command is retried until retries are exhausted",
+			retries:          2,
+			errs:             []error{errors.New("busy"), errors.New("busy"), errors.New("still busy")},
+			expectedAttempts: 3,
+			expectedErr:      "still busy",
+		},
+		{
+			name:             "failed command without retries isn't retried",
+			errs:             []error{errors.New("busy")},
+			expectedAttempts: 1,
+			expectedErr:      "busy",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			hook := &velerov1api.ExecHook{
+				Command:      []string{"/usr/bin/flush"},
+				Retries:      test.retries,
+				RetryBackoff: metav1.Duration{Duration: time.Millisecond},
+			}
+
+			executor := &velerotest.MockPodCommandExecutor{}
+			defer executor.AssertExpectations(t)
+			for _, err := range test.errs {
+				executor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "pod-1", "my-hook", hook).Return(err).Once()
+			}
+
+			_, attempts, err := execWithRetries(executor, velerotest.NewLogger(), nil, "ns-1", "pod-1", "my-hook", hook)
+			assert.Equal(t, test.expectedAttempts, attempts)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// maxHookOutputLength is the maximum number of bytes of a command's stdout and stderr
+// recorded in an execution report.
+const maxHookOutputLength = 4096
+
+// HookExecution is the record of a hook executed during a backup or restore.
+type HookExecution struct {
+	// Pod is the pod the hook was executed for, as <namespace>/<name>. For a backup-wide
+	// hook, it's the hook's target: the pod its command was executed in, or its Job. It's
+	// empty if a backup-wide hook failed before it had a target.
+	Pod string `json:"pod"`
+
+	// Container is the container an exec hook's command was executed in.
+	Container string `json:"container,omitempty"`
+
+	// Phase is the phase the hook was executed in, pre or post.
+	Phase string `json:"phase"`
+
+	// BackupWide is true for the backup-wide hooks executed once around a whole backup,
+	// rather than for each pod.
+	BackupWide bool `json:"backupWide,omitempty"`
+
+	// HookName is the name of the hook, or <from-annotation> if it's specified by the
+	// pod's annotations.
+	HookName string `json:"hookName"`
+
+	// Type is the type of the hook: exec, http or job.
+	Type string `json:"type"`
+
+	// Command is what the hook executed: the command of an exec hook, the request of an
+	// HTTP hook, or the Job of a job hook.
+	Command string `json:"command,omitempty"`
+
+	// ExitCode is the exit code of an exec hook's command, if it's known.
+	ExitCode *int `json:"exitCode,omitempty"`
+
+	// Attempts is the number of times an exec hook's command was executed, including retries.
+	Attempts int `json:"attempts,omitempty"`
+
+	// Stdout is the standard output of an exec hook's command's last attempt, truncated.
+	Stdout string `json:"stdout,omitempty"`
+
+	// Stderr is the standard error of an exec hook's command's last attempt, truncated.
+	Stderr string `json:"stderr,omitempty"`
+
+	// Error is why the hook failed. It's empty if the hook succeeded.
+	Error string `json:"error,omitempty"`
+
+	// StartTimestamp is when the hook's execution started.
+	StartTimestamp metav1.Time `json:"startTimestamp"`
+
+	// Duration is how long the hook's execution took.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ExecutionReport records the hooks executed during a backup or restore. It's safe for
+// concurrent use. A nil ExecutionReport records nothing.
+type ExecutionReport struct {
+	lock       sync.Mutex
+	executions []HookExecution
+}
+
+// NewExecutionReport returns an empty ExecutionReport.
+func NewExecutionReport() *ExecutionReport {
+	return &ExecutionReport{}
+}
+
+// Add records a hook execution.
+func (r *ExecutionReport) Add(execution HookExecution) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.executions = append(r.executions, execution)
+}
+
+// Executions returns the recorded hook executions, in the order they were recorded.
+func (r *ExecutionReport) Executions() []HookExecution {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]HookExecution(nil), r.executions...)
+}
+
+// Summary returns the number of recorded hook executions, and how many of them failed.
+func (r *ExecutionReport) Summary() (attempted, failed int) {
+	for _, execution := range r.Executions() {
+		attempted++
+		if execution.Error != "" {
+			failed++
+		}
+	}
+	return attempted, failed
+}
+
+// record adds the execution, started at start, of a hook that returned err.
+func (r *ExecutionReport) record(execution HookExecution, start time.Time, err error) {
+	execution.StartTimestamp = metav1.NewTime(start)
+	execution.Duration = metav1.Duration{Duration: time.Since(start)}
+	if err != nil {
+		execution.Error = err.Error()
+	}
+	r.Add(execution)
+}
+
+// executePodCommand executes an exec hook's command in a pod, retrying it as specified by the
+// hook, and records its execution in report. The output of the command's last attempt is only
+// recorded if executor is a PodCommandOutputExecutor.
+func executePodCommand(
+	executor podexec.PodCommandExecutor,
+	report *ExecutionReport,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName string,
+	phase hookPhase,
+	hook *velerov1api.ExecHook,
+) error {
+	execution, start, err := runPodCommand(executor, log, item, namespace, name, hookName, phase, hook)
+	report.record(execution, start, err)
+
+	return err
+}
+
+// runPodCommand executes an exec hook's command in a pod, retrying it as specified by the
+// hook, and returns the record of its execution, when it started, and its error.
+func runPodCommand(
+	executor podexec.PodCommandExecutor,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName string,
+	phase hookPhase,
+	hook *velerov1api.ExecHook,
+) (HookExecution, time.Time, error) {
+	start := time.Now()
+	output, attempts, err := execWithRetries(executor, log, item, namespace, name, hookName, hook)
+
+	execution := HookExecution{
+		Pod:       fmt.Sprintf("%s/%s", namespace, name),
+		Container: hook.Container,
+		Phase:     string(phase),
+		HookName:  hookName,
+		Type:      "exec",
+		Command:   strings.Join(hook.Command, " "),
+		Attempts:  attempts,
+	}
+	if output != nil {
+		execution.ExitCode = output.ExitCode
+		execution.Stdout = truncateHookOutput(output.Stdout)
+		execution.Stderr = truncateHookOutput(output.Stderr)
+	}
+
+	return execution, start, err
+}
+
+// executeHTTPHook sends an HTTP hook's request for a pod and records its execution in report.
+func executeHTTPHook(
+	executor podexec.HTTPHookExecutor,
+	report *ExecutionReport,
+	log logrus.FieldLogger,
+	namespace, name, hookName string,
+	phase hookPhase,
+	hook *velerov1api.HTTPHook,
+) error {
+	start := time.Now()
+	err := executor.ExecuteHTTPHook(log, namespace, hookName, hook)
+
+	report.record(HookExecution{
+		Pod:      fmt.Sprintf("%s/%s", namespace, name),
+		Phase:    string(phase),
+		HookName: hookName,
+		Type:     "http",
+		Command:  httpHookRequest(hook),
+	}, start, err)
+
+	return err
+}
+
+// executePodJobHook runs a job hook for a pod and records its execution in report.
+func executePodJobHook(
+	dynamicFactory client.DynamicFactory,
+	report *ExecutionReport,
+	log logrus.FieldLogger,
+	namespace, name, hookName string,
+	phase hookPhase,
+	hook *velerov1api.JobHook,
+) error {
+	start := time.Now()
+	target, err := executeJobHook(log, dynamicFactory, namespace, hookName, nil, hook)
+	if target != "" {
+		log = log.WithField("target", target)
+	}
+	if err == nil {
+		log.Info("Job hook completed")
+	}
+
+	report.record(HookExecution{
+		Pod:      fmt.Sprintf("%s/%s", namespace, name),
+		Phase:    string(phase),
+		HookName: hookName,
+		Type:     "job",
+		Command:  target,
+	}, start, err)
+
+	return err
+}
+
+// httpHookRequest describes the request of an HTTP hook.
+func httpHookRequest(hook *velerov1api.HTTPHook) string {
+	service := hook.Service
+	if hook.Namespace != "" {
+		service = hook.Namespace + "/" + service
+	}
+	if hook.Port != "" {
+		service = service + ":" + hook.Port
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s://%s%s", strings.ToUpper(hook.Method), hook.Scheme, service, hook.Path))
+}
+
+// truncateHookOutput truncates output to maxHookOutputLength bytes.
+func truncateHookOutput(output string) string {
+	if len(output) <= maxHookOutputLength {
+		return output
+	}
+	return output[:maxHookOutputLength] + "... (truncated)"
+}
@@ -0,0 +1,138 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeOutputExecutor is a PodCommandOutputExecutor that returns a fixed output and error.
+type fakeOutputExecutor struct {
+	velerotest.MockPodCommandExecutor
+	output *podexec.PodCommandOutput
+	err    error
+}
+
+func (e *fakeOutputExecutor) ExecutePodCommandWithOutput(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *velerov1api.ExecHook) (*podexec.PodCommandOutput, error) {
+	return e.output, e.err
+}
+
+func TestExecutePodCommandRecordsExecution(t *testing.T) {
+	exitCode := func(code int) *int { return &code }
+
+	hook := &velerov1api.ExecHook{
+		Container: "c1",
+		Command:   []string{"/bin/sh", "-c", "exit 2"},
+	}
+
+	tests := []struct {
+		name              string
+		executor          podexec.PodCommandExecutor
+		expectedExitCode  *int
+		expectedStdout    string
+		expectedStderr    string
+		expectedError     string
+		expectedAttempted int
+		expectedFailed    int
+	}{
+		{
+			name: "output and exit code of a successful command are recorded",
+			executor: &fakeOutputExecutor{
+				output: &podexec.PodCommandOutput{Stdout: "frozen", ExitCode: exitCode(0)},
+			},
+			expectedExitCode:  exitCode(0),
+			expectedStdout:    "frozen",
+			expectedAttempted: 1,
+		},
+		{
+			name: "output, exit code and error of a failed command are recorded, and output is truncated",
+			executor: &fakeOutputExecutor{
+				output: &podexec.PodCommandOutput{Stderr: strings.Repeat("x", maxHookOutputLength+1), ExitCode: exitCode(2)},
+				err:    errors.New("command terminated with exit code 2"),
+			},
+			expectedExitCode:  exitCode(2),
+			expectedStderr:    strings.Repeat("x", maxHookOutputLength) + "... (truncated)",
+			expectedError:     "command terminated with exit code 2",
+			expectedAttempted: 1,
+			expectedFailed:    1,
+		},
+		{
+			name: "executors that don't return output only record the error",
+			executor: func() podexec.PodCommandExecutor {
+				e := &velerotest.MockPodCommandExecutor{}
+				e.On("ExecutePodCommand", mock.Anything, map[string]interface{}(nil), "ns-1", "pod-1", "my-hook", hook).Return(errors.New("timed out"))
+				return e
+			}(),
+			expectedError:     "timed out",
+			expectedAttempted: 1,
+			expectedFailed:    1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			report := NewExecutionReport()
+
+			err := executePodCommand(test.executor, report, velerotest.NewLogger(), nil, "ns-1", "pod-1", "my-hook", PhasePre, hook)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			executions := report.Executions()
+			require.Len(t, executions, 1)
+			execution := executions[0]
+			assert.Equal(t, "ns-1/pod-1", execution.Pod)
+			assert.Equal(t, "c1", execution.Container)
+			assert.Equal(t, "pre", execution.Phase)
+			assert.Equal(t, "exec", execution.Type)
+			assert.Equal(t, "/bin/sh -c exit 2", execution.Command)
+			assert.Equal(t, test.expectedExitCode, execution.ExitCode)
+			assert.Equal(t, test.expectedStdout, execution.Stdout)
+			assert.Equal(t, test.expectedStderr, execution.Stderr)
+			assert.Equal(t, test.expectedError, execution.Error)
+			assert.False(t, execution.StartTimestamp.IsZero())
+
+			attempted, failed := report.Summary()
+			assert.Equal(t, test.expectedAttempted, attempted)
+			assert.Equal(t, test.expectedFailed, failed)
+		})
+	}
+}
+
+func TestNilExecutionReport(t *testing.T) {
+	var report *ExecutionReport
+	report.Add(HookExecution{Pod: "ns-1/pod-1"})
+
+	assert.Nil(t, report.Executions())
+	attempted, failed := report.Summary()
+	assert.Zero(t, attempted)
+	assert.Zero(t, failed)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// PodBackupHookOrder returns the order of the hooks executed for a pod during a backup. If the
+// pod specifies backup hooks in its annotations, that's the order in its order annotation.
+// Otherwise it's the lowest order of the resource hooks with pre or post hooks that apply to
+// the pod. Pods without hooks have order 0.
+func PodBackupHookOrder(resourceHooks []ResourceHook, pod metav1.Object, log logrus.FieldLogger) int {
+	annotations := pod.GetAnnotations()
+	if len(getPodBackupHooksFromAnnotations(annotations, PhasePre, log)) > 0 ||
+		len(getPodBackupHooksFromAnnotations(annotations, PhasePost, log)) > 0 ||
+		len(getPodBackupHooksFromAnnotations(annotations, "", log)) > 0 {
+		return parseHookOrder(annotations[podBackupHookOrderAnnotationKey], log)
+	}
+
+	order, found := 0, false
+	for _, resourceHook := range resourceHooks {
+		if len(resourceHook.Pre) == 0 && len(resourceHook.Post) == 0 {
+			continue
+		}
+		if !resourceHook.Selector.applicableTo(kuberesource.Pods, pod.GetNamespace(), labels.Set(pod.GetLabels())) {
+			continue
+		}
+		if !found || resourceHook.Order < order {
+			order, found = resourceHook.Order, true
+		}
+	}
+	return order
+}
+
+// PodRestoreHookOrder returns the order of the post hooks executed for a restored pod. If the
+// pod specifies post-restore hooks in its annotations, that's the order in its order annotation.
+// Otherwise it's the lowest order of the resource restore hooks with exec, HTTP or job hooks
+// that apply to the pod. Pods without hooks have order 0.
+func PodRestoreHookOrder(resourceRestoreHooks []ResourceRestoreHook, pod metav1.Object, log logrus.FieldLogger) int {
+	annotations := pod.GetAnnotations()
+	if getPodExecRestoreHookFromAnnotations(annotations, log) != nil || len(getPodRestoreHooksFromAnnotations(annotations, log)) > 0 {
+		return parseHookOrder(annotations[podRestoreHookOrderAnnotationKey], log)
+	}
+
+	order, found := 0, false
+	for _, rrh := range resourceRestoreHooks {
+		if !hasPostRestoreHooks(rrh) {
+			continue
+		}
+		if !rrh.Selector.applicableTo(kuberesource.Pods, pod.GetNamespace(), labels.Set(pod.GetLabels())) {
+			continue
+		}
+		if !found || rrh.Order < order {
+			order, found = rrh.Order, true
+		}
+	}
+	return order
+}
+
+// hasPostRestoreHooks returns whether a resource restore hook has hooks that are executed once
+// a pod is running.
+func hasPostRestoreHooks(rrh ResourceRestoreHook) bool {
+	for _, rh := range rrh.RestoreHooks {
+		if rh.Exec != nil || rh.HTTP != nil || rh.Job != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sortResourceHooksByOrder returns a copy of resourceHooks sorted by increasing order, keeping
+// resource hooks with the same order in the given order.
+func sortResourceHooksByOrder(resourceHooks []ResourceHook) []ResourceHook {
+	sorted := append([]ResourceHook(nil), resourceHooks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+	return sorted
+}
+
+// sortResourceRestoreHooksByOrder returns a copy of resourceRestoreHooks sorted by increasing
+// order, keeping resource restore hooks with the same order in the given order.
+func sortResourceRestoreHooksByOrder(resourceRestoreHooks []ResourceRestoreHook) []ResourceRestoreHook {
+	sorted := append([]ResourceRestoreHook(nil), resourceRestoreHooks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+	return sorted
+}
+
+func parseHookOrder(value string, log logrus.FieldLogger) int {
+	if value == "" {
+		return 0
+	}
+	order, err := strconv.Atoi(value)
+	if err != nil || order < 0 {
+		log.Warnf("Invalid hook order %s, using default", value)
+		return 0
+	}
+	return order
+}
+
+// HookSequencer orders the execution of the post hooks of restored pods: the hooks of a pod are
+// only executed once the hooks of all pods with a lower order are done. Pods are added as they're
+// restored, so pods with an order above 0 also wait until the sequencer is closed, once all
+// pods are restored. A nil HookSequencer doesn't order hooks.
+type HookSequencer struct {
+	lock    sync.Mutex
+	pending map[int]int
+	closed  bool
+	// changed is closed, and replaced, whenever pending or closed change.
+	changed chan struct{}
+}
+
+// NewHookSequencer returns a HookSequencer without any pods.
+func NewHookSequencer() *HookSequencer {
+	return &HookSequencer{
+		pending: map[int]int{},
+		changed: make(chan struct{}),
+	}
+}
+
+// Add adds a pod whose hooks have the given order. Every added pod must be marked as done.
+func (s *HookSequencer) Add(order int) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pending[order]++
+	s.notify()
+}
+
+// Done marks the hooks of a pod added with the given order as done.
+func (s *HookSequencer) Done(order int) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending[order]--; s.pending[order] <= 0 {
+		delete(s.pending, order)
+	}
+	s.notify()
+}
+
+// Close records that all pods have been added.
+func (s *HookSequencer) Close() {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	s.notify()
+}
+
+// Wait blocks until the hooks of a pod with the given order can be executed, or ctx is done.
+func (s *HookSequencer) Wait(ctx context.Context, order int) error {
+	if s == nil {
+		return nil
+	}
+
+	for {
+		ready, changed := s.ready(order)
+		if ready {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "error waiting for hooks with an order lower than %d", order)
+		}
+	}
+}
+
+// ready returns whether the hooks of a pod with the given order can be executed and, if not,
+// a channel that's closed once that may have changed.
+func (s *HookSequencer) ready(order int) (bool, <-chan struct{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if order > 0 && !s.closed {
+		return false, s.changed
+	}
+	for pendingOrder := range s.pending {
+		if pendingOrder < order {
+			return false, s.changed
+		}
+	}
+	return true, nil
+}
+
+// notify wakes up waiting pods. It must be called with the lock held.
+func (s *HookSequencer) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
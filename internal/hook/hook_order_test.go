@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func TestPodRestoreHookOrder(t *testing.T) {
+	execHook := []velerov1api.RestoreResourceHook{{Exec: &velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/thaw"}}}}
+	resourceRestoreHooks := []ResourceRestoreHook{
+		{
+			Name: "primary",
+			Selector: ResourceHookSelector{
+				Namespaces: collections.NewIncludesExcludes().Includes("db"),
+				Resources:  collections.NewIncludesExcludes().Includes(kuberesource.Pods.Resource),
+			},
+			Order:        2,
+			RestoreHooks: execHook,
+		},
+		{
+			Name: "replicas",
+			Selector: ResourceHookSelector{
+				Namespaces: collections.NewIncludesExcludes().Includes("db"),
+				Resources:  collections.NewIncludesExcludes().Includes(kuberesource.Pods.Resource),
+			},
+			Order:        1,
+			RestoreHooks: execHook,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pod      *builder.PodBuilder
+		expected int
+	}{
+		{
+			name:     "lowest order of applicable resource restore hooks is used",
+			pod:      builder.ForPod("db", "pod-1"),
+			expected: 1,
+		},
+		{
+			name:     "pod without applicable hooks has order 0",
+			pod:      builder.ForPod("web", "pod-1"),
+			expected: 0,
+		},
+		{
+			name: "order annotation is used for hooks from annotations",
+			pod: builder.ForPod("db", "pod-1").ObjectMeta(builder.WithAnnotations(
+				podRestoreHookCommandAnnotationKey, "/usr/bin/thaw",
+				podRestoreHookOrderAnnotationKey, "5",
+			)),
+			expected: 5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PodRestoreHookOrder(resourceRestoreHooks, test.pod.Result(), velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestHookSequencer(t *testing.T) {
+	s := NewHookSequencer()
+	s.Add(0)
+	s.Add(1)
+
+	waitErr := func(order int) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		return s.Wait(ctx, order)
+	}
+
+	// Hooks with order 0 don't wait for the sequencer to be closed.
+	require.NoError(t, waitErr(0))
+	// Hooks with a higher order wait until all pods are added...
+	assert.Error(t, waitErr(1))
+	s.Close()
+	// ...and until the hooks with a lower order are done.
+	assert.Error(t, waitErr(1))
+	s.Done(0)
+	require.NoError(t, waitErr(1))
+	assert.Error(t, waitErr(2))
+	s.Done(1)
+	require.NoError(t, waitErr(2))
+}
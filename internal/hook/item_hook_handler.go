@@ -17,14 +17,18 @@ limitations under the License.
 package hook
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	uuid "github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,9 +38,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -55,6 +61,18 @@ const (
 	podBackupHookOnErrorAnnotationKey   = "hook.backup.velero.io/on-error"
 	podBackupHookTimeoutAnnotationKey   = "hook.backup.velero.io/timeout"
 
+	podBackupHookRetriesAnnotationKey      = "hook.backup.velero.io/retries"
+	podBackupHookRetryBackoffAnnotationKey = "hook.backup.velero.io/retry-backoff"
+	podBackupHookOrderAnnotationKey        = "hook.backup.velero.io/order"
+
+	podBackupHookHTTPServiceAnnotationKey        = "hook.backup.velero.io/http-service"
+	podBackupHookHTTPPortAnnotationKey           = "hook.backup.velero.io/http-port"
+	podBackupHookHTTPSchemeAnnotationKey         = "hook.backup.velero.io/http-scheme"
+	podBackupHookHTTPMethodAnnotationKey         = "hook.backup.velero.io/http-method"
+	podBackupHookHTTPPathAnnotationKey           = "hook.backup.velero.io/http-path"
+	podBackupHookHTTPExpectedStatusAnnotationKey = "hook.backup.velero.io/http-expected-status"
+	podBackupHookJobTemplateAnnotationKey        = "hook.backup.velero.io/job-template"
+
 	// Restore hook annotations
 	podRestoreHookContainerAnnotationKey            = "post.hook.restore.velero.io/container"
 	podRestoreHookCommandAnnotationKey              = "post.hook.restore.velero.io/command"
@@ -65,6 +83,19 @@ const (
 	podRestoreHookInitContainerNameAnnotationKey    = "init.hook.restore.velero.io/container-name"
 	podRestoreHookInitContainerCommandAnnotationKey = "init.hook.restore.velero.io/command"
 	podRestoreHookInitContainerTimeoutAnnotationKey = "init.hook.restore.velero.io/timeout"
+
+	podRestoreHookHTTPServiceAnnotationKey        = "post.hook.restore.velero.io/http-service"
+	podRestoreHookHTTPPortAnnotationKey           = "post.hook.restore.velero.io/http-port"
+	podRestoreHookHTTPSchemeAnnotationKey         = "post.hook.restore.velero.io/http-scheme"
+	podRestoreHookHTTPMethodAnnotationKey         = "post.hook.restore.velero.io/http-method"
+	podRestoreHookHTTPPathAnnotationKey           = "post.hook.restore.velero.io/http-path"
+	podRestoreHookHTTPExpectedStatusAnnotationKey = "post.hook.restore.velero.io/http-expected-status"
+	podRestoreHookJobTemplateAnnotationKey        = "post.hook.restore.velero.io/job-template"
+	podRestoreHookHTTPJobTimeoutAnnotationKey     = "post.hook.restore.velero.io/timeout"
+
+	podRestoreHookRetriesAnnotationKey      = "post.hook.restore.velero.io/retries"
+	podRestoreHookRetryBackoffAnnotationKey = "post.hook.restore.velero.io/retry-backoff"
+	podRestoreHookOrderAnnotationKey        = "post.hook.restore.velero.io/order"
 )
 
 // ItemHookHandler invokes hooks for an item.
@@ -74,6 +105,7 @@ type ItemHookHandler interface {
 	// determine if there are any hooks relevant to the item, taking into account the hook spec's
 	// namespaces, resources, and label selector.
 	HandleHooks(
+		ctx context.Context,
 		log logrus.FieldLogger,
 		groupResource schema.GroupResource,
 		obj runtime.Unstructured,
@@ -166,20 +198,30 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+	HTTPHookExecutor   podexec.HTTPHookExecutor
+	DynamicFactory     client.DynamicFactory
+	// Report, if set, records the executed hooks.
+	Report *ExecutionReport
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
+	ctx context.Context,
 	log logrus.FieldLogger,
 	groupResource schema.GroupResource,
 	obj runtime.Unstructured,
 	resourceHooks []ResourceHook,
 	phase hookPhase,
-) error {
+) (err error) {
 	// We only support hooks on pods right now
 	if groupResource != kuberesource.Pods {
 		return nil
 	}
 
+	_, span := tracing.Start(ctx, "ItemHookHandler.HandleHooks", attribute.String("velero.hook.phase", string(phase)))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	metadata, err := meta.Accessor(obj)
 	if err != nil {
 		return errors.Wrap(err, "unable to get a metadata accessor")
@@ -188,24 +230,26 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	namespace := metadata.GetNamespace()
 	name := metadata.GetName()
 
-	// If the pod has the hook specified via annotations, that takes priority.
-	hookFromAnnotations := getPodExecHookFromAnnotations(metadata.GetAnnotations(), phase, log)
-	if phase == PhasePre && hookFromAnnotations == nil {
+	// If the pod has hooks specified via annotations, they take priority.
+	hooksFromAnnotations := getPodBackupHooksFromAnnotations(metadata.GetAnnotations(), phase, log)
+	if phase == PhasePre && len(hooksFromAnnotations) == 0 {
 		// See if the pod has the legacy hook annotation keys (i.e. without a phase specified)
-		hookFromAnnotations = getPodExecHookFromAnnotations(metadata.GetAnnotations(), "", log)
-	}
-	if hookFromAnnotations != nil {
-		hookLog := log.WithFields(
-			logrus.Fields{
-				"hookSource": "annotation",
-				"hookType":   "exec",
-				"hookPhase":  phase,
-			},
-		)
-		if err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
-			hookLog.WithError(err).Error("Error executing hook")
-			if hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
-				return err
+		hooksFromAnnotations = getPodBackupHooksFromAnnotations(metadata.GetAnnotations(), "", log)
+	}
+	if len(hooksFromAnnotations) > 0 {
+		for _, hook := range hooksFromAnnotations {
+			hookLog := log.WithFields(
+				logrus.Fields{
+					"hookSource": "annotation",
+					"hookType":   backupHookType(hook),
+					"hookPhase":  phase,
+				},
+			)
+			if err := h.executeHook(hookLog, obj, namespace, name, "<from-annotation>", phase, hook); err != nil {
+				hookLog.WithError(err).Error("Error executing hook")
+				if backupHookErrorMode(hook) == velerov1api.HookErrorModeFail {
+					return err
+				}
 			}
 		}
 
@@ -213,8 +257,8 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	}
 
 	labels := labels.Set(metadata.GetLabels())
-	// Otherwise, check for hooks defined in the backup spec.
-	for _, resourceHook := range resourceHooks {
+	// Otherwise, check for hooks defined in the backup spec, in order.
+	for _, resourceHook := range sortResourceHooksByOrder(resourceHooks) {
 		if !resourceHook.Selector.applicableTo(groupResource, namespace, labels) {
 			continue
 		}
@@ -227,20 +271,21 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		}
 		for _, hook := range hooks {
 			if groupResource == kuberesource.Pods {
-				if hook.Exec != nil {
-					hookLog := log.WithFields(
-						logrus.Fields{
-							"hookSource": "backupSpec",
-							"hookType":   "exec",
-							"hookPhase":  phase,
-						},
-					)
-					err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
-					if err != nil {
-						hookLog.WithError(err).Error("Error executing hook")
-						if hook.Exec.OnError == velerov1api.HookErrorModeFail {
-							return err
-						}
+				hookType := backupHookType(hook)
+				if hookType == "" {
+					continue
+				}
+				hookLog := log.WithFields(
+					logrus.Fields{
+						"hookSource": "backupSpec",
+						"hookType":   hookType,
+						"hookPhase":  phase,
+					},
+				)
+				if err := h.executeHook(hookLog, obj, namespace, name, resourceHook.Name, phase, hook); err != nil {
+					hookLog.WithError(err).Error("Error executing hook")
+					if backupHookErrorMode(hook) == velerov1api.HookErrorModeFail {
+						return err
 					}
 				}
 			}
@@ -250,6 +295,50 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	return nil
 }
 
+// executeHook executes an exec, HTTP or job hook for a pod and records its execution.
+func (h *DefaultItemHookHandler) executeHook(log logrus.FieldLogger, obj runtime.Unstructured, namespace, name, hookName string, phase hookPhase, hook velerov1api.BackupResourceHook) error {
+	switch {
+	case hook.Exec != nil:
+		return executePodCommand(h.PodCommandExecutor, h.Report, log, obj.UnstructuredContent(), namespace, name, hookName, phase, hook.Exec)
+	case hook.HTTP != nil:
+		return executeHTTPHook(h.HTTPHookExecutor, h.Report, log, namespace, name, hookName, phase, hook.HTTP)
+	case hook.Job != nil:
+		return executePodJobHook(h.DynamicFactory, h.Report, log, namespace, name, hookName, phase, hook.Job)
+	}
+	return nil
+}
+
+// backupHookType returns the type of a BackupResourceHook, or an empty string if it has none.
+func backupHookType(hook velerov1api.BackupResourceHook) string {
+	switch {
+	case hook.Exec != nil:
+		return "exec"
+	case hook.HTTP != nil:
+		return "http"
+	case hook.Job != nil:
+		return "job"
+	}
+	return ""
+}
+
+// backupHookErrorMode returns how an error from a BackupResourceHook should be treated,
+// defaulting to Fail.
+func backupHookErrorMode(hook velerov1api.BackupResourceHook) velerov1api.HookErrorMode {
+	var mode velerov1api.HookErrorMode
+	switch {
+	case hook.Exec != nil:
+		mode = hook.Exec.OnError
+	case hook.HTTP != nil:
+		mode = hook.HTTP.OnError
+	case hook.Job != nil:
+		mode = hook.Job.OnError
+	}
+	if mode != velerov1api.HookErrorModeContinue {
+		return velerov1api.HookErrorModeFail
+	}
+	return mode
+}
+
 func phasedKey(phase hookPhase, key string) string {
 	if phase != "" {
 		return fmt.Sprintf("%v.%v", phase, key)
@@ -288,13 +377,131 @@ func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhas
 	}
 
 	return &velerov1api.ExecHook{
-		Container: container,
-		Command:   parseStringToCommand(commandValue),
-		OnError:   onError,
-		Timeout:   metav1.Duration{Duration: timeout},
+		Container:    container,
+		Command:      parseStringToCommand(commandValue),
+		OnError:      onError,
+		Timeout:      metav1.Duration{Duration: timeout},
+		Retries:      parseHookRetries(getHookAnnotation(annotations, podBackupHookRetriesAnnotationKey, phase), log),
+		RetryBackoff: metav1.Duration{Duration: parseHookTimeout(getHookAnnotation(annotations, podBackupHookRetryBackoffAnnotationKey, phase), log)},
 	}
 }
 
+// getPodBackupHooksFromAnnotations returns the exec, HTTP and job hooks, in that order, specified
+// by the annotations for the given phase.
+func getPodBackupHooksFromAnnotations(annotations map[string]string, phase hookPhase, log logrus.FieldLogger) []velerov1api.BackupResourceHook {
+	var hooks []velerov1api.BackupResourceHook
+	if hook := getPodExecHookFromAnnotations(annotations, phase, log); hook != nil {
+		hooks = append(hooks, velerov1api.BackupResourceHook{Exec: hook})
+	}
+
+	onError := getHookAnnotation(annotations, podBackupHookOnErrorAnnotationKey, phase)
+	timeout := getHookAnnotation(annotations, podBackupHookTimeoutAnnotationKey, phase)
+	if hook := getHTTPHookFromAnnotations(
+		getHookAnnotation(annotations, podBackupHookHTTPServiceAnnotationKey, phase),
+		getHookAnnotation(annotations, podBackupHookHTTPPortAnnotationKey, phase),
+		getHookAnnotation(annotations, podBackupHookHTTPSchemeAnnotationKey, phase),
+		getHookAnnotation(annotations, podBackupHookHTTPMethodAnnotationKey, phase),
+		getHookAnnotation(annotations, podBackupHookHTTPPathAnnotationKey, phase),
+		getHookAnnotation(annotations, podBackupHookHTTPExpectedStatusAnnotationKey, phase),
+		onError,
+		timeout,
+		log,
+	); hook != nil {
+		hooks = append(hooks, velerov1api.BackupResourceHook{HTTP: hook})
+	}
+	if hook := getJobHookFromAnnotations(getHookAnnotation(annotations, podBackupHookJobTemplateAnnotationKey, phase), onError, timeout, log); hook != nil {
+		hooks = append(hooks, velerov1api.BackupResourceHook{Job: hook})
+	}
+
+	return hooks
+}
+
+// getHTTPHookFromAnnotations returns an HTTPHook based on the values of its annotations, as long as
+// the service is specified, as <name> or <namespace>/<name>. If it is absent, this returns nil.
+// Values that can't be parsed are logged and ignored.
+func getHTTPHookFromAnnotations(service, port, scheme, method, path, expectedStatus, onError, timeout string, log logrus.FieldLogger) *velerov1api.HTTPHook {
+	if service == "" {
+		return nil
+	}
+
+	hook := &velerov1api.HTTPHook{
+		Service: service,
+		Port:    port,
+		Method:  method,
+		Path:    path,
+		OnError: parseHookErrorMode(onError),
+		Timeout: metav1.Duration{Duration: parseHookTimeout(timeout, log)},
+	}
+	if parts := strings.SplitN(service, "/", 2); len(parts) == 2 {
+		hook.Namespace, hook.Service = parts[0], parts[1]
+	}
+	switch scheme {
+	case "", "http", "https":
+		hook.Scheme = scheme
+	default:
+		log.Warnf("Invalid HTTP hook scheme %s, using default", scheme)
+	}
+	if expectedStatus != "" {
+		if status, err := strconv.Atoi(expectedStatus); err == nil {
+			hook.ExpectedStatus = status
+		} else {
+			log.Warn(errors.Wrapf(err, "Unable to parse expected status %s, using default", expectedStatus))
+		}
+	}
+
+	return hook
+}
+
+// getJobHookFromAnnotations returns a JobHook based on the values of its annotations, as long as
+// the template, a JSON JobTemplateSpec, is specified and valid. Otherwise, this returns nil.
+func getJobHookFromAnnotations(template, onError, timeout string, log logrus.FieldLogger) *velerov1api.JobHook {
+	if template == "" {
+		return nil
+	}
+	if !json.Valid([]byte(template)) {
+		log.Warnf("Invalid job hook template %s, ignoring", template)
+		return nil
+	}
+
+	return &velerov1api.JobHook{
+		Template: runtime.RawExtension{Raw: []byte(template)},
+		OnError:  parseHookErrorMode(onError),
+		Timeout:  metav1.Duration{Duration: parseHookTimeout(timeout, log)},
+	}
+}
+
+func parseHookErrorMode(value string) velerov1api.HookErrorMode {
+	onError := velerov1api.HookErrorMode(value)
+	if onError != velerov1api.HookErrorModeContinue && onError != velerov1api.HookErrorModeFail {
+		onError = ""
+	}
+	return onError
+}
+
+func parseHookTimeout(value string, log logrus.FieldLogger) time.Duration {
+	if value == "" {
+		return 0
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		log.Warn(errors.Wrapf(err, "Unable to parse provided timeout %s, using default", value))
+		return 0
+	}
+	return timeout
+}
+
+func parseHookRetries(value string, log logrus.FieldLogger) int {
+	if value == "" {
+		return 0
+	}
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 {
+		log.Warnf("Invalid hook retries %s, using default", value)
+		return 0
+	}
+	return retries
+}
+
 func parseStringToCommand(commandValue string) []string {
 	var command []string
 	// check for json array
@@ -318,8 +525,10 @@ type ResourceHookSelector struct {
 type ResourceHook struct {
 	Name     string
 	Selector ResourceHookSelector
-	Pre      []velerov1api.BackupResourceHook
-	Post     []velerov1api.BackupResourceHook
+	// Order is the position of the hook in the sequence of hooks executed during the backup.
+	Order int
+	Pre   []velerov1api.BackupResourceHook
+	Post  []velerov1api.BackupResourceHook
 }
 
 func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, namespace string, labels labels.Set) bool {
@@ -337,8 +546,10 @@ func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, n
 
 // ResourceRestoreHook is a restore hook for a given resource.
 type ResourceRestoreHook struct {
-	Name         string
-	Selector     ResourceHookSelector
+	Name     string
+	Selector ResourceHookSelector
+	// Order is the position of the hook in the sequence of post hooks executed during the restore.
+	Order        int
 	RestoreHooks []velerov1api.RestoreResourceHook
 }
 
@@ -376,7 +587,8 @@ func getInitRestoreHookFromAnnotation(podName string, annotations map[string]str
 	}
 }
 
-// GetRestoreHooksFromSpec returns a list of ResourceRestoreHooks from the restore Spec.
+// GetRestoreHooksFromSpec returns a list of ResourceRestoreHooks from the restore Spec, sorted by
+// increasing order.
 func GetRestoreHooksFromSpec(hooksSpec *velerov1api.RestoreHooks) ([]ResourceRestoreHook, error) {
 	if hooksSpec == nil {
 		return []ResourceRestoreHook{}, nil
@@ -391,6 +603,7 @@ func GetRestoreHooksFromSpec(hooksSpec *velerov1api.RestoreHooks) ([]ResourceRes
 				// TODO: resolve the pods resource via discovery?
 				Resources: collections.NewIncludesExcludes().Includes(kuberesource.Pods.Resource),
 			},
+			Order: rs.Order,
 			// TODO does this work for ExecRestoreHook as well?
 			RestoreHooks: rs.PostHooks,
 		}
@@ -405,6 +618,10 @@ func GetRestoreHooksFromSpec(hooksSpec *velerov1api.RestoreHooks) ([]ResourceRes
 		restoreHooks = append(restoreHooks, rh)
 	}
 
+	sort.SliceStable(restoreHooks, func(i, j int) bool {
+		return restoreHooks[i].Order < restoreHooks[j].Order
+	})
+
 	return restoreHooks, nil
 }
 
@@ -444,11 +661,13 @@ func getPodExecRestoreHookFromAnnotations(annotations map[string]string, log log
 	}
 
 	return &velerov1api.ExecRestoreHook{
-		Container:   container,
-		Command:     parseStringToCommand(commandValue),
-		OnError:     onError,
-		ExecTimeout: metav1.Duration{Duration: execTimeout},
-		WaitTimeout: metav1.Duration{Duration: waitTimeout},
+		Container:    container,
+		Command:      parseStringToCommand(commandValue),
+		OnError:      onError,
+		ExecTimeout:  metav1.Duration{Duration: execTimeout},
+		WaitTimeout:  metav1.Duration{Duration: waitTimeout},
+		Retries:      parseHookRetries(annotations[podRestoreHookRetriesAnnotationKey], log),
+		RetryBackoff: metav1.Duration{Duration: parseHookTimeout(annotations[podRestoreHookRetryBackoffAnnotationKey], log)},
 	}
 }
 
@@ -456,12 +675,14 @@ type PodExecRestoreHook struct {
 	HookName   string
 	HookSource string
 	Hook       velerov1api.ExecRestoreHook
-	executed   bool
+	// Order is the position of the hook in the sequence of post hooks executed during the restore.
+	Order    int
+	executed bool
 }
 
 // GroupRestoreExecHooks returns a list of hooks to be executed in a pod grouped by
 // container name. If an exec hook is defined in annotation that is used, else applicable exec
-// hooks from the restore resource are accumulated.
+// hooks from the restore resource are accumulated, in order.
 func GroupRestoreExecHooks(
 	resourceRestoreHooks []ResourceRestoreHook,
 	pod *corev1api.Pod,
@@ -487,15 +708,20 @@ func GroupRestoreExecHooks(
 				HookName:   "<from-annotation>",
 				HookSource: "annotation",
 				Hook:       *hookFromAnnotation,
+				Order:      parseHookOrder(metadata.GetAnnotations()[podRestoreHookOrderAnnotationKey], log),
 			},
 		}
 		return byContainer, nil
 	}
+	// HTTP and job hooks in annotations also take priority over the restore spec's hooks.
+	if len(getPodRestoreHooksFromAnnotations(metadata.GetAnnotations(), log)) > 0 {
+		return byContainer, nil
+	}
 
 	// No hook found on pod's annotations so check for applicable hooks from the restore spec
 	labels := metadata.GetLabels()
 	namespace := metadata.GetNamespace()
-	for _, rrh := range resourceRestoreHooks {
+	for _, rrh := range sortResourceRestoreHooksByOrder(resourceRestoreHooks) {
 		if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels) {
 			continue
 		}
@@ -507,6 +733,7 @@ func GroupRestoreExecHooks(
 				HookName:   rrh.Name,
 				Hook:       *rh.Exec,
 				HookSource: "backupSpec",
+				Order:      rrh.Order,
 			}
 			// default to first container in pod if unset, without mutating resource restore hook
 			if named.Hook.Container == "" {
@@ -518,3 +745,82 @@ func GroupRestoreExecHooks(
 
 	return byContainer, nil
 }
+
+// PodRestoreHook is an HTTP or job restore hook to be executed once a pod is running.
+type PodRestoreHook struct {
+	HookName   string
+	HookSource string
+	Hook       velerov1api.RestoreResourceHook
+}
+
+// getPodRestoreHooksFromAnnotations returns the HTTP and job restore hooks, in that order,
+// specified by the annotations.
+func getPodRestoreHooksFromAnnotations(annotations map[string]string, log logrus.FieldLogger) []velerov1api.RestoreResourceHook {
+	var hooks []velerov1api.RestoreResourceHook
+
+	onError := annotations[podRestoreHookOnErrorAnnotationKey]
+	timeout := annotations[podRestoreHookHTTPJobTimeoutAnnotationKey]
+	if hook := getHTTPHookFromAnnotations(
+		annotations[podRestoreHookHTTPServiceAnnotationKey],
+		annotations[podRestoreHookHTTPPortAnnotationKey],
+		annotations[podRestoreHookHTTPSchemeAnnotationKey],
+		annotations[podRestoreHookHTTPMethodAnnotationKey],
+		annotations[podRestoreHookHTTPPathAnnotationKey],
+		annotations[podRestoreHookHTTPExpectedStatusAnnotationKey],
+		onError,
+		timeout,
+		log,
+	); hook != nil {
+		hooks = append(hooks, velerov1api.RestoreResourceHook{HTTP: hook})
+	}
+	if hook := getJobHookFromAnnotations(annotations[podRestoreHookJobTemplateAnnotationKey], onError, timeout, log); hook != nil {
+		hooks = append(hooks, velerov1api.RestoreResourceHook{Job: hook})
+	}
+
+	return hooks
+}
+
+// GetPodRestoreHooks returns the HTTP and job hooks to be executed once a restored pod is
+// running. If hooks are defined in the pod's annotations, either exec, HTTP or job ones, those
+// are used, else applicable hooks from the restore resource are accumulated, in order.
+func GetPodRestoreHooks(
+	resourceRestoreHooks []ResourceRestoreHook,
+	pod *corev1api.Pod,
+	log logrus.FieldLogger,
+) []PodRestoreHook {
+	if pod == nil {
+		return nil
+	}
+
+	hooksFromAnnotations := getPodRestoreHooksFromAnnotations(pod.Annotations, log)
+	if len(hooksFromAnnotations) > 0 || getPodExecRestoreHookFromAnnotations(pod.Annotations, log) != nil {
+		var hooks []PodRestoreHook
+		for _, hook := range hooksFromAnnotations {
+			hooks = append(hooks, PodRestoreHook{
+				HookName:   "<from-annotation>",
+				HookSource: "annotation",
+				Hook:       hook,
+			})
+		}
+		return hooks
+	}
+
+	var hooks []PodRestoreHook
+	for _, rrh := range sortResourceRestoreHooksByOrder(resourceRestoreHooks) {
+		if !rrh.Selector.applicableTo(kuberesource.Pods, pod.Namespace, pod.Labels) {
+			continue
+		}
+		for _, rh := range rrh.RestoreHooks {
+			if rh.HTTP == nil && rh.Job == nil {
+				continue
+			}
+			hooks = append(hooks, PodRestoreHook{
+				HookName:   rrh.Name,
+				HookSource: "backupSpec",
+				Hook:       rh,
+			})
+		}
+	}
+
+	return hooks
+}
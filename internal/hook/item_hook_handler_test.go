@@ -17,6 +17,7 @@ limitations under the License.
 package hook
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -44,7 +45,7 @@ type mockItemHookHandler struct {
 	mock.Mock
 }
 
-func (h *mockItemHookHandler) HandleHooks(log logrus.FieldLogger, groupResource schema.GroupResource, obj runtime.Unstructured, resourceHooks []ResourceHook, phase hookPhase) error {
+func (h *mockItemHookHandler) HandleHooks(ctx context.Context, log logrus.FieldLogger, groupResource schema.GroupResource, obj runtime.Unstructured, resourceHooks []ResourceHook, phase hookPhase) error {
 	args := h.Called(log, groupResource, obj, resourceHooks, phase)
 	return args.Error(0)
 }
@@ -127,7 +128,7 @@ func TestHandleHooksSkips(t *testing.T) {
 			}
 
 			groupResource := schema.ParseGroupResource(test.groupResource)
-			err := h.HandleHooks(velerotest.NewLogger(), groupResource, test.item, test.hooks, PhasePre)
+			err := h.HandleHooks(context.Background(), velerotest.NewLogger(), groupResource, test.item, test.hooks, PhasePre)
 			assert.NoError(t, err)
 		})
 	}
@@ -494,7 +495,7 @@ func TestHandleHooks(t *testing.T) {
 			}
 
 			groupResource := schema.ParseGroupResource(test.groupResource)
-			err := h.HandleHooks(velerotest.NewLogger(), groupResource, test.item, test.hooks, test.phase)
+			err := h.HandleHooks(context.Background(), velerotest.NewLogger(), groupResource, test.item, test.hooks, test.phase)
 
 			if test.expectedError != nil {
 				assert.EqualError(t, err, test.expectedError.Error())
@@ -611,6 +612,195 @@ func TestGetPodExecHookFromAnnotations(t *testing.T) {
 	}
 }
 
+func TestGetPodBackupHooksFromAnnotations(t *testing.T) {
+	phases := []hookPhase{"", PhasePre, PhasePost}
+	for _, phase := range phases {
+		tests := []struct {
+			name          string
+			annotations   map[string]string
+			expectedHooks []velerov1api.BackupResourceHook
+		}{
+			{
+				name: "no hook annotations",
+			},
+			{
+				name: "http hook with defaults",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookHTTPServiceAnnotationKey): "svc",
+				},
+				expectedHooks: []velerov1api.BackupResourceHook{
+					{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+				},
+			},
+			{
+				name: "http hook with all annotations",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookHTTPServiceAnnotationKey):        "other/svc",
+					phasedKey(phase, podBackupHookHTTPPortAnnotationKey):           "8080",
+					phasedKey(phase, podBackupHookHTTPSchemeAnnotationKey):         "https",
+					phasedKey(phase, podBackupHookHTTPMethodAnnotationKey):         "POST",
+					phasedKey(phase, podBackupHookHTTPPathAnnotationKey):           "/quiesce",
+					phasedKey(phase, podBackupHookHTTPExpectedStatusAnnotationKey): "204",
+					phasedKey(phase, podBackupHookOnErrorAnnotationKey):            string(velerov1api.HookErrorModeContinue),
+					phasedKey(phase, podBackupHookTimeoutAnnotationKey):            "10s",
+				},
+				expectedHooks: []velerov1api.BackupResourceHook{
+					{
+						HTTP: &velerov1api.HTTPHook{
+							Namespace:      "other",
+							Service:        "svc",
+							Port:           "8080",
+							Scheme:         "https",
+							Method:         "POST",
+							Path:           "/quiesce",
+							ExpectedStatus: 204,
+							OnError:        velerov1api.HookErrorModeContinue,
+							Timeout:        metav1.Duration{Duration: 10 * time.Second},
+						},
+					},
+				},
+			},
+			{
+				name: "invalid http scheme and expected status are ignored",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookHTTPServiceAnnotationKey):        "svc",
+					phasedKey(phase, podBackupHookHTTPSchemeAnnotationKey):         "ftp",
+					phasedKey(phase, podBackupHookHTTPExpectedStatusAnnotationKey): "ok",
+				},
+				expectedHooks: []velerov1api.BackupResourceHook{
+					{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+				},
+			},
+			{
+				name: "job hook",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookJobTemplateAnnotationKey): `{"spec":{}}`,
+					phasedKey(phase, podBackupHookTimeoutAnnotationKey):     "1m",
+				},
+				expectedHooks: []velerov1api.BackupResourceHook{
+					{
+						Job: &velerov1api.JobHook{
+							Template: runtime.RawExtension{Raw: []byte(`{"spec":{}}`)},
+							Timeout:  metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			{
+				name: "invalid job template is ignored",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookJobTemplateAnnotationKey): `{"spec":`,
+				},
+			},
+			{
+				name: "exec, http and job hooks are returned in order",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookJobTemplateAnnotationKey): `{}`,
+					phasedKey(phase, podBackupHookHTTPServiceAnnotationKey): "svc",
+					phasedKey(phase, podBackupHookCommandAnnotationKey):     "/usr/bin/foo",
+				},
+				expectedHooks: []velerov1api.BackupResourceHook{
+					{Exec: &velerov1api.ExecHook{Command: []string{"/usr/bin/foo"}}},
+					{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+					{Job: &velerov1api.JobHook{Template: runtime.RawExtension{Raw: []byte(`{}`)}}},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%s (phase=%q)", test.name, phase), func(t *testing.T) {
+				hooks := getPodBackupHooksFromAnnotations(test.annotations, phase, velerotest.NewLogger())
+				assert.Equal(t, test.expectedHooks, hooks)
+			})
+		}
+	}
+}
+
+func TestHandleHooksHTTPAndJobHooks(t *testing.T) {
+	pod := builder.ForPod("ns", "name").Result()
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		resourceHooks []ResourceHook
+		executionErr  error
+		expectedHook  *velerov1api.HTTPHook
+		expectedName  string
+		expectedError bool
+	}{
+		{
+			name: "http hook from annotation is executed",
+			annotations: map[string]string{
+				phasedKey(PhasePre, podBackupHookHTTPServiceAnnotationKey): "svc",
+			},
+			expectedHook: &velerov1api.HTTPHook{Service: "svc"},
+			expectedName: "<from-annotation>",
+		},
+		{
+			name: "http hook from spec is executed",
+			resourceHooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{HTTP: &velerov1api.HTTPHook{Service: "svc", Path: "/freeze"}},
+					},
+				},
+			},
+			expectedHook: &velerov1api.HTTPHook{Service: "svc", Path: "/freeze"},
+			expectedName: "hook1",
+		},
+		{
+			name: "failed http hook defaults to fail",
+			resourceHooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+					},
+				},
+			},
+			executionErr:  errors.New("connection refused"),
+			expectedHook:  &velerov1api.HTTPHook{Service: "svc"},
+			expectedName:  "hook1",
+			expectedError: true,
+		},
+		{
+			name: "failed http hook with onError continue doesn't return an error",
+			resourceHooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{HTTP: &velerov1api.HTTPHook{Service: "svc", OnError: velerov1api.HookErrorModeContinue}},
+					},
+				},
+			},
+			executionErr: errors.New("connection refused"),
+			expectedHook: &velerov1api.HTTPHook{Service: "svc", OnError: velerov1api.HookErrorModeContinue},
+			expectedName: "hook1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpHookExecutor := &velerotest.MockHTTPHookExecutor{}
+			defer httpHookExecutor.AssertExpectations(t)
+			httpHookExecutor.On("ExecuteHTTPHook", mock.Anything, "ns", test.expectedName, test.expectedHook).Return(test.executionErr)
+
+			h := &DefaultItemHookHandler{HTTPHookExecutor: httpHookExecutor}
+
+			obj := pod.DeepCopy()
+			obj.Annotations = test.annotations
+			u := toUnstructuredOrDie(t, obj)
+			err := h.HandleHooks(context.Background(), velerotest.NewLogger(), kuberesource.Pods, &u, test.resourceHooks, PhasePre)
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestResourceHookApplicableTo(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -1856,3 +2046,85 @@ func TestHandleRestoreHooks(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodRestoreHooks(t *testing.T) {
+	specHooks := []ResourceRestoreHook{
+		{
+			Name: "hook1",
+			RestoreHooks: []velerov1api.RestoreResourceHook{
+				{Exec: &velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/foo"}}},
+				{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+				{Job: &velerov1api.JobHook{Template: runtime.RawExtension{Raw: []byte(`{}`)}}},
+			},
+		},
+		{
+			Name:     "hook2",
+			Selector: ResourceHookSelector{Namespaces: collections.NewIncludesExcludes().Excludes("default")},
+			RestoreHooks: []velerov1api.RestoreResourceHook{
+				{HTTP: &velerov1api.HTTPHook{Service: "excluded"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		pod           *corev1api.Pod
+		expectedHooks []PodRestoreHook
+	}{
+		{
+			name: "should return http and job hooks from the applicable spec hooks",
+			pod:  builder.ForPod("default", "my-pod").Result(),
+			expectedHooks: []PodRestoreHook{
+				{
+					HookName:   "hook1",
+					HookSource: "backupSpec",
+					Hook:       velerov1api.RestoreResourceHook{HTTP: &velerov1api.HTTPHook{Service: "svc"}},
+				},
+				{
+					HookName:   "hook1",
+					HookSource: "backupSpec",
+					Hook:       velerov1api.RestoreResourceHook{Job: &velerov1api.JobHook{Template: runtime.RawExtension{Raw: []byte(`{}`)}}},
+				},
+			},
+		},
+		{
+			name: "should return hooks from annotations instead of spec hooks",
+			pod: builder.ForPod("default", "my-pod").
+				ObjectMeta(builder.WithAnnotations(
+					podRestoreHookHTTPServiceAnnotationKey, "other/svc",
+					podRestoreHookHTTPPathAnnotationKey, "/warm-up",
+					podRestoreHookOnErrorAnnotationKey, string(velerov1api.HookErrorModeContinue),
+					podRestoreHookHTTPJobTimeoutAnnotationKey, "1m",
+				)).
+				Result(),
+			expectedHooks: []PodRestoreHook{
+				{
+					HookName:   "<from-annotation>",
+					HookSource: "annotation",
+					Hook: velerov1api.RestoreResourceHook{
+						HTTP: &velerov1api.HTTPHook{
+							Namespace: "other",
+							Service:   "svc",
+							Path:      "/warm-up",
+							OnError:   velerov1api.HookErrorModeContinue,
+							Timeout:   metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "should return no hooks when the pod has an exec hook annotation",
+			pod: builder.ForPod("default", "my-pod").
+				ObjectMeta(builder.WithAnnotations(podRestoreHookCommandAnnotationKey, "/usr/bin/foo")).
+				Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hooks := GetPodRestoreHooks(specHooks, test.pod, velerotest.NewLogger())
+			assert.Equal(t, test.expectedHooks, hooks)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	batchv1beta1api "k8s.io/api/batch/v1beta1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+const (
+	// defaultJobHookTimeout is how long a job hook's Job is waited for if the hook doesn't
+	// specify a timeout.
+	defaultJobHookTimeout = 10 * time.Minute
+
+	// jobHookNamePrefix is the prefix of the generated name of a job hook's Job.
+	jobHookNamePrefix = "velero-hook-"
+
+	// hookNameLabel is the label holding the name of the hook that created a Job.
+	hookNameLabel = "velero.io/hook-name"
+)
+
+// jobHookPollInterval is how often a job hook's Job is checked for completion.
+var jobHookPollInterval = time.Second
+
+// executeJobHook creates a Job from the hook's template, in the hook's namespace or else in
+// namespace, and waits for it to complete. The Job is labeled with labels and the hook's name.
+// Completed Jobs are deleted, failed ones are kept so their pods' logs can be inspected. The
+// Job, as <namespace>/<name>, is returned once it's created, along with an error if it failed
+// or didn't complete in time.
+func executeJobHook(
+	log logrus.FieldLogger,
+	dynamicFactory client.DynamicFactory,
+	namespace, hookName string,
+	labels map[string]string,
+	hook *velerov1api.JobHook,
+) (string, error) {
+	if hook.Namespace != "" {
+		namespace = hook.Namespace
+	}
+	if namespace == "" {
+		return "", errors.New("namespace is required")
+	}
+
+	template := new(batchv1beta1api.JobTemplateSpec)
+	if err := json.Unmarshal(hook.Template.Raw, template); err != nil {
+		return "", errors.Wrap(err, "error decoding job template")
+	}
+
+	job := &batchv1api.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: batchv1api.SchemeGroupVersion.String(),
+			Kind:       "Job",
+		},
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	job.Name = ""
+	job.GenerateName = jobHookNamePrefix
+	job.Namespace = namespace
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		job.Labels[k] = v
+	}
+	job.Labels[hookNameLabel] = hookName
+
+	jobMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+	if err != nil {
+		return "", errors.Wrap(err, "error converting job")
+	}
+
+	jobClient, err := dynamicFactory.ClientForGroupVersionResource(
+		batchv1api.SchemeGroupVersion,
+		metav1.APIResource{Name: "jobs", Namespaced: true, Kind: "Job"},
+		namespace,
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting client for jobs")
+	}
+
+	created, err := jobClient.Create(&unstructured.Unstructured{Object: jobMap})
+	if err != nil {
+		return "", errors.Wrapf(err, "error creating job in namespace %s", namespace)
+	}
+	target := fmt.Sprintf("%s/%s", created.GetNamespace(), created.GetName())
+	log = log.WithField("target", target)
+	log.Info("Created job for hook, waiting for it to complete")
+
+	timeout := hook.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultJobHookTimeout
+	}
+
+	var failure error
+	err = wait.PollImmediate(jobHookPollInterval, timeout, func() (bool, error) {
+		res, err := jobClient.Get(created.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrap(err, "error getting job")
+		}
+		current := new(batchv1api.Job)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(res.UnstructuredContent(), current); err != nil {
+			return false, errors.Wrap(err, "error converting job")
+		}
+		for _, cond := range current.Status.Conditions {
+			if cond.Status != corev1api.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1api.JobComplete:
+				return true, nil
+			case batchv1api.JobFailed:
+				failure = errors.Errorf("job failed: %s", cond.Message)
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	switch {
+	case err == wait.ErrWaitTimeout:
+		return target, errors.Errorf("timed out after %v waiting for job to complete", timeout)
+	case err != nil:
+		return target, err
+	case failure != nil:
+		return target, failure
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := jobClient.Delete(created.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+		log.WithError(err).Warn("Error deleting completed job")
+	}
+	return target, nil
+}
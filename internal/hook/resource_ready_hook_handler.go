@@ -0,0 +1,360 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// resourceReadyPollInterval is how often a restored resource is checked for readiness, and its
+// namespace for a running pod to execute a resource ready hook in.
+var resourceReadyPollInterval = time.Second
+
+// ResourceReadyHook is a resource ready hook to execute for a restored resource.
+type ResourceReadyHook struct {
+	HookName string
+	Hook     velerov1api.ResourceReadyRestoreHook
+	// Order is the position of the hook in the sequence of post hooks executed during the restore.
+	Order int
+}
+
+// GetResourceReadyHooksFromSpec returns a list of ResourceRestoreHooks with the resource ready hooks
+// of the restore Spec, sorted by increasing order. Unlike the ones returned by
+// GetRestoreHooksFromSpec, they apply to the hook specs' included resources, resolved with
+// discoveryHelper, which must be specified.
+func GetResourceReadyHooksFromSpec(hooksSpec *velerov1api.RestoreHooks, discoveryHelper discovery.Helper) ([]ResourceRestoreHook, error) {
+	if hooksSpec == nil {
+		return []ResourceRestoreHook{}, nil
+	}
+	restoreHooks := []ResourceRestoreHook{}
+	for _, rs := range hooksSpec.Resources {
+		var readyHooks []velerov1api.RestoreResourceHook
+		for _, rh := range rs.PostHooks {
+			if rh.ResourceReady != nil {
+				readyHooks = append(readyHooks, rh)
+			}
+		}
+		if len(readyHooks) == 0 {
+			continue
+		}
+		if len(rs.IncludedResources) == 0 {
+			return nil, errors.Errorf("hook spec %s has resource ready hooks but no included resources", rs.Name)
+		}
+
+		rh := ResourceRestoreHook{
+			Name: rs.Name,
+			Selector: ResourceHookSelector{
+				Namespaces: collections.NewIncludesExcludes().Includes(rs.IncludedNamespaces...).Excludes(rs.ExcludedNamespaces...),
+				Resources:  collections.GetResourceIncludesExcludes(discoveryHelper, rs.IncludedResources, rs.ExcludedResources),
+			},
+			Order:        rs.Order,
+			RestoreHooks: readyHooks,
+		}
+
+		if rs.LabelSelector != nil {
+			ls, err := metav1.LabelSelectorAsSelector(rs.LabelSelector)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			rh.Selector.LabelSelector = ls
+		}
+		restoreHooks = append(restoreHooks, rh)
+	}
+
+	sort.SliceStable(restoreHooks, func(i, j int) bool {
+		return restoreHooks[i].Order < restoreHooks[j].Order
+	})
+
+	return restoreHooks, nil
+}
+
+// GetResourceReadyHooks returns the resource ready hooks to execute for a restored resource, in
+// order, from the resource restore hooks returned by GetResourceReadyHooksFromSpec.
+func GetResourceReadyHooks(resourceReadyHooks []ResourceRestoreHook, groupResource schema.GroupResource, obj metav1.Object) []ResourceReadyHook {
+	var hooks []ResourceReadyHook
+	for _, rrh := range sortResourceRestoreHooksByOrder(resourceReadyHooks) {
+		if !rrh.Selector.applicableTo(groupResource, obj.GetNamespace(), labels.Set(obj.GetLabels())) {
+			continue
+		}
+		for _, rh := range rrh.RestoreHooks {
+			if rh.ResourceReady == nil {
+				continue
+			}
+			hooks = append(hooks, ResourceReadyHook{
+				HookName: rrh.Name,
+				Hook:     *rh.ResourceReady,
+				Order:    rrh.Order,
+			})
+		}
+	}
+	return hooks
+}
+
+// ResourceReadyHookHandler executes the resource ready hooks of a restored resource.
+type ResourceReadyHookHandler interface {
+	HandleHooks(
+		ctx context.Context,
+		log logrus.FieldLogger,
+		resourceClient client.Getter,
+		obj *unstructured.Unstructured,
+		hooks []ResourceReadyHook,
+	) []error
+}
+
+type DefaultResourceReadyHookHandler struct {
+	DynamicFactory     client.DynamicFactory
+	PodCommandExecutor podexec.PodCommandExecutor
+	// Report, if set, records the executed hooks.
+	Report *ExecutionReport
+}
+
+var _ ResourceReadyHookHandler = &DefaultResourceReadyHookHandler{}
+
+// HandleHooks executes the hooks in order, each once the resource, got with resourceClient, is
+// ready and one of the hook's pods is running. It stops at the first failed hook whose OnError is
+// Fail. Only failures of hooks whose OnError is Fail are returned.
+func (e *DefaultResourceReadyHookHandler) HandleHooks(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	resourceClient client.Getter,
+	obj *unstructured.Unstructured,
+	hooks []ResourceReadyHook,
+) []error {
+	if obj == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	resourceLog := log.WithField("resource", kube.NamespaceAndName(obj))
+
+	for _, hook := range hooks {
+		hookLog := resourceLog.WithFields(
+			logrus.Fields{
+				"hookSource": "backupSpec",
+				"hookType":   "resourceReady",
+				"hookPhase":  "post",
+			},
+		)
+
+		if err := e.executeHook(ctx, hookLog, resourceClient, obj, hook); err != nil {
+			hookErr := fmt.Errorf("Hook %s for %s failed: %v", hook.HookName, kube.NamespaceAndName(obj), err)
+			hookLog.Error(hookErr)
+			if hook.Hook.Exec.OnError == velerov1api.HookErrorModeFail {
+				return []error{hookErr}
+			}
+		}
+	}
+
+	return nil
+}
+
+// executeHook waits for the resource to be ready and a pod selected by the hook to be running,
+// for up to the hook's wait timeout, then executes the hook's command in it.
+func (e *DefaultResourceReadyHookHandler) executeHook(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	resourceClient client.Getter,
+	obj *unstructured.Unstructured,
+	hook ResourceReadyHook,
+) error {
+	if timeout := hook.Hook.Exec.WaitTimeout.Duration; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := wait.PollImmediateUntil(resourceReadyPollInterval, func() (bool, error) {
+		current, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			log.WithError(err).Warn("Error getting resource: post-restore hooks will not yet be executed")
+			return false, nil
+		}
+		ready, err := isResourceReady(current, &hook.Hook)
+		if err != nil {
+			return false, err
+		}
+		if !ready {
+			log.Info("Resource is not ready: post-restore hooks will not yet be executed")
+		}
+		return ready, nil
+	}, ctx.Done())
+	if err != nil {
+		return errors.Wrap(err, "resource was not observed ready")
+	}
+
+	selector, err := resourceReadyHookPodSelector(obj, &hook.Hook)
+	if err != nil {
+		return err
+	}
+	podClient, err := e.DynamicFactory.ClientForGroupVersionResource(
+		schema.GroupVersion{Version: "v1"},
+		metav1.APIResource{Name: "pods", Namespaced: true, Kind: "Pod"},
+		obj.GetNamespace(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "error getting client for pods")
+	}
+
+	var pod *corev1api.Pod
+	err = wait.PollImmediateUntil(resourceReadyPollInterval, func() (bool, error) {
+		list, err := podClient.List(metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			log.WithError(err).Warn("Error listing pods: post-restore hooks will not yet be executed")
+			return false, nil
+		}
+		pod, err = firstRunningPod(list, hook.Hook.Exec.Container)
+		if err != nil {
+			return false, err
+		}
+		if pod == nil {
+			log.Infof("No pod matching %q is running: post-restore hooks will not yet be executed", selector)
+		}
+		return pod != nil, nil
+	}, ctx.Done())
+	if err != nil {
+		return errors.Wrapf(err, "no pod matching %q was observed running", selector)
+	}
+
+	eh := &velerov1api.ExecHook{
+		Container:    hook.Hook.Exec.Container,
+		Command:      hook.Hook.Exec.Command,
+		OnError:      hook.Hook.Exec.OnError,
+		Timeout:      hook.Hook.Exec.ExecTimeout,
+		Retries:      hook.Hook.Exec.Retries,
+		RetryBackoff: hook.Hook.Exec.RetryBackoff,
+	}
+	if eh.Container == "" {
+		eh.Container = pod.Spec.Containers[0].Name
+	}
+	podMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return executePodCommand(e.PodCommandExecutor, e.Report, log.WithField("pod", kube.NamespaceAndName(pod)), podMap, pod.Namespace, pod.Name, hook.HookName, PhasePost, eh)
+}
+
+// isResourceReady returns whether the resource has the condition and field value the hook waits
+// for. A hook without a condition or a field path is always ready.
+func isResourceReady(obj *unstructured.Unstructured, hook *velerov1api.ResourceReadyRestoreHook) (bool, error) {
+	if hook.Condition != "" {
+		status := hook.ConditionStatus
+		if status == "" {
+			status = string(metav1.ConditionTrue)
+		}
+
+		conditions, _, err := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "conditions")
+		if err != nil {
+			return false, errors.Wrap(err, "error getting status.conditions")
+		}
+		found := false
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if condition["type"] == hook.Condition && condition["status"] == status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if hook.FieldPath != "" {
+		fields := strings.Split(strings.TrimPrefix(hook.FieldPath, "."), ".")
+		value, found, err := unstructured.NestedFieldNoCopy(obj.UnstructuredContent(), fields...)
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting %s", hook.FieldPath)
+		}
+		if !found || fmt.Sprint(value) != hook.FieldValue {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// resourceReadyHookPodSelector returns the label selector of the pods a hook may be executed in:
+// the hook's pod selector if it has one, else the resource's spec.selector.
+func resourceReadyHookPodSelector(obj *unstructured.Unstructured, hook *velerov1api.ResourceReadyRestoreHook) (string, error) {
+	if hook.PodSelector != nil {
+		return labelSelectorString(hook.PodSelector)
+	}
+
+	selectorMap, found, err := unstructured.NestedMap(obj.UnstructuredContent(), "spec", "selector")
+	if err != nil || !found {
+		return "", errors.New("hook has no pod selector and the resource has no spec.selector")
+	}
+	selector := new(metav1.LabelSelector)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, selector); err != nil {
+		return "", errors.Wrap(err, "error converting spec.selector")
+	}
+	return labelSelectorString(selector)
+}
+
+// firstRunningPod returns the running pod of the list with the lowest name whose container, or
+// first container if unspecified, is running, or nil if there's none.
+func firstRunningPod(list *unstructured.UnstructuredList, container string) (*corev1api.Pod, error) {
+	var pods []*corev1api.Pod
+	for i := range list.Items {
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].UnstructuredContent(), pod); err != nil {
+			return nil, errors.Wrap(err, "error converting pod")
+		}
+		if pod.Status.Phase != corev1api.PodRunning || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		containerName := container
+		if containerName == "" {
+			containerName = pod.Spec.Containers[0].Name
+		}
+		if !isContainerRunning(pod, containerName) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	return pods[0], nil
+}
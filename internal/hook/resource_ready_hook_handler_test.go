@@ -0,0 +1,267 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newDeployment(conditions ...map[string]interface{}) *unstructured.Unstructured {
+	var statusConditions []interface{}
+	for _, c := range conditions {
+		statusConditions = append(statusConditions, c)
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "db",
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "db"},
+			},
+		},
+		"status": map[string]interface{}{
+			"phase":      "Running",
+			"conditions": statusConditions,
+		},
+	}}
+}
+
+func TestIsResourceReady(t *testing.T) {
+	available := map[string]interface{}{"type": "Available", "status": "True"}
+	unavailable := map[string]interface{}{"type": "Available", "status": "False"}
+
+	tests := []struct {
+		name     string
+		obj      *unstructured.Unstructured
+		hook     velerov1api.ResourceReadyRestoreHook
+		expected bool
+	}{
+		{
+			name:     "hook without condition or field path is ready",
+			obj:      newDeployment(),
+			expected: true,
+		},
+		{
+			name:     "condition with the default status is ready",
+			obj:      newDeployment(available),
+			hook:     velerov1api.ResourceReadyRestoreHook{Condition: "Available"},
+			expected: true,
+		},
+		{
+			name: "condition with another status isn't ready",
+			obj:  newDeployment(unavailable),
+			hook: velerov1api.ResourceReadyRestoreHook{Condition: "Available"},
+		},
+		{
+			name:     "condition with the expected status is ready",
+			obj:      newDeployment(unavailable),
+			hook:     velerov1api.ResourceReadyRestoreHook{Condition: "Available", ConditionStatus: "False"},
+			expected: true,
+		},
+		{
+			name: "missing condition isn't ready",
+			obj:  newDeployment(),
+			hook: velerov1api.ResourceReadyRestoreHook{Condition: "Available"},
+		},
+		{
+			name:     "field with the expected value is ready",
+			obj:      newDeployment(),
+			hook:     velerov1api.ResourceReadyRestoreHook{FieldPath: ".status.phase", FieldValue: "Running"},
+			expected: true,
+		},
+		{
+			name: "field with another value isn't ready",
+			obj:  newDeployment(),
+			hook: velerov1api.ResourceReadyRestoreHook{FieldPath: "status.phase", FieldValue: "Pending"},
+		},
+		{
+			name: "missing field isn't ready",
+			obj:  newDeployment(),
+			hook: velerov1api.ResourceReadyRestoreHook{FieldPath: "status.primary", FieldValue: "db-0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ready, err := isResourceReady(test.obj, &test.hook)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ready)
+		})
+	}
+}
+
+func TestResourceReadyHookHandlerHandleHooks(t *testing.T) {
+	resourceReadyPollInterval = time.Millisecond
+
+	runningPod := func(name string) *corev1api.Pod {
+		return builder.ForPod("ns-1", name).
+			Phase(corev1api.PodRunning).
+			Containers(&corev1api.Container{Name: "postgres"}).
+			ContainerStatuses(&corev1api.ContainerStatus{Name: "postgres", State: corev1api.ContainerState{Running: &corev1api.ContainerStateRunning{}}}).
+			Result()
+	}
+
+	tests := []struct {
+		name          string
+		current       *unstructured.Unstructured
+		pods          []*corev1api.Pod
+		onError       velerov1api.HookErrorMode
+		expectedPod   string
+		expectedError bool
+	}{
+		{
+			name:        "hook is executed in the running pod with the lowest name once the resource is ready",
+			current:     newDeployment(map[string]interface{}{"type": "Available", "status": "True"}),
+			pods:        []*corev1api.Pod{runningPod("db-1"), runningPod("db-0"), builder.ForPod("ns-1", "db-2").Phase(corev1api.PodPending).Result()},
+			expectedPod: "db-0",
+		},
+		{
+			name:          "hook whose resource isn't ready in time fails",
+			current:       newDeployment(),
+			pods:          []*corev1api.Pod{runningPod("db-0")},
+			onError:       velerov1api.HookErrorModeFail,
+			expectedError: true,
+		},
+		{
+			name:    "failed hook with onError continue doesn't return an error",
+			current: newDeployment(),
+			pods:    []*corev1api.Pod{runningPod("db-0")},
+		},
+		{
+			name:          "hook without running pods fails",
+			current:       newDeployment(map[string]interface{}{"type": "Available", "status": "True"}),
+			onError:       velerov1api.HookErrorModeFail,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resourceClient := &velerotest.FakeDynamicClient{}
+			resourceClient.On("Get", "db", metav1.GetOptions{}).Return(test.current, nil)
+
+			list := &unstructured.UnstructuredList{}
+			for _, pod := range test.pods {
+				list.Items = append(list.Items, toUnstructuredOrDie(t, pod))
+			}
+			podClient := &velerotest.FakeDynamicClient{}
+			podClient.On("List", metav1.ListOptions{LabelSelector: "app=db"}).Return(list, nil)
+			dynamicFactory := &velerotest.FakeDynamicFactory{}
+			dynamicFactory.On("ClientForGroupVersionResource", schema.GroupVersion{Version: "v1"}, mock.Anything, "ns-1").Return(podClient, nil)
+
+			hook := ResourceReadyHook{
+				HookName: "thaw",
+				Hook: velerov1api.ResourceReadyRestoreHook{
+					Condition: "Available",
+					Exec: velerov1api.ExecRestoreHook{
+						Command:     []string{"/usr/bin/thaw"},
+						OnError:     test.onError,
+						WaitTimeout: metav1.Duration{Duration: 50 * time.Millisecond},
+					},
+				},
+			}
+
+			podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+			defer podCommandExecutor.AssertExpectations(t)
+			if test.expectedPod != "" {
+				podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", test.expectedPod, "thaw", &velerov1api.ExecHook{
+					Container: "postgres",
+					Command:   []string{"/usr/bin/thaw"},
+				}).Return(nil)
+			}
+
+			h := &DefaultResourceReadyHookHandler{
+				DynamicFactory:     dynamicFactory,
+				PodCommandExecutor: podCommandExecutor,
+			}
+			errs := h.HandleHooks(context.Background(), velerotest.NewLogger(), resourceClient, newDeployment(), []ResourceReadyHook{hook})
+
+			if test.expectedError {
+				assert.Len(t, errs, 1)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestGetResourceReadyHooks(t *testing.T) {
+	hooksSpec := &velerov1api.RestoreHooks{
+		Resources: []velerov1api.RestoreResourceHookSpec{
+			{
+				Name:              "pod-hooks",
+				IncludedResources: []string{"pods"},
+				PostHooks:         []velerov1api.RestoreResourceHook{{Exec: &velerov1api.ExecRestoreHook{Command: []string{"true"}}}},
+			},
+			{
+				Name:              "primary",
+				IncludedResources: []string{"deployments"},
+				Order:             2,
+				PostHooks:         []velerov1api.RestoreResourceHook{{ResourceReady: &velerov1api.ResourceReadyRestoreHook{Condition: "Available"}}},
+			},
+			{
+				Name:              "replicas",
+				IncludedResources: []string{"deployments"},
+				Order:             1,
+				PostHooks:         []velerov1api.RestoreResourceHook{{ResourceReady: &velerov1api.ResourceReadyRestoreHook{Condition: "Available"}}},
+			},
+		},
+	}
+	discoveryHelper := velerotest.NewFakeDiscoveryHelper(false, map[schema.GroupVersionResource]schema.GroupVersionResource{
+		{Resource: "deployments"}: {Group: "apps", Version: "v1", Resource: "deployments"},
+	})
+
+	resourceReadyHooks, err := GetResourceReadyHooksFromSpec(hooksSpec, discoveryHelper)
+	require.NoError(t, err)
+
+	hooks := GetResourceReadyHooks(resourceReadyHooks, schema.GroupResource{Group: "apps", Resource: "deployments"}, newDeployment())
+	var names []string
+	for _, hook := range hooks {
+		names = append(names, hook.HookName)
+	}
+	assert.Equal(t, []string{"replicas", "primary"}, names)
+
+	assert.Empty(t, GetResourceReadyHooks(resourceReadyHooks, schema.GroupResource{Resource: "pods"}, newDeployment()))
+
+	_, err = GetResourceReadyHooksFromSpec(&velerov1api.RestoreHooks{
+		Resources: []velerov1api.RestoreResourceHookSpec{
+			{
+				Name:      "no-resources",
+				PostHooks: []velerov1api.RestoreResourceHook{{ResourceReady: &velerov1api.ResourceReadyRestoreHook{Condition: "Available"}}},
+			},
+		},
+	}, discoveryHelper)
+	assert.Error(t, err)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// PodRestoreHookHandler executes the HTTP and job restore hooks of a pod.
+type PodRestoreHookHandler interface {
+	HandleHooks(
+		ctx context.Context,
+		log logrus.FieldLogger,
+		pod *v1.Pod,
+		hooks []PodRestoreHook,
+	) []error
+}
+
+type DefaultPodRestoreHookHandler struct {
+	ListWatchFactory ListWatchFactory
+	HTTPHookExecutor podexec.HTTPHookExecutor
+	DynamicFactory   client.DynamicFactory
+	// Report, if set, records the executed hooks.
+	Report *ExecutionReport
+}
+
+var _ PodRestoreHookHandler = &DefaultPodRestoreHookHandler{}
+
+// HandleHooks waits for the pod to be running, then executes its hooks in order. It stops at the
+// first failed hook whose OnError is Fail. Only failures of hooks whose OnError is Fail, including
+// hooks that weren't executed because the pod never ran, are returned.
+func (e *DefaultPodRestoreHookHandler) HandleHooks(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	pod *v1.Pod,
+	hooks []PodRestoreHook,
+) []error {
+	if pod == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	podLog := log.WithField("pod", kube.NamespaceAndName(pod))
+
+	if err := e.waitForPodRunning(ctx, podLog, pod); err != nil {
+		var errs []error
+		for _, hook := range hooks {
+			hookErr := fmt.Errorf("Hook %s in pod %s not executed: %v", hook.HookName, kube.NamespaceAndName(pod), err)
+			restoreHookLog(podLog, hook).Error(hookErr)
+			if restoreHookErrorMode(hook.Hook) == velerov1api.HookErrorModeFail {
+				errs = append(errs, hookErr)
+			}
+		}
+		return errs
+	}
+
+	for _, hook := range hooks {
+		hookLog := restoreHookLog(podLog, hook)
+
+		var err error
+		switch {
+		case hook.Hook.HTTP != nil:
+			err = executeHTTPHook(e.HTTPHookExecutor, e.Report, hookLog, pod.Namespace, pod.Name, hook.HookName, PhasePost, hook.Hook.HTTP)
+		case hook.Hook.Job != nil:
+			err = executePodJobHook(e.DynamicFactory, e.Report, hookLog, pod.Namespace, pod.Name, hook.HookName, PhasePost, hook.Hook.Job)
+		}
+		if err != nil {
+			hookLog.WithError(err).Error("Error executing hook")
+			if restoreHookErrorMode(hook.Hook) == velerov1api.HookErrorModeFail {
+				return []error{err}
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForPodRunning watches the pod until it's running. An error is returned if the pod is
+// deleted or terminates first, or ctx is done.
+func (e *DefaultPodRestoreHookHandler) waitForPodRunning(ctx context.Context, log logrus.FieldLogger, pod *v1.Pod) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		running bool
+		err     error
+	)
+	handler := func(newObj interface{}) {
+		newPod, ok := newObj.(*v1.Pod)
+		if !ok {
+			return
+		}
+
+		switch newPod.Status.Phase {
+		case v1.PodRunning:
+			running = true
+			cancel()
+		case v1.PodSucceeded, v1.PodFailed:
+			err = fmt.Errorf("pod entered phase %s before it was observed running", newPod.Status.Phase)
+			cancel()
+		default:
+			log.Infof("Pod is in phase %s: post-restore hooks will not yet be executed", newPod.Status.Phase)
+		}
+	}
+
+	selector := fields.OneTermEqualSelector("metadata.name", pod.Name)
+	lw := e.ListWatchFactory.NewListWatch(pod.Namespace, selector)
+
+	_, podWatcher := cache.NewInformer(lw, pod, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: handler,
+		UpdateFunc: func(_, newObj interface{}) {
+			handler(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			err = fmt.Errorf("pod deleted before it was observed running")
+			cancel()
+		},
+	})
+
+	podWatcher.Run(ctx.Done())
+
+	if err != nil {
+		return err
+	}
+	if !running {
+		return fmt.Errorf("pod was not observed running: %v", ctx.Err())
+	}
+	return nil
+}
+
+func restoreHookLog(log logrus.FieldLogger, hook PodRestoreHook) logrus.FieldLogger {
+	hookType := "http"
+	if hook.Hook.Job != nil {
+		hookType = "job"
+	}
+	return log.WithFields(
+		logrus.Fields{
+			"hookSource": hook.HookSource,
+			"hookType":   hookType,
+			"hookPhase":  "post",
+		},
+	)
+}
+
+// restoreHookErrorMode returns how an error from an HTTP or job restore hook should be treated,
+// defaulting to Continue like exec restore hooks.
+func restoreHookErrorMode(hook velerov1api.RestoreResourceHook) velerov1api.HookErrorMode {
+	var mode velerov1api.HookErrorMode
+	switch {
+	case hook.HTTP != nil:
+		mode = hook.HTTP.OnError
+	case hook.Job != nil:
+		mode = hook.Job.OnError
+	}
+	if mode != velerov1api.HookErrorModeFail {
+		return velerov1api.HookErrorModeContinue
+	}
+	return mode
+}
@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	fcache "k8s.io/client-go/tools/cache/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPodRestoreHookHandleHooks(t *testing.T) {
+	pending := builder.ForPod("ns-1", "my-pod").Phase(v1.PodPending).Result()
+	running := builder.ForPod("ns-1", "my-pod").Phase(v1.PodRunning).Result()
+	failed := builder.ForPod("ns-1", "my-pod").Phase(v1.PodFailed).Result()
+
+	httpHook := func(service string, onError velerov1api.HookErrorMode) PodRestoreHook {
+		return PodRestoreHook{
+			HookName:   "my-hook",
+			HookSource: "backupSpec",
+			Hook: velerov1api.RestoreResourceHook{
+				HTTP: &velerov1api.HTTPHook{Service: service, OnError: onError},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		initialPod         *v1.Pod
+		updatedPod         *v1.Pod
+		hooks              []PodRestoreHook
+		executionErrs      map[string]error
+		expectedExecutions []string
+		expectedErrors     []string
+	}{
+		{
+			name:               "hooks are executed once the pod is running",
+			initialPod:         pending,
+			updatedPod:         running,
+			hooks:              []PodRestoreHook{httpHook("svc-1", ""), httpHook("svc-2", "")},
+			expectedExecutions: []string{"svc-1", "svc-2"},
+		},
+		{
+			name:               "failed hook without onError fail doesn't stop execution",
+			initialPod:         running,
+			hooks:              []PodRestoreHook{httpHook("svc-1", ""), httpHook("svc-2", "")},
+			executionErrs:      map[string]error{"svc-1": errors.New("connection refused")},
+			expectedExecutions: []string{"svc-1", "svc-2"},
+		},
+		{
+			name:               "failed hook with onError fail stops execution",
+			initialPod:         running,
+			hooks:              []PodRestoreHook{httpHook("svc-1", velerov1api.HookErrorModeFail), httpHook("svc-2", "")},
+			executionErrs:      map[string]error{"svc-1": errors.New("connection refused")},
+			expectedExecutions: []string{"svc-1"},
+			expectedErrors:     []string{"connection refused"},
+		},
+		{
+			name:       "hooks aren't executed if the pod terminates before running",
+			initialPod: pending,
+			updatedPod: failed,
+			hooks:      []PodRestoreHook{httpHook("svc-1", ""), httpHook("svc-2", velerov1api.HookErrorModeFail)},
+			expectedErrors: []string{
+				"Hook my-hook in pod ns-1/my-pod not executed: pod entered phase Failed before it was observed running",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			source := fcache.NewFakeControllerSource()
+			go func() {
+				source.Add(test.initialPod)
+				if test.updatedPod != nil {
+					time.Sleep(10 * time.Millisecond)
+					source.Modify(test.updatedPod)
+				}
+			}()
+
+			httpHookExecutor := &velerotest.MockHTTPHookExecutor{}
+			defer httpHookExecutor.AssertExpectations(t)
+			for _, service := range test.expectedExecutions {
+				service := service
+				httpHookExecutor.On("ExecuteHTTPHook", mock.Anything, "ns-1", "my-hook", mock.MatchedBy(func(hook *velerov1api.HTTPHook) bool {
+					return hook.Service == service
+				})).Return(test.executionErrs[service])
+			}
+
+			h := &DefaultPodRestoreHookHandler{
+				ListWatchFactory: &fakeListWatchFactory{source},
+				HTTPHookExecutor: httpHookExecutor,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			errs := h.HandleHooks(ctx, velerotest.NewLogger(), test.initialPod, test.hooks)
+
+			require.Len(t, errs, len(test.expectedErrors))
+			for i, expected := range test.expectedErrors {
+				assert.EqualError(t, errs[i], expected)
+			}
+		})
+	}
+}
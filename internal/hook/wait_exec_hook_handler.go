@@ -19,6 +19,7 @@ package hook
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -58,6 +59,8 @@ var _ ListWatchFactory = &DefaultListWatchFactory{}
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+	// Report, if set, records the executed hooks.
+	Report *ExecutionReport
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -125,9 +128,21 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 			return
 		}
 
-		for containerName, hooks := range byContainer {
+		// Containers are handled in the order of their hooks. The hooks of a container aren't
+		// executed while a container whose hooks have a lower order isn't running yet.
+		blockingOrder := -1
+		for _, containerName := range containersByHookOrder(byContainer) {
+			hooks := byContainer[containerName]
+			order := containerHookOrder(hooks)
+			if blockingOrder >= 0 && order > blockingOrder {
+				podLog.Infof("Container %s is waiting for hooks with a lower order: post-restore hooks will not yet be executed", containerName)
+				continue
+			}
 			if !isContainerRunning(newPod, containerName) {
 				podLog.Infof("Container %s is not running: post-restore hooks will not yet be executed", containerName)
+				if blockingOrder < 0 {
+					blockingOrder = order
+				}
 				continue
 			}
 			podMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPod)
@@ -164,12 +179,14 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					}
 				}
 				eh := &velerov1api.ExecHook{
-					Container: hook.Hook.Container,
-					Command:   hook.Hook.Command,
-					OnError:   hook.Hook.OnError,
-					Timeout:   hook.Hook.ExecTimeout,
+					Container:    hook.Hook.Container,
+					Command:      hook.Hook.Command,
+					OnError:      hook.Hook.OnError,
+					Timeout:      hook.Hook.ExecTimeout,
+					Retries:      hook.Hook.Retries,
+					RetryBackoff: hook.Hook.RetryBackoff,
 				}
-				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
+				if err := executePodCommand(e.PodCommandExecutor, e.Report, hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, PhasePost, eh); err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
@@ -257,6 +274,34 @@ func isContainerRunning(pod *v1.Pod, containerName string) bool {
 	return false
 }
 
+// containersByHookOrder returns the names of the containers with hooks, sorted by the order of
+// their hooks and then by name.
+func containersByHookOrder(byContainer map[string][]PodExecRestoreHook) []string {
+	containers := make([]string, 0, len(byContainer))
+	for containerName := range byContainer {
+		containers = append(containers, containerName)
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		iOrder, jOrder := containerHookOrder(byContainer[containers[i]]), containerHookOrder(byContainer[containers[j]])
+		if iOrder != jOrder {
+			return iOrder < jOrder
+		}
+		return containers[i] < containers[j]
+	})
+	return containers
+}
+
+// containerHookOrder returns the lowest order of a container's hooks.
+func containerHookOrder(hooks []PodExecRestoreHook) int {
+	order := 0
+	for i, hook := range hooks {
+		if i == 0 || hook.Order < order {
+			order = hook.Order
+		}
+	}
+	return order
+}
+
 // maxHookWait returns 0 to mean wait indefinitely. Any hook without a wait timeout will cause this
 // function to return 0.
 func maxHookWait(byContainer map[string][]PodExecRestoreHook) time.Duration {
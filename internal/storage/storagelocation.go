@@ -95,3 +95,24 @@ func ListBackupStorageLocations(ctx context.Context, kbClient client.Client, nam
 
 	return locations, nil
 }
+
+// QuotaExceeded returns an error describing the quota of a backup storage location its usage
+// has reached, as recorded in its status, or nil if it hasn't reached any.
+func QuotaExceeded(location *velerov1api.BackupStorageLocation) error {
+	quota := location.Spec.Quota
+	if quota == nil {
+		return nil
+	}
+
+	if quota.Backups != nil && location.Status.BackupCount >= *quota.Backups {
+		return errors.Errorf("backup storage location %s stores %d backups, reaching its quota of %d backups",
+			location.Name, location.Status.BackupCount, *quota.Backups)
+	}
+
+	if quota.Bytes != nil && location.Status.TotalBackupBytes >= quota.Bytes.Value() {
+		return errors.Errorf("backups stored in backup storage location %s take up %d bytes, reaching its quota of %s",
+			location.Name, location.Status.TotalBackupBytes, quota.Bytes.String())
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -174,3 +175,58 @@ func TestListBackupStorageLocations(t *testing.T) {
 		})
 	}
 }
+
+func TestQuotaExceeded(t *testing.T) {
+	backups := func(n int) *int { return &n }
+	bytes := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	tests := []struct {
+		name        string
+		location    *velerov1api.BackupStorageLocation
+		expectError bool
+	}{
+		{
+			name:     "location without a quota doesn't exceed it",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").Usage(100, 1<<30).Result(),
+		},
+		{
+			name: "location under its quota doesn't exceed it",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				Quota(&velerov1api.BackupStorageLocationQuota{Backups: backups(10), Bytes: bytes("1Gi")}).
+				Usage(9, 1<<29).
+				Result(),
+		},
+		{
+			name: "location reaching its backups quota exceeds it",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				Quota(&velerov1api.BackupStorageLocationQuota{Backups: backups(10)}).
+				Usage(10, 0).
+				Result(),
+			expectError: true,
+		},
+		{
+			name: "location reaching its bytes quota exceeds it",
+			location: builder.ForBackupStorageLocation("ns-1", "location-1").
+				Quota(&velerov1api.BackupStorageLocationQuota{Bytes: bytes("1Gi")}).
+				Usage(1, 1<<30).
+				Result(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := QuotaExceeded(tt.location)
+			if tt.expectError {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package velero
 
 import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
 
@@ -26,15 +27,32 @@ type PluginLister interface {
 	List(kind framework.PluginKind) []framework.PluginIdentifier
 }
 
-// GetInstalledPluginInfo returns a list of installed plugins
-func GetInstalledPluginInfo(pluginLister PluginLister) []velerov1api.PluginInfo {
+type PluginStatusGetter interface {
+	// Get returns the status of the process of the plugin executable command, and
+	// whether the server started one.
+	Get(command string) (clientmgmt.ProcessStatus, bool)
+}
+
+// GetInstalledPluginInfo returns a list of installed plugins, with the health of their
+// processes from pluginStatuses. Plugins whose executable the server has only run to
+// discover them are reported as healthy.
+func GetInstalledPluginInfo(pluginLister PluginLister, pluginStatuses PluginStatusGetter) []velerov1api.PluginInfo {
 	var plugins []velerov1api.PluginInfo
 	for _, v := range framework.AllPluginKinds() {
 		list := pluginLister.List(v)
 		for _, plugin := range list {
 			pluginInfo := velerov1api.PluginInfo{
-				Name: plugin.Name,
-				Kind: plugin.Kind.String(),
+				Name:         plugin.Name,
+				Kind:         plugin.Kind.String(),
+				Capabilities: plugin.Capabilities,
+				Status:       velerov1api.PluginStatusHealthy,
+			}
+			if pluginStatuses != nil {
+				if status, found := pluginStatuses.Get(plugin.Command); found {
+					pluginInfo.Status = status.Status
+					pluginInfo.Restarts = status.Restarts
+					pluginInfo.LastError = status.LastError
+				}
 			}
 			plugins = append(plugins, pluginInfo)
 		}
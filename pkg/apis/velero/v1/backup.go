@@ -18,6 +18,7 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type Metadata struct {
@@ -101,6 +102,13 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
+
+	// ReplicationTargets is a list of names of BackupStorageLocations the backup
+	// should be copied to once it completes, in addition to the replication targets
+	// of its storage location.
+	// +optional
+	// +nullable
+	ReplicationTargets []string `json:"replicationTargets,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -109,6 +117,71 @@ type BackupHooks struct {
 	// +optional
 	// +nullable
 	Resources []BackupResourceHookSpec `json:"resources,omitempty"`
+
+	// BackupPre is a list of hooks executed once, in order, before any item is backed up.
+	// +optional
+	// +nullable
+	BackupPre []BackupWideHook `json:"backupPre,omitempty"`
+
+	// BackupPost is a list of hooks executed once, in order, after all items are backed up
+	// and their volumes are snapshotted. They're executed even if the backup fails after its
+	// pre hooks were executed.
+	// +optional
+	// +nullable
+	BackupPost []BackupWideHook `json:"backupPost,omitempty"`
+}
+
+// BackupWideHook defines a hook executed once around a whole backup. Exactly one of
+// Exec and Job must be specified.
+type BackupWideHook struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// Exec executes a command in each running pod selected by the hook.
+	// +optional
+	// +nullable
+	Exec *BackupWideExecHook `json:"exec,omitempty"`
+
+	// Job creates a Job in the source cluster and waits for it to complete.
+	// +optional
+	// +nullable
+	Job *JobHook `json:"job,omitempty"`
+}
+
+// BackupWideExecHook is an exec hook executed in each running pod it selects.
+type BackupWideExecHook struct {
+	// Namespace is the namespace of the pods the command is executed in.
+	Namespace string `json:"namespace"`
+
+	// LabelSelector, if specified, selects the pods the command is executed in. Otherwise
+	// it's executed in every running pod in the namespace.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	ExecHook `json:",inline"`
+}
+
+// JobHook is a hook that creates a Job and waits for it to complete.
+type JobHook struct {
+	// Namespace is the namespace the Job is created in. Defaults to the namespace of the item
+	// the hook is executed for. Required for backup-wide hooks.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Template is the batch/v1 JobTemplateSpec the Job is created from. The Job's name is
+	// generated.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template runtime.RawExtension `json:"template"`
+
+	// OnError specifies how Velero should behave if the Job fails.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the Job to complete
+	// before considering the hook a failure. Defaults to 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 // BackupResourceHookSpec defines one or more BackupResourceHooks that should be executed based on
@@ -144,6 +217,14 @@ type BackupResourceHookSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// Order is the position of this hook spec in the sequence of hooks executed during the
+	// backup. Pods whose hooks have a lower order are backed up, and their hooks executed,
+	// before pods whose hooks have a higher order, and a pod's hook specs are executed in
+	// increasing order. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Order int `json:"order,omitempty"`
+
 	// PreHooks is a list of BackupResourceHooks to execute prior to storing the item in the backup.
 	// These are executed before any "additional items" from item actions are processed.
 	// +optional
@@ -155,10 +236,20 @@ type BackupResourceHookSpec struct {
 	PostHooks []BackupResourceHook `json:"post,omitempty"`
 }
 
-// BackupResourceHook defines a hook for a resource.
+// BackupResourceHook defines a hook for a resource. Exactly one of Exec, HTTP and Job must be
+// specified.
 type BackupResourceHook struct {
 	// Exec defines an exec hook.
-	Exec *ExecHook `json:"exec"`
+	// +optional
+	Exec *ExecHook `json:"exec,omitempty"`
+
+	// HTTP defines an HTTP hook.
+	// +optional
+	HTTP *HTTPHook `json:"http,omitempty"`
+
+	// Job defines a job hook.
+	// +optional
+	Job *JobHook `json:"job,omitempty"`
 }
 
 // ExecHook is a hook that uses the pod exec API to execute a command in a container in a pod.
@@ -180,6 +271,60 @@ type ExecHook struct {
 	// considering the execution a failure.
 	// +optional
 	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries is the number of times Velero retries the command if it fails. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retries int `json:"retries,omitempty"`
+
+	// RetryBackoff is how long Velero waits before the first retry of a failed command. It
+	// doubles after each retry. Defaults to 1s.
+	// +optional
+	RetryBackoff metav1.Duration `json:"retryBackoff,omitempty"`
+}
+
+// HTTPHook is a hook that sends an HTTP request to a Service through the API server's service
+// proxy, so that neither a shell in the target container nor the pod exec API is needed.
+type HTTPHook struct {
+	// Namespace is the namespace of the Service. Defaults to the namespace of the item the hook
+	// is executed for.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Service is the name of the Service the request is sent to.
+	Service string `json:"service"`
+
+	// Port is the name or number of the Service port the request is sent to. If not specified,
+	// the Service's first port is used.
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// Scheme is the scheme of the request. Defaults to http.
+	// +optional
+	// +kubebuilder:validation:Enum=http;https
+	Scheme string `json:"scheme,omitempty"`
+
+	// Method is the HTTP method of the request. Defaults to GET.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Path is the path of the request. Defaults to /.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// ExpectedStatus is the HTTP status code of a successful response. Defaults to 200.
+	// +optional
+	ExpectedStatus int `json:"expectedStatus,omitempty"`
+
+	// OnError specifies how Velero should behave if the request fails or its response has an
+	// unexpected status.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the response before
+	// considering the hook a failure. Defaults to 30 seconds.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 // HookErrorMode defines how Velero should treat an error from a hook.
@@ -198,7 +343,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;Completed;PartiallyFailed;Failed;Deleting
 type BackupPhase string
 
 const (
@@ -224,6 +369,11 @@ const (
 	// The backup is not usable yet.
 	BackupPhaseUploadingPartialFailure BackupPhase = "UploadingPartialFailure"
 
+	// BackupPhaseWaitingForPluginOperations means the backup's items have been
+	// backed up and uploaded, but asynchronous operations started by backup
+	// item action plugins are still running.
+	BackupPhaseWaitingForPluginOperations BackupPhase = "WaitingForPluginOperations"
+
 	// BackupPhaseCompleted means the backup has run successfully without
 	// errors.
 	BackupPhaseCompleted BackupPhase = "Completed"
@@ -292,6 +442,17 @@ type BackupStatus struct {
 	// +optional
 	VolumeSnapshotsCompleted int `json:"volumeSnapshotsCompleted,omitempty"`
 
+	// HooksAttempted is the total number of hooks executed during the
+	// backup, for pods and backup-wide. The executions are detailed in the backup's hook report
+	// in object storage.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the number of hooks executed during the backup that
+	// failed.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+
 	// Warnings is a count of all warning messages that were generated during
 	// execution of the backup. The actual warnings are in the backup's log
 	// file in object storage.
@@ -310,6 +471,111 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// Replications contains the status of the copies of the backup in its
+	// replication targets.
+	// +optional
+	// +nullable
+	Replications []BackupReplicationStatus `json:"replications,omitempty"`
+
+	// ObjectLock is the lock set on the backup's files in object storage, which
+	// keeps them from being deleted until it expires.
+	// +optional
+	// +nullable
+	ObjectLock *BackupObjectLock `json:"objectLock,omitempty"`
+
+	// PluginOperations lists the asynchronous operations started by backup item
+	// action plugins during the backup.
+	// +optional
+	// +nullable
+	PluginOperations []PluginOperation `json:"pluginOperations,omitempty"`
+
+	// StorageSize is the size of the backup's files in its backup storage location,
+	// measured when they're uploaded.
+	// +optional
+	// +nullable
+	StorageSize *BackupStorageSize `json:"storageSize,omitempty"`
+
+	// HookStatuses contains the results of the backup's pre and post hooks.
+	// +optional
+	// +nullable
+	HookStatuses []BackupHookStatus `json:"hookStatuses,omitempty"`
+}
+
+// BackupHookPhase is when a backup-wide hook is executed.
+// +kubebuilder:validation:Enum=pre;post
+type BackupHookPhase string
+
+const (
+	// BackupHookPhasePre means the hook is executed before any item is backed up.
+	BackupHookPhasePre BackupHookPhase = "pre"
+
+	// BackupHookPhasePost means the hook is executed after all items are backed up.
+	BackupHookPhasePost BackupHookPhase = "post"
+)
+
+// HookResult is the result of executing a hook.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type HookResult string
+
+const (
+	// HookResultSucceeded means the hook completed successfully.
+	HookResultSucceeded HookResult = "Succeeded"
+
+	// HookResultFailed means the hook failed or timed out.
+	HookResultFailed HookResult = "Failed"
+)
+
+// BackupHookStatus is the result of executing a backup-wide hook on one target.
+type BackupHookStatus struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+
+	// Phase is when the hook was executed.
+	Phase BackupHookPhase `json:"phase"`
+
+	// Target is the pod, as <namespace>/<name>, an exec hook was executed in, or the
+	// Job, as <namespace>/<name>, a job hook created.
+	// +optional
+	Target string `json:"target,omitempty"`
+
+	// Result is the result of the hook.
+	Result HookResult `json:"result"`
+
+	// StartTimestamp records the time the hook was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the hook completed or failed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Message is a description of the error of a failed hook.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupStorageSize is the size, in bytes, of the files of a backup stored in
+// a backup storage location.
+type BackupStorageSize struct {
+	// Contents is the size of the backup's tarball.
+	// +optional
+	Contents int64 `json:"contents,omitempty"`
+
+	// Log is the size of the backup's log file.
+	// +optional
+	Log int64 `json:"log,omitempty"`
+
+	// Metadata is the size of the backup's other files, such as its resource list
+	// and volume snapshot lists, except for the backup's own metadata file.
+	// +optional
+	Metadata int64 `json:"metadata,omitempty"`
+
+	// Total is the size of all of the backup's files above.
+	// +optional
+	Total int64 `json:"total,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -327,6 +593,76 @@ type BackupProgress struct {
 	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
 }
 
+// BackupReplicationPhase is a string representation of the lifecycle phase
+// of the copy of a Velero backup in a replication target.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed
+type BackupReplicationPhase string
+
+const (
+	// BackupReplicationPhaseInProgress means the backup is being copied
+	// to the replication target.
+	BackupReplicationPhaseInProgress BackupReplicationPhase = "InProgress"
+
+	// BackupReplicationPhaseCompleted means the backup has been copied
+	// to the replication target and the copy has been verified.
+	BackupReplicationPhaseCompleted BackupReplicationPhase = "Completed"
+
+	// BackupReplicationPhaseFailed means the backup couldn't be copied
+	// to the replication target. The copy is retried periodically.
+	BackupReplicationPhaseFailed BackupReplicationPhase = "Failed"
+)
+
+// BackupReplicationStatus captures the status of the copy of a backup in
+// a replication target.
+type BackupReplicationStatus struct {
+	// Location is the name of the BackupStorageLocation the backup is copied to.
+	Location string `json:"location"`
+
+	// Phase is the current state of the copy.
+	// +optional
+	Phase BackupReplicationPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the last attempt to copy the backup
+	// was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the last attempt to copy the
+	// backup ended, whether it succeeded or failed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Message is a description of the error of the last failed attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObjectLock is the lock set on the files of the copy, if the replication
+	// target is an immutable backup storage location.
+	// +optional
+	// +nullable
+	ObjectLock *BackupObjectLock `json:"objectLock,omitempty"`
+}
+
+// BackupObjectLock describes the lock set on the files of a backup stored in an
+// immutable backup storage location.
+type BackupObjectLock struct {
+	// Mode is the retention mode of the lock.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// RetainUntil is the time the lock expires.
+	// +optional
+	// +nullable
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+
+	// LegalHold indicates the files are under a legal hold, which keeps them
+	// locked after RetainUntil until the hold is removed in the object store.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
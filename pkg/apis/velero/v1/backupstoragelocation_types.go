@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -54,8 +55,81 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// ReplicationTargets is a list of names of BackupStorageLocations the backups
+	// stored in this location are copied to once they complete.
+	// +optional
+	// +nullable
+	ReplicationTargets []string `json:"replicationTargets,omitempty"`
+
+	// Immutability makes the backups stored in this location immutable: their files are
+	// locked in object storage, so they can't be deleted or overwritten until the lock
+	// expires. The location's object store plugin must support object locks.
+	// +optional
+	// +nullable
+	Immutability *BackupStorageLocationImmutability `json:"immutability,omitempty"`
+
+	// PluginTimeout is how long each call to the location's object store plugin may
+	// take before it's canceled. Uploads and downloads are canceled when they go this
+	// long without progress. Defaults to the Velero server's ObjectStore plugin timeout,
+	// which is none unless configured.
+	// +optional
+	// +nullable
+	PluginTimeout *metav1.Duration `json:"pluginTimeout,omitempty"`
+
+	// Quota limits the space and number of backups Velero may use in the location.
+	// New backups to the location fail validation once its usage, as reported in
+	// its status, reaches the quota.
+	// +optional
+	// +nullable
+	Quota *BackupStorageLocationQuota `json:"quota,omitempty"`
+}
+
+// BackupStorageLocationQuota defines limits on the backups stored in a backup
+// storage location. A limit that isn't set isn't enforced.
+type BackupStorageLocationQuota struct {
+	// Bytes is the most space the backups stored in the location may take up.
+	// +optional
+	// +nullable
+	Bytes *resource.Quantity `json:"bytes,omitempty"`
+
+	// Backups is the most backups the location may store.
+	// +optional
+	// +nullable
+	Backups *int `json:"backups,omitempty"`
 }
 
+// BackupStorageLocationImmutability defines the locks set on the files of the backups
+// stored in a backup storage location.
+type BackupStorageLocationImmutability struct {
+	// Mode is the retention mode of the locks. Defaults to Governance.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// RetentionPeriod is how long the files of a backup are locked after the backup
+	// completes.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
+
+	// LegalHold places a legal hold on the files of the backups, which keeps them locked
+	// until the hold is removed in the object store, regardless of their retention period.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
+// ObjectLockMode is the retention mode of an object lock.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means the lock can be removed, or its retention
+	// shortened, by object store users with special permissions.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance means the lock can't be removed, nor its retention
+	// shortened, by any object store user.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -88,6 +162,15 @@ type BackupStorageLocationStatus struct {
 	// will be removed entirely as of v2.0.
 	// +optional
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
+
+	// BackupCount is the number of backups stored in the location.
+	// +optional
+	BackupCount int `json:"backupCount,omitempty"`
+
+	// TotalBackupBytes is the space the backups stored in the location take up,
+	// as recorded in their status.
+	// +optional
+	TotalBackupBytes int64 `json:"totalBackupBytes,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
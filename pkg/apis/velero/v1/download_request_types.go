@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;RestoreLog;RestoreResults
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupResourceList;BackupHookReport;BackupContentIndex;RestoreLog;RestoreResults;RestoreHookReport;RestoreItemReport
 type DownloadTargetKind string
 
 const (
@@ -33,8 +33,12 @@ const (
 	DownloadTargetKindBackupContents        DownloadTargetKind = "BackupContents"
 	DownloadTargetKindBackupVolumeSnapshots DownloadTargetKind = "BackupVolumeSnapshots"
 	DownloadTargetKindBackupResourceList    DownloadTargetKind = "BackupResourceList"
+	DownloadTargetKindBackupHookReport      DownloadTargetKind = "BackupHookReport"
+	DownloadTargetKindBackupContentIndex    DownloadTargetKind = "BackupContentIndex"
 	DownloadTargetKindRestoreLog            DownloadTargetKind = "RestoreLog"
 	DownloadTargetKindRestoreResults        DownloadTargetKind = "RestoreResults"
+	DownloadTargetKindRestoreHookReport     DownloadTargetKind = "RestoreHookReport"
+	DownloadTargetKindRestoreItemReport     DownloadTargetKind = "RestoreItemReport"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
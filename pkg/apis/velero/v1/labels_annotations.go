@@ -62,4 +62,16 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// SourceClusterHostAnnotation is the annotation key used to identify the host
+	// of the cluster a backup was taken from, if it's not the cluster Velero runs in.
+	SourceClusterHostAnnotation = "velero.io/source-cluster-host"
+
+	// BackupOwnerAnnotation is the annotation key used to identify the Velero server
+	// running an in-progress backup.
+	BackupOwnerAnnotation = "velero.io/backup-owner"
+
+	// BackupHeartbeatAnnotation is the annotation key used to record when the Velero
+	// server running an in-progress backup last reported that it's still running it.
+	BackupHeartbeatAnnotation = "velero.io/backup-heartbeat"
 )
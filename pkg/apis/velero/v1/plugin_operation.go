@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// PluginOperationPhase is a string representation of the lifecycle phase
+// of an asynchronous operation started by a plugin.
+// +kubebuilder:validation:Enum=InProgress;Completed;Failed;Canceled
+type PluginOperationPhase string
+
+const (
+	// PluginOperationPhaseInProgress means the operation is still running.
+	PluginOperationPhaseInProgress PluginOperationPhase = "InProgress"
+
+	// PluginOperationPhaseCompleted means the plugin reported that the
+	// operation finished successfully.
+	PluginOperationPhaseCompleted PluginOperationPhase = "Completed"
+
+	// PluginOperationPhaseFailed means the plugin reported that the
+	// operation failed, or its progress couldn't be checked.
+	PluginOperationPhaseFailed PluginOperationPhase = "Failed"
+
+	// PluginOperationPhaseCanceled means Velero canceled the operation,
+	// because it didn't finish in time.
+	PluginOperationPhaseCanceled PluginOperationPhase = "Canceled"
+)
+
+// PluginOperation is an asynchronous operation that a backup or restore item
+// action plugin started for an item, and that must finish before the backup
+// or restore is complete.
+type PluginOperation struct {
+	// PluginName is the name of the item action that started the operation.
+	PluginName string `json:"pluginName"`
+
+	// PluginKind is the kind of plugin that started the operation. It's empty
+	// for operations started by item actions.
+	// +optional
+	PluginKind string `json:"pluginKind,omitempty"`
+
+	// OperationID is the ID the plugin returned for the operation.
+	OperationID string `json:"operationID"`
+
+	// Resource is the group-resource of the item the operation was started for.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the item the operation was started for.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item the operation was started for.
+	Name string `json:"name"`
+
+	// Phase is the current state of the operation.
+	// +optional
+	Phase PluginOperationPhase `json:"phase,omitempty"`
+
+	// Error is the error reported for a failed or canceled operation.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// NCompleted is the number of units of work of the operation that are done.
+	// +optional
+	NCompleted int64 `json:"nCompleted,omitempty"`
+
+	// NTotal is the total number of units of work of the operation, if known.
+	// +optional
+	NTotal int64 `json:"nTotal,omitempty"`
+
+	// OperationUnits is the unit of work NCompleted and NTotal are counted in.
+	// +optional
+	OperationUnits string `json:"operationUnits,omitempty"`
+
+	// Description is a description of the current state of the operation.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Created records the time the operation was started.
+	// +optional
+	// +nullable
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// Updated records the last time Velero checked the progress of the operation.
+	// +optional
+	// +nullable
+	Updated *metav1.Time `json:"updated,omitempty"`
+}
+
+// PluginOperationsInProgress returns whether any of operations is still in progress.
+func PluginOperationsInProgress(operations []PluginOperation) bool {
+	for _, op := range operations {
+		if op.Phase == PluginOperationPhaseInProgress {
+			return true
+		}
+	}
+	return false
+}
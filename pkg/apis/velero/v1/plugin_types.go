@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.image"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+
+// Plugin is a Velero plugin that's installed from an image while the Velero
+// server is running.
+type Plugin struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec PluginSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status PluginInstallStatus `json:"status,omitempty"`
+}
+
+// PluginSpec is the specification for a Plugin.
+type PluginSpec struct {
+	// Image is the image that contains the plugin's binaries in its /plugins
+	// directory, without a tag or digest, like "velero/velero-plugin-for-aws".
+	Image string `json:"image"`
+
+	// Digest is the digest of the image's manifest, or of its index for a
+	// multi-platform image, like "sha256:4b3e...".
+	// +kubebuilder:validation:Pattern="^sha256:[a-f0-9]{64}$"
+	Digest string `json:"digest"`
+
+	// ImagePullSecret is the name of a secret of type kubernetes.io/dockerconfigjson
+	// in the Velero namespace, with the credentials to pull the image.
+	// +optional
+	ImagePullSecret string `json:"imagePullSecret,omitempty"`
+}
+
+// PluginPhase represents the lifecycle phase of a Plugin.
+// +kubebuilder:validation:Enum=New;Installed;Failed
+type PluginPhase string
+
+const (
+	// PluginPhaseNew means the Plugin has not been installed yet.
+	PluginPhaseNew PluginPhase = "New"
+
+	// PluginPhaseInstalled means the Plugin's binaries are in the plugin directory
+	// of the Velero server, and their plugins are registered.
+	PluginPhaseInstalled PluginPhase = "Installed"
+
+	// PluginPhaseFailed means the Plugin couldn't be installed. Velero retries
+	// installing it periodically.
+	PluginPhaseFailed PluginPhase = "Failed"
+)
+
+// PluginInstallStatus is the current status of a Plugin.
+type PluginInstallStatus struct {
+	// Phase is the current lifecycle phase of the Plugin.
+	// +optional
+	Phase PluginPhase `json:"phase,omitempty"`
+
+	// Message is why the Plugin couldn't be installed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// InstalledDigest is the digest of the image that's installed.
+	// +optional
+	InstalledDigest string `json:"installedDigest,omitempty"`
+
+	// InstalledTimestamp is when the Plugin's image was installed.
+	// +optional
+	// +nullable
+	InstalledTimestamp *metav1.Time `json:"installedTimestamp,omitempty"`
+
+	// Binaries lists the plugin binaries that were installed from the image,
+	// relative to its /plugins directory.
+	// +optional
+	// +nullable
+	Binaries []string `json:"binaries,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=plugins,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=plugins/status,verbs=get;update;patch
+
+// PluginList is a list of Plugins.
+type PluginList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Plugin `json:"items"`
+}
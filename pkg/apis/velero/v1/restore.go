@@ -92,6 +92,60 @@ type RestoreSpec struct {
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// ReadinessGates are conditions the restore waits for once the items of a resource are
+	// restored, before restoring the items of the next resources.
+	// +optional
+	ReadinessGates []RestoreReadinessGate `json:"readinessGates,omitempty"`
+
+	// RetryOf is the name of a PartiallyFailed restore of the same backup whose
+	// failed items this restore retries. If specified, only the items recorded
+	// as failed in that restore's item report are restored.
+	// +optional
+	RetryOf string `json:"retryOf,omitempty"`
+}
+
+// RestoreReadinessGate makes a restore wait, once the items of a resource are restored, until the
+// restored items of some resources are ready.
+type RestoreReadinessGate struct {
+	// Name is the name of this gate.
+	Name string `json:"name"`
+
+	// AfterResource is the resource, such as customresourcedefinitions, after whose items are
+	// restored the restore waits.
+	AfterResource string `json:"afterResource"`
+
+	// Resources are the resources whose restored items must be ready. Defaults to AfterResource.
+	// CustomResourceDefinitions are ready once established, APIServices once available, Pods
+	// once ready, and Deployments, StatefulSets and DaemonSets once all their replicas are
+	// ready. Other items are ready once their Ready or Available condition, if they have either,
+	// is True.
+	// +optional
+	// +nullable
+	Resources []string `json:"resources,omitempty"`
+
+	// IncludedNamespaces restricts the items that must be ready to the ones restored into these
+	// namespaces. If empty, items restored into any namespace, and cluster-scoped items, must be
+	// ready.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// Condition, if specified, is the type of the condition in the items' status.conditions
+	// that must be True for them to be ready, instead of the default readiness checks.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+
+	// Timeout is how long the restore waits for the items to be ready. Defaults to 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// OnError specifies how the restore treats items that aren't ready in time: Continue records
+	// a warning and the restore goes on, and Fail records an error and stops the restore before
+	// the next resources are restored, which makes the restore PartiallyFailed. Defaults to
+	// Continue.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
@@ -132,6 +186,14 @@ type RestoreResourceHookSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// Order is the position of this hook spec in the sequence of post hooks executed during
+	// the restore. The hooks of pods whose hooks have a higher order are only executed once the
+	// hooks of all restored pods whose hooks have a lower order are done, and a pod's hook specs
+	// are executed in increasing order. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Order int `json:"order,omitempty"`
+
 	// PostHooks is a list of RestoreResourceHooks to execute during and after restoring a resource.
 	// +optional
 	PostHooks []RestoreResourceHook `json:"postHooks,omitempty"`
@@ -144,6 +206,57 @@ type RestoreResourceHook struct {
 
 	// Init defines an init restore hook.
 	Init *InitRestoreHook `json:"init,omitempty"`
+
+	// HTTP defines an HTTP hook executed once the pod is running.
+	// +optional
+	HTTP *HTTPHook `json:"http,omitempty"`
+
+	// Job defines a job hook executed once the pod is running.
+	// +optional
+	Job *JobHook `json:"job,omitempty"`
+
+	// ResourceReady defines a hook executed once a restored resource of any kind, such as a
+	// Deployment, a StatefulSet or a custom resource, is ready.
+	// +optional
+	ResourceReady *ResourceReadyRestoreHook `json:"resourceReady,omitempty"`
+}
+
+// ResourceReadyRestoreHook is a hook that waits for a restored resource to be ready, then executes
+// a command in a container of a pod selected among the pods in the resource's namespace. Unlike
+// other restore hooks, it applies to the resources of its hook spec's IncludedResources, which
+// must be specified, rather than to pods.
+type ResourceReadyRestoreHook struct {
+	// Condition is the type of a condition in the resource's status.conditions, such as
+	// Available, whose status must be ConditionStatus for the resource to be ready.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+
+	// ConditionStatus is the status Condition must have for the resource to be ready. Defaults
+	// to True.
+	// +optional
+	ConditionStatus string `json:"conditionStatus,omitempty"`
+
+	// FieldPath is the dot-separated path of a field of the resource, such as status.phase, whose
+	// value must be FieldValue for the resource to be ready. If neither Condition nor FieldPath
+	// are specified, the resource is ready once it's restored.
+	// +optional
+	FieldPath string `json:"fieldPath,omitempty"`
+
+	// FieldValue is the value the field at FieldPath must have for the resource to be ready.
+	// +optional
+	FieldValue string `json:"fieldValue,omitempty"`
+
+	// PodSelector selects the pods in the resource's namespace in which the command may be
+	// executed. The command is executed in the running pod with the lowest name. Defaults to the
+	// resource's spec.selector, such as the selector of a Deployment or a StatefulSet.
+	// +optional
+	// +nullable
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Exec is the command executed once the resource is ready. Its WaitTimeout is how long
+	// Velero waits for the resource to be ready and for a selected pod to be running, and its
+	// OnError also applies to the resource not becoming ready in time.
+	Exec ExecRestoreHook `json:"exec"`
 }
 
 // ExecRestoreHook is a hook that uses pod exec API to execute a command inside a container in a pod
@@ -170,6 +283,16 @@ type ExecRestoreHook struct {
 	// before attempting to run the command.
 	// +optional
 	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+
+	// Retries is the number of times Velero retries the command if it fails. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retries int `json:"retries,omitempty"`
+
+	// RetryBackoff is how long Velero waits before the first retry of a failed command. It
+	// doubles after each retry. Defaults to 1s.
+	// +optional
+	RetryBackoff metav1.Duration `json:"retryBackoff,omitempty"`
 }
 
 // InitRestoreHook is a hook that adds an init container to a PodSpec to run commands before the
@@ -186,7 +309,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;Completed;PartiallyFailed;Failed
 type RestorePhase string
 
 const (
@@ -201,6 +324,11 @@ const (
 	// RestorePhaseInProgress means the restore is currently executing.
 	RestorePhaseInProgress RestorePhase = "InProgress"
 
+	// RestorePhaseWaitingForPluginOperations means the restore's items have
+	// been restored, but asynchronous operations started by restore item
+	// action plugins are still running.
+	RestorePhaseWaitingForPluginOperations RestorePhase = "WaitingForPluginOperations"
+
 	// RestorePhaseCompleted means the restore has run successfully
 	// without errors.
 	RestorePhaseCompleted RestorePhase = "Completed"
@@ -236,6 +364,17 @@ type RestoreStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// HooksAttempted is the total number of hooks executed for pods during
+	// the restore. The executions are detailed in the restore's hook report
+	// in object storage.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the number of hooks executed for pods during the restore
+	// that failed.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
@@ -259,6 +398,22 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// PluginOperations lists the asynchronous operations started by restore item
+	// action plugins during the restore.
+	// +optional
+	// +nullable
+	PluginOperations []PluginOperation `json:"pluginOperations,omitempty"`
+
+	// RetriedItems is the number of failed items of the restore named by
+	// RetryOf that this restore retried.
+	// +optional
+	RetriedItems int `json:"retriedItems,omitempty"`
+
+	// RetriedBy lists the restores that retried this restore's failed items.
+	// +optional
+	// +nullable
+	RetriedBy []string `json:"retriedBy,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
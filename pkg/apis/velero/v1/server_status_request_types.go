@@ -64,8 +64,42 @@ const (
 type PluginInfo struct {
 	Name string `json:"name"`
 	Kind string `json:"kind"`
+
+	// Capabilities lists the optional features the plugin reported that it supports.
+	// +optional
+	// +nullable
+	Capabilities []string `json:"capabilities,omitempty"`
+
+	// Status is the health of the plugin's process.
+	// +optional
+	Status PluginStatus `json:"status,omitempty"`
+
+	// Restarts is the number of times the plugin's process was restarted after it
+	// crashed or failed a health check.
+	// +optional
+	Restarts int `json:"restarts,omitempty"`
+
+	// LastError is the reason the plugin's process was last restarted or failed.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
 }
 
+// PluginStatus is the health of a plugin's process.
+// +kubebuilder:validation:Enum=Healthy;Restarting;Failed
+type PluginStatus string
+
+const (
+	// PluginStatusHealthy means the plugin's process is running and passed its last health check.
+	PluginStatusHealthy PluginStatus = "Healthy"
+
+	// PluginStatusRestarting means the plugin's process crashed or failed a health check,
+	// and is being restarted.
+	PluginStatusRestarting PluginStatus = "Restarting"
+
+	// PluginStatusFailed means the plugin's process couldn't be restarted.
+	PluginStatusFailed PluginStatus = "Failed"
+)
+
 // ServerStatusRequestStatus is the current status of a ServerStatusRequest.
 type ServerStatusRequestStatus struct {
 	// Phase is the current lifecycle phase of the ServerStatusRequest.
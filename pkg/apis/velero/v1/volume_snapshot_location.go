@@ -55,6 +55,13 @@ type VolumeSnapshotLocationSpec struct {
 	// Config is for provider-specific configuration fields.
 	// +optional
 	Config map[string]string `json:"config,omitempty"`
+
+	// PluginTimeout is how long each call to the location's volume snapshotter plugin
+	// may take before it's canceled. Defaults to the Velero server's VolumeSnapshotter
+	// plugin timeout, which is none unless configured.
+	// +optional
+	// +nullable
+	PluginTimeout *metav1.Duration `json:"pluginTimeout,omitempty"`
 }
 
 // VolumeSnapshotLocationPhase is the lifecycle phase of a Velero VolumeSnapshotLocation.
@@ -26,17 +26,21 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -45,8 +49,10 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
@@ -60,8 +66,8 @@ const BackupFormatVersion = "1.1.0"
 // Backupper performs backups.
 type Backupper interface {
 	// Backup takes a backup using the specification in the velerov1api.Backup and writes backup and log data
-	// to the given writers.
-	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
+	// to the given writers. The backup's spans are children of the span in ctx.
+	Backup(ctx context.Context, logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
 	SrcClusterHost() string
 }
 
@@ -71,20 +77,48 @@ type kubernetesBackupper struct {
 	dynamicFactory         client.DynamicFactory
 	discoveryHelper        discovery.Helper
 	podCommandExecutor     podexec.PodCommandExecutor
+	httpHookExecutor       podexec.HTTPHookExecutor
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
 	defaultVolumesToRestic bool
-	srcClusterHost         string
+	checkpointInterval     time.Duration
+	eventRecorder          record.EventRecorder
+	srcEventRecorder       record.EventRecorder
+	srcCluster             client.ClusterContextProvider
 }
 
 type resolvedAction struct {
-	velero.BackupItemAction
+	biav2.BackupItemAction
 
 	resourceIncludesExcludes  *collections.IncludesExcludes
 	namespaceIncludesExcludes *collections.IncludesExcludes
 	selector                  labels.Selector
 }
 
+// resolvedItemSnapshotter is an ItemSnapshotter with the selectors it applies to resolved.
+type resolvedItemSnapshotter struct {
+	velero.ItemSnapshotter
+
+	name                      string
+	resourceIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes *collections.IncludesExcludes
+	selector                  labels.Selector
+}
+
+// appliesTo returns whether the snapshotter should be invoked for an item.
+func (s *resolvedItemSnapshotter) appliesTo(groupResource schema.GroupResource, namespace string, itemLabels map[string]string) bool {
+	if !s.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return false
+	}
+	if namespace != "" && !s.namespaceIncludesExcludes.ShouldInclude(namespace) {
+		return false
+	}
+	if namespace == "" && !s.namespaceIncludesExcludes.IncludeEverything() {
+		return false
+	}
+	return s.selector.Matches(labels.Set(itemLabels))
+}
+
 func (i *itemKey) String() string {
 	return fmt.Sprintf("resource=%s,namespace=%s,name=%s", i.resource, i.namespace, i.name)
 }
@@ -105,24 +139,32 @@ func NewKubernetesBackupper(
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
 	podCommandExecutor podexec.PodCommandExecutor,
+	httpHookExecutor podexec.HTTPHookExecutor,
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
 	defaultVolumesToRestic bool,
-	srcClusterHost string,
+	checkpointInterval time.Duration,
+	eventRecorder record.EventRecorder,
+	srcEventRecorder record.EventRecorder,
+	srcCluster client.ClusterContextProvider,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		backupClient:           backupClient,
 		discoveryHelper:        discoveryHelper,
 		dynamicFactory:         dynamicFactory,
 		podCommandExecutor:     podCommandExecutor,
+		httpHookExecutor:       httpHookExecutor,
 		resticBackupperFactory: resticBackupperFactory,
 		resticTimeout:          resticTimeout,
 		defaultVolumesToRestic: defaultVolumesToRestic,
-		srcClusterHost:         srcClusterHost,
+		checkpointInterval:     checkpointInterval,
+		eventRecorder:          eventRecorder,
+		srcEventRecorder:       srcEventRecorder,
+		srcCluster:             srcCluster,
 	}, nil
 }
 
-func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper) ([]resolvedAction, error) {
+func resolveActions(actions []biav2.BackupItemAction, helper discovery.Helper) ([]resolvedAction, error) {
 	var resolved []resolvedAction
 
 	for _, action := range actions {
@@ -154,6 +196,42 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 	return resolved, nil
 }
 
+// resolveItemSnapshotters resolves the selectors of the item snapshotters, sorted by name
+// so that they're invoked in a consistent order.
+func resolveItemSnapshotters(snapshotters map[string]velero.ItemSnapshotter, helper discovery.Helper) ([]resolvedItemSnapshotter, error) {
+	names := make([]string, 0, len(snapshotters))
+	for name := range snapshotters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var resolved []resolvedItemSnapshotter
+	for _, name := range names {
+		snapshotter := snapshotters[name]
+		resourceSelector, err := snapshotter.AppliesTo()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the resources item snapshotter %s applies to", name)
+		}
+
+		selector := labels.Everything()
+		if resourceSelector.LabelSelector != "" {
+			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+
+		resolved = append(resolved, resolvedItemSnapshotter{
+			ItemSnapshotter:           snapshotter,
+			name:                      name,
+			resourceIncludesExcludes:  collections.GetResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources),
+			namespaceIncludesExcludes: collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...),
+			selector:                  selector,
+		})
+	}
+
+	return resolved, nil
+}
+
 // getNamespaceIncludesExcludes returns an IncludesExcludes list containing which namespaces to
 // include and exclude from the backup.
 func getNamespaceIncludesExcludes(backup *velerov1api.Backup) *collections.IncludesExcludes {
@@ -177,7 +255,8 @@ func getResourceHooks(hookSpecs []velerov1api.BackupResourceHookSpec, discoveryH
 
 func getResourceHook(hookSpec velerov1api.BackupResourceHookSpec, discoveryHelper discovery.Helper) (hook.ResourceHook, error) {
 	h := hook.ResourceHook{
-		Name: hookSpec.Name,
+		Name:  hookSpec.Name,
+		Order: hookSpec.Order,
 		Selector: hook.ResourceHookSelector{
 			Namespaces: collections.NewIncludesExcludes().Includes(hookSpec.IncludedNamespaces...).Excludes(hookSpec.ExcludedNamespaces...),
 			Resources:  collections.GetResourceIncludesExcludes(discoveryHelper, hookSpec.IncludedResources, hookSpec.ExcludedResources),
@@ -206,7 +285,15 @@ type VolumeSnapshotterGetter interface {
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
-func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+func (kb *kubernetesBackupper) Backup(ctx context.Context, log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) (err error) {
+	ctx, span := tracing.Start(ctx, "kubernetesBackupper.Backup")
+	defer func() {
+		if backupRequest.BackedUpItems != nil {
+			span.SetAttributes(attribute.Int("velero.backup.items", len(backupRequest.BackedUpItems)))
+		}
+		tracing.End(span, err)
+	}()
+
 	gzippedData := gzip.NewWriter(backupFile)
 	defer gzippedData.Close()
 
@@ -219,7 +306,7 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	}
 
 	if kb.SrcClusterHost() != "" {
-		log.Info("Using source cluster at %s", kb.srcClusterHost)
+		log.Info("Using source cluster at %s", kb.srcCluster.Host())
 	} else {
 		log.Info("Using local cluster for source")
 	}
@@ -233,7 +320,6 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 	log.Infof("Backing up all pod volumes using restic: %t", *backupRequest.Backup.Spec.DefaultVolumesToRestic)
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		return err
@@ -244,7 +330,21 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return err
 	}
 
+	backupRequest.ResolvedItemSnapshotters, err = resolveItemSnapshotters(backupRequest.ItemSnapshotters, kb.discoveryHelper)
+	if err != nil {
+		return err
+	}
+
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.BackedUpItemSizes = map[itemKey]int64{}
+	backupRequest.BackedUpVolumeSizes = map[itemKey]resource.Quantity{}
+
+	var resumedItems map[itemKey]struct{}
+	if backupRequest.ResumeFrom != nil {
+		resumedItems = backupRequest.ResumeFrom.backedUpItems()
+		log.Infof("Resuming backup from a checkpoint with %d items, %d volume snapshots and %d pod volume backups",
+			len(resumedItems), len(backupRequest.ResumeFrom.VolumeSnapshots), len(backupRequest.ResumeFrom.PodVolumeBackups))
+	}
 
 	podVolumeTimeout := kb.resticTimeout
 	if val := backupRequest.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
@@ -256,12 +356,12 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	resticCtx, cancelFunc := context.WithTimeout(ctx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper restic.Backupper
 	if kb.resticBackupperFactory != nil {
-		resticBackupper, err = kb.resticBackupperFactory.NewBackupper(ctx, backupRequest.Backup)
+		resticBackupper, err = kb.resticBackupperFactory.NewBackupper(resticCtx, backupRequest.Backup)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -284,7 +384,31 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		dir:                   tempDir,
 	}
 
-	items := collector.getAllItems()
+	backupHookHandler := &hook.BackupHookHandler{
+		PodCommandExecutor: kb.podCommandExecutor,
+		DynamicFactory:     kb.dynamicFactory,
+		Report:             backupRequest.HookReport,
+	}
+	if len(backupRequest.Spec.Hooks.BackupPost) > 0 {
+		defer func() {
+			log.Info("Executing backup post hooks")
+			statuses, hookErr := backupHookHandler.HandleHooks(log, backupRequest.Backup, velerov1api.BackupHookPhasePost)
+			backupRequest.Status.HookStatuses = append(backupRequest.Status.HookStatuses, statuses...)
+			if hookErr != nil && err == nil {
+				err = hookErr
+			}
+		}()
+	}
+	if len(backupRequest.Spec.Hooks.BackupPre) > 0 {
+		log.Info("Executing backup pre hooks")
+		statuses, err := backupHookHandler.HandleHooks(log, backupRequest.Backup, velerov1api.BackupHookPhasePre)
+		backupRequest.Status.HookStatuses = append(backupRequest.Status.HookStatuses, statuses...)
+		if err != nil {
+			return err
+		}
+	}
+
+	items := collector.getAllItems(ctx)
 	log.WithField("progress", "").Infof("Collected %d items matching the backup spec from the Kubernetes API (actual number of items backed up may be more or less depending on velero.io/exclude-from-backup annotation, plugins returning additional related items to back up, etc.)", len(items))
 
 	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: len(items)}
@@ -293,6 +417,10 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		log.WithError(errors.WithStack((err))).Warn("Got error trying to update backup's status.progress.totalItems")
 	}
 
+	if backupRequest.HookReport == nil {
+		backupRequest.HookReport = hook.NewExecutionReport()
+	}
+
 	itemBackupper := &itemBackupper{
 		backupRequest:           backupRequest,
 		tarWriter:               tw,
@@ -303,7 +431,14 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
+			HTTPHookExecutor:   kb.httpHookExecutor,
+			DynamicFactory:     kb.dynamicFactory,
+			Report:             backupRequest.HookReport,
 		},
+		resumedItems:     resumedItems,
+		eventRecorder:    kb.eventRecorder,
+		srcEventRecorder: kb.srcEventRecorder,
+		srcCluster:       kb.srcCluster,
 	}
 
 	// helper struct to send current progress between the main
@@ -354,6 +489,7 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 	backedUpGroupResources := map[schema.GroupResource]bool{}
 	totalItems := len(items)
+	lastCheckpoint := time.Now()
 
 	for i, item := range items {
 		log.WithFields(map[string]interface{}{
@@ -381,7 +517,7 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 				return
 			}
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
+			if backedUp := kb.backupItem(ctx, log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
 				backedUpGroupResources[item.groupResource] = true
 			}
 		}()
@@ -402,6 +538,11 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 			"namespace": item.namespace,
 			"name":      item.name,
 		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+
+		if backupRequest.Checkpointer != nil && kb.checkpointInterval > 0 && time.Since(lastCheckpoint) >= kb.checkpointInterval {
+			kb.checkpoint(log, backupRequest)
+			lastCheckpoint = time.Now()
+		}
 	}
 
 	// no more progress updates will be sent on the 'update' channel
@@ -412,7 +553,7 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	// we don't want to back it up, and if it's true it will already be included.
 	if backupRequest.Spec.IncludeClusterResources == nil {
 		for gr := range backedUpGroupResources {
-			kb.backupCRD(log, gr, itemBackupper)
+			kb.backupCRD(ctx, log, gr, itemBackupper)
 		}
 	}
 
@@ -431,8 +572,18 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	return nil
 }
 
-func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
-	backedUpItem, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR)
+// checkpoint persists the current progress of the backup so that it can be resumed if the
+// server restarts before the backup completes. Failing to persist a checkpoint doesn't
+// affect the backup itself, so errors are only logged.
+func (kb *kubernetesBackupper) checkpoint(log logrus.FieldLogger, backupRequest *Request) {
+	log.Debug("Checkpointing backup progress")
+	if err := backupRequest.Checkpointer.Checkpoint(backupRequest.checkpoint()); err != nil {
+		log.WithError(err).Warn("Error checkpointing backup progress")
+	}
+}
+
+func (kb *kubernetesBackupper) backupItem(ctx context.Context, log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
+	backedUpItem, err := itemBackupper.backupItem(ctx, log, unstructured, gr, preferredGVR)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
 		log.WithField("name", unstructured.GetName()).Infof("%d errors encountered backup up item", len(aggregate.Errors()))
 		// log each error separately so we get error location info in the log, and an
@@ -452,7 +603,7 @@ func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.Grou
 
 // backupCRD checks if the resource is a custom resource, and if so, backs up the custom resource definition
 // associated with it.
-func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper) {
+func (kb *kubernetesBackupper) backupCRD(ctx context.Context, log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper) {
 	crdGroupResource := kuberesource.CustomResourceDefinitions
 
 	log.Debugf("Getting server preferred API version for %s", crdGroupResource)
@@ -485,7 +636,7 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 	}
 	log.Infof("Found associated CRD %s to add to backup", gr.String())
 
-	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
+	kb.backupItem(ctx, log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
 }
 
 func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
@@ -509,7 +660,7 @@ func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
 }
 
 func (kb *kubernetesBackupper) SrcClusterHost() string {
-	return kb.srcClusterHost
+	return kb.srcCluster.Host()
 }
 
 type tarWriter interface {
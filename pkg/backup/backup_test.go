@@ -35,11 +35,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -47,6 +55,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
@@ -85,7 +94,7 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 	// go through BackedUpItems after the backup to assemble the list of files we
 	// expect to see in the tarball and compare to see if they match
@@ -114,6 +123,48 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
 }
 
+// TestBackupContentIndex verifies that the content index of a backup counts the items
+// written to the tarball, by group-resource and namespace, and records volume sizes.
+func TestBackupContentIndex(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	pv := builder.ForPersistentVolume("pv-1").Result()
+	pv.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}
+	pvc := builder.ForPersistentVolumeClaim("foo", "pvc-1").VolumeName("pv-1").Result()
+	pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")}
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("foo", "baz").Result(),
+			builder.ForPod("zoo", "raz").Result(),
+		),
+		test.PVCs(pvc),
+		test.PVs(pv),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
+
+	index := req.ContentIndex()
+	var counts []string
+	for _, contents := range index.Resources {
+		assert.True(t, contents.Bytes > 0, "%s in namespace %q has no bytes", contents.Resource, contents.Namespace)
+		counts = append(counts, fmt.Sprintf("%s/%s=%d", contents.Resource, contents.Namespace, contents.Items))
+	}
+	assert.Equal(t, []string{"persistentvolumeclaims/foo=1", "persistentvolumes/=1", "pods/foo=2", "pods/zoo=1"}, counts)
+	assert.Equal(t, 5, index.TotalItems())
+
+	assert.Equal(t, []VolumeContents{
+		{Resource: "persistentvolumeclaims", Namespace: "foo", Name: "pvc-1", Size: "5Gi", Bytes: 5 * 1024 * 1024 * 1024},
+		{Resource: "persistentvolumes", Name: "pv-1", Size: "10Gi", Bytes: 10 * 1024 * 1024 * 1024},
+	}, index.Volumes)
+}
+
 // TestBackupProgressIsUpdated verifies that after a backup has run, its
 // status.progress fields are updated to reflect the total number of items
 // backed up. It validates this by comparing their values to the length of
@@ -141,13 +192,466 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 	require.NotNil(t, req.Status.Progress)
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.TotalItems)
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.ItemsBackedUp)
 }
 
+// TestBackupRecordsPluginOperations verifies that when a backup item action
+// starts an asynchronous operation, the operation is recorded in the backup's
+// status so it can be tracked to completion.
+func TestBackupRecordsPluginOperations(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+	h.addItems(t, test.PVs(builder.ForPersistentVolume("pv-1").Result()))
+
+	action := new(recordResourcesAction).ForResource("pods").WithOperationID("op-1")
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, []biav2.BackupItemAction{action}, nil))
+
+	require.Len(t, req.Status.PluginOperations, 1)
+	op := req.Status.PluginOperations[0]
+	assert.Equal(t, "velero.io/record-resources", op.PluginName)
+	assert.Equal(t, "op-1", op.OperationID)
+	assert.Equal(t, "pods", op.Resource)
+	assert.Equal(t, "ns-1", op.Namespace)
+	assert.Equal(t, "pod-1", op.Name)
+	assert.Equal(t, velerov1.PluginOperationPhaseInProgress, op.Phase)
+	assert.NotNil(t, op.Created)
+	assert.True(t, velerov1.PluginOperationsInProgress(req.Status.PluginOperations))
+}
+
+// TestBackupPassesSourceClusterToActions verifies that backup item actions are told
+// which cluster the items are being backed up from.
+func TestBackupPassesSourceClusterToActions(t *testing.T) {
+	h := newHarness(t)
+	cluster := velero.ClusterContext{
+		Host:  "https://source.example.com",
+		Token: "token",
+	}
+	h.backupper.srcCluster = &fakeClusterContextProvider{cluster: cluster}
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+
+	action := new(recordResourcesAction).ForResource("pods")
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, []biav2.BackupItemAction{action}, nil))
+
+	assert.Equal(t, []velero.ClusterContext{cluster}, action.clusters)
+}
+
+// fakeClusterContextProvider provides a fixed cluster context.
+type fakeClusterContextProvider struct {
+	cluster velero.ClusterContext
+}
+
+func (p *fakeClusterContextProvider) Host() string {
+	return p.cluster.Host
+}
+
+func (p *fakeClusterContextProvider) ClusterContext() (velero.ClusterContext, error) {
+	return p.cluster, nil
+}
+
+// TestBackupIsTraced runs a backup with an in-memory span exporter and verifies
+// that the backup, the item collection, each item and each action are traced.
+func TestBackupIsTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	h := newHarness(t)
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+	h.addItems(t, test.PVs(builder.ForPersistentVolume("pv-1").Result()))
+
+	action := &contextRecordingAction{recordResourcesAction: new(recordResourcesAction)}
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "Backup")
+	require.NoError(t, h.backupper.Backup(ctx, h.log, req, backupFile, []biav2.BackupItemAction{action}, nil))
+	parent.End()
+
+	spans := exporter.GetSpans()
+
+	backupSpans := spansNamed(spans, "kubernetesBackupper.Backup")
+	require.Len(t, backupSpans, 1)
+	backupSpan := backupSpans[0]
+	assert.Equal(t, parent.SpanContext().SpanID(), backupSpan.Parent.SpanID())
+	assert.Equal(t, codes.Unset, backupSpan.Status.Code)
+	assert.Contains(t, backupSpan.Attributes, attribute.Int("velero.backup.items", 2))
+
+	collectorSpans := spansNamed(spans, "itemCollector.getAllItems")
+	require.Len(t, collectorSpans, 1)
+	assert.Equal(t, backupSpan.SpanContext.SpanID(), collectorSpans[0].Parent.SpanID())
+
+	itemSpans := spansNamed(spans, "itemBackupper.backupItem")
+	require.Len(t, itemSpans, 2)
+	var items []string
+	for _, span := range itemSpans {
+		assert.Equal(t, backupSpan.SpanContext.SpanID(), span.Parent.SpanID())
+		for _, attr := range span.Attributes {
+			if attr.Key == "velero.name" {
+				items = append(items, attr.Value.AsString())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"pod-1", "pv-1"}, items)
+
+	actionSpans := spansNamed(spans, "BackupItemAction.Execute")
+	require.Len(t, actionSpans, 2)
+	for _, span := range actionSpans {
+		assert.Equal(t, backupSpan.SpanContext.TraceID(), span.SpanContext.TraceID())
+		assert.Contains(t, []trace.SpanID{itemSpans[0].SpanContext.SpanID(), itemSpans[1].SpanContext.SpanID()}, span.Parent.SpanID())
+	}
+
+	// the action is called with the context of its span, so the spans of its
+	// gRPC calls are children of it.
+	require.Len(t, action.contexts, 2)
+	for _, ctx := range action.contexts {
+		spanID := trace.SpanContextFromContext(ctx).SpanID()
+		assert.Contains(t, []trace.SpanID{actionSpans[0].SpanContext.SpanID(), actionSpans[1].SpanContext.SpanID()}, spanID)
+	}
+}
+
+// contextRecordingAction is a BackupItemAction that records the contexts its
+// calls are made with.
+type contextRecordingAction struct {
+	*recordResourcesAction
+	contexts []context.Context
+}
+
+func (a *contextRecordingAction) WithContext(ctx context.Context) interface{} {
+	a.contexts = append(a.contexts, ctx)
+	return a
+}
+
+// spansNamed returns the spans with the given name.
+func spansNamed(spans tracetest.SpanStubs, name string) tracetest.SpanStubs {
+	var named tracetest.SpanStubs
+	for _, span := range spans {
+		if span.Name == name {
+			named = append(named, span)
+		}
+	}
+	return named
+}
+
+type recordingCheckpointer struct {
+	checkpoints []*Checkpoint
+}
+
+func (c *recordingCheckpointer) Checkpoint(checkpoint *Checkpoint) error {
+	c.checkpoints = append(c.checkpoints, checkpoint)
+	return nil
+}
+
+// TestBackupIsCheckpointed verifies that the progress of a backup is checkpointed
+// while it runs when a checkpoint interval is configured.
+func TestBackupIsCheckpointed(t *testing.T) {
+	h := newHarness(t)
+	h.backupper.checkpointInterval = time.Nanosecond
+
+	checkpointer := new(recordingCheckpointer)
+	req := &Request{Backup: defaultBackup().Result(), Checkpointer: checkpointer}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
+
+	require.Len(t, checkpointer.checkpoints, 2)
+	assert.Len(t, checkpointer.checkpoints[0].BackedUpItems, 1)
+	assert.ElementsMatch(t, []CheckpointItem{
+		{Resource: "v1/Pod", Namespace: "foo", Name: "bar"},
+		{Resource: "v1/Pod", Namespace: "zoo", Name: "raz"},
+	}, checkpointer.checkpoints[1].BackedUpItems)
+}
+
+// TestBackupResumesFromCheckpoint verifies that when a backup is resumed from a
+// checkpoint, completed volume snapshots and pod volume backups recorded in the
+// checkpoint are reused rather than taken again.
+func TestBackupResumesFromCheckpoint(t *testing.T) {
+	h := newHarness(t)
+	h.backupper.resticBackupperFactory = new(fakeResticBackupperFactory)
+
+	checkpointedSnapshot := &volume.Snapshot{
+		Spec: volume.SnapshotSpec{
+			BackupName:           "backup-1",
+			Location:             "default",
+			PersistentVolumeName: "pv-1",
+			ProviderVolumeID:     "vol-1",
+		},
+		Status: volume.SnapshotStatus{
+			Phase:              volume.SnapshotPhaseCompleted,
+			ProviderSnapshotID: "checkpointed-snapshot",
+		},
+	}
+	checkpointedPVB := builder.ForPodVolumeBackup("velero", "checkpointed-pvb").
+		PodNamespace("ns-1").
+		PodName("pod-1").
+		Volume("foo").
+		Phase(velerov1.PodVolumeBackupPhaseCompleted).
+		Result()
+
+	req := &Request{
+		Backup: defaultBackup().Result(),
+		SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+			newSnapshotLocation("velero", "default", "default"),
+		},
+		ResumeFrom: &Checkpoint{
+			BackedUpItems:    []CheckpointItem{{Resource: "v1/PersistentVolume", Name: "pv-1"}},
+			VolumeSnapshots:  []*volume.Snapshot{checkpointedSnapshot},
+			PodVolumeBackups: []*velerov1.PodVolumeBackup{checkpointedPVB},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "foo,bar")).Result(),
+	))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+		builder.ForPersistentVolume("pv-2").Result(),
+	))
+
+	snapshotterGetter := volumeSnapshotterGetter{
+		"default": new(fakeVolumeSnapshotter).
+			WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+			WithVolume("pv-2", "vol-2", "", "type-1", 100, false),
+	}
+
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, snapshotterGetter))
+
+	require.Len(t, req.VolumeSnapshots, 2)
+	assert.Equal(t, checkpointedSnapshot, req.VolumeSnapshots[0])
+	assert.Equal(t, "vol-2-snapshot", req.VolumeSnapshots[1].Status.ProviderSnapshotID)
+
+	assert.Equal(t, []*velerov1.PodVolumeBackup{
+		checkpointedPVB,
+		builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-bar").Result(),
+	}, req.PodVolumeBackups)
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/ns-1/pod-1.json",
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+		"resources/persistentvolumes/cluster/pv-1.json",
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+		"resources/persistentvolumes/cluster/pv-2.json",
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-2.json",
+	)
+}
+
+// TestBackupResumedPodHooks verifies that the hooks of a pod that was backed up before
+// the backup was interrupted are only skipped if all of its pod volume backups are
+// reused from the checkpoint.
+func TestBackupResumedPodHooks(t *testing.T) {
+	var (
+		h                  = newHarness(t)
+		podCommandExecutor = new(testutil.MockPodCommandExecutor)
+		backupFile         = bytes.NewBuffer([]byte{})
+		hook               = &velerov1.ExecHook{Command: []string{"sync"}}
+	)
+	h.backupper.resticBackupperFactory = new(fakeResticBackupperFactory)
+	h.backupper.podCommandExecutor = podCommandExecutor
+	defer podCommandExecutor.AssertExpectations(t)
+
+	completedPVB := func(podName, volume string) *velerov1.PodVolumeBackup {
+		return builder.ForPodVolumeBackup("velero", "pvb-ns-1-"+podName+"-"+volume).
+			PodNamespace("ns-1").
+			PodName(podName).
+			Volume(volume).
+			Phase(velerov1.PodVolumeBackupPhaseCompleted).
+			Result()
+	}
+
+	req := &Request{
+		Backup: defaultBackup().
+			Hooks(velerov1.BackupHooks{
+				Resources: []velerov1.BackupResourceHookSpec{
+					{
+						Name:     "hook-1",
+						PreHooks: []velerov1.BackupResourceHook{{Exec: hook}},
+					},
+				},
+			}).
+			Result(),
+		ResumeFrom: &Checkpoint{
+			BackedUpItems: []CheckpointItem{
+				{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1"},
+				{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-2"},
+			},
+			PodVolumeBackups: []*velerov1.PodVolumeBackup{
+				completedPVB("pod-1", "foo"),
+				completedPVB("pod-2", "foo"),
+			},
+		},
+	}
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "foo")).Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "foo,bar")).Result(),
+		builder.ForPod("ns-1", "pod-3").Result(),
+	))
+
+	// pod-1's only volume is reused from the checkpoint, so its hooks aren't run again.
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "pod-2", "hook-1", hook).Return(nil)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "pod-3", "hook-1", hook).Return(nil)
+
+	require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
+
+	assert.Equal(t, []*velerov1.PodVolumeBackup{
+		completedPVB("pod-1", "foo"),
+		completedPVB("pod-2", "foo"),
+		builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-2-bar").Result(),
+	}, req.PodVolumeBackups)
+}
+
+// fakeItemSnapshotter is an ItemSnapshotter for pods that annotates the pods it
+// snapshots, and reports its snapshots as completed if complete is true.
+type fakeItemSnapshotter struct {
+	complete    bool
+	snapshotted []string
+}
+
+func (s *fakeItemSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{IncludedResources: []string{"pods"}}, nil
+}
+
+func (s *fakeItemSnapshotter) AlterBackupItem(input *velero.AlterBackupItemInput) (runtime.Unstructured, error) {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, err
+	}
+	metadata.SetAnnotations(map[string]string{"snapshotted": "true"})
+	return input.Item, nil
+}
+
+func (s *fakeItemSnapshotter) Snapshot(input *velero.SnapshotItemInput) (*velero.SnapshotItemOutput, error) {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, err
+	}
+	s.snapshotted = append(s.snapshotted, metadata.GetName())
+
+	return &velero.SnapshotItemOutput{
+		SnapshotID:       "snapshot-" + metadata.GetName(),
+		SnapshotMetadata: map[string]string{"annotated": metadata.GetAnnotations()["snapshotted"]},
+	}, nil
+}
+
+func (s *fakeItemSnapshotter) Progress(input *velero.ItemSnapshotProgressInput) (velero.OperationProgress, error) {
+	return velero.OperationProgress{Completed: s.complete}, nil
+}
+
+func (s *fakeItemSnapshotter) Delete(input *velero.DeleteItemSnapshotInput) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) Restore(input *velero.RestoreItemFromSnapshotInput) (*velero.RestoreItemFromSnapshotOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+// TestBackupWithItemSnapshotters verifies that item snapshotters are invoked for the
+// items they apply to, that their snapshots are recorded, and that snapshots that aren't
+// complete yet are tracked as plugin operations.
+func TestBackupWithItemSnapshotters(t *testing.T) {
+	itemSnapshot := func(name string) *volume.ItemSnapshot {
+		return &volume.ItemSnapshot{
+			Spec: volume.ItemSnapshotSpec{
+				BackupName:      "backup-1",
+				ItemSnapshotter: "velero.io/fake",
+				Resource:        "pods",
+				Namespace:       "ns-1",
+				Name:            name,
+			},
+			Status: volume.ItemSnapshotStatus{
+				SnapshotID: "snapshot-" + name,
+				Metadata:   map[string]string{"annotated": "true"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		complete          bool
+		resumeFrom        *Checkpoint
+		wantSnapshotted   []string
+		wantItemSnapshots []*volume.ItemSnapshot
+		wantOperationIDs  []string
+	}{
+		{
+			name:              "completed snapshots are recorded",
+			complete:          true,
+			wantSnapshotted:   []string{"pod-1", "pod-2"},
+			wantItemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pod-1"), itemSnapshot("pod-2")},
+		},
+		{
+			name:              "snapshots in progress are tracked as plugin operations",
+			wantSnapshotted:   []string{"pod-1", "pod-2"},
+			wantItemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pod-1"), itemSnapshot("pod-2")},
+			wantOperationIDs:  []string{"snapshot-pod-1", "snapshot-pod-2"},
+		},
+		{
+			name:     "snapshots recorded in a checkpoint are reused",
+			complete: true,
+			resumeFrom: &Checkpoint{
+				BackedUpItems: []CheckpointItem{{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1"}},
+				ItemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pod-1")},
+			},
+			wantSnapshotted:   []string{"pod-2"},
+			wantItemSnapshots: []*volume.ItemSnapshot{itemSnapshot("pod-1"), itemSnapshot("pod-2")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			snapshotter := &fakeItemSnapshotter{complete: tc.complete}
+			req := &Request{
+				Backup:           defaultBackup().Result(),
+				ItemSnapshotters: map[string]velero.ItemSnapshotter{"velero.io/fake": snapshotter},
+				ResumeFrom:       tc.resumeFrom,
+			}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			))
+			h.addItems(t, test.PVs(builder.ForPersistentVolume("pv-1").Result()))
+
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
+
+			assert.Equal(t, tc.wantSnapshotted, snapshotter.snapshotted)
+			assert.Equal(t, tc.wantItemSnapshots, req.ItemSnapshots)
+
+			var operationIDs []string
+			for _, op := range req.Status.PluginOperations {
+				assert.Equal(t, "velero.io/fake", op.PluginName)
+				assert.Equal(t, "ItemSnapshotter", op.PluginKind)
+				assert.Equal(t, velerov1.PluginOperationPhaseInProgress, op.Phase)
+				operationIDs = append(operationIDs, op.OperationID)
+			}
+			assert.Equal(t, tc.wantOperationIDs, operationIDs)
+		})
+	}
+}
+
 // TestBackupResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -742,7 +1246,7 @@ func TestBackupResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -916,7 +1420,7 @@ func TestCRDInclusion(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -984,7 +1488,7 @@ func TestBackupResourceCohabitation(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1007,7 +1511,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	h.addItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 	h.addItems(t, test.ExtensionsDeployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 
-	h.backupper.Backup(h.log, backup1, backup1File, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, backup1, backup1File, nil, nil)
 
 	assertTarballContents(t, backup1File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 
@@ -1017,7 +1521,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	}
 	backup2File := bytes.NewBuffer([]byte{})
 
-	h.backupper.Backup(h.log, backup2, backup2File, nil, nil)
+	h.backupper.Backup(context.Background(), h.log, backup2, backup2File, nil, nil)
 
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 }
@@ -1069,7 +1573,7 @@ func TestBackupResourceOrdering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil)
 
 			assertTarballOrdering(t, backupFile, "pods", "persistentvolumeclaims", "persistentvolumes")
 		})
@@ -1084,17 +1588,32 @@ type recordResourcesAction struct {
 	ids             []string
 	backups         []velerov1.Backup
 	additionalItems []velero.ResourceIdentifier
+	operationID     string
+	clusters        []velero.ClusterContext
+}
+
+func (a *recordResourcesAction) Name() string {
+	return "velero.io/record-resources"
 }
 
-func (a *recordResourcesAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+func (a *recordResourcesAction) Execute(item runtime.Unstructured, backup *velerov1.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
 	metadata, err := meta.Accessor(item)
 	if err != nil {
-		return item, a.additionalItems, err
+		return item, a.additionalItems, "", err
 	}
 	a.ids = append(a.ids, kubeutil.NamespaceAndName(metadata))
 	a.backups = append(a.backups, *backup)
+	a.clusters = append(a.clusters, cluster)
 
-	return item, a.additionalItems, nil
+	return item, a.additionalItems, a.operationID, nil
+}
+
+func (a *recordResourcesAction) Progress(operationID string, backup *velerov1.Backup) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, nil
+}
+
+func (a *recordResourcesAction) Cancel(operationID string, backup *velerov1.Backup) error {
+	return nil
 }
 
 func (a *recordResourcesAction) AppliesTo() (velero.ResourceSelector, error) {
@@ -1121,6 +1640,11 @@ func (a *recordResourcesAction) WithAdditionalItems(items []velero.ResourceIdent
 	return a
 }
 
+func (a *recordResourcesAction) WithOperationID(operationID string) *recordResourcesAction {
+	a.operationID = operationID
+	return a
+}
+
 // TestBackupActionsRunsForCorrectItems runs backups with backup item actions, and
 // verifies that each backup item action is run for the correct set of resources based on its
 // AppliesTo() resource selector. Verification is done by using the recordResourcesAction struct,
@@ -1307,12 +1831,12 @@ func TestBackupActionsRunForCorrectItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			actions := []velero.BackupItemAction{}
+			actions := []biav2.BackupItemAction{}
 			for action := range tc.actions {
 				actions = append(actions, action)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, actions, nil)
 			assert.NoError(t, err)
 
 			for action, want := range tc.actions {
@@ -1333,7 +1857,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 		name         string
 		backup       *velerov1.Backup
 		apiResources []*test.APIResource
-		actions      []velero.BackupItemAction
+		actions      []biav2.BackupItemAction
 	}{
 		{
 			name: "action with invalid label selector results in an error",
@@ -1349,7 +1873,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 					builder.ForPersistentVolume("baz").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				new(recordResourcesAction).ForLabelSelector("=invalid-selector"),
 			},
 		},
@@ -1367,7 +1891,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 					builder.ForPersistentVolume("baz").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&appliesToErrorAction{},
 			},
 		},
@@ -1385,7 +1909,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+			assert.Error(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, tc.actions, nil))
 		})
 	}
 }
@@ -1394,11 +1918,23 @@ func TestBackupWithInvalidActions(t *testing.T) {
 // an error when AppliesTo() is called.
 type appliesToErrorAction struct{}
 
+func (a *appliesToErrorAction) Name() string {
+	return "velero.io/applies-to-error"
+}
+
 func (a *appliesToErrorAction) AppliesTo() (velero.ResourceSelector, error) {
 	return velero.ResourceSelector{}, errors.New("error calling AppliesTo")
 }
 
-func (a *appliesToErrorAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+func (a *appliesToErrorAction) Execute(item runtime.Unstructured, backup *velerov1.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
+	panic("not implemented")
+}
+
+func (a *appliesToErrorAction) Progress(operationID string, backup *velerov1.Backup) (velero.OperationProgress, error) {
+	panic("not implemented")
+}
+
+func (a *appliesToErrorAction) Cancel(operationID string, backup *velerov1.Backup) error {
 	panic("not implemented")
 }
 
@@ -1429,7 +1965,7 @@ func TestBackupActionModifications(t *testing.T) {
 		name         string
 		backup       *velerov1.Backup
 		apiResources []*test.APIResource
-		actions      []velero.BackupItemAction
+		actions      []biav2.BackupItemAction
 		want         map[string]unstructuredObject
 	}{
 		{
@@ -1440,7 +1976,7 @@ func TestBackupActionModifications(t *testing.T) {
 					builder.ForPod("ns-1", "pod-1").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.SetLabels(map[string]string{"updated": "true"})
 				}),
@@ -1457,7 +1993,7 @@ func TestBackupActionModifications(t *testing.T) {
 					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("should-be-removed", "true")).Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.SetLabels(nil)
 				}),
@@ -1474,7 +2010,7 @@ func TestBackupActionModifications(t *testing.T) {
 					builder.ForPod("ns-1", "pod-1").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.Object["spec"].(map[string]interface{})["nodeName"] = "foo"
 				}),
@@ -1492,7 +2028,7 @@ func TestBackupActionModifications(t *testing.T) {
 					builder.ForPod("ns-1", "pod-1").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.SetName(item.GetName() + "-updated")
 					item.SetNamespace(item.GetNamespace() + "-updated")
@@ -1516,7 +2052,7 @@ func TestBackupActionModifications(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, tc.actions, nil)
 			assert.NoError(t, err)
 
 			assertTarballFileContents(t, backupFile, tc.want)
@@ -1533,7 +2069,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 		name         string
 		backup       *velerov1.Backup
 		apiResources []*test.APIResource
-		actions      []velero.BackupItemAction
+		actions      []biav2.BackupItemAction
 		want         []string
 	}{
 		{
@@ -1546,7 +2082,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPod("ns-3", "pod-3").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
@@ -1578,7 +2114,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPod("ns-3", "pod-3").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 						additionalItems := []velero.ResourceIdentifier{
@@ -1608,7 +2144,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPersistentVolume("pv-2").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 						additionalItems := []velero.ResourceIdentifier{
@@ -1641,7 +2177,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPersistentVolume("pv-2").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 						additionalItems := []velero.ResourceIdentifier{
@@ -1671,7 +2207,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPersistentVolume("pv-2").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 						additionalItems := []velero.ResourceIdentifier{
@@ -1702,7 +2238,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPersistentVolume("pv-2").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 						additionalItems := []velero.ResourceIdentifier{
@@ -1732,7 +2268,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 					builder.ForPod("ns-3", "pod-3").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
+			actions: []biav2.BackupItemAction{
 				&pluggableAction{
 					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
 					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
@@ -1768,7 +2304,7 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(context.Background(), h.log, req, backupFile, tc.actions, nil)
 			assert.NoError(t, err)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
@@ -2212,7 +2748,7 @@ func TestBackupWithSnapshots(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
+			err := h.backupper.Backup(context.Background(), h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
 			assert.NoError(t, err)
 
 			assert.Equal(t, tc.want, tc.req.VolumeSnapshots)
@@ -2270,7 +2806,7 @@ func TestBackupWithInvalidHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil), tc.want.Error())
+			assert.EqualError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil), tc.want.Error())
 		})
 	}
 }
@@ -2294,6 +2830,7 @@ func TestBackupWithHooks(t *testing.T) {
 		apiResources               []*test.APIResource
 		wantExecutePodCommandCalls []*expectedCall
 		wantBackedUp               []string
+		wantHookFailedEvents       int
 	}{
 		{
 			name: "pre hook with no resource filters runs for all pods",
@@ -2503,6 +3040,7 @@ func TestBackupWithHooks(t *testing.T) {
 				"resources/pods/namespaces/ns-2/pod-2.json",
 				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
 			},
+			wantHookFailedEvents: 1,
 		},
 	}
 
@@ -2513,9 +3051,13 @@ func TestBackupWithHooks(t *testing.T) {
 				req                = &Request{Backup: tc.backup}
 				backupFile         = bytes.NewBuffer([]byte{})
 				podCommandExecutor = new(testutil.MockPodCommandExecutor)
+				eventRecorder      = record.NewFakeRecorder(10)
+				srcEventRecorder   = record.NewFakeRecorder(10)
 			)
 
 			h.backupper.podCommandExecutor = podCommandExecutor
+			h.backupper.eventRecorder = eventRecorder
+			h.backupper.srcEventRecorder = srcEventRecorder
 			defer podCommandExecutor.AssertExpectations(t)
 
 			for _, expect := range tc.wantExecutePodCommandCalls {
@@ -2533,9 +3075,17 @@ func TestBackupWithHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, nil))
 
 			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
+
+			// a hook failure is recorded on both the backup and the pod
+			for _, recorder := range []*record.FakeRecorder{eventRecorder, srcEventRecorder} {
+				require.Len(t, recorder.Events, tc.wantHookFailedEvents)
+				for i := 0; i < tc.wantHookFailedEvents; i++ {
+					assert.Contains(t, <-recorder.Events, "Warning HookFailed Backup pre hook")
+				}
+			}
 		})
 	}
 }
@@ -2658,7 +3208,7 @@ func TestBackupWithRestic(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, tc.snapshotterGetter))
+			require.NoError(t, h.backupper.Backup(context.Background(), h.log, req, backupFile, nil, tc.snapshotterGetter))
 
 			assert.Equal(t, tc.want, req.PodVolumeBackups)
 
@@ -2675,12 +3225,25 @@ type pluggableAction struct {
 	executeFunc func(runtime.Unstructured, *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error)
 }
 
-func (a *pluggableAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+func (a *pluggableAction) Name() string {
+	return "velero.io/pluggable"
+}
+
+func (a *pluggableAction) Execute(item runtime.Unstructured, backup *velerov1.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
 	if a.executeFunc == nil {
-		return item, nil, nil
+		return item, nil, "", nil
 	}
 
-	return a.executeFunc(item, backup)
+	updatedItem, additionalItems, err := a.executeFunc(item, backup)
+	return updatedItem, additionalItems, "", err
+}
+
+func (a *pluggableAction) Progress(operationID string, backup *velerov1.Backup) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, nil
+}
+
+func (a *pluggableAction) Cancel(operationID string, backup *velerov1.Backup) error {
+	return nil
 }
 
 func (a *pluggableAction) AppliesTo() (velero.ResourceSelector, error) {
@@ -2726,12 +3289,16 @@ func newHarness(t *testing.T) *harness {
 	return &harness{
 		APIServer: apiServer,
 		backupper: &kubernetesBackupper{
-			backupClient:    apiServer.VeleroClient.VeleroV1(),
-			dynamicFactory:  client.NewDynamicFactory(apiServer.DynamicClient),
-			discoveryHelper: discoveryHelper,
+			backupClient:     apiServer.VeleroClient.VeleroV1(),
+			dynamicFactory:   client.NewDynamicFactory(apiServer.DynamicClient),
+			discoveryHelper:  discoveryHelper,
+			eventRecorder:    &record.FakeRecorder{},
+			srcEventRecorder: &record.FakeRecorder{},
+			srcCluster:       client.LocalClusterContextProvider{},
 
 			// unsupported
 			podCommandExecutor:     nil,
+			httpHookExecutor:       nil,
 			resticBackupperFactory: nil,
 			resticTimeout:          0,
 		},
@@ -0,0 +1,138 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// Checkpoint records the progress of an in-progress backup so that the backup
+// can be resumed, rather than started over, if the Velero server restarts before
+// the backup completes.
+type Checkpoint struct {
+	// BackupUID is the UID of the backup the checkpoint was taken of. A checkpoint
+	// left behind by a deleted backup isn't used by a new backup with the same name.
+	BackupUID string `json:"backupUID,omitempty"`
+
+	// BackedUpItems are the items that had been written to the backup tarball
+	// when the checkpoint was taken.
+	BackedUpItems []CheckpointItem `json:"backedUpItems,omitempty"`
+
+	// VolumeSnapshots are the native volume snapshots that had been taken
+	// when the checkpoint was taken.
+	VolumeSnapshots []*volume.Snapshot `json:"volumeSnapshots,omitempty"`
+
+	// ItemSnapshots are the snapshots item snapshotters had taken when
+	// the checkpoint was taken.
+	ItemSnapshots []*volume.ItemSnapshot `json:"itemSnapshots,omitempty"`
+
+	// PodVolumeBackups are the restic pod volume backups that had been
+	// created when the checkpoint was taken.
+	PodVolumeBackups []*velerov1api.PodVolumeBackup `json:"podVolumeBackups,omitempty"`
+}
+
+// CheckpointItem identifies an item that was backed up before a checkpoint was taken.
+type CheckpointItem struct {
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// Checkpointer persists backup checkpoints.
+type Checkpointer interface {
+	// Checkpoint persists the given checkpoint, replacing any checkpoint
+	// previously persisted for the backup.
+	Checkpoint(checkpoint *Checkpoint) error
+}
+
+// checkpoint returns a Checkpoint representing the current progress of the backup.
+func (r *Request) checkpoint() *Checkpoint {
+	checkpoint := &Checkpoint{
+		BackupUID:        string(r.UID),
+		BackedUpItems:    make([]CheckpointItem, 0, len(r.BackedUpItems)),
+		VolumeSnapshots:  append([]*volume.Snapshot(nil), r.VolumeSnapshots...),
+		ItemSnapshots:    append([]*volume.ItemSnapshot(nil), r.ItemSnapshots...),
+		PodVolumeBackups: append([]*velerov1api.PodVolumeBackup(nil), r.PodVolumeBackups...),
+	}
+
+	for key := range r.BackedUpItems {
+		checkpoint.BackedUpItems = append(checkpoint.BackedUpItems, CheckpointItem{
+			Resource:  key.resource,
+			Namespace: key.namespace,
+			Name:      key.name,
+		})
+	}
+
+	return checkpoint
+}
+
+// backedUpItems returns the set of items recorded in the checkpoint.
+func (c *Checkpoint) backedUpItems() map[itemKey]struct{} {
+	items := make(map[itemKey]struct{}, len(c.BackedUpItems))
+	for _, item := range c.BackedUpItems {
+		items[itemKey{resource: item.Resource, namespace: item.Namespace, name: item.Name}] = struct{}{}
+	}
+	return items
+}
+
+// completedVolumeSnapshot returns the completed volume snapshot recorded in the checkpoint for
+// the named persistent volume, or nil if there isn't one.
+func (c *Checkpoint) completedVolumeSnapshot(persistentVolume string) *volume.Snapshot {
+	if c == nil {
+		return nil
+	}
+
+	for _, snapshot := range c.VolumeSnapshots {
+		if snapshot.Spec.PersistentVolumeName == persistentVolume && snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// itemSnapshot returns the snapshot the named item snapshotter took of an item that's
+// recorded in the checkpoint, or nil if there isn't one.
+func (c *Checkpoint) itemSnapshot(snapshotter, resource, namespace, name string) *volume.ItemSnapshot {
+	if c == nil {
+		return nil
+	}
+
+	for _, snapshot := range c.ItemSnapshots {
+		if snapshot.Spec.ItemSnapshotter == snapshotter && snapshot.Spec.Resource == resource &&
+			snapshot.Spec.Namespace == namespace && snapshot.Spec.Name == name {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// completedPodVolumeBackup returns the completed pod volume backup recorded in the checkpoint
+// for the given pod volume, or nil if there isn't one.
+func (c *Checkpoint) completedPodVolumeBackup(podNamespace, podName, volumeName string) *velerov1api.PodVolumeBackup {
+	if c == nil {
+		return nil
+	}
+
+	for _, pvb := range c.PodVolumeBackups {
+		if pvb.Spec.Pod.Namespace == podNamespace && pvb.Spec.Pod.Name == podName && pvb.Spec.Volume == volumeName &&
+			pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted {
+			return pvb
+		}
+	}
+	return nil
+}
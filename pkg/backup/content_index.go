@@ -0,0 +1,230 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ContentIndex summarizes what a backup contains, for capacity planning and auditing.
+type ContentIndex struct {
+	// Resources are the backed up items, by group-resource and namespace.
+	Resources []ResourceContents `json:"resources"`
+
+	// Volumes are the backed up persistent volume claims and persistent volumes, with
+	// their sizes.
+	Volumes []VolumeContents `json:"volumes,omitempty"`
+}
+
+// ResourceContents are the items of a group-resource in a namespace that a backup contains.
+type ResourceContents struct {
+	Resource string `json:"resource"`
+
+	// Namespace is empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Items is the number of items.
+	Items int `json:"items"`
+
+	// Bytes is the size of the items, serialized as they are in the backup tarball.
+	Bytes int64 `json:"bytes"`
+}
+
+// VolumeContents is a persistent volume claim or persistent volume that a backup contains.
+type VolumeContents struct {
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Size is the volume's capacity, like "10Gi".
+	Size string `json:"size"`
+
+	// Bytes is the volume's capacity in bytes.
+	Bytes int64 `json:"bytes"`
+}
+
+// TotalItems returns the number of items in the index.
+func (c *ContentIndex) TotalItems() int {
+	total := 0
+	for _, contents := range c.Resources {
+		total += contents.Items
+	}
+	return total
+}
+
+// TotalBytes returns the serialized size of the items in the index.
+func (c *ContentIndex) TotalBytes() int64 {
+	var total int64
+	for _, contents := range c.Resources {
+		total += contents.Bytes
+	}
+	return total
+}
+
+// TotalVolumeBytes returns the capacity of the volumes in the index.
+func (c *ContentIndex) TotalVolumeBytes() int64 {
+	var total int64
+	for _, volume := range c.Volumes {
+		total += volume.Bytes
+	}
+	return total
+}
+
+// contentIndexKey identifies the items of a group-resource in a namespace.
+type contentIndexKey struct {
+	resource  string
+	namespace string
+}
+
+// ContentIndex returns the index of the backed up items and volumes, sorted by group-resource,
+// namespace and name.
+func (r *Request) ContentIndex() *ContentIndex {
+	resources := map[contentIndexKey]*ResourceContents{}
+	for item, size := range r.BackedUpItemSizes {
+		key := contentIndexKey{resource: item.resource, namespace: item.namespace}
+		contents, ok := resources[key]
+		if !ok {
+			contents = &ResourceContents{Resource: item.resource, Namespace: item.namespace}
+			resources[key] = contents
+		}
+		contents.Items++
+		contents.Bytes += size
+	}
+
+	index := &ContentIndex{Resources: []ResourceContents{}}
+	for _, contents := range resources {
+		index.Resources = append(index.Resources, *contents)
+	}
+	sort.Slice(index.Resources, func(i, j int) bool {
+		if index.Resources[i].Resource != index.Resources[j].Resource {
+			return index.Resources[i].Resource < index.Resources[j].Resource
+		}
+		return index.Resources[i].Namespace < index.Resources[j].Namespace
+	})
+
+	for item, size := range r.BackedUpVolumeSizes {
+		index.Volumes = append(index.Volumes, VolumeContents{
+			Resource:  item.resource,
+			Namespace: item.namespace,
+			Name:      item.name,
+			Size:      size.String(),
+			Bytes:     size.Value(),
+		})
+	}
+	sort.Slice(index.Volumes, func(i, j int) bool {
+		a, b := index.Volumes[i], index.Volumes[j]
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return index
+}
+
+// volumeSize returns the capacity of a persistent volume claim or persistent volume, and
+// whether the item is one that has a capacity. A claim's capacity is its bound capacity,
+// or its requested storage if it isn't bound yet.
+func volumeSize(groupResource schema.GroupResource, obj runtime.Unstructured) (resource.Quantity, bool) {
+	var fields [][]string
+	switch groupResource {
+	case kuberesource.PersistentVolumeClaims:
+		fields = [][]string{
+			{"status", "capacity", string(corev1api.ResourceStorage)},
+			{"spec", "resources", "requests", string(corev1api.ResourceStorage)},
+		}
+	case kuberesource.PersistentVolumes:
+		fields = [][]string{
+			{"spec", "capacity", string(corev1api.ResourceStorage)},
+		}
+	default:
+		return resource.Quantity{}, false
+	}
+
+	for _, field := range fields {
+		value, found, err := unstructured.NestedString(obj.UnstructuredContent(), field...)
+		if err != nil || !found {
+			continue
+		}
+		if size, err := resource.ParseQuantity(value); err == nil {
+			return size, true
+		}
+	}
+	return resource.Quantity{}, false
+}
+
+// ResourceContentsDiff is how the items of a group-resource in a namespace differ between
+// two backups.
+type ResourceContentsDiff struct {
+	Resource  string
+	Namespace string
+	FromItems int
+	ToItems   int
+	FromBytes int64
+	ToBytes   int64
+}
+
+// CompareContentIndexes returns how the items in the from and to indexes differ, for each
+// group-resource and namespace in either of them, sorted by group-resource and namespace.
+// Entries that didn't change are only returned if all is true.
+func CompareContentIndexes(from, to *ContentIndex, all bool) []ResourceContentsDiff {
+	diffs := map[contentIndexKey]*ResourceContentsDiff{}
+	get := func(contents ResourceContents) *ResourceContentsDiff {
+		key := contentIndexKey{resource: contents.Resource, namespace: contents.Namespace}
+		diff, ok := diffs[key]
+		if !ok {
+			diff = &ResourceContentsDiff{Resource: contents.Resource, Namespace: contents.Namespace}
+			diffs[key] = diff
+		}
+		return diff
+	}
+
+	for _, contents := range from.Resources {
+		diff := get(contents)
+		diff.FromItems, diff.FromBytes = contents.Items, contents.Bytes
+	}
+	for _, contents := range to.Resources {
+		diff := get(contents)
+		diff.ToItems, diff.ToBytes = contents.Items, contents.Bytes
+	}
+
+	var result []ResourceContentsDiff
+	for _, diff := range diffs {
+		if !all && diff.FromItems == diff.ToItems && diff.FromBytes == diff.ToBytes {
+			continue
+		}
+		result = append(result, *diff)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Resource != result[j].Resource {
+			return result[i].Resource < result[j].Resource
+		}
+		return result[i].Namespace < result[j].Namespace
+	})
+	return result
+}
@@ -0,0 +1,53 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareContentIndexes(t *testing.T) {
+	from := &ContentIndex{
+		Resources: []ResourceContents{
+			{Resource: "configmaps", Namespace: "foo", Items: 2, Bytes: 200},
+			{Resource: "pods", Namespace: "foo", Items: 3, Bytes: 3000},
+			{Resource: "storageclasses.storage.k8s.io", Items: 1, Bytes: 100},
+		},
+	}
+	to := &ContentIndex{
+		Resources: []ResourceContents{
+			{Resource: "configmaps", Namespace: "foo", Items: 2, Bytes: 200},
+			{Resource: "pods", Namespace: "bar", Items: 1, Bytes: 1000},
+			{Resource: "pods", Namespace: "foo", Items: 4, Bytes: 4100},
+		},
+	}
+
+	assert.Equal(t, []ResourceContentsDiff{
+		{Resource: "pods", Namespace: "bar", ToItems: 1, ToBytes: 1000},
+		{Resource: "pods", Namespace: "foo", FromItems: 3, ToItems: 4, FromBytes: 3000, ToBytes: 4100},
+		{Resource: "storageclasses.storage.k8s.io", FromItems: 1, FromBytes: 100},
+	}, CompareContentIndexes(from, to, false))
+
+	all := CompareContentIndexes(from, to, true)
+	assert.Len(t, all, 4)
+	assert.Equal(t, ResourceContentsDiff{Resource: "configmaps", Namespace: "foo", FromItems: 2, ToItems: 2, FromBytes: 200, ToBytes: 200}, all[0])
+
+	assert.Equal(t, 7, to.TotalItems())
+	assert.Equal(t, int64(5300), to.TotalBytes())
+}
@@ -18,6 +18,7 @@ package backup
 
 import (
 	"archive/tar"
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -25,23 +26,30 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -57,13 +65,25 @@ type itemBackupper struct {
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
+
+	// resumedItems are the items that were backed up before the backup
+	// was interrupted, if it's being resumed from a checkpoint.
+	resumedItems map[itemKey]struct{}
+
+	// eventRecorder records events about the backup and srcEventRecorder records
+	// events about the items being backed up, in the source cluster.
+	eventRecorder    record.EventRecorder
+	srcEventRecorder record.EventRecorder
+
+	// srcCluster is the cluster the items are being backed up from.
+	srcCluster client.ClusterContextProvider
 }
 
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
 // namespaces IncludesExcludes list.
 // In addition to the error return, backupItem also returns a bool indicating whether the item
 // was actually backed up.
-func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (bool, error) {
+func (ib *itemBackupper) backupItem(ctx context.Context, logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (_ bool, err error) {
 	metadata, err := meta.Accessor(obj)
 	if err != nil {
 		return false, err
@@ -72,7 +92,7 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	namespace := metadata.GetNamespace()
 	name := metadata.GetName()
 
-	log := logger.WithField("name", name)
+	var log logrus.FieldLogger = logger.WithField("name", name)
 	log = log.WithField("resource", groupResource.String())
 	log = log.WithField("namespace", namespace)
 
@@ -118,12 +138,14 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
 
-	log.Info("Backing up item")
+	ctx, span := tracing.Start(ctx, "itemBackupper.backupItem",
+		attribute.String("velero.resource", groupResource.String()),
+		attribute.String("velero.namespace", namespace),
+		attribute.String("velero.name", name),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	log.Debug("Executing pre hooks")
-	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre); err != nil {
-		return false, err
-	}
+	log.Info("Backing up item")
 
 	var (
 		backupErrs            []error
@@ -153,28 +175,49 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 
 				resticVolumesToBackup = append(resticVolumesToBackup, volume)
 			}
+		}
+	}
+
+	// hooks for items that were backed up before the backup was interrupted have
+	// already run. They're run again for a pod with a volume that's backed up again
+	// rather than reused from the checkpoint, so that its data is quiesced.
+	_, resumed := ib.resumedItems[key]
+	if resumed && pod != nil && !ib.podVolumesResumed(pod, resticVolumesToBackup) {
+		resumed = false
+	}
 
-			// track the volumes that are PVCs using the PVC snapshot tracker, so that when we backup PVCs/PVs
-			// via an item action in the next step, we don't snapshot PVs that will have their data backed up
-			// with restic.
-			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
+	if !resumed {
+		log.Debug("Executing pre hooks")
+		if err := ib.itemHookHandler.HandleHooks(ctx, log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre); err != nil {
+			ib.recordHookFailure(obj, string(hook.PhasePre), err)
+			return false, err
 		}
 	}
 
+	if pod != nil {
+		// track the volumes that are PVCs using the PVC snapshot tracker, so that when we backup PVCs/PVs
+		// via an item action in the next step, we don't snapshot PVs that will have their data backed up
+		// with restic.
+		ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
+	}
+
 	// capture the version of the object before invoking plugin actions as the plugin may update
 	// the group version of the object.
 	// group version of this object
 	// Used on filepath to backup up all groups and versions
 	version := resourceVersion(obj)
 
-	updatedObj, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata)
+	updatedObj, err := ib.executeActions(ctx, log, obj, groupResource, name, namespace, metadata)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
 
 		// if there was an error running actions, execute post hooks and return
-		log.Debug("Executing post hooks")
-		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
-			backupErrs = append(backupErrs, err)
+		if !resumed {
+			log.Debug("Executing post hooks")
+			if err := ib.itemHookHandler.HandleHooks(ctx, log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+				ib.recordHookFailure(obj, string(hook.PhasePost), err)
+				backupErrs = append(backupErrs, err)
+			}
 		}
 
 		return false, kubeerrs.NewAggregate(backupErrs)
@@ -187,8 +230,17 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	name = metadata.GetName()
 	namespace = metadata.GetNamespace()
 
+	if updatedObj, err := ib.snapshotItem(ctx, log, obj, groupResource, name, namespace, metadata); err != nil {
+		backupErrs = append(backupErrs, err)
+	} else {
+		obj = updatedObj
+	}
+
 	if groupResource == kuberesource.PersistentVolumes {
-		if err := ib.takePVSnapshot(obj, log); err != nil {
+		snapshotCtx, snapshotSpan := tracing.Start(ctx, "itemBackupper.takePVSnapshot")
+		err := ib.takePVSnapshot(snapshotCtx, obj, log)
+		tracing.End(snapshotSpan, err)
+		if err != nil {
 			backupErrs = append(backupErrs, err)
 		}
 	}
@@ -196,15 +248,22 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	if groupResource == kuberesource.Pods && pod != nil {
 		// this function will return partial results, so process podVolumeBackups
 		// even if there are errors.
+		_, podVolumesSpan := tracing.Start(ctx, "itemBackupper.backupPodVolumes",
+			attribute.Int("velero.pod_volumes", len(resticVolumesToBackup)),
+		)
 		podVolumeBackups, errs := ib.backupPodVolumes(log, pod, resticVolumesToBackup)
+		tracing.End(podVolumesSpan, kubeerrs.NewAggregate(errs))
 
 		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
 		backupErrs = append(backupErrs, errs...)
 	}
 
-	log.Debug("Executing post hooks")
-	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
-		backupErrs = append(backupErrs, err)
+	if !resumed {
+		log.Debug("Executing post hooks")
+		if err := ib.itemHookHandler.HandleHooks(ctx, log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+			ib.recordHookFailure(obj, string(hook.PhasePost), err)
+			backupErrs = append(backupErrs, err)
+		}
 	}
 
 	if len(backupErrs) != 0 {
@@ -251,6 +310,12 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		return false, errors.WithStack(err)
 	}
 
+	indexKey := itemKey{resource: groupResource.String(), namespace: namespace, name: name}
+	ib.backupRequest.BackedUpItemSizes[indexKey] = int64(len(itemBytes))
+	if size, ok := volumeSize(groupResource, obj); ok {
+		ib.backupRequest.BackedUpVolumeSizes[indexKey] = size
+	}
+
 	// backing up the preferred version backup without API Group version on path -  this is for backward compatibility
 
 	log.Debugf("Resource %s/%s, version= %s, preferredVersion=%s", groupResource.String(), name, version, preferredVersion)
@@ -283,20 +348,51 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 
 // backupPodVolumes triggers restic backups of the specified pod volumes, and returns a list of PodVolumeBackups
 // for volumes that were successfully backed up, and a slice of any errors that were encountered.
+// podVolumesResumed returns whether the completed pod volume backups of all of the given
+// volumes of the pod are recorded in the checkpoint the backup is resumed from.
+func (ib *itemBackupper) podVolumesResumed(pod *corev1api.Pod, volumes []string) bool {
+	for _, volume := range volumes {
+		if ib.backupRequest.ResumeFrom.completedPodVolumeBackup(pod.Namespace, pod.Name, volume) == nil {
+			return false
+		}
+	}
+	return true
+}
+
 func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api.Pod, volumes []string) ([]*velerov1api.PodVolumeBackup, []error) {
 	if len(volumes) == 0 {
 		return nil, nil
 	}
 
+	// reuse the pod volume backups that completed before the backup was interrupted
+	var (
+		resumedPodVolumeBackups []*velerov1api.PodVolumeBackup
+		remainingVolumes        []string
+	)
+	for _, volume := range volumes {
+		if pvb := ib.backupRequest.ResumeFrom.completedPodVolumeBackup(pod.Namespace, pod.Name, volume); pvb != nil {
+			log.WithField("podVolume", volume).Info("Pod volume was backed up with restic before the backup was interrupted, reusing its pod volume backup.")
+			resumedPodVolumeBackups = append(resumedPodVolumeBackups, pvb)
+			continue
+		}
+		remainingVolumes = append(remainingVolumes, volume)
+	}
+
+	if len(remainingVolumes) == 0 {
+		return resumedPodVolumeBackups, nil
+	}
+
 	if ib.resticBackupper == nil {
 		log.Warn("No restic backupper, not backing up pod's volumes")
-		return nil, nil
+		return resumedPodVolumeBackups, nil
 	}
 
-	return ib.resticBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, log)
+	podVolumeBackups, errs := ib.resticBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, remainingVolumes, log)
+	return append(resumedPodVolumeBackups, podVolumeBackups...), errs
 }
 
 func (ib *itemBackupper) executeActions(
+	ctx context.Context,
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
 	groupResource schema.GroupResource,
@@ -324,47 +420,207 @@ func (ib *itemBackupper) executeActions(
 			continue
 		}
 
-		log.Info("Executing custom action")
+		log.WithField("action", action.Name()).Info("Executing custom action")
+
+		cluster, err := ib.srcCluster.ClusterContext()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the source cluster for custom action %s", action.Name())
+		}
 
-		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		actionCtx, span := tracing.Start(ctx, "BackupItemAction.Execute", attribute.String("velero.plugin", action.Name()))
+		tracedAction := framework.WithContext(action.BackupItemAction, actionCtx).(biav2.BackupItemAction)
+		updatedItem, additionalItemIdentifiers, operationID, err := tracedAction.Execute(obj, ib.backupRequest.Backup, cluster)
+		tracing.End(span, err)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+			return nil, errors.Wrapf(err, "error executing custom action %s (groupResource=%s, namespace=%s, name=%s)", action.Name(), groupResource.String(), namespace, name)
 		}
 		obj = updatedItem
 
-		for _, additionalItem := range additionalItemIdentifiers {
-			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
-			if err != nil {
-				return nil, err
-			}
+		// the backup won't be complete until the operation the action started finishes, so record
+		// it for the backup operations controller to track.
+		if operationID != "" {
+			log.WithFields(logrus.Fields{"action": action.Name(), "operationID": operationID}).Info("Custom action started an asynchronous operation")
+			created := metav1.Now()
+			ib.backupRequest.Status.PluginOperations = append(ib.backupRequest.Status.PluginOperations, velerov1api.PluginOperation{
+				PluginName:  action.Name(),
+				OperationID: operationID,
+				Resource:    groupResource.String(),
+				Namespace:   namespace,
+				Name:        name,
+				Phase:       velerov1api.PluginOperationPhaseInProgress,
+				Created:     &created,
+			})
+		}
 
-			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
-			if err != nil {
-				return nil, err
-			}
+		if err := ib.backupAdditionalItems(ctx, log, additionalItemIdentifiers); err != nil {
+			return nil, err
+		}
+	}
 
-			item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
-			if apierrors.IsNotFound(err) {
-				log.WithFields(logrus.Fields{
-					"groupResource": additionalItem.GroupResource,
-					"namespace":     additionalItem.Namespace,
-					"name":          additionalItem.Name,
-				}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
-				continue
-			}
+	return obj, nil
+}
+
+// backupAdditionalItems backs up the additional items a plugin returned for an item.
+func (ib *itemBackupper) backupAdditionalItems(ctx context.Context, log logrus.FieldLogger, additionalItems []velero.ResourceIdentifier) error {
+	for _, additionalItem := range additionalItems {
+		gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
+		if err != nil {
+			return err
+		}
+
+		client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+		if err != nil {
+			return err
+		}
+
+		item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			log.WithFields(logrus.Fields{
+				"groupResource": additionalItem.GroupResource,
+				"namespace":     additionalItem.Namespace,
+				"name":          additionalItem.Name,
+			}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+			continue
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if _, err = ib.backupItem(ctx, log, item, gvr.GroupResource(), gvr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotItem invokes the item snapshotters that apply to the item, recording the
+// snapshots they take, and returns the item as altered by them.
+func (ib *itemBackupper) snapshotItem(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	groupResource schema.GroupResource,
+	name, namespace string,
+	metadata metav1.Object,
+) (runtime.Unstructured, error) {
+	for i := range ib.backupRequest.ResolvedItemSnapshotters {
+		snapshotter := &ib.backupRequest.ResolvedItemSnapshotters[i]
+		if !snapshotter.appliesTo(groupResource, namespace, metadata.GetLabels()) {
+			continue
+		}
+
+		snapshotLog := log.WithField("itemSnapshotter", snapshotter.name)
+		errorContext := fmt.Sprintf("item snapshotter %s (groupResource=%s, namespace=%s, name=%s)", snapshotter.name, groupResource.String(), namespace, name)
+
+		cluster, err := ib.srcCluster.ClusterContext()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the source cluster for %s", errorContext)
+		}
+
+		updatedItem, err := snapshotter.AlterBackupItem(&velero.AlterBackupItemInput{
+			Item:    obj,
+			Backup:  ib.backupRequest.Backup,
+			Cluster: cluster,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error altering item with %s", errorContext)
+		}
+		obj = updatedItem
+
+		snapshot := ib.backupRequest.ResumeFrom.itemSnapshot(snapshotter.name, groupResource.String(), namespace, name)
+		var additionalItems []velero.ResourceIdentifier
+		if snapshot != nil {
+			snapshotLog.Info("Item was snapshotted before the backup was interrupted, reusing its snapshot.")
+		} else {
+			snapshotLog.Info("Snapshotting item")
+			snapshotCtx, span := tracing.Start(ctx, "ItemSnapshotter.Snapshot", attribute.String("velero.plugin", snapshotter.name))
+			tracedSnapshotter := framework.WithContext(snapshotter.ItemSnapshotter, snapshotCtx).(velero.ItemSnapshotter)
+			output, err := tracedSnapshotter.Snapshot(&velero.SnapshotItemInput{
+				Item:    obj,
+				Backup:  ib.backupRequest.Backup,
+				Cluster: cluster,
+			})
+			tracing.End(span, err)
 			if err != nil {
-				return nil, errors.WithStack(err)
+				return nil, errors.Wrapf(err, "error snapshotting item with %s", errorContext)
 			}
 
-			if _, err = ib.backupItem(log, item, gvr.GroupResource(), gvr); err != nil {
-				return nil, err
+			snapshot = &volume.ItemSnapshot{
+				Spec: volume.ItemSnapshotSpec{
+					BackupName:      ib.backupRequest.Name,
+					BackupUID:       string(ib.backupRequest.UID),
+					ItemSnapshotter: snapshotter.name,
+					Resource:        groupResource.String(),
+					Namespace:       namespace,
+					Name:            name,
+				},
+				Status: volume.ItemSnapshotStatus{
+					SnapshotID: output.SnapshotID,
+					Metadata:   output.SnapshotMetadata,
+				},
 			}
+			additionalItems = output.AdditionalItems
+		}
+		ib.backupRequest.ItemSnapshots = append(ib.backupRequest.ItemSnapshots, snapshot)
+
+		if err := ib.trackItemSnapshot(snapshotLog, snapshotter, snapshot, groupResource); err != nil {
+			return nil, errors.Wrapf(err, "error checking the progress of the snapshot taken by %s", errorContext)
+		}
+
+		if err := ib.backupAdditionalItems(ctx, log, additionalItems); err != nil {
+			return nil, err
 		}
 	}
 
 	return obj, nil
 }
 
+// trackItemSnapshot checks the progress of an item snapshot. The backup won't be complete
+// until the snapshot is, so if it isn't complete yet, it's recorded as a plugin operation
+// for the backup operations controller to track.
+func (ib *itemBackupper) trackItemSnapshot(log logrus.FieldLogger, snapshotter *resolvedItemSnapshotter, snapshot *volume.ItemSnapshot, groupResource schema.GroupResource) error {
+	progress, err := snapshotter.Progress(&velero.ItemSnapshotProgressInput{
+		ItemID: velero.ResourceIdentifier{
+			GroupResource: groupResource,
+			Namespace:     snapshot.Spec.Namespace,
+			Name:          snapshot.Spec.Name,
+		},
+		SnapshotID: snapshot.Status.SnapshotID,
+		Backup:     ib.backupRequest.Backup,
+	})
+	if err != nil {
+		return err
+	}
+	if progress.Err != "" {
+		return errors.New(progress.Err)
+	}
+	if progress.Completed {
+		return nil
+	}
+
+	for _, op := range ib.backupRequest.Status.PluginOperations {
+		if op.PluginKind == string(framework.PluginKindItemSnapshotter) && op.PluginName == snapshotter.name && op.OperationID == snapshot.Status.SnapshotID {
+			return nil
+		}
+	}
+
+	log.WithField("snapshotID", snapshot.Status.SnapshotID).Info("Item snapshot is still in progress")
+	created := metav1.Now()
+	ib.backupRequest.Status.PluginOperations = append(ib.backupRequest.Status.PluginOperations, velerov1api.PluginOperation{
+		PluginName:  snapshotter.name,
+		PluginKind:  string(framework.PluginKindItemSnapshotter),
+		OperationID: snapshot.Status.SnapshotID,
+		Resource:    groupResource.String(),
+		Namespace:   snapshot.Spec.Namespace,
+		Name:        snapshot.Spec.Name,
+		Phase:       velerov1api.PluginOperationPhaseInProgress,
+		Created:     &created,
+	})
+
+	return nil
+}
+
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
@@ -376,6 +632,7 @@ func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeS
 	if err != nil {
 		return nil, err
 	}
+	framework.SetLocationTimeout(bs, snapshotLocation.Spec.PluginTimeout)
 
 	if err := bs.Init(snapshotLocation.Spec.Config); err != nil {
 		return nil, err
@@ -401,7 +658,7 @@ const (
 // takePVSnapshot triggers a snapshot for the volume/disk underlying a PersistentVolume if the provided
 // backup has volume snapshots enabled and the PV is of a compatible type. Also records cloud
 // disk type and IOPS (if applicable) to be able to restore to current state later.
-func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.FieldLogger) error {
+func (ib *itemBackupper) takePVSnapshot(ctx context.Context, obj runtime.Unstructured, log logrus.FieldLogger) error {
 	log.Info("Executing takePVSnapshot")
 
 	if boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
@@ -425,6 +682,12 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		}
 	}
 
+	if snapshot := ib.backupRequest.ResumeFrom.completedVolumeSnapshot(pv.Name); snapshot != nil {
+		log.Info("Persistent volume was snapshotted before the backup was interrupted, reusing its snapshot.")
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+		return nil
+	}
+
 	// TODO: -- once failure-domain.beta.kubernetes.io/zone is no longer
 	// supported in any velero-supported version of Kubernetes, remove fallback checking of it
 	pvFailureDomainZone, labelFound := pv.Labels[zoneLabel]
@@ -449,6 +712,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			log.WithError(err).Error("Error getting volume snapshotter for volume snapshot location")
 			continue
 		}
+		bs = framework.WithContext(bs, ctx).(velero.VolumeSnapshotter)
 
 		if volumeID, err = bs.GetVolumeID(obj); err != nil {
 			log.WithError(err).Errorf("Error attempting to get volume ID for persistent volume")
@@ -483,7 +747,9 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	log.Info("Getting volume information")
 	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, pvFailureDomainZone)
 	if err != nil {
-		return errors.WithMessage(err, "error getting volume info")
+		err = errors.WithMessage(err, "error getting volume info")
+		ib.recordSnapshotFailure(pv, err)
+		return err
 	}
 
 	log.Info("Snapshotting persistent volume")
@@ -492,7 +758,9 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	var errs []error
 	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
 	if err != nil {
-		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
+		err = errors.Wrap(err, "error taking snapshot of volume")
+		ib.recordSnapshotFailure(pv, err)
+		errs = append(errs, err)
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
 	} else {
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
@@ -535,3 +803,21 @@ func resourceVersion(obj runtime.Unstructured) string {
 	gvk := obj.GetObjectKind().GroupVersionKind()
 	return gvk.Version
 }
+
+// recordHookFailure records events about a failed hook on the backup and on the item the hook was for.
+func (ib *itemBackupper) recordHookFailure(obj runtime.Unstructured, phase string, err error) {
+	item := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	ib.eventRecorder.Eventf(ib.backupRequest.Backup, corev1api.EventTypeWarning, kube.EventReasonHookFailed,
+		"Backup %s hook for %s %s failed: %v", phase, item.GetKind(), kube.NamespaceAndName(item), err)
+	ib.srcEventRecorder.Eventf(item, corev1api.EventTypeWarning, kube.EventReasonHookFailed,
+		"Backup %s hook for backup %s failed: %v", phase, ib.backupRequest.Name, err)
+}
+
+// recordSnapshotFailure records events about a failed volume snapshot on the backup and on the
+// persistent volume.
+func (ib *itemBackupper) recordSnapshotFailure(pv *corev1api.PersistentVolume, err error) {
+	ib.eventRecorder.Eventf(ib.backupRequest.Backup, corev1api.EventTypeWarning, kube.EventReasonSnapshotFailed,
+		"Snapshot of persistent volume %s failed: %v", pv.Name, err)
+	ib.srcEventRecorder.Eventf(pv, corev1api.EventTypeWarning, kube.EventReasonSnapshotFailed,
+		"Snapshot for backup %s failed: %v", ib.backupRequest.Name, err)
+}
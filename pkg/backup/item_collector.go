@@ -17,6 +17,7 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -25,14 +26,17 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
@@ -54,18 +58,24 @@ type kubernetesResource struct {
 }
 
 // getAllItems gets all relevant items from all API groups.
-func (r *itemCollector) getAllItems() []*kubernetesResource {
+func (r *itemCollector) getAllItems(ctx context.Context) []*kubernetesResource {
+	_, span := tracing.Start(ctx, "itemCollector.getAllItems")
+	defer span.End()
+
+	log := r.log
+
 	var resources []*kubernetesResource
 	for _, group := range r.discoveryHelper.Resources() {
-		groupItems, err := r.getGroupItems(r.log, group)
+		groupItems, err := r.getGroupItems(log, group)
 		if err != nil {
-			r.log.WithError(err).WithField("apiGroup", group.String()).Error("Error collecting resources from API group")
+			log.WithError(err).WithField("apiGroup", group.String()).Error("Error collecting resources from API group")
 			continue
 		}
 
 		resources = append(resources, groupItems...)
 	}
 
+	span.SetAttributes(attribute.Int("velero.backup.items", len(resources)))
 	return resources
 }
 
@@ -273,6 +283,8 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 	}
 
 	var items []*kubernetesResource
+	// hookOrders are the orders of the pods' hooks, so pods are backed up in their hooks' order.
+	hookOrders := map[*kubernetesResource]int{}
 
 	for _, namespace := range namespacesToList {
 		log = log.WithField("namespace", namespace)
@@ -311,15 +323,25 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 				continue
 			}
 
-			items = append(items, &kubernetesResource{
+			resource := &kubernetesResource{
 				groupResource: gr,
 				preferredGVR:  preferredGVR,
 				namespace:     item.GetNamespace(),
 				name:          item.GetName(),
 				path:          path,
-			})
+			}
+			items = append(items, resource)
+
+			if gr == kuberesource.Pods {
+				hookOrders[resource] = hook.PodBackupHookOrder(r.backupRequest.ResourceHooks, item, log)
+			}
 		}
 	}
+	if len(hookOrders) > 0 {
+		sort.SliceStable(items, func(i, j int) bool {
+			return hookOrders[items[i]] < hookOrders[items[j]]
+		})
+	}
 	if len(orders) > 0 {
 		items = sortResourcesByOrder(r.log, items, orders)
 	}
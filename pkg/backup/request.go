@@ -20,8 +20,12 @@ import (
 	"fmt"
 	"sort"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -44,9 +48,32 @@ type Request struct {
 	ResourceHooks             []hook.ResourceHook
 	ResolvedActions           []resolvedAction
 
+	// ItemSnapshotters are the initialized ItemSnapshotters, by name, that snapshot
+	// the items they apply to.
+	ItemSnapshotters         map[string]velero.ItemSnapshotter
+	ResolvedItemSnapshotters []resolvedItemSnapshotter
+
 	VolumeSnapshots  []*volume.Snapshot
+	ItemSnapshots    []*volume.ItemSnapshot
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	BackedUpItems    map[itemKey]struct{}
+
+	// BackedUpItemSizes are the serialized sizes of the backed up items, in bytes. Unlike
+	// BackedUpItems, they're keyed by group-resource rather than API version and kind.
+	BackedUpItemSizes map[itemKey]int64
+	// BackedUpVolumeSizes are the capacities of the backed up persistent volume claims and
+	// persistent volumes, keyed like BackedUpItemSizes.
+	BackedUpVolumeSizes map[itemKey]resource.Quantity
+
+	// HookReport records the hooks executed for the backup's pods.
+	HookReport *hook.ExecutionReport
+
+	// Checkpointer, if set, is used to periodically persist the backup's progress.
+	Checkpointer Checkpointer
+	// ResumeFrom, if set, is the checkpoint of an interrupted run of this backup.
+	// Volume snapshots and pod volume backups recorded in it are reused rather
+	// than taken again.
+	ResumeFrom *Checkpoint
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
@@ -68,3 +95,15 @@ func (r *Request) BackupResourceList() map[string][]string {
 
 	return resources
 }
+
+// BackedUpNamespaces returns the sorted list of namespaces that namespaced items
+// were backed up from.
+func (r *Request) BackedUpNamespaces() []string {
+	namespaces := sets.NewString()
+	for i := range r.BackedUpItems {
+		if i.namespace != "" {
+			namespaces.Insert(i.namespace)
+		}
+	}
+	return namespaces.List()
+}
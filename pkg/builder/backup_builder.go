@@ -227,3 +227,42 @@ func (b *BackupBuilder) OrderedResources(orders map[string]string) *BackupBuilde
 	b.object.Spec.OrderedResources = orders
 	return b
 }
+
+// ReplicationTargets sets the Backup's replication targets.
+func (b *BackupBuilder) ReplicationTargets(locations ...string) *BackupBuilder {
+	b.object.Spec.ReplicationTargets = locations
+	return b
+}
+
+// Replications appends to the Backup's replication statuses.
+func (b *BackupBuilder) Replications(replications ...velerov1api.BackupReplicationStatus) *BackupBuilder {
+	b.object.Status.Replications = append(b.object.Status.Replications, replications...)
+	return b
+}
+
+// PluginOperations appends to the Backup's plugin operations.
+func (b *BackupBuilder) PluginOperations(operations ...velerov1api.PluginOperation) *BackupBuilder {
+	b.object.Status.PluginOperations = append(b.object.Status.PluginOperations, operations...)
+	return b
+}
+
+// ObjectLock sets the Backup's object lock.
+func (b *BackupBuilder) ObjectLock(mode velerov1api.ObjectLockMode, retainUntil time.Time, legalHold bool) *BackupBuilder {
+	b.object.Status.ObjectLock = &velerov1api.BackupObjectLock{
+		Mode:        mode,
+		RetainUntil: &metav1.Time{Time: retainUntil},
+		LegalHold:   legalHold,
+	}
+	return b
+}
+
+// StorageSize sets the Backup's storage size.
+func (b *BackupBuilder) StorageSize(contents, log, metadata int64) *BackupBuilder {
+	b.object.Status.StorageSize = &velerov1api.BackupStorageSize{
+		Contents: contents,
+		Log:      log,
+		Metadata: metadata,
+		Total:    contents + log + metadata,
+	}
+	return b
+}
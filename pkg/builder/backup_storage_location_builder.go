@@ -128,3 +128,32 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// ReplicationTargets sets the BackupStorageLocation's replication targets.
+func (b *BackupStorageLocationBuilder) ReplicationTargets(locations ...string) *BackupStorageLocationBuilder {
+	b.object.Spec.ReplicationTargets = locations
+	return b
+}
+
+// Immutability sets the BackupStorageLocation's immutability.
+func (b *BackupStorageLocationBuilder) Immutability(mode velerov1api.ObjectLockMode, retentionPeriod time.Duration, legalHold bool) *BackupStorageLocationBuilder {
+	b.object.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{
+		Mode:            mode,
+		RetentionPeriod: metav1.Duration{Duration: retentionPeriod},
+		LegalHold:       legalHold,
+	}
+	return b
+}
+
+// Quota sets the BackupStorageLocation's quota.
+func (b *BackupStorageLocationBuilder) Quota(quota *velerov1api.BackupStorageLocationQuota) *BackupStorageLocationBuilder {
+	b.object.Spec.Quota = quota
+	return b
+}
+
+// Usage sets the BackupStorageLocation's backup count and total backup bytes.
+func (b *BackupStorageLocationBuilder) Usage(backupCount int, totalBackupBytes int64) *BackupStorageLocationBuilder {
+	b.object.Status.BackupCount = backupCount
+	b.object.Status.TotalBackupBytes = totalBackupBytes
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PluginBuilder builds Plugin objects.
+type PluginBuilder struct {
+	object *velerov1api.Plugin
+}
+
+// ForPlugin is the constructor for a PluginBuilder.
+func ForPlugin(ns, name string) *PluginBuilder {
+	return &PluginBuilder{
+		object: &velerov1api.Plugin{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "Plugin",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built Plugin.
+func (b *PluginBuilder) Result() *velerov1api.Plugin {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the Plugin's ObjectMeta.
+func (b *PluginBuilder) ObjectMeta(opts ...ObjectMetaOpt) *PluginBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Image sets the Plugin's image and digest.
+func (b *PluginBuilder) Image(image, digest string) *PluginBuilder {
+	b.object.Spec.Image = image
+	b.object.Spec.Digest = digest
+	return b
+}
+
+// ImagePullSecret sets the Plugin's image pull secret.
+func (b *PluginBuilder) ImagePullSecret(name string) *PluginBuilder {
+	b.object.Spec.ImagePullSecret = name
+	return b
+}
+
+// Phase sets the Plugin's phase.
+func (b *PluginBuilder) Phase(phase velerov1api.PluginPhase) *PluginBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// InstalledDigest sets the digest of the Plugin's installed image.
+func (b *PluginBuilder) InstalledDigest(digest string) *PluginBuilder {
+	b.object.Status.InstalledDigest = digest
+	return b
+}
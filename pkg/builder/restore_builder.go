@@ -142,6 +142,12 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// RetryOf sets the name of the Restore whose failed items the Restore retries.
+func (b *RestoreBuilder) RetryOf(name string) *RestoreBuilder {
+	b.object.Spec.RetryOf = name
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
@@ -153,3 +159,9 @@ func (b *RestoreBuilder) CompletionTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.CompletionTimestamp = &metav1.Time{Time: val}
 	return b
 }
+
+// PluginOperations appends to the Restore's plugin operations.
+func (b *RestoreBuilder) PluginOperations(operations ...velerov1api.PluginOperation) *RestoreBuilder {
+	b.object.Status.PluginOperations = append(b.object.Status.PluginOperations, operations...)
+	return b
+}
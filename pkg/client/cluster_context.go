@@ -0,0 +1,135 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ClusterContextProvider provides the context of the cluster that backups, restores or
+// backup deletions operate on, which is passed to item action plugins.
+type ClusterContextProvider interface {
+	// Host returns the URL of the cluster's API server. It's empty for the cluster
+	// Velero runs in.
+	Host() string
+
+	// ClusterContext returns the cluster's context, with a token that's valid for a
+	// while yet if the cluster is a remote one.
+	ClusterContext() (velero.ClusterContext, error)
+}
+
+// LocalClusterContextProvider provides the context of the cluster Velero runs in.
+// Plugins run in the Velero server's pod, so they don't need a token for it.
+type LocalClusterContextProvider struct{}
+
+func (LocalClusterContextProvider) Host() string {
+	return ""
+}
+
+func (LocalClusterContextProvider) ClusterContext() (velero.ClusterContext, error) {
+	return velero.ClusterContext{}, nil
+}
+
+// serviceAccountTokenProvider provides the context of a remote cluster, with a
+// short-lived token for a service account in it, requested with the TokenRequest API.
+// Plugins only get the access that the service account is granted, rather than the
+// Velero server's own credentials for the cluster.
+type serviceAccountTokenProvider struct {
+	cluster         velero.ClusterContext
+	serviceAccounts corev1client.ServiceAccountInterface
+	serviceAccount  string
+	expiration      time.Duration
+	clock           clock.Clock
+
+	lock    sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewClusterContextProvider returns a provider of the context of the cluster at host,
+// which config is the Velero server's client config for, and which Velero connects to
+// through the proxy at proxyURL, if any. If host is empty, it's the cluster Velero runs
+// in. Otherwise, plugins get tokens for serviceAccount in namespace, which expire after
+// expiration. They get no token at all if serviceAccount is empty.
+func NewClusterContextProvider(host string, config *rest.Config, proxyURL string, serviceAccounts corev1client.ServiceAccountsGetter, namespace, serviceAccount string, expiration time.Duration) (ClusterContextProvider, error) {
+	if host == "" {
+		return LocalClusterContextProvider{}, nil
+	}
+
+	// plugins get the cluster's CA as data, so load it if the config refers to a file.
+	config = rest.CopyConfig(config)
+	if err := rest.LoadTLSFiles(config); err != nil {
+		return nil, errors.Wrapf(err, "error loading the TLS files of cluster %s", host)
+	}
+
+	return &serviceAccountTokenProvider{
+		cluster: velero.ClusterContext{
+			Host:     host,
+			CAData:   config.TLSClientConfig.CAData,
+			Insecure: config.TLSClientConfig.Insecure,
+			ProxyURL: proxyURL,
+		},
+		serviceAccounts: serviceAccounts.ServiceAccounts(namespace),
+		serviceAccount:  serviceAccount,
+		expiration:      expiration,
+		clock:           clock.RealClock{},
+	}, nil
+}
+
+func (p *serviceAccountTokenProvider) Host() string {
+	return p.cluster.Host
+}
+
+// ClusterContext returns the remote cluster's context. The token is cached, and a new
+// one is requested once less than half of its lifetime is left, so that a plugin has
+// some time to use it.
+func (p *serviceAccountTokenProvider) ClusterContext() (velero.ClusterContext, error) {
+	if p.serviceAccount == "" {
+		return p.cluster, nil
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.token == "" || p.clock.Now().Add(p.expiration/2).After(p.expires) {
+		seconds := int64(p.expiration.Seconds())
+		tokenRequest, err := p.serviceAccounts.CreateToken(context.Background(), p.serviceAccount, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &seconds},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return velero.ClusterContext{}, errors.Wrapf(err, "error requesting a token for service account %s in cluster %s", p.serviceAccount, p.cluster.Host)
+		}
+
+		p.token = tokenRequest.Status.Token
+		p.expires = tokenRequest.Status.ExpirationTimestamp.Time
+	}
+
+	cluster := p.cluster
+	cluster.Token = p.token
+	return cluster, nil
+}
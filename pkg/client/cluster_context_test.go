@@ -0,0 +1,127 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	core "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func TestLocalClusterContextProvider(t *testing.T) {
+	provider, err := NewClusterContextProvider("", &rest.Config{}, "", fake.NewSimpleClientset().CoreV1(), "velero", "velero-plugin", 10*time.Minute)
+	require.NoError(t, err)
+
+	cluster, err := provider.ClusterContext()
+	require.NoError(t, err)
+	assert.True(t, cluster.IsLocal())
+	assert.Equal(t, "", provider.Host())
+}
+
+func TestServiceAccountTokenProvider(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFakeClock(now)
+
+	kubeClient := fake.NewSimpleClientset()
+	var requests []*authenticationv1.TokenRequest
+	kubeClient.PrependReactor("create", "serviceaccounts", func(action core.Action) (bool, runtime.Object, error) {
+		createAction := action.(core.CreateAction)
+		assert.Equal(t, "token", createAction.GetSubresource())
+		assert.Equal(t, "velero", createAction.GetNamespace())
+
+		request := createAction.GetObject().(*authenticationv1.TokenRequest)
+		requests = append(requests, request)
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", len(requests)),
+			ExpirationTimestamp: metav1.NewTime(fakeClock.Now().Add(time.Duration(*request.Spec.ExpirationSeconds) * time.Second)),
+		}
+		return true, request, nil
+	})
+
+	config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")}}
+	provider, err := NewClusterContextProvider("https://source.example.com", config, "http://proxy.example.com:3128", kubeClient.CoreV1(), "velero", "velero-plugin", 10*time.Minute)
+	require.NoError(t, err)
+	provider.(*serviceAccountTokenProvider).clock = fakeClock
+
+	cluster, err := provider.ClusterContext()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ClusterContext{Host: "https://source.example.com", Token: "token-1", CAData: []byte("ca"), ProxyURL: "http://proxy.example.com:3128"}, cluster)
+	require.Len(t, requests, 1)
+	assert.Equal(t, int64(600), *requests[0].Spec.ExpirationSeconds)
+
+	// the token is reused while more than half of its lifetime is left
+	fakeClock.Step(4 * time.Minute)
+	cluster, err = provider.ClusterContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", cluster.Token)
+	assert.Len(t, requests, 1)
+
+	fakeClock.Step(2 * time.Minute)
+	cluster, err = provider.ClusterContext()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", cluster.Token)
+	assert.Len(t, requests, 2)
+}
+
+func TestServiceAccountTokenProviderWithoutServiceAccount(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	provider, err := NewClusterContextProvider("https://source.example.com", &rest.Config{}, "", kubeClient.CoreV1(), "velero", "", 10*time.Minute)
+	require.NoError(t, err)
+
+	cluster, err := provider.ClusterContext()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ClusterContext{Host: "https://source.example.com"}, cluster)
+	assert.Empty(t, kubeClient.Actions())
+}
+
+func TestClusterContextProviderLoadsCAFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cluster-context")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, ioutil.WriteFile(caFile, []byte("ca"), 0600))
+
+	config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAFile: caFile}}
+	provider, err := NewClusterContextProvider("https://source.example.com", config, "", fake.NewSimpleClientset().CoreV1(), "velero", "", 10*time.Minute)
+	require.NoError(t, err)
+
+	cluster, err := provider.ClusterContext()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ca"), cluster.CAData)
+	// the server's own config is left as is
+	assert.Empty(t, config.TLSClientConfig.CAData)
+
+	config.TLSClientConfig.CAFile = filepath.Join(dir, "missing.crt")
+	_, err = NewClusterContextProvider("https://source.example.com", config, "", fake.NewSimpleClientset().CoreV1(), "velero", "", 10*time.Minute)
+	assert.Error(t, err)
+}
@@ -35,6 +35,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
+		NewGetItemCommand(f),
+		NewListContentsCommand(f),
+		NewCompareCommand(f),
+		NewCopyCommand(f),
 		NewDeleteCommand(f, "delete"),
 	)
 
@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewCompareCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewCompareOptions()
+	o.caCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "compare FROM TO",
+		Short: "Compare the contents of two backups",
+		Long: `Compare the number of items, and their size, per resource and namespace, between two backups.
+
+Only backups taken by a Velero server that records content indexes can be compared.`,
+		Example: `  # Show what changed between backups "backup-1" and "backup-2".
+  velero backup compare backup-1 backup-2
+
+  # Show all the resources and namespaces in either backup, including the unchanged ones.
+  velero backup compare backup-1 backup-2 --all`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(f, os.Stdout))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CompareOptions struct {
+	From                  string
+	To                    string
+	All                   bool
+	InsecureSkipTLSVerify bool
+	caCertFile            string
+}
+
+func NewCompareOptions() *CompareOptions {
+	return &CompareOptions{}
+}
+
+func (o *CompareOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.All, "all", o.All, "Show the resources and namespaces whose contents didn't change too.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *CompareOptions) Complete(args []string) error {
+	o.From = args[0]
+	o.To = args[1]
+	return nil
+}
+
+func (o *CompareOptions) Run(f client.Factory, w io.Writer) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	indexes := make([]*pkgbackup.ContentIndex, 2)
+	for i, name := range []string{o.From, o.To} {
+		index, err := output.GetBackupContentIndex(context.Background(), kbClient, f.Namespace(), name, o.InsecureSkipTLSVerify, o.caCertFile)
+		if err == downloadrequest.ErrNotFound {
+			return errors.Errorf("backup %s has no content index, it may have been taken by an older version of Velero", name)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error getting the content index of backup %s", name)
+		}
+		indexes[i] = index
+	}
+
+	printContentIndexDiff(w, indexes[0], indexes[1], o.All)
+	return nil
+}
+
+func printContentIndexDiff(w io.Writer, from, to *pkgbackup.ContentIndex, all bool) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tNAMESPACE\tITEMS\tBYTES")
+	for _, diff := range pkgbackup.CompareContentIndexes(from, to, all) {
+		namespace := diff.Namespace
+		if namespace == "" {
+			namespace = "<cluster-scoped>"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", diff.Resource, namespace,
+			countChange(int64(diff.FromItems), int64(diff.ToItems)), countChange(diff.FromBytes, diff.ToBytes))
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t%s\t%s\n",
+		countChange(int64(from.TotalItems()), int64(to.TotalItems())), countChange(from.TotalBytes(), to.TotalBytes()))
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nVolume bytes: %s\n", countChange(from.TotalVolumeBytes(), to.TotalVolumeBytes()))
+}
+
+// countChange formats how a count changed, like "3 -> 5 (+2)", or just the count if it
+// didn't change.
+func countChange(from, to int64) string {
+	if from == to {
+		return fmt.Sprintf("%d", to)
+	}
+	return fmt.Sprintf("%d -> %d (%+d)", from, to, to-from)
+}
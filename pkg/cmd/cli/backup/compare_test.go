@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+)
+
+func TestPrintContentIndexDiff(t *testing.T) {
+	from := &pkgbackup.ContentIndex{
+		Resources: []pkgbackup.ResourceContents{
+			{Resource: "configmaps", Namespace: "foo", Items: 2, Bytes: 200},
+			{Resource: "pods", Namespace: "foo", Items: 3, Bytes: 3000},
+		},
+		Volumes: []pkgbackup.VolumeContents{{Resource: "persistentvolumes", Name: "pv-1", Size: "1Ki", Bytes: 1024}},
+	}
+	to := &pkgbackup.ContentIndex{
+		Resources: []pkgbackup.ResourceContents{
+			{Resource: "configmaps", Namespace: "foo", Items: 2, Bytes: 200},
+			{Resource: "pods", Namespace: "foo", Items: 4, Bytes: 4100},
+			{Resource: "storageclasses.storage.k8s.io", Items: 1, Bytes: 100},
+		},
+		Volumes: []pkgbackup.VolumeContents{{Resource: "persistentvolumes", Name: "pv-1", Size: "1Ki", Bytes: 1024}},
+	}
+
+	var buf bytes.Buffer
+	printContentIndexDiff(&buf, from, to, false)
+	assert.Equal(t, `RESOURCE                       NAMESPACE         ITEMS        BYTES
+pods                           foo               3 -> 4 (+1)  3000 -> 4100 (+1100)
+storageclasses.storage.k8s.io  <cluster-scoped>  0 -> 1 (+1)  0 -> 100 (+100)
+TOTAL                                            5 -> 7 (+2)  3200 -> 4400 (+1200)
+
+Volume bytes: 1024
+`, buf.String())
+}
@@ -0,0 +1,231 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// ContentsOptions are the options for downloading a backup's contents.
+type ContentsOptions struct {
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	caCertFile            string
+}
+
+func NewContentsOptions() *ContentsOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return &ContentsOptions{
+		Timeout:    time.Minute,
+		caCertFile: config.CACertFile(),
+	}
+}
+
+func (o *ContentsOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+// backupContents is a backup's tarball, extracted to a directory.
+type backupContents struct {
+	fs        filesystem.Interface
+	dir       string
+	resources map[string]*archive.ResourceItems
+}
+
+// backupItem identifies an item in a backup's tarball.
+type backupItem struct {
+	Resource  string
+	Namespace string
+	Name      string
+}
+
+// downloadBackupContents streams the backup's tarball through the extractor into a temp
+// directory, and parses it. The caller must call remove once done with the contents.
+func downloadBackupContents(f client.Factory, name string, o *ContentsOptions) (*backupContents, error) {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return nil, err
+	}
+
+	log := logrus.New()
+	log.SetLevel(logrus.WarnLevel)
+	fs := filesystem.NewFileSystem()
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), name, velerov1api.DownloadTargetKindBackupContents, writer, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile))
+	}()
+
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(reader)
+	// unblock the download if the extractor stopped reading early
+	reader.CloseWithError(errors.New("backup contents are no longer being read"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error extracting backup %s", name)
+	}
+
+	contents, err := parseBackupContents(log, fs, dir)
+	if err != nil {
+		fs.RemoveAll(dir)
+		return nil, err
+	}
+	return contents, nil
+}
+
+func parseBackupContents(log logrus.FieldLogger, fs filesystem.Interface, dir string) (*backupContents, error) {
+	resources, err := archive.NewParser(log, fs).Parse(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing backup contents")
+	}
+
+	return &backupContents{
+		fs:        fs,
+		dir:       dir,
+		resources: resources,
+	}, nil
+}
+
+// remove deletes the extracted contents.
+func (b *backupContents) remove() error {
+	return b.fs.RemoveAll(b.dir)
+}
+
+// resolveResource returns the group-resource in the backup that resource refers to. resource is
+// either a group-resource, like "deployments.apps", or a resource without its group, like
+// "deployments", as long as only one group in the backup has a resource by that name.
+func (b *backupContents) resolveResource(resource string) (string, error) {
+	if _, ok := b.resources[resource]; ok {
+		return resource, nil
+	}
+
+	var matches []string
+	for groupResource := range b.resources {
+		if strings.SplitN(groupResource, ".", 2)[0] == resource {
+			matches = append(matches, groupResource)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", errors.Errorf("resource %s was not found in the backup", resource)
+	case 1:
+		return matches[0], nil
+	default:
+		sort.Strings(matches)
+		return "", errors.Errorf("resource %s is ambiguous, it could be any of %s", resource, strings.Join(matches, ", "))
+	}
+}
+
+// getItem returns the backed up version of an item. namespace is empty for cluster-scoped
+// items.
+func (b *backupContents) getItem(resource, namespace, name string) (*unstructured.Unstructured, error) {
+	groupResource, err := b.resolveResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, item := range b.resources[groupResource].ItemsByNamespace[namespace] {
+		if item == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		if namespace == "" {
+			return nil, errors.Errorf("%s %s was not found in the backup", groupResource, name)
+		}
+		return nil, errors.Errorf("%s %s/%s was not found in the backup", groupResource, namespace, name)
+	}
+
+	obj, err := archive.Unmarshal(b.fs, archive.GetItemFilePath(b.dir, groupResource, namespace, name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s %s from the backup", groupResource, name)
+	}
+	return obj, nil
+}
+
+// listItems returns the items of the resources, in the namespaces, sorted by resource,
+// namespace and name. If no resources or "*" are specified, it returns the items of all
+// resources. Cluster-scoped items are only returned if all namespaces are included.
+func (b *backupContents) listItems(resources []string, namespaces *collections.IncludesExcludes) ([]backupItem, error) {
+	var groupResources []string
+	if len(resources) == 0 || (len(resources) == 1 && resources[0] == "*") {
+		for groupResource := range b.resources {
+			groupResources = append(groupResources, groupResource)
+		}
+	} else {
+		for _, resource := range resources {
+			groupResource, err := b.resolveResource(resource)
+			if err != nil {
+				return nil, err
+			}
+			groupResources = append(groupResources, groupResource)
+		}
+	}
+
+	var items []backupItem
+	for _, groupResource := range groupResources {
+		for namespace, names := range b.resources[groupResource].ItemsByNamespace {
+			if namespace == "" && !namespaces.IncludeEverything() {
+				continue
+			}
+			if namespace != "" && !namespaces.ShouldInclude(namespace) {
+				continue
+			}
+
+			for _, name := range names {
+				items = append(items, backupItem{Resource: groupResource, Namespace: namespace, Name: name})
+			}
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Resource != items[j].Resource {
+			return items[i].Resource < items[j].Resource
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items, nil
+}
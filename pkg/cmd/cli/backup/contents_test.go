@@ -0,0 +1,101 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func newTestBackupContents(t *testing.T) *backupContents {
+	fs := test.NewFakeFileSystem().
+		WithFile("backup/resources/configmaps/namespaces/foo/bar.json", []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"foo","name":"bar"}}`)).
+		WithFile("backup/resources/configmaps/namespaces/baz/qux.json", []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"baz","name":"qux"}}`)).
+		WithFile("backup/resources/storageclasses.storage.k8s.io/cluster/standard.json", []byte(`{"apiVersion":"storage.k8s.io/v1","kind":"StorageClass","metadata":{"name":"standard"}}`)).
+		WithFile("backup/resources/widgets.foo/cluster/widget-1.json", []byte(`{"apiVersion":"foo/v1","kind":"Widget","metadata":{"name":"widget-1"}}`)).
+		WithFile("backup/resources/widgets.bar/cluster/widget-2.json", []byte(`{"apiVersion":"bar/v1","kind":"Widget","metadata":{"name":"widget-2"}}`))
+
+	contents, err := parseBackupContents(test.NewLogger(), fs, "backup")
+	require.NoError(t, err)
+	return contents
+}
+
+func TestBackupContentsGetItem(t *testing.T) {
+	contents := newTestBackupContents(t)
+
+	item, err := contents.getItem("configmaps", "foo", "bar")
+	require.NoError(t, err)
+	assert.Equal(t, "ConfigMap", item.GetKind())
+	assert.Equal(t, "bar", item.GetName())
+
+	item, err = contents.getItem("storageclasses", "", "standard")
+	require.NoError(t, err)
+	assert.Equal(t, "StorageClass", item.GetKind())
+
+	_, err = contents.getItem("configmaps", "baz", "bar")
+	assert.EqualError(t, err, "configmaps baz/bar was not found in the backup")
+
+	_, err = contents.getItem("secrets", "foo", "bar")
+	assert.EqualError(t, err, "resource secrets was not found in the backup")
+
+	_, err = contents.getItem("widgets", "", "widget-1")
+	assert.EqualError(t, err, "resource widgets is ambiguous, it could be any of widgets.bar, widgets.foo")
+
+	_, err = contents.getItem("widgets.foo", "", "widget-1")
+	assert.NoError(t, err)
+}
+
+func TestBackupContentsListItems(t *testing.T) {
+	contents := newTestBackupContents(t)
+
+	items, err := contents.listItems(nil, collections.NewIncludesExcludes())
+	require.NoError(t, err)
+	assert.Equal(t, []backupItem{
+		{Resource: "configmaps", Namespace: "baz", Name: "qux"},
+		{Resource: "configmaps", Namespace: "foo", Name: "bar"},
+		{Resource: "storageclasses.storage.k8s.io", Name: "standard"},
+		{Resource: "widgets.bar", Name: "widget-2"},
+		{Resource: "widgets.foo", Name: "widget-1"},
+	}, items)
+
+	items, err = contents.listItems([]string{"*"}, collections.NewIncludesExcludes().Includes("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, []backupItem{{Resource: "configmaps", Namespace: "foo", Name: "bar"}}, items)
+
+	items, err = contents.listItems([]string{"storageclasses", "configmaps"}, collections.NewIncludesExcludes().Excludes("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, []backupItem{{Resource: "configmaps", Namespace: "baz", Name: "qux"}}, items)
+
+	_, err = contents.listItems([]string{"widgets"}, collections.NewIncludesExcludes())
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	printBackupItems(&buf, []backupItem{
+		{Resource: "configmaps", Namespace: "foo", Name: "bar"},
+		{Resource: "storageclasses.storage.k8s.io", Name: "standard"},
+	})
+	assert.Equal(t, `RESOURCE                       NAMESPACE         NAME
+configmaps                     foo               bar
+storageclasses.storage.k8s.io  <cluster-scoped>  standard
+`, buf.String())
+}
@@ -0,0 +1,172 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+func NewCopyCommand(f client.Factory) *cobra.Command {
+	o := NewCopyOptions()
+
+	c := &cobra.Command{
+		Use:   "copy NAME",
+		Short: "Copy a backup to other backup storage locations",
+		Long: `Copy a backup to other backup storage locations.
+
+The locations are added to the backup's replication targets. Once the backup completes, Velero copies
+its files, including the data of its restic snapshots, to each of them and records the status of each
+copy in the backup's status. Copies that failed are retried periodically, or when this command is run
+again. Copies are deleted with the backup.`,
+		Example: `  # Copy backup-1 to the backup storage location named "secondary".
+  velero backup copy backup-1 --to-location secondary`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CopyOptions struct {
+	Name       string
+	ToLocation []string
+
+	client veleroclient.Interface
+}
+
+func NewCopyOptions() *CopyOptions {
+	return &CopyOptions{}
+}
+
+func (o *CopyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVar(&o.ToLocation, "to-location", o.ToLocation, "Backup storage locations to copy the backup to.")
+}
+
+func (o *CopyOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.client = client
+	return nil
+}
+
+func (o *CopyOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if len(o.ToLocation) == 0 {
+		return errors.New("--to-location is required")
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	for _, loc := range o.ToLocation {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      loc,
+		}, location); err != nil {
+			return err
+		}
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			return errors.Errorf("backup storage location %s is in read-only mode", loc)
+		}
+	}
+
+	return nil
+}
+
+func (o *CopyOptions) Run(c *cobra.Command, f client.Factory) error {
+	backup, err := o.client.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	original, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, loc := range o.ToLocation {
+		if loc == backup.Spec.StorageLocation {
+			return errors.Errorf("backup %s is stored in backup storage location %s", backup.Name, loc)
+		}
+		if !contains(backup.Spec.ReplicationTargets, loc) {
+			backup.Spec.ReplicationTargets = append(backup.Spec.ReplicationTargets, loc)
+		}
+
+		// forget failed copies, so they're retried right away
+		var replications []velerov1api.BackupReplicationStatus
+		for _, replication := range backup.Status.Replications {
+			if replication.Location != loc || replication.Phase != velerov1api.BackupReplicationPhaseFailed {
+				replications = append(replications, replication)
+			}
+		}
+		backup.Status.Replications = replications
+	}
+
+	updated, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	patchBytes, err := jsonpatch.CreateMergePatch(original, updated)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := o.client.VeleroV1().Backups(backup.Namespace).Patch(context.TODO(), backup.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	fmt.Printf("Backup %q will be copied to %s. Run `velero backup describe %s` to see the status of the copies.\n", backup.Name, strings.Join(o.ToLocation, ", "), backup.Name)
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
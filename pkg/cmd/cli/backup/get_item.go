@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+func NewGetItemCommand(f client.Factory) *cobra.Command {
+	o := NewGetItemOptions()
+
+	c := &cobra.Command{
+		Use:   "get-item NAME",
+		Short: "Get a single item from a backup",
+		Long: `Get the backed up version of a single item from a backup, without restoring it.
+
+The backup's contents are downloaded and extracted locally, and the item is printed, or written to a file.`,
+		Example: `  # Print the configmap "bar" in namespace "foo" from backup "backup-1".
+  velero backup get-item backup-1 --resource configmaps --item-namespace foo --name bar
+
+  # Write the cluster-scoped storage class "standard" from backup "backup-1" to a file, as JSON.
+  velero backup get-item backup-1 --resource storageclasses.storage.k8s.io --name standard -o json --output-file standard.json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate(f))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type GetItemOptions struct {
+	*ContentsOptions
+
+	BackupName string
+	Resource   string
+	Namespace  string
+	Name       string
+	Output     string
+	OutputFile string
+	Force      bool
+}
+
+func NewGetItemOptions() *GetItemOptions {
+	return &GetItemOptions{
+		ContentsOptions: NewContentsOptions(),
+		Output:          "yaml",
+	}
+}
+
+func (o *GetItemOptions) BindFlags(flags *pflag.FlagSet) {
+	o.ContentsOptions.BindFlags(flags)
+	flags.StringVar(&o.Resource, "resource", o.Resource, "Resource of the item, formatted as resource.group, such as deployments.apps. The group can be left out if only one group in the backup has a resource by that name.")
+	flags.StringVar(&o.Namespace, "item-namespace", o.Namespace, "Namespace of the item. Leave it empty for cluster-scoped items.")
+	flags.StringVar(&o.Name, "name", o.Name, "Name of the item.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format. Valid values are 'json' and 'yaml'.")
+	flags.StringVar(&o.OutputFile, "output-file", o.OutputFile, "Path of the file to write the item to. Defaults to standard output.")
+	flags.BoolVar(&o.Force, "force", o.Force, "Overwrite the output file if it exists already.")
+}
+
+func (o *GetItemOptions) Complete(args []string) error {
+	o.BackupName = args[0]
+	return nil
+}
+
+func (o *GetItemOptions) Validate(f client.Factory) error {
+	if o.Resource == "" {
+		return errors.New("--resource is required")
+	}
+	if o.Name == "" {
+		return errors.New("--name is required")
+	}
+	if o.Output != "json" && o.Output != "yaml" {
+		return errors.Errorf("invalid output format %q, valid values are 'json' and 'yaml'", o.Output)
+	}
+
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetItemOptions) Run(f client.Factory) error {
+	contents, err := downloadBackupContents(f, o.BackupName, o.ContentsOptions)
+	if err != nil {
+		return err
+	}
+	defer contents.remove()
+
+	item, err := contents.getItem(o.Resource, o.Namespace, o.Name)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if o.OutputFile != "" {
+		writeOptions := os.O_RDWR | os.O_CREATE | os.O_EXCL
+		if o.Force {
+			writeOptions = os.O_RDWR | os.O_CREATE | os.O_TRUNC
+		}
+
+		file, err := os.OpenFile(o.OutputFile, writeOptions, 0600)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := encode.EncodeTo(item, o.Output, w); err != nil {
+		return err
+	}
+
+	if o.OutputFile != "" {
+		fmt.Fprintf(os.Stderr, "Item has been successfully written to %s\n", o.OutputFile)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func NewListContentsCommand(f client.Factory) *cobra.Command {
+	o := NewListContentsOptions()
+
+	c := &cobra.Command{
+		Use:   "ls NAME",
+		Short: "List the items in a backup",
+		Long: `List the items in a backup, by resource, namespace and name.
+
+The backup's contents are downloaded and extracted locally. Use 'velero backup get-item' to get one of the items.`,
+		Example: `  # List all the items in backup "backup-1".
+  velero backup ls backup-1
+
+  # List the items in namespace "foo" in backup "backup-1".
+  velero backup ls backup-1 --include-namespaces foo
+
+  # List the deployments and configmaps in backup "backup-1".
+  velero backup ls backup-1 --include-resources deployments,configmaps`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate(f))
+			cmd.CheckError(o.Run(f, os.Stdout))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type ListContentsOptions struct {
+	*ContentsOptions
+
+	BackupName        string
+	IncludeNamespaces flag.StringArray
+	ExcludeNamespaces flag.StringArray
+	IncludeResources  flag.StringArray
+}
+
+func NewListContentsOptions() *ListContentsOptions {
+	return &ListContentsOptions{
+		ContentsOptions:   NewContentsOptions(),
+		IncludeNamespaces: flag.NewStringArray("*"),
+	}
+}
+
+func (o *ListContentsOptions) BindFlags(flags *pflag.FlagSet) {
+	o.ContentsOptions.BindFlags(flags)
+	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to list the items of (use '*' for all namespaces). Cluster-scoped items are only listed for all namespaces.")
+	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces not to list the items of.")
+	flags.Var(&o.IncludeResources, "include-resources", "Resources to list the items of, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources). The group can be left out if only one group in the backup has a resource by that name.")
+}
+
+func (o *ListContentsOptions) Complete(args []string) error {
+	o.BackupName = args[0]
+	return nil
+}
+
+func (o *ListContentsOptions) Validate(f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListContentsOptions) Run(f client.Factory, w io.Writer) error {
+	contents, err := downloadBackupContents(f, o.BackupName, o.ContentsOptions)
+	if err != nil {
+		return err
+	}
+	defer contents.remove()
+
+	namespaces := collections.NewIncludesExcludes().Includes(o.IncludeNamespaces...).Excludes(o.ExcludeNamespaces...)
+	items, err := contents.listItems(o.IncludeResources, namespaces)
+	if err != nil {
+		return err
+	}
+
+	printBackupItems(w, items)
+	return nil
+}
+
+func printBackupItems(w io.Writer, items []backupItem) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tNAMESPACE\tNAME")
+	for _, item := range items {
+		namespace := item.Namespace
+		if namespace == "" {
+			namespace = "<cluster-scoped>"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", item.Resource, namespace, item.Name)
+	}
+	tw.Flush()
+}
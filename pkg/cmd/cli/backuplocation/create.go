@@ -27,6 +27,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -72,6 +73,12 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	ReplicationTargets                    []string
+	ImmutabilityRetention                 time.Duration
+	ImmutabilityMode                      *flag.Enum
+	LegalHold                             bool
+	QuotaBytes                            string
+	QuotaBackups                          int
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -83,6 +90,11 @@ func NewCreateOptions() *CreateOptions {
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		ImmutabilityMode: flag.NewEnum(
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
 	}
 }
 
@@ -96,12 +108,22 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "How often to verify if the backup storage location is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
+	flags.StringSliceVar(&o.ReplicationTargets, "replication-targets", o.ReplicationTargets, "List of backup storage locations the backups stored in this location should be copied to once they complete. Optional.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.DurationVar(&o.ImmutabilityRetention, "immutability-retention", o.ImmutabilityRetention, "How long the files of the backups stored in this location are locked in object storage after the backups complete. Optional. The provider's plugin must support object locks.")
+	flags.Var(
+		o.ImmutabilityMode,
+		"immutability-mode",
+		fmt.Sprintf("Retention mode of the locks set on the files of the backups. Valid values are %s", strings.Join(o.ImmutabilityMode.AllowedValues(), ",")),
+	)
+	flags.BoolVar(&o.LegalHold, "legal-hold", o.LegalHold, "Place the files of the backups stored in this location under a legal hold. Optional.")
+	flags.StringVar(&o.QuotaBytes, "quota-bytes", o.QuotaBytes, "Most space the backups stored in this location may take up, e.g. 500Gi. New backups to the location fail validation once it's reached. Optional.")
+	flags.IntVar(&o.QuotaBackups, "quota-backups", o.QuotaBackups, "Most backups this location may store. New backups to the location fail validation once it's reached. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -125,6 +147,18 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.ImmutabilityRetention < 0 {
+		return errors.New("--immutability-retention must be non-negative")
+	}
+
+	if o.ImmutabilityRetention == 0 && (c.Flags().Changed("immutability-mode") || o.LegalHold) {
+		return errors.New("--immutability-mode and --legal-hold require --immutability-retention")
+	}
+
+	if _, err := parseQuota(o.QuotaBytes, o.QuotaBackups); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -184,9 +218,24 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			AccessMode:          velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
 			BackupSyncPeriod:    backupSyncPeriod,
 			ValidationFrequency: validationFrequency,
+			ReplicationTargets:  o.ReplicationTargets,
 		},
 	}
 
+	if o.ImmutabilityRetention > 0 {
+		backupStorageLocation.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{
+			Mode:            velerov1api.ObjectLockMode(o.ImmutabilityMode.String()),
+			RetentionPeriod: metav1.Duration{Duration: o.ImmutabilityRetention},
+			LegalHold:       o.LegalHold,
+		}
+	}
+
+	quota, err := parseQuota(o.QuotaBytes, o.QuotaBackups)
+	if err != nil {
+		return err
+	}
+	backupStorageLocation.Spec.Quota = quota
+
 	if printed, err := output.PrintWithFormat(c, backupStorageLocation); printed || err != nil {
 		return err
 	}
@@ -221,3 +270,33 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup storage location %q configured successfully.\n", backupStorageLocation.Name)
 	return nil
 }
+
+// parseQuota returns the quota set by the --quota-bytes and --quota-backups flags, or nil
+// if neither sets a limit.
+func parseQuota(bytes string, backups int) (*velerov1api.BackupStorageLocationQuota, error) {
+	if backups < 0 {
+		return nil, errors.New("--quota-backups must be non-negative")
+	}
+
+	quota := new(velerov1api.BackupStorageLocationQuota)
+	if bytes != "" {
+		q, err := resource.ParseQuantity(bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid --quota-bytes")
+		}
+		if q.Sign() < 0 {
+			return nil, errors.New("--quota-bytes must be non-negative")
+		}
+		if !q.IsZero() {
+			quota.Bytes = &q
+		}
+	}
+	if backups > 0 {
+		quota.Backups = &backups
+	}
+
+	if quota.Bytes == nil && quota.Backups == nil {
+		return nil, nil
+	}
+	return quota, nil
+}
@@ -59,6 +59,9 @@ type SetOptions struct {
 	CACertFile                   string
 	Credential                   flag.Map
 	DefaultBackupStorageLocation bool
+	ReplicationTargets           []string
+	QuotaBytes                   string
+	QuotaBackups                 int
 }
 
 func NewSetOptions() *SetOptions {
@@ -71,6 +74,9 @@ func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
+	flags.StringSliceVar(&o.ReplicationTargets, "replication-targets", o.ReplicationTargets, "Sets the list of backup storage locations the backups stored in this location are copied to once they complete. Optional.")
+	flags.StringVar(&o.QuotaBytes, "quota-bytes", o.QuotaBytes, "Sets the most space the backups stored in this location may take up, e.g. 500Gi. Set to 0 to remove the limit. Optional.")
+	flags.IntVar(&o.QuotaBackups, "quota-backups", o.QuotaBackups, "Sets the most backups this location may store. Set to 0 to remove the limit. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -78,6 +84,10 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if _, err := parseQuota(o.QuotaBytes, o.QuotaBackups); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -139,6 +149,31 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 	location.Spec.Default = o.DefaultBackupStorageLocation
 	location.Spec.StorageType.ObjectStorage.CACert = caCertData
 
+	if c.Flags().Changed("replication-targets") {
+		location.Spec.ReplicationTargets = o.ReplicationTargets
+	}
+
+	if c.Flags().Changed("quota-bytes") || c.Flags().Changed("quota-backups") {
+		quota := location.Spec.Quota
+		if quota == nil {
+			quota = new(velerov1api.BackupStorageLocationQuota)
+		}
+		changed, _ := parseQuota(o.QuotaBytes, o.QuotaBackups)
+		if changed == nil {
+			changed = new(velerov1api.BackupStorageLocationQuota)
+		}
+		if c.Flags().Changed("quota-bytes") {
+			quota.Bytes = changed.Bytes
+		}
+		if c.Flags().Changed("quota-backups") {
+			quota.Backups = changed.Backups
+		}
+		location.Spec.Quota = quota
+		if quota.Bytes == nil && quota.Backups == nil {
+			location.Spec.Quota = nil
+		}
+	}
+
 	for name, key := range o.Credential.Data() {
 		location.Spec.Credential = builder.ForSecretKeySelector(name, key).Result()
 		break
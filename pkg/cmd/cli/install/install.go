@@ -39,6 +39,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/persistence/filesystem"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -78,6 +79,8 @@ type InstallOptions struct {
 	DefaultVolumesToRestic            bool
 	HttpsProxy                        string
 	HttpProxy                         string
+	FileSystemPVC                     string
+	FileSystemHostPath                string
 }
 
 // BindFlags adds command line values to the options struct.
@@ -112,6 +115,8 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CRDsVersion, "crds-version", o.CRDsVersion, "The version to generate CustomResourceDefinition resources if Velero can't discover the Kubernetes preferred CRD API version. Optional.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.StringVar(&o.Features, "features", o.Features, "Comma separated list of Velero feature flags to be set on the Velero deployment and the restic daemonset, if restic is enabled")
+	flags.StringVar(&o.FileSystemPVC, "filesystem-pvc", o.FileSystemPVC, "Persistent volume claim, such as an NFS one, to mount in the Velero pod to store backups with the filesystem provider. Optional.")
+	flags.StringVar(&o.FileSystemHostPath, "filesystem-host-path", o.FileSystemHostPath, "Directory of the node's filesystem to mount in the Velero pod to store backups with the filesystem provider. Optional.")
 	flags.BoolVar(&o.DefaultVolumesToRestic, "default-volumes-to-restic", o.DefaultVolumesToRestic, "Bool flag to configure Velero server to use restic by default to backup all pod volumes on all backups. Optional.")
 }
 
@@ -199,6 +204,8 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		DefaultVolumesToRestic:            o.DefaultVolumesToRestic,
 		HttpsProxy:                        o.HttpsProxy,
 		HttpProxy:                         o.HttpProxy,
+		FileSystemPVC:                     o.FileSystemPVC,
+		FileSystemHostPath:                o.FileSystemHostPath,
 	}, nil
 }
 
@@ -241,7 +248,9 @@ This is useful as a starting point for more customized installations.
 
   # velero install --provider gcp --plugins velero/velero-plugin-for-gcp:v1.0.0 --bucket gcp-backups --secret-file ./gcp-creds.json --restic-pod-cpu-request=1000m --restic-pod-cpu-limit=5000m --restic-pod-mem-request=512Mi --restic-pod-mem-limit=1024Mi
 
-  # velero install --provider azure --plugins velero/velero-plugin-for-microsoft-azure:v1.0.0 --bucket $BLOB_CONTAINER --secret-file ./credentials-velero --backup-location-config resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,storageAccount=$AZURE_STORAGE_ACCOUNT_ID[,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID] --snapshot-location-config apiTimeout=<YOUR_TIMEOUT>[,resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID]`,
+  # velero install --provider azure --plugins velero/velero-plugin-for-microsoft-azure:v1.0.0 --bucket $BLOB_CONTAINER --secret-file ./credentials-velero --backup-location-config resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,storageAccount=$AZURE_STORAGE_ACCOUNT_ID[,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID] --snapshot-location-config apiTimeout=<YOUR_TIMEOUT>[,resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID]
+
+  # velero install --provider filesystem --bucket backups --no-secret --use-volume-snapshots=false --filesystem-pvc nfs-backups --backup-location-config downloadURL=https://velero-downloads.example.com`,
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Validate(c, args, f))
 			cmd.CheckError(o.Complete(args, f))
@@ -417,11 +426,23 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 			return errors.New("--provider must be empty when using --no-default-backup-location and --use-volume-snapshots=false")
 		}
 	} else {
-		if len(o.Plugins) == 0 {
+		// the filesystem provider is built into Velero
+		if len(o.Plugins) == 0 && o.ProviderName != filesystem.ProviderName {
 			return errors.New("--plugins flag is required")
 		}
 	}
 
+	if o.ProviderName == filesystem.ProviderName {
+		if o.UseVolumeSnapshots {
+			return errors.Errorf("--use-volume-snapshots=false is required with the %s provider", filesystem.ProviderName)
+		}
+		if (o.FileSystemPVC == "") == (o.FileSystemHostPath == "") {
+			return errors.Errorf("exactly one of --filesystem-pvc and --filesystem-host-path is required with the %s provider", filesystem.ProviderName)
+		}
+	} else if o.FileSystemPVC != "" || o.FileSystemHostPath != "" {
+		return errors.Errorf("--filesystem-pvc and --filesystem-host-path can only be used with the %s provider", filesystem.ProviderName)
+	}
+
 	if o.DefaultVolumesToRestic && !o.UseRestic {
 		return errors.New("--use-restic is required when using --default-volumes-to-restic")
 	}
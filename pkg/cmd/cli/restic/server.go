@@ -73,6 +73,7 @@ const (
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	var clusterName string
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -87,7 +88,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f, defaultMetricsAddress)
+			s, err := newResticServer(logger, f, defaultMetricsAddress, clusterName)
 			cmd.CheckError(err)
 
 			s.run()
@@ -96,6 +97,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().StringVar(&clusterName, "cluster-name", clusterName, "The name of the cluster the restic server runs in, used as the cluster label of its metrics. It should be the host of the cluster as configured for the Velero server, or empty for the cluster Velero runs in.")
 
 	return command
 }
@@ -113,10 +115,11 @@ type resticServer struct {
 	mgr                   manager.Manager
 	metrics               *metrics.ServerMetrics
 	metricsAddress        string
+	clusterName           string
 	namespace             string
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress, clusterName string) (*resticServer, error) {
 
 	kubeClient, err := factory.KubeClient()
 	if err != nil {
@@ -170,6 +173,7 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		fileSystem:            filesystem.NewFileSystem(),
 		mgr:                   mgr,
 		metricsAddress:        metricAddress,
+		clusterName:           clusterName,
 		namespace:             factory.Namespace(),
 	}
 
@@ -191,7 +195,7 @@ func (s *resticServer) run() {
 			s.logger.Fatalf("Failed to start metric server for restic at [%s]: %v", s.metricsAddress, err)
 		}
 	}()
-	s.metrics = metrics.NewResticServerMetrics()
+	s.metrics = metrics.NewResticServerMetrics(s.clusterName)
 	s.metrics.RegisterAllMetrics()
 	s.metrics.InitResticMetricsForNode(os.Getenv("NODE_NAME"))
 
@@ -337,7 +337,9 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 					return nil
 				}
 
-				if restore.Status.Phase != api.RestorePhaseNew && restore.Status.Phase != api.RestorePhaseInProgress {
+				switch restore.Status.Phase {
+				case api.RestorePhaseNew, api.RestorePhaseInProgress, api.RestorePhaseWaitingForPluginOperations:
+				default:
 					fmt.Printf("\nRestore completed with status: %s. You may check for more information using the commands `velero restore describe %s` and `velero restore logs %s`.\n", restore.Status.Phase, restore.Name, restore.Name)
 					return nil
 				}
@@ -20,21 +20,27 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 )
 
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	var (
 		listOptions           metav1.ListOptions
 		details               bool
+		items                 bool
+		itemStatuses          flag.StringArray
 		insecureSkipTLSVerify bool
 	)
 
@@ -48,6 +54,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe restores",
 		Run: func(c *cobra.Command, args []string) {
+			statuses, err := parseItemStatuses(itemStatuses)
+			cmd.CheckError(err)
+			items = items || len(statuses) > 0
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -75,7 +85,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeRestores for restore %s: %v\n", restore.Name, err)
 				}
 
-				s := output.DescribeRestore(context.Background(), kbClient, &restore, podvolumeRestoreList.Items, details, veleroClient, insecureSkipTLSVerify, caCertFile)
+				s := output.DescribeRestore(context.Background(), kbClient, &restore, podvolumeRestoreList.Items, details, items, statuses, veleroClient, insecureSkipTLSVerify, caCertFile)
 				if first {
 					first = false
 					fmt.Print(s)
@@ -89,8 +99,37 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
+	c.Flags().BoolVar(&items, "items", items, "List what the restore did with each item from the backup.")
+	c.Flags().Var(&itemStatuses, "item-status", fmt.Sprintf("Only list the items with these statuses. Implies --items. Valid statuses are %s.", strings.Join(itemStatusNames(), ", ")))
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
 
 	return c
 }
+
+// parseItemStatuses returns the item statuses with the names, which are case-insensitive.
+func parseItemStatuses(names []string) ([]pkgrestore.ItemStatus, error) {
+	var statuses []pkgrestore.ItemStatus
+	for _, name := range names {
+		var found bool
+		for _, status := range pkgrestore.ItemStatuses {
+			if strings.EqualFold(name, string(status)) {
+				statuses = append(statuses, status)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("invalid item status %q, valid statuses are %s", name, strings.Join(itemStatusNames(), ", "))
+		}
+	}
+	return statuses, nil
+}
+
+func itemStatusNames() []string {
+	var names []string
+	for _, status := range pkgrestore.ItemStatuses {
+		names = append(names, string(status))
+	}
+	return names
+}
@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+func NewRetryCommand(f client.Factory, use string) *cobra.Command {
+	o := NewRetryOptions()
+
+	c := &cobra.Command{
+		Use:   use + " RESTORE_NAME [RETRY_NAME]",
+		Short: "Retry the failed items of a restore",
+		Long: `Create a restore that restores only the items that failed to be restored by a PartiallyFailed restore.
+
+The new restore has the same spec as the restore it retries, so it uses the same namespace mappings, hooks and
+other options.`,
+		Example: `  # Retry the failed items of restore "restore-1", in a restore with a default name ("restore-1-retry-<timestamp>").
+  velero restore retry restore-1
+
+  # Retry the failed items of restore "restore-1", in a restore named "restore-1-retry".
+  velero restore retry restore-1 restore-1-retry`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	output.BindFlags(c.Flags())
+	output.ClearOutputFlagDefault(c)
+
+	return c
+}
+
+type RetryOptions struct {
+	RestoreName string
+	RetryName   string
+
+	client  veleroclient.Interface
+	restore *api.Restore
+}
+
+func NewRetryOptions() *RetryOptions {
+	return &RetryOptions{}
+}
+
+func (o *RetryOptions) Complete(args []string, f client.Factory) error {
+	o.RestoreName = args[0]
+	if len(args) == 2 {
+		o.RetryName = args[1]
+	} else {
+		o.RetryName = fmt.Sprintf("%s-retry-%s", o.RestoreName, time.Now().Format("20060102150405"))
+	}
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *RetryOptions) Validate(f client.Factory) error {
+	restore, err := o.client.VeleroV1().Restores(f.Namespace()).Get(context.TODO(), o.RestoreName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if restore.Status.Phase != api.RestorePhasePartiallyFailed {
+		return errors.Errorf("restore %s is %s, only PartiallyFailed restores can be retried", restore.Name, restore.Status.Phase)
+	}
+
+	o.restore = restore
+	return nil
+}
+
+func (o *RetryOptions) Run(c *cobra.Command, f client.Factory) error {
+	spec := o.restore.Spec.DeepCopy()
+	// the server fills in the schedule of the restore's backup, so only keep the backup to restore
+	// from the same one.
+	spec.ScheduleName = ""
+	spec.RetryOf = o.restore.Name
+
+	retry := &api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: f.Namespace(),
+			Name:      o.RetryName,
+			Labels:    o.restore.Labels,
+		},
+		Spec: *spec,
+	}
+
+	if printed, err := output.PrintWithFormat(c, retry); printed || err != nil {
+		return err
+	}
+
+	retry, err := o.client.VeleroV1().Restores(retry.Namespace).Create(context.TODO(), retry, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Restore request %q submitted successfully, retrying the failed items of restore %q.\n", retry.Name, o.restore.Name)
+	fmt.Printf("Run `velero restore describe %s` or `velero restore logs %s` for more details.\n", retry.Name, retry.Name)
+
+	return nil
+}
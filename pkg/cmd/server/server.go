@@ -64,11 +64,15 @@ import (
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
+	filesystemstore "github.com/vmware-tanzu/velero/pkg/persistence/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/image"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -88,6 +92,7 @@ const (
 	defaultStoreValidationFrequency   = time.Minute
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
+	defaultBackupCheckpointInterval   = time.Minute
 
 	// server's client default qps and burst
 	defaultClientQPS   float32 = 20.0
@@ -102,13 +107,19 @@ const (
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// defaultPluginTokenExpiration is how long the tokens plugins get for a remote
+	// cluster are valid. It's the shortest expiration the TokenRequest API allows.
+	defaultPluginTokenExpiration = 10 * time.Minute
 )
 
 type serverConfig struct {
 	// TODO(2.0) Deprecate defaultBackupLocation
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
-	defaultBackupTTL, storeValidationFrequency                              time.Duration
+	defaultBackupTTL, storeValidationFrequency, backupCheckpointInterval    time.Duration
+	itemOperationTimeout                                                    time.Duration
+	pluginTimeouts                                                          clientmgmt.Timeouts
 	restoreResourcePriorities                                               []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
@@ -119,6 +130,10 @@ type serverConfig struct {
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
 	defaultVolumesToRestic                                                  bool
+	fileSystemDownloadAddress                                               string
+	fileSystemRoot                                                          string
+	pluginServiceAccount                                                    string
+	pluginTokenExpiration                                                   time.Duration
 }
 
 type controllerRunInfo struct {
@@ -129,6 +144,7 @@ type controllerRunInfo struct {
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
+		pluginTimeouts          = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                         "/plugins",
@@ -144,9 +160,13 @@ func NewCommand(f client.Factory) *cobra.Command {
 			clientBurst:                       defaultClientBurst,
 			profilerAddress:                   defaultProfilerAddress,
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
+			backupCheckpointInterval:          defaultBackupCheckpointInterval,
+			itemOperationTimeout:              controller.DefaultItemOperationTimeout,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
 			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			pluginTokenExpiration:             defaultPluginTokenExpiration,
+			fileSystemRoot:                    filesystemstore.DefaultRoot,
 		}
 	)
 
@@ -183,6 +203,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			timeouts, err := clientmgmt.ParseTimeouts(pluginTimeouts.Data())
+			cmd.CheckError(err)
+			config.pluginTimeouts = timeouts
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -208,9 +232,16 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "The address to expose the pprof profiler.")
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
+	command.Flags().DurationVar(&config.itemOperationTimeout, "item-operation-timeout", config.itemOperationTimeout, "How long to wait for the asynchronous operations started by backup and restore item action plugins before canceling them.")
+	command.Flags().Var(&pluginTimeouts, "plugin-timeouts", "How long each call to a plugin may take before it's canceled, by plugin kind (BackupItemAction=10m,ObjectStore=30m,...). A timeout of 0 disables it. Item actions time out after 10m by default; object stores, volume snapshotters and item snapshotters don't time out unless configured. Backup storage and volume snapshot locations can override it with spec.pluginTimeout.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().StringVar(&config.fileSystemDownloadAddress, "filesystem-download-address", config.fileSystemDownloadAddress, "The address to serve downloads of the objects of backup storage locations using the filesystem provider on. Optional. Downloads are disabled if empty.")
+	command.Flags().StringVar(&config.fileSystemRoot, "filesystem-root", config.fileSystemRoot, "The directory the download endpoint of the filesystem provider serves objects from. It must be the root of the backup storage locations objects are downloaded from.")
+	command.Flags().StringVar(&config.pluginServiceAccount, "plugin-service-account", config.pluginServiceAccount, "Name of the service account, in Velero's namespace of a remote source or destination cluster, that item action plugins get short-lived tokens for to access that cluster. Optional. Plugins get no access to remote clusters if empty. Velero doesn't create the service account or grant it access.")
+	command.Flags().DurationVar(&config.pluginTokenExpiration, "plugin-token-expiration", config.pluginTokenExpiration, "How long the tokens item action plugins get for a remote cluster are valid.")
+	command.Flags().DurationVar(&config.backupCheckpointInterval, "backup-checkpoint-interval", config.backupCheckpointInterval, "How often the progress of an in-progress backup is checkpointed to object storage so the backup can be resumed after a server restart. Set this to `0s` to disable checkpointing.")
 
 	return command
 }
@@ -227,6 +258,8 @@ type server struct {
 
 	srcClusterHost       string
 	destClusterHost      string
+	srcCluster           client.ClusterContextProvider
+	destCluster          client.ClusterContextProvider
 	srcDiscoveryHelper   velerodiscovery.Helper
 	destDiscoveryHelper  velerodiscovery.Helper
 	srcKubeClientConfig  *rest.Config
@@ -248,6 +281,7 @@ type server struct {
 	logger                              logrus.FieldLogger
 	logLevel                            logrus.Level
 	pluginRegistry                      clientmgmt.Registry
+	pluginStatuses                      *clientmgmt.ProcessStatuses
 	resticManager                       restic.RepositoryManager
 	metrics                             *metrics.ServerMetrics
 	config                              serverConfig
@@ -274,29 +308,45 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
-	srcKubeClient, err := f.SourceKubeClient()
+	srcKubeClientConfig, err := f.SourceClientConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	destKubeClient, err := f.DestinationKubeClient()
+	destKubeClientConfig, err := f.DestinationClientConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	// the metrics are created before the clients for the source and destination
+	// clusters so that the latency of their requests is recorded.
+	serverMetrics := metrics.NewServerMetrics(f.SrcClusterHost(), f.DestClusterHost())
+	serverMetrics.InstrumentClientConfig(srcKubeClientConfig, f.SrcClusterHost())
+	serverMetrics.InstrumentClientConfig(destKubeClientConfig, f.DestClusterHost())
+
+	srcKubeClient, err := kubernetes.NewForConfig(srcKubeClientConfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	destKubeClient, err := kubernetes.NewForConfig(destKubeClientConfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	veleroClient, err := f.Client()
 	if err != nil {
 		return nil, err
 	}
 
-	srcVeleroClient, err := f.SourceClient()
+	srcVeleroClient, err := clientset.NewForConfig(srcKubeClientConfig)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithStack(err)
 	}
 
-	destVeleroClient, err := f.DestinationClient()
+	destVeleroClient, err := clientset.NewForConfig(destKubeClientConfig)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithStack(err)
 	}
 
 	dynamicClient, err := f.DynamicClient()
@@ -304,14 +354,14 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
-	srcDynamicClient, err := f.SourceDynamicClient()
+	srcDynamicClient, err := dynamic.NewForConfig(srcKubeClientConfig)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithStack(err)
 	}
 
-	destDynamicClient, err := f.DestinationDynamicClient()
+	destDynamicClient, err := dynamic.NewForConfig(destKubeClientConfig)
 	if err != nil {
-		return nil, err
+		return nil, errors.WithStack(err)
 	}
 
 	pluginRegistry := clientmgmt.NewRegistry(config.pluginDir, logger, logger.Level)
@@ -363,6 +413,22 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	// item action plugins are told which cluster they operate on and, for a
+	// remote cluster, given short-lived tokens for a service account in it
+	// rather than the server's credentials.
+	srcCluster, err := client.NewClusterContextProvider(f.SrcClusterHost(), srcKubeClientConfig, f.HttpsProxy(),
+		srcKubeClient.CoreV1(), f.Namespace(), config.pluginServiceAccount, config.pluginTokenExpiration)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+	destCluster, err := client.NewClusterContextProvider(f.DestClusterHost(), destKubeClientConfig, f.HttpsProxy(),
+		destKubeClient.CoreV1(), f.Namespace(), config.pluginServiceAccount, config.pluginTokenExpiration)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
 	s := &server{
 		namespace:                           f.Namespace(),
 		metricsAddress:                      config.metricsAddress,
@@ -371,6 +437,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		veleroClient:                        veleroClient,
 		discoveryClient:                     veleroClient.Discovery(),
 		dynamicClient:                       dynamicClient,
+		srcKubeClientConfig:                 srcKubeClientConfig,
+		destKubeClientConfig:                destKubeClientConfig,
 		srcKubeClient:                       srcKubeClient,
 		destKubeClient:                      destKubeClient,
 		srcDiscoveryClient:                  srcVeleroClient.Discovery(),
@@ -379,6 +447,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		destDynamicClient:                   destDynamicClient,
 		srcClusterHost:                      f.SrcClusterHost(),
 		destClusterHost:                     f.DestClusterHost(),
+		srcCluster:                          srcCluster,
+		destCluster:                         destCluster,
 		sharedInformerFactory:               informers.NewSharedInformerFactoryWithOptions(veleroClient, 0, informers.WithNamespace(f.Namespace())),
 		csiSnapshotterSharedInformerFactory: NewCSIInformerFactoryWrapper(csiSnapClient),
 		csiSnapshotClient:                   csiSnapClient,
@@ -387,9 +457,11 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logger:                              logger,
 		logLevel:                            logger.Level,
 		pluginRegistry:                      pluginRegistry,
+		pluginStatuses:                      clientmgmt.NewProcessStatuses(serverMetrics),
 		config:                              config,
 		mgr:                                 mgr,
 		credentialFileStore:                 credentialFileStore,
+		metrics:                             serverMetrics,
 		httpsProxy:                          f.HttpsProxy(),
 		httpProxy:                           f.HttpProxy(),
 	}
@@ -404,6 +476,24 @@ func (s *server) run() error {
 		go s.runProfiler()
 	}
 
+	shutdownTracing, err := tracing.ConfigureFromEnvironment("velero", s.logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			s.logger.WithError(err).Warn("Error exporting traces")
+		}
+	}()
+
+	if s.config.fileSystemDownloadAddress != "" {
+		if err := s.runFileSystemDownloadServer(); err != nil {
+			return err
+		}
+	}
+
 	if s.srcClusterHost != "" {
 		s.logger.Infof("Server is using source cluster at %s.", s.srcClusterHost)
 	} else {
@@ -684,19 +774,24 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger.Fatalf("Failed to start metric server at [%s]: %v", s.metricsAddress, err)
 		}
 	}()
-	s.metrics = metrics.NewServerMetrics()
 	s.metrics.RegisterAllMetrics()
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry, s.config.pluginTimeouts, s.pluginStatuses)
 	}
 
 	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
 
 	csiVSLister, csiVSCLister := s.getCSISnapshotListers()
 
+	// events about Velero objects are recorded in the cluster Velero runs in, and events
+	// about the items being backed up or restored in the source or destination cluster.
+	eventRecorder := kube.NewEventRecorder(s.kubeClient, s.mgr.GetScheme(), ctx.Done())
+	srcEventRecorder := kube.NewEventRecorder(s.srcKubeClient, s.mgr.GetScheme(), ctx.Done())
+	destEventRecorder := kube.NewEventRecorder(s.destKubeClient, s.mgr.GetScheme(), ctx.Done())
+
 	backupSyncControllerRunInfo := func() controllerRunInfo {
 		backupSyncContoller := controller.NewBackupSyncController(
 			s.veleroClient.VeleroV1(),
@@ -710,6 +805,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.defaultBackupLocation,
 			newPluginManager,
 			backupStoreGetter,
+			s.metrics,
 			s.logger,
 		)
 
@@ -726,11 +822,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.veleroClient.VeleroV1(),
 			s.srcDiscoveryHelper,
 			client.NewDynamicFactory(s.srcDynamicClient),
-			podexec.NewPodCommandExecutor(s.srcKubeClientConfig, s.srcKubeClient.CoreV1().RESTClient()),
+			podexec.NewObservedPodCommandExecutor(
+				podexec.NewPodCommandExecutor(s.srcKubeClientConfig, s.srcKubeClient.CoreV1().RESTClient()),
+				func(duration time.Duration, err error) {
+					s.metrics.ObserveBackupHookDuration(duration.Seconds(), err != nil)
+				},
+			),
+			podexec.NewHTTPHookExecutor(s.srcKubeClient.CoreV1().RESTClient()),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
-			s.srcClusterHost,
+			s.config.backupCheckpointInterval,
+			eventRecorder,
+			srcEventRecorder,
+			s.srcCluster,
 		)
 		cmd.CheckError(err)
 
@@ -754,6 +859,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSLister,
 			csiVSCLister,
 			backupStoreGetter,
+			eventRecorder,
+			srcEventRecorder,
 		)
 
 		return controllerRunInfo{
@@ -813,6 +920,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			s.discoveryHelper,
+			s.srcCluster,
 		)
 
 		return controllerRunInfo{
@@ -832,9 +940,17 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.podVolumeOperationTimeout,
 			s.config.resourceTerminatingTimeout,
 			s.logger,
-			podexec.NewPodCommandExecutor(s.destKubeClientConfig, s.destKubeClient.CoreV1().RESTClient()),
+			podexec.NewObservedPodCommandExecutor(
+				podexec.NewPodCommandExecutor(s.destKubeClientConfig, s.destKubeClient.CoreV1().RESTClient()),
+				func(duration time.Duration, err error) {
+					s.metrics.ObserveRestoreHookDuration(duration.Seconds(), err != nil)
+				},
+			),
+			podexec.NewHTTPHookExecutor(s.destKubeClient.CoreV1().RESTClient()),
 			s.destKubeClient.CoreV1().RESTClient(),
-			s.destClusterHost,
+			eventRecorder,
+			destEventRecorder,
+			s.destCluster,
 		)
 		cmd.CheckError(err)
 
@@ -853,6 +969,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			eventRecorder,
 		)
 
 		return controllerRunInfo{
@@ -861,6 +978,44 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	backupOperationsControllerRunInfo := func() controllerRunInfo {
+		backupOperationsController := controller.NewBackupOperationsController(
+			s.namespace,
+			s.mgr.GetClient(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			newPluginManager,
+			backupStoreGetter,
+			s.config.itemOperationTimeout,
+			s.metrics,
+			eventRecorder,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: backupOperationsController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	restoreOperationsControllerRunInfo := func() controllerRunInfo {
+		restoreOperationsController := controller.NewRestoreOperationsController(
+			s.namespace,
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			newPluginManager,
+			s.config.itemOperationTimeout,
+			s.metrics,
+			eventRecorder,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: restoreOperationsController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	resticRepoControllerRunInfo := func() controllerRunInfo {
 		resticRepoController := controller.NewResticRepositoryController(
 			s.logger,
@@ -877,27 +1032,73 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	backupReplicationControllerRunInfo := func() controllerRunInfo {
+		backupReplicationController := controller.NewBackupReplicationController(
+			s.namespace,
+			s.mgr.GetClient(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().PodVolumeBackups().Lister(),
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: backupReplicationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
+	notificationControllerRunInfo := func() controllerRunInfo {
+		notificationController := controller.NewNotificationController(
+			s.namespace,
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().Restores(),
+			s.sharedInformerFactory.Velero().V1().Schedules(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
+			s.kubeClient.CoreV1(),
+			s.mgr.GetClient(),
+			s.srcClusterHost,
+			s.destClusterHost,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: notificationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	enabledControllers := map[string]func() controllerRunInfo{
 		controller.BackupSync:        backupSyncControllerRunInfo,
 		controller.Backup:            backupControllerRunInfo,
+		controller.BackupOperations:  backupOperationsControllerRunInfo,
 		controller.Schedule:          scheduleControllerRunInfo,
 		controller.GarbageCollection: gcControllerRunInfo,
 		controller.BackupDeletion:    deletionControllerRunInfo,
+		controller.BackupReplication: backupReplicationControllerRunInfo,
 		controller.Restore:           restoreControllerRunInfo,
+		controller.RestoreOperations: restoreOperationsControllerRunInfo,
 		controller.ResticRepo:        resticRepoControllerRunInfo,
+		controller.Notification:      notificationControllerRunInfo,
 	}
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := make(map[string]struct{})
 	enabledRuntimeControllers[controller.ServerStatusRequest] = struct{}{}
 	enabledRuntimeControllers[controller.DownloadRequest] = struct{}{}
+	enabledRuntimeControllers[controller.Plugin] = struct{}{}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, backup-operations, schedule, delete-backup, backup-replication, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			controller.Backup,
+			controller.BackupOperations,
 			controller.Schedule,
 			controller.GarbageCollection,
 			controller.BackupDeletion,
+			controller.BackupReplication,
 		)
 	}
 
@@ -946,6 +1147,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		},
 		NewPluginManager:  newPluginManager,
 		BackupStoreGetter: backupStoreGetter,
+		EventRecorder:     eventRecorder,
+		Metrics:           s.metrics,
 		Log:               s.logger,
 	}
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
@@ -958,6 +1161,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			Client:         s.mgr.GetClient(),
 			Ctx:            s.ctx,
 			PluginRegistry: s.pluginRegistry,
+			PluginStatuses: s.pluginStatuses,
 			Clock:          clock.RealClock{},
 			Log:            s.logger,
 		}
@@ -980,6 +1184,21 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.Plugin]; ok {
+		r := controller.PluginReconciler{
+			Client:    s.mgr.GetClient(),
+			Namespace: s.namespace,
+			PluginDir: s.config.pluginDir,
+			Puller:    image.NewPuller(http.DefaultTransport),
+			Registry:  s.pluginRegistry,
+			Clock:     clock.RealClock{},
+			Log:       s.logger,
+		}
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.Plugin)
+		}
+	}
+
 	// TODO(2.0): presuming all controllers and resources are converted to runtime-controller
 	// by v2.0, the block from this line and including the `s.mgr.Start() will be
 	// deprecated, since the manager auto-starts all the caches. Until then, we need to start the
@@ -1036,6 +1255,28 @@ func (s *server) runProfiler() {
 	}
 }
 
+// runFileSystemDownloadServer serves the signed download URLs created by the filesystem
+// object store. The signing key is set in the environment before any plugin process is
+// started, so that the plugins inherit it.
+func (s *server) runFileSystemDownloadServer() error {
+	key, err := filesystemstore.EnsureSigningKey()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(filesystemstore.DownloadPath, filesystemstore.NewDownloadHandler(key, s.config.fileSystemRoot, s.logger))
+
+	go func() {
+		s.logger.Infof("Starting filesystem download server at address [%s]", s.config.fileSystemDownloadAddress)
+		if err := http.ListenAndServe(s.config.fileSystemDownloadAddress, mux); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Error("error running filesystem download http server")
+		}
+	}()
+
+	return nil
+}
+
 // CSIInformerFactoryWrapper is a proxy around the CSI SharedInformerFactory that checks the CSI feature flag before performing operations.
 type CSIInformerFactoryWrapper struct {
 	factory snapshotv1beta1informers.SharedInformerFactory
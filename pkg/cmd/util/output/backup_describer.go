@@ -29,9 +29,12 @@ import (
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 
 	"github.com/fatih/color"
+	"github.com/pkg/errors"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -159,6 +162,13 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
 
+	d.Println()
+	s = "<none>"
+	if len(spec.ReplicationTargets) > 0 {
+		s = strings.Join(spec.ReplicationTargets, ", ")
+	}
+	d.Printf("Replication Targets:\t%s\n", s)
+
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
 
@@ -166,10 +176,14 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
 
 	d.Println()
-	if len(spec.Hooks.Resources) == 0 {
+	if len(spec.Hooks.Resources) == 0 && len(spec.Hooks.BackupPre) == 0 && len(spec.Hooks.BackupPost) == 0 {
 		d.Printf("Hooks:\t<none>\n")
 	} else {
 		d.Printf("Hooks:\n")
+		describeBackupWideHooks(d, "Backup Pre", spec.Hooks.BackupPre)
+		describeBackupWideHooks(d, "Backup Post", spec.Hooks.BackupPost)
+	}
+	if len(spec.Hooks.Resources) > 0 {
 		d.Printf("\tResources:\n")
 		for _, backupResourceHookSpec := range spec.Hooks.Resources {
 			d.Printf("\t\t%s:\n", backupResourceHookSpec.Name)
@@ -211,25 +225,11 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 			d.Printf("\t\t\tLabel selector:\t%s\n", s)
 
 			for _, hook := range backupResourceHookSpec.PreHooks {
-				if hook.Exec != nil {
-					d.Println()
-					d.Printf("\t\t\tPre Exec Hook:\n")
-					d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
-					d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
-					d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
-					d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
-				}
+				describeBackupResourceHook(d, "Pre", hook)
 			}
 
 			for _, hook := range backupResourceHookSpec.PostHooks {
-				if hook.Exec != nil {
-					d.Println()
-					d.Printf("\t\t\tPost Exec Hook:\n")
-					d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
-					d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
-					d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
-					d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
-				}
+				describeBackupResourceHook(d, "Post", hook)
 			}
 		}
 	}
@@ -244,6 +244,65 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 }
 
+// describeBackupResourceHook describes a pre or post resource hook.
+func describeBackupResourceHook(d *Describer, phase string, hook velerov1api.BackupResourceHook) {
+	switch {
+	case hook.Exec != nil:
+		d.Println()
+		d.Printf("\t\t\t%s Exec Hook:\n", phase)
+		d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
+		d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+		d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
+		d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
+	case hook.HTTP != nil:
+		d.Println()
+		d.Printf("\t\t\t%s HTTP Hook:\n", phase)
+		d.Printf("\t\t\t\tService:\t%s\n", hook.HTTP.Service)
+		d.Printf("\t\t\t\tPort:\t%s\n", hook.HTTP.Port)
+		d.Printf("\t\t\t\tRequest:\t%s %s\n", hook.HTTP.Method, hook.HTTP.Path)
+		d.Printf("\t\t\t\tExpected Status:\t%d\n", hook.HTTP.ExpectedStatus)
+		d.Printf("\t\t\t\tOn Error:\t%s\n", hook.HTTP.OnError)
+		d.Printf("\t\t\t\tTimeout:\t%s\n", hook.HTTP.Timeout.Duration)
+	case hook.Job != nil:
+		d.Println()
+		d.Printf("\t\t\t%s Job Hook:\n", phase)
+		d.Printf("\t\t\t\tNamespace:\t%s\n", hook.Job.Namespace)
+		d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Job.OnError)
+		d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Job.Timeout.Duration)
+	}
+}
+
+// describeBackupWideHooks describes the backup-wide hooks of one phase.
+func describeBackupWideHooks(d *Describer, title string, hooks []velerov1api.BackupWideHook) {
+	if len(hooks) == 0 {
+		return
+	}
+
+	d.Printf("\t%s:\n", title)
+	for _, hook := range hooks {
+		d.Printf("\t\t%s:\n", hook.Name)
+		switch {
+		case hook.Exec != nil:
+			s := "<none>"
+			if hook.Exec.LabelSelector != nil {
+				s = metav1.FormatLabelSelector(hook.Exec.LabelSelector)
+			}
+			d.Printf("\t\t\tExec Hook:\n")
+			d.Printf("\t\t\t\tNamespace:\t%s\n", hook.Exec.Namespace)
+			d.Printf("\t\t\t\tLabel selector:\t%s\n", s)
+			d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
+			d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+			d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
+			d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
+		case hook.Job != nil:
+			d.Printf("\t\t\tJob Hook:\n")
+			d.Printf("\t\t\t\tNamespace:\t%s\n", hook.Job.Namespace)
+			d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Job.OnError)
+			d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Job.Timeout.Duration)
+		}
+	}
+}
+
 // DescribeBackupStatus describes a backup status in human-readable format.
 func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
 	status := backup.Status
@@ -271,6 +330,37 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Expiration:\t%s\n", status.Expiration)
 	d.Println()
 
+	if status.StorageSize != nil {
+		d.Printf("Storage Size:\t%d bytes\n", status.StorageSize.Total)
+		d.Println()
+	}
+
+	if status.ObjectLock != nil {
+		describeBackupObjectLock(d, status.ObjectLock)
+		d.Println()
+	}
+
+	if len(status.Replications) > 0 {
+		describeBackupReplications(d, status.Replications)
+		d.Println()
+	}
+
+	if len(status.PluginOperations) > 0 {
+		describePluginOperations(d, status.PluginOperations)
+		d.Println()
+	}
+
+	if len(status.HookStatuses) > 0 {
+		describeBackupHookStatuses(d, status.HookStatuses)
+		d.Println()
+	}
+
+	if status.HooksAttempted > 0 {
+		describeHookReport(ctx, kbClient, d, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookReport,
+			status.HooksAttempted, status.HooksFailed, details, insecureSkipTLSVerify, caCertPath)
+		d.Println()
+	}
+
 	if backup.Status.Progress != nil {
 		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
 			d.Printf("Estimated total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
@@ -286,6 +376,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	if details {
 		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
 		d.Println()
+
+		describeBackupContentIndex(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+		d.Println()
 	}
 
 	if status.VolumeSnapshotsAttempted > 0 {
@@ -316,6 +409,133 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Velero-Native Snapshots: <none included>\n")
 }
 
+func describeBackupObjectLock(d *Describer, lock *velerov1api.BackupObjectLock) {
+	d.Printf("Object Lock:\n")
+	d.Printf("\tMode:\t%s\n", lock.Mode)
+	if lock.RetainUntil != nil {
+		d.Printf("\tRetain Until:\t%s\n", lock.RetainUntil.Time)
+	}
+	if lock.LegalHold {
+		d.Printf("\tLegal Hold:\tyes\n")
+	}
+}
+
+func describeBackupReplications(d *Describer, replications []velerov1api.BackupReplicationStatus) {
+	d.Printf("Replications:\n")
+	for _, replication := range replications {
+		switch {
+		case replication.Phase == velerov1api.BackupReplicationPhaseFailed:
+			d.Printf("\t%s:\t%s (%s)\n", replication.Location, replication.Phase, replication.Message)
+		case replication.ObjectLock != nil && replication.ObjectLock.LegalHold:
+			d.Printf("\t%s:\t%s, under a legal hold\n", replication.Location, replication.Phase)
+		case replication.ObjectLock != nil && replication.ObjectLock.RetainUntil != nil:
+			d.Printf("\t%s:\t%s, locked until %s\n", replication.Location, replication.Phase, replication.ObjectLock.RetainUntil.Time)
+		case replication.CompletionTimestamp != nil:
+			d.Printf("\t%s:\t%s at %s\n", replication.Location, replication.Phase, replication.CompletionTimestamp.Time)
+		default:
+			d.Printf("\t%s:\t%s\n", replication.Location, replication.Phase)
+		}
+	}
+}
+
+// describeBackupHookStatuses describes the results of a backup's backup-wide hooks.
+func describeBackupHookStatuses(d *Describer, statuses []velerov1api.BackupHookStatus) {
+	d.Printf("Backup Hooks:\n")
+	for _, status := range statuses {
+		target := status.Target
+		if target == "" {
+			target = "<none>"
+		}
+
+		if status.Result == velerov1api.HookResultFailed {
+			d.Printf("\t%s %s (%s):\t%s (%s)\n", status.Phase, status.Name, target, status.Result, status.Message)
+		} else {
+			d.Printf("\t%s %s (%s):\t%s\n", status.Phase, status.Name, target, status.Result)
+		}
+	}
+}
+
+// describeHookReport describes the hooks executed during a backup or restore,
+// downloading its hook report of the given kind if details are requested.
+func describeHookReport(ctx context.Context, kbClient kbclient.Client, d *Describer, namespace, name string, kind velerov1api.DownloadTargetKind,
+	attempted, failed int, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	if !details {
+		d.Printf("Hook Executions:\t%d of %d executed successfully (specify --details for more information)\n", attempted-failed, attempted)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, kind, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Hook Executions:\t<hook report not found>")
+		} else {
+			d.Printf("Hook Executions:\t<error getting hook report: %v>\n", err)
+		}
+		return
+	}
+
+	var executions []hook.HookExecution
+	if err := json.NewDecoder(buf).Decode(&executions); err != nil {
+		d.Printf("Hook Executions:\t<error reading hook report: %v>\n", err)
+		return
+	}
+
+	d.Printf("Hook Executions:\n")
+	for _, execution := range executions {
+		target := execution.Pod
+		if execution.Container != "" {
+			target = fmt.Sprintf("%s, container %s", target, execution.Container)
+		}
+		phase := execution.Phase
+		if execution.BackupWide {
+			phase = "backup-wide " + phase
+		}
+		if target == "" {
+			target = "no target"
+		}
+		d.Printf("\t%s %s hook %s (%s):\n", phase, execution.Type, execution.HookName, target)
+		if execution.Command != "" {
+			d.Printf("\t\tCommand:\t%s\n", execution.Command)
+		}
+		if execution.ExitCode != nil {
+			d.Printf("\t\tExit Code:\t%d\n", *execution.ExitCode)
+		}
+		d.Printf("\t\tDuration:\t%s\n", execution.Duration.Duration)
+		if execution.Error != "" {
+			d.Printf("\t\tResult:\t%s\n", color.RedString("failed (%s)", execution.Error))
+			if execution.Stderr != "" {
+				d.Printf("\t\tStderr:\t%s\n", strings.TrimSpace(execution.Stderr))
+			}
+		} else {
+			d.Printf("\t\tResult:\t%s\n", color.GreenString("succeeded"))
+		}
+	}
+}
+
+// describePluginOperations describes the asynchronous operations started by the
+// item action plugins of a backup or restore.
+func describePluginOperations(d *Describer, operations []velerov1api.PluginOperation) {
+	d.Printf("Plugin Operations:\n")
+	for _, op := range operations {
+		item := op.Name
+		if op.Namespace != "" {
+			item = fmt.Sprintf("%s/%s", op.Namespace, op.Name)
+		}
+
+		progress := ""
+		if op.NTotal > 0 {
+			progress = fmt.Sprintf(", %d of %d %s", op.NCompleted, op.NTotal, op.OperationUnits)
+		}
+
+		switch op.Phase {
+		case velerov1api.PluginOperationPhaseFailed, velerov1api.PluginOperationPhaseCanceled:
+			d.Printf("\t%s (%s %s):\t%s (%s)\n", op.PluginName, op.Resource, item, op.Phase, op.Error)
+		default:
+			d.Printf("\t%s (%s %s):\t%s%s\n", op.PluginName, op.Resource, item, op.Phase, progress)
+		}
+	}
+}
+
 func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
 	buf := new(bytes.Buffer)
 	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
@@ -352,6 +572,62 @@ func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d
 	}
 }
 
+// GetBackupContentIndex downloads the content index of a backup.
+func GetBackupContentIndex(ctx context.Context, kbClient kbclient.Client, namespace, name string, insecureSkipTLSVerify bool, caCertPath string) (*pkgbackup.ContentIndex, error) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, velerov1api.DownloadTargetKindBackupContentIndex, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		return nil, err
+	}
+
+	index := new(pkgbackup.ContentIndex)
+	if err := json.NewDecoder(buf).Decode(index); err != nil {
+		return nil, errors.Wrap(err, "error reading content index")
+	}
+	return index, nil
+}
+
+func describeBackupContentIndex(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	index, err := GetBackupContentIndex(ctx, kbClient, backup.Namespace, backup.Name, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// backups taken before content indexes were added don't have one
+			d.Println("Content Index:\t<content index not found>")
+		} else {
+			d.Printf("Content Index:\t<error getting content index: %v>\n", err)
+		}
+		return
+	}
+
+	d.Println("Content Index:")
+	d.Println("\tResource\tNamespace\tItems\tBytes")
+	for _, contents := range index.Resources {
+		d.Printf("\t%s\t%s\t%d\t%d\n", contents.Resource, namespaceOrClusterScoped(contents.Namespace), contents.Items, contents.Bytes)
+	}
+	d.Printf("\tTotal\t\t%d\t%d\n", index.TotalItems(), index.TotalBytes())
+
+	if len(index.Volumes) == 0 {
+		return
+	}
+
+	d.Println()
+	d.Println("Volume Sizes:")
+	for _, volume := range index.Volumes {
+		name := volume.Name
+		if volume.Namespace != "" {
+			name = volume.Namespace + "/" + volume.Name
+		}
+		d.Printf("\t%s\t%s\t%s\n", volume.Resource, name, volume.Size)
+	}
+}
+
+// namespaceOrClusterScoped returns the namespace, or "<cluster-scoped>" if it's empty.
+func namespaceOrClusterScoped(namespace string) string {
+	if namespace == "" {
+		return "<cluster-scoped>"
+	}
+	return namespace
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
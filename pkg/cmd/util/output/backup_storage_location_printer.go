@@ -17,6 +17,8 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -34,6 +36,8 @@ var (
 		{Name: "Last Validated"},
 		{Name: "Access Mode"},
 		{Name: "Default"},
+		{Name: "Backups"},
+		{Name: "Size"},
 	}
 )
 
@@ -77,6 +81,11 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		LastValidatedStr = lastValidated.String()
 	}
 
+	size := fmt.Sprintf("%d", location.Status.TotalBackupBytes)
+	if quota := location.Spec.Quota; quota != nil && quota.Bytes != nil {
+		size += "/" + quota.Bytes.String()
+	}
+
 	row.Cells = append(row.Cells,
 		location.Name,
 		location.Spec.Provider,
@@ -85,6 +94,8 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		LastValidatedStr,
 		accessMode,
 		isDefault,
+		location.Status.BackupCount,
+		size,
 	)
 
 	return []metav1.TableRow{row}
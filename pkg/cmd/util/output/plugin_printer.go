@@ -18,6 +18,7 @@ package output
 
 import (
 	"sort"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -30,6 +31,10 @@ var (
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Kind"},
+		{Name: "Capabilities"},
+		{Name: "Status"},
+		{Name: "Restarts"},
+		{Name: "Last Error"},
 	}
 )
 
@@ -57,7 +62,7 @@ func sortByKindAndName(plugins []velerov1api.PluginInfo) {
 func printPlugin(plugin velerov1api.PluginInfo) []metav1.TableRow {
 	row := metav1.TableRow{}
 
-	row.Cells = append(row.Cells, plugin.Name, plugin.Kind)
+	row.Cells = append(row.Cells, plugin.Name, plugin.Kind, strings.Join(plugin.Capabilities, ","), plugin.Status, plugin.Restarts, plugin.LastError)
 
 	return []metav1.TableRow{row}
 }
@@ -35,7 +35,7 @@ import (
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 )
 
-func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
+func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details, items bool, itemStatuses []pkgrestore.ItemStatus, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(restore.ObjectMeta)
 
@@ -90,6 +90,11 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			}
 		}
 
+		if len(restore.Status.PluginOperations) > 0 {
+			d.Println()
+			describePluginOperations(d, restore.Status.PluginOperations)
+		}
+
 		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 
 		d.Println()
@@ -148,9 +153,20 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			describePodVolumeRestores(d, podVolumeRestores, details)
 		}
 
+		if restore.Status.HooksAttempted > 0 {
+			d.Println()
+			describeHookReport(ctx, kbClient, d, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreHookReport,
+				restore.Status.HooksAttempted, restore.Status.HooksFailed, details, insecureSkipTLSVerify, caCertFile)
+		}
+
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		if items {
+			d.Println()
+			describeRestoreItems(ctx, kbClient, d, restore, itemStatuses, insecureSkipTLSVerify, caCertFile)
+		}
+
 	})
 }
 
@@ -196,6 +212,72 @@ func describeRestoreResult(d *Describer, name string, result pkgrestore.Result)
 	}
 }
 
+// describeRestoreItems describes what the restore did with each item from the backup, for the
+// items with any of the statuses.
+func describeRestoreItems(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, statuses []pkgrestore.ItemStatus, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreItemReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Items:\t<item report not found>")
+		} else {
+			d.Printf("Items:\t<error getting item report: %v>\n", err)
+		}
+		return
+	}
+
+	var items []pkgrestore.ItemResult
+	if err := json.NewDecoder(buf).Decode(&items); err != nil {
+		d.Printf("Items:\t<error reading item report: %v>\n", err)
+		return
+	}
+
+	items = pkgrestore.FilterItemResults(items, statuses...)
+	if len(items) == 0 {
+		d.Println("Items:\t<none>")
+		return
+	}
+
+	itemsByResource := make(map[string][]pkgrestore.ItemResult)
+	for _, item := range items {
+		itemsByResource[item.Resource] = append(itemsByResource[item.Resource], item)
+	}
+	var resources []string
+	for resource := range itemsByResource {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	d.Printf("Items:\n")
+	for _, resource := range resources {
+		d.Printf("\t%s:\n", resource)
+		for _, item := range itemsByResource[resource] {
+			name := item.Name
+			if item.Namespace != "" {
+				name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+			}
+			if item.NewName != "" {
+				name = fmt.Sprintf("%s (renamed to %s)", name, item.NewName)
+			}
+
+			status := string(item.Status)
+			switch item.Status {
+			case pkgrestore.ItemStatusCreated, pkgrestore.ItemStatusUpdated:
+				status = color.GreenString(status)
+			case pkgrestore.ItemStatusFailed:
+				status = color.RedString(status)
+			}
+			if item.Reason != "" {
+				status = fmt.Sprintf("%s (%s)", status, item.Reason)
+			}
+			if len(item.TransformedBy) > 0 {
+				status = fmt.Sprintf("%s, transformed by %s", status, strings.Join(item.TransformedBy, ", "))
+			}
+
+			d.Printf("\t\t%s:\t%s\n", name, status)
+		}
+	}
+}
+
 // describePodVolumeRestores describes pod volume restores in human-readable format.
 func describePodVolumeRestores(d *Describer, restores []velerov1api.PodVolumeRestore, details bool) {
 	if details {
@@ -25,18 +25,26 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1beta1"
@@ -53,6 +61,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -84,8 +94,28 @@ type backupController struct {
 	formatFlag                  logging.Format
 	volumeSnapshotLister        snapshotv1beta1listers.VolumeSnapshotLister
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister
+	eventRecorder               record.EventRecorder
+	srcEventRecorder            record.EventRecorder
+
+	// owner identifies this server in the owner annotation of the backups it runs.
+	owner string
+
+	// claimed are the keys of the interrupted backups this server took ownership of,
+	// which are resumed when they're processed.
+	claimedLock sync.Mutex
+	claimed     sets.String
 }
 
+var (
+	// backupHeartbeatInterval is how often the server running a backup records a
+	// heartbeat on it.
+	backupHeartbeatInterval = 30 * time.Second
+
+	// backupHeartbeatTimeout is how long after its last heartbeat an in-progress backup
+	// is considered abandoned by the server that was running it.
+	backupHeartbeatTimeout = 3 * backupHeartbeatInterval
+)
+
 func NewBackupController(
 	backupInformer velerov1informers.BackupInformer,
 	client velerov1client.BackupsGetter,
@@ -106,6 +136,8 @@ func NewBackupController(
 	volumeSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	eventRecorder record.EventRecorder,
+	srcEventRecorder record.EventRecorder,
 ) Interface {
 	c := &backupController{
 		genericController:           newGenericController(Backup, logger),
@@ -128,6 +160,10 @@ func NewBackupController(
 		volumeSnapshotLister:        volumeSnapshotLister,
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
 		backupStoreGetter:           backupStoreGetter,
+		eventRecorder:               eventRecorder,
+		srcEventRecorder:            srcEventRecorder,
+		owner:                       newBackupOwner(),
+		claimed:                     sets.NewString(),
 	}
 
 	c.syncHandler = c.processBackup
@@ -159,10 +195,175 @@ func NewBackupController(
 			},
 		},
 	)
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, backupInformer.Informer().HasSynced)
 
 	return c
 }
 
+// newBackupOwner returns the identity of this server in the owner annotation of the
+// backups it runs: its host name, which is its pod's name, and a unique ID so a
+// restarted server doesn't mistake the backups it was running for its own.
+func newBackupOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "velero"
+	}
+	return fmt.Sprintf("%s-%s", host, uuid.NewUUID())
+}
+
+func (c *backupController) Run(ctx context.Context, numWorkers int) error {
+	go c.resumeInterruptedBackups(ctx)
+	return c.genericController.Run(ctx, numWorkers)
+}
+
+// resumeInterruptedBackups resumes the in-progress backups whose server stopped running
+// them before they completed, typically because it was restarted. It's run once, when the
+// controller starts. The in-progress backups it finds are waited for until either they're
+// no longer in progress, or their heartbeat expires, in which case this server takes
+// ownership of them and resumes them. Taking ownership is an update of the backup, so only
+// one server can resume it.
+func (c *backupController) resumeInterruptedBackups(ctx context.Context) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.cacheSyncWaiters...) {
+		return
+	}
+
+	backups, err := c.lister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backups to resume")
+		return
+	}
+
+	var inProgress []*velerov1api.Backup
+	for _, backup := range backups {
+		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
+			inProgress = append(inProgress, backup)
+		}
+	}
+
+	for len(inProgress) > 0 {
+		if inProgress = c.claimInterruptedBackups(ctx, inProgress); len(inProgress) == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.clock.After(backupHeartbeatInterval):
+		}
+	}
+}
+
+// claimInterruptedBackups takes ownership of the given backups that are still in progress
+// and whose heartbeat has expired, and queues them to be resumed. It returns the backups
+// that are still being run, whose heartbeat hasn't expired yet.
+func (c *backupController) claimInterruptedBackups(ctx context.Context, backups []*velerov1api.Backup) []*velerov1api.Backup {
+	var running []*velerov1api.Backup
+	for _, backup := range backups {
+		log := c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+
+		// get the backup from the API server rather than the informer's cache, since
+		// ownership is taken by updating it.
+		current, err := c.client.Backups(backup.Namespace).Get(ctx, backup.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error getting in-progress backup")
+			running = append(running, backup)
+			continue
+		}
+		if current.Status.Phase != velerov1api.BackupPhaseInProgress {
+			continue
+		}
+		if !c.backupHeartbeatExpired(current) {
+			running = append(running, current)
+			continue
+		}
+
+		claimed := current.DeepCopy()
+		c.setBackupOwner(claimed)
+		if _, err := c.client.Backups(claimed.Namespace).Update(ctx, claimed, metav1.UpdateOptions{}); err != nil {
+			// on a conflict, another server may have taken ownership of the backup, which
+			// is checked again with its heartbeat
+			if !apierrors.IsConflict(err) {
+				log.WithError(errors.WithStack(err)).Error("Error taking ownership of interrupted backup")
+			}
+			running = append(running, current)
+			continue
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(claimed)
+		if err != nil {
+			log.WithError(err).Error("Error creating queue key, interrupted backup not resumed")
+			continue
+		}
+		log.WithField("previousOwner", current.Annotations[velerov1api.BackupOwnerAnnotation]).Info("Took ownership of interrupted backup")
+
+		c.claimedLock.Lock()
+		c.claimed.Insert(key)
+		c.claimedLock.Unlock()
+		c.queue.Add(key)
+	}
+
+	return running
+}
+
+// takeClaim returns whether this server took ownership of the interrupted backup with
+// the given key, and forgets it so it's only resumed once.
+func (c *backupController) takeClaim(key string) bool {
+	c.claimedLock.Lock()
+	defer c.claimedLock.Unlock()
+
+	if !c.claimed.Has(key) {
+		return false
+	}
+	c.claimed.Delete(key)
+	return true
+}
+
+// backupHeartbeatExpired returns whether the server running an in-progress backup last
+// recorded a heartbeat on it longer than backupHeartbeatTimeout ago. Backups without a
+// heartbeat were started by a version of Velero that didn't record them.
+func (c *backupController) backupHeartbeatExpired(backup *velerov1api.Backup) bool {
+	heartbeat, err := time.Parse(time.RFC3339, backup.Annotations[velerov1api.BackupHeartbeatAnnotation])
+	if err != nil {
+		return true
+	}
+	return c.clock.Since(heartbeat) > backupHeartbeatTimeout
+}
+
+// setBackupOwner records this server as the owner of a backup, with a heartbeat.
+func (c *backupController) setBackupOwner(backup *velerov1api.Backup) {
+	metav1.SetMetaDataAnnotation(&backup.ObjectMeta, velerov1api.BackupOwnerAnnotation, c.owner)
+	metav1.SetMetaDataAnnotation(&backup.ObjectMeta, velerov1api.BackupHeartbeatAnnotation, c.clock.Now().UTC().Format(time.RFC3339))
+}
+
+// startBackupHeartbeat periodically records a heartbeat on a backup this server is
+// running, until the returned function is called.
+func (c *backupController) startBackupHeartbeat(backup *velerov1api.Backup) func() {
+	log := c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+	stop := make(chan struct{})
+
+	go wait.Until(func() {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					velerov1api.BackupHeartbeatAnnotation: c.clock.Now().UTC().Format(time.RFC3339),
+				},
+			},
+		})
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error creating backup heartbeat patch")
+			return
+		}
+		if _, err := c.client.Backups(backup.Namespace).Patch(context.TODO(), backup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Error recording backup heartbeat")
+		}
+	}, backupHeartbeatInterval, stop)
+
+	return func() { close(stop) }
+}
+
 func (c *backupController) resync() {
 	// recompute backup_total metric
 	backups, err := c.lister.List(labels.Everything())
@@ -232,9 +433,19 @@ func (c *backupController) processBackup(key string) error {
 	// informer sees the update. In the latter case, after the informer has seen the update to
 	// InProgress, we still need this check so we can return nil to indicate we've finished processing
 	// this key (even though it was a no-op).
+	var resume bool
 	switch original.Status.Phase {
 	case "", velerov1api.BackupPhaseNew:
 		// only process new backups
+	case velerov1api.BackupPhaseInProgress:
+		// An in-progress backup is only processed again if this server took ownership
+		// of it after the server running it stopped, in which case it's resumed from
+		// its last checkpoint.
+		if !c.takeClaim(key) {
+			return nil
+		}
+		log.Info("Backup was interrupted before completing, resuming it")
+		resume = true
 	default:
 		return nil
 	}
@@ -246,7 +457,10 @@ func (c *backupController) processBackup(key string) error {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
-		request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if !resume || request.Status.StartTimestamp == nil {
+			request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		}
+		c.setBackupOwner(request.Backup)
 	}
 
 	// update status
@@ -259,20 +473,34 @@ func (c *backupController) processBackup(key string) error {
 	request.Backup = updatedBackup.DeepCopy()
 
 	if request.Status.Phase == velerov1api.BackupPhaseFailedValidation {
+		c.eventRecorder.Eventf(request.Backup, corev1api.EventTypeWarning, kubeutil.EventReasonFailedValidation,
+			"Backup failed validation: %s", strings.Join(request.Status.ValidationErrors, "; "))
 		return nil
 	}
 
+	if resume {
+		c.eventRecorder.Event(request.Backup, corev1api.EventTypeNormal, kubeutil.EventReasonPhaseChanged, "Backup was interrupted and is being resumed")
+	} else {
+		c.eventRecorder.Event(request.Backup, corev1api.EventTypeNormal, kubeutil.EventReasonPhaseChanged, "Backup is in progress")
+	}
+
 	c.backupTracker.Add(request.Namespace, request.Name)
 	defer c.backupTracker.Delete(request.Namespace, request.Name)
 
 	log.Debug("Running backup")
 	log.Infof("Running backup on source cluster at %s", c.backupper.SrcClusterHost())
+	if host := c.backupper.SrcClusterHost(); host != "" {
+		metav1.SetMetaDataAnnotation(&request.ObjectMeta, velerov1api.SourceClusterHostAnnotation, host)
+	}
 
 	backupScheduleName := request.GetLabels()[velerov1api.ScheduleNameLabel]
 	c.metrics.RegisterBackupAttempt(backupScheduleName)
 
 	// execution & upload of backup
-	if err := c.runBackup(request); err != nil {
+	stopHeartbeat := c.startBackupHeartbeat(request.Backup)
+	err = c.runBackup(request, resume)
+	stopHeartbeat()
+	if err != nil {
 		// even though runBackup sets the backup's phase prior
 		// to uploading artifacts to object storage, we have to
 		// check for an error again here and update the phase if
@@ -299,9 +527,32 @@ func (c *backupController) processBackup(key string) error {
 		log.WithError(err).Error("error updating backup's final status")
 	}
 
+	c.recordBackupCompletion(request)
+
 	return nil
 }
 
+// recordBackupCompletion records events about the backup's final phase on the backup and on
+// each namespace that was backed up, in the source cluster.
+func (c *backupController) recordBackupCompletion(request *pkgbackup.Request) {
+	eventType := corev1api.EventTypeNormal
+	message := fmt.Sprintf("Backup %s with %d error(s) and %d warning(s)", request.Status.Phase, request.Status.Errors, request.Status.Warnings)
+	switch request.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+	case velerov1api.BackupPhaseWaitingForPluginOperations:
+		message = fmt.Sprintf("Backup is waiting for plugin operations to complete, with %d error(s) and %d warning(s) so far", request.Status.Errors, request.Status.Warnings)
+	default:
+		eventType = corev1api.EventTypeWarning
+	}
+
+	c.eventRecorder.Event(request.Backup, eventType, kubeutil.EventReasonPhaseChanged, message)
+
+	for _, ns := range request.BackedUpNamespaces() {
+		c.srcEventRecorder.Eventf(kubeutil.NamespaceReference(ns), eventType, kubeutil.EventReasonBackedUp,
+			"Namespace backed up by backup %s/%s, phase %s", request.Namespace, request.Name, request.Status.Phase)
+	}
+}
+
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
 	origBytes, err := json.Marshal(original)
 	if err != nil {
@@ -391,6 +642,11 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		if err := storage.QuotaExceeded(request.StorageLocation); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup can't be created because %v", err))
+		}
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -429,9 +685,64 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the backup-wide hooks
+	request.Status.ValidationErrors = append(request.Status.ValidationErrors, validateBackupWideHooks(request.Spec.Hooks.BackupPre)...)
+	request.Status.ValidationErrors = append(request.Status.ValidationErrors, validateBackupWideHooks(request.Spec.Hooks.BackupPost)...)
+	request.Status.ValidationErrors = append(request.Status.ValidationErrors, validateResourceHooks(request.Spec.Hooks.Resources)...)
+
 	return request
 }
 
+// validateBackupWideHooks ensures each hook specifies exactly one of exec or job, and the
+// namespace it's executed in.
+func validateBackupWideHooks(hooks []velerov1api.BackupWideHook) []string {
+	var errs []string
+	for _, hook := range hooks {
+		switch {
+		case (hook.Exec == nil) == (hook.Job == nil):
+			errs = append(errs, fmt.Sprintf("Invalid backup-wide hook %s: exactly one of exec or job must be specified", hook.Name))
+		case hook.Exec != nil && hook.Exec.Namespace == "":
+			errs = append(errs, fmt.Sprintf("Invalid backup-wide hook %s: exec.namespace must be specified", hook.Name))
+		case hook.Job != nil && hook.Job.Namespace == "":
+			errs = append(errs, fmt.Sprintf("Invalid backup-wide hook %s: job.namespace must be specified", hook.Name))
+		}
+	}
+	return errs
+}
+
+// validateResourceHooks ensures each pre and post resource hook specifies exactly one of exec, http
+// or job.
+func validateResourceHooks(resourceHooks []velerov1api.BackupResourceHookSpec) []string {
+	var errs []string
+	for _, resourceHook := range resourceHooks {
+		for i, hook := range resourceHook.PreHooks {
+			if !hasSingleHookType(hook) {
+				errs = append(errs, fmt.Sprintf("Invalid resource hook %s: pre hook %d must specify exactly one of exec, http or job", resourceHook.Name, i))
+			}
+		}
+		for i, hook := range resourceHook.PostHooks {
+			if !hasSingleHookType(hook) {
+				errs = append(errs, fmt.Sprintf("Invalid resource hook %s: post hook %d must specify exactly one of exec, http or job", resourceHook.Name, i))
+			}
+		}
+	}
+	return errs
+}
+
+func hasSingleHookType(hook velerov1api.BackupResourceHook) bool {
+	var set int
+	if hook.Exec != nil {
+		set++
+	}
+	if hook.HTTP != nil {
+		set++
+	}
+	if hook.Job != nil {
+		set++
+	}
+	return set == 1
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 // - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -530,8 +841,9 @@ func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.B
 
 // runBackup runs and uploads a validated backup. Any error returned from this function
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
-// field is checked to see if the backup was a partial failure.
-func (c *backupController) runBackup(backup *pkgbackup.Request) error {
+// field is checked to see if the backup was a partial failure. If resume is true, the
+// backup continues from the checkpoint recorded by its interrupted run, if there is one.
+func (c *backupController) runBackup(backup *pkgbackup.Request, resume bool) (err error) {
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Setting up backup log")
 
 	logFile, err := ioutil.TempFile("", "")
@@ -554,6 +866,18 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 
 	backupLog := logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
 
+	ctx, span := tracing.Start(context.Background(), "Backup",
+		attribute.String("velero.backup.namespace", backup.Namespace),
+		attribute.String("velero.backup.name", backup.Name),
+		attribute.String("velero.storage_location", backup.Spec.StorageLocation),
+		attribute.String("velero.cluster", c.backupper.SrcClusterHost()),
+		attribute.Bool("velero.backup.resumed", resume),
+	)
+	defer func() {
+		span.SetAttributes(attribute.String("velero.backup.phase", string(backup.Status.Phase)))
+		tracing.End(span, err)
+	}()
+
 	backupLog.Info("Setting up backup temp file")
 	backupFile, err := ioutil.TempFile("", "")
 	if err != nil {
@@ -566,7 +890,13 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	defer pluginManager.CleanupClients()
 
 	backupLog.Info("Getting backup item actions")
-	actions, err := pluginManager.GetBackupItemActions()
+	actions, err := pluginManager.GetBackupItemActionsV2()
+	if err != nil {
+		return err
+	}
+
+	backupLog.Info("Getting item snapshotters")
+	backup.ItemSnapshotters, err = initItemSnapshotters(pluginManager, c.kbClient, backup.Namespace)
 	if err != nil {
 		return err
 	}
@@ -576,6 +906,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	if err != nil {
 		return err
 	}
+	backupStore = persistence.WithContext(backupStore, ctx)
 
 	exists, err := backupStore.BackupExists(backup.StorageLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name)
 	if exists || err != nil {
@@ -587,8 +918,23 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	backup.Checkpointer = &backupStoreCheckpointer{backupStore: backupStore, backupName: backup.Name}
+	if resume {
+		checkpoint, err := getBackupCheckpoint(backupStore, backup.Name)
+		switch {
+		case err != nil:
+			backupLog.WithError(err).Warn("Error getting checkpoint of interrupted backup, backing up all items again")
+		case checkpoint == nil:
+			backupLog.Info("Interrupted backup has no checkpoint, backing up all items again")
+		case checkpoint.BackupUID != string(backup.UID):
+			backupLog.Info("Checkpoint was taken of an earlier backup with the same name, backing up all items again")
+		default:
+			backup.ResumeFrom = checkpoint
+		}
+	}
+
 	var fatalErrs []error
-	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, pluginManager); err != nil {
+	if err := c.backupper.Backup(ctx, backupLog, backup, backupFile, actions, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
 
@@ -620,6 +966,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// Mark completion timestamp before serializing and uploading.
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	backup.Status.ObjectLock = newBackupObjectLock(backup.StorageLocation, backup.Status.CompletionTimestamp.Time)
 
 	backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
 	for _, snap := range backup.VolumeSnapshots {
@@ -627,8 +974,9 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 			backup.Status.VolumeSnapshotsCompleted++
 		}
 	}
+	backup.Status.HooksAttempted, backup.Status.HooksFailed = backup.HookReport.Summary()
 
-	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
+	recordBackupMetrics(backupLog, backup, backupFile, c.metrics)
 
 	if err := gzippedLogFile.Close(); err != nil {
 		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("error closing gzippedLogFile")
@@ -641,9 +989,14 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// logged to backupLog are captured. This is done before uploading the
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
+	//
+	// If plugins started operations that are still running, the backup
+	// operations controller will finalize the phase once they're done.
 	switch {
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
+	case velerov1api.PluginOperationsInProgress(backup.Status.PluginOperations):
+		backup.Status.Phase = velerov1api.BackupPhaseWaitingForPluginOperations
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	default:
@@ -652,15 +1005,18 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running backup
+	persistCtx, persistSpan := tracing.Start(ctx, "Backup.persist")
 	backupLog.Info("Setting up backup store to persist the backup")
 	backupStore, err = c.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
+		tracing.End(persistSpan, err)
 		return err
 	}
+	backupStore = persistence.WithContext(backupStore, persistCtx)
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
-		fatalErrs = append(fatalErrs, errs...)
-	}
+	persistErrs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents)
+	tracing.End(persistSpan, kerrors.NewAggregate(persistErrs))
+	fatalErrs = append(fatalErrs, persistErrs...)
 
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
 
@@ -669,7 +1025,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
-func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
+func recordBackupMetrics(log logrus.FieldLogger, backup *pkgbackup.Request, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
 	var backupSizeBytes int64
@@ -679,6 +1035,12 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 		backupSizeBytes = backupFileStat.Size()
 	}
 	serverMetrics.SetBackupTarballSizeBytesGauge(backupScheduleName, backupSizeBytes)
+	serverMetrics.SetBackupUploadedBytes(backupScheduleName, backup.Name, backupSizeBytes)
+
+	serverMetrics.SetBackupItemsTotal(backupScheduleName, backup.Name, len(backup.BackedUpItems))
+	for resource, items := range backup.BackupResourceList() {
+		serverMetrics.SetBackupResourceItemsTotal(backupScheduleName, backup.Name, resource, len(items))
+	}
 
 	backupDuration := backup.Status.CompletionTimestamp.Time.Sub(backup.Status.StartTimestamp.Time)
 	backupDurationSeconds := float64(backupDuration / time.Second)
@@ -696,11 +1058,6 @@ func persistBackup(backup *pkgbackup.Request,
 	csiVolumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
 ) []error {
 	persistErrs := []error{}
-	backupJSON := new(bytes.Buffer)
-
-	if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
-		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
-	}
 
 	// Velero-native volume snapshots (as opposed to CSI ones)
 	nativeVolumeSnapshots, errs := encodeToJSONGzip(backup.VolumeSnapshots, "native volumesnapshots list")
@@ -708,6 +1065,11 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	itemSnapshots, errs := encodeToJSONGzip(backup.ItemSnapshots, "item snapshots list")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	podVolumeBackups, errs := encodeToJSONGzip(backup.PodVolumeBackups, "pod volume backups list")
 	if errs != nil {
 		persistErrs = append(persistErrs, errs...)
@@ -728,14 +1090,41 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	hookReport, errs := encodeToJSONGzip(backup.HookReport.Executions(), "hook report")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	contentIndex, errs := encodeToJSONGzip(backup.ContentIndex(), "content index")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	// record the size of the backup's files before encoding the backup, so the
+	// size is stored along with it.
+	if size, err := backupStorageSize(backupContents, backupLog,
+		nativeVolumeSnapshots, itemSnapshots, podVolumeBackups, csiSnapshotJSON, csiSnapshotContentsJSON, backupResourceList, hookReport, contentIndex); err != nil {
+		log.WithError(err).Warn("Error getting the size of the backup's files")
+	} else {
+		backup.Status.StorageSize = size
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
+		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
 		backupContents = nil
 		nativeVolumeSnapshots = nil
+		itemSnapshots = nil
 		backupResourceList = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
+		hookReport = nil
+		contentIndex = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -745,10 +1134,14 @@ func persistBackup(backup *pkgbackup.Request,
 		Log:                       backupLog,
 		PodVolumeBackups:          podVolumeBackups,
 		VolumeSnapshots:           nativeVolumeSnapshots,
+		ItemSnapshots:             itemSnapshots,
 		BackupResourceList:        backupResourceList,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
+		HookReport:                hookReport,
+		ContentIndex:              contentIndex,
 	}
+	backupInfo.ObjectLock = objectLock(backup.Status.ObjectLock)
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
 	}
@@ -756,6 +1149,141 @@ func persistBackup(backup *pkgbackup.Request,
 	return persistErrs
 }
 
+// backupStorageSize returns the size of a backup's tarball, log and other files
+// uploaded along with its metadata file.
+func backupStorageSize(contents, log *os.File, files ...*bytes.Buffer) (*velerov1api.BackupStorageSize, error) {
+	size := new(velerov1api.BackupStorageSize)
+
+	if contents != nil {
+		info, err := contents.Stat()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting backup contents file info")
+		}
+		size.Contents = info.Size()
+	}
+
+	if log != nil {
+		info, err := log.Stat()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting backup log file info")
+		}
+		size.Log = info.Size()
+	}
+
+	for _, file := range files {
+		if file != nil {
+			size.Metadata += int64(file.Len())
+		}
+	}
+
+	size.Total = size.Contents + size.Log + size.Metadata
+	return size, nil
+}
+
+// newBackupObjectLock returns the lock to set on the files of a backup completed at
+// the given time, or nil if its storage location isn't immutable.
+func newBackupObjectLock(location *velerov1api.BackupStorageLocation, completed time.Time) *velerov1api.BackupObjectLock {
+	if location == nil || location.Spec.Immutability == nil {
+		return nil
+	}
+
+	immutability := location.Spec.Immutability
+	lock := &velerov1api.BackupObjectLock{
+		Mode:        immutability.Mode,
+		RetainUntil: &metav1.Time{Time: completed.Add(immutability.RetentionPeriod.Duration)},
+		LegalHold:   immutability.LegalHold,
+	}
+	if lock.Mode == "" {
+		lock.Mode = velerov1api.ObjectLockModeGovernance
+	}
+
+	return lock
+}
+
+// objectLock returns the lock to set on the files of a backup in object storage.
+func objectLock(lock *velerov1api.BackupObjectLock) *velero.ObjectLock {
+	if lock == nil {
+		return nil
+	}
+
+	return &velero.ObjectLock{
+		Mode:        string(lock.Mode),
+		RetainUntil: lock.RetainUntil.Time,
+		LegalHold:   lock.LegalHold,
+	}
+}
+
+// backupLockedUntil returns the time the locks on a backup's files, and on the files of
+// its copies in replication targets, expire, and whether any of them are locked at the
+// given time. Files under a legal hold stay locked indefinitely.
+func backupLockedUntil(backup *velerov1api.Backup, now time.Time) (time.Time, bool) {
+	locks := []*velerov1api.BackupObjectLock{backup.Status.ObjectLock}
+	for _, replication := range backup.Status.Replications {
+		locks = append(locks, replication.ObjectLock)
+	}
+
+	var (
+		lockedUntil time.Time
+		locked      bool
+	)
+	for _, lock := range locks {
+		if lock == nil {
+			continue
+		}
+		if lock.LegalHold {
+			return time.Time{}, true
+		}
+		if lock.RetainUntil == nil || !now.Before(lock.RetainUntil.Time) {
+			continue
+		}
+		if lock.RetainUntil.After(lockedUntil) {
+			lockedUntil = lock.RetainUntil.Time
+		}
+		locked = true
+	}
+	return lockedUntil, locked
+}
+
+// backupStoreCheckpointer persists backup checkpoints to the backup's storage location.
+type backupStoreCheckpointer struct {
+	backupStore persistence.BackupStore
+	backupName  string
+}
+
+func (c *backupStoreCheckpointer) Checkpoint(checkpoint *pkgbackup.Checkpoint) error {
+	checkpointJSON, errs := encodeToJSONGzip(checkpoint, "backup checkpoint")
+	if errs != nil {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return c.backupStore.PutBackupCheckpoint(c.backupName, checkpointJSON)
+}
+
+// getBackupCheckpoint returns the checkpoint persisted for the backup, or nil if there isn't one.
+func getBackupCheckpoint(backupStore persistence.BackupStore, backupName string) (*pkgbackup.Checkpoint, error) {
+	res, err := backupStore.GetBackupCheckpoint(backupName)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	gzr, err := gzip.NewReader(res)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	checkpoint := new(pkgbackup.Checkpoint)
+	if err := json.NewDecoder(gzr).Decode(checkpoint); err != nil {
+		return nil, errors.Wrap(err, "error decoding backup checkpoint")
+	}
+
+	return checkpoint, nil
+}
+
 func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	if file == nil {
 		log.Debug("Skipping removal of file due to nil file pointer")
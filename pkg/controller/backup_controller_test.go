@@ -21,7 +21,8 @@ import (
 	"context"
 	"fmt"
 	"io"
-
+	"io/ioutil"
+	"os"
 	"sort"
 	"strings"
 	"testing"
@@ -34,7 +35,9 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -48,7 +51,7 @@ import (
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
-	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -58,11 +61,15 @@ type fakeBackupper struct {
 	mock.Mock
 }
 
-func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+func (b *fakeBackupper) Backup(_ context.Context, logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []biav2.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
 	args := b.Called(logger, backup, backupFile, actions, volumeSnapshotterGetter)
 	return args.Error(0)
 }
 
+func (b *fakeBackupper) SrcClusterHost() string {
+	return ""
+}
+
 func defaultBackup() *builder.BackupBuilder {
 	return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1")
 }
@@ -87,7 +94,7 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 			backup: defaultBackup().Phase(velerov1api.BackupPhaseFailedValidation).Result(),
 		},
 		{
-			name:   "InProgress backup is not processed",
+			name:   "InProgress backup this server didn't take ownership of is not processed",
 			key:    "velero/backup-1",
 			backup: defaultBackup().Phase(velerov1api.BackupPhaseInProgress).Result(),
 		},
@@ -115,6 +122,7 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 				genericController: newGenericController("backup-test", logger),
 				lister:            sharedInformers.Velero().V1().Backups().Lister(),
 				formatFlag:        formatFlag,
+				claimed:           sets.NewString(),
 			}
 
 			if test.backup != nil {
@@ -164,6 +172,55 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:   "backup for backup location that reached its quota fails validation",
+			backup: defaultBackup().StorageLocation("full").Result(),
+			backupLocation: builder.ForBackupStorageLocation("velero", "full").
+				Quota(&velerov1api.BackupStorageLocationQuota{Backups: &[]int{2}[0]}).
+				Usage(2, 1024).
+				Result(),
+			expectedErrs: []string{"backup can't be created because backup storage location full stores 2 backups, reaching its quota of 2 backups"},
+		},
+		{
+			name: "invalid backup-wide hooks fail validation",
+			backup: defaultBackup().Hooks(velerov1api.BackupHooks{
+				BackupPre: []velerov1api.BackupWideHook{
+					{Name: "none"},
+					{Name: "no-namespace", Exec: &velerov1api.BackupWideExecHook{ExecHook: velerov1api.ExecHook{Command: []string{"sync"}}}},
+				},
+				BackupPost: []velerov1api.BackupWideHook{
+					{Name: "both", Exec: &velerov1api.BackupWideExecHook{Namespace: "ns-1"}, Job: &velerov1api.JobHook{Namespace: "ns-1"}},
+				},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid backup-wide hook none: exactly one of exec or job must be specified",
+				"Invalid backup-wide hook no-namespace: exec.namespace must be specified",
+				"Invalid backup-wide hook both: exactly one of exec or job must be specified",
+			},
+		},
+		{
+			name: "resource hooks without exactly one hook type fail validation",
+			backup: defaultBackup().Hooks(velerov1api.BackupHooks{
+				Resources: []velerov1api.BackupResourceHookSpec{
+					{
+						Name: "hooks",
+						PreHooks: []velerov1api.BackupResourceHook{
+							{Exec: &velerov1api.ExecHook{Command: []string{"sync"}}},
+							{},
+						},
+						PostHooks: []velerov1api.BackupResourceHook{
+							{HTTP: &velerov1api.HTTPHook{Service: "svc"}, Job: &velerov1api.JobHook{}},
+						},
+					},
+				},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid resource hook hooks: pre hook 1 must specify exactly one of exec, http or job",
+				"Invalid resource hook hooks: post hook 0 must specify exactly one of exec, http or job",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -179,6 +236,8 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
 			require.NoError(t, err)
 
+			eventRecorder := record.NewFakeRecorder(10)
+
 			var fakeClient kbclient.Client
 			if test.backupLocation != nil {
 				fakeClient = velerotest.NewFakeControllerRuntimeClient(t, test.backupLocation)
@@ -196,6 +255,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 				defaultBackupLocation:  defaultBackupLocation.Name,
 				clock:                  &clock.RealClock{},
 				formatFlag:             formatFlag,
+				eventRecorder:          eventRecorder,
 			}
 
 			require.NotNil(t, test.backup)
@@ -209,6 +269,9 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			assert.Equal(t, velerov1api.BackupPhaseFailedValidation, res.Status.Phase)
 			assert.Equal(t, test.expectedErrs, res.Status.ValidationErrors)
 
+			require.Len(t, eventRecorder.Events, 1)
+			assert.Equal(t, "Warning FailedValidation Backup failed validation: "+strings.Join(test.expectedErrs, "; "), <-eventRecorder.Events)
+
 			// Any backup that would actually proceed to processing will cause a segfault because this
 			// test hasn't set up the necessary controller dependencies for running backups. So the lack
 			// of segfaults during test execution here imply that backups are not being processed, which
@@ -370,6 +433,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -406,6 +471,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "alt-loc",
@@ -445,6 +512,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "read-write",
@@ -481,6 +550,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -519,6 +590,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -557,6 +630,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -595,6 +670,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -633,6 +710,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -671,6 +750,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -710,6 +791,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -747,6 +830,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/backup-owner":                     "velero-1",
+						"velero.io/backup-heartbeat":                 "2006-01-02T22:04:05Z",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -815,17 +900,21 @@ func TestProcessBackupCompletions(t *testing.T) {
 				defaultBackupLocation:  defaultBackupLocation.Name,
 				defaultVolumesToRestic: test.defaultVolumesToRestic,
 				backupTracker:          NewBackupTracker(),
-				metrics:                metrics.NewServerMetrics(),
+				metrics:                metrics.NewServerMetrics("", ""),
 				clock:                  clock.NewFakeClock(now),
 				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
 				backupper:              backupper,
 				formatFlag:             formatFlag,
+				eventRecorder:          &record.FakeRecorder{},
+				srcEventRecorder:       &record.FakeRecorder{},
+				owner:                  "velero-1",
 			}
 
-			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+			pluginManager.On("GetItemSnapshotters").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
-			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
+			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []biav2.BackupItemAction(nil), pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
 
 			// Ensure we have a CompletionTimestamp when uploading and that the backup name matches the backup in the object store.
@@ -850,6 +939,13 @@ func TestProcessBackupCompletions(t *testing.T) {
 			res, err := clientset.VeleroV1().Backups(test.backup.Namespace).Get(context.TODO(), test.backup.Name, metav1.GetOptions{})
 			require.NoError(t, err)
 
+			// the storage size depends on the encoded backup and its log, so it's only
+			// checked to be set here. backupStorageSize is covered by TestBackupStorageSize.
+			if test.expectedResult.Status.Phase == velerov1api.BackupPhaseCompleted {
+				require.NotNil(t, res.Status.StorageSize)
+			}
+			res.Status.StorageSize = nil
+
 			assert.Equal(t, test.expectedResult, res)
 
 			// reset defaultBackupLocation resourceVersion
@@ -1144,3 +1240,171 @@ func Test_getLastSuccessBySchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupCheckpointRoundTrip(t *testing.T) {
+	var (
+		backupStore  = new(persistencemocks.BackupStore)
+		checkpointer = &backupStoreCheckpointer{backupStore: backupStore, backupName: "backup-1"}
+		persisted    = new(bytes.Buffer)
+		checkpoint   = &pkgbackup.Checkpoint{
+			BackupUID: "uid-1",
+			BackedUpItems: []pkgbackup.CheckpointItem{
+				{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1"},
+			},
+			PodVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-1").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+			},
+		}
+	)
+
+	backupStore.On("PutBackupCheckpoint", "backup-1", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		_, err := io.Copy(persisted, args.Get(1).(io.Reader))
+		require.NoError(t, err)
+	})
+	require.NoError(t, checkpointer.Checkpoint(checkpoint))
+
+	backupStore.On("GetBackupCheckpoint", "backup-1").Return(ioutil.NopCloser(persisted), nil)
+	res, err := getBackupCheckpoint(backupStore, "backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint, res)
+
+	backupStore.On("GetBackupCheckpoint", "backup-2").Return(nil, nil)
+	res, err = getBackupCheckpoint(backupStore, "backup-2")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestNewBackupObjectLock(t *testing.T) {
+	completed := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, newBackupObjectLock(builder.ForBackupStorageLocation("velero", "default").Result(), completed))
+
+	location := builder.ForBackupStorageLocation("velero", "default").Immutability("", 24*time.Hour, true).Result()
+	assert.Equal(t, &velerov1api.BackupObjectLock{
+		Mode:        velerov1api.ObjectLockModeGovernance,
+		RetainUntil: &metav1.Time{Time: completed.Add(24 * time.Hour)},
+		LegalHold:   true,
+	}, newBackupObjectLock(location, completed))
+}
+
+func TestBackupLockedUntil(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	lock := func(retainUntil time.Time, legalHold bool) *velerov1api.BackupObjectLock {
+		return &velerov1api.BackupObjectLock{RetainUntil: &metav1.Time{Time: retainUntil}, LegalHold: legalHold}
+	}
+	replication := func(lock *velerov1api.BackupObjectLock) velerov1api.BackupReplicationStatus {
+		return velerov1api.BackupReplicationStatus{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted, ObjectLock: lock}
+	}
+
+	tests := []struct {
+		name            string
+		backup          *velerov1api.Backup
+		wantLockedUntil time.Time
+		wantLocked      bool
+	}{
+		{
+			name:   "backup without locks isn't locked",
+			backup: builder.ForBackup("velero", "backup-1").Replications(replication(nil)).Result(),
+		},
+		{
+			name:   "expired locks don't lock the backup",
+			backup: builder.ForBackup("velero", "backup-1").ObjectLock(velerov1api.ObjectLockModeGovernance, now, false).Replications(replication(lock(now.Add(-time.Hour), false))).Result(),
+		},
+		{
+			name:            "the backup is locked until the lock of its files expires",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectLock(velerov1api.ObjectLockModeGovernance, now.Add(time.Hour), false).Result(),
+			wantLockedUntil: now.Add(time.Hour),
+			wantLocked:      true,
+		},
+		{
+			name:            "the backup is locked until the lock of its copies expires",
+			backup:          builder.ForBackup("velero", "backup-1").ObjectLock(velerov1api.ObjectLockModeGovernance, now.Add(time.Hour), false).Replications(replication(lock(now.Add(2*time.Hour), false))).Result(),
+			wantLockedUntil: now.Add(2 * time.Hour),
+			wantLocked:      true,
+		},
+		{
+			name:       "a copy under a legal hold locks the backup indefinitely",
+			backup:     builder.ForBackup("velero", "backup-1").Replications(replication(lock(now.Add(-time.Hour), true))).Result(),
+			wantLocked: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lockedUntil, locked := backupLockedUntil(tc.backup, now)
+			assert.Equal(t, tc.wantLocked, locked)
+			assert.Equal(t, tc.wantLockedUntil, lockedUntil)
+		})
+	}
+}
+
+func TestBackupStorageSize(t *testing.T) {
+	contents, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer os.Remove(contents.Name())
+	_, err = contents.Write(make([]byte, 100))
+	require.NoError(t, err)
+
+	log, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer os.Remove(log.Name())
+	_, err = log.Write(make([]byte, 10))
+	require.NoError(t, err)
+
+	size, err := backupStorageSize(contents, log, bytes.NewBufferString("abc"), nil, bytes.NewBufferString("de"))
+	require.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageSize{
+		Contents: 100,
+		Log:      10,
+		Metadata: 5,
+		Total:    115,
+	}, size)
+}
+
+func TestClaimInterruptedBackups(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	heartbeat := func(ago time.Duration) map[string]string {
+		return map[string]string{
+			velerov1api.BackupOwnerAnnotation:     "velero-0",
+			velerov1api.BackupHeartbeatAnnotation: now.Add(-ago).Format(time.RFC3339),
+		}
+	}
+
+	var (
+		expired   = builder.ForBackup("velero", "expired").Phase(velerov1api.BackupPhaseInProgress).ObjectMeta(builder.WithAnnotationsMap(heartbeat(2 * backupHeartbeatTimeout))).Result()
+		running   = builder.ForBackup("velero", "running").Phase(velerov1api.BackupPhaseInProgress).ObjectMeta(builder.WithAnnotationsMap(heartbeat(backupHeartbeatInterval))).Result()
+		legacy    = builder.ForBackup("velero", "legacy").Phase(velerov1api.BackupPhaseInProgress).Result()
+		completed = builder.ForBackup("velero", "completed").Phase(velerov1api.BackupPhaseCompleted).Result()
+		clientset = fake.NewSimpleClientset(expired, running, legacy, completed)
+	)
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", velerotest.NewLogger()),
+		client:            clientset.VeleroV1(),
+		clock:             clock.NewFakeClock(now),
+		owner:             "velero-1",
+		claimed:           sets.NewString(),
+	}
+	defer c.queue.ShutDown()
+
+	stillRunning := c.claimInterruptedBackups(context.Background(), []*velerov1api.Backup{expired, running, legacy, completed})
+	require.Len(t, stillRunning, 1)
+	assert.Equal(t, "running", stillRunning[0].Name)
+
+	assert.Equal(t, 2, c.queue.Len())
+	for _, name := range []string{"expired", "legacy"} {
+		res, err := clientset.VeleroV1().Backups("velero").Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "velero-1", res.Annotations[velerov1api.BackupOwnerAnnotation])
+		assert.Equal(t, "2021-01-01T12:00:00Z", res.Annotations[velerov1api.BackupHeartbeatAnnotation])
+
+		// the claim is only taken once
+		assert.True(t, c.takeClaim("velero/"+name))
+		assert.False(t, c.takeClaim("velero/"+name))
+	}
+	assert.False(t, c.takeClaim("velero/running"))
+
+	res, err := clientset.VeleroV1().Backups("velero").Get(context.TODO(), "running", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "velero-0", res.Annotations[velerov1api.BackupOwnerAnnotation])
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
@@ -46,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -78,6 +80,7 @@ type backupDeletionController struct {
 	backupStoreGetter         persistence.ObjectBackupStoreGetter
 	metrics                   *metrics.ServerMetrics
 	helper                    discovery.Helper
+	srcCluster                veleroclient.ClusterContextProvider
 }
 
 // NewBackupDeletionController creates a new backup deletion controller.
@@ -100,6 +103,7 @@ func NewBackupDeletionController(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	helper discovery.Helper,
+	srcCluster veleroclient.ClusterContextProvider,
 ) Interface {
 	c := &backupDeletionController{
 		genericController:         newGenericController(BackupDeletion, logger),
@@ -118,6 +122,7 @@ func NewBackupDeletionController(
 		csiSnapshotClient:         csiSnapshotClient,
 		metrics:                   metrics,
 		helper:                    helper,
+		srcCluster:                srcCluster,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
@@ -220,6 +225,17 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return errors.Wrap(err, "error getting backup")
 	}
 
+	// Don't allow deleting a backup whose plugin operations are still running, since
+	// the resources they create would be left behind
+	if backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations {
+		_, err = c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = []string{"backup is waiting for plugin operations to complete"}
+		})
+
+		return err
+	}
+
 	// Don't allow deleting backups in read-only storage locations
 	location := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -244,6 +260,19 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return err
 	}
 
+	// Don't allow deleting backups whose files are locked in object storage
+	if lockedUntil, locked := backupLockedUntil(backup, c.clock.Now()); locked {
+		message := fmt.Sprintf("cannot delete backup because its files are locked until %s", lockedUntil.Format(time.RFC3339))
+		if lockedUntil.IsZero() {
+			message = "cannot delete backup because its files are under a legal hold"
+		}
+		_, err := c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, message)
+		})
+		return err
+	}
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -316,6 +345,7 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 				Log:             c.logger,
 				DiscoveryHelper: c.helper,
 				Filesystem:      filesystem.NewFileSystem(),
+				Cluster:         c.srcCluster,
 			}
 
 			// Optimization: wrap in a gofunc? Would be useful for large backups with lots of objects.
@@ -354,6 +384,46 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
+	if backupStore != nil {
+		log.Info("Removing item snapshots")
+
+		if snapshots, err := backupStore.GetItemSnapshots(backup.Name); err != nil {
+			errs = append(errs, errors.Wrap(err, "error getting backup's item snapshots").Error())
+		} else {
+			itemSnapshotters := make(map[string]velero.ItemSnapshotter)
+
+			for _, snapshot := range snapshots {
+				log.WithFields(logrus.Fields{
+					"itemSnapshotter": snapshot.Spec.ItemSnapshotter,
+					"snapshotID":      snapshot.Status.SnapshotID,
+				}).Info("Removing item snapshot associated with backup")
+
+				itemSnapshotter, ok := itemSnapshotters[snapshot.Spec.ItemSnapshotter]
+				if !ok {
+					if itemSnapshotter, err = initItemSnapshotter(pluginManager, c.kbClient, backup.Namespace, snapshot.Spec.ItemSnapshotter); err != nil {
+						errs = append(errs, err.Error())
+						continue
+					}
+					itemSnapshotters[snapshot.Spec.ItemSnapshotter] = itemSnapshotter
+				}
+
+				cluster, err := c.srcCluster.ClusterContext()
+				if err != nil {
+					errs = append(errs, err.Error())
+					continue
+				}
+
+				if err := itemSnapshotter.Delete(&velero.DeleteItemSnapshotInput{
+					SnapshotID:       snapshot.Status.SnapshotID,
+					SnapshotMetadata: snapshot.Status.Metadata,
+					Cluster:          cluster,
+				}); err != nil {
+					errs = append(errs, errors.Wrapf(err, "error deleting item snapshot %s", snapshot.Status.SnapshotID).Error())
+				}
+			}
+		}
+	}
+
 	log.Info("Removing restic snapshots")
 	if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
 		for _, err := range deleteErrs {
@@ -368,6 +438,11 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
+	log.Info("Removing copies of backup from replication targets")
+	for _, err := range c.deleteReplicas(backup, pluginManager, log) {
+		errs = append(errs, err.Error())
+	}
+
 	log.Info("Removing restores")
 	if restores, err := c.restoreLister.Restores(backup.Namespace).List(labels.Everything()); err != nil {
 		log.WithError(errors.WithStack(err)).Error("Error listing restore API objects")
@@ -398,6 +473,8 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		err = c.backupClient.Backups(backup.Namespace).Delete(context.TODO(), backup.Name, metav1.DeleteOptions{})
 		if err != nil {
 			errs = append(errs, errors.Wrapf(err, "error deleting backup %s", kube.NamespaceAndName(backup)).Error())
+		} else {
+			c.metrics.DeleteBackupMetrics(backup.Name)
 		}
 	}
 
@@ -443,6 +520,7 @@ func volumeSnapshotterForSnapshotLocation(
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
 	}
+	framework.SetLocationTimeout(volumeSnapshotter, snapshotLocation.Spec.PluginTimeout)
 
 	if err = volumeSnapshotter.Init(snapshotLocation.Spec.Config); err != nil {
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", snapshotLocationName)
@@ -496,6 +574,61 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *velerov1api.Bac
 	return errs
 }
 
+// deleteReplicas deletes the copies of a backup, including their restic snapshots,
+// from the backup's replication targets. Targets that no longer exist or are in
+// read-only mode are skipped.
+func (c *backupDeletionController) deleteReplicas(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []error {
+	var errs []error
+	for _, replication := range backup.Status.Replications {
+		log := log.WithField("replicationTarget", replication.Location)
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+			Namespace: backup.Namespace,
+			Name:      replication.Location,
+		}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Warn("Skipping copy of backup in replication target that does not exist")
+				continue
+			}
+			errs = append(errs, errors.Wrapf(err, "error getting backup storage location %s", replication.Location))
+			continue
+		}
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			log.Warn("Skipping copy of backup in replication target in read-only mode")
+			continue
+		}
+
+		if replication.Phase == velerov1api.BackupReplicationPhaseCompleted && c.resticMgr != nil {
+			snapshots, err := restic.GetSnapshotsInBackup(backup, c.podvolumeBackupLister)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), resticTimeout)
+			for _, snapshot := range snapshots {
+				snapshot.BackupStorageLocation = location.Name
+				if err := c.resticMgr.Forget(ctx, snapshot); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			cancelFunc()
+		}
+
+		backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := backupStore.DeleteBackup(backup.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
 const deleteBackupRequestMaxAge = 24 * time.Hour
 
 func (c *backupDeletionController) deleteExpiredRequests() {
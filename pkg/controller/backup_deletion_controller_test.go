@@ -39,6 +39,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -72,8 +73,9 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		nil, // csiSnapshotClient
 		nil, // new plugin manager func
 		nil, // backupStoreGetter
-		metrics.NewServerMetrics(),
+		metrics.NewServerMetrics("", ""),
 		nil, // discovery helper
+		veleroclient.LocalClusterContextProvider{},
 	).(*backupDeletionController)
 
 	// Error splitting key
@@ -170,8 +172,9 @@ func setupBackupDeletionControllerTest(t *testing.T, objects ...runtime.Object)
 			nil, // csiSnapshotClient
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			NewFakeSingleObjectBackupStoreGetter(backupStore),
-			metrics.NewServerMetrics(),
+			metrics.NewServerMetrics("", ""),
 			nil, // discovery helper
+			veleroclient.LocalClusterContextProvider{},
 		).(*backupDeletionController),
 
 		req: req,
@@ -399,6 +402,33 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("deleting a backup waiting for plugin operations isn't allowed", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"errors":["backup is waiting for plugin operations to complete"],"phase":"Processed"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
 	t.Run("backup storage location is in read-only mode", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
 		location := builder.ForBackupStorageLocation("velero", "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()
@@ -426,6 +456,35 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		assert.Equal(t, expectedActions, td.client.Actions())
 	})
 
+	t.Run("backup files are locked", func(t *testing.T) {
+		now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").ObjectLock(velerov1api.ObjectLockModeCompliance, now.Add(time.Hour), false).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+
+		td := setupBackupDeletionControllerTest(t, location, backup)
+		td.controller.clock = clock.NewFakeClock(now)
+
+		err := td.controller.processRequest(td.req)
+		require.NoError(t, err)
+
+		expectedActions := []core.Action{
+			core.NewGetAction(
+				velerov1api.SchemeGroupVersion.WithResource("backups"),
+				td.req.Namespace,
+				td.req.Spec.BackupName,
+			),
+			core.NewPatchAction(
+				velerov1api.SchemeGroupVersion.WithResource("deletebackuprequests"),
+				td.req.Namespace,
+				td.req.Name,
+				types.MergePatchType,
+				[]byte(`{"status":{"errors":["cannot delete backup because its files are locked until 2021-01-01T13:00:00Z"],"phase":"Processed"}}`),
+			),
+		}
+
+		assert.Equal(t, expectedActions, td.client.Actions())
+	})
+
 	t.Run("full delete, no errors", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
@@ -505,6 +564,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
@@ -666,6 +726,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
@@ -807,6 +868,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 
 		err := td.controller.processRequest(td.req)
@@ -937,6 +999,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
+		td.backupStore.On("GetItemSnapshots", td.req.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(nil, fmt.Errorf("error downloading tarball"))
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 
@@ -1128,8 +1191,9 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				nil, // csiSnapshotClient
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
-				metrics.NewServerMetrics(),
+				metrics.NewServerMetrics("", ""),
 				nil, // discovery helper,
+				veleroclient.LocalClusterContextProvider{},
 			).(*backupDeletionController)
 
 			fakeClock := &clock.FakeClock{}
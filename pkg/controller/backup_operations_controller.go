@@ -0,0 +1,278 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// backupOperationsController tracks the asynchronous operations started by backup item
+// actions, and finishes the backups that are waiting for them once they're all done.
+type backupOperationsController struct {
+	*genericController
+
+	namespace            string
+	kbClient             client.Client
+	backupClient         velerov1client.BackupsGetter
+	backupLister         velerov1listers.BackupLister
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter    persistence.ObjectBackupStoreGetter
+	itemOperationTimeout time.Duration
+	metrics              *metrics.ServerMetrics
+	eventRecorder        record.EventRecorder
+	clock                clock.Clock
+}
+
+// NewBackupOperationsController constructs a new backupOperationsController.
+func NewBackupOperationsController(
+	namespace string,
+	kbClient client.Client,
+	backupClient velerov1client.BackupsGetter,
+	backupInformer velerov1informers.BackupInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	itemOperationTimeout time.Duration,
+	metrics *metrics.ServerMetrics,
+	eventRecorder record.EventRecorder,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &backupOperationsController{
+		genericController:    newGenericController(BackupOperations, logger),
+		namespace:            namespace,
+		kbClient:             kbClient,
+		backupClient:         backupClient,
+		backupLister:         backupInformer.Lister(),
+		newPluginManager:     newPluginManager,
+		backupStoreGetter:    backupStoreGetter,
+		itemOperationTimeout: itemOperationTimeout,
+		metrics:              metrics,
+		eventRecorder:        eventRecorder,
+		clock:                clock.RealClock{},
+	}
+
+	c.syncHandler = c.processBackup
+	c.resyncPeriod = pluginOperationsResyncPeriod
+	c.resyncFunc = c.enqueueWaitingBackups
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueIfWaiting,
+			UpdateFunc: func(_, obj interface{}) { c.enqueueIfWaiting(obj) },
+		},
+	)
+
+	return c
+}
+
+func (c *backupOperationsController) enqueueIfWaiting(obj interface{}) {
+	backup, ok := obj.(*velerov1api.Backup)
+	if !ok || backup.Status.Phase != velerov1api.BackupPhaseWaitingForPluginOperations {
+		return
+	}
+
+	c.enqueue(backup)
+}
+
+// enqueueWaitingBackups enqueues the backups that are waiting for plugin operations, so
+// the progress of the operations is checked periodically.
+func (c *backupOperationsController) enqueueWaitingBackups() {
+	backups, err := c.backupLister.Backups(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backups")
+		return
+	}
+
+	for _, backup := range backups {
+		c.enqueueIfWaiting(backup)
+	}
+}
+
+func (c *backupOperationsController) processBackup(key string) error {
+	log := c.logger.WithField("backup", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	original, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+	if original.Status.Phase != velerov1api.BackupPhaseWaitingForPluginOperations {
+		return nil
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backup := original.DeepCopy()
+	getHandler := func(op *velerov1api.PluginOperation) (pluginOperationHandler, error) {
+		if op.PluginKind == string(framework.PluginKindItemSnapshotter) {
+			snapshotter, err := initItemSnapshotter(pluginManager, c.kbClient, backup.Namespace, op.PluginName)
+			if err != nil {
+				return nil, err
+			}
+			return &itemSnapshotOperationHandler{
+				snapshotter: snapshotter,
+				itemID: velero.ResourceIdentifier{
+					GroupResource: schema.ParseGroupResource(op.Resource),
+					Namespace:     op.Namespace,
+					Name:          op.Name,
+				},
+				backup: backup,
+			}, nil
+		}
+
+		action, err := pluginManager.GetBackupItemActionV2(op.PluginName)
+		if err != nil {
+			return nil, err
+		}
+		return &backupOperationHandler{action: action, backup: backup}, nil
+	}
+	updated := updatePluginOperations(backup.Status.PluginOperations, getHandler, c.itemOperationTimeout, c.clock.Now(), log)
+
+	if velerov1api.PluginOperationsInProgress(backup.Status.PluginOperations) {
+		if !updated {
+			return nil
+		}
+		_, err := patchBackup(original, backup, c.backupClient)
+		return errors.Wrap(err, "error updating backup's plugin operations")
+	}
+
+	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
+	if backup.Status.Errors > 0 || pluginOperationsFailed(backup.Status.PluginOperations) {
+		log.Info("Backup partially failed")
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+		c.metrics.RegisterBackupPartialFailure(backupScheduleName)
+	} else {
+		log.Info("Backup completed")
+		backup.Status.Phase = velerov1api.BackupPhaseCompleted
+		c.metrics.RegisterBackupSuccess(backupScheduleName)
+	}
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	// the backup's metadata in object storage is updated so that backups synced from
+	// it have the final phase and operations. This is best-effort: the metadata of a
+	// backup in an immutable location can't be overwritten until its lock expires.
+	if err := c.putBackupMetadata(backup, pluginManager, log); err != nil {
+		log.WithError(err).Warn("Error updating the backup's metadata in object storage")
+	}
+
+	if _, err := patchBackup(original, backup, c.backupClient); err != nil {
+		return errors.Wrap(err, "error updating backup's final status")
+	}
+
+	eventType := corev1api.EventTypeNormal
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+		eventType = corev1api.EventTypeWarning
+	}
+	c.eventRecorder.Eventf(backup, eventType, kubeutil.EventReasonPhaseChanged,
+		"Backup %s with %d error(s) and %d warning(s)", backup.Status.Phase, backup.Status.Errors, backup.Status.Warnings)
+
+	return nil
+}
+
+func (c *backupOperationsController) putBackupMetadata(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+
+	return backupStore.PutBackupMetadata(backup.Name, backupJSON)
+}
+
+// backupOperationHandler checks on and cancels the operations a backup item action
+// started for a backup.
+type backupOperationHandler struct {
+	action biav2.BackupItemAction
+	backup *velerov1api.Backup
+}
+
+func (h *backupOperationHandler) Progress(operationID string) (velero.OperationProgress, error) {
+	return h.action.Progress(operationID, h.backup)
+}
+
+func (h *backupOperationHandler) Cancel(operationID string) error {
+	return h.action.Cancel(operationID, h.backup)
+}
+
+// itemSnapshotOperationHandler checks on the snapshot an item snapshotter took of an
+// item for a backup. The operation ID is the snapshot ID.
+type itemSnapshotOperationHandler struct {
+	snapshotter velero.ItemSnapshotter
+	itemID      velero.ResourceIdentifier
+	backup      *velerov1api.Backup
+}
+
+func (h *itemSnapshotOperationHandler) Progress(operationID string) (velero.OperationProgress, error) {
+	return h.snapshotter.Progress(&velero.ItemSnapshotProgressInput{
+		ItemID:     h.itemID,
+		SnapshotID: operationID,
+		Backup:     h.backup,
+	})
+}
+
+// Cancel does nothing, since item snapshotters can't cancel snapshots. A snapshot
+// that timed out is deleted with its backup.
+func (h *itemSnapshotOperationHandler) Cancel(operationID string) error {
+	return nil
+}
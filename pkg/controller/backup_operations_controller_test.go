@@ -0,0 +1,186 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupOperationsControllerProcessBackup(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+
+	operation := func(created time.Time) velerov1api.PluginOperation {
+		return velerov1api.PluginOperation{
+			PluginName:  "velero.io/snapshotter",
+			OperationID: "op-1",
+			Resource:    "persistentvolumeclaims",
+			Namespace:   "ns-1",
+			Name:        "pvc-1",
+			Phase:       velerov1api.PluginOperationPhaseInProgress,
+			Created:     &metav1.Time{Time: created},
+		}
+	}
+	waitingBackup := func() *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+			StorageLocation("default").
+			Phase(velerov1api.BackupPhaseWaitingForPluginOperations)
+	}
+
+	tests := []struct {
+		name            string
+		backup          *velerov1api.Backup
+		progress        velero.OperationProgress
+		progressErr     error
+		expectCancel    bool
+		expectedPhase   velerov1api.BackupPhase
+		expectedOpPhase velerov1api.PluginOperationPhase
+		expectedOpErr   string
+	}{
+		{
+			name:   "backups that aren't waiting for plugin operations are ignored",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).PluginOperations(operation(now)).Result(),
+		},
+		{
+			name:            "backups with operations in progress keep waiting",
+			backup:          waitingBackup().PluginOperations(operation(now.Add(-time.Minute))).Result(),
+			progress:        velero.OperationProgress{NCompleted: 5, NTotal: 10, OperationUnits: "GB"},
+			expectedPhase:   velerov1api.BackupPhaseWaitingForPluginOperations,
+			expectedOpPhase: velerov1api.PluginOperationPhaseInProgress,
+		},
+		{
+			name:            "backups whose operations completed are completed",
+			backup:          waitingBackup().PluginOperations(operation(now.Add(-time.Minute))).Result(),
+			progress:        velero.OperationProgress{Completed: true, NCompleted: 10, NTotal: 10, OperationUnits: "GB"},
+			expectedPhase:   velerov1api.BackupPhaseCompleted,
+			expectedOpPhase: velerov1api.PluginOperationPhaseCompleted,
+		},
+		{
+			name:            "backups whose operations failed are partially failed",
+			backup:          waitingBackup().PluginOperations(operation(now.Add(-time.Minute))).Result(),
+			progress:        velero.OperationProgress{Completed: true, Err: "snapshot failed"},
+			expectedPhase:   velerov1api.BackupPhasePartiallyFailed,
+			expectedOpPhase: velerov1api.PluginOperationPhaseFailed,
+			expectedOpErr:   "snapshot failed",
+		},
+		{
+			name:            "operations whose progress can't be checked are failed",
+			backup:          waitingBackup().PluginOperations(operation(now.Add(-time.Minute))).Result(),
+			progressErr:     errors.New("unknown operation"),
+			expectedPhase:   velerov1api.BackupPhasePartiallyFailed,
+			expectedOpPhase: velerov1api.PluginOperationPhaseFailed,
+			expectedOpErr:   "unknown operation",
+		},
+		{
+			name:            "operations that timed out are canceled",
+			backup:          waitingBackup().PluginOperations(operation(now.Add(-timeout))).Result(),
+			expectCancel:    true,
+			expectedPhase:   velerov1api.BackupPhasePartiallyFailed,
+			expectedOpPhase: velerov1api.PluginOperationPhaseCanceled,
+			expectedOpErr:   "operation timed out after 1h0m0s",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				action          = &biav2mocks.BackupItemAction{}
+			)
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetBackupItemActionV2", "velero.io/snapshotter").Return(action, nil)
+			action.On("Progress", "op-1", mock.Anything).Return(test.progress, test.progressErr)
+			action.On("Cancel", "op-1", mock.Anything).Return(nil)
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+
+			// the backup storage location doesn't exist, so updating the backup's
+			// metadata in object storage fails without failing the backup.
+			c := NewBackupOperationsController(
+				velerov1api.DefaultNamespace,
+				velerotest.NewFakeControllerRuntimeClient(t),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{}),
+				timeout,
+				metrics.NewServerMetrics("", ""),
+				record.NewFakeRecorder(10),
+				velerotest.NewLogger(),
+			).(*backupOperationsController)
+			c.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, c.processBackup("velero/backup-1"))
+
+			if test.expectedPhase == "" {
+				assert.Empty(t, client.Actions())
+				return
+			}
+
+			if test.expectCancel {
+				action.AssertCalled(t, "Cancel", "op-1", mock.Anything)
+			} else {
+				action.AssertNotCalled(t, "Cancel", "op-1", mock.Anything)
+			}
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			if test.expectedPhase == velerov1api.BackupPhaseWaitingForPluginOperations {
+				assert.Nil(t, res.Status.CompletionTimestamp)
+			} else {
+				require.NotNil(t, res.Status.CompletionTimestamp)
+				assert.True(t, res.Status.CompletionTimestamp.Time.Equal(now))
+			}
+
+			require.Len(t, res.Status.PluginOperations, 1)
+			op := res.Status.PluginOperations[0]
+			assert.Equal(t, test.expectedOpPhase, op.Phase)
+			assert.Equal(t, test.expectedOpErr, op.Error)
+			assert.Equal(t, test.progress.NCompleted, op.NCompleted)
+			assert.Equal(t, test.progress.NTotal, op.NTotal)
+			require.NotNil(t, op.Updated)
+			assert.True(t, op.Updated.Time.Equal(now))
+		})
+	}
+}
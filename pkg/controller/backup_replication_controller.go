@@ -0,0 +1,312 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/storage"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+)
+
+const (
+	backupReplicationResyncPeriod = time.Minute
+
+	// BackupReplicationRetryInterval is how long to wait before retrying to copy a
+	// backup to a replication target after an attempt failed.
+	BackupReplicationRetryInterval = 10 * time.Minute
+)
+
+// backupReplicationController copies completed backups to the replication targets
+// of the backups and of their storage locations.
+type backupReplicationController struct {
+	*genericController
+
+	namespace             string
+	kbClient              client.Client
+	backupClient          velerov1client.BackupsGetter
+	backupLister          velerov1listers.BackupLister
+	podVolumeBackupLister velerov1listers.PodVolumeBackupLister
+	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter     persistence.ObjectBackupStoreGetter
+	copyBackup            func(ctx context.Context, src, dst persistence.BackupStore, name string, resticVolumeNamespaces []string, lock *velero.ObjectLock, log logrus.FieldLogger) error
+	clock                 clock.Clock
+}
+
+// NewBackupReplicationController constructs a new backupReplicationController.
+func NewBackupReplicationController(
+	namespace string,
+	kbClient client.Client,
+	backupClient velerov1client.BackupsGetter,
+	backupInformer velerov1informers.BackupInformer,
+	podVolumeBackupLister velerov1listers.PodVolumeBackupLister,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &backupReplicationController{
+		genericController:     newGenericController(BackupReplication, logger),
+		namespace:             namespace,
+		kbClient:              kbClient,
+		backupClient:          backupClient,
+		backupLister:          backupInformer.Lister(),
+		podVolumeBackupLister: podVolumeBackupLister,
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		copyBackup:        persistence.CopyBackup,
+		clock:             clock.RealClock{},
+	}
+
+	c.syncHandler = c.processBackup
+	c.resyncPeriod = backupReplicationResyncPeriod
+	c.resyncFunc = c.enqueueAllBackups
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		},
+	)
+
+	return c
+}
+
+// enqueueAllBackups enqueues all backups, so failed copies are retried and backups
+// are copied to the replication targets added to their storage locations.
+func (c *backupReplicationController) enqueueAllBackups() {
+	backups, err := c.backupLister.Backups(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backups")
+		return
+	}
+
+	for _, backup := range backups {
+		c.enqueue(backup)
+	}
+}
+
+func (c *backupReplicationController) processBackup(key string) error {
+	log := c.logger.WithField("backup", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	backup, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+	default:
+		return nil
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: ns,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debugf("Backup cannot be replicated because backup storage location %s does not exist", backup.Spec.StorageLocation)
+			return nil
+		}
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	var pending []string
+	now := c.clock.Now()
+	for _, target := range replicationTargets(backup, location) {
+		status := getReplicationStatus(backup, target)
+		if status == nil {
+			pending = append(pending, target)
+			continue
+		}
+
+		switch status.Phase {
+		case velerov1api.BackupReplicationPhaseCompleted:
+		case velerov1api.BackupReplicationPhaseFailed:
+			if status.CompletionTimestamp == nil || !now.Before(status.CompletionTimestamp.Add(BackupReplicationRetryInterval)) {
+				pending = append(pending, target)
+			}
+		default:
+			// a copy that is still in progress when the backup is processed was
+			// interrupted by a restart of the server, so it's started over.
+			pending = append(pending, target)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	sourceStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+
+	resticVolumeNamespaces, err := c.resticVolumeNamespaces(backup)
+	if err != nil {
+		return err
+	}
+
+	// work on a copy, since the backup is patched as the copies progress
+	backup = backup.DeepCopy()
+	for _, target := range pending {
+		targetLog := log.WithField("replicationTarget", target)
+
+		status := velerov1api.BackupReplicationStatus{
+			Location:       target,
+			Phase:          velerov1api.BackupReplicationPhaseInProgress,
+			StartTimestamp: &metav1.Time{Time: c.clock.Now()},
+		}
+		updated := backup.DeepCopy()
+		setReplicationStatus(updated, status)
+		if backup, err = patchBackup(backup, updated, c.backupClient); err != nil {
+			return err
+		}
+
+		targetLog.Info("Copying backup to replication target")
+		lock, err := c.copyToLocation(backup, sourceStore, target, resticVolumeNamespaces, pluginManager, targetLog)
+		if err != nil {
+			targetLog.WithError(err).Error("Error copying backup to replication target")
+			status.Phase = velerov1api.BackupReplicationPhaseFailed
+			status.Message = err.Error()
+		} else {
+			targetLog.Info("Backup copied to replication target")
+			status.Phase = velerov1api.BackupReplicationPhaseCompleted
+			status.Message = ""
+			status.ObjectLock = lock
+		}
+		status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+		updated = backup.DeepCopy()
+		setReplicationStatus(updated, status)
+		if backup, err = patchBackup(backup, updated, c.backupClient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyToLocation copies a backup to a replication target. It returns the lock set on
+// the files of the copy, if the target is immutable.
+func (c *backupReplicationController) copyToLocation(backup *velerov1api.Backup, sourceStore persistence.BackupStore, target string, resticVolumeNamespaces []string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) (*velerov1api.BackupObjectLock, error) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      target,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Errorf("backup storage location %s does not exist", target)
+		}
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", target)
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil, errors.Errorf("backup storage location %s is in read-only mode", target)
+	}
+
+	if err := storage.QuotaExceeded(location); err != nil {
+		return nil, err
+	}
+
+	targetStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting backup store for backup storage location %s", target)
+	}
+
+	lock := newBackupObjectLock(location, c.clock.Now())
+	return lock, c.copyBackup(context.Background(), sourceStore, targetStore, backup.Name, resticVolumeNamespaces, objectLock(lock), log)
+}
+
+// resticVolumeNamespaces returns the namespaces of the restic repositories holding
+// the backup's pod volume backups.
+func (c *backupReplicationController) resticVolumeNamespaces(backup *velerov1api.Backup) ([]string, error) {
+	snapshots, err := restic.GetSnapshotsInBackup(backup, c.podVolumeBackupLister)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting restic snapshots in backup")
+	}
+
+	namespaces := sets.NewString()
+	for _, snapshot := range snapshots {
+		namespaces.Insert(snapshot.VolumeNamespace)
+	}
+
+	return namespaces.List(), nil
+}
+
+// replicationTargets returns the names of the backup storage locations a backup is
+// copied to: its own replication targets and those of its storage location.
+func replicationTargets(backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation) []string {
+	targets := sets.NewString(backup.Spec.ReplicationTargets...)
+	if location != nil {
+		targets.Insert(location.Spec.ReplicationTargets...)
+	}
+	targets.Delete("", backup.Spec.StorageLocation)
+
+	return targets.List()
+}
+
+// getReplicationStatus returns the status of the copy of a backup in a replication
+// target, or nil if the backup hasn't been copied to it.
+func getReplicationStatus(backup *velerov1api.Backup, location string) *velerov1api.BackupReplicationStatus {
+	for i := range backup.Status.Replications {
+		if backup.Status.Replications[i].Location == location {
+			return &backup.Status.Replications[i]
+		}
+	}
+	return nil
+}
+
+func setReplicationStatus(backup *velerov1api.Backup, status velerov1api.BackupReplicationStatus) {
+	if existing := getReplicationStatus(backup, status.Location); existing != nil {
+		*existing = status
+		return
+	}
+	backup.Status.Replications = append(backup.Status.Replications, status)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupReplicationControllerProcessBackup(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	fullQuota := 1
+
+	locations := []runtime.Object{
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Provider("aws").Bucket("primary").ReplicationTargets("secondary").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Provider("aws").Bucket("secondary").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "tertiary").Provider("aws").Bucket("tertiary").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Provider("aws").Bucket("read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "full").Provider("aws").Bucket("full").Quota(&velerov1api.BackupStorageLocationQuota{Backups: &fullQuota}).Usage(1, 100).Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "immutable").Provider("aws").Bucket("immutable").Immutability(velerov1api.ObjectLockModeCompliance, 24*time.Hour, false).Result(),
+	}
+	immutableLock := &velerov1api.BackupObjectLock{
+		Mode:        velerov1api.ObjectLockModeCompliance,
+		RetainUntil: &metav1.Time{Time: now.Add(24 * time.Hour)},
+	}
+
+	completedBackup := func() *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").Phase(velerov1api.BackupPhaseCompleted)
+	}
+
+	tests := []struct {
+		name                 string
+		backup               *velerov1api.Backup
+		copyErrs             map[string]error
+		expectedCopies       []string
+		expectedLocks        map[string]*velero.ObjectLock
+		expectedReplications []velerov1api.BackupReplicationStatus
+	}{
+		{
+			name:   "backups that haven't completed aren't copied",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("primary").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		},
+		{
+			name:           "completed backups are copied to their targets and their location's targets",
+			backup:         completedBackup().ReplicationTargets("tertiary", "primary").Result(),
+			expectedCopies: []string{"secondary", "tertiary"},
+			expectedReplications: []velerov1api.BackupReplicationStatus{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+				{Location: "tertiary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+			},
+		},
+		{
+			name:           "failed copies are recorded",
+			backup:         completedBackup().ReplicationTargets("tertiary", "read-only", "missing", "full").Result(),
+			copyErrs:       map[string]error{"tertiary": errors.New("bucket is full")},
+			expectedCopies: []string{"secondary", "tertiary"},
+			expectedReplications: []velerov1api.BackupReplicationStatus{
+				{Location: "full", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup storage location full stores 1 backups, reaching its quota of 1 backups"},
+				{Location: "missing", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup storage location missing does not exist"},
+				{Location: "read-only", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "backup storage location read-only is in read-only mode"},
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+				{Location: "tertiary", Phase: velerov1api.BackupReplicationPhaseFailed, Message: "bucket is full"},
+			},
+		},
+		{
+			name:           "copies to immutable targets are locked",
+			backup:         completedBackup().ReplicationTargets("immutable").Result(),
+			expectedCopies: []string{"immutable", "secondary"},
+			expectedLocks: map[string]*velero.ObjectLock{
+				"immutable": {Mode: "Compliance", RetainUntil: now.Add(24 * time.Hour)},
+			},
+			expectedReplications: []velerov1api.BackupReplicationStatus{
+				{Location: "immutable", Phase: velerov1api.BackupReplicationPhaseCompleted, ObjectLock: immutableLock},
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+			},
+		},
+		{
+			name: "completed copies and recently failed copies aren't retried",
+			backup: completedBackup().ReplicationTargets("tertiary").Replications(
+				velerov1api.BackupReplicationStatus{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+				velerov1api.BackupReplicationStatus{Location: "tertiary", Phase: velerov1api.BackupReplicationPhaseFailed, CompletionTimestamp: &metav1.Time{Time: now.Add(-time.Minute)}},
+			).Result(),
+		},
+		{
+			name: "failed copies are retried after the retry interval, as are interrupted copies",
+			backup: completedBackup().ReplicationTargets("tertiary").Replications(
+				velerov1api.BackupReplicationStatus{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseInProgress},
+				velerov1api.BackupReplicationStatus{Location: "tertiary", Phase: velerov1api.BackupReplicationPhaseFailed, CompletionTimestamp: &metav1.Time{Time: now.Add(-BackupReplicationRetryInterval)}},
+			).Result(),
+			expectedCopies: []string{"secondary", "tertiary"},
+			expectedReplications: []velerov1api.BackupReplicationStatus{
+				{Location: "secondary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+				{Location: "tertiary", Phase: velerov1api.BackupReplicationPhaseCompleted},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStores    = map[string]*persistencemocks.BackupStore{}
+			)
+			for _, location := range locations {
+				backupStores[location.(*velerov1api.BackupStorageLocation).Name] = &persistencemocks.BackupStore{}
+			}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+				ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+				PodNamespace("ns-1").
+				SnapshotID("snapshot-1").
+				Result()
+			require.NoError(t, sharedInformers.Velero().V1().PodVolumeBackups().Informer().GetStore().Add(pvb))
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+
+			c := NewBackupReplicationController(
+				velerov1api.DefaultNamespace,
+				velerotest.NewFakeControllerRuntimeClient(t, locations...),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().PodVolumeBackups().Lister(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(backupStores),
+				velerotest.NewLogger(),
+			).(*backupReplicationController)
+			c.clock = clock.NewFakeClock(now)
+
+			var copies []string
+			c.copyBackup = func(_ context.Context, src, dst persistence.BackupStore, name string, resticVolumeNamespaces []string, lock *velero.ObjectLock, _ logrus.FieldLogger) error {
+				assert.Equal(t, backupStores["primary"], src)
+				assert.Equal(t, "backup-1", name)
+				assert.Equal(t, []string{"ns-1"}, resticVolumeNamespaces)
+
+				for location, store := range backupStores {
+					if store == dst {
+						assert.Equal(t, test.expectedLocks[location], lock)
+						copies = append(copies, location)
+						return test.copyErrs[location]
+					}
+				}
+				return errors.New("unexpected backup store")
+			}
+
+			require.NoError(t, c.processBackup("velero/backup-1"))
+			assert.Equal(t, test.expectedCopies, copies)
+
+			if test.expectedReplications == nil {
+				assert.Empty(t, client.Actions())
+				return
+			}
+
+			res, err := client.VeleroV1().Backups(velerov1api.DefaultNamespace).Get(context.TODO(), "backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			var replications []velerov1api.BackupReplicationStatus
+			for _, replication := range res.Status.Replications {
+				require.NotNil(t, replication.StartTimestamp)
+				require.NotNil(t, replication.CompletionTimestamp)
+				replication.StartTimestamp = nil
+				replication.CompletionTimestamp = nil
+				if replication.ObjectLock != nil {
+					// the time zone is lost in serialization
+					replication.ObjectLock.RetainUntil.Time = replication.ObjectLock.RetainUntil.UTC()
+				}
+				replications = append(replications, replication)
+			}
+			assert.ElementsMatch(t, test.expectedReplications, replications)
+		})
+	}
+}
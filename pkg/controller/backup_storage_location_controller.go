@@ -24,16 +24,20 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // BackupStorageLocationReconciler reconciles a BackupStorageLocation object
@@ -46,10 +50,27 @@ type BackupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	NewPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	BackupStoreGetter persistence.ObjectBackupStoreGetter
+	EventRecorder     record.EventRecorder
+	Metrics           *metrics.ServerMetrics
 
 	Log logrus.FieldLogger
 }
 
+// backupStorageUsageKey identifies the backups of a schedule, taken from a cluster, that are
+// stored in a backup storage location.
+type backupStorageUsageKey struct {
+	clusterHost string
+	location    string
+	schedule    string
+}
+
+// backupStorageUsage is the number of backups stored in a backup storage location, and the
+// space, in bytes, they take up.
+type backupStorageUsage struct {
+	backups int
+	bytes   int64
+}
+
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
 func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -74,6 +95,11 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		}
 	}
 
+	usage, err := r.backupStorageUsage(req.Namespace)
+	if err != nil {
+		log.WithError(err).Error("Error getting the usage of backup storage locations")
+	}
+
 	var unavailableErrors []string
 	var anyVerified bool
 	for i := range locationList.Items {
@@ -90,6 +116,10 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 			defaultFound = true
 		}
 
+		if usage != nil {
+			r.updateUsage(location, usage, log)
+		}
+
 		if !storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.DefaultBackupLocationInfo.ServerValidationFrequency, log) {
 			log.Debug("Validation not required, skipping...")
 			continue
@@ -113,13 +143,20 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 
 		log.Info("Validating backup storage location")
 		anyVerified = true
+		previousPhase := location.Status.Phase
 		if err := backupStore.IsValid(); err != nil {
 			log.Info("Backup storage location is invalid, marking as unavailable")
 			unavailableErrors = append(unavailableErrors, errors.Wrapf(err, "Backup storage location %q is unavailable", location.Name).Error())
 			location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
+			if previousPhase != location.Status.Phase {
+				r.EventRecorder.Eventf(location, corev1api.EventTypeWarning, kube.EventReasonUnavailable, "Backup storage location is unavailable: %v", err)
+			}
 		} else {
 			log.Info("Backup storage location valid, marking as available")
 			location.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
+			if previousPhase != location.Status.Phase {
+				r.EventRecorder.Event(location, corev1api.EventTypeNormal, kube.EventReasonAvailable, "Backup storage location is available")
+			}
 		}
 		location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
 
@@ -137,6 +174,96 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 	return ctrl.Result{Requeue: true}, nil
 }
 
+// backupStorageUsage sums up the usage of the backup storage locations in namespace by the
+// backups, and the copies of backups, stored in them, and records it in the server's metrics.
+func (r *BackupStorageLocationReconciler) backupStorageUsage(namespace string) (map[backupStorageUsageKey]backupStorageUsage, error) {
+	backups := new(velerov1api.BackupList)
+	if err := r.Client.List(r.Ctx, backups, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	usage := make(map[backupStorageUsageKey]backupStorageUsage)
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		if !isStoredBackup(backup) {
+			continue
+		}
+
+		// the copies of the backup in its replication targets take up as much space as
+		// the backup itself
+		locations := []string{backup.Spec.StorageLocation}
+		for _, replication := range backup.Status.Replications {
+			if replication.Phase == velerov1api.BackupReplicationPhaseCompleted {
+				locations = append(locations, replication.Location)
+			}
+		}
+
+		for _, location := range locations {
+			key := backupStorageUsageKey{
+				clusterHost: backup.Annotations[velerov1api.SourceClusterHostAnnotation],
+				location:    location,
+				schedule:    backup.Labels[velerov1api.ScheduleNameLabel],
+			}
+			u := usage[key]
+			u.backups++
+			if size := backup.Status.StorageSize; size != nil {
+				u.bytes += size.Total
+			}
+			usage[key] = u
+		}
+	}
+
+	if r.Metrics != nil {
+		r.Metrics.ResetBackupStorageLocationUsage()
+		for key, u := range usage {
+			r.Metrics.SetBackupStorageLocationUsage(key.clusterHost, key.location, key.schedule, u.backups, u.bytes)
+		}
+	}
+
+	return usage, nil
+}
+
+// updateUsage patches the backup count and total backup bytes of location if they've changed.
+func (r *BackupStorageLocationReconciler) updateUsage(location *velerov1api.BackupStorageLocation, usage map[backupStorageUsageKey]backupStorageUsage, log logrus.FieldLogger) {
+	var total backupStorageUsage
+	for key, u := range usage {
+		if key.location == location.Name {
+			total.backups += u.backups
+			total.bytes += u.bytes
+		}
+	}
+
+	if location.Status.BackupCount == total.backups && location.Status.TotalBackupBytes == total.bytes {
+		return
+	}
+
+	patchHelper, err := patch.NewHelper(location, r.Client)
+	if err != nil {
+		log.WithError(err).Error("Error getting a patch helper to update this resource")
+		return
+	}
+
+	location.Status.BackupCount = total.backups
+	location.Status.TotalBackupBytes = total.bytes
+	if err := patchHelper.Patch(r.Ctx, location); err != nil {
+		log.WithError(err).Error("Error updating backup storage location usage")
+	}
+}
+
+// isStoredBackup returns whether the files of backup have been uploaded to its storage location.
+func isStoredBackup(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseWaitingForPluginOperations,
+		velerov1api.BackupPhaseCompleted,
+		velerov1api.BackupPhasePartiallyFailed,
+		velerov1api.BackupPhaseFailed,
+		velerov1api.BackupPhaseDeleting:
+		return true
+	default:
+		return false
+	}
+}
+
 func (r *BackupStorageLocationReconciler) logReconciledPhase(defaultFound bool, locationList velerov1api.BackupStorageLocationList, errs []string) {
 	var availableBSLs []*velerov1api.BackupStorageLocation
 	var unAvailableBSLs []*velerov1api.BackupStorageLocation
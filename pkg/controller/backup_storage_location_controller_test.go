@@ -29,10 +29,12 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -65,6 +67,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 		var (
 			pluginManager = &pluginmocks.Manager{}
 			backupStores  = make(map[string]*persistencemocks.BackupStore)
+			eventRecorder = record.NewFakeRecorder(10)
 		)
 		pluginManager.On("CleanupClients").Return(nil)
 
@@ -88,6 +91,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			},
 			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     eventRecorder,
 			Log:               velerotest.NewLogger(),
 		}
 
@@ -107,6 +111,11 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			Expect(instance.Spec.Default).To(BeIdenticalTo(tests[i].expectedIsDefault))
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
 		}
+
+		// Each location's phase changed, so an event is recorded for each
+		Expect(eventRecorder.Events).To(HaveLen(2))
+		Expect(<-eventRecorder.Events).To(Equal("Normal Available Backup storage location is available"))
+		Expect(<-eventRecorder.Events).To(Equal("Warning Unavailable Backup storage location is unavailable: an error"))
 	})
 
 	It("Should successfully patch a backup storage location object spec default if the BSL is the default one", func() {
@@ -131,6 +140,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 		var (
 			pluginManager = &pluginmocks.Manager{}
 			backupStores  = make(map[string]*persistencemocks.BackupStore)
+			eventRecorder = record.NewFakeRecorder(10)
 		)
 		pluginManager.On("CleanupClients").Return(nil)
 
@@ -154,6 +164,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			},
 			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     eventRecorder,
 			Log:               velerotest.NewLogger(),
 		}
 
@@ -202,6 +213,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 		var (
 			pluginManager = &pluginmocks.Manager{}
 			backupStores  = make(map[string]*persistencemocks.BackupStore)
+			eventRecorder = record.NewFakeRecorder(10)
 		)
 		pluginManager.On("CleanupClients").Return(nil)
 
@@ -224,6 +236,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			},
 			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			EventRecorder:     eventRecorder,
 			Log:               velerotest.NewLogger(),
 		}
 
@@ -244,4 +257,64 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
 		}
 	})
+
+	It("Should patch a backup storage location object status with the number and size of the backups stored in it", func() {
+		locations := &velerov1api.BackupStorageLocationList{
+			Items: []velerov1api.BackupStorageLocation{
+				*builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(0).LastValidationTime(time.Now()).Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(0).LastValidationTime(time.Now()).Usage(5, 5000).Result(),
+			},
+		}
+		backups := &velerov1api.BackupList{
+			Items: []velerov1api.Backup{
+				*builder.ForBackup("ns-1", "backup-1").StorageLocation("location-1").Phase(velerov1api.BackupPhaseCompleted).StorageSize(100, 10, 1).Result(),
+				*builder.ForBackup("ns-1", "backup-2").StorageLocation("location-1").Phase(velerov1api.BackupPhasePartiallyFailed).StorageSize(200, 20, 2).Result(),
+				*builder.ForBackup("ns-1", "backup-3").StorageLocation("location-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+				*builder.ForBackup("ns-1", "backup-4").StorageLocation("location-2").Phase(velerov1api.BackupPhaseCompleted).Result(),
+				*builder.ForBackup("ns-1", "backup-5").StorageLocation("location-1").Phase(velerov1api.BackupPhaseCompleted).StorageSize(1000, 0, 0).Replications(
+					velerov1api.BackupReplicationStatus{Location: "location-2", Phase: velerov1api.BackupReplicationPhaseCompleted},
+					velerov1api.BackupReplicationStatus{Location: "location-3", Phase: velerov1api.BackupReplicationPhaseFailed},
+				).Result(),
+			},
+		}
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients").Return(nil)
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations, backups),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation:           "default",
+				ServerValidationFrequency: 0,
+			},
+			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			BackupStoreGetter: NewFakeObjectBackupStoreGetter(nil),
+			EventRecorder:     record.NewFakeRecorder(10),
+			Metrics:           metrics.NewServerMetrics("", ""),
+			Log:               velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		expected := map[string]velerov1api.BackupStorageLocationStatus{
+			"location-1": {BackupCount: 3, TotalBackupBytes: 1333},
+			"location-2": {BackupCount: 2, TotalBackupBytes: 1000},
+		}
+		for name, status := range expected {
+			instance := &velerov1api.BackupStorageLocation{}
+			err := r.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: "ns-1"}, instance)
+			Expect(err).To(BeNil())
+			Expect(instance.Status.BackupCount).To(Equal(status.BackupCount))
+			Expect(instance.Status.TotalBackupBytes).To(Equal(status.TotalBackupBytes))
+		}
+	})
 })
@@ -35,6 +35,7 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 
@@ -55,6 +56,7 @@ type backupSyncController struct {
 	defaultBackupSyncPeriod time.Duration
 	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter       persistence.ObjectBackupStoreGetter
+	metrics                 *metrics.ServerMetrics
 }
 
 func NewBackupSyncController(
@@ -69,6 +71,7 @@ func NewBackupSyncController(
 	defaultBackupLocation string,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -87,6 +90,7 @@ func NewBackupSyncController(
 		backupLister:            backupLister,
 		csiSnapshotClient:       csiSnapshotClient,
 		kubeClient:              kubeClient,
+		metrics:                 metrics,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
@@ -217,6 +221,18 @@ func (c *backupSyncController) run() {
 				continue
 			}
 
+			// a backup is uploaded before its plugin operations complete, and its metadata
+			// is updated by the cluster that created it, which is the only one that checks
+			// on them, once they do. It isn't synced until then, unless its metadata can't
+			// be updated because the location is immutable.
+			if backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations {
+				if location.Spec.Immutability == nil {
+					log.Info("Backup is waiting for plugin operations to complete, not syncing it yet")
+					continue
+				}
+				failPluginOperationsInProgress(backup)
+			}
+
 			backup.Namespace = c.namespace
 			backup.ResourceVersion = ""
 
@@ -229,6 +245,11 @@ func (c *backupSyncController) run() {
 			}
 			backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
 
+			// the replication targets are locations of the cluster that created the
+			// backup, and the backup may itself be one of its copies.
+			backup.Spec.ReplicationTargets = nil
+			backup.Status.Replications = nil
+
 			// attempt to create backup custom resource via API
 			backup, err = c.backupClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
 			switch {
@@ -347,6 +368,21 @@ func (c *backupSyncController) deleteOrphanedBackups(locationName string, backup
 			log.WithError(errors.WithStack(err)).Error("Error deleting orphaned backup from cluster")
 		} else {
 			log.Debug("Deleted orphaned backup from cluster")
+			c.metrics.DeleteBackupMetrics(backup.Name)
+		}
+	}
+}
+
+// failPluginOperationsInProgress marks the plugin operations of a synced backup that
+// are still in progress as failed, and the backup as partially failed, since only the
+// cluster that created the backup can check on them.
+func failPluginOperationsInProgress(backup *velerov1api.Backup) {
+	for i := range backup.Status.PluginOperations {
+		op := &backup.Status.PluginOperations[i]
+		if op.Phase == velerov1api.PluginOperationPhaseInProgress {
+			op.Phase = velerov1api.PluginOperationPhaseFailed
+			op.Error = "the backup was synced from its backup storage location before the operation completed"
 		}
 	}
+	backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	kuberrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -34,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -351,6 +353,7 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				"",
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeObjectBackupStoreGetter(backupStores),
+				metrics.NewServerMetrics("", ""),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -465,6 +468,75 @@ func TestBackupSyncControllerRun(t *testing.T) {
 	}
 }
 
+// TestBackupSyncControllerRunWaitingForPluginOperations verifies that a backup whose
+// plugin operations were in progress when it was uploaded isn't synced until its metadata
+// is updated, unless its location is immutable, in which case it's synced with its
+// operations failed.
+func TestBackupSyncControllerRunWaitingForPluginOperations(t *testing.T) {
+	var (
+		client        = fake.NewSimpleClientset()
+		fakeClient    = velerotest.NewFakeControllerRuntimeClient(t)
+		pluginManager = &pluginmocks.Manager{}
+		backupStores  = map[string]*persistencemocks.BackupStore{
+			"location-1": {},
+			"location-2": {},
+		}
+		locations = defaultLocationsList("velero")
+		operation = velerov1api.PluginOperation{
+			PluginName:  "velero.io/plugin",
+			OperationID: "operation-1",
+			Resource:    "pods",
+			Namespace:   "ns-1",
+			Name:        "pod-1",
+			Phase:       velerov1api.PluginOperationPhaseInProgress,
+		}
+	)
+
+	locations[1].Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeGovernance}
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		informers.NewSharedInformerFactory(client, 0).Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"velero",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(backupStores),
+		metrics.NewServerMetrics("", ""),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	pluginManager.On("CleanupClients").Return(nil)
+	for _, location := range locations {
+		require.NoError(t, fakeClient.Create(context.Background(), location))
+	}
+
+	backupStores["location-1"].On("ListBackups").Return([]string{"backup-1"}, nil)
+	backupStores["location-1"].On("GetBackupMetadata", "backup-1").Return(
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseWaitingForPluginOperations).PluginOperations(operation).Result(), nil)
+
+	backupStores["location-2"].On("ListBackups").Return([]string{"backup-2"}, nil)
+	backupStores["location-2"].On("GetBackupMetadata", "backup-2").Return(
+		builder.ForBackup("velero", "backup-2").Phase(velerov1api.BackupPhaseWaitingForPluginOperations).PluginOperations(operation).Result(), nil)
+	backupStores["location-2"].On("GetPodVolumeBackups", "backup-2").Return(nil, nil)
+
+	c.run()
+
+	_, err := client.VeleroV1().Backups("velero").Get(context.TODO(), "backup-1", metav1.GetOptions{})
+	assert.True(t, kuberrs.IsNotFound(err), "backup-1 shouldn't have been synced")
+
+	synced, err := client.VeleroV1().Backups("velero").Get(context.TODO(), "backup-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhasePartiallyFailed, synced.Status.Phase)
+	require.Len(t, synced.Status.PluginOperations, 1)
+	assert.Equal(t, velerov1api.PluginOperationPhaseFailed, synced.Status.PluginOperations[0].Phase)
+	assert.NotEmpty(t, synced.Status.PluginOperations[0].Error)
+}
+
 func TestDeleteOrphanedBackups(t *testing.T) {
 	baseBuilder := func(name string) *builder.BackupBuilder {
 		return builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default"))
@@ -572,6 +644,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				"",
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				metrics.NewServerMetrics("", ""),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -666,6 +739,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				"",
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				metrics.NewServerMetrics("", ""),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -19,14 +19,19 @@ package controller
 const (
 	Backup                = "backup"
 	BackupDeletion        = "backup-deletion"
+	BackupOperations      = "backup-operations"
+	BackupReplication     = "backup-replication"
 	BackupStorageLocation = "backup-storage-location"
 	BackupSync            = "backup-sync"
 	DownloadRequest       = "download-request"
 	GarbageCollection     = "gc"
+	Notification          = "notification"
+	Plugin                = "plugin"
 	PodVolumeBackup       = "pod-volume-backup"
 	PodVolumeRestore      = "pod-volume-restore"
 	ResticRepo            = "restic-repo"
 	Restore               = "restore"
+	RestoreOperations     = "restore-operations"
 	Schedule              = "schedule"
 	ServerStatusRequest   = "server-status-request"
 )
@@ -35,11 +40,16 @@ const (
 var DisableableControllers = []string{
 	Backup,
 	BackupDeletion,
+	BackupOperations,
+	BackupReplication,
 	BackupSync,
 	DownloadRequest,
 	GarbageCollection,
+	Notification,
+	Plugin,
 	ResticRepo,
 	Restore,
+	RestoreOperations,
 	Schedule,
 	ServerStatusRequest,
 }
@@ -113,7 +113,9 @@ func (r *DownloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		downloadRequest.Status.Expiration = &metav1.Time{Time: r.Clock.Now().Add(persistence.DownloadURLTTL)}
 
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreLog ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults {
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults ||
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreHookReport ||
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemReport {
 			restore := &velerov1api.Restore{}
 			if err := r.Client.Get(ctx, kbclient.ObjectKey{
 				Namespace: downloadRequest.Namespace,
@@ -134,6 +134,15 @@ func (c *gcController) processQueueItem(key string) error {
 
 	log.Info("Backup has expired")
 
+	if lockedUntil, locked := backupLockedUntil(backup, now); locked {
+		if lockedUntil.IsZero() {
+			log.Info("Backup cannot be garbage-collected because its files are under a legal hold")
+		} else {
+			log.Infof("Backup cannot be garbage-collected because its files are locked until %s", lockedUntil.Format(time.RFC3339))
+		}
+		return nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: ns,
@@ -184,6 +184,24 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result(),
 			expectDeletion: true,
 		},
+		{
+			name:           "expired backup with locked files is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).ObjectLock(velerov1api.ObjectLockModeCompliance, fakeClock.Now().Add(time.Hour), false).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name:           "expired backup with files under a legal hold is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).ObjectLock(velerov1api.ObjectLockModeGovernance, fakeClock.Now().Add(-time.Hour), true).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name:           "expired backup with an expired lock is deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).ObjectLock(velerov1api.ObjectLockModeCompliance, fakeClock.Now().Add(-time.Second), false).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
 		{
 			name:           "expired backup with no pending deletion requests is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// itemSnapshotterConfig returns the config of the named ItemSnapshotter. It's the data
+// of the ConfigMap in namespace labeled with velero.io/plugin-config and
+// <name>=ItemSnapshotter, or an empty map if there isn't one.
+func itemSnapshotterConfig(kbClient client.Client, namespace, name string) (map[string]string, error) {
+	selector, err := labels.Parse(fmt.Sprintf("velero.io/plugin-config,%s=%s", name, framework.PluginKindItemSnapshotter))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	list := &corev1api.ConfigMapList{}
+	if err := kbClient.List(context.Background(), list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch len(list.Items) {
+	case 0:
+		return map[string]string{}, nil
+	case 1:
+		if list.Items[0].Data == nil {
+			return map[string]string{}, nil
+		}
+		return list.Items[0].Data, nil
+	default:
+		var items []string
+		for _, item := range list.Items {
+			items = append(items, item.Name)
+		}
+		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", selector.String(), items)
+	}
+}
+
+// initItemSnapshotter gets the named ItemSnapshotter and initializes it with its config.
+func initItemSnapshotter(pluginManager clientmgmt.Manager, kbClient client.Client, namespace, name string) (velero.ItemSnapshotter, error) {
+	snapshotter, err := pluginManager.GetItemSnapshotter(name)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := itemSnapshotterConfig(kbClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := snapshotter.Init(config); err != nil {
+		return nil, errors.Wrapf(err, "error initializing item snapshotter %s", name)
+	}
+
+	return snapshotter, nil
+}
+
+// initItemSnapshotters gets every installed ItemSnapshotter, by name, and initializes
+// each with its config.
+func initItemSnapshotters(pluginManager clientmgmt.Manager, kbClient client.Client, namespace string) (map[string]velero.ItemSnapshotter, error) {
+	snapshotters, err := pluginManager.GetItemSnapshotters()
+	if err != nil {
+		return nil, err
+	}
+
+	for name, snapshotter := range snapshotters {
+		config, err := itemSnapshotterConfig(kbClient, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := snapshotter.Init(config); err != nil {
+			return nil, errors.Wrapf(err, "error initializing item snapshotter %s", name)
+		}
+	}
+
+	return snapshotters, nil
+}
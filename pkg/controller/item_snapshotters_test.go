@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestItemSnapshotterConfig(t *testing.T) {
+	pluginConfig := func(name string, labels ...string) *builder.ConfigMapBuilder {
+		return builder.ForConfigMap(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(append([]string{"velero.io/plugin-config", ""}, labels...)...))
+	}
+
+	tests := []struct {
+		name       string
+		configMaps []runtime.Object
+		want       map[string]string
+		wantErr    bool
+	}{
+		{
+			name: "no config map returns an empty config",
+			want: map[string]string{},
+		},
+		{
+			name: "config map for another plugin or kind is ignored",
+			configMaps: []runtime.Object{
+				pluginConfig("other-plugin", "velero.io/other", "ItemSnapshotter").Data("key", "other").Result(),
+				pluginConfig("other-kind", "velero.io/database", "RestoreItemAction").Data("key", "other").Result(),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "config map for the plugin returns its data",
+			configMaps: []runtime.Object{
+				pluginConfig("config", "velero.io/database", "ItemSnapshotter").Data("key", "value").Result(),
+			},
+			want: map[string]string{"key": "value"},
+		},
+		{
+			name: "more than one config map for the plugin is an error",
+			configMaps: []runtime.Object{
+				pluginConfig("config-1", "velero.io/database", "ItemSnapshotter").Data("key", "value").Result(),
+				pluginConfig("config-2", "velero.io/database", "ItemSnapshotter").Data("key", "value").Result(),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, tc.configMaps...)
+
+			config, err := itemSnapshotterConfig(kbClient, velerov1api.DefaultNamespace, "velero.io/database")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, config)
+		})
+	}
+}
+
+func TestInitItemSnapshotters(t *testing.T) {
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForConfigMap(velerov1api.DefaultNamespace, "config").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/database", "ItemSnapshotter")).
+			Data("key", "value").
+			Result(),
+	)
+
+	database := new(providermocks.ItemSnapshotter)
+	database.On("Init", map[string]string{"key": "value"}).Return(nil)
+	other := new(providermocks.ItemSnapshotter)
+	other.On("Init", map[string]string{}).Return(nil)
+
+	pluginManager := new(pluginmocks.Manager)
+	pluginManager.On("GetItemSnapshotters").Return(map[string]velero.ItemSnapshotter{
+		"velero.io/database": database,
+		"velero.io/other":    other,
+	}, nil)
+
+	snapshotters, err := initItemSnapshotters(pluginManager, kbClient, velerov1api.DefaultNamespace)
+	require.NoError(t, err)
+	assert.Len(t, snapshotters, 2)
+
+	database.AssertExpectations(t)
+	other.AssertExpectations(t)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/notification"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const notificationConfigSyncPeriod = time.Minute
+
+// notificationController watches Velero objects for lifecycle events and sends
+// them to the notification endpoints configured in the Velero namespace.
+type notificationController struct {
+	*genericController
+
+	namespace       string
+	configMapClient corev1client.ConfigMapsGetter
+	kbClient        client.Client
+	notifier        *notification.Notifier
+	srcClusterHost  string
+	destClusterHost string
+}
+
+// NewNotificationController constructs a new notificationController.
+func NewNotificationController(
+	namespace string,
+	backupInformer velerov1informers.BackupInformer,
+	restoreInformer velerov1informers.RestoreInformer,
+	scheduleInformer velerov1informers.ScheduleInformer,
+	backupLocationInformer velerov1informers.BackupStorageLocationInformer,
+	deleteBackupRequestInformer velerov1informers.DeleteBackupRequestInformer,
+	configMapClient corev1client.ConfigMapsGetter,
+	kbClient client.Client,
+	srcClusterHost string,
+	destClusterHost string,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &notificationController{
+		genericController: newGenericController(Notification, logger),
+		namespace:         namespace,
+		configMapClient:   configMapClient,
+		kbClient:          kbClient,
+		srcClusterHost:    srcClusterHost,
+		destClusterHost:   destClusterHost,
+	}
+	c.notifier = notification.NewNotifier(c.logger)
+
+	c.resyncFunc = c.syncEndpoints
+	c.resyncPeriod = notificationConfigSyncPeriod
+
+	backupInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{UpdateFunc: c.backupUpdated})
+	restoreInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{UpdateFunc: c.restoreUpdated})
+	scheduleInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{UpdateFunc: c.scheduleUpdated})
+	backupLocationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{UpdateFunc: c.backupLocationUpdated})
+	deleteBackupRequestInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{UpdateFunc: c.deleteBackupRequestUpdated})
+
+	return c
+}
+
+// Run starts the notifier's delivery workers and the periodic reload of the endpoint
+// configuration. It blocks until ctx is done.
+func (c *notificationController) Run(ctx context.Context, numWorkers int) error {
+	go c.notifier.Run(ctx, numWorkers)
+	return c.genericController.Run(ctx, numWorkers)
+}
+
+// syncEndpoints reloads the notification endpoints from the ConfigMaps in the Velero
+// namespace labeled with notification.ConfigLabel. Invalid endpoints are logged and skipped.
+func (c *notificationController) syncEndpoints() {
+	configMaps, err := c.configMapClient.ConfigMaps(c.namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: notification.ConfigLabel})
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing notification ConfigMaps")
+		return
+	}
+
+	var endpoints []*notification.Endpoint
+	for _, configMap := range configMaps.Items {
+		names := make([]string, 0, len(configMap.Data))
+		for name := range configMap.Data {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			endpointName := configMap.Name + "/" + name
+			log := c.logger.WithField("endpoint", endpointName)
+
+			config, err := notification.ParseEndpointConfig(configMap.Data[name])
+			if err != nil {
+				log.WithError(err).Error("Error parsing notification endpoint configuration, skipping it")
+				continue
+			}
+
+			var signingKey []byte
+			if config.SigningSecret != nil {
+				if signingKey, err = kube.GetSecretKey(c.kbClient, c.namespace, config.SigningSecret); err != nil {
+					log.WithError(err).Error("Error getting notification endpoint signing secret, skipping it")
+					continue
+				}
+			}
+
+			endpoint, err := notification.NewEndpoint(endpointName, config, signingKey)
+			if err != nil {
+				log.WithError(err).Error("Invalid notification endpoint configuration, skipping it")
+				continue
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	c.notifier.SetEndpoints(endpoints)
+}
+
+func (c *notificationController) backupUpdated(oldObj, newObj interface{}) {
+	oldBackup := oldObj.(*velerov1api.Backup)
+	backup := newObj.(*velerov1api.Backup)
+
+	if oldBackup.Status.Phase == backup.Status.Phase {
+		return
+	}
+
+	reason := notification.ReasonPhaseChanged
+	if backup.Status.Phase == velerov1api.BackupPhaseFailedValidation {
+		reason = notification.ReasonValidationFailed
+	}
+
+	c.notify(reason, "Backup", &backup.ObjectMeta, string(oldBackup.Status.Phase), string(backup.Status.Phase), c.srcClusterHost, backup.Status.ValidationErrors)
+}
+
+func (c *notificationController) restoreUpdated(oldObj, newObj interface{}) {
+	oldRestore := oldObj.(*velerov1api.Restore)
+	restore := newObj.(*velerov1api.Restore)
+
+	if oldRestore.Status.Phase == restore.Status.Phase {
+		return
+	}
+
+	reason := notification.ReasonPhaseChanged
+	if restore.Status.Phase == velerov1api.RestorePhaseFailedValidation {
+		reason = notification.ReasonValidationFailed
+	}
+
+	// copy the validation errors so the informer's object isn't modified
+	errs := append([]string(nil), restore.Status.ValidationErrors...)
+	if restore.Status.FailureReason != "" {
+		errs = append(errs, restore.Status.FailureReason)
+	}
+
+	c.notify(reason, "Restore", &restore.ObjectMeta, string(oldRestore.Status.Phase), string(restore.Status.Phase), c.destClusterHost, errs)
+}
+
+func (c *notificationController) scheduleUpdated(oldObj, newObj interface{}) {
+	oldSchedule := oldObj.(*velerov1api.Schedule)
+	schedule := newObj.(*velerov1api.Schedule)
+
+	if oldSchedule.Status.Phase == schedule.Status.Phase {
+		return
+	}
+
+	reason := notification.ReasonPhaseChanged
+	if schedule.Status.Phase == velerov1api.SchedulePhaseFailedValidation {
+		reason = notification.ReasonValidationFailed
+	}
+
+	c.notify(reason, "Schedule", &schedule.ObjectMeta, string(oldSchedule.Status.Phase), string(schedule.Status.Phase), c.srcClusterHost, schedule.Status.ValidationErrors)
+}
+
+func (c *notificationController) backupLocationUpdated(oldObj, newObj interface{}) {
+	oldLocation := oldObj.(*velerov1api.BackupStorageLocation)
+	location := newObj.(*velerov1api.BackupStorageLocation)
+
+	if oldLocation.Status.Phase == location.Status.Phase || location.Status.Phase != velerov1api.BackupStorageLocationPhaseUnavailable {
+		return
+	}
+
+	c.notify(notification.ReasonUnavailable, "BackupStorageLocation", &location.ObjectMeta, string(oldLocation.Status.Phase), string(location.Status.Phase), "", nil)
+}
+
+func (c *notificationController) deleteBackupRequestUpdated(oldObj, newObj interface{}) {
+	oldReq := oldObj.(*velerov1api.DeleteBackupRequest)
+	req := newObj.(*velerov1api.DeleteBackupRequest)
+
+	if oldReq.Status.Phase == req.Status.Phase || req.Status.Phase != velerov1api.DeleteBackupRequestPhaseProcessed || len(req.Status.Errors) == 0 {
+		return
+	}
+
+	c.notify(notification.ReasonDeletionFailed, "DeleteBackupRequest", &req.ObjectMeta, string(oldReq.Status.Phase), string(req.Status.Phase), c.srcClusterHost, req.Status.Errors)
+}
+
+func (c *notificationController) notify(reason notification.Reason, kind string, obj *metav1.ObjectMeta, previousPhase, phase, cluster string, errs []string) {
+	c.notifier.Notify(notification.Event{
+		Kind:          kind,
+		Reason:        reason,
+		Namespace:     obj.Namespace,
+		Name:          obj.Name,
+		Labels:        obj.Labels,
+		Phase:         phase,
+		PreviousPhase: previousPhase,
+		Cluster:       cluster,
+		Errors:        errs,
+	})
+}
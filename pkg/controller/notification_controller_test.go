@@ -0,0 +1,152 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/notification"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type notificationRequest struct {
+	signature string
+	event     notification.Event
+}
+
+func testDeleteBackupRequest(name string, phase velerov1api.DeleteBackupRequestPhase, errs ...string) *velerov1api.DeleteBackupRequest {
+	return &velerov1api.DeleteBackupRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: name},
+		Status:     velerov1api.DeleteBackupRequestStatus{Phase: phase, Errors: errs},
+	}
+}
+
+func TestNotificationControllerSendsEvents(t *testing.T) {
+	requests := make(chan notificationRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var event notification.Event
+		require.NoError(t, json.Unmarshal(body, &event))
+
+		assert.NoError(t, notification.Verify([]byte("key"), r.Header.Get(notification.SignatureHeader), r.Header.Get(notification.TimestampHeader), body, time.Now()))
+		requests <- notificationRequest{signature: r.Header.Get(notification.SignatureHeader), event: event}
+	}))
+	defer server.Close()
+
+	var (
+		sharedInformers = informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+		configMap       = builder.ForConfigMap("velero", "notifications").
+				ObjectMeta(builder.WithLabels(notification.ConfigLabel, "true")).
+				Data("failures", "url: "+server.URL+"\nphases: [Failed, FailedValidation, Unavailable, Processed]\nsigningSecret:\n  name: webhook\n  key: signing-key").
+				Result()
+		secret = builder.ForSecret("velero", "webhook").Data(map[string][]byte{"signing-key": []byte("key")}).Result()
+	)
+
+	c := NewNotificationController(
+		"velero",
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().Restores(),
+		sharedInformers.Velero().V1().Schedules(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().DeleteBackupRequests(),
+		kubefake.NewSimpleClientset(configMap).CoreV1(),
+		velerotest.NewFakeControllerRuntimeClient(t, secret),
+		"https://src:6443",
+		"https://dest:6443",
+		velerotest.NewLogger(),
+	).(*notificationController)
+
+	c.syncEndpoints()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.notifier.Run(ctx, 1)
+
+	// phase changes that don't match the endpoint's filters, or aren't phase changes
+	// at all, are not sent
+	c.backupUpdated(
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseNew).Result(),
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+	)
+	c.backupUpdated(
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseFailed).Result(),
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseFailed).Result(),
+	)
+	c.deleteBackupRequestUpdated(
+		testDeleteBackupRequest("delete-1", velerov1api.DeleteBackupRequestPhaseInProgress),
+		testDeleteBackupRequest("delete-1", velerov1api.DeleteBackupRequestPhaseProcessed),
+	)
+
+	c.backupUpdated(
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+		builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseFailed).Result(),
+	)
+	c.restoreUpdated(
+		builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseNew).Result(),
+		builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseFailedValidation).Result(),
+	)
+	c.backupLocationUpdated(
+		builder.ForBackupStorageLocation("velero", "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+		builder.ForBackupStorageLocation("velero", "default").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+	)
+	c.deleteBackupRequestUpdated(
+		testDeleteBackupRequest("delete-2", velerov1api.DeleteBackupRequestPhaseInProgress),
+		testDeleteBackupRequest("delete-2", velerov1api.DeleteBackupRequestPhaseProcessed, "error deleting backup"),
+	)
+
+	want := []notification.Event{
+		{Kind: "Backup", Reason: notification.ReasonPhaseChanged, Namespace: "velero", Name: "backup-1", PreviousPhase: "InProgress", Phase: "Failed", Cluster: "https://src:6443"},
+		{Kind: "Restore", Reason: notification.ReasonValidationFailed, Namespace: "velero", Name: "restore-1", PreviousPhase: "New", Phase: "FailedValidation", Cluster: "https://dest:6443"},
+		{Kind: "BackupStorageLocation", Reason: notification.ReasonUnavailable, Namespace: "velero", Name: "default", PreviousPhase: "Available", Phase: "Unavailable"},
+		{Kind: "DeleteBackupRequest", Reason: notification.ReasonDeletionFailed, Namespace: "velero", Name: "delete-2", PreviousPhase: "InProgress", Phase: "Processed", Cluster: "https://src:6443", Errors: []string{"error deleting backup"}},
+	}
+
+	for _, wantEvent := range want {
+		select {
+		case req := <-requests:
+			assert.NotEmpty(t, req.signature)
+			assert.False(t, req.event.Time.IsZero())
+			req.event.Time = time.Time{}
+			assert.Equal(t, wantEvent, req.event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s event", wantEvent.Kind)
+		}
+	}
+
+	select {
+	case req := <-requests:
+		t.Fatalf("unexpected event %v", req.event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/image"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// pluginImagesDir is the directory of the plugin directory that Plugins are
+	// installed in, each in a directory named after the Plugin.
+	pluginImagesDir = "images"
+
+	// pluginInstallRetryPeriod is how long to wait before retrying to install a
+	// Plugin that couldn't be installed.
+	pluginInstallRetryPeriod = time.Minute
+)
+
+// PluginImagePuller pulls the binaries of plugin images.
+type PluginImagePuller interface {
+	// Pull unpacks the files in the /plugins directory of the image with digest into
+	// dir, and returns their paths relative to dir. The credentials for the image's
+	// registry are resolved with keychain.
+	Pull(ctx context.Context, image, digest string, keychain authn.Keychain, dir string) ([]string, error)
+}
+
+// PluginDiscoverer discovers the plugins in the plugin directory.
+type PluginDiscoverer interface {
+	// DiscoverPlugins discovers all available plugins.
+	DiscoverPlugins() error
+}
+
+// PluginReconciler installs the binaries of Plugins into the plugin directory of the
+// Velero server, and re-discovers the plugins when they're installed or removed.
+type PluginReconciler struct {
+	Client kbclient.Client
+	// Namespace is the namespace of the Velero server. Plugins in other namespaces
+	// belong to other Velero servers and are ignored.
+	Namespace string
+	PluginDir string
+	Puller    PluginImagePuller
+	Registry  PluginDiscoverer
+	Clock     clock.Clock
+
+	Log logrus.FieldLogger
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=plugins,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=plugins/status,verbs=get;update;patch
+func (r *PluginReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithFields(logrus.Fields{
+		"controller": Plugin,
+		"plugin":     req.NamespacedName,
+	})
+
+	if req.Namespace != r.Namespace {
+		return ctrl.Result{}, nil
+	}
+
+	log.Debug("Getting Plugin")
+	plugin := &velerov1api.Plugin{}
+	if err := r.Client.Get(ctx, req.NamespacedName, plugin); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find Plugin, removing its binaries")
+			return ctrl.Result{}, r.uninstall(req.Name, log)
+		}
+
+		log.WithError(err).Error("Error getting Plugin")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	// the plugin directory doesn't outlive the pod, so a Plugin that's installed
+	// has to be installed again when the Velero server restarts
+	installed, err := dirExists(r.installDir(plugin.Name))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if installed && plugin.Status.Phase == velerov1api.PluginPhaseInstalled && plugin.Status.InstalledDigest == plugin.Spec.Digest {
+		log.Debug("Plugin is already installed")
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(plugin, r.Client)
+	if err != nil {
+		log.WithError(err).Error("Error getting a patch helper to update this resource")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	log.WithFields(logrus.Fields{
+		"image":  plugin.Spec.Image,
+		"digest": plugin.Spec.Digest,
+	}).Info("Installing plugin")
+
+	result := ctrl.Result{}
+	binaries, err := r.install(ctx, plugin, log)
+	if err != nil {
+		log.WithError(err).Error("Error installing plugin")
+		// the binaries of the previously installed digest, if any, are still installed
+		plugin.Status.Phase = velerov1api.PluginPhaseFailed
+		plugin.Status.Message = err.Error()
+		result.RequeueAfter = pluginInstallRetryPeriod
+	} else {
+		plugin.Status.Phase = velerov1api.PluginPhaseInstalled
+		plugin.Status.Message = ""
+		plugin.Status.InstalledDigest = plugin.Spec.Digest
+		plugin.Status.InstalledTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		plugin.Status.Binaries = binaries
+	}
+
+	if err := patchHelper.Patch(ctx, plugin); err != nil {
+		log.WithError(err).Error("Error updating Plugin status")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+// install pulls the binaries of plugin into its install directory, replacing the ones
+// that are there, and re-discovers the plugins. If the new binaries' plugins can't be
+// discovered, the previous binaries are put back.
+func (r *PluginReconciler) install(ctx context.Context, plugin *velerov1api.Plugin, log logrus.FieldLogger) ([]string, error) {
+	keychain, err := r.keychain(plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	imagesDir := filepath.Join(r.PluginDir, pluginImagesDir)
+	if err := os.MkdirAll(imagesDir, 0755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// the registry skips hidden directories, so the binaries aren't discovered
+	// until all of them are pulled
+	pullDir, err := ioutil.TempDir(imagesDir, ".pull-"+plugin.Name+"-")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer os.RemoveAll(pullDir)
+
+	binaries, err := r.Puller.Pull(ctx, plugin.Spec.Image, plugin.Spec.Digest, keychain, pullDir)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := r.installDir(plugin.Name)
+	previousDir := pullDir + ".previous"
+	hasPrevious, err := dirExists(dir)
+	if err != nil {
+		return nil, err
+	}
+	if hasPrevious {
+		if err := os.Rename(dir, previousDir); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		defer os.RemoveAll(previousDir)
+	}
+	if err := os.Rename(pullDir, dir); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := r.Registry.DiscoverPlugins(); err != nil {
+		if rollbackErr := rollback(dir, previousDir, hasPrevious); rollbackErr != nil {
+			log.WithError(rollbackErr).Error("Error putting back the previous binaries of the plugin")
+		} else if discoverErr := r.Registry.DiscoverPlugins(); discoverErr != nil {
+			log.WithError(discoverErr).Error("Error discovering plugins")
+		}
+		return nil, errors.WithMessage(err, "error discovering the plugins of the image")
+	}
+
+	return binaries, nil
+}
+
+// uninstall removes the binaries of the Plugin with name, if it was installed, and
+// re-discovers the plugins.
+func (r *PluginReconciler) uninstall(name string, log logrus.FieldLogger) error {
+	dir := r.installDir(name)
+	installed, err := dirExists(dir)
+	if err != nil || !installed {
+		return err
+	}
+
+	log.Info("Removing plugin")
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithMessage(r.Registry.DiscoverPlugins(), "error discovering plugins")
+}
+
+// keychain returns the keychain of plugin's image pull secret, or an anonymous one if
+// it has none.
+func (r *PluginReconciler) keychain(plugin *velerov1api.Plugin) (authn.Keychain, error) {
+	if plugin.Spec.ImagePullSecret == "" {
+		return image.Anonymous, nil
+	}
+
+	secret, err := kube.GetSecret(r.Client, plugin.Namespace, plugin.Spec.ImagePullSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting image pull secret %s", plugin.Spec.ImagePullSecret)
+	}
+
+	return image.PullSecretKeychain(secret.Data[corev1api.DockerConfigJsonKey])
+}
+
+// installDir returns the directory that the binaries of the Plugin with name are
+// installed in.
+func (r *PluginReconciler) installDir(name string) string {
+	return filepath.Join(r.PluginDir, pluginImagesDir, name)
+}
+
+// rollback replaces the binaries in dir with the ones in previousDir, if there were any.
+func rollback(dir, previousDir string, hasPrevious bool) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.WithStack(err)
+	}
+	if !hasPrevious {
+		return nil
+	}
+	return errors.WithStack(os.Rename(previousDir, dir))
+}
+
+func dirExists(dir string) (bool, error) {
+	info, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return info.IsDir(), nil
+}
+
+func (r *PluginReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Plugin{}).
+		Complete(r)
+}
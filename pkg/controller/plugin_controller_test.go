@@ -0,0 +1,306 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const (
+	pluginDigest1 = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	pluginDigest2 = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+// fakePluginImagePuller writes a binary named after the digest it pulls.
+type fakePluginImagePuller struct {
+	err      error
+	keychain authn.Keychain
+}
+
+func (p *fakePluginImagePuller) Pull(ctx context.Context, image, digest string, keychain authn.Keychain, dir string) ([]string, error) {
+	p.keychain = keychain
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	name := "velero-plugin-" + digest[len("sha256:"):len("sha256:")+4]
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(digest), 0755); err != nil {
+		return nil, err
+	}
+	return []string{name}, nil
+}
+
+// fakePluginDiscoverer records the binaries in the plugin directory each time
+// plugins are discovered.
+type fakePluginDiscoverer struct {
+	dir         string
+	errs        []error
+	discoveries [][]string
+}
+
+func (d *fakePluginDiscoverer) DiscoverPlugins() error {
+	var binaries []string
+	filepath.Walk(d.dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			rel, _ := filepath.Rel(d.dir, path)
+			binaries = append(binaries, rel)
+		}
+		return nil
+	})
+	d.discoveries = append(d.discoveries, binaries)
+
+	if len(d.errs) == 0 {
+		return nil
+	}
+	err := d.errs[0]
+	d.errs = d.errs[1:]
+	return err
+}
+
+func TestPluginReconcile(t *testing.T) {
+	require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	pullSecret := builder.ForSecret(velerov1api.DefaultNamespace, "pull-secret").
+		Data(map[string][]byte{corev1api.DockerConfigJsonKey: []byte(`{"auths": {"gcr.io": {"username": "user", "password": "pass"}}}`)}).
+		Result()
+
+	tests := []struct {
+		name             string
+		plugin           *velerov1api.Plugin
+		installed        map[string]string
+		pullErr          error
+		discoverErrs     []error
+		wantPhase        velerov1api.PluginPhase
+		wantDigest       string
+		wantBinaries     []string
+		wantInstalled    map[string]string
+		wantCredentials  *authn.AuthConfig
+		wantDiscoveries  int
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:            "a new plugin is installed and discovered",
+			plugin:          builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest1).Result(),
+			wantPhase:       velerov1api.PluginPhaseInstalled,
+			wantDigest:      pluginDigest1,
+			wantBinaries:    []string{"velero-plugin-1111"},
+			wantInstalled:   map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantDiscoveries: 1,
+		},
+		{
+			name:            "the image pull secret's credentials are used",
+			plugin:          builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest1).ImagePullSecret("pull-secret").Result(),
+			wantPhase:       velerov1api.PluginPhaseInstalled,
+			wantDigest:      pluginDigest1,
+			wantBinaries:    []string{"velero-plugin-1111"},
+			wantInstalled:   map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantCredentials: &authn.AuthConfig{Username: "user", Password: "pass"},
+			wantDiscoveries: 1,
+		},
+		{
+			name: "an installed plugin isn't installed again",
+			plugin: builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest1).
+				Phase(velerov1api.PluginPhaseInstalled).InstalledDigest(pluginDigest1).Result(),
+			installed:     map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantPhase:     velerov1api.PluginPhaseInstalled,
+			wantDigest:    pluginDigest1,
+			wantInstalled: map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+		},
+		{
+			name: "an installed plugin whose binaries are gone is installed again",
+			plugin: builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest1).
+				Phase(velerov1api.PluginPhaseInstalled).InstalledDigest(pluginDigest1).Result(),
+			wantPhase:       velerov1api.PluginPhaseInstalled,
+			wantDigest:      pluginDigest1,
+			wantBinaries:    []string{"velero-plugin-1111"},
+			wantInstalled:   map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantDiscoveries: 1,
+		},
+		{
+			name: "a new digest replaces the binaries of the previous one",
+			plugin: builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest2).
+				Phase(velerov1api.PluginPhaseInstalled).InstalledDigest(pluginDigest1).Result(),
+			installed:       map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantPhase:       velerov1api.PluginPhaseInstalled,
+			wantDigest:      pluginDigest2,
+			wantBinaries:    []string{"velero-plugin-2222"},
+			wantInstalled:   map[string]string{"images/my-plugin/velero-plugin-2222": pluginDigest2},
+			wantDiscoveries: 1,
+		},
+		{
+			name:             "a plugin that can't be pulled fails and is retried",
+			plugin:           builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest1).Result(),
+			pullErr:          errors.New("pull failed"),
+			wantPhase:        velerov1api.PluginPhaseFailed,
+			wantInstalled:    map[string]string{},
+			wantRequeueAfter: pluginInstallRetryPeriod,
+		},
+		{
+			name: "the previous binaries are put back when the new ones can't be discovered",
+			plugin: builder.ForPlugin(velerov1api.DefaultNamespace, "my-plugin").Image("gcr.io/velero/my-plugin", pluginDigest2).
+				Phase(velerov1api.PluginPhaseInstalled).InstalledDigest(pluginDigest1).Result(),
+			installed:        map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			discoverErrs:     []error{errors.New("duplicate plugin")},
+			wantPhase:        velerov1api.PluginPhaseFailed,
+			wantDigest:       pluginDigest1,
+			wantInstalled:    map[string]string{"images/my-plugin/velero-plugin-1111": pluginDigest1},
+			wantDiscoveries:  2,
+			wantRequeueAfter: pluginInstallRetryPeriod,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginDir, err := ioutil.TempDir("", "")
+			require.NoError(t, err)
+			defer os.RemoveAll(pluginDir)
+
+			for path, content := range tc.installed {
+				require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(pluginDir, path)), 0755))
+				require.NoError(t, ioutil.WriteFile(filepath.Join(pluginDir, path), []byte(content), 0755))
+			}
+
+			puller := &fakePluginImagePuller{err: tc.pullErr}
+			discoverer := &fakePluginDiscoverer{dir: pluginDir, errs: tc.discoverErrs}
+			r := PluginReconciler{
+				Client:    fake.NewFakeClientWithScheme(scheme.Scheme, tc.plugin, pullSecret),
+				Namespace: velerov1api.DefaultNamespace,
+				PluginDir: pluginDir,
+				Puller:    puller,
+				Registry:  discoverer,
+				Clock:     clock.NewFakeClock(now),
+				Log:       velerotest.NewLogger(),
+			}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: tc.plugin.Namespace, Name: tc.plugin.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRequeueAfter, result.RequeueAfter)
+
+			plugin := &velerov1api.Plugin{}
+			require.NoError(t, r.Client.Get(context.Background(), kbclient.ObjectKey{Namespace: tc.plugin.Namespace, Name: tc.plugin.Name}, plugin))
+			assert.Equal(t, tc.wantPhase, plugin.Status.Phase)
+			assert.Equal(t, tc.wantDigest, plugin.Status.InstalledDigest)
+			if tc.wantBinaries != nil {
+				assert.Equal(t, tc.wantBinaries, plugin.Status.Binaries)
+				assert.True(t, now.Equal(plugin.Status.InstalledTimestamp.Time))
+			}
+			if tc.wantPhase == velerov1api.PluginPhaseFailed {
+				assert.NotEmpty(t, plugin.Status.Message)
+			}
+
+			if puller.keychain != nil {
+				assert.Equal(t, tc.wantCredentials, resolveCredentials(t, puller.keychain, "gcr.io/velero/my-plugin"))
+			}
+			assert.Len(t, discoverer.discoveries, tc.wantDiscoveries)
+			assert.Equal(t, tc.wantInstalled, readPluginDir(t, pluginDir))
+		})
+	}
+}
+
+// resolveCredentials returns the credentials that keychain has for repository, or nil
+// if it has none.
+func resolveCredentials(t *testing.T, keychain authn.Keychain, repository string) *authn.AuthConfig {
+	repo, err := name.NewRepository(repository)
+	require.NoError(t, err)
+	authenticator, err := keychain.Resolve(repo)
+	require.NoError(t, err)
+	if authenticator == authn.Anonymous {
+		return nil
+	}
+	authConfig, err := authenticator.Authorization()
+	require.NoError(t, err)
+	return authConfig
+}
+
+func TestPluginReconcileRemovesDeletedPlugins(t *testing.T) {
+	require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+	pluginDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(pluginDir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(pluginDir, "images", "my-plugin"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pluginDir, "images", "my-plugin", "velero-plugin-1111"), []byte(pluginDigest1), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pluginDir, "velero-plugin-for-aws"), []byte("aws"), 0755))
+
+	discoverer := &fakePluginDiscoverer{dir: pluginDir}
+	r := PluginReconciler{
+		Client:    fake.NewFakeClientWithScheme(scheme.Scheme),
+		Namespace: velerov1api.DefaultNamespace,
+		PluginDir: pluginDir,
+		Puller:    &fakePluginImagePuller{},
+		Registry:  discoverer,
+		Clock:     clock.NewFakeClock(time.Now()),
+		Log:       velerotest.NewLogger(),
+	}
+
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "my-plugin"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"velero-plugin-for-aws": "aws"}, readPluginDir(t, pluginDir))
+	assert.Equal(t, [][]string{{"velero-plugin-for-aws"}}, discoverer.discoveries)
+
+	// a Plugin of another Velero server is ignored
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "other", Name: "velero-plugin-for-aws"}})
+	require.NoError(t, err)
+	assert.Len(t, discoverer.discoveries, 1)
+}
+
+// readPluginDir returns the content of each file in dir, by its path relative to dir.
+func readPluginDir(t *testing.T, dir string) map[string]string {
+	files := map[string]string{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	}))
+	return files
+}
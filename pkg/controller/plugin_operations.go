@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	pluginOperationsResyncPeriod = 10 * time.Second
+
+	// DefaultItemOperationTimeout is how long the asynchronous operations started
+	// by item action plugins can run before they're canceled.
+	DefaultItemOperationTimeout = 4 * time.Hour
+)
+
+// pluginOperationHandler knows how to check on and cancel the operations started
+// by a single plugin.
+type pluginOperationHandler interface {
+	Progress(operationID string) (velero.OperationProgress, error)
+	Cancel(operationID string) error
+}
+
+// updatePluginOperations checks the progress of every operation that's still in progress,
+// canceling the ones that have been running for longer than timeout. getHandler returns
+// the handler for the plugin that started an operation. It returns whether any operation
+// was updated.
+func updatePluginOperations(
+	operations []velerov1api.PluginOperation,
+	getHandler func(op *velerov1api.PluginOperation) (pluginOperationHandler, error),
+	timeout time.Duration,
+	now time.Time,
+	log logrus.FieldLogger,
+) bool {
+	updated := false
+	for i := range operations {
+		op := &operations[i]
+		if op.Phase != velerov1api.PluginOperationPhaseInProgress {
+			continue
+		}
+		updated = true
+
+		opLog := log.WithFields(logrus.Fields{"plugin": op.PluginName, "operationID": op.OperationID})
+		op.Updated = &metav1.Time{Time: now}
+
+		handler, err := getHandler(op)
+		if err != nil {
+			opLog.WithError(err).Error("Error getting the plugin that started the operation")
+			op.Phase = velerov1api.PluginOperationPhaseFailed
+			op.Error = err.Error()
+			continue
+		}
+
+		if op.Created != nil && timeout > 0 && !now.Before(op.Created.Add(timeout)) {
+			opLog.Warn("Canceling the operation because it timed out")
+			if err := handler.Cancel(op.OperationID); err != nil {
+				opLog.WithError(err).Warn("Error canceling the operation")
+			}
+			op.Phase = velerov1api.PluginOperationPhaseCanceled
+			op.Error = fmt.Sprintf("operation timed out after %s", timeout)
+			continue
+		}
+
+		progress, err := handler.Progress(op.OperationID)
+		if err != nil {
+			opLog.WithError(err).Error("Error getting the progress of the operation")
+			op.Phase = velerov1api.PluginOperationPhaseFailed
+			op.Error = err.Error()
+			continue
+		}
+
+		op.NCompleted = progress.NCompleted
+		op.NTotal = progress.NTotal
+		op.OperationUnits = progress.OperationUnits
+		op.Description = progress.Description
+
+		switch {
+		case progress.Err != "":
+			opLog.Errorf("The operation failed: %s", progress.Err)
+			op.Phase = velerov1api.PluginOperationPhaseFailed
+			op.Error = progress.Err
+		case progress.Completed:
+			opLog.Info("The operation completed")
+			op.Phase = velerov1api.PluginOperationPhaseCompleted
+		}
+	}
+
+	return updated
+}
+
+// pluginOperationsFailed returns whether any of the operations failed or was canceled.
+func pluginOperationsFailed(operations []velerov1api.PluginOperation) bool {
+	for _, op := range operations {
+		if op.Phase == velerov1api.PluginOperationPhaseFailed || op.Phase == velerov1api.PluginOperationPhaseCanceled {
+			return true
+		}
+	}
+
+	return false
+}
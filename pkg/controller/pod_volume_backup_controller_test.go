@@ -157,7 +157,7 @@ func TestPVBHandler(t *testing.T) {
 			c := &podVolumeBackupController{
 				genericController: newGenericController(PodVolumeBackup, velerotest.NewLogger()),
 				nodeName:          controllerNode,
-				metrics:           metrics.NewResticServerMetrics(),
+				metrics:           metrics.NewResticServerMetrics(""),
 			}
 
 			c.pvbHandler(test.obj)
@@ -26,18 +26,24 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
@@ -47,7 +53,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -91,6 +99,7 @@ type restoreController struct {
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
 	clock                  clock.Clock
+	eventRecorder          record.EventRecorder
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
@@ -111,6 +120,7 @@ func NewRestoreController(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	eventRecorder record.EventRecorder,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController(Restore, logger),
@@ -126,6 +136,7 @@ func NewRestoreController(
 		metrics:                metrics,
 		logFormat:              logFormat,
 		clock:                  &clock.RealClock{},
+		eventRecorder:          eventRecorder,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
@@ -160,6 +171,16 @@ func NewRestoreController(
 				}
 				c.queue.Add(key)
 			},
+			DeleteFunc: func(obj interface{}) {
+				// restores are deleted along with their backup, or on their own, and
+				// their per-restore metrics go with them
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if restore, ok := obj.(*api.Restore); ok {
+					c.metrics.DeleteRestoreMetrics(restore.Name)
+				}
+			},
 		},
 	)
 
@@ -252,14 +273,23 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	restore = updatedRestore.DeepCopy()
 
 	if restore.Status.Phase == api.RestorePhaseFailedValidation {
+		c.eventRecorder.Eventf(restore, corev1api.EventTypeWarning, kubeutil.EventReasonFailedValidation,
+			"Restore failed validation: %s", strings.Join(restore.Status.ValidationErrors, "; "))
 		return nil
 	}
 
+	c.eventRecorder.Event(restore, corev1api.EventTypeNormal, kubeutil.EventReasonPhaseChanged, "Restore is in progress")
+
 	if err := c.runValidatedRestore(restore, info); err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
 		c.metrics.RegisterRestoreFailed(backupScheduleName)
+	} else if api.PluginOperationsInProgress(restore.Status.PluginOperations) {
+		// the restore operations controller finishes the restore, and records its
+		// metrics, once every asynchronous operation started by a plugin is done.
+		c.logger.Debug("Restore is waiting for plugin operations")
+		restore.Status.Phase = api.RestorePhaseWaitingForPluginOperations
 	} else if restore.Status.Errors > 0 {
 		c.logger.Debug("Restore partially failed")
 		restore.Status.Phase = api.RestorePhasePartiallyFailed
@@ -270,12 +300,31 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		c.metrics.RegisterRestoreSuccess(backupScheduleName)
 	}
 
-	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if restore.Status.Progress != nil {
+		c.metrics.SetRestoreItemsTotal(backupScheduleName, restore.Name, restore.Status.Progress.ItemsRestored)
+	}
+
+	if restore.Status.Phase != api.RestorePhaseWaitingForPluginOperations {
+		restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	}
 	c.logger.Debug("Updating restore's final status")
 	if _, err = patchRestore(original, restore, c.restoreClient); err != nil {
 		c.logger.WithError(errors.WithStack(err)).Info("Error updating restore's final status")
 	}
 
+	if restore.Status.Phase == api.RestorePhaseWaitingForPluginOperations {
+		c.eventRecorder.Eventf(restore, corev1api.EventTypeNormal, kubeutil.EventReasonPhaseChanged,
+			"Restore is waiting for plugin operations to complete, with %d error(s) and %d warning(s) so far", restore.Status.Errors, restore.Status.Warnings)
+		return nil
+	}
+
+	eventType := corev1api.EventTypeNormal
+	if restore.Status.Phase != api.RestorePhaseCompleted {
+		eventType = corev1api.EventTypeWarning
+	}
+	c.eventRecorder.Eventf(restore, eventType, kubeutil.EventReasonPhaseChanged,
+		"Restore %s with %d error(s) and %d warning(s)", restore.Status.Phase, restore.Status.Errors, restore.Status.Warnings)
+
 	return nil
 }
 
@@ -342,6 +391,10 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		}
 	}
 
+	if restore.Spec.RetryOf != "" && !c.validateRetry(restore) {
+		return backupInfo{}
+	}
+
 	info, err := c.fetchBackupInfo(restore.Spec.BackupName, pluginManager)
 	if err != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving backup: %v", err))
@@ -353,9 +406,87 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	if restore.Spec.RetryOf != "" && !validateRetriedErrors(restore, info.backupStore) {
+		return backupInfo{}
+	}
+
 	return info
 }
 
+// validateRetry validates that the restore whose failed items the restore retries is a
+// PartiallyFailed restore of the same backup, adding a validation error to the restore if
+// it isn't.
+func (c *restoreController) validateRetry(restore *api.Restore) bool {
+	retried, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Spec.RetryOf)
+	if err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving restore to retry: %v", err))
+		return false
+	}
+
+	if retried.Status.Phase != api.RestorePhasePartiallyFailed {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+			fmt.Sprintf("Restore to retry %s is %s, only PartiallyFailed restores can be retried", retried.Name, retried.Status.Phase))
+		return false
+	}
+
+	if retried.Spec.BackupName != restore.Spec.BackupName {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+			fmt.Sprintf("Restore to retry %s is of backup %s, not %s", retried.Name, retried.Spec.BackupName, restore.Spec.BackupName))
+		return false
+	}
+
+	return true
+}
+
+// validateRetriedErrors validates that the restore whose failed items the restore retries has
+// failed items, and that all its errors are about them, adding a validation error to the restore
+// if it doesn't. Errors that aren't recorded against an item, such as failed restic restores of
+// pod volumes or errors about a whole namespace, wouldn't be retried.
+func validateRetriedErrors(restore *api.Restore, backupStore persistence.BackupStore) bool {
+	items, err := getItemReport(restore.Spec.RetryOf, backupStore)
+	if err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving item report of restore to retry: %v", err))
+		return false
+	}
+	results, err := getResults(restore.Spec.RetryOf, backupStore)
+	if err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving results of restore to retry: %v", err))
+		return false
+	}
+
+	failed := pkgrestore.FilterItemResults(items, pkgrestore.ItemStatusFailed)
+	if len(failed) == 0 {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+			fmt.Sprintf("Restore to retry %s has no failed items to retry", restore.Spec.RetryOf))
+		return false
+	}
+
+	reasons := sets.NewString()
+	for _, item := range failed {
+		reasons.Insert(item.Reason)
+	}
+	errs := results["errors"]
+	allErrs := append(append([]string{}, errs.Velero...), errs.Cluster...)
+	for _, nsErrs := range errs.Namespaces {
+		allErrs = append(allErrs, nsErrs...)
+	}
+	var unretried []string
+	for _, err := range allErrs {
+		if !reasons.Has(err) {
+			unretried = append(unretried, err)
+		}
+	}
+	if len(unretried) > 0 {
+		sort.Strings(unretried)
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+			fmt.Sprintf("Restore to retry %s has %d error(s) that aren't about a failed item, so retrying its failed items wouldn't retry them: %s",
+				restore.Spec.RetryOf, len(unretried), strings.Join(unretried, "; ")))
+		return false
+	}
+
+	return true
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
 func backupXorScheduleProvided(restore *api.Restore) bool {
@@ -427,7 +558,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) (err error) {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
@@ -436,10 +567,19 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	}
 	defer restoreLog.closeAndRemove(c.logger)
 
+	ctx, span := tracing.Start(context.Background(), "Restore",
+		attribute.String("velero.restore.namespace", restore.Namespace),
+		attribute.String("velero.restore.name", restore.Name),
+		attribute.String("velero.backup.name", restore.Spec.BackupName),
+		attribute.String("velero.cluster", c.restorer.DestClusterHost()),
+	)
+	defer func() { tracing.End(span, err) }()
+	info.backupStore = persistence.WithContext(info.backupStore, ctx)
+
 	pluginManager := c.newPluginManager(restoreLog)
 	defer pluginManager.CleanupClients()
 
-	actions, err := pluginManager.GetRestoreItemActions()
+	actions, err := pluginManager.GetRestoreItemActionsV2()
 	if err != nil {
 		return errors.Wrap(err, "error getting restore item actions")
 	}
@@ -462,12 +602,48 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	itemSnapshots, err := info.backupStore.GetItemSnapshots(restore.Spec.BackupName)
+	if err != nil {
+		return errors.Wrap(err, "error fetching item snapshots metadata")
+	}
+
+	// only the item snapshotters that took snapshots of items in the backup are needed. If one
+	// of them isn't available, the items it snapshotted fail to restore.
+	itemSnapshotters := map[string]velero.ItemSnapshotter{}
+	for _, snapshot := range itemSnapshots {
+		name := snapshot.Spec.ItemSnapshotter
+		if _, ok := itemSnapshotters[name]; ok {
+			continue
+		}
+		snapshotter, err := initItemSnapshotter(pluginManager, c.kbClient, restore.Namespace, name)
+		if err != nil {
+			restoreLog.WithError(err).Errorf("Error getting item snapshotter %s", name)
+			continue
+		}
+		itemSnapshotters[name] = snapshotter
+	}
+
 	restoreLog.Infof("Starting restore on destination cluster at %s", c.restorer.DestClusterHost())
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
 	for i := range podVolumeBackupList.Items {
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
+	var includedItems []velero.ResourceIdentifier
+	if restore.Spec.RetryOf != "" {
+		includedItems, err = getFailedItems(restore.Spec.RetryOf, info.backupStore)
+		if err != nil {
+			return errors.Wrapf(err, "error getting failed items of restore %s", restore.Spec.RetryOf)
+		}
+		if len(includedItems) == 0 {
+			return errors.Errorf("restore %s has no failed items to retry", restore.Spec.RetryOf)
+		}
+		restore.Status.RetriedItems = len(includedItems)
+		restoreLog.Infof("Retrying %d failed item(s) of restore %s", len(includedItems), restore.Spec.RetryOf)
+
+		c.recordRetry(restore)
+	}
+
 	restoreReq := pkgrestore.Request{
 		Log:              restoreLog,
 		Restore:          restore,
@@ -475,8 +651,13 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		PodVolumeBackups: podVolumeBackups,
 		VolumeSnapshots:  volumeSnapshots,
 		BackupReader:     backupFile,
+		ItemSnapshots:    itemSnapshots,
+		ItemSnapshotters: itemSnapshotters,
+		HookReport:       hook.NewExecutionReport(),
+		ItemReport:       pkgrestore.NewItemReport(),
+		IncludedItems:    includedItems,
 	}
-	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
+	restoreWarnings, restoreErrors := c.restorer.Restore(ctx, restoreReq, actions, c.snapshotLocationLister, pluginManager)
 	restoreLog.Info("restore completed")
 
 	// re-instantiate the backup store because credentials could have changed since the original
@@ -485,6 +666,7 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	if err != nil {
 		return errors.Wrap(err, "error setting up backup store to persist log and results files")
 	}
+	info.backupStore = persistence.WithContext(info.backupStore, ctx)
 
 	if logReader, err := restoreLog.done(c.logger); err != nil {
 		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
@@ -516,9 +698,140 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if err := putItemReport(restore, restoreReq.ItemReport, info.backupStore); err != nil {
+		c.logger.WithError(err).Error("Error uploading restore item report to backup storage")
+	}
+
+	restore.Status.HooksAttempted, restore.Status.HooksFailed = restoreReq.HookReport.Summary()
+	if restore.Status.HooksAttempted > 0 {
+		if err := putHookReport(restore, restoreReq.HookReport, info.backupStore); err != nil {
+			c.logger.WithError(err).Error("Error uploading restore hook report to backup storage")
+		}
+	}
+
 	return nil
 }
 
+// getFailedItems returns the items recorded as failed in the item report of a restore.
+func getFailedItems(restoreName string, backupStore persistence.BackupStore) ([]velero.ResourceIdentifier, error) {
+	items, err := getItemReport(restoreName, backupStore)
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []velero.ResourceIdentifier
+	for _, item := range pkgrestore.FilterItemResults(items, pkgrestore.ItemStatusFailed) {
+		failed = append(failed, velero.ResourceIdentifier{
+			GroupResource: schema.ParseGroupResource(item.Resource),
+			Namespace:     item.Namespace,
+			Name:          item.Name,
+		})
+	}
+	return failed, nil
+}
+
+// getItemReport returns the item report of a restore.
+func getItemReport(restoreName string, backupStore persistence.BackupStore) ([]pkgrestore.ItemResult, error) {
+	rc, err := backupStore.GetRestoreItemReport(restoreName)
+	if err != nil {
+		return nil, err
+	}
+	if rc == nil {
+		return nil, errors.New("restore has no item report")
+	}
+	defer rc.Close()
+
+	gzr, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	var items []pkgrestore.ItemResult
+	if err := json.NewDecoder(gzr).Decode(&items); err != nil {
+		return nil, errors.Wrap(err, "error decoding restore item report")
+	}
+	return items, nil
+}
+
+// getResults returns the warnings and errors of a restore, keyed by "warnings" and "errors".
+func getResults(restoreName string, backupStore persistence.BackupStore) (map[string]pkgrestore.Result, error) {
+	rc, err := backupStore.GetRestoreResults(restoreName)
+	if err != nil {
+		return nil, err
+	}
+	if rc == nil {
+		return nil, errors.New("restore has no results")
+	}
+	defer rc.Close()
+
+	gzr, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	var results map[string]pkgrestore.Result
+	if err := json.NewDecoder(gzr).Decode(&results); err != nil {
+		return nil, errors.Wrap(err, "error decoding restore results")
+	}
+	return results, nil
+}
+
+// recordRetry records, in the status of the restore whose failed items the restore retries,
+// that the restore retries them.
+func (c *restoreController) recordRetry(restore *api.Restore) {
+	original, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Spec.RetryOf)
+	if err != nil {
+		c.logger.WithError(err).Errorf("Error getting restore %s to record its retry", restore.Spec.RetryOf)
+		return
+	}
+
+	for _, name := range original.Status.RetriedBy {
+		if name == restore.Name {
+			return
+		}
+	}
+
+	updated := original.DeepCopy()
+	updated.Status.RetriedBy = append(updated.Status.RetriedBy, restore.Name)
+	if _, err := patchRestore(original, updated, c.restoreClient); err != nil {
+		c.logger.WithError(err).Errorf("Error recording retry in restore %s", original.Name)
+	}
+}
+
+func putHookReport(restore *api.Restore, report *hook.ExecutionReport, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report.Executions()); err != nil {
+		return errors.Wrap(err, "error encoding restore hook report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreHookReport(restore.Spec.BackupName, restore.Name, buf)
+}
+
+func putItemReport(restore *api.Restore, report *pkgrestore.ItemReport, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report.Items()); err != nil {
+		return errors.Wrap(err, "error encoding restore item report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreItemReport(restore.Spec.BackupName, restore.Name, buf)
+}
+
 func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
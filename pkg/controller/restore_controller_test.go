@@ -18,8 +18,10 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -32,9 +34,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/clock"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -46,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -117,8 +122,9 @@ func TestFetchBackupInfo(t *testing.T) {
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
-				metrics.NewServerMetrics(),
+				metrics.NewServerMetrics("", ""),
 				formatFlag,
+				&record.FakeRecorder{},
 			).(*restoreController)
 
 			if test.backupStoreError == nil {
@@ -209,8 +215,9 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				logrus.InfoLevel,
 				nil,
 				nil, // backupStoreGetter
-				metrics.NewServerMetrics(),
+				metrics.NewServerMetrics("", ""),
 				formatFlag,
+				&record.FakeRecorder{},
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -414,6 +421,7 @@ func TestProcessQueueItem(t *testing.T) {
 				logger          = velerotest.NewLogger()
 				pluginManager   = &pluginmocks.Manager{}
 				backupStore     = &persistencemocks.BackupStore{}
+				eventRecorder   = record.NewFakeRecorder(10)
 			)
 
 			defer restorer.AssertExpectations(t)
@@ -436,8 +444,9 @@ func TestProcessQueueItem(t *testing.T) {
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
-				metrics.NewServerMetrics(),
+				metrics.NewServerMetrics("", ""),
 				formatFlag,
+				eventRecorder,
 			).(*restoreController)
 
 			c.clock = clock.NewFakeClock(now)
@@ -510,6 +519,7 @@ func TestProcessQueueItem(t *testing.T) {
 				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreItemReport", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 
 				volumeSnapshots := []*volume.Snapshot{
 					{
@@ -520,6 +530,7 @@ func TestProcessQueueItem(t *testing.T) {
 					},
 				}
 				backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return(volumeSnapshots, nil)
+				backupStore.On("GetItemSnapshots", test.backup.Name).Return(nil, nil)
 			}
 
 			var (
@@ -544,7 +555,7 @@ func TestProcessQueueItem(t *testing.T) {
 			}
 
 			if test.restore != nil {
-				pluginManager.On("GetRestoreItemActions").Return(nil, nil)
+				pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
 				pluginManager.On("CleanupClients")
 			}
 
@@ -605,6 +616,11 @@ func TestProcessQueueItem(t *testing.T) {
 
 			velerotest.ValidatePatch(t, actions[0], expected, decode)
 
+			if test.expectedPhase == string(velerov1api.RestorePhaseFailedValidation) {
+				require.Len(t, eventRecorder.Events, 1)
+				assert.Contains(t, <-eventRecorder.Events, "Warning FailedValidation Restore failed validation")
+			}
+
 			// if we don't expect a restore, validate it wasn't called and exit the test
 			if test.expectedRestorerCall == nil {
 				assert.Empty(t, restorer.Calls)
@@ -635,6 +651,10 @@ func TestProcessQueueItem(t *testing.T) {
 
 			velerotest.ValidatePatch(t, actions[2], expected, decode)
 
+			require.Len(t, eventRecorder.Events, 2)
+			assert.Equal(t, "Normal PhaseChanged Restore is in progress", <-eventRecorder.Events)
+			assert.Contains(t, <-eventRecorder.Events, "PhaseChanged Restore "+string(expected.Status.Phase))
+
 			// explicitly capturing the argument passed to Restore myself because
 			// I want to validate the called arg as of the time of calling, but
 			// the mock stores the pointer, which gets modified after
@@ -651,6 +671,7 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		sharedInformers = informers.NewSharedInformerFactory(client, 0)
 		logger          = velerotest.NewLogger()
 		pluginManager   = &pluginmocks.Manager{}
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t, builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result())
 	)
 
 	c := NewRestoreController(
@@ -660,14 +681,15 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		client.VeleroV1(),
 		nil,
 		sharedInformers.Velero().V1().Backups().Lister(),
-		nil,
+		fakeClient,
 		sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 		logger,
 		logrus.DebugLevel,
 		nil,
-		nil, // backupStoreGetter
+		NewFakeSingleObjectBackupStoreGetter(&persistencemocks.BackupStore{}),
 		nil,
 		formatFlag,
+		&record.FakeRecorder{},
 	).(*restoreController)
 
 	restore := &velerov1api.Restore{
@@ -688,8 +710,8 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 			Result(),
 	))
 
-	errs := c.validateAndComplete(restore, pluginManager)
-	assert.Equal(t, []string{"No backups found for schedule"}, errs)
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, []string{"No backups found for schedule", "No completed backups found for schedule"}, restore.Status.ValidationErrors)
 	assert.Empty(t, restore.Spec.BackupName)
 
 	// no completed backups created from the schedule: fail validation
@@ -703,8 +725,9 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 			Result(),
 	))
 
-	errs = c.validateAndComplete(restore, pluginManager)
-	assert.Equal(t, []string{"No completed backups found for schedule"}, errs)
+	restore.Status.ValidationErrors = nil
+	c.validateAndComplete(restore, pluginManager)
+	assert.Equal(t, []string{"No completed backups found for schedule"}, restore.Status.ValidationErrors)
 	assert.Empty(t, restore.Spec.BackupName)
 
 	// multiple completed backups created from the schedule: use most recent
@@ -716,6 +739,7 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 				builder.WithName("foo"),
 				builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"),
 			).
+			StorageLocation("default").
 			Phase(velerov1api.BackupPhaseCompleted).
 			StartTimestamp(now).
 			Result(),
@@ -723,17 +747,158 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(
 		defaultBackup().
 			ObjectMeta(
-				builder.WithName("foo"),
+				builder.WithName("bar"),
 				builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"),
 			).
+			StorageLocation("default").
 			Phase(velerov1api.BackupPhaseCompleted).
 			StartTimestamp(now.Add(time.Second)).
 			Result(),
 	))
 
-	errs = c.validateAndComplete(restore, pluginManager)
-	assert.Nil(t, errs)
+	restore.Status.ValidationErrors = nil
+	info := c.validateAndComplete(restore, pluginManager)
+	assert.Empty(t, restore.Status.ValidationErrors)
 	assert.Equal(t, "bar", restore.Spec.BackupName)
+	assert.Equal(t, "bar", info.backup.Name)
+}
+
+func TestValidateRetry(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+	)
+
+	c := &restoreController{
+		restoreLister: sharedInformers.Velero().V1().Restores().Lister(),
+	}
+
+	for _, retried := range []*velerov1api.Restore{
+		builder.ForRestore(velerov1api.DefaultNamespace, "partially-failed").Backup("backup-1").Phase(velerov1api.RestorePhasePartiallyFailed).Result(),
+		builder.ForRestore(velerov1api.DefaultNamespace, "completed").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+	} {
+		require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(retried))
+	}
+
+	tests := []struct {
+		name                     string
+		restore                  *velerov1api.Restore
+		expectedValidationErrors []string
+	}{
+		{
+			name:    "retry of a partially failed restore of the same backup is valid",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("partially-failed").Result(),
+		},
+		{
+			name:                     "retry of a missing restore isn't valid",
+			restore:                  builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("missing").Result(),
+			expectedValidationErrors: []string{"Error retrieving restore to retry: restore.velero.io \"missing\" not found"},
+		},
+		{
+			name:                     "retry of a completed restore isn't valid",
+			restore:                  builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("completed").Result(),
+			expectedValidationErrors: []string{"Restore to retry completed is Completed, only PartiallyFailed restores can be retried"},
+		},
+		{
+			name:                     "retry of a restore of another backup isn't valid",
+			restore:                  builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-2").RetryOf("partially-failed").Result(),
+			expectedValidationErrors: []string{"Restore to retry partially-failed is of backup backup-1, not backup-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedValidationErrors == nil, c.validateRetry(test.restore))
+			assert.Equal(t, test.expectedValidationErrors, test.restore.Status.ValidationErrors)
+		})
+	}
+}
+
+func TestGetFailedItems(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	require.NoError(t, json.NewEncoder(gzw).Encode([]pkgrestore.ItemResult{
+		{Resource: "deployments.apps", Namespace: "ns-1", Name: "app", Status: pkgrestore.ItemStatusCreated},
+		{Resource: "services", Namespace: "ns-1", Name: "app", Status: pkgrestore.ItemStatusFailed, Reason: "error restoring services/ns-1/app"},
+		{Resource: "persistentvolumes", Name: "pv-1", Status: pkgrestore.ItemStatusFailed, Reason: "error restoring persistentvolumes/pv-1"},
+	}))
+	require.NoError(t, gzw.Close())
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetRestoreItemReport", "restore-1").Return(ioutil.NopCloser(buf), nil)
+	backupStore.On("GetRestoreItemReport", "restore-2").Return(nil, nil)
+
+	items, err := getFailedItems("restore-1", backupStore)
+	require.NoError(t, err)
+	assert.Equal(t, []velero.ResourceIdentifier{
+		{GroupResource: schema.GroupResource{Resource: "services"}, Namespace: "ns-1", Name: "app"},
+		{GroupResource: schema.GroupResource{Resource: "persistentvolumes"}, Name: "pv-1"},
+	}, items)
+
+	_, err = getFailedItems("restore-2", backupStore)
+	assert.Error(t, err)
+}
+
+func TestValidateRetriedErrors(t *testing.T) {
+	gzipJSON := func(v interface{}) io.ReadCloser {
+		buf := new(bytes.Buffer)
+		gzw := gzip.NewWriter(buf)
+		require.NoError(t, json.NewEncoder(gzw).Encode(v))
+		require.NoError(t, gzw.Close())
+		return ioutil.NopCloser(buf)
+	}
+
+	items := []pkgrestore.ItemResult{
+		{Resource: "deployments.apps", Namespace: "ns-1", Name: "app", Status: pkgrestore.ItemStatusCreated},
+		{Resource: "services", Namespace: "ns-1", Name: "app", Status: pkgrestore.ItemStatusFailed, Reason: "error restoring services/ns-1/app"},
+	}
+
+	tests := []struct {
+		name                     string
+		items                    []pkgrestore.ItemResult
+		errors                   pkgrestore.Result
+		expectedValidationErrors []string
+	}{
+		{
+			name:   "restore whose errors are all about failed items can be retried",
+			items:  items,
+			errors: pkgrestore.Result{Namespaces: map[string][]string{"ns-1": {"error restoring services/ns-1/app"}}},
+		},
+		{
+			name:                     "restore without failed items can't be retried",
+			items:                    items[:1],
+			errors:                   pkgrestore.Result{Velero: []string{"pod volume restore failed: error running restic restore"}},
+			expectedValidationErrors: []string{"Restore to retry partially-failed has no failed items to retry"},
+		},
+		{
+			name:  "restore with errors that aren't about failed items can't be retried",
+			items: items,
+			errors: pkgrestore.Result{
+				Velero:     []string{"pod volume restore failed: error running restic restore"},
+				Namespaces: map[string][]string{"ns-1": {"error restoring services/ns-1/app"}},
+			},
+			expectedValidationErrors: []string{"Restore to retry partially-failed has 1 error(s) that aren't about a failed item, so retrying its failed items wouldn't retry them: pod volume restore failed: error running restic restore"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetRestoreItemReport", "partially-failed").Return(gzipJSON(test.items), nil)
+			backupStore.On("GetRestoreResults", "partially-failed").Return(gzipJSON(map[string]pkgrestore.Result{"errors": test.errors}), nil)
+
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("partially-failed").Result()
+			assert.Equal(t, test.expectedValidationErrors == nil, validateRetriedErrors(restore, backupStore))
+			assert.Equal(t, test.expectedValidationErrors, restore.Status.ValidationErrors)
+		})
+	}
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetRestoreItemReport", "partially-failed").Return(gzipJSON(items), nil)
+	backupStore.On("GetRestoreResults", "partially-failed").Return(nil, nil)
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "retry").Backup("backup-1").RetryOf("partially-failed").Result()
+	assert.False(t, validateRetriedErrors(restore, backupStore))
+	assert.Equal(t, []string{"Error retrieving results of restore to retry: restore has no results"}, restore.Status.ValidationErrors)
 }
 
 func TestBackupXorScheduleProvided(t *testing.T) {
@@ -847,8 +1012,9 @@ type fakeRestorer struct {
 }
 
 func (r *fakeRestorer) Restore(
+	_ context.Context,
 	info pkgrestore.Request,
-	actions []velero.RestoreItemAction,
+	actions []riav2.RestoreItemAction,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
 	volumeSnapshotterGetter pkgrestore.VolumeSnapshotterGetter,
 ) (pkgrestore.Result, pkgrestore.Result) {
@@ -858,3 +1024,7 @@ func (r *fakeRestorer) Restore(
 
 	return res.Get(0).(pkgrestore.Result), res.Get(1).(pkgrestore.Result)
 }
+
+func (r *fakeRestorer) DestClusterHost() string {
+	return ""
+}
@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restoreOperationsController tracks the asynchronous operations started by restore item
+// actions, and finishes the restores that are waiting for them once they're all done.
+type restoreOperationsController struct {
+	*genericController
+
+	namespace            string
+	restoreClient        velerov1client.RestoresGetter
+	restoreLister        velerov1listers.RestoreLister
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	itemOperationTimeout time.Duration
+	metrics              *metrics.ServerMetrics
+	eventRecorder        record.EventRecorder
+	clock                clock.Clock
+}
+
+// NewRestoreOperationsController constructs a new restoreOperationsController.
+func NewRestoreOperationsController(
+	namespace string,
+	restoreClient velerov1client.RestoresGetter,
+	restoreInformer velerov1informers.RestoreInformer,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	itemOperationTimeout time.Duration,
+	metrics *metrics.ServerMetrics,
+	eventRecorder record.EventRecorder,
+	logger logrus.FieldLogger,
+) Interface {
+	c := &restoreOperationsController{
+		genericController:    newGenericController(RestoreOperations, logger),
+		namespace:            namespace,
+		restoreClient:        restoreClient,
+		restoreLister:        restoreInformer.Lister(),
+		newPluginManager:     newPluginManager,
+		itemOperationTimeout: itemOperationTimeout,
+		metrics:              metrics,
+		eventRecorder:        eventRecorder,
+		clock:                clock.RealClock{},
+	}
+
+	c.syncHandler = c.processRestore
+	c.resyncPeriod = pluginOperationsResyncPeriod
+	c.resyncFunc = c.enqueueWaitingRestores
+
+	restoreInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueIfWaiting,
+			UpdateFunc: func(_, obj interface{}) { c.enqueueIfWaiting(obj) },
+		},
+	)
+
+	return c
+}
+
+func (c *restoreOperationsController) enqueueIfWaiting(obj interface{}) {
+	restore, ok := obj.(*velerov1api.Restore)
+	if !ok || restore.Status.Phase != velerov1api.RestorePhaseWaitingForPluginOperations {
+		return
+	}
+
+	c.enqueue(restore)
+}
+
+// enqueueWaitingRestores enqueues the restores that are waiting for plugin operations, so
+// the progress of the operations is checked periodically.
+func (c *restoreOperationsController) enqueueWaitingRestores() {
+	restores, err := c.restoreLister.Restores(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing restores")
+		return
+	}
+
+	for _, restore := range restores {
+		c.enqueueIfWaiting(restore)
+	}
+}
+
+func (c *restoreOperationsController) processRestore(key string) error {
+	log := c.logger.WithField("restore", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	original, err := c.restoreLister.Restores(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find restore")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting restore")
+	}
+	if original.Status.Phase != velerov1api.RestorePhaseWaitingForPluginOperations {
+		return nil
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	restore := original.DeepCopy()
+	getHandler := func(op *velerov1api.PluginOperation) (pluginOperationHandler, error) {
+		action, err := pluginManager.GetRestoreItemActionV2(op.PluginName)
+		if err != nil {
+			return nil, err
+		}
+		return &restoreOperationHandler{action: action, restore: restore}, nil
+	}
+	updated := updatePluginOperations(restore.Status.PluginOperations, getHandler, c.itemOperationTimeout, c.clock.Now(), log)
+
+	if velerov1api.PluginOperationsInProgress(restore.Status.PluginOperations) {
+		if !updated {
+			return nil
+		}
+		_, err := patchRestore(original, restore, c.restoreClient)
+		return errors.Wrap(err, "error updating restore's plugin operations")
+	}
+
+	backupScheduleName := restore.Spec.ScheduleName
+	if restore.Status.Errors > 0 || pluginOperationsFailed(restore.Status.PluginOperations) {
+		log.Info("Restore partially failed")
+		restore.Status.Phase = velerov1api.RestorePhasePartiallyFailed
+		c.metrics.RegisterRestorePartialFailure(backupScheduleName)
+	} else {
+		log.Info("Restore completed")
+		restore.Status.Phase = velerov1api.RestorePhaseCompleted
+		c.metrics.RegisterRestoreSuccess(backupScheduleName)
+	}
+	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	if _, err := patchRestore(original, restore, c.restoreClient); err != nil {
+		return errors.Wrap(err, "error updating restore's final status")
+	}
+
+	eventType := corev1api.EventTypeNormal
+	if restore.Status.Phase != velerov1api.RestorePhaseCompleted {
+		eventType = corev1api.EventTypeWarning
+	}
+	c.eventRecorder.Eventf(restore, eventType, kubeutil.EventReasonPhaseChanged,
+		"Restore %s with %d error(s) and %d warning(s)", restore.Status.Phase, restore.Status.Errors, restore.Status.Warnings)
+
+	return nil
+}
+
+// restoreOperationHandler checks on and cancels the operations a restore item action
+// started for a restore.
+type restoreOperationHandler struct {
+	action  riav2.RestoreItemAction
+	restore *velerov1api.Restore
+}
+
+func (h *restoreOperationHandler) Progress(operationID string) (velero.OperationProgress, error) {
+	return h.action.Progress(operationID, h.restore)
+}
+
+func (h *restoreOperationHandler) Cancel(operationID string) error {
+	return h.action.Cancel(operationID, h.restore)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestoreOperationsControllerProcessRestore(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	waitingRestore := func() *velerov1api.Restore {
+		return builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+			Phase(velerov1api.RestorePhaseWaitingForPluginOperations).
+			PluginOperations(velerov1api.PluginOperation{
+				PluginName:  "velero.io/volume-populator",
+				OperationID: "op-1",
+				Resource:    "persistentvolumeclaims",
+				Namespace:   "ns-1",
+				Name:        "pvc-1",
+				Phase:       velerov1api.PluginOperationPhaseInProgress,
+				Created:     &metav1.Time{Time: now.Add(-time.Minute)},
+			}).
+			Result()
+	}
+
+	tests := []struct {
+		name            string
+		progress        velero.OperationProgress
+		expectedPhase   velerov1api.RestorePhase
+		expectedOpPhase velerov1api.PluginOperationPhase
+	}{
+		{
+			name:            "restores with operations in progress keep waiting",
+			progress:        velero.OperationProgress{NCompleted: 1, NTotal: 2},
+			expectedPhase:   velerov1api.RestorePhaseWaitingForPluginOperations,
+			expectedOpPhase: velerov1api.PluginOperationPhaseInProgress,
+		},
+		{
+			name:            "restores whose operations completed are completed",
+			progress:        velero.OperationProgress{Completed: true, NCompleted: 2, NTotal: 2},
+			expectedPhase:   velerov1api.RestorePhaseCompleted,
+			expectedOpPhase: velerov1api.PluginOperationPhaseCompleted,
+		},
+		{
+			name:            "restores whose operations failed are partially failed",
+			progress:        velero.OperationProgress{Completed: true, Err: "volume population failed"},
+			expectedPhase:   velerov1api.RestorePhasePartiallyFailed,
+			expectedOpPhase: velerov1api.PluginOperationPhaseFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				restore         = waitingRestore()
+				client          = fake.NewSimpleClientset(restore)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				action          = &riav2mocks.RestoreItemAction{}
+			)
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetRestoreItemActionV2", "velero.io/volume-populator").Return(action, nil)
+			action.On("Progress", "op-1", mock.Anything).Return(test.progress, nil)
+
+			require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore))
+
+			c := NewRestoreOperationsController(
+				velerov1api.DefaultNamespace,
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Restores(),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				DefaultItemOperationTimeout,
+				metrics.NewServerMetrics("", ""),
+				record.NewFakeRecorder(10),
+				velerotest.NewLogger(),
+			).(*restoreOperationsController)
+			c.clock = clock.NewFakeClock(now)
+
+			require.NoError(t, c.processRestore("velero/restore-1"))
+
+			res, err := client.VeleroV1().Restores(velerov1api.DefaultNamespace).Get(context.TODO(), "restore-1", metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedPhase != velerov1api.RestorePhaseWaitingForPluginOperations, res.Status.CompletionTimestamp != nil)
+			require.Len(t, res.Status.PluginOperations, 1)
+			assert.Equal(t, test.expectedOpPhase, res.Status.PluginOperations[0].Phase)
+			assert.Equal(t, test.progress.Err, res.Status.PluginOperations[0].Error)
+			assert.Equal(t, test.progress.NCompleted, res.Status.PluginOperations[0].NCompleted)
+		})
+	}
+}
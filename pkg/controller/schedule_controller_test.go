@@ -132,7 +132,7 @@ func TestProcessSchedule(t *testing.T) {
 				client.VeleroV1(),
 				sharedInformers.Velero().V1().Schedules(),
 				logger,
-				metrics.NewServerMetrics(),
+				metrics.NewServerMetrics("", ""),
 			)
 
 			var (
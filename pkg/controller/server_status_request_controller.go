@@ -53,6 +53,7 @@ type ServerStatusRequestReconciler struct {
 	Client         client.Client
 	Ctx            context.Context
 	PluginRegistry PluginLister
+	PluginStatuses velero.PluginStatusGetter
 	Clock          clock.Clock
 
 	Log logrus.FieldLogger
@@ -99,7 +100,7 @@ func (r *ServerStatusRequestReconciler) Reconcile(ctx context.Context, req ctrl.
 		statusRequest.Status.ServerVersion = buildinfo.Version
 		statusRequest.Status.Phase = velerov1api.ServerStatusRequestPhaseProcessed
 		statusRequest.Status.ProcessedTimestamp = &metav1.Time{Time: r.Clock.Now()}
-		statusRequest.Status.Plugins = velero.GetInstalledPluginInfo(r.PluginRegistry)
+		statusRequest.Status.Plugins = velero.GetInstalledPluginInfo(r.PluginRegistry, r.PluginStatuses)
 
 		if err := patchHelper.Patch(r.Ctx, statusRequest); err != nil {
 			log.WithError(err).Error("Error updating ServerStatusRequest status")
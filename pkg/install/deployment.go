@@ -27,8 +27,13 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/velero"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence/filesystem"
 )
 
+// fileSystemDownloadPort is the port of the server's download endpoint for the
+// filesystem object store provider.
+const fileSystemDownloadPort = 8086
+
 type podTemplateOption func(*podTemplateConfig)
 
 type podTemplateConfig struct {
@@ -44,6 +49,7 @@ type podTemplateConfig struct {
 	defaultVolumesToRestic            bool
 	httpsProxy                        string
 	httpProxy                         string
+	fileSystemVolume                  *corev1.VolumeSource
 }
 
 func WithImage(image string) podTemplateOption {
@@ -129,6 +135,14 @@ func WithHttpProxy(proxy string) podTemplateOption {
 	}
 }
 
+// WithFileSystemVolume mounts the volume storing the backups of the filesystem
+// object store provider, and enables the server's download endpoint for them.
+func WithFileSystemVolume(source corev1.VolumeSource) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.fileSystemVolume = &source
+	}
+}
+
 func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment {
 	// TODO: Add support for server args
 	c := &podTemplateConfig{
@@ -293,6 +307,34 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--default-restic-prune-frequency=%v", c.defaultResticMaintenanceFrequency))
 	}
 
+	if c.fileSystemVolume != nil {
+		deployment.Spec.Template.Spec.Volumes = append(
+			deployment.Spec.Template.Spec.Volumes,
+			corev1.Volume{
+				Name:         "filesystem-backups",
+				VolumeSource: *c.fileSystemVolume,
+			},
+		)
+
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{
+				Name:      "filesystem-backups",
+				MountPath: filesystem.DefaultRoot,
+			},
+		)
+
+		deployment.Spec.Template.Spec.Containers[0].Ports = append(
+			deployment.Spec.Template.Spec.Containers[0].Ports,
+			corev1.ContainerPort{
+				Name:          "filesystem",
+				ContainerPort: fileSystemDownloadPort,
+			},
+		)
+
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--filesystem-download-address=:%d", fileSystemDownloadPort))
+	}
+
 	if len(c.plugins) > 0 {
 		for _, image := range c.plugins {
 			container := *builder.ForPluginContainer(image, pullPolicy).Result()
@@ -53,4 +53,12 @@ func TestDeployment(t *testing.T) {
 	deploy = Deployment("velero", WithFeatures([]string{"EnableCSI", "foo", "bar", "baz"}))
 	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
 	assert.Equal(t, "--features=EnableCSI,foo,bar,baz", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
+	deploy = Deployment("velero", WithFileSystemVolume(corev1.VolumeSource{
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nfs-backups"},
+	}))
+	assert.Equal(t, "--filesystem-download-address=:8086", deploy.Spec.Template.Spec.Containers[0].Args[1])
+	assert.Equal(t, "nfs-backups", deploy.Spec.Template.Spec.Volumes[2].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "/velero-backups", deploy.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Ports, 2)
 }
@@ -220,6 +220,10 @@ type VeleroOptions struct {
 	DefaultVolumesToRestic            bool
 	HttpsProxy                        string
 	HttpProxy                         string
+	// FileSystemPVC and FileSystemHostPath are the persistent volume claim or
+	// the host path mounted to store the backups of the filesystem provider.
+	FileSystemPVC      string
+	FileSystemHostPath string
 }
 
 func AllCRDs(perferredAPIVersion string) *unstructured.UnstructuredList {
@@ -307,6 +311,16 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		deployOpts = append(deployOpts, WithHttpProxy(o.HttpProxy))
 	}
 
+	if o.FileSystemPVC != "" {
+		deployOpts = append(deployOpts, WithFileSystemVolume(corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: o.FileSystemPVC},
+		}))
+	} else if o.FileSystemHostPath != "" {
+		deployOpts = append(deployOpts, WithFileSystemVolume(corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: o.FileSystemHostPath},
+		}))
+	}
+
 	deploy := Deployment(o.Namespace, deployOpts...)
 
 	appendUnstructured(resources, deploy)
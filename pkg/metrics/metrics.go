@@ -17,14 +17,22 @@ limitations under the License.
 package metrics
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/client-go/rest"
 )
 
 // ServerMetrics contains Prometheus metrics for the Velero server.
 type ServerMetrics struct {
 	metrics map[string]prometheus.Collector
+
+	// srcCluster and destCluster are the values of the cluster label of the metrics
+	// about backups and restores respectively.
+	srcCluster  string
+	destCluster string
 }
 
 const (
@@ -52,6 +60,16 @@ const (
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
+	backupItemsTotal              = "backup_items_total"
+	backupResourceItemsTotal      = "backup_resource_items_total"
+	backupUploadedBytes           = "backup_uploaded_bytes"
+	restoreItemsTotal             = "restore_items_total"
+	hookDurationSeconds           = "hook_duration_seconds"
+	clusterAPIRequestSeconds      = "cluster_api_request_duration_seconds"
+	pluginProcessCrashesTotal     = "plugin_process_crashes_total"
+	pluginProcessRestartsTotal    = "plugin_process_restarts_total"
+	backupStorageLocationBytes    = "backup_storage_location_bytes"
+	backupStorageLocationBackups  = "backup_storage_location_backups"
 
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
@@ -65,13 +83,41 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	restoreNameLabel     = "restoreName"
+	clusterLabel         = "cluster"
+	resourceLabel        = "resource"
+	hookOperationLabel   = "operation"
+	hookResultLabel      = "result"
+	verbLabel            = "verb"
+	pluginCommandLabel   = "command"
+	backupLocationLabel  = "backupLocation"
 
 	secondsInMinute = 60.0
+
+	// localCluster is the value of the cluster label for the cluster Velero runs in.
+	localCluster = "local"
+
+	hookOperationBackup  = "backup"
+	hookOperationRestore = "restore"
+	hookResultSucceeded  = "succeeded"
+	hookResultFailed     = "failed"
 )
 
-// NewServerMetrics returns new ServerMetrics
-func NewServerMetrics() *ServerMetrics {
+// clusterLabelValue returns the value of the cluster label for the cluster with
+// the given host, which is empty for the cluster Velero runs in.
+func clusterLabelValue(host string) string {
+	if host == "" {
+		return localCluster
+	}
+	return host
+}
+
+// NewServerMetrics returns new ServerMetrics. The metrics about backups are labeled
+// with srcClusterHost and the metrics about restores with destClusterHost.
+func NewServerMetrics(srcClusterHost, destClusterHost string) *ServerMetrics {
 	return &ServerMetrics{
+		srcCluster:  clusterLabelValue(srcClusterHost),
+		destCluster: clusterLabelValue(destClusterHost),
 		metrics: map[string]prometheus.Collector{
 			backupTarballSizeBytesGauge: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
@@ -79,7 +125,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupTarballSizeBytesGauge,
 					Help:      "Size, in bytes, of a backup",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupLastSuccessfulTimestamp: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
@@ -87,14 +133,15 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupLastSuccessfulTimestamp,
 					Help:      "Last time a backup ran successfully, Unix timestamp in seconds",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
-			backupTotal: prometheus.NewGauge(
+			backupTotal: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
 					Name:      backupTotal,
 					Help:      "Current number of existent backups",
 				},
+				[]string{clusterLabel},
 			),
 			backupAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -102,7 +149,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupAttemptTotal,
 					Help:      "Total number of attempted backups",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupSuccessTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -110,7 +157,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupSuccessTotal,
 					Help:      "Total number of successful backups",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupPartialFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -118,7 +165,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupPartialFailureTotal,
 					Help:      "Total number of partially failed backups",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -126,7 +173,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupFailureTotal,
 					Help:      "Total number of failed backups",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupValidationFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -134,7 +181,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupValidationFailureTotal,
 					Help:      "Total number of validation failed backups",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupDeletionAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -142,7 +189,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupDeletionAttemptTotal,
 					Help:      "Total number of attempted backup deletions",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupDeletionSuccessTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -150,7 +197,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupDeletionSuccessTotal,
 					Help:      "Total number of successful backup deletions",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupDeletionFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -158,7 +205,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      backupDeletionFailureTotal,
 					Help:      "Total number of failed backup deletions",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			backupDurationSeconds: prometheus.NewHistogramVec(
 				prometheus.HistogramOpts{
@@ -177,14 +224,15 @@ func NewServerMetrics() *ServerMetrics {
 						toSeconds(4 * time.Hour),
 					},
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
-			restoreTotal: prometheus.NewGauge(
+			restoreTotal: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
 					Name:      restoreTotal,
 					Help:      "Current number of existent restores",
 				},
+				[]string{clusterLabel},
 			),
 			restoreAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -192,7 +240,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      restoreAttemptTotal,
 					Help:      "Total number of attempted restores",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			restoreSuccessTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -200,7 +248,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      restoreSuccessTotal,
 					Help:      "Total number of successful restores",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			restorePartialFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -208,7 +256,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      restorePartialFailureTotal,
 					Help:      "Total number of partially failed restores",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			restoreFailedTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -216,7 +264,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      restoreFailedTotal,
 					Help:      "Total number of failed restores",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			restoreValidationFailedTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -224,7 +272,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      restoreValidationFailedTotal,
 					Help:      "Total number of failed restores failing validations",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			volumeSnapshotAttemptTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -232,7 +280,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      volumeSnapshotAttemptTotal,
 					Help:      "Total number of attempted volume snapshots",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			volumeSnapshotSuccessTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -240,7 +288,7 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      volumeSnapshotSuccessTotal,
 					Help:      "Total number of successful volume snapshots",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
 			),
 			volumeSnapshotFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -248,14 +296,112 @@ func NewServerMetrics() *ServerMetrics {
 					Name:      volumeSnapshotFailureTotal,
 					Help:      "Total number of failed volume snapshots",
 				},
-				[]string{scheduleLabel},
+				[]string{clusterLabel, scheduleLabel},
+			),
+			backupItemsTotal: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupItemsTotal,
+					Help:      "Number of items backed up by a backup",
+				},
+				[]string{clusterLabel, scheduleLabel, backupNameLabel},
+			),
+			backupResourceItemsTotal: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupResourceItemsTotal,
+					Help:      "Number of items of a resource type backed up by a backup",
+				},
+				[]string{clusterLabel, scheduleLabel, backupNameLabel, resourceLabel},
+			),
+			backupUploadedBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupUploadedBytes,
+					Help:      "Size, in bytes, of the contents uploaded to object storage by a backup",
+				},
+				[]string{clusterLabel, scheduleLabel, backupNameLabel},
+			),
+			restoreItemsTotal: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      restoreItemsTotal,
+					Help:      "Number of items restored by a restore",
+				},
+				[]string{clusterLabel, scheduleLabel, restoreNameLabel},
+			),
+			hookDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      hookDurationSeconds,
+					Help:      "Time taken to execute backup and restore exec hooks, in seconds",
+					Buckets: []float64{
+						0.1,
+						0.5,
+						1,
+						5,
+						10,
+						30,
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(30 * time.Minute),
+					},
+				},
+				[]string{clusterLabel, hookOperationLabel, hookResultLabel},
+			),
+			clusterAPIRequestSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      clusterAPIRequestSeconds,
+					Help:      "Latency of requests to the API server of the source and destination clusters, in seconds",
+					Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+				},
+				[]string{clusterLabel, verbLabel},
+			),
+			pluginProcessCrashesTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginProcessCrashesTotal,
+					Help:      "Total number of times a plugin process exited or failed a health check",
+				},
+				[]string{pluginCommandLabel},
+			),
+			pluginProcessRestartsTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      pluginProcessRestartsTotal,
+					Help:      "Total number of times a plugin process was restarted",
+				},
+				[]string{pluginCommandLabel},
+			),
+			backupStorageLocationBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationBytes,
+					Help:      "Space, in bytes, taken up by the backups stored in a backup storage location",
+				},
+				[]string{clusterLabel, backupLocationLabel, scheduleLabel},
+			),
+			backupStorageLocationBackups: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationBackups,
+					Help:      "Number of backups stored in a backup storage location",
+				},
+				[]string{clusterLabel, backupLocationLabel, scheduleLabel},
 			),
 		},
 	}
 }
 
-func NewResticServerMetrics() *ServerMetrics {
+// NewResticServerMetrics returns new ServerMetrics for the restic server. The metrics
+// are labeled with clusterName, which is empty for the cluster Velero runs in.
+func NewResticServerMetrics(clusterName string) *ServerMetrics {
+	cluster := clusterLabelValue(clusterName)
 	return &ServerMetrics{
+		srcCluster:  cluster,
+		destCluster: cluster,
 		metrics: map[string]prometheus.Collector{
 			podVolumeBackupEnqueueTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -263,7 +409,7 @@ func NewResticServerMetrics() *ServerMetrics {
 					Name:      podVolumeBackupEnqueueTotal,
 					Help:      "Total number of pod_volume_backup objects enqueued",
 				},
-				[]string{nodeMetricLabel},
+				[]string{clusterLabel, nodeMetricLabel},
 			),
 			podVolumeBackupDequeueTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
@@ -271,7 +417,7 @@ func NewResticServerMetrics() *ServerMetrics {
 					Name:      podVolumeBackupDequeueTotal,
 					Help:      "Total number of pod_volume_backup objects dequeued",
 				},
-				[]string{nodeMetricLabel},
+				[]string{clusterLabel, nodeMetricLabel},
 			),
 			resticOperationLatencyGaugeSeconds: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
@@ -279,7 +425,7 @@ func NewResticServerMetrics() *ServerMetrics {
 					Name:      resticOperationLatencyGaugeSeconds,
 					Help:      "Gauge metric indicating time taken, in seconds, to perform restic operations",
 				},
-				[]string{nodeMetricLabel, resticOperationLabel, backupNameLabel, pvbNameLabel},
+				[]string{clusterLabel, nodeMetricLabel, resticOperationLabel, backupNameLabel, pvbNameLabel},
 			),
 			resticOperationLatencySeconds: prometheus.NewHistogramVec(
 				prometheus.HistogramOpts{
@@ -298,7 +444,7 @@ func NewResticServerMetrics() *ServerMetrics {
 						toSeconds(4 * time.Hour),
 					},
 				},
-				[]string{nodeMetricLabel, resticOperationLabel, backupNameLabel, pvbNameLabel},
+				[]string{clusterLabel, nodeMetricLabel, resticOperationLabel, backupNameLabel, pvbNameLabel},
 			),
 		},
 	}
@@ -314,125 +460,125 @@ func (m *ServerMetrics) RegisterAllMetrics() {
 // InitSchedule initializes counter metrics of a schedule.
 func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupPartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[backupDeletionFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.destCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restorePartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.destCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.destCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.destCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.destCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 	if c, ok := m.metrics[volumeSnapshotFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(scheduleName).Add(0)
+		c.WithLabelValues(m.srcCluster, scheduleName).Add(0)
 	}
 }
 
 // InitSchedule initializes counter metrics for a node.
 func (m *ServerMetrics) InitResticMetricsForNode(node string) {
 	if c, ok := m.metrics[podVolumeBackupEnqueueTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
+		c.WithLabelValues(m.srcCluster, node).Add(0)
 	}
 	if c, ok := m.metrics[podVolumeBackupDequeueTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Add(0)
+		c.WithLabelValues(m.srcCluster, node).Add(0)
 	}
 }
 
 // RegisterPodVolumeBackupEnqueue records enqueuing of a PodVolumeBackup object.
 func (m *ServerMetrics) RegisterPodVolumeBackupEnqueue(node string) {
 	if c, ok := m.metrics[podVolumeBackupEnqueueTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.srcCluster, node).Inc()
 	}
 }
 
 // RegisterPodVolumeBackupDequeue records dequeuing of a PodVolumeBackup object.
 func (m *ServerMetrics) RegisterPodVolumeBackupDequeue(node string) {
 	if c, ok := m.metrics[podVolumeBackupDequeueTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(node).Inc()
+		c.WithLabelValues(m.srcCluster, node).Inc()
 	}
 }
 
 // ObserveResticOpLatency records the number of seconds a restic operation took.
 func (m *ServerMetrics) ObserveResticOpLatency(node, pvbName, opName, backupName string, seconds float64) {
 	if h, ok := m.metrics[resticOperationLatencySeconds].(*prometheus.HistogramVec); ok {
-		h.WithLabelValues(node, opName, backupName, pvbName).Observe(seconds)
+		h.WithLabelValues(m.srcCluster, node, opName, backupName, pvbName).Observe(seconds)
 	}
 }
 
 // RegisterResticOpLatencyGauge registers the restic operation latency as a gauge metric.
 func (m *ServerMetrics) RegisterResticOpLatencyGauge(node, pvbName, opName, backupName string, seconds float64) {
 	if g, ok := m.metrics[resticOperationLatencyGaugeSeconds].(*prometheus.GaugeVec); ok {
-		g.WithLabelValues(node, opName, backupName, pvbName).Set(seconds)
+		g.WithLabelValues(m.srcCluster, node, opName, backupName, pvbName).Set(seconds)
 	}
 }
 
 // SetBackupTarballSizeBytesGauge records the size, in bytes, of a backup tarball.
 func (m *ServerMetrics) SetBackupTarballSizeBytesGauge(backupSchedule string, size int64) {
 	if g, ok := m.metrics[backupTarballSizeBytesGauge].(*prometheus.GaugeVec); ok {
-		g.WithLabelValues(backupSchedule).Set(float64(size))
+		g.WithLabelValues(m.srcCluster, backupSchedule).Set(float64(size))
 	}
 }
 
 // SetBackupLastSuccessfulTimestamp records the last time a backup ran successfully, Unix timestamp in seconds
 func (m *ServerMetrics) SetBackupLastSuccessfulTimestamp(backupSchedule string, time time.Time) {
 	if g, ok := m.metrics[backupLastSuccessfulTimestamp].(*prometheus.GaugeVec); ok {
-		g.WithLabelValues(backupSchedule).Set(float64(time.Unix()))
+		g.WithLabelValues(m.srcCluster, backupSchedule).Set(float64(time.Unix()))
 	}
 }
 
 // SetBackupTotal records the current number of existent backups.
 func (m *ServerMetrics) SetBackupTotal(numberOfBackups int64) {
-	if g, ok := m.metrics[backupTotal].(prometheus.Gauge); ok {
-		g.Set(float64(numberOfBackups))
+	if g, ok := m.metrics[backupTotal].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.srcCluster).Set(float64(numberOfBackups))
 	}
 }
 
 // RegisterBackupAttempt records an backup attempt.
 func (m *ServerMetrics) RegisterBackupAttempt(backupSchedule string) {
 	if c, ok := m.metrics[backupAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupSuccess records a successful completion of a backup.
 func (m *ServerMetrics) RegisterBackupSuccess(backupSchedule string) {
 	if c, ok := m.metrics[backupSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 	m.SetBackupLastSuccessfulTimestamp(backupSchedule, time.Now())
 }
@@ -440,49 +586,49 @@ func (m *ServerMetrics) RegisterBackupSuccess(backupSchedule string) {
 // RegisterBackupPartialFailure records a partially failed backup.
 func (m *ServerMetrics) RegisterBackupPartialFailure(backupSchedule string) {
 	if c, ok := m.metrics[backupPartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupFailed records a failed backup.
 func (m *ServerMetrics) RegisterBackupFailed(backupSchedule string) {
 	if c, ok := m.metrics[backupFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupValidationFailure records a validation failed backup.
 func (m *ServerMetrics) RegisterBackupValidationFailure(backupSchedule string) {
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupDuration records the number of seconds a backup took.
 func (m *ServerMetrics) RegisterBackupDuration(backupSchedule string, seconds float64) {
 	if c, ok := m.metrics[backupDurationSeconds].(*prometheus.HistogramVec); ok {
-		c.WithLabelValues(backupSchedule).Observe(seconds)
+		c.WithLabelValues(m.srcCluster, backupSchedule).Observe(seconds)
 	}
 }
 
 // RegisterBackupDeletionAttempt records the number of attempted backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionAttempt(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupDeletionFailed records the number of failed backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionFailed(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterBackupDeletionSuccess records the number of successful backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionSuccess(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.srcCluster, backupSchedule).Inc()
 	}
 }
 
@@ -494,63 +640,222 @@ func toSeconds(d time.Duration) float64 {
 
 // SetRestoreTotal records the current number of existent restores.
 func (m *ServerMetrics) SetRestoreTotal(numberOfRestores int64) {
-	if g, ok := m.metrics[restoreTotal].(prometheus.Gauge); ok {
-		g.Set(float64(numberOfRestores))
+	if g, ok := m.metrics[restoreTotal].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.destCluster).Set(float64(numberOfRestores))
 	}
 }
 
 // RegisterRestoreAttempt records an attempt to restore a backup.
 func (m *ServerMetrics) RegisterRestoreAttempt(backupSchedule string) {
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.destCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterRestoreSuccess records a successful (maybe partial) completion of a restore.
 func (m *ServerMetrics) RegisterRestoreSuccess(backupSchedule string) {
 	if c, ok := m.metrics[restoreSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.destCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterRestorePartialFailure records a restore that partially failed.
 func (m *ServerMetrics) RegisterRestorePartialFailure(backupSchedule string) {
 	if c, ok := m.metrics[restorePartialFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.destCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterRestoreFailed records a restore that failed.
 func (m *ServerMetrics) RegisterRestoreFailed(backupSchedule string) {
 	if c, ok := m.metrics[restoreFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.destCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterRestoreValidationFailed records a restore that failed validation.
 func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Inc()
+		c.WithLabelValues(m.destCluster, backupSchedule).Inc()
 	}
 }
 
 // RegisterVolumeSnapshotAttempts records an attempt to snapshot a volume.
 func (m *ServerMetrics) RegisterVolumeSnapshotAttempts(backupSchedule string, volumeSnapshotsAttempted int) {
 	if c, ok := m.metrics[volumeSnapshotAttemptTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsAttempted))
+		c.WithLabelValues(m.srcCluster, backupSchedule).Add(float64(volumeSnapshotsAttempted))
 	}
 }
 
 // RegisterVolumeSnapshotSuccesses records a completed volume snapshot.
 func (m *ServerMetrics) RegisterVolumeSnapshotSuccesses(backupSchedule string, volumeSnapshotsCompleted int) {
 	if c, ok := m.metrics[volumeSnapshotSuccessTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsCompleted))
+		c.WithLabelValues(m.srcCluster, backupSchedule).Add(float64(volumeSnapshotsCompleted))
 	}
 }
 
 // RegisterVolumeSnapshotFailures records a failed volume snapshot.
 func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, volumeSnapshotsFailed int) {
 	if c, ok := m.metrics[volumeSnapshotFailureTotal].(*prometheus.CounterVec); ok {
-		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
+		c.WithLabelValues(m.srcCluster, backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// SetBackupItemsTotal records the number of items backed up by a backup.
+func (m *ServerMetrics) SetBackupItemsTotal(backupSchedule, backupName string, items int) {
+	if g, ok := m.metrics[backupItemsTotal].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.srcCluster, backupSchedule, backupName).Set(float64(items))
+	}
+}
+
+// SetBackupResourceItemsTotal records the number of items of a resource type backed up by a backup.
+func (m *ServerMetrics) SetBackupResourceItemsTotal(backupSchedule, backupName, resource string, items int) {
+	if g, ok := m.metrics[backupResourceItemsTotal].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.srcCluster, backupSchedule, backupName, resource).Set(float64(items))
+	}
+}
+
+// SetBackupUploadedBytes records the size, in bytes, of the contents uploaded to object storage by a backup.
+func (m *ServerMetrics) SetBackupUploadedBytes(backupSchedule, backupName string, size int64) {
+	if g, ok := m.metrics[backupUploadedBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.srcCluster, backupSchedule, backupName).Set(float64(size))
+	}
+}
+
+// DeleteBackupMetrics deletes the per-backup metrics of a backup, once the backup is deleted.
+func (m *ServerMetrics) DeleteBackupMetrics(backupName string) {
+	for _, name := range []string{backupItemsTotal, backupResourceItemsTotal, backupUploadedBytes} {
+		if g, ok := m.metrics[name].(*prometheus.GaugeVec); ok {
+			deleteMatchingSeries(g, backupNameLabel, backupName)
+		}
+	}
+}
+
+// ResetBackupStorageLocationUsage clears the recorded usage of every backup storage location.
+func (m *ServerMetrics) ResetBackupStorageLocationUsage() {
+	for _, name := range []string{backupStorageLocationBytes, backupStorageLocationBackups} {
+		if g, ok := m.metrics[name].(*prometheus.GaugeVec); ok {
+			g.Reset()
+		}
+	}
+}
+
+// SetBackupStorageLocationUsage records the number of backups of a schedule stored in a backup
+// storage location, and the space, in bytes, they take up. clusterHost is the host of the cluster
+// the backups were taken from, which is empty for the cluster Velero runs in.
+func (m *ServerMetrics) SetBackupStorageLocationUsage(clusterHost, location, backupSchedule string, backups int, bytes int64) {
+	cluster := clusterLabelValue(clusterHost)
+	if g, ok := m.metrics[backupStorageLocationBackups].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(cluster, location, backupSchedule).Set(float64(backups))
+	}
+	if g, ok := m.metrics[backupStorageLocationBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(cluster, location, backupSchedule).Set(float64(bytes))
+	}
+}
+
+// SetRestoreItemsTotal records the number of items restored by a restore.
+func (m *ServerMetrics) SetRestoreItemsTotal(backupSchedule, restoreName string, items int) {
+	if g, ok := m.metrics[restoreItemsTotal].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(m.destCluster, backupSchedule, restoreName).Set(float64(items))
+	}
+}
+
+// DeleteRestoreMetrics deletes the per-restore metrics of a restore, once the restore is deleted.
+func (m *ServerMetrics) DeleteRestoreMetrics(restoreName string) {
+	if g, ok := m.metrics[restoreItemsTotal].(*prometheus.GaugeVec); ok {
+		deleteMatchingSeries(g, restoreNameLabel, restoreName)
+	}
+}
+
+// deleteMatchingSeries deletes every series of a gauge whose label has the given value,
+// whatever the values of its other labels.
+func deleteMatchingSeries(g *prometheus.GaugeVec, label, value string) {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		g.Collect(ch)
+		close(ch)
+	}()
+
+	// the series are deleted once they're all collected, since Collect holds the
+	// gauge's lock
+	var matches []prometheus.Labels
+	for metric := range ch {
+		series := new(dto.Metric)
+		if err := metric.Write(series); err != nil {
+			continue
+		}
+		labels := prometheus.Labels{}
+		for _, pair := range series.GetLabel() {
+			labels[pair.GetName()] = pair.GetValue()
+		}
+		if labels[label] == value {
+			matches = append(matches, labels)
+		}
+	}
+
+	for _, labels := range matches {
+		g.Delete(labels)
+	}
+}
+
+// ObserveBackupHookDuration records the number of seconds a backup exec hook took.
+func (m *ServerMetrics) ObserveBackupHookDuration(seconds float64, failed bool) {
+	m.observeHookDuration(m.srcCluster, hookOperationBackup, seconds, failed)
+}
+
+// ObserveRestoreHookDuration records the number of seconds a restore exec hook took.
+func (m *ServerMetrics) ObserveRestoreHookDuration(seconds float64, failed bool) {
+	m.observeHookDuration(m.destCluster, hookOperationRestore, seconds, failed)
+}
+
+func (m *ServerMetrics) observeHookDuration(cluster, operation string, seconds float64, failed bool) {
+	result := hookResultSucceeded
+	if failed {
+		result = hookResultFailed
+	}
+	if h, ok := m.metrics[hookDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(cluster, operation, result).Observe(seconds)
+	}
+}
+
+// RegisterPluginProcessCrash records that the process of the plugin executable command
+// exited or failed a health check.
+func (m *ServerMetrics) RegisterPluginProcessCrash(command string) {
+	if c, ok := m.metrics[pluginProcessCrashesTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(command).Inc()
+	}
+}
+
+// RegisterPluginProcessRestart records that the process of the plugin executable command
+// was restarted.
+func (m *ServerMetrics) RegisterPluginProcessRestart(command string) {
+	if c, ok := m.metrics[pluginProcessRestartsTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(command).Inc()
+	}
+}
+
+// InstrumentClientConfig makes the clients created from config record the latency
+// of their requests to the API server of the cluster with the given host.
+func (m *ServerMetrics) InstrumentClientConfig(config *rest.Config, host string) {
+	h, ok := m.metrics[clusterAPIRequestSeconds].(*prometheus.HistogramVec)
+	if !ok {
+		return
+	}
+
+	cluster := clusterLabelValue(host)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			defer func() {
+				h.WithLabelValues(cluster, req.Method).Observe(time.Since(start).Seconds())
+			}()
+			return rt.RoundTrip(req)
+		})
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2018 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBackupAndRestoreMetrics(t *testing.T) {
+	m := NewServerMetrics("", "")
+
+	for _, backup := range []string{"backup-1", "backup-2"} {
+		m.SetBackupItemsTotal("schedule-1", backup, 3)
+		m.SetBackupUploadedBytes("schedule-1", backup, 1024)
+		m.SetBackupResourceItemsTotal("schedule-1", backup, "pods", 2)
+		m.SetBackupResourceItemsTotal("schedule-1", backup, "configmaps", 1)
+	}
+	m.SetRestoreItemsTotal("schedule-1", "restore-1", 3)
+	m.SetRestoreItemsTotal("schedule-1", "restore-2", 3)
+
+	m.DeleteBackupMetrics("backup-1")
+	m.DeleteRestoreMetrics("restore-1")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.metrics[backupItemsTotal]))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.metrics[backupUploadedBytes]))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.metrics[backupResourceItemsTotal]))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.metrics[restoreItemsTotal]))
+	assert.Equal(t, float64(3), testutil.ToFloat64(m.metrics[restoreItemsTotal]))
+}
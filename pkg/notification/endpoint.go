@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ConfigLabel is the label that identifies ConfigMaps containing notification
+// endpoint configuration. Each data key in such a ConfigMap is the name of an
+// endpoint and its value is the endpoint's configuration, in YAML or JSON.
+const ConfigLabel = "velero.io/notification-config"
+
+const (
+	defaultMaxRetries = 5
+	defaultTimeout    = 10 * time.Second
+)
+
+// Format is the payload format used when sending events to an endpoint.
+type Format string
+
+const (
+	// FormatJSON sends the Event as a plain JSON document.
+	FormatJSON Format = "json"
+
+	// FormatCloudEvents sends the Event as a CloudEvents 1.0 event in
+	// structured content mode.
+	FormatCloudEvents Format = "cloudevents"
+)
+
+// EndpointConfig is the user-provided configuration of a notification endpoint.
+type EndpointConfig struct {
+	// URL is where events are POSTed.
+	URL string `json:"url"`
+
+	// Format is the payload format, either json (the default) or cloudevents.
+	Format Format `json:"format,omitempty"`
+
+	// SigningSecret, if set, references a key of a secret in the Velero
+	// namespace that is used to sign payloads with HMAC-SHA256.
+	SigningSecret *corev1api.SecretKeySelector `json:"signingSecret,omitempty"`
+
+	// Kinds, if set, limits the endpoint to events about these kinds of objects.
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Reasons, if set, limits the endpoint to events with these reasons.
+	Reasons []Reason `json:"reasons,omitempty"`
+
+	// Phases, if set, limits the endpoint to events about objects in these phases.
+	Phases []string `json:"phases,omitempty"`
+
+	// LabelSelector, if set, limits the endpoint to events about objects
+	// whose labels match the selector.
+	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Clusters, if set, limits the endpoint to events about these clusters.
+	// Use an empty string for the cluster Velero runs in.
+	Clusters []string `json:"clusters,omitempty"`
+
+	// MaxRetries is how many times delivery of an event is retried.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// Timeout is the timeout of each delivery attempt.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// Endpoint is a validated notification endpoint, ready to have events sent to it.
+type Endpoint struct {
+	Name       string
+	URL        string
+	Format     Format
+	SigningKey []byte
+	MaxRetries int
+	Timeout    time.Duration
+
+	kinds    []string
+	reasons  []Reason
+	phases   []string
+	selector labels.Selector
+	clusters []string
+}
+
+// ParseEndpointConfig parses the YAML or JSON configuration of an endpoint.
+func ParseEndpointConfig(data string) (*EndpointConfig, error) {
+	config := new(EndpointConfig)
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(data), len(data)).Decode(config); err != nil {
+		return nil, errors.Wrap(err, "error decoding endpoint configuration")
+	}
+	return config, nil
+}
+
+// NewEndpoint validates the endpoint configuration and returns an Endpoint. signingKey is the
+// value of the configuration's signing secret, if it has one.
+func NewEndpoint(name string, config *EndpointConfig, signingKey []byte) (*Endpoint, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url for endpoint %s", name)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("url for endpoint %s must be http or https", name)
+	}
+
+	endpoint := &Endpoint{
+		Name:       name,
+		URL:        config.URL,
+		Format:     config.Format,
+		SigningKey: signingKey,
+		MaxRetries: defaultMaxRetries,
+		Timeout:    defaultTimeout,
+		kinds:      config.Kinds,
+		reasons:    config.Reasons,
+		phases:     config.Phases,
+		selector:   labels.Everything(),
+		clusters:   config.Clusters,
+	}
+
+	switch endpoint.Format {
+	case "":
+		endpoint.Format = FormatJSON
+	case FormatJSON, FormatCloudEvents:
+	default:
+		return nil, errors.Errorf("invalid format %q for endpoint %s, must be %s or %s", config.Format, name, FormatJSON, FormatCloudEvents)
+	}
+
+	if config.LabelSelector != "" {
+		if endpoint.selector, err = labels.Parse(config.LabelSelector); err != nil {
+			return nil, errors.Wrapf(err, "invalid label selector for endpoint %s", name)
+		}
+	}
+
+	if config.MaxRetries != nil {
+		if *config.MaxRetries < 0 {
+			return nil, errors.Errorf("maxRetries for endpoint %s must not be negative", name)
+		}
+		endpoint.MaxRetries = *config.MaxRetries
+	}
+
+	if config.Timeout != nil && config.Timeout.Duration > 0 {
+		endpoint.Timeout = config.Timeout.Duration
+	}
+
+	return endpoint, nil
+}
+
+// Matches returns true if the event passes the endpoint's filters.
+func (e *Endpoint) Matches(event Event) bool {
+	if len(e.kinds) > 0 && !contains(e.kinds, event.Kind) {
+		return false
+	}
+
+	if len(e.reasons) > 0 {
+		var found bool
+		for _, reason := range e.reasons {
+			if reason == event.Reason {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(e.phases) > 0 && !contains(e.phases, event.Phase) {
+		return false
+	}
+
+	if len(e.clusters) > 0 && !contains(e.clusters, event.Cluster) {
+		return false
+	}
+
+	return e.selector.Matches(labels.Set(event.Labels))
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"time"
+)
+
+// Reason describes why a notification Event was generated.
+type Reason string
+
+const (
+	// ReasonPhaseChanged means the object moved to a new phase.
+	ReasonPhaseChanged Reason = "PhaseChanged"
+
+	// ReasonValidationFailed means the object failed validation.
+	ReasonValidationFailed Reason = "ValidationFailed"
+
+	// ReasonDeletionFailed means a backup deletion request was processed
+	// but the backup or some of its data could not be deleted.
+	ReasonDeletionFailed Reason = "DeletionFailed"
+
+	// ReasonUnavailable means a backup storage location became unavailable.
+	ReasonUnavailable Reason = "Unavailable"
+)
+
+// Event is a lifecycle event of a Velero object that is sent to notification endpoints.
+type Event struct {
+	// Kind is the kind of the Velero object the event is about, e.g. Backup.
+	Kind string `json:"kind"`
+
+	// Reason is why the event was generated.
+	Reason Reason `json:"reason"`
+
+	// Namespace and Name identify the Velero object the event is about.
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	// Labels are the labels of the Velero object.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Phase is the current phase of the Velero object and PreviousPhase is
+	// the phase it was in before the event.
+	Phase         string `json:"phase,omitempty"`
+	PreviousPhase string `json:"previousPhase,omitempty"`
+
+	// Cluster is the host of the cluster being backed up or restored to.
+	// It's empty for the cluster Velero runs in and for objects that aren't
+	// tied to a cluster.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Errors are any error messages recorded on the Velero object.
+	Errors []string `json:"errors,omitempty"`
+
+	// Time is when the event was generated.
+	Time time.Time `json:"time"`
+}
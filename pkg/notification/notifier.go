@@ -0,0 +1,276 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// SignatureHeader is the HTTP header containing the HMAC-SHA256 signature of the
+	// request's timestamp and body, as "sha256=<hex digest>", when the endpoint has a
+	// signing secret. See Sign.
+	SignatureHeader = "X-Velero-Signature"
+
+	// TimestampHeader is the HTTP header containing when the request was sent, in Unix
+	// seconds, when the endpoint has a signing secret. It's covered by the signature, so
+	// receivers can reject replayed requests whose timestamp is too old.
+	TimestampHeader = "X-Velero-Timestamp"
+
+	// SignatureTolerance is how far from the current time the timestamp of a signed
+	// request should be for Verify to accept it. Each delivery attempt is signed anew, so
+	// retried requests have a recent timestamp too.
+	SignatureTolerance = 5 * time.Minute
+
+	// EventSource is the CloudEvents source of events sent by Velero.
+	EventSource = "velero.io"
+
+	queueSize = 1000
+)
+
+type delivery struct {
+	endpoint *Endpoint
+	event    Event
+}
+
+// Notifier sends events to notification endpoints. Events are queued and
+// delivered asynchronously by a pool of workers, so sending an event never
+// blocks the caller.
+type Notifier struct {
+	client *http.Client
+	logger logrus.FieldLogger
+	queue  chan delivery
+
+	// backoff is the backoff between delivery attempts. Its Steps field is
+	// set from each endpoint's MaxRetries.
+	backoff wait.Backoff
+
+	lock      sync.RWMutex
+	endpoints []*Endpoint
+}
+
+// NewNotifier returns a Notifier with no endpoints.
+func NewNotifier(logger logrus.FieldLogger) *Notifier {
+	return &Notifier{
+		client: &http.Client{},
+		logger: logger,
+		queue:  make(chan delivery, queueSize),
+		backoff: wait.Backoff{
+			Duration: time.Second,
+			Factor:   2,
+			Jitter:   0.1,
+			Cap:      time.Minute,
+		},
+	}
+}
+
+// SetEndpoints replaces the endpoints events are sent to.
+func (n *Notifier) SetEndpoints(endpoints []*Endpoint) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.endpoints = endpoints
+}
+
+// Notify queues the event for delivery to every endpoint whose filters it matches.
+// If the queue is full, the event is dropped for that endpoint and a warning is logged.
+func (n *Notifier) Notify(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	for _, endpoint := range n.endpoints {
+		if !endpoint.Matches(event) {
+			continue
+		}
+
+		select {
+		case n.queue <- delivery{endpoint: endpoint, event: event}:
+		default:
+			n.logger.WithFields(logrus.Fields{
+				"endpoint": endpoint.Name,
+				"kind":     event.Kind,
+				"name":     event.Name,
+				"reason":   event.Reason,
+			}).Warn("Notification queue is full, dropping event")
+		}
+	}
+}
+
+// Run starts the given number of delivery workers and blocks until ctx is done.
+func (n *Notifier) Run(ctx context.Context, workers int) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-n.queue:
+					n.deliver(ctx, d)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (n *Notifier) deliver(ctx context.Context, d delivery) {
+	log := n.logger.WithFields(logrus.Fields{
+		"endpoint": d.endpoint.Name,
+		"kind":     d.event.Kind,
+		"name":     d.event.Name,
+		"reason":   d.event.Reason,
+	})
+
+	body, contentType, err := encode(d.endpoint.Format, d.event)
+	if err != nil {
+		log.WithError(err).Error("Error encoding notification")
+		return
+	}
+
+	backoff := n.backoff
+	backoff.Steps = d.endpoint.MaxRetries
+	for attempt := 0; ; attempt++ {
+		retryable, err := n.send(ctx, d.endpoint, body, contentType)
+		if err == nil {
+			log.Debug("Notification delivered")
+			return
+		}
+
+		if !retryable || attempt >= d.endpoint.MaxRetries {
+			log.WithError(err).Errorf("Error delivering notification after %d attempt(s)", attempt+1)
+			return
+		}
+
+		log.WithError(err).Debug("Error delivering notification, retrying")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff.Step()):
+		}
+	}
+}
+
+// send makes a single delivery attempt. It returns whether a failed attempt may be retried.
+func (n *Notifier) send(ctx context.Context, endpoint *Endpoint, body []byte, contentType string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, endpoint.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", contentType)
+	if len(endpoint.SigningKey) > 0 {
+		timestamp := time.Now().Unix()
+		req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(SignatureHeader, Sign(endpoint.SigningKey, timestamp, body))
+	}
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return true, errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+
+	retryable := res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests
+	return retryable, errors.Errorf("endpoint returned %s", res.Status)
+}
+
+// Sign returns the value of the signature header for a request with the given timestamp, in
+// Unix seconds, and body. The signed payload is the timestamp, a period, and the body.
+func Sign(key []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a request with the given body, as
+// received at now. It returns an error if the signature doesn't match, or if the timestamp is
+// more than SignatureTolerance away from now, which means the request may be a replay.
+func Verify(key []byte, signature, timestamp string, body []byte, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid timestamp %q", timestamp)
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(key, seconds, body))) {
+		return errors.New("signature doesn't match")
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > SignatureTolerance || age < -SignatureTolerance {
+		return errors.Errorf("timestamp is %s away from the current time, more than %s", age, SignatureTolerance)
+	}
+	return nil
+}
+
+// cloudEvent is a CloudEvents 1.0 event in structured content mode.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            Event     `json:"data"`
+}
+
+func encode(format Format, event Event) ([]byte, string, error) {
+	if format != FormatCloudEvents {
+		body, err := json.Marshal(event)
+		return body, "application/json", errors.WithStack(err)
+	}
+
+	body, err := json.Marshal(cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              string(uuid.NewUUID()),
+		Source:          EventSource,
+		Type:            strings.ToLower(fmt.Sprintf("io.velero.%s.%s", event.Kind, event.Reason)),
+		Subject:         event.Namespace + "/" + event.Name,
+		Time:            event.Time,
+		DataContentType: "application/json",
+		Data:            event,
+	})
+	return body, "application/cloudevents+json", errors.WithStack(err)
+}
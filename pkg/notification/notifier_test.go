@@ -0,0 +1,330 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// testServer is an HTTP server that records the requests it receives and
+// responds with the queued status codes, then 200s.
+type testServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	statuses []int
+	requests []receivedRequest
+	received chan struct{}
+}
+
+func newTestServer(statuses ...int) *testServer {
+	s := &testServer{statuses: statuses, received: make(chan struct{}, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		s.lock.Lock()
+		s.requests = append(s.requests, receivedRequest{header: r.Header, body: body})
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		s.lock.Unlock()
+
+		w.WriteHeader(status)
+		s.received <- struct{}{}
+	}))
+	return s
+}
+
+func (s *testServer) waitForRequests(t *testing.T, n int) []receivedRequest {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-s.received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for request %d", i+1)
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]receivedRequest(nil), s.requests...)
+}
+
+func newTestNotifier(t *testing.T, endpoints ...*Endpoint) *Notifier {
+	n := NewNotifier(velerotest.NewLogger())
+	n.backoff.Duration = time.Millisecond
+	n.backoff.Jitter = 0
+	n.SetEndpoints(endpoints)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go n.Run(ctx, 1)
+
+	return n
+}
+
+func newTestEndpoint(t *testing.T, name, data string, signingKey []byte) *Endpoint {
+	config, err := ParseEndpointConfig(data)
+	require.NoError(t, err)
+	endpoint, err := NewEndpoint(name, config, signingKey)
+	require.NoError(t, err)
+	return endpoint
+}
+
+func testEvent() Event {
+	return Event{
+		Kind:          "Backup",
+		Reason:        ReasonPhaseChanged,
+		Namespace:     "velero",
+		Name:          "nightly",
+		Labels:        map[string]string{"velero.io/schedule-name": "nightly"},
+		Phase:         "Completed",
+		PreviousPhase: "InProgress",
+		Cluster:       "https://remote:6443",
+		Time:          time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestNotifierSendsSignedJSON(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	key := []byte("secret")
+	n := newTestNotifier(t, newTestEndpoint(t, "ep", "url: "+server.URL, key))
+	n.Notify(testEvent())
+
+	requests := server.waitForRequests(t, 1)
+	require.Len(t, requests, 1)
+
+	assert.Equal(t, "application/json", requests[0].header.Get("Content-Type"))
+	assert.NoError(t, Verify(key, requests[0].header.Get(SignatureHeader), requests[0].header.Get(TimestampHeader), requests[0].body, time.Now()))
+
+	var got Event
+	require.NoError(t, json.Unmarshal(requests[0].body, &got))
+	assert.Equal(t, testEvent(), got)
+}
+
+func TestVerify(t *testing.T) {
+	key := []byte("secret")
+	body := []byte(`{"kind":"Backup"}`)
+	sent := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	timestamp := strconv.FormatInt(sent.Unix(), 10)
+	signature := Sign(key, sent.Unix(), body)
+
+	assert.NoError(t, Verify(key, signature, timestamp, body, sent.Add(time.Minute)))
+	assert.NoError(t, Verify(key, signature, timestamp, body, sent.Add(-time.Minute)))
+
+	// a replayed request is rejected once its timestamp is too old
+	assert.EqualError(t, Verify(key, signature, timestamp, body, sent.Add(10*time.Minute)), "timestamp is 10m0s away from the current time, more than 5m0s")
+	// and its timestamp can't be changed without breaking the signature
+	assert.EqualError(t, Verify(key, signature, strconv.FormatInt(sent.Add(10*time.Minute).Unix(), 10), body, sent.Add(10*time.Minute)), "signature doesn't match")
+
+	assert.EqualError(t, Verify([]byte("other"), signature, timestamp, body, sent), "signature doesn't match")
+	assert.EqualError(t, Verify(key, signature, timestamp, []byte(`{"kind":"Restore"}`), sent), "signature doesn't match")
+	assert.EqualError(t, Verify(key, signature, "", body, sent), `invalid timestamp ""`)
+}
+
+func TestNotifierSendsCloudEvents(t *testing.T) {
+	server := newTestServer()
+	defer server.Close()
+
+	n := newTestNotifier(t, newTestEndpoint(t, "ep", `{"url": "`+server.URL+`", "format": "cloudevents"}`, nil))
+	n.Notify(testEvent())
+
+	requests := server.waitForRequests(t, 1)
+	require.Len(t, requests, 1)
+
+	assert.Equal(t, "application/cloudevents+json", requests[0].header.Get("Content-Type"))
+	assert.Empty(t, requests[0].header.Get(SignatureHeader))
+	assert.Empty(t, requests[0].header.Get(TimestampHeader))
+
+	var got cloudEvent
+	require.NoError(t, json.Unmarshal(requests[0].body, &got))
+	assert.Equal(t, "1.0", got.SpecVersion)
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, EventSource, got.Source)
+	assert.Equal(t, "io.velero.backup.phasechanged", got.Type)
+	assert.Equal(t, "velero/nightly", got.Subject)
+	assert.Equal(t, testEvent(), got.Data)
+}
+
+func TestNotifierRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		maxRetries   string
+		wantRequests int
+	}{
+		{
+			name:         "server errors are retried until success",
+			statuses:     []int{http.StatusInternalServerError, http.StatusTooManyRequests},
+			maxRetries:   "5",
+			wantRequests: 3,
+		},
+		{
+			name:         "retries stop after maxRetries",
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:   "2",
+			wantRequests: 3,
+		},
+		{
+			name:         "client errors are not retried",
+			statuses:     []int{http.StatusBadRequest},
+			maxRetries:   "5",
+			wantRequests: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(tc.statuses...)
+			defer server.Close()
+
+			n := newTestNotifier(t, newTestEndpoint(t, "ep", "url: "+server.URL+"\nmaxRetries: "+tc.maxRetries, nil))
+			n.Notify(testEvent())
+
+			server.waitForRequests(t, tc.wantRequests)
+
+			// make sure no further attempts are made
+			select {
+			case <-server.received:
+				t.Fatal("unexpected extra request")
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestEndpointMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   bool
+	}{
+		{
+			name:   "no filters matches everything",
+			config: "url: http://example.com",
+			want:   true,
+		},
+		{
+			name:   "matching kind, phase, reason, cluster and labels",
+			config: "url: http://example.com\nkinds: [Backup]\nphases: [Completed, Failed]\nreasons: [PhaseChanged]\nclusters: ['https://remote:6443']\nlabelSelector: velero.io/schedule-name=nightly",
+			want:   true,
+		},
+		{
+			name:   "non-matching kind",
+			config: "url: http://example.com\nkinds: [Restore]",
+			want:   false,
+		},
+		{
+			name:   "non-matching phase",
+			config: "url: http://example.com\nphases: [Failed]",
+			want:   false,
+		},
+		{
+			name:   "non-matching reason",
+			config: "url: http://example.com\nreasons: [Unavailable]",
+			want:   false,
+		},
+		{
+			name:   "non-matching cluster",
+			config: "url: http://example.com\nclusters: ['']",
+			want:   false,
+		},
+		{
+			name:   "non-matching label selector",
+			config: "url: http://example.com\nlabelSelector: velero.io/schedule-name!=nightly",
+			want:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoint := newTestEndpoint(t, "ep", tc.config, nil)
+			assert.Equal(t, tc.want, endpoint.Matches(testEvent()))
+		})
+	}
+}
+
+func TestNewEndpointValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name:   "defaults are applied",
+			config: "url: https://example.com",
+		},
+		{
+			name:    "non-http url",
+			config:  "url: ftp://example.com",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			config:  "url: https://example.com\nformat: xml",
+			wantErr: true,
+		},
+		{
+			name:    "invalid label selector",
+			config:  "url: https://example.com\nlabelSelector: '!!'",
+			wantErr: true,
+		},
+		{
+			name:    "negative maxRetries",
+			config:  "url: https://example.com\nmaxRetries: -1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := ParseEndpointConfig(tc.config)
+			require.NoError(t, err)
+
+			endpoint, err := NewEndpoint("ep", config, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, FormatJSON, endpoint.Format)
+			assert.Equal(t, defaultMaxRetries, endpoint.MaxRetries)
+			assert.Equal(t, defaultTimeout, endpoint.Timeout)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SigningKeyEnvVar is the environment variable holding the key signing download
+	// URLs. The Velero server sets it when it starts the download endpoint, and its
+	// plugin processes inherit it.
+	SigningKeyEnvVar = "VELERO_FILESYSTEM_SIGNING_KEY"
+
+	// DownloadPath is the path of the download endpoint.
+	DownloadPath = "/filesystem/download"
+
+	bucketParam    = "bucket"
+	keyParam       = "key"
+	expiresParam   = "expires"
+	signatureParam = "signature"
+)
+
+// EnsureSigningKey returns the key signing download URLs, generating a random one
+// and setting it in the environment if it's not already set.
+func EnsureSigningKey() ([]byte, error) {
+	if key := os.Getenv(SigningKeyEnvVar); key != "" {
+		return []byte(key), nil
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, errors.Wrap(err, "error generating download signing key")
+	}
+	key := hex.EncodeToString(random)
+	if err := os.Setenv(SigningKeyEnvVar, key); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return []byte(key), nil
+}
+
+func signature(key []byte, bucket, objectKey string, expires int64) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(bucket + "\n" + objectKey + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signedURL returns the URL of the download endpoint at baseURL serving the object
+// with the given bucket and key until expires.
+func signedURL(baseURL string, key []byte, bucket, objectKey string, expires time.Time) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid %s %q", downloadURLConfigKey, baseURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.Errorf("invalid %s %q, the scheme must be http or https", downloadURLConfigKey, baseURL)
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + DownloadPath
+	u.RawQuery = url.Values{
+		bucketParam:    []string{bucket},
+		keyParam:       []string{objectKey},
+		expiresParam:   []string{strconv.FormatInt(expires.Unix(), 10)},
+		signatureParam: []string{signature(key, bucket, objectKey, expires.Unix())},
+	}.Encode()
+
+	return u.String(), nil
+}
+
+type downloadHandler struct {
+	key   []byte
+	store *ObjectStore
+	log   logrus.FieldLogger
+	clock func() time.Time
+}
+
+// NewDownloadHandler returns the handler of the download endpoint, which serves the
+// objects of the URLs signed with key until they expire. Objects are only served from
+// the buckets under root.
+func NewDownloadHandler(key []byte, root string, log logrus.FieldLogger) http.Handler {
+	return &downloadHandler{key: key, store: &ObjectStore{log: log, root: root}, log: log, clock: time.Now}
+}
+
+func (h *downloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	bucket, objectKey := query.Get(bucketParam), query.Get(keyParam)
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if bucket == "" || objectKey == "" || err != nil {
+		http.Error(w, "invalid download URL", http.StatusBadRequest)
+		return
+	}
+
+	if !hmac.Equal([]byte(query.Get(signatureParam)), []byte(signature(h.key, bucket, objectKey, expires))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if h.clock().Unix() > expires {
+		http.Error(w, "download URL has expired", http.StatusForbidden)
+		return
+	}
+
+	file, err := h.store.objectPath(bucket, objectKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	file, err = resolveWithin(h.store.root, file)
+	if err == errOutsideRoot {
+		http.Error(w, "invalid object key", http.StatusForbidden)
+		return
+	}
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		h.log.WithError(err).WithField("file", file).Error("Error resolving file to download")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		h.log.WithError(errors.WithStack(err)).WithField("file", file).Error("Error opening file to download")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.ServeContent(w, r, filepath.Base(file), info.ModTime(), f)
+}
+
+var errOutsideRoot = errors.New("file is outside of the root directory")
+
+// resolveWithin returns file with its symbolic links resolved, since the object's file,
+// or a directory above it, may be a link pointing out of the root directory. It returns
+// errOutsideRoot if the resolved file isn't within root.
+func resolveWithin(root, file string) (string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	resolved, err := filepath.EvalSymlinks(file)
+	if err != nil {
+		// not wrapped, so it can be checked with os.IsNotExist
+		return "", err
+	}
+
+	rel, err := filepath.Rel(resolvedRoot, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errOutsideRoot
+	}
+	return resolved, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestEnsureSigningKey(t *testing.T) {
+	os.Unsetenv(SigningKeyEnvVar)
+	defer os.Unsetenv(SigningKeyEnvVar)
+
+	key, err := EnsureSigningKey()
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+	assert.Equal(t, string(key), os.Getenv(SigningKeyEnvVar))
+
+	again, err := EnsureSigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+}
+
+func TestDownloadHandler(t *testing.T) {
+	o, root := newTestObjectStore(t)
+	require.NoError(t, o.PutObject("bucket", "backups/backup-1/backup-1.tar.gz", strings.NewReader("contents")))
+
+	key := []byte("key")
+	now := time.Now()
+	handler := NewDownloadHandler(key, root, velerotest.NewLogger()).(*downloadHandler)
+	handler.clock = func() time.Time { return now }
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	get := func(u string) (int, string) {
+		res, err := http.Get(u)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	signed, err := signedURL(server.URL, key, "bucket", "backups/backup-1/backup-1.tar.gz", now.Add(time.Minute))
+	require.NoError(t, err)
+	status, body := get(signed)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "contents", body)
+
+	// the signature covers the bucket and key, so it can't be used to download another object
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	query := u.Query()
+	query.Set(keyParam, "backups/backup-1/velero-backup.json")
+	u.RawQuery = query.Encode()
+	status, _ = get(u.String())
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// a URL signed with another key is rejected
+	other, err := signedURL(server.URL, []byte("other"), "bucket", "backups/backup-1/backup-1.tar.gz", now.Add(time.Minute))
+	require.NoError(t, err)
+	status, _ = get(other)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	expired, err := signedURL(server.URL, key, "bucket", "backups/backup-1/backup-1.tar.gz", now.Add(-time.Second))
+	require.NoError(t, err)
+	status, _ = get(expired)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	missing, err := signedURL(server.URL, key, "bucket", "missing", now.Add(time.Minute))
+	require.NoError(t, err)
+	status, _ = get(missing)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, _ = get(server.URL + DownloadPath)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestDownloadHandlerOnlyServesObjectsUnderRoot(t *testing.T) {
+	_, root := newTestObjectStore(t)
+
+	outside, err := ioutil.TempFile("", "velero-filesystem-outside-")
+	require.NoError(t, err)
+	defer os.Remove(outside.Name())
+	_, err = outside.WriteString("secret")
+	require.NoError(t, err)
+	require.NoError(t, outside.Close())
+	require.NoError(t, os.Symlink(outside.Name(), filepath.Join(root, "bucket", "link")))
+
+	key := []byte("key")
+	server := httptest.NewServer(NewDownloadHandler(key, root, velerotest.NewLogger()))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		bucket, key string
+		want        int
+	}{
+		{bucket: "bucket", key: outside.Name(), want: http.StatusBadRequest},
+		{bucket: "bucket", key: "../../" + filepath.Base(outside.Name()), want: http.StatusBadRequest},
+		{bucket: "..", key: filepath.Base(outside.Name()), want: http.StatusBadRequest},
+		{bucket: "bucket", key: "link", want: http.StatusForbidden},
+	} {
+		signed, err := signedURL(server.URL, key, tc.bucket, tc.key, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		res, err := http.Get(signed)
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, tc.want, res.StatusCode, "bucket %q, key %q", tc.bucket, tc.key)
+	}
+}
+
+func TestSignedURLRequiresHTTP(t *testing.T) {
+	_, err := signedURL("ftp://velero.example.com", []byte("key"), "bucket", "key", time.Now())
+	assert.Error(t, err)
+}
@@ -0,0 +1,305 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package filesystem implements the "filesystem" object store provider, which stores
+// objects as files under a directory of the Velero server's filesystem, typically a
+// mounted NFS share or persistent volume. Each bucket is a subdirectory of the root
+// directory and each object key is a path within its bucket.
+package filesystem
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+const (
+	// ProviderName is the name of the provider in backup storage locations.
+	ProviderName = "filesystem"
+
+	// DefaultRoot is the directory objects are stored under if the root config
+	// key isn't set. It's where `velero install` mounts the backup volume.
+	DefaultRoot = "/velero-backups"
+
+	rootConfigKey        = "root"
+	downloadURLConfigKey = "downloadURL"
+	bucketConfigKey      = "bucket"
+
+	// tempFilePrefix is the prefix of the files objects are written to before
+	// they're renamed into place. These files are never listed.
+	tempFilePrefix = ".velero-upload-"
+)
+
+// ObjectStore is a velero.ObjectStore storing objects as files.
+type ObjectStore struct {
+	log         logrus.FieldLogger
+	root        string
+	downloadURL string
+}
+
+// NewObjectStore returns a new, uninitialized ObjectStore.
+func NewObjectStore(log logrus.FieldLogger) *ObjectStore {
+	return &ObjectStore{log: log}
+}
+
+// Init initializes the object store from the backup storage location's config. The
+// bucket's directory is created if it doesn't exist.
+func (o *ObjectStore) Init(config map[string]string) error {
+	if err := framework.ValidateObjectStoreConfigKeys(config, rootConfigKey, downloadURLConfigKey); err != nil {
+		return err
+	}
+
+	o.root = config[rootConfigKey]
+	if o.root == "" {
+		o.root = DefaultRoot
+	}
+	if !filepath.IsAbs(o.root) {
+		return errors.Errorf("%s %q must be an absolute path", rootConfigKey, o.root)
+	}
+	o.downloadURL = config[downloadURLConfigKey]
+
+	if bucket := config[bucketConfigKey]; bucket != "" {
+		dir, err := o.bucketDir(bucket)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrapf(err, "error creating directory for bucket %q", bucket)
+		}
+	}
+
+	return nil
+}
+
+// bucketDir returns the directory of bucket.
+func (o *ObjectStore) bucketDir(bucket string) (string, error) {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", errors.Errorf("invalid bucket name %q", bucket)
+	}
+	return filepath.Join(o.root, bucket), nil
+}
+
+// objectPath returns the path of the file storing the object with the given key.
+func (o *ObjectStore) objectPath(bucket, key string) (string, error) {
+	dir, err := o.bucketDir(bucket)
+	if err != nil {
+		return "", err
+	}
+
+	if key == "" || !validPath(key) {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+	if strings.HasPrefix(path.Base(key), tempFilePrefix) {
+		return "", errors.Errorf("invalid object key %q, object names can't start with %q", key, tempFilePrefix)
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(key)), nil
+}
+
+// validPath returns whether p is a relative path within a bucket, without empty, "."
+// or ".." elements.
+func validPath(p string) bool {
+	return !path.IsAbs(p) && path.Clean(p) == p && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+// PutObject writes body to a temporary file that is then renamed into place, so
+// readers never see a partially written object.
+func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	tmp, err := ioutil.TempFile(dir, tempFilePrefix)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "error writing object %q", key)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(tmp.Name(), file))
+}
+
+func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return info.Mode().IsRegular(), nil
+}
+
+func (o *ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return f, nil
+}
+
+func (o *ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefixes []string
+	seen := map[string]bool{}
+	for _, key := range keys {
+		rest := strings.TrimPrefix(key, prefix)
+		i := strings.Index(rest, delimiter)
+		if i < 0 {
+			continue
+		}
+
+		commonPrefix := prefix + rest[:i+len(delimiter)]
+		if !seen[commonPrefix] {
+			seen[commonPrefix] = true
+			prefixes = append(prefixes, commonPrefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+// ListObjects returns the keys starting with prefix, in lexical order. Only the
+// directory holding the prefix is walked.
+func (o *ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	dir, err := o.bucketDir(bucket)
+	if err != nil {
+		return nil, err
+	}
+	// a prefix ends with "/" when it's a directory
+	if p := strings.TrimSuffix(prefix, "/"); p != "" && !validPath(p) {
+		return nil, errors.Errorf("invalid prefix %q", prefix)
+	}
+
+	if _, err := os.Stat(dir); err != nil {
+		return nil, errors.Wrapf(err, "error reading bucket %q", bucket)
+	}
+
+	start := dir
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		start = filepath.Join(dir, filepath.FromSlash(path.Clean(prefix[:i])))
+	}
+
+	var keys []string
+	err = filepath.Walk(start, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.Mode().IsRegular() || strings.HasPrefix(info.Name(), tempFilePrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return keys, nil
+}
+
+// DeleteObject removes the object's file, and the directories that are left empty,
+// up to the bucket's directory. Deleting an object that doesn't exist isn't an error.
+func (o *ObjectStore) DeleteObject(bucket, key string) error {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	bucketDir, _ := o.bucketDir(bucket)
+	for dir := filepath.Dir(file); dir != bucketDir && strings.HasPrefix(dir, bucketDir); dir = filepath.Dir(dir) {
+		// fails, ending the loop, once a directory isn't empty
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// CreateSignedURL returns a URL of the Velero server's download endpoint, configured
+// by the downloadURL config key, that serves the object until ttl has elapsed.
+func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	if o.downloadURL == "" {
+		return "", errors.Errorf("the %s config key of the backup storage location must be set to download objects", downloadURLConfigKey)
+	}
+
+	if _, err := o.objectPath(bucket, key); err != nil {
+		return "", err
+	}
+
+	signingKey := os.Getenv(SigningKeyEnvVar)
+	if signingKey == "" {
+		return "", errors.New("the Velero server's filesystem download endpoint isn't enabled, set the server's --filesystem-download-address flag")
+	}
+
+	return signedURL(o.downloadURL, []byte(signingKey), bucket, key, time.Now().Add(ttl))
+}
@@ -0,0 +1,181 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestObjectStore(t *testing.T) (*ObjectStore, string) {
+	t.Helper()
+
+	root, err := ioutil.TempDir("", "velero-filesystem-")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(map[string]string{"root": root, "bucket": "bucket", "prefix": "velero"}))
+
+	return o, root
+}
+
+func TestInit(t *testing.T) {
+	o, root := newTestObjectStore(t)
+
+	info, err := os.Stat(filepath.Join(root, "bucket"))
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+	assert.Equal(t, root, o.root)
+
+	assert.Error(t, o.Init(map[string]string{"root": "relative/path"}))
+	assert.Error(t, o.Init(map[string]string{"root": root, "region": "us-east-1"}))
+	assert.Error(t, o.Init(map[string]string{"root": root, "bucket": "../escape"}))
+}
+
+func TestPutGetObject(t *testing.T) {
+	o, root := newTestObjectStore(t)
+
+	require.NoError(t, o.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("metadata")))
+
+	exists, err := o.ObjectExists("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	rc, err := o.GetObject("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	rc.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "metadata", string(data))
+
+	// overwriting replaces the content, and leaves no temporary file behind
+	require.NoError(t, o.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("updated")))
+	data, err = ioutil.ReadFile(filepath.Join(root, "bucket", "backups", "backup-1", "velero-backup.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "updated", string(data))
+	files, err := ioutil.ReadDir(filepath.Join(root, "bucket", "backups", "backup-1"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	exists, err = o.ObjectExists("bucket", "backups/backup-2/velero-backup.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = o.GetObject("bucket", "backups/backup-2/velero-backup.json")
+	assert.Error(t, err)
+}
+
+func TestInvalidKeys(t *testing.T) {
+	o, _ := newTestObjectStore(t)
+
+	for _, key := range []string{"", "/etc/passwd", "../other-bucket/key", "backups/../../key", "backups//key", "backups/", tempFilePrefix + "key"} {
+		assert.Error(t, o.PutObject("bucket", key, strings.NewReader("data")), key)
+		_, err := o.ObjectExists("bucket", key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestListObjectsAndCommonPrefixes(t *testing.T) {
+	o, _ := newTestObjectStore(t)
+
+	for _, key := range []string{
+		"backups/backup-1/velero-backup.json",
+		"backups/backup-1/backup-1.tar.gz",
+		"backups/backup-2/velero-backup.json",
+		"restores/restore-1/restore-restore-1-logs.gz",
+		"metadata/revision",
+	} {
+		require.NoError(t, o.PutObject("bucket", key, strings.NewReader("data")))
+	}
+
+	keys, err := o.ListObjects("bucket", "backups/backup-1/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/backup-1.tar.gz", "backups/backup-1/velero-backup.json"}, keys)
+
+	keys, err = o.ListObjects("bucket", "backups/backup")
+	require.NoError(t, err)
+	assert.Len(t, keys, 3)
+
+	keys, err = o.ListObjects("bucket", "does-not-exist/")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	prefixes, err := o.ListCommonPrefixes("bucket", "backups/", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/", "backups/backup-2/"}, prefixes)
+
+	prefixes, err = o.ListCommonPrefixes("bucket", "", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/", "metadata/", "restores/"}, prefixes)
+
+	_, err = o.ListObjects("missing-bucket", "")
+	assert.Error(t, err)
+
+	for _, prefix := range []string{"../", "/etc/", "backups/../../", "backups//"} {
+		_, err = o.ListObjects("bucket", prefix)
+		assert.Error(t, err, "prefix %q", prefix)
+	}
+}
+
+func TestDeleteObject(t *testing.T) {
+	o, root := newTestObjectStore(t)
+
+	require.NoError(t, o.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("data")))
+	require.NoError(t, o.PutObject("bucket", "backups/backup-2/velero-backup.json", strings.NewReader("data")))
+
+	require.NoError(t, o.DeleteObject("bucket", "backups/backup-1/velero-backup.json"))
+	require.NoError(t, o.DeleteObject("bucket", "backups/backup-1/velero-backup.json"))
+
+	// the emptied directory is removed, but not its parent that still holds a backup
+	_, err := os.Stat(filepath.Join(root, "bucket", "backups", "backup-1"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(root, "bucket", "backups", "backup-2", "velero-backup.json"))
+	assert.NoError(t, err)
+
+	require.NoError(t, o.DeleteObject("bucket", "backups/backup-2/velero-backup.json"))
+	_, err = os.Stat(filepath.Join(root, "bucket"))
+	assert.NoError(t, err, "the bucket's directory should never be removed")
+}
+
+func TestCreateSignedURL(t *testing.T) {
+	o, root := newTestObjectStore(t)
+
+	os.Setenv(SigningKeyEnvVar, "key")
+	defer os.Unsetenv(SigningKeyEnvVar)
+
+	_, err := o.CreateSignedURL("bucket", "backups/backup-1/backup-1.tar.gz", time.Minute)
+	assert.Error(t, err, "downloadURL isn't set")
+
+	require.NoError(t, o.Init(map[string]string{"root": root, "downloadURL": "https://velero.example.com/"}))
+	signed, err := o.CreateSignedURL("bucket", "backups/backup-1/backup-1.tar.gz", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signed, "https://velero.example.com"+DownloadPath+"?"), signed)
+
+	os.Unsetenv(SigningKeyEnvVar)
+	_, err = o.CreateSignedURL("bucket", "backups/backup-1/backup-1.tar.gz", time.Minute)
+	assert.Error(t, err, "the download endpoint isn't enabled")
+}
@@ -151,6 +151,52 @@ func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot
 	return r0, r1
 }
 
+// GetItemSnapshots provides a mock function with given fields: name
+func (_m *BackupStore) GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error) {
+	ret := _m.Called(name)
+
+	var r0 []*volume.ItemSnapshot
+	if rf, ok := ret.Get(0).(func(string) []*volume.ItemSnapshot); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*volume.ItemSnapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -246,6 +292,34 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupMetadata provides a mock function with given fields: name, metadata
+func (_m *BackupStore) PutBackupMetadata(name string, metadata io.Reader) error {
+	ret := _m.Called(name, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupCheckpoint provides a mock function with given fields: name, checkpoint
+func (_m *BackupStore) PutBackupCheckpoint(name string, checkpoint io.Reader) error {
+	ret := _m.Called(name, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -274,6 +348,80 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoreHookReport provides a mock function with given fields: backup, restore, report
+func (_m *BackupStore) PutRestoreHookReport(backup string, restore string, report io.Reader) error {
+	ret := _m.Called(backup, restore, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreItemReport provides a mock function with given fields: backup, restore, report
+func (_m *BackupStore) PutRestoreItemReport(backup string, restore string, report io.Reader) error {
+	ret := _m.Called(backup, restore, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRestoreResults provides a mock function with given fields: restore
+func (_m *BackupStore) GetRestoreResults(restore string) (io.ReadCloser, error) {
+	ret := _m.Called(restore)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestoreItemReport provides a mock function with given fields: restore
+func (_m *BackupStore) GetRestoreItemReport(restore string) (io.ReadCloser, error) {
+	ret := _m.Called(restore)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1beta1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
@@ -18,6 +18,7 @@ package persistence
 
 import (
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -28,12 +29,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -44,9 +49,16 @@ type BackupInfo struct {
 	Log,
 	PodVolumeBackups,
 	VolumeSnapshots,
+	ItemSnapshots,
 	BackupResourceList,
+	HookReport,
+	ContentIndex,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents io.Reader
+
+	// ObjectLock, if set, is the lock set on the backup's files. The object
+	// store must implement velero.ObjectLocker.
+	ObjectLock *velero.ObjectLock
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -57,8 +69,12 @@ type BackupStore interface {
 	ListBackups() ([]string, error)
 
 	PutBackup(info BackupInfo) error
+	// PutBackupMetadata replaces the metadata file of a backup that's already been
+	// stored, e.g. to record its final phase.
+	PutBackupMetadata(name string, metadata io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
+	GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1beta1api.VolumeSnapshot, error)
@@ -67,10 +83,25 @@ type BackupStore interface {
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
+	// PutBackupCheckpoint stores the checkpoint of an in-progress backup, replacing
+	// any existing checkpoint for the backup.
+	PutBackupCheckpoint(name string, checkpoint io.Reader) error
+	// GetBackupCheckpoint returns the checkpoint of an in-progress backup, or nil if
+	// the backup doesn't have one.
+	GetBackupCheckpoint(name string) (io.ReadCloser, error)
+
 	DeleteBackup(name string) error
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	// GetRestoreResults returns the warnings and errors of a restore, or nil if the restore
+	// doesn't have them.
+	GetRestoreResults(restore string) (io.ReadCloser, error)
+	PutRestoreHookReport(backup, restore string, report io.Reader) error
+	PutRestoreItemReport(backup, restore string, report io.Reader) error
+	// GetRestoreItemReport returns the item report of a restore, or nil if the restore
+	// doesn't have one.
+	GetRestoreItemReport(restore string) (io.ReadCloser, error)
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
@@ -84,6 +115,9 @@ type objectBackupStore struct {
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+
+	// ctx is the context of the store's operations, if it's not nil.
+	ctx context.Context
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -157,6 +191,7 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 	if err != nil {
 		return nil, err
 	}
+	framework.SetLocationTimeout(objectStore, location.Spec.PluginTimeout)
 
 	if err := objectStore.Init(location.Spec.Config); err != nil {
 		return nil, err
@@ -175,7 +210,44 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 	}, nil
 }
 
-func (s *objectBackupStore) IsValid() error {
+// WithContext returns a copy of store whose operations are traced as children of the
+// span in ctx, and whose calls to its object store are made with ctx, if store supports
+// contexts, or store itself if it doesn't.
+func WithContext(store BackupStore, ctx context.Context) BackupStore {
+	if s, ok := store.(*objectBackupStore); ok {
+		return s.withContext(ctx)
+	}
+	return store
+}
+
+// withContext returns a copy of s whose operations are made with ctx.
+func (s *objectBackupStore) withContext(ctx context.Context) *objectBackupStore {
+	copy := *s
+	copy.ctx = ctx
+	copy.objectStore = framework.WithContext(s.objectStore, ctx).(velero.ObjectStore)
+	return &copy
+}
+
+// startSpan starts a span for a backup store operation, as a child of the span in the
+// store's context, and returns a copy of the store whose calls are part of the span.
+func (s *objectBackupStore) startSpan(operation string, attrs ...attribute.KeyValue) (*objectBackupStore, trace.Span) {
+	ctx := s.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, span := tracing.Start(ctx, "objectBackupStore."+operation, append(attrs, attribute.String("velero.bucket", s.bucket))...)
+	return s.withContext(ctx), span
+}
+
+// endSpan ends span, marking it as failed if *err is not nil.
+func endSpan(span trace.Span, err *error) {
+	tracing.End(span, *err)
+}
+
+func (s *objectBackupStore) IsValid() (err error) {
+	s, span := s.startSpan("IsValid")
+	defer endSpan(span, &err)
+
 	dirs, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.rootPrefix, "/")
 	if err != nil {
 		return errors.WithStack(err)
@@ -200,7 +272,10 @@ func (s *objectBackupStore) IsValid() error {
 	return nil
 }
 
-func (s *objectBackupStore) ListBackups() ([]string, error) {
+func (s *objectBackupStore) ListBackups() (_ []string, err error) {
+	s, span := s.startSpan("ListBackups")
+	defer endSpan(span, &err)
+
 	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["backups"], "/")
 	if err != nil {
 		return nil, err
@@ -224,8 +299,24 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	return output, nil
 }
 
-func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+func (s *objectBackupStore) PutBackup(info BackupInfo) (err error) {
+	s, span := s.startSpan("PutBackup", attribute.String("velero.backup.name", info.Name))
+	defer endSpan(span, &err)
+
+	putObject := func(key string, file io.Reader) error {
+		return seekAndPutObject(s.objectStore, s.bucket, key, file)
+	}
+	if info.ObjectLock != nil {
+		locker, ok := s.objectStore.(velero.ObjectLocker)
+		if !ok {
+			return errors.Errorf("object store %T does not support object locks", s.objectStore)
+		}
+		putObject = func(key string, file io.Reader) error {
+			return seekAndPutLockedObject(locker, s.bucket, key, file, *info.ObjectLock)
+		}
+	}
+
+	if err := putObject(s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
@@ -238,12 +329,12 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return nil
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := putObject(s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := putObject(s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -253,13 +344,16 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	var backupObjs = map[string]io.Reader{
 		s.layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
 		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
+		s.layout.getItemSnapshotsKey(info.Name):             info.ItemSnapshots,
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
+		s.layout.getBackupHookReportKey(info.Name):          info.HookReport,
+		s.layout.getBackupContentIndexKey(info.Name):        info.ContentIndex,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		if err := putObject(key, reader); err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -272,10 +366,34 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	// The backup is complete in object storage, so the checkpoint recorded while it was in
+	// progress is no longer needed. Removing it is best-effort.
+	if err := tryDelete(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(info.Name)); err != nil {
+		s.logger.WithError(err).WithField("backup", info.Name).Error("Error deleting backup checkpoint")
+	}
+
 	return nil
 }
 
-func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
+func (s *objectBackupStore) PutBackupMetadata(name string, metadata io.Reader) (err error) {
+	s, span := s.startSpan("PutBackupMetadata", attribute.String("velero.backup.name", name))
+	defer endSpan(span, &err)
+
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(name), metadata)
+}
+
+func (s *objectBackupStore) PutBackupCheckpoint(name string, checkpoint io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name), checkpoint)
+}
+
+func (s *objectBackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) GetBackupMetadata(name string) (_ *velerov1api.Backup, err error) {
+	s, span := s.startSpan("GetBackupMetadata", attribute.String("velero.backup.name", name))
+	defer endSpan(span, &err)
+
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
 	res, err := s.objectStore.GetObject(s.bucket, metadataKey)
@@ -324,6 +442,25 @@ func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Sna
 	return volumeSnapshots, nil
 }
 
+func (s *objectBackupStore) GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error) {
+	// a backup taken without any ItemSnapshotters doesn't have this file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getItemSnapshotsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var itemSnapshots []*volume.ItemSnapshot
+	if err := decode(res, &itemSnapshots); err != nil {
+		return nil, err
+	}
+
+	return itemSnapshots, nil
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
 func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
@@ -338,6 +475,19 @@ func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser,
 	return objectStore.GetObject(bucket, key)
 }
 
+// tryDelete deletes the object with the given key if it exists.
+func tryDelete(objectStore velero.ObjectStore, bucket, key string) error {
+	exists, err := objectStore.ObjectExists(bucket, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exists {
+		return nil
+	}
+
+	return objectStore.DeleteObject(bucket, key)
+}
+
 // decode extracts a .json.gz file reader into the object pointed to
 // by 'into'.
 func decode(jsongzReader io.Reader, into interface{}) error {
@@ -411,15 +561,24 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	return podVolumeBackups, nil
 }
 
-func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
+func (s *objectBackupStore) GetBackupContents(name string) (_ io.ReadCloser, err error) {
+	s, span := s.startSpan("GetBackupContents", attribute.String("velero.backup.name", name))
+	defer endSpan(span, &err)
+
 	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
 }
 
-func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
+func (s *objectBackupStore) BackupExists(bucket, backupName string) (_ bool, err error) {
+	s, span := s.startSpan("BackupExists", attribute.String("velero.backup.name", backupName))
+	defer endSpan(span, &err)
+
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
 
-func (s *objectBackupStore) DeleteBackup(name string) error {
+func (s *objectBackupStore) DeleteBackup(name string) (err error) {
+	s, span := s.startSpan("DeleteBackup", attribute.String("velero.backup.name", name))
+	defer endSpan(span, &err)
+
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
 	if err != nil {
 		return err
@@ -435,10 +594,18 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		}
 	}
 
+	// the checkpoint is left behind if the backup didn't complete.
+	if err := tryDelete(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name)); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
-func (s *objectBackupStore) DeleteRestore(name string) error {
+func (s *objectBackupStore) DeleteRestore(name string) (err error) {
+	s, span := s.startSpan("DeleteRestore", attribute.String("velero.restore.name", name))
+	defer endSpan(span, &err)
+
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
 		return err
@@ -457,14 +624,42 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
-func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
+func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) (err error) {
+	s, span := s.startSpan("PutRestoreLog", attribute.String("velero.restore.name", restore))
+	defer endSpan(span, &err)
+
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
 }
 
-func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
+func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) (err error) {
+	s, span := s.startSpan("PutRestoreResults", attribute.String("velero.restore.name", restore))
+	defer endSpan(span, &err)
+
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) GetRestoreResults(restore string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRestoreResultsKey(restore))
+}
+
+func (s *objectBackupStore) PutRestoreHookReport(backup string, restore string, report io.Reader) (err error) {
+	s, span := s.startSpan("PutRestoreHookReport", attribute.String("velero.restore.name", restore))
+	defer endSpan(span, &err)
+
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreHookReportKey(restore), report)
+}
+
+func (s *objectBackupStore) PutRestoreItemReport(backup string, restore string, report io.Reader) (err error) {
+	s, span := s.startSpan("PutRestoreItemReport", attribute.String("velero.restore.name", restore))
+	defer endSpan(span, &err)
+
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreItemReportKey(restore), report)
+}
+
+func (s *objectBackupStore) GetRestoreItemReport(restore string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRestoreItemReportKey(restore))
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
@@ -475,10 +670,18 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupHookReport:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupHookReportKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupContentIndex:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentIndexKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreHookReport:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreHookReportKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreItemReport:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemReportKey(target.Name), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -505,3 +708,15 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 
 	return objectStore.PutObject(bucket, key, file)
 }
+
+func seekAndPutLockedObject(locker velero.ObjectLocker, bucket, key string, file io.Reader, lock velero.ObjectLock) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return locker.PutLockedObject(bucket, key, file, lock)
+}
@@ -27,6 +27,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -339,6 +340,51 @@ func TestPutBackup(t *testing.T) {
 	}
 }
 
+// lockingObjectStore is an in-memory object store that records the locks set on
+// the objects written to it.
+type lockingObjectStore struct {
+	*inMemoryObjectStore
+	locks map[string]velero.ObjectLock
+}
+
+func (o *lockingObjectStore) PutLockedObject(bucket, key string, body io.Reader, lock velero.ObjectLock) error {
+	o.locks[key] = lock
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func TestPutBackupWithObjectLock(t *testing.T) {
+	lock := velero.ObjectLock{Mode: "Compliance", RetainUntil: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	backupInfo := func() BackupInfo {
+		return BackupInfo{
+			Name:       "backup-1",
+			Metadata:   newStringReadSeeker("metadata"),
+			Contents:   newStringReadSeeker("contents"),
+			Log:        newStringReadSeeker("log"),
+			ObjectLock: &lock,
+		}
+	}
+
+	t.Run("the backup's files are locked", func(t *testing.T) {
+		harness := newObjectBackupStoreTestHarness("foo", "")
+		objectStore := &lockingObjectStore{inMemoryObjectStore: harness.objectStore, locks: map[string]velero.ObjectLock{}}
+		harness.objectBackupStore.objectStore = objectStore
+
+		require.NoError(t, harness.PutBackup(backupInfo()))
+		assert.Equal(t, map[string]velero.ObjectLock{
+			"backups/backup-1/velero-backup.json": lock,
+			"backups/backup-1/backup-1.tar.gz":    lock,
+			"backups/backup-1/backup-1-logs.gz":   lock,
+		}, objectStore.locks)
+	})
+
+	t.Run("object stores that don't support object locks fail the upload", func(t *testing.T) {
+		harness := newObjectBackupStoreTestHarness("foo", "")
+
+		assert.Error(t, harness.PutBackup(backupInfo()))
+		assert.Empty(t, harness.objectStore.Data[harness.bucket])
+	})
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -384,6 +430,20 @@ func TestGetBackupMetadata(t *testing.T) {
 	}
 }
 
+func TestPutBackupMetadata(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "test-backup",
+		Metadata: newStringReadSeeker("metadata-1"),
+		Contents: newStringReadSeeker("contents"),
+	}))
+	require.NoError(t, harness.PutBackupMetadata("test-backup", newStringReadSeeker("metadata-2")))
+
+	assert.Equal(t, "metadata-2", string(harness.objectStore.Data[harness.bucket]["backups/test-backup/velero-backup.json"]))
+	assert.Equal(t, "contents", string(harness.objectStore.Data[harness.bucket]["backups/test-backup/test-backup.tar.gz"]))
+}
+
 func TestGetBackupVolumeSnapshots(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -426,6 +486,48 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	assert.EqualValues(t, snapshots, res)
 }
 
+func TestGetItemSnapshots(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// itemsnapshots file not found should not error
+	res, err := harness.GetItemSnapshots("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// itemsnapshots file containing invalid data should error
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemsnapshots.json.gz", newStringReadSeeker("foo"))
+	_, err = harness.GetItemSnapshots("test-backup")
+	assert.NotNil(t, err)
+
+	// itemsnapshots file containing gzipped json data should return correctly
+	snapshots := []*volume.ItemSnapshot{
+		{
+			Spec: volume.ItemSnapshotSpec{
+				BackupName:      "test-backup",
+				ItemSnapshotter: "velero.io/database",
+				Resource:        "databases.example.com",
+				Namespace:       "ns-1",
+				Name:            "db-1",
+			},
+			Status: volume.ItemSnapshotStatus{
+				SnapshotID: "snap-1",
+				Metadata:   map[string]string{"key": "value"},
+			},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(snapshots))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemsnapshots.json.gz", obj))
+
+	res, err = harness.GetItemSnapshots("test-backup")
+	assert.NoError(t, err)
+	assert.EqualValues(t, snapshots, res)
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -440,17 +542,59 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestBackupCheckpoint(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a backup without a checkpoint should not error
+	rc, err := harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, rc)
+
+	// putting a checkpoint replaces any existing one
+	require.NoError(t, harness.PutBackupCheckpoint("test-backup", newStringReadSeeker("checkpoint-1")))
+	require.NoError(t, harness.PutBackupCheckpoint("test-backup", newStringReadSeeker("checkpoint-2")))
+
+	rc, err = harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, rc)
+
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint-2", string(data))
+
+	// the checkpoint is kept outside of the backup's directory, so it's never listed as a backup
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "checkpoints/test-backup-checkpoint.json.gz")
+	backups, err := harness.ListBackups()
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	// persisting the completed backup removes its checkpoint
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "test-backup",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+	}))
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "checkpoints/test-backup-checkpoint.json.gz")
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/velero-backup.json")
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
 		prefix           string
 		listObjectsError error
 		deleteErrors     []error
+		checkpoint       bool
 		expectedErr      string
 	}{
 		{
 			name: "normal case",
 		},
+		{
+			name:       "the checkpoint of a backup that didn't complete is deleted",
+			prefix:     "velero-backups/",
+			checkpoint: true,
+		},
 		{
 			name:   "normal case with backup store prefix",
 			prefix: "velero-backups/",
@@ -485,6 +629,12 @@ func TestDeleteBackup(t *testing.T) {
 				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
 			}
 
+			checkpointKey := test.prefix + "checkpoints/bak-checkpoint.json.gz"
+			objectStore.On("ObjectExists", backupStore.bucket, checkpointKey).Return(test.checkpoint, nil)
+			if test.checkpoint {
+				objectStore.On("DeleteObject", backupStore.bucket, checkpointKey).Return(nil)
+			}
+
 			err := backupStore.DeleteBackup("bak")
 
 			velerotest.AssertErrorMatches(t, test.expectedErr, err)
@@ -507,6 +657,8 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupHookReport:      "backups/my-backup/my-backup-hook-report.json.gz",
+				velerov1api.DownloadTargetKindBackupContentIndex:    "backups/my-backup/my-backup-content-index.json.gz",
 			},
 		},
 		{
@@ -518,6 +670,8 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupLog:             "velero-backups/backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupHookReport:      "velero-backups/backups/my-backup/my-backup-hook-report.json.gz",
+				velerov1api.DownloadTargetKindBackupContentIndex:    "velero-backups/backups/my-backup/my-backup-content-index.json.gz",
 			},
 		},
 		{
@@ -555,8 +709,10 @@ func TestGetDownloadURL(t *testing.T) {
 			name:       "restore",
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:        "restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:    "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreHookReport: "restores/my-backup/restore-my-backup-hook-report.gz",
+				velerov1api.DownloadTargetKindRestoreItemReport: "restores/my-backup/restore-my-backup-item-report.gz",
 			},
 		},
 		{
@@ -564,8 +720,10 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			prefix:     "velero-backups/",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
-				velerov1api.DownloadTargetKindRestoreLog:     "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
-				velerov1api.DownloadTargetKindRestoreResults: "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreLog:        "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
+				velerov1api.DownloadTargetKindRestoreResults:    "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestoreHookReport: "velero-backups/restores/my-backup/restore-my-backup-hook-report.gz",
+				velerov1api.DownloadTargetKindRestoreItemReport: "velero-backups/restores/my-backup/restore-my-backup-item-report.gz",
 			},
 		},
 		{
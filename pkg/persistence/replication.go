@@ -0,0 +1,235 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
+)
+
+// resticRepoConfigFile is the name of the object identifying a restic repository.
+// It's the same in every copy of a repository, and differs between repositories.
+const resticRepoConfigFile = "config"
+
+// CopyBackup copies all the files of a backup, and the restic repositories of the
+// given volume namespaces, from one backup store to another. Each object is verified
+// by reading it back from the target store. The backup's metadata is copied last,
+// so the copy isn't listed in the target store until it's complete.
+//
+// Restic repositories are copied incrementally: objects that already exist in the
+// target repository are skipped, since restic names them by their contents. It's an
+// error for the target store to hold a different repository for the same namespace,
+// or a different backup with the same name.
+//
+// If lock is set, the backup's files are locked in the target store, which must
+// implement velero.ObjectLocker.
+func CopyBackup(ctx context.Context, src, dst BackupStore, name string, resticVolumeNamespaces []string, lock *velero.ObjectLock, log logrus.FieldLogger) (err error) {
+	source, ok := src.(*objectBackupStore)
+	if !ok {
+		return errors.Errorf("backup store %T does not support copying backups", src)
+	}
+	target, ok := dst.(*objectBackupStore)
+	if !ok {
+		return errors.Errorf("backup store %T does not support copying backups", dst)
+	}
+
+	putBackupObject := target.objectStore.PutObject
+	if lock != nil {
+		locker, ok := target.objectStore.(velero.ObjectLocker)
+		if !ok {
+			return errors.Errorf("object store %T does not support object locks", target.objectStore)
+		}
+		putBackupObject = func(bucket, key string, body io.Reader) error {
+			return locker.PutLockedObject(bucket, key, body, *lock)
+		}
+	}
+
+	ctx, span := tracing.Start(ctx, "persistence.CopyBackup", attribute.String("velero.backup.name", name))
+	defer endSpan(span, &err)
+	source, target = source.withContext(ctx), target.withContext(ctx)
+
+	metadataKey := source.layout.getBackupMetadataKey(name)
+	metadata, err := readObject(source, metadataKey)
+	if err != nil {
+		return errors.Wrap(err, "error reading backup metadata")
+	}
+
+	exists, err := target.objectStore.ObjectExists(target.bucket, target.layout.getBackupMetadataKey(name))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if exists {
+		existing, err := readObject(target, target.layout.getBackupMetadataKey(name))
+		if err != nil {
+			return errors.Wrap(err, "error reading metadata of existing backup in target location")
+		}
+		if !bytes.Equal(existing, metadata) {
+			return errors.Errorf("a different backup named %s already exists in the target location", name)
+		}
+	}
+
+	for _, namespace := range resticVolumeNamespaces {
+		if err := copyResticRepo(source, target, namespace, log); err != nil {
+			return errors.Wrapf(err, "error copying restic repository for namespace %s", namespace)
+		}
+	}
+
+	keys, err := source.objectStore.ListObjects(source.bucket, source.layout.getBackupDir(name))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, key := range keys {
+		if key == metadataKey {
+			continue
+		}
+		targetKey := target.layout.getBackupDir(name) + strings.TrimPrefix(key, source.layout.getBackupDir(name))
+		if err := copyObject(source, target, key, targetKey, putBackupObject, log); err != nil {
+			return err
+		}
+	}
+
+	return copyObject(source, target, metadataKey, target.layout.getBackupMetadataKey(name), putBackupObject, log)
+}
+
+// copyResticRepo copies the objects of a restic repository that are missing from
+// the target store.
+func copyResticRepo(source, target *objectBackupStore, namespace string, log logrus.FieldLogger) error {
+	sourceDir := path.Join(source.layout.GetResticDir(), namespace) + "/"
+	targetDir := path.Join(target.layout.GetResticDir(), namespace) + "/"
+
+	sourceConfig, err := readObject(source, sourceDir+resticRepoConfigFile)
+	if err != nil {
+		return errors.Wrap(err, "error reading repository config")
+	}
+
+	exists, err := target.objectStore.ObjectExists(target.bucket, targetDir+resticRepoConfigFile)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if exists {
+		targetConfig, err := readObject(target, targetDir+resticRepoConfigFile)
+		if err != nil {
+			return errors.Wrap(err, "error reading repository config in target location")
+		}
+		if !bytes.Equal(sourceConfig, targetConfig) {
+			return errors.New("the target location has a different restic repository for the namespace")
+		}
+	}
+
+	keys, err := source.objectStore.ListObjects(source.bucket, sourceDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var files []string
+	for _, key := range keys {
+		file := strings.TrimPrefix(key, sourceDir)
+		// locks are only meaningful to the restic processes using the source repository
+		if strings.HasPrefix(file, "locks/") || file == resticRepoConfigFile {
+			continue
+		}
+		files = append(files, file)
+	}
+	// copy the data before the files referencing it, so an interrupted copy never
+	// leaves snapshots pointing at missing data
+	sort.SliceStable(files, func(i, j int) bool {
+		return resticFileOrder(files[i]) < resticFileOrder(files[j])
+	})
+	files = append(files, resticRepoConfigFile)
+
+	for _, file := range files {
+		exists, err := target.objectStore.ObjectExists(target.bucket, targetDir+file)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if exists {
+			continue
+		}
+		if err := copyObject(source, target, sourceDir+file, targetDir+file, target.objectStore.PutObject, log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resticFileOrder(file string) int {
+	for i, dir := range []string{"data/", "index/", "keys/", "snapshots/"} {
+		if strings.HasPrefix(file, dir) {
+			return i
+		}
+	}
+	return 4
+}
+
+// copyObject copies an object between backup stores, writing it with putObject, then
+// reads it back from the target store to verify it was written correctly.
+func copyObject(source, target *objectBackupStore, key, targetKey string, putObject func(bucket, key string, body io.Reader) error, log logrus.FieldLogger) error {
+	log.WithFields(logrus.Fields{"key": key, "targetKey": targetKey}).Debug("Copying object")
+
+	body, err := source.objectStore.GetObject(source.bucket, key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %s", key)
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	if err := putObject(target.bucket, targetKey, io.TeeReader(body, hash)); err != nil {
+		return errors.Wrapf(err, "error putting object %s", targetKey)
+	}
+
+	written, err := target.objectStore.GetObject(target.bucket, targetKey)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %s to verify it", targetKey)
+	}
+	defer written.Close()
+
+	verify := sha256.New()
+	if _, err := io.Copy(verify, written); err != nil {
+		return errors.Wrapf(err, "error reading object %s to verify it", targetKey)
+	}
+
+	if !bytes.Equal(hash.Sum(nil), verify.Sum(nil)) {
+		return errors.Errorf("object %s does not match object %s it was copied from", targetKey, key)
+	}
+
+	return nil
+}
+
+func readObject(s *objectBackupStore, key string) ([]byte, error) {
+	body, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	return data, errors.WithStack(err)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// corruptingObjectStore is an in-memory object store that drops the last byte of
+// every object written to it.
+type corruptingObjectStore struct {
+	*inMemoryObjectStore
+}
+
+func (o *corruptingObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if err := o.inMemoryObjectStore.PutObject(bucket, key, body); err != nil {
+		return err
+	}
+	data := o.Data[bucket][key]
+	o.Data[bucket][key] = data[:len(data)-1]
+	return nil
+}
+
+func TestCopyBackup(t *testing.T) {
+	newSource := func() *objectBackupStoreTestHarness {
+		source := newObjectBackupStoreTestHarness("source-bucket", "velero")
+		source.objectStore.Data["source-bucket"] = BucketData{
+			"velero/backups/backup-1/velero-backup.json":    []byte("metadata"),
+			"velero/backups/backup-1/backup-1.tar.gz":       []byte("contents"),
+			"velero/backups/backup-1/backup-1-logs.gz":      []byte("logs"),
+			"velero/backups/backup-2/velero-backup.json":    []byte("other metadata"),
+			"velero/restic/ns-1/config":                     []byte("repo-1"),
+			"velero/restic/ns-1/data/00/0000":               []byte("data"),
+			"velero/restic/ns-1/index/1111":                 []byte("index"),
+			"velero/restic/ns-1/snapshots/2222":             []byte("snapshot"),
+			"velero/restic/ns-1/keys/3333":                  []byte("key"),
+			"velero/restic/ns-1/locks/4444":                 []byte("lock"),
+			"velero/restic/ns-2/config":                     []byte("repo-2"),
+			"velero/restic/ns-2/snapshots/5555":             []byte("snapshot"),
+			"velero/restores/restore-1/restore-1-logs.gz":   []byte("restore logs"),
+			"velero/metadata/revision":                      []byte("revision"),
+			"velero/backups/backup-1/backup-1-volumes.json": []byte("volumes"),
+		}
+		return source
+	}
+
+	t.Run("all the backup's files and restic repositories are copied", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		target.objectStore.Data["target-bucket"]["restic/ns-1/config"] = []byte("repo-1")
+		target.objectStore.Data["target-bucket"]["restic/ns-1/data/00/0000"] = []byte("already copied")
+
+		require.NoError(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", []string{"ns-1"}, nil, velerotest.NewLogger()))
+
+		assert.Equal(t, BucketData{
+			"backups/backup-1/velero-backup.json":    []byte("metadata"),
+			"backups/backup-1/backup-1.tar.gz":       []byte("contents"),
+			"backups/backup-1/backup-1-logs.gz":      []byte("logs"),
+			"backups/backup-1/backup-1-volumes.json": []byte("volumes"),
+			"restic/ns-1/config":                     []byte("repo-1"),
+			"restic/ns-1/data/00/0000":               []byte("already copied"),
+			"restic/ns-1/index/1111":                 []byte("index"),
+			"restic/ns-1/snapshots/2222":             []byte("snapshot"),
+			"restic/ns-1/keys/3333":                  []byte("key"),
+		}, target.objectStore.Data["target-bucket"])
+
+		// copying again is a no-op
+		require.NoError(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", []string{"ns-1"}, nil, velerotest.NewLogger()))
+	})
+
+	t.Run("a different restic repository in the target location is an error", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		target.objectStore.Data["target-bucket"]["restic/ns-2/config"] = []byte("another repo")
+
+		assert.Error(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", []string{"ns-2"}, nil, velerotest.NewLogger()))
+		_, exists := target.objectStore.Data["target-bucket"]["backups/backup-1/velero-backup.json"]
+		assert.False(t, exists)
+	})
+
+	t.Run("a different backup with the same name in the target location is an error", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		target.objectStore.Data["target-bucket"]["backups/backup-1/velero-backup.json"] = []byte("another backup")
+
+		assert.Error(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", nil, nil, velerotest.NewLogger()))
+		assert.Equal(t, []byte("another backup"), target.objectStore.Data["target-bucket"]["backups/backup-1/velero-backup.json"])
+	})
+
+	t.Run("objects that don't match after being copied fail the copy", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		target.objectBackupStore.objectStore = &corruptingObjectStore{target.objectStore}
+
+		assert.Error(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", nil, nil, velerotest.NewLogger()))
+		_, exists := target.objectStore.Data["target-bucket"]["backups/backup-1/velero-backup.json"]
+		assert.False(t, exists, "the metadata shouldn't be copied when other files fail to copy")
+	})
+	t.Run("the backup's files are locked in the target location", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		locker := &lockingObjectStore{inMemoryObjectStore: target.objectStore, locks: map[string]velero.ObjectLock{}}
+		target.objectBackupStore.objectStore = locker
+		lock := velero.ObjectLock{Mode: "Compliance", RetainUntil: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		require.NoError(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", []string{"ns-1"}, &lock, velerotest.NewLogger()))
+
+		assert.Equal(t, map[string]velero.ObjectLock{
+			"backups/backup-1/velero-backup.json":    lock,
+			"backups/backup-1/backup-1.tar.gz":       lock,
+			"backups/backup-1/backup-1-logs.gz":      lock,
+			"backups/backup-1/backup-1-volumes.json": lock,
+		}, locker.locks)
+	})
+
+	t.Run("locking the backup's files fails if the target object store doesn't support locks", func(t *testing.T) {
+		source := newSource()
+		target := newObjectBackupStoreTestHarness("target-bucket", "")
+		lock := velero.ObjectLock{Mode: "Compliance", RetainUntil: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		assert.Error(t, CopyBackup(context.Background(), source.objectBackupStore, target.objectBackupStore, "backup-1", nil, &lock, velerotest.NewLogger()))
+		assert.Empty(t, target.objectStore.Data["target-bucket"])
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+)
+
+// backupItemActionV1Adapter lets Velero call a version 1 backup item action through the
+// version 2 interface. Version 1 actions never start asynchronous operations.
+type backupItemActionV1Adapter struct {
+	name   string
+	action velero.BackupItemAction
+}
+
+// newBackupItemActionV1Adapter returns a version 2 backup item action that delegates to action,
+// a version 1 backup item action registered as name.
+func newBackupItemActionV1Adapter(name string, action velero.BackupItemAction) biav2.BackupItemAction {
+	return &backupItemActionV1Adapter{
+		name:   name,
+		action: action,
+	}
+}
+
+// Name returns the name the version 1 action is registered with.
+func (a *backupItemActionV1Adapter) Name() string {
+	return a.name
+}
+
+// WithContext returns an adapter of the version 1 action with its calls made with ctx.
+func (a *backupItemActionV1Adapter) WithContext(ctx context.Context) interface{} {
+	return newBackupItemActionV1Adapter(a.name, framework.WithContext(a.action, ctx).(velero.BackupItemAction))
+}
+
+// AppliesTo delegates to the version 1 action.
+func (a *backupItemActionV1Adapter) AppliesTo() (velero.ResourceSelector, error) {
+	return a.action.AppliesTo()
+}
+
+// Execute delegates to the version 1 action, and never returns an operation ID.
+// Version 1 actions aren't told which cluster the item comes from.
+func (a *backupItemActionV1Adapter) Execute(item runtime.Unstructured, backup *api.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
+	updatedItem, additionalItems, err := a.action.Execute(item, backup)
+	return updatedItem, additionalItems, "", err
+}
+
+// Progress returns an error, because version 1 actions don't start operations.
+func (a *backupItemActionV1Adapter) Progress(operationID string, backup *api.Backup) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, errors.Errorf("backup item action %s doesn't support asynchronous operations", a.name)
+}
+
+// Cancel returns an error, because version 1 actions don't start operations.
+func (a *backupItemActionV1Adapter) Cancel(operationID string, backup *api.Backup) error {
+	return errors.Errorf("backup item action %s doesn't support asynchronous operations", a.name)
+}
+
+// restoreItemActionV1Adapter lets Velero call a version 1 restore item action through the
+// version 2 interface. Version 1 actions never start asynchronous operations.
+type restoreItemActionV1Adapter struct {
+	name   string
+	action velero.RestoreItemAction
+}
+
+// newRestoreItemActionV1Adapter returns a version 2 restore item action that delegates to action,
+// a version 1 restore item action registered as name.
+func newRestoreItemActionV1Adapter(name string, action velero.RestoreItemAction) riav2.RestoreItemAction {
+	return &restoreItemActionV1Adapter{
+		name:   name,
+		action: action,
+	}
+}
+
+// Name returns the name the version 1 action is registered with.
+func (a *restoreItemActionV1Adapter) Name() string {
+	return a.name
+}
+
+// WithContext returns an adapter of the version 1 action with its calls made with ctx.
+func (a *restoreItemActionV1Adapter) WithContext(ctx context.Context) interface{} {
+	return newRestoreItemActionV1Adapter(a.name, framework.WithContext(a.action, ctx).(velero.RestoreItemAction))
+}
+
+// AppliesTo delegates to the version 1 action.
+func (a *restoreItemActionV1Adapter) AppliesTo() (velero.ResourceSelector, error) {
+	return a.action.AppliesTo()
+}
+
+// Execute delegates to the version 1 action. Any operation ID in its output is dropped,
+// since Velero couldn't check the operation's progress.
+func (a *restoreItemActionV1Adapter) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	output, err := a.action.Execute(input)
+	if output != nil {
+		output.OperationID = ""
+	}
+	return output, err
+}
+
+// Progress returns an error, because version 1 actions don't start operations.
+func (a *restoreItemActionV1Adapter) Progress(operationID string, restore *api.Restore) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, errors.Errorf("restore item action %s doesn't support asynchronous operations", a.name)
+}
+
+// Cancel returns an error, because version 1 actions don't start operations.
+func (a *restoreItemActionV1Adapter) Cancel(operationID string, restore *api.Restore) error {
+	return errors.Errorf("restore item action %s doesn't support asynchronous operations", a.name)
+}
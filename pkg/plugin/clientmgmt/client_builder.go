@@ -67,15 +67,19 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemActionV2):  framework.NewBackupItemActionV2Plugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindObjectStore):         framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindPluginLister):        &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindRestoreItemActionV2): framework.NewRestoreItemActionV2Plugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindItemSnapshotter):     framework.NewItemSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
 		},
-		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...),
+		Logger:          b.pluginLogger,
+		Cmd:             exec.Command(b.commandName, b.commandArgs...),
+		GRPCDialOptions: framework.GRPCDialOptions(),
 	}
 }
 
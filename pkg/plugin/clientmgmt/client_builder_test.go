@@ -60,17 +60,25 @@ func TestClientConfig(t *testing.T) {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemActionV2):  framework.NewBackupItemActionV2Plugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindObjectStore):         framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPluginLister):        &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindRestoreItemActionV2): framework.NewRestoreItemActionV2Plugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindItemSnapshotter):     framework.NewItemSnapshotterPlugin(framework.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
 	}
 
 	cc := cb.clientConfig()
+
+	// the dial options hold the tracing interceptors, which are funcs that can't be compared
+	assert.Len(t, cc.GRPCDialOptions, len(framework.GRPCDialOptions()))
+	cc.GRPCDialOptions = nil
+
 	assert.Equal(t, expected, cc)
 }
@@ -18,6 +18,7 @@ package clientmgmt
 
 import (
 	"fmt"
+	"io"
 	"log"
 
 	hclog "github.com/hashicorp/go-hclog"
@@ -63,6 +64,22 @@ func argsToFields(args ...interface{}) logrus.Fields {
 	return logrus.Fields(fields)
 }
 
+// Log emits a message and key/value pairs at the given level
+func (l *logrusAdapter) Log(level hclog.Level, msg string, args ...interface{}) {
+	switch level {
+	case hclog.Trace:
+		l.Trace(msg, args...)
+	case hclog.Debug:
+		l.Debug(msg, args...)
+	case hclog.Warn:
+		l.Warn(msg, args...)
+	case hclog.Error:
+		l.Error(msg, args...)
+	default:
+		l.Info(msg, args...)
+	}
+}
+
 // Trace emits a message and key/value pairs at the DEBUG level
 // (logrus doesn't have a TRACE level)
 func (l *logrusAdapter) Trace(msg string, args ...interface{}) {
@@ -119,14 +136,26 @@ func (l *logrusAdapter) IsError() bool {
 	return l.level <= logrus.ErrorLevel
 }
 
+// ImpliedArgs returns the key/value pairs added to the logger with With. The
+// adapter keeps them as logrus fields instead, so there are none.
+func (l *logrusAdapter) ImpliedArgs() []interface{} {
+	return nil
+}
+
 // With creates a sublogger that will always have the given key/value pairs
 func (l *logrusAdapter) With(args ...interface{}) hclog.Logger {
 	return &logrusAdapter{
 		impl:  l.impl.WithFields(argsToFields(args...)),
 		level: l.level,
+		name:  l.name,
 	}
 }
 
+// Name returns the logger's name
+func (l *logrusAdapter) Name() string {
+	return l.name
+}
+
 // Named creates a logger that will add a `pluginName` field with the name string
 // as the value. If the logger already has a name, the new value will be appended
 // to the current name.
@@ -157,6 +186,12 @@ func (l *logrusAdapter) StandardLogger(opts *hclog.StandardLoggerOptions) *log.L
 	panic("not implemented")
 }
 
+// StandardWriter returns a value that conforms to io.Writer, which can be passed
+// into log.SetOutput()
+func (l *logrusAdapter) StandardWriter(opts *hclog.StandardLoggerOptions) io.Writer {
+	panic("not implemented")
+}
+
 // Updates the level. This should affect all sub-loggers as well. If an
 // implementation cannot update the level on the fly, it should no-op.
 func (l *logrusAdapter) SetLevel(_ hclog.Level) {
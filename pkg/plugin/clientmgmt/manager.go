@@ -24,6 +24,8 @@ import (
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 )
 
 // Manager manages the lifecycles of plugins.
@@ -40,18 +42,38 @@ type Manager interface {
 	// GetBackupItemAction returns the backup item action plugin for name.
 	GetBackupItemAction(name string) (velero.BackupItemAction, error)
 
+	// GetBackupItemActionsV2 returns all backup item action plugins as version 2 actions,
+	// adapting version 1 actions.
+	GetBackupItemActionsV2() ([]biav2.BackupItemAction, error)
+
+	// GetBackupItemActionV2 returns the backup item action plugin for name as a version 2 action.
+	GetBackupItemActionV2(name string) (biav2.BackupItemAction, error)
+
 	// GetRestoreItemActions returns all restore item action plugins.
 	GetRestoreItemActions() ([]velero.RestoreItemAction, error)
 
 	// GetRestoreItemAction returns the restore item action plugin for name.
 	GetRestoreItemAction(name string) (velero.RestoreItemAction, error)
 
+	// GetRestoreItemActionsV2 returns all restore item action plugins as version 2 actions,
+	// adapting version 1 actions.
+	GetRestoreItemActionsV2() ([]riav2.RestoreItemAction, error)
+
+	// GetRestoreItemActionV2 returns the restore item action plugin for name as a version 2 action.
+	GetRestoreItemActionV2(name string) (riav2.RestoreItemAction, error)
+
 	// GetDeleteItemActions returns all delete item action plugins.
 	GetDeleteItemActions() ([]velero.DeleteItemAction, error)
 
 	// GetDeleteItemAction returns the delete item action plugin for name.
 	GetDeleteItemAction(name string) (velero.DeleteItemAction, error)
 
+	// GetItemSnapshotters returns all item snapshotter plugins, by name.
+	GetItemSnapshotters() (map[string]velero.ItemSnapshotter, error)
+
+	// GetItemSnapshotter returns the ItemSnapshotter plugin for name.
+	GetItemSnapshotter(name string) (velero.ItemSnapshotter, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -69,14 +91,15 @@ type manager struct {
 	restartableProcesses map[string]RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry) Manager {
+// NewManager constructs a manager for getting plugins. The calls to the plugins time out
+// according to timeouts, and the health of the plugin processes is recorded in statuses.
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry Registry, timeouts Timeouts, statuses *ProcessStatuses) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: newRestartableProcessFactory(),
+		restartableProcessFactory: newRestartableProcessFactory(timeouts, statuses),
 
 		restartableProcesses: make(map[string]RestartableProcess),
 	}
@@ -190,6 +213,81 @@ func (m *manager) GetBackupItemAction(name string) (velero.BackupItemAction, err
 	return r, nil
 }
 
+// GetBackupItemActionsV2 returns all backup item actions as version 2 actions: version 2 plugins
+// first, then the version 1 plugins that no version 2 plugin has the name of.
+func (m *manager) GetBackupItemActionsV2() ([]biav2.BackupItemAction, error) {
+	names := m.versionedPluginNames(framework.PluginKindBackupItemActionV2)
+
+	actions := make([]biav2.BackupItemAction, 0, len(names))
+
+	for _, name := range names {
+		r, err := m.GetBackupItemActionV2(name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetBackupItemActionV2 returns a restartableBackupItemActionV2 for name, or an adapted
+// restartableBackupItemAction if name is a version 1 plugin.
+func (m *manager) GetBackupItemActionV2(name string) (biav2.BackupItemAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, kind, err := m.getVersionedRestartableProcess(framework.PluginKindBackupItemActionV2, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case framework.PluginKindBackupItemAction:
+		return newBackupItemActionV1Adapter(name, newRestartableBackupItemAction(name, restartableProcess)), nil
+	default:
+		return newRestartableBackupItemActionV2(name, restartableProcess), nil
+	}
+}
+
+// versionedPluginNames returns the names of the plugins of kind and of the older versions of
+// kind, without duplicates.
+func (m *manager) versionedPluginNames(kind framework.PluginKind) []string {
+	var names []string
+	seen := make(map[string]bool)
+
+	kinds := append([]framework.PluginKind{kind}, framework.PluginKindsAdaptableTo()[kind]...)
+	for _, k := range kinds {
+		for _, id := range m.registry.List(k) {
+			if seen[id.Name] {
+				continue
+			}
+			seen[id.Name] = true
+			names = append(names, id.Name)
+		}
+	}
+
+	return names
+}
+
+// getVersionedRestartableProcess returns a restartableProcess for the plugin named name, looking
+// for it under kind and then under the older versions of kind. It also returns the kind the
+// plugin was found under.
+func (m *manager) getVersionedRestartableProcess(kind framework.PluginKind, name string) (RestartableProcess, framework.PluginKind, error) {
+	kinds := append([]framework.PluginKind{kind}, framework.PluginKindsAdaptableTo()[kind]...)
+	for _, k := range kinds {
+		restartableProcess, err := m.getRestartableProcess(k, name)
+		if err == nil {
+			return restartableProcess, k, nil
+		}
+		if _, ok := err.(*pluginNotFoundError); !ok {
+			return nil, "", err
+		}
+	}
+
+	return nil, "", newPluginNotFoundError(kind, name)
+}
+
 // GetRestoreItemActions returns all restore item actions as restartableRestoreItemActions.
 func (m *manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 	list := m.registry.List(framework.PluginKindRestoreItemAction)
@@ -223,6 +321,43 @@ func (m *manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, e
 	return r, nil
 }
 
+// GetRestoreItemActionsV2 returns all restore item actions as version 2 actions: version 2 plugins
+// first, then the version 1 plugins that no version 2 plugin has the name of.
+func (m *manager) GetRestoreItemActionsV2() ([]riav2.RestoreItemAction, error) {
+	names := m.versionedPluginNames(framework.PluginKindRestoreItemActionV2)
+
+	actions := make([]riav2.RestoreItemAction, 0, len(names))
+
+	for _, name := range names {
+		r, err := m.GetRestoreItemActionV2(name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetRestoreItemActionV2 returns a restartableRestoreItemActionV2 for name, or an adapted
+// restartableRestoreItemAction if name is a version 1 plugin.
+func (m *manager) GetRestoreItemActionV2(name string) (riav2.RestoreItemAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, kind, err := m.getVersionedRestartableProcess(framework.PluginKindRestoreItemActionV2, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case framework.PluginKindRestoreItemAction:
+		return newRestoreItemActionV1Adapter(name, newRestartableRestoreItemAction(name, restartableProcess)), nil
+	default:
+		return newRestartableRestoreItemActionV2(name, restartableProcess), nil
+	}
+}
+
 // GetDeleteItemActions returns all delete item actions as restartableDeleteItemActions.
 func (m *manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	list := m.registry.List(framework.PluginKindDeleteItemAction)
@@ -256,6 +391,39 @@ func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, err
 	return r, nil
 }
 
+// GetItemSnapshotters returns all item snapshotters as restartableItemSnapshotters, by name.
+func (m *manager) GetItemSnapshotters() (map[string]velero.ItemSnapshotter, error) {
+	list := m.registry.List(framework.PluginKindItemSnapshotter)
+
+	snapshotters := make(map[string]velero.ItemSnapshotter, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetItemSnapshotter(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotters[id.Name] = r
+	}
+
+	return snapshotters, nil
+}
+
+// GetItemSnapshotter returns a restartableItemSnapshotter for name.
+func (m *manager) GetItemSnapshotter(name string) (velero.ItemSnapshotter, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindItemSnapshotter, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableItemSnapshotter(name, restartableProcess)
+	return r, nil
+}
+
 // sanitizeName adds "velero.io" to legacy plugins that weren't namespaced.
 func sanitizeName(name string) string {
 	// Backwards compatibility with non-namespaced Velero plugins, following principle of least surprise
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -60,7 +61,7 @@ func TestNewManager(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 	assert.Equal(t, logger, m.logger)
 	assert.Equal(t, logLevel, m.logLevel)
 	assert.Equal(t, registry, m.registry)
@@ -115,7 +116,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -161,7 +162,7 @@ func TestCleanupClients(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 
 	for i := 0; i < 5; i++ {
 		rp := &mockRestartableProcess{}
@@ -207,6 +208,23 @@ func TestGetVolumeSnapshotter(t *testing.T) {
 	)
 }
 
+func TestGetItemSnapshotter(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindItemSnapshotter,
+		"velero.io/database",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetItemSnapshotter(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableItemSnapshotter{
+				key:                 kindAndName{kind: framework.PluginKindItemSnapshotter, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		true,
+	)
+}
+
 func TestGetBackupItemAction(t *testing.T) {
 	getPluginTest(t,
 		framework.PluginKindBackupItemAction,
@@ -255,7 +273,7 @@ func getPluginTest(
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -322,7 +340,7 @@ func TestGetBackupItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -384,6 +402,70 @@ func TestGetBackupItemActions(t *testing.T) {
 	}
 }
 
+func TestGetBackupItemActionsV2(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	v2ID := func(name string) framework.PluginIdentifier {
+		return framework.PluginIdentifier{Command: "/command", Kind: framework.PluginKindBackupItemActionV2, Name: name}
+	}
+	v1ID := func(name string) framework.PluginIdentifier {
+		return framework.PluginIdentifier{Command: "/command", Kind: framework.PluginKindBackupItemAction, Name: name}
+	}
+
+	// velero.io/a is registered as both versions, velero.io/b only as version 1.
+	registry.On("List", framework.PluginKindBackupItemActionV2).Return([]framework.PluginIdentifier{v2ID("velero.io/a")})
+	registry.On("List", framework.PluginKindBackupItemAction).Return([]framework.PluginIdentifier{v1ID("velero.io/a"), v1ID("velero.io/b")})
+	registry.On("Get", framework.PluginKindBackupItemActionV2, "velero.io/a").Return(v2ID("velero.io/a"), nil)
+	registry.On("Get", framework.PluginKindBackupItemActionV2, "velero.io/b").Return(nil, newPluginNotFoundError(framework.PluginKindBackupItemActionV2, "velero.io/b"))
+	registry.On("Get", framework.PluginKindBackupItemAction, "velero.io/b").Return(v1ID("velero.io/b"), nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+
+	actions, err := m.GetBackupItemActionsV2()
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+
+	assert.Equal(t, &restartableBackupItemActionV2{
+		key:                 kindAndName{kind: framework.PluginKindBackupItemActionV2, name: "velero.io/a"},
+		sharedPluginProcess: restartableProcess,
+	}, actions[0])
+	assert.Equal(t, "velero.io/a", actions[0].Name())
+
+	assert.Equal(t, &backupItemActionV1Adapter{
+		name: "velero.io/b",
+		action: &restartableBackupItemAction{
+			key:                 kindAndName{kind: framework.PluginKindBackupItemAction, name: "velero.io/b"},
+			sharedPluginProcess: restartableProcess,
+		},
+	}, actions[1])
+	assert.Equal(t, "velero.io/b", actions[1].Name())
+}
+
+func TestGetBackupItemActionV2NotFound(t *testing.T) {
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(test.NewLogger(), logrus.InfoLevel, registry, DefaultTimeouts(), nil)
+
+	registry.On("Get", framework.PluginKindBackupItemActionV2, "velero.io/a").Return(nil, newPluginNotFoundError(framework.PluginKindBackupItemActionV2, "velero.io/a"))
+	registry.On("Get", framework.PluginKindBackupItemAction, "velero.io/a").Return(nil, newPluginNotFoundError(framework.PluginKindBackupItemAction, "velero.io/a"))
+
+	action, err := m.GetBackupItemActionV2("a")
+	assert.Nil(t, action)
+	assert.EqualError(t, err, "unable to locate BackupItemAction/v2 plugin named velero.io/a")
+}
+
 func TestGetRestoreItemActions(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -414,7 +496,7 @@ func TestGetRestoreItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -476,6 +558,59 @@ func TestGetRestoreItemActions(t *testing.T) {
 	}
 }
 
+func TestGetRestoreItemActionsV2(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	v2ID := func(name string) framework.PluginIdentifier {
+		return framework.PluginIdentifier{Command: "/command", Kind: framework.PluginKindRestoreItemActionV2, Name: name}
+	}
+	v1ID := func(name string) framework.PluginIdentifier {
+		return framework.PluginIdentifier{Command: "/command", Kind: framework.PluginKindRestoreItemAction, Name: name}
+	}
+
+	// velero.io/a is registered as both versions, velero.io/b only as version 1.
+	registry.On("List", framework.PluginKindRestoreItemActionV2).Return([]framework.PluginIdentifier{v2ID("velero.io/a")})
+	registry.On("List", framework.PluginKindRestoreItemAction).Return([]framework.PluginIdentifier{v1ID("velero.io/a"), v1ID("velero.io/b")})
+	registry.On("Get", framework.PluginKindRestoreItemActionV2, "velero.io/a").Return(v2ID("velero.io/a"), nil)
+	registry.On("Get", framework.PluginKindRestoreItemActionV2, "velero.io/b").Return(nil, newPluginNotFoundError(framework.PluginKindRestoreItemActionV2, "velero.io/b"))
+	registry.On("Get", framework.PluginKindRestoreItemAction, "velero.io/b").Return(v1ID("velero.io/b"), nil)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+
+	actions, err := m.GetRestoreItemActionsV2()
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+
+	assert.Equal(t, &restartableRestoreItemActionV2{
+		key:                 kindAndName{kind: framework.PluginKindRestoreItemActionV2, name: "velero.io/a"},
+		sharedPluginProcess: restartableProcess,
+	}, actions[0])
+	assert.Equal(t, "velero.io/a", actions[0].Name())
+
+	assert.Equal(t, &restoreItemActionV1Adapter{
+		name: "velero.io/b",
+		action: &restartableRestoreItemAction{
+			key:                 kindAndName{kind: framework.PluginKindRestoreItemAction, name: "velero.io/b"},
+			sharedPluginProcess: restartableProcess,
+		},
+	}, actions[1])
+	assert.Equal(t, "velero.io/b", actions[1].Name())
+
+	_, err = actions[1].Progress("operation-1", new(v1.Restore))
+	assert.EqualError(t, err, "restore item action velero.io/b doesn't support asynchronous operations")
+}
+
 func TestGetDeleteItemAction(t *testing.T) {
 	getPluginTest(t,
 		framework.PluginKindDeleteItemAction,
@@ -513,7 +648,7 @@ func TestGetDeleteItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, DefaultTimeouts(), nil).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
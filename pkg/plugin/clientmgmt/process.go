@@ -17,11 +17,14 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 )
@@ -44,6 +47,7 @@ func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger,
 type Process interface {
 	dispense(key kindAndName) (interface{}, error)
 	exited() bool
+	ping(timeout time.Duration) error
 	kill()
 }
 
@@ -148,6 +152,23 @@ func (r *process) exited() bool {
 	return r.client.Exited()
 }
 
+// ping checks the health of the plugin process with the gRPC health service the plugin
+// server runs, waiting for at most timeout.
+func (r *process) ping(timeout time.Duration) error {
+	grpcClient, ok := r.protocolClient.(*plugin.GRPCClient)
+	if !ok {
+		return errors.WithStack(r.protocolClient.Ping())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	_, err := grpc_health_v1.NewHealthClient(grpcClient.Conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+		Service: plugin.GRPCServiceName,
+	})
+	return errors.WithStack(err)
+}
+
 func (r *process) kill() {
 	r.client.Kill()
 }
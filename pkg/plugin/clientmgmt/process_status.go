@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"sync"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+)
+
+// ProcessStatus is the health of the process of a plugin executable.
+type ProcessStatus struct {
+	Status    velerov1api.PluginStatus
+	Restarts  int
+	LastError string
+}
+
+// ProcessStatuses keeps track of the health of plugin processes, by command. It's shared
+// by all the Managers of a Velero server, so that it covers every process the server
+// started.
+type ProcessStatuses struct {
+	metrics *metrics.ServerMetrics
+
+	lock     sync.Mutex
+	statuses map[string]ProcessStatus
+}
+
+// NewProcessStatuses returns a ProcessStatuses that also records crashes and restarts
+// in serverMetrics, if it's not nil.
+func NewProcessStatuses(serverMetrics *metrics.ServerMetrics) *ProcessStatuses {
+	return &ProcessStatuses{
+		metrics:  serverMetrics,
+		statuses: make(map[string]ProcessStatus),
+	}
+}
+
+// Get returns the status of the process of command, and whether Velero started one.
+func (s *ProcessStatuses) Get(command string) (ProcessStatus, bool) {
+	if s == nil {
+		return ProcessStatus{}, false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status, found := s.statuses[command]
+	return status, found
+}
+
+// healthy records that the process of command is running and healthy.
+func (s *ProcessStatuses) healthy(command string) {
+	s.update(command, func(status *ProcessStatus) {
+		status.Status = velerov1api.PluginStatusHealthy
+	})
+}
+
+// crashed records that the process of command exited or failed a health check, and
+// is going to be restarted.
+func (s *ProcessStatuses) crashed(command string, reason string) {
+	s.update(command, func(status *ProcessStatus) {
+		status.Status = velerov1api.PluginStatusRestarting
+		status.LastError = reason
+	})
+	if s != nil && s.metrics != nil {
+		s.metrics.RegisterPluginProcessCrash(command)
+	}
+}
+
+// restarted records that the process of command was restarted after a crash.
+func (s *ProcessStatuses) restarted(command string) {
+	s.update(command, func(status *ProcessStatus) {
+		status.Status = velerov1api.PluginStatusHealthy
+		status.Restarts++
+	})
+	if s != nil && s.metrics != nil {
+		s.metrics.RegisterPluginProcessRestart(command)
+	}
+}
+
+// failed records that the process of command couldn't be started.
+func (s *ProcessStatuses) failed(command string, err error) {
+	s.update(command, func(status *ProcessStatus) {
+		status.Status = velerov1api.PluginStatusFailed
+		status.LastError = err.Error()
+	})
+}
+
+func (s *ProcessStatuses) update(command string, fn func(*ProcessStatus)) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status := s.statuses[command]
+	fn(&status)
+	s.statuses[command] = status
+}
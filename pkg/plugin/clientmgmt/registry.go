@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -30,7 +32,8 @@ import (
 
 // Registry manages information about available plugins.
 type Registry interface {
-	// DiscoverPlugins discovers all available plugins.
+	// DiscoverPlugins discovers all available plugins. Calling it again discovers the
+	// plugins that were added to or removed from the plugin directory since.
 	DiscoverPlugins() error
 	// List returns all PluginIdentifiers for kind.
 	List(kind framework.PluginKind) []framework.PluginIdentifier
@@ -53,8 +56,11 @@ type registry struct {
 
 	processFactory ProcessFactory
 	fs             filesystem.Interface
-	pluginsByID    map[kindAndName]framework.PluginIdentifier
-	pluginsByKind  map[framework.PluginKind][]framework.PluginIdentifier
+
+	// lock guards the plugins, which are replaced each time they're discovered.
+	lock          sync.RWMutex
+	pluginsByID   map[kindAndName]framework.PluginIdentifier
+	pluginsByKind map[framework.PluginKind][]framework.PluginIdentifier
 }
 
 // NewRegistry returns a new registry.
@@ -85,7 +91,13 @@ func (r *registry) DiscoverPlugins() error {
 	return r.discoverPlugins(commands)
 }
 
+// discoverPlugins lists the plugins of each of commands and replaces the registered
+// plugins with them. If any command can't be listed, the registered plugins are left
+// as they were.
 func (r *registry) discoverPlugins(commands []string) error {
+	pluginsByID := make(map[kindAndName]framework.PluginIdentifier)
+	pluginsByKind := make(map[framework.PluginKind][]framework.PluginIdentifier)
+
 	for _, command := range commands {
 		plugins, err := r.listPlugins(command)
 		if err != nil {
@@ -99,24 +111,36 @@ func (r *registry) discoverPlugins(commands []string) error {
 				"command": command,
 			}).Info("registering plugin")
 
-			if err := r.register(plugin); err != nil {
+			if err := register(pluginsByID, pluginsByKind, plugin); err != nil {
 				return err
 			}
 		}
 	}
 
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.pluginsByID = pluginsByID
+	r.pluginsByKind = pluginsByKind
+
 	return nil
 }
 
 // List returns info about all plugin binaries that implement the given
 // PluginKind.
 func (r *registry) List(kind framework.PluginKind) []framework.PluginIdentifier {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	return r.pluginsByKind[kind]
 }
 
 // Get returns info about a plugin with the given name and kind, or an
 // error if one cannot be found.
 func (r *registry) Get(kind framework.PluginKind, name string) (framework.PluginIdentifier, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	p, found := r.pluginsByID[kindAndName{kind: kind, name: name}]
 	if !found {
 		return framework.PluginIdentifier{}, newPluginNotFoundError(kind, name)
@@ -140,6 +164,11 @@ func (r *registry) readPluginsDir(dir string) ([]string, error) {
 
 	fullPaths := make([]string, 0, len(files))
 	for _, file := range files {
+		// skip hidden files and directories, like plugins that are being installed
+		if strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
 		fullPath := filepath.Join(dir, file.Name())
 
 		if file.IsDir() {
@@ -195,10 +224,10 @@ func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, er
 	return lister.ListPlugins()
 }
 
-// register registers a PluginIdentifier with the registry.
-func (r *registry) register(id framework.PluginIdentifier) error {
+// register adds a PluginIdentifier to the registered plugins, by ID and by kind.
+func register(pluginsByID map[kindAndName]framework.PluginIdentifier, pluginsByKind map[framework.PluginKind][]framework.PluginIdentifier, id framework.PluginIdentifier) error {
 	key := kindAndName{kind: id.Kind, name: id.Name}
-	if existing, found := r.pluginsByID[key]; found {
+	if existing, found := pluginsByID[key]; found {
 		return newDuplicatePluginRegistrationError(existing, id)
 	}
 
@@ -207,8 +236,8 @@ func (r *registry) register(id framework.PluginIdentifier) error {
 		return errors.Errorf("invalid plugin name %q: %s", id.Name, err)
 	}
 
-	r.pluginsByID[key] = id
-	r.pluginsByKind[id.Kind] = append(r.pluginsByKind[id.Kind], id)
+	pluginsByID[key] = id
+	pluginsByKind[id.Kind] = append(pluginsByKind[id.Kind], id)
 
 	return nil
 }
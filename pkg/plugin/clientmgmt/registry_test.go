@@ -113,7 +113,9 @@ func TestReadPluginsDir(t *testing.T) {
 		WithFileAndMode("/plugins/nonexecutable2", []byte("plugin2"), 0644).
 		WithFileAndMode("/plugins/executable3", []byte("plugin3"), 0755).
 		WithFileAndMode("/plugins/nested/executable4", []byte("plugin4"), 0755).
-		WithFileAndMode("/plugins/nested/nonexecutable5", []byte("plugin4"), 0644)
+		WithFileAndMode("/plugins/nested/nonexecutable5", []byte("plugin4"), 0644).
+		WithFileAndMode("/plugins/.hidden6", []byte("plugin6"), 0755).
+		WithFileAndMode("/plugins/.install-123/executable7", []byte("plugin7"), 0755)
 
 	plugins, err := r.readPluginsDir(dir)
 	require.NoError(t, err)
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -32,6 +33,8 @@ import (
 type restartableBackupItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
+	// ctx is the context of the calls made through this restartableBackupItemAction, if it's not nil.
+	ctx context.Context
 }
 
 // newRestartableBackupItemAction returns a new restartableBackupItemAction.
@@ -50,6 +53,9 @@ func (r *restartableBackupItemAction) getBackupItemAction() (velero.BackupItemAc
 	if err != nil {
 		return nil, err
 	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
 
 	backupItemAction, ok := plugin.(velero.BackupItemAction)
 	if !ok {
@@ -68,6 +74,13 @@ func (r *restartableBackupItemAction) getDelegate() (velero.BackupItemAction, er
 	return r.getBackupItemAction()
 }
 
+// WithContext returns a copy of r whose calls to the plugin are made with ctx.
+func (r *restartableBackupItemAction) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
 // AppliesTo restarts the plugin's process if needed, then delegates the call.
 func (r *restartableBackupItemAction) AppliesTo() (velero.ResourceSelector, error) {
 	delegate, err := r.getDelegate()
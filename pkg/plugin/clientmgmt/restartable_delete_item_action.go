@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -31,6 +32,8 @@ type restartableDeleteItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
 	config              map[string]string
+	// ctx is the context of the calls made through this restartableDeleteItemAction, if it's not nil.
+	ctx context.Context
 }
 
 // newRestartableDeleteItemAction returns a new restartableDeleteItemAction.
@@ -49,6 +52,9 @@ func (r *restartableDeleteItemAction) getDeleteItemAction() (velero.DeleteItemAc
 	if err != nil {
 		return nil, err
 	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
 
 	deleteItemAction, ok := plugin.(velero.DeleteItemAction)
 	if !ok {
@@ -67,6 +73,13 @@ func (r *restartableDeleteItemAction) getDelegate() (velero.DeleteItemAction, er
 	return r.getDeleteItemAction()
 }
 
+// WithContext returns a copy of r whose calls to the plugin are made with ctx.
+func (r *restartableDeleteItemAction) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
 // AppliesTo restarts the plugin's process if needed, then delegates the call.
 func (r *restartableDeleteItemAction) AppliesTo() (velero.ResourceSelector, error) {
 	delegate, err := r.getDelegate()
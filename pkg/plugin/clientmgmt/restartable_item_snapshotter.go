@@ -0,0 +1,185 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableItemSnapshotter is an item snapshotter for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableItemSnapshotter asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableItemSnapshotter struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+	config              map[string]string
+	// timeout overrides the timeout of the plugin's kind for the calls made through
+	// this restartableItemSnapshotter, if it's not zero.
+	timeout time.Duration
+	// ctx is the context of the calls made through this restartableItemSnapshotter, if it's not nil.
+	ctx context.Context
+}
+
+// newRestartableItemSnapshotter returns a new restartableItemSnapshotter.
+func newRestartableItemSnapshotter(name string, sharedPluginProcess RestartableProcess) *restartableItemSnapshotter {
+	key := kindAndName{kind: framework.PluginKindItemSnapshotter, name: name}
+	r := &restartableItemSnapshotter{
+		key:                 key,
+		sharedPluginProcess: sharedPluginProcess,
+	}
+
+	// Register our reinitializer so we can reinitialize after a restart with r.config.
+	sharedPluginProcess.addReinitializer(key, r)
+
+	return r
+}
+
+// reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
+func (r *restartableItemSnapshotter) reinitialize(dispensed interface{}) error {
+	itemSnapshotter, ok := dispensed.(velero.ItemSnapshotter)
+	if !ok {
+		return errors.Errorf("%T is not an ItemSnapshotter!", dispensed)
+	}
+	return itemSnapshotter.Init(r.config)
+}
+
+// getItemSnapshotter returns the item snapshotter for this restartableItemSnapshotter. It does *not* restart the
+// plugin process.
+func (r *restartableItemSnapshotter) getItemSnapshotter() (velero.ItemSnapshotter, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
+	if r.timeout > 0 {
+		// the plugin is shared by every location of its provider, so it's copied
+		// with this location's timeout rather than changed.
+		plugin = framework.WithTimeout(plugin, r.timeout)
+	}
+
+	itemSnapshotter, ok := plugin.(velero.ItemSnapshotter)
+	if !ok {
+		return nil, errors.Errorf("%T is not an ItemSnapshotter!", plugin)
+	}
+
+	return itemSnapshotter, nil
+}
+
+// SetTimeout sets the time each call to the plugin may take, overriding the timeout
+// of the ItemSnapshotter plugin kind.
+func (r *restartableItemSnapshotter) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the item snapshotter for this restartableItemSnapshotter.
+func (r *restartableItemSnapshotter) getDelegate() (velero.ItemSnapshotter, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getItemSnapshotter()
+}
+
+// WithContext returns a copy of r whose calls to the plugin are made with ctx. Init must be
+// called on r rather than on the copy, since only r reinitializes the plugin after a restart.
+func (r *restartableItemSnapshotter) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
+// Init initializes the item snapshotter instance using config. If this is the first invocation, r stores config for future
+// reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
+func (r *restartableItemSnapshotter) Init(config map[string]string) error {
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
+	// Not using getDelegate() to avoid possible infinite recursion
+	delegate, err := r.getItemSnapshotter()
+	if err != nil {
+		return err
+	}
+
+	r.config = config
+
+	return delegate.Init(config)
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+	return delegate.AppliesTo()
+}
+
+// AlterBackupItem restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) AlterBackupItem(input *velero.AlterBackupItemInput) (runtime.Unstructured, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.AlterBackupItem(input)
+}
+
+// Snapshot restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) Snapshot(input *velero.SnapshotItemInput) (*velero.SnapshotItemOutput, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.Snapshot(input)
+}
+
+// Progress restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) Progress(input *velero.ItemSnapshotProgressInput) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+	return delegate.Progress(input)
+}
+
+// Delete restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) Delete(input *velero.DeleteItemSnapshotInput) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.Delete(input)
+}
+
+// Restore restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemSnapshotter) Restore(input *velero.RestoreItemFromSnapshotInput) (*velero.RestoreItemFromSnapshotOutput, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.Restore(input)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+)
+
+func TestRestartableItemSnapshotterReinitialize(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindItemSnapshotter, name: "velero.io/database"}
+	r := &restartableItemSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+		config: map[string]string{
+			"color": "blue",
+		},
+	}
+
+	err := r.reinitialize(3)
+	assert.EqualError(t, err, "int is not an ItemSnapshotter!")
+
+	itemSnapshotter := new(providermocks.ItemSnapshotter)
+	itemSnapshotter.Test(t)
+	defer itemSnapshotter.AssertExpectations(t)
+
+	itemSnapshotter.On("Init", r.config).Return(errors.Errorf("init error")).Once()
+	err = r.reinitialize(itemSnapshotter)
+	assert.EqualError(t, err, "init error")
+
+	itemSnapshotter.On("Init", r.config).Return(nil)
+	err = r.reinitialize(itemSnapshotter)
+	assert.NoError(t, err)
+}
+
+func TestRestartableItemSnapshotterInit(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindItemSnapshotter, name: "velero.io/database"}
+	r := &restartableItemSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	itemSnapshotter := new(providermocks.ItemSnapshotter)
+	itemSnapshotter.Test(t)
+	defer itemSnapshotter.AssertExpectations(t)
+	p.On("getByKindAndName", key).Return(itemSnapshotter, nil)
+
+	config := map[string]string{
+		"color": "blue",
+	}
+	itemSnapshotter.On("Init", config).Return(nil)
+	err := r.Init(config)
+	assert.NoError(t, err)
+	assert.Equal(t, config, r.config)
+
+	// Calling Init twice is forbidden
+	err = r.Init(config)
+	assert.EqualError(t, err, "already initialized")
+}
+
+func TestRestartableItemSnapshotterDelegatedFunctions(t *testing.T) {
+	item := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"color": "blue",
+		},
+	}
+
+	itemToReturn := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"color": "green",
+		},
+	}
+
+	backup := new(v1.Backup)
+	restore := new(v1.Restore)
+
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindItemSnapshotter,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableItemSnapshotter{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+		},
+		func() mockable {
+			return new(providermocks.ItemSnapshotter)
+		},
+		restartableDelegateTest{
+			function:                "AppliesTo",
+			inputs:                  []interface{}{},
+			expectedErrorOutputs:    []interface{}{velero.ResourceSelector{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.ResourceSelector{IncludedNamespaces: []string{"a"}}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "AlterBackupItem",
+			inputs:                  []interface{}{&velero.AlterBackupItemInput{Item: item, Backup: backup}},
+			expectedErrorOutputs:    []interface{}{nil, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{itemToReturn, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Snapshot",
+			inputs:                  []interface{}{&velero.SnapshotItemInput{Item: item, Backup: backup}},
+			expectedErrorOutputs:    []interface{}{(*velero.SnapshotItemOutput)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{&velero.SnapshotItemOutput{SnapshotID: "snapshot-1"}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Progress",
+			inputs:                  []interface{}{&velero.ItemSnapshotProgressInput{SnapshotID: "snapshot-1", Backup: backup}},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{Completed: true}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Delete",
+			inputs:                  []interface{}{&velero.DeleteItemSnapshotInput{SnapshotID: "snapshot-1"}},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Restore",
+			inputs:                  []interface{}{&velero.RestoreItemFromSnapshotInput{Item: item, ItemFromBackup: item, SnapshotID: "snapshot-1", Restore: restore}},
+			expectedErrorOutputs:    []interface{}{(*velero.RestoreItemFromSnapshotOutput)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{&velero.RestoreItemFromSnapshotOutput{UpdatedItem: itemToReturn}, errors.Errorf("delegate error")},
+		},
+	)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"io"
 	"time"
 
@@ -36,6 +37,11 @@ type restartableObjectStore struct {
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+	// timeout overrides the timeout of the plugin's kind for the calls made through
+	// this restartableObjectStore, if it's not zero.
+	timeout time.Duration
+	// ctx is the context of the calls made through this restartableObjectStore, if it's not nil.
+	ctx context.Context
 }
 
 // newRestartableObjectStore returns a new restartableObjectStore.
@@ -69,6 +75,14 @@ func (r *restartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
+	if r.timeout > 0 {
+		// the plugin is shared by every location of its provider, so it's copied
+		// with this location's timeout rather than changed.
+		plugin = framework.WithTimeout(plugin, r.timeout)
+	}
 
 	objectStore, ok := plugin.(velero.ObjectStore)
 	if !ok {
@@ -78,6 +92,12 @@ func (r *restartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
 	return objectStore, nil
 }
 
+// SetTimeout sets the time each call to the plugin may take, overriding the timeout
+// of the ObjectStore plugin kind.
+func (r *restartableObjectStore) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
 // getDelegate restarts the plugin process (if needed) and returns the object store for this restartableObjectStore.
 func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
 	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
@@ -87,6 +107,14 @@ func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
 	return r.getObjectStore()
 }
 
+// WithContext returns a copy of r whose calls to the plugin are made with ctx. Init must be
+// called on r rather than on the copy, since only r reinitializes the plugin after a restart.
+func (r *restartableObjectStore) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
 // Init initializes the object store instance using config. If this is the first invocation, r stores config for future
 // reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
 func (r *restartableObjectStore) Init(config map[string]string) error {
@@ -173,3 +201,17 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// PutLockedObject restarts the plugin's process if needed, then delegates the call if
+// the plugin supports object locks.
+func (r *restartableObjectStore) PutLockedObject(bucket string, key string, body io.Reader, lock velero.ObjectLock) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	locker, ok := delegate.(velero.ObjectLocker)
+	if !ok {
+		return errors.Errorf("object store plugin %s does not support object locks", r.key.name)
+	}
+	return locker.PutLockedObject(bucket, key, body, lock)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -202,6 +203,12 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "PutLockedObject",
+			inputs:                  []interface{}{"bucket", "key", strings.NewReader("body"), velero.ObjectLock{Mode: "Compliance", RetainUntil: time.Unix(1600000000, 0)}},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
 		restartableDelegateTest{
 			function:                "GetObject",
 			inputs:                  []interface{}{"bucket", "key"},
@@ -17,10 +17,25 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+const (
+	// healthCheckInterval is how often, at most, a restartableProcess checks the health of
+	// its plugin process before a call to one of its plugins.
+	healthCheckInterval = 30 * time.Second
+
+	// healthCheckTimeout is how long a health check may take before the plugin process is
+	// considered unhealthy.
+	healthCheckTimeout = 5 * time.Second
 )
 
 type RestartableProcessFactory interface {
@@ -28,14 +43,19 @@ type RestartableProcessFactory interface {
 }
 
 type restartableProcessFactory struct {
+	timeouts Timeouts
+	statuses *ProcessStatuses
 }
 
-func newRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+func newRestartableProcessFactory(timeouts Timeouts, statuses *ProcessStatuses) RestartableProcessFactory {
+	return &restartableProcessFactory{
+		timeouts: timeouts,
+		statuses: statuses,
+	}
 }
 
 func (rpf *restartableProcessFactory) newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(command, logger, logLevel, newProcessFactory(), rpf.timeouts, rpf.statuses)
 }
 
 type RestartableProcess interface {
@@ -47,19 +67,24 @@ type RestartableProcess interface {
 }
 
 // restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file. It is able
-// to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
-// the original configuration data.
+// to restart a plugin process if it is terminated for any reason, or if it fails a health check. If this happens,
+// all plugins are reinitialized using the original configuration data.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command        string
+	logger         logrus.FieldLogger
+	logLevel       logrus.Level
+	processFactory ProcessFactory
+	timeouts       Timeouts
+	statuses       *ProcessStatuses
+	clock          clock.Clock
 
 	// lock guards all of the fields below
-	lock           sync.RWMutex
-	process        Process
-	plugins        map[kindAndName]interface{}
-	reinitializers map[kindAndName]reinitializer
-	resetFailures  int
+	lock            sync.RWMutex
+	process         Process
+	plugins         map[kindAndName]interface{}
+	reinitializers  map[kindAndName]reinitializer
+	resetFailures   int
+	lastHealthCheck time.Time
 }
 
 // reinitializer is capable of reinitializing a restartable plugin instance using the newly dispensed plugin.
@@ -68,20 +93,28 @@ type reinitializer interface {
 	reinitialize(dispensed interface{}) error
 }
 
-// newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+// newRestartableProcess creates a new restartableProcess for the given command and options. The calls to its
+// plugins time out according to timeouts, and the health of its process is recorded in statuses.
+func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, processFactory ProcessFactory, timeouts Timeouts, statuses *ProcessStatuses) (RestartableProcess, error) {
 	p := &restartableProcess{
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
+		processFactory: processFactory,
+		timeouts:       timeouts,
+		statuses:       statuses,
+		clock:          clock.RealClock{},
 		plugins:        make(map[kindAndName]interface{}),
 		reinitializers: make(map[kindAndName]reinitializer),
 	}
 
 	// This launches the process
-	err := p.reset()
+	if err := p.reset(); err != nil {
+		return p, err
+	}
+	statuses.healthy(command)
 
-	return p, err
+	return p, nil
 }
 
 // addReinitializer registers the reinitializer r for key.
@@ -101,20 +134,33 @@ func (p *restartableProcess) reset() error {
 }
 
 // resetLH (re)launches the plugin process. It redispenses all previously dispensed plugins and reinitializes all the
-// registered reinitializers using the newly dispensed plugins.
+// registered reinitializers using the newly dispensed plugins. If that fails, the process is recorded as failed.
 //
 // Callers of resetLH *must* acquire the lock before calling it.
 func (p *restartableProcess) resetLH() error {
+	if err := p.launchLH(); err != nil {
+		p.statuses.failed(p.command, err)
+		return err
+	}
+
+	return nil
+}
+
+// launchLH does the work of resetLH.
+//
+// Callers of launchLH *must* acquire the lock before calling it.
+func (p *restartableProcess) launchLH() error {
 	if p.resetFailures > 10 {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := p.processFactory.newProcess(p.command, p.logger, p.logLevel)
 	if err != nil {
 		p.resetFailures++
 		return err
 	}
 	p.process = process
+	p.lastHealthCheck = p.clock.Now()
 
 	// Redispense any previously dispensed plugins, reinitializing if necessary.
 	// Start by creating a new map to hold the newly dispensed plugins.
@@ -131,7 +177,7 @@ func (p *restartableProcess) resetLH() error {
 
 		// Reinitialize
 		if r, found := p.reinitializers[key]; found {
-			if err := r.reinitialize(dispensed); err != nil {
+			if err := r.reinitialize(framework.WithTimeout(dispensed, p.timeouts[key.kind])); err != nil {
 				p.resetFailures++
 				return err
 			}
@@ -146,15 +192,41 @@ func (p *restartableProcess) resetLH() error {
 	return nil
 }
 
-// resetIfNeeded checks if the plugin process has exited and resets p if it has.
+// resetIfNeeded checks if the plugin process has exited and resets p if it has. If the process hasn't been
+// checked for healthCheckInterval, it also checks the process's health, and kills and resets it if it's unhealthy.
 func (p *restartableProcess) resetIfNeeded() error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
 	if p.process.exited() {
 		p.logger.Info("Plugin process exited - restarting.")
-		return p.resetLH()
+		return p.restartLH("plugin process exited")
+	}
+
+	if p.clock.Since(p.lastHealthCheck) < healthCheckInterval {
+		return nil
 	}
+	p.lastHealthCheck = p.clock.Now()
+
+	if err := p.process.ping(healthCheckTimeout); err != nil {
+		p.logger.WithError(err).Warn("Plugin process failed its health check - restarting.")
+		p.process.kill()
+		return p.restartLH(fmt.Sprintf("plugin process failed its health check: %v", err))
+	}
+
+	return nil
+}
+
+// restartLH records that the plugin process crashed for reason, then resets p.
+//
+// Callers of restartLH *must* acquire the lock before calling it.
+func (p *restartableProcess) restartLH(reason string) error {
+	p.statuses.crashed(p.command, reason)
+
+	if err := p.resetLH(); err != nil {
+		return err
+	}
+	p.statuses.restarted(p.command)
 
 	return nil
 }
@@ -168,19 +240,21 @@ func (p *restartableProcess) getByKindAndName(key kindAndName) (interface{}, err
 }
 
 // getByKindAndNameLH returns the dispensed plugin for key. If the plugin hasn't been dispensed before, it dispenses a
-// new one.
+// new one. The plugin's calls time out after the timeout of its kind.
 func (p *restartableProcess) getByKindAndNameLH(key kindAndName) (interface{}, error) {
 	dispensed, found := p.plugins[key]
-	if found {
-		return dispensed, nil
+	if !found {
+		var err error
+		dispensed, err = p.process.dispense(key)
+		if err != nil {
+			return nil, err
+		}
+		p.plugins[key] = dispensed
 	}
 
-	dispensed, err := p.process.dispense(key)
-	if err != nil {
-		return nil, err
-	}
-	p.plugins[key] = dispensed
-	return p.plugins[key], nil
+	// The dispensed plugin is shared, so the callers get a copy with the timeout of
+	// its kind, which they may copy again with their own timeout.
+	return framework.WithTimeout(dispensed, p.timeouts[key.kind]), nil
 }
 
 // stop terminates the plugin process.
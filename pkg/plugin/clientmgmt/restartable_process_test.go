@@ -0,0 +1,233 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type mockProcessFactory struct {
+	mock.Mock
+}
+
+func (f *mockProcessFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+	args := f.Called(command)
+	var p Process
+	if args.Get(0) != nil {
+		p = args.Get(0).(Process)
+	}
+	return p, args.Error(1)
+}
+
+type mockProcess struct {
+	mock.Mock
+}
+
+func (p *mockProcess) dispense(key kindAndName) (interface{}, error) {
+	args := p.Called(key)
+	return args.Get(0), args.Error(1)
+}
+
+func (p *mockProcess) exited() bool {
+	args := p.Called()
+	return args.Bool(0)
+}
+
+func (p *mockProcess) ping(timeout time.Duration) error {
+	args := p.Called(timeout)
+	return args.Error(0)
+}
+
+func (p *mockProcess) kill() {
+	p.Called()
+}
+
+// timeoutClient is a dispensed plugin client that records its timeout.
+type timeoutClient struct {
+	timeout time.Duration
+}
+
+func (c *timeoutClient) WithTimeout(timeout time.Duration) interface{} {
+	return &timeoutClient{timeout: timeout}
+}
+
+func newTestRestartableProcess(t *testing.T, factory ProcessFactory, statuses *ProcessStatuses) *restartableProcess {
+	t.Helper()
+
+	rp, err := newRestartableProcess("/plugins/velero-plugin", test.NewLogger(), logrus.InfoLevel, factory, DefaultTimeouts(), statuses)
+	require.NoError(t, err)
+
+	p := rp.(*restartableProcess)
+	p.clock = clock.NewFakeClock(time.Now())
+	p.lastHealthCheck = p.clock.Now()
+	return p
+}
+
+func TestRestartableProcessRestartsExitedProcess(t *testing.T) {
+	factory := new(mockProcessFactory)
+	first, second := new(mockProcess), new(mockProcess)
+	factory.On("newProcess", "/plugins/velero-plugin").Return(first, nil).Once()
+	factory.On("newProcess", "/plugins/velero-plugin").Return(second, nil).Once()
+
+	statuses := NewProcessStatuses(nil)
+	p := newTestRestartableProcess(t, factory, statuses)
+
+	status, found := statuses.Get("/plugins/velero-plugin")
+	require.True(t, found)
+	assert.Equal(t, velerov1api.PluginStatusHealthy, status.Status)
+
+	first.On("exited").Return(true)
+	require.NoError(t, p.resetIfNeeded())
+	assert.Equal(t, second, p.process)
+
+	status, _ = statuses.Get("/plugins/velero-plugin")
+	assert.Equal(t, ProcessStatus{
+		Status:    velerov1api.PluginStatusHealthy,
+		Restarts:  1,
+		LastError: "plugin process exited",
+	}, status)
+	factory.AssertExpectations(t)
+}
+
+func TestRestartableProcessChecksHealth(t *testing.T) {
+	factory := new(mockProcessFactory)
+	first, second := new(mockProcess), new(mockProcess)
+	factory.On("newProcess", "/plugins/velero-plugin").Return(first, nil).Once()
+	factory.On("newProcess", "/plugins/velero-plugin").Return(second, nil).Once()
+
+	statuses := NewProcessStatuses(nil)
+	p := newTestRestartableProcess(t, factory, statuses)
+	fakeClock := p.clock.(*clock.FakeClock)
+
+	first.On("exited").Return(false)
+
+	// the process isn't checked again until the health check interval passes.
+	require.NoError(t, p.resetIfNeeded())
+	first.AssertNotCalled(t, "ping", mock.Anything)
+
+	fakeClock.Step(healthCheckInterval)
+	first.On("ping", healthCheckTimeout).Return(errors.New("connection refused")).Once()
+	first.On("kill").Once()
+	require.NoError(t, p.resetIfNeeded())
+
+	first.AssertExpectations(t)
+	assert.Equal(t, second, p.process)
+
+	status, _ := statuses.Get("/plugins/velero-plugin")
+	assert.Equal(t, velerov1api.PluginStatusHealthy, status.Status)
+	assert.Equal(t, 1, status.Restarts)
+	assert.Equal(t, "plugin process failed its health check: connection refused", status.LastError)
+}
+
+func TestRestartableProcessRecordsFailedRestart(t *testing.T) {
+	factory := new(mockProcessFactory)
+	first := new(mockProcess)
+	factory.On("newProcess", "/plugins/velero-plugin").Return(first, nil).Once()
+	factory.On("newProcess", "/plugins/velero-plugin").Return(nil, errors.New("exec format error")).Once()
+
+	statuses := NewProcessStatuses(nil)
+	p := newTestRestartableProcess(t, factory, statuses)
+
+	first.On("exited").Return(true)
+	assert.EqualError(t, p.resetIfNeeded(), "exec format error")
+
+	status, _ := statuses.Get("/plugins/velero-plugin")
+	assert.Equal(t, ProcessStatus{
+		Status:    velerov1api.PluginStatusFailed,
+		LastError: "exec format error",
+	}, status)
+}
+
+func TestRestartableProcessSetsPluginTimeouts(t *testing.T) {
+	factory := new(mockProcessFactory)
+	process := new(mockProcess)
+	factory.On("newProcess", "/plugins/velero-plugin").Return(process, nil).Once()
+
+	p := newTestRestartableProcess(t, factory, nil)
+
+	action := new(timeoutClient)
+	key := kindAndName{kind: framework.PluginKindBackupItemAction, name: "velero.io/pod"}
+	process.On("dispense", key).Return(action, nil).Once()
+
+	// the shared dispensed plugin isn't changed, callers get a copy with the timeout
+	// of its kind.
+	dispensed, err := p.getByKindAndName(key)
+	require.NoError(t, err)
+	assert.Equal(t, &timeoutClient{timeout: 10 * time.Minute}, dispensed)
+	assert.Zero(t, action.timeout)
+
+	objectStore := new(timeoutClient)
+	key = kindAndName{kind: framework.PluginKindObjectStore, name: "velero.io/aws"}
+	process.On("dispense", key).Return(objectStore, nil).Once()
+
+	// object stores don't time out by default.
+	dispensed, err = p.getByKindAndName(key)
+	require.NoError(t, err)
+	assert.Equal(t, &timeoutClient{}, dispensed)
+}
+
+func TestRestartableObjectStoreLocationTimeout(t *testing.T) {
+	factory := new(mockProcessFactory)
+	process := new(mockProcess)
+	factory.On("newProcess", "/plugins/velero-plugin").Return(process, nil).Once()
+
+	p := newTestRestartableProcess(t, factory, nil)
+
+	objectStore := &timeoutObjectStore{}
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: "velero.io/aws"}
+	process.On("dispense", key).Return(objectStore, nil).Once()
+
+	// two locations of the same provider share the dispensed plugin, but each one's
+	// calls get its own timeout.
+	first := newRestartableObjectStore("velero.io/aws", p)
+	first.SetTimeout(time.Minute)
+	second := newRestartableObjectStore("velero.io/aws", p)
+	second.SetTimeout(time.Hour)
+
+	delegate, err := first.getObjectStore()
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, delegate.(*timeoutObjectStore).timeout)
+
+	delegate, err = second.getObjectStore()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, delegate.(*timeoutObjectStore).timeout)
+
+	assert.Zero(t, objectStore.timeout)
+}
+
+// timeoutObjectStore is a dispensed object store plugin client that records its timeout.
+type timeoutObjectStore struct {
+	providermocks.ObjectStore
+	timeout time.Duration
+}
+
+func (c *timeoutObjectStore) WithTimeout(timeout time.Duration) interface{} {
+	return &timeoutObjectStore{timeout: timeout}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -31,6 +32,8 @@ type restartableRestoreItemAction struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
 	config              map[string]string
+	// ctx is the context of the calls made through this restartableRestoreItemAction, if it's not nil.
+	ctx context.Context
 }
 
 // newRestartableRestoreItemAction returns a new restartableRestoreItemAction.
@@ -49,6 +52,9 @@ func (r *restartableRestoreItemAction) getRestoreItemAction() (velero.RestoreIte
 	if err != nil {
 		return nil, err
 	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
 
 	restoreItemAction, ok := plugin.(velero.RestoreItemAction)
 	if !ok {
@@ -67,6 +73,13 @@ func (r *restartableRestoreItemAction) getDelegate() (velero.RestoreItemAction,
 	return r.getRestoreItemAction()
 }
 
+// WithContext returns a copy of r whose calls to the plugin are made with ctx.
+func (r *restartableRestoreItemAction) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
 // AppliesTo restarts the plugin's process if needed, then delegates the call.
 func (r *restartableRestoreItemAction) AppliesTo() (velero.ResourceSelector, error) {
 	delegate, err := r.getDelegate()
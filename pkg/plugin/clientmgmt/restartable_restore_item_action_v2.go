@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"context"
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+)
+
+// restartableRestoreItemActionV2 is a version 2 restore item action for a given implementation (such as "pod"). It is
+// associated with a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of
+// each method call, the restartableRestoreItemActionV2 asks its restartableProcess to restart itself if needed (e.g.
+// if the process terminated for any reason), then it proceeds with the actual call.
+type restartableRestoreItemActionV2 struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+	// ctx is the context of the calls made through this restartableRestoreItemActionV2, if it's not nil.
+	ctx context.Context
+}
+
+// newRestartableRestoreItemActionV2 returns a new restartableRestoreItemActionV2.
+func newRestartableRestoreItemActionV2(name string, sharedPluginProcess RestartableProcess) *restartableRestoreItemActionV2 {
+	r := &restartableRestoreItemActionV2{
+		key:                 kindAndName{kind: framework.PluginKindRestoreItemActionV2, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getRestoreItemAction returns the restore item action for this restartableRestoreItemActionV2. It does *not* restart
+// the plugin process.
+func (r *restartableRestoreItemActionV2) getRestoreItemAction() (riav2.RestoreItemAction, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
+
+	restoreItemAction, ok := plugin.(riav2.RestoreItemAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a RestoreItemAction/v2!", plugin)
+	}
+
+	return restoreItemAction, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the restore item action for this
+// restartableRestoreItemActionV2.
+func (r *restartableRestoreItemActionV2) getDelegate() (riav2.RestoreItemAction, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getRestoreItemAction()
+}
+
+// WithContext returns a copy of r whose calls to the plugin are made with ctx.
+func (r *restartableRestoreItemActionV2) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
+// Name returns the name of the plugin.
+func (r *restartableRestoreItemActionV2) Name() string {
+	return r.key.name
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	return delegate.AppliesTo()
+}
+
+// Execute restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	return delegate.Execute(input)
+}
+
+// Progress restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) Progress(operationID string, restore *api.Restore) (velero.OperationProgress, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+
+	return delegate.Progress(operationID, restore)
+}
+
+// Cancel restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreItemActionV2) Cancel(operationID string, restore *api.Restore) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	return delegate.Cancel(operationID, restore)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restore/mocks"
+)
+
+func TestRestartableGetRestoreItemActionV2(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name:          "error getting by kind and name",
+			getError:      errors.Errorf("get error"),
+			expectedError: "get error",
+		},
+		{
+			name:          "version 1 plugin",
+			plugin:        new(mocks.ItemAction),
+			expectedError: "*mocks.ItemAction is not a RestoreItemAction/v2!",
+		},
+		{
+			name:   "happy path",
+			plugin: new(riav2mocks.RestoreItemAction),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(mockRestartableProcess)
+			defer p.AssertExpectations(t)
+
+			name := "pod"
+			key := kindAndName{kind: framework.PluginKindRestoreItemActionV2, name: name}
+			p.On("getByKindAndName", key).Return(tc.plugin, tc.getError)
+
+			r := newRestartableRestoreItemActionV2(name, p)
+			a, err := r.getRestoreItemAction()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartableRestoreItemActionV2DelegatedFunctions(t *testing.T) {
+	r := new(v1.Restore)
+
+	pv := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"color": "blue",
+		},
+	}
+
+	input := &velero.RestoreItemActionExecuteInput{
+		Item:           pv,
+		ItemFromBackup: pv,
+		Restore:        r,
+	}
+
+	output := &velero.RestoreItemActionExecuteOutput{
+		UpdatedItem: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"color": "green",
+			},
+		},
+		OperationID: "operation-1",
+	}
+
+	runRestartableDelegateTests(
+		t,
+		framework.PluginKindRestoreItemActionV2,
+		func(key kindAndName, p RestartableProcess) interface{} {
+			return &restartableRestoreItemActionV2{
+				key:                 key,
+				sharedPluginProcess: p,
+			}
+		},
+		func() mockable {
+			return new(riav2mocks.RestoreItemAction)
+		},
+		restartableDelegateTest{
+			function:                "AppliesTo",
+			inputs:                  []interface{}{},
+			expectedErrorOutputs:    []interface{}{velero.ResourceSelector{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.ResourceSelector{IncludedNamespaces: []string{"a"}}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Execute",
+			inputs:                  []interface{}{input},
+			expectedErrorOutputs:    []interface{}{nil, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{output, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Progress",
+			inputs:                  []interface{}{"operation-1", r},
+			expectedErrorOutputs:    []interface{}{velero.OperationProgress{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.OperationProgress{Completed: true}, errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "Cancel",
+			inputs:                  []interface{}{"operation-1", r},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+	)
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
+	"time"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -32,6 +35,11 @@ type restartableVolumeSnapshotter struct {
 	key                 kindAndName
 	sharedPluginProcess RestartableProcess
 	config              map[string]string
+	// timeout overrides the timeout of the plugin's kind for the calls made through
+	// this restartableVolumeSnapshotter, if it's not zero.
+	timeout time.Duration
+	// ctx is the context of the calls made through this restartableVolumeSnapshotter, if it's not nil.
+	ctx context.Context
 }
 
 // newRestartableVolumeSnapshotter returns a new restartableVolumeSnapshotter.
@@ -64,6 +72,14 @@ func (r *restartableVolumeSnapshotter) getVolumeSnapshotter() (velero.VolumeSnap
 	if err != nil {
 		return nil, err
 	}
+	if r.ctx != nil {
+		plugin = framework.WithContext(plugin, r.ctx)
+	}
+	if r.timeout > 0 {
+		// the plugin is shared by every location of its provider, so it's copied
+		// with this location's timeout rather than changed.
+		plugin = framework.WithTimeout(plugin, r.timeout)
+	}
 
 	volumeSnapshotter, ok := plugin.(velero.VolumeSnapshotter)
 	if !ok {
@@ -73,6 +89,12 @@ func (r *restartableVolumeSnapshotter) getVolumeSnapshotter() (velero.VolumeSnap
 	return volumeSnapshotter, nil
 }
 
+// SetTimeout sets the time each call to the plugin may take, overriding the timeout
+// of the VolumeSnapshotter plugin kind.
+func (r *restartableVolumeSnapshotter) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
 // getDelegate restarts the plugin process (if needed) and returns the volume snapshotter for this restartableVolumeSnapshotter.
 func (r *restartableVolumeSnapshotter) getDelegate() (velero.VolumeSnapshotter, error) {
 	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
@@ -82,6 +104,14 @@ func (r *restartableVolumeSnapshotter) getDelegate() (velero.VolumeSnapshotter,
 	return r.getVolumeSnapshotter()
 }
 
+// WithContext returns a copy of r whose calls to the plugin are made with ctx. Init must be
+// called on r rather than on the copy, since only r reinitializes the plugin after a restart.
+func (r *restartableVolumeSnapshotter) WithContext(ctx context.Context) interface{} {
+	copy := *r
+	copy.ctx = ctx
+	return &copy
+}
+
 // Init initializes the volume snapshotter instance using config. If this is the first invocation, r stores config for future
 // reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
 func (r *restartableVolumeSnapshotter) Init(config map[string]string) error {
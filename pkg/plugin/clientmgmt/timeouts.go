@@ -0,0 +1,70 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+// Timeouts holds how long each call to a plugin may take, by plugin kind. Calls to
+// plugins of kinds that aren't in it don't time out.
+type Timeouts map[framework.PluginKind]time.Duration
+
+// DefaultTimeouts returns the plugin call timeouts Velero uses unless they're configured.
+// Calls to object stores, volume snapshotters and item snapshotters don't time out by
+// default: they upload or download whole files, or wait for the cloud provider, for as
+// long as it takes. Their timeout is opted into for a kind or, with its plugin timeout,
+// for a backup storage or volume snapshot location.
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		framework.PluginKindBackupItemAction:    10 * time.Minute,
+		framework.PluginKindBackupItemActionV2:  10 * time.Minute,
+		framework.PluginKindRestoreItemAction:   10 * time.Minute,
+		framework.PluginKindRestoreItemActionV2: 10 * time.Minute,
+		framework.PluginKindDeleteItemAction:    10 * time.Minute,
+		framework.PluginKindPluginLister:        time.Minute,
+	}
+}
+
+// ParseTimeouts returns the default timeouts, overridden by config, which maps plugin
+// kinds to durations, like "ObjectStore": "2h". A duration of 0 disables the timeout.
+func ParseTimeouts(config map[string]string) (Timeouts, error) {
+	timeouts := DefaultTimeouts()
+
+	for kindName, value := range config {
+		kind, ok := framework.AllPluginKinds()[kindName]
+		if !ok {
+			return nil, errors.Errorf("invalid plugin kind %q", kindName)
+		}
+
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout for plugin kind %s", kindName)
+		}
+		if timeout < 0 {
+			return nil, errors.Errorf("invalid timeout for plugin kind %s: must not be negative", kindName)
+		}
+
+		timeouts[kind] = timeout
+	}
+
+	return timeouts, nil
+}
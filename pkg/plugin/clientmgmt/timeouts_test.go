@@ -0,0 +1,87 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+func TestParseTimeouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]string
+		want        map[framework.PluginKind]time.Duration
+		expectedErr string
+	}{
+		{
+			name: "no config returns the defaults",
+			want: map[framework.PluginKind]time.Duration{
+				framework.PluginKindObjectStore:       0,
+				framework.PluginKindVolumeSnapshotter: 0,
+				framework.PluginKindBackupItemAction:  10 * time.Minute,
+			},
+		},
+		{
+			name: "configured kinds override the defaults",
+			config: map[string]string{
+				"ObjectStore":         "2h",
+				"BackupItemAction/v2": "0",
+			},
+			want: map[framework.PluginKind]time.Duration{
+				framework.PluginKindObjectStore:        2 * time.Hour,
+				framework.PluginKindBackupItemActionV2: 0,
+				framework.PluginKindBackupItemAction:   10 * time.Minute,
+			},
+		},
+		{
+			name:        "unknown kind is an error",
+			config:      map[string]string{"Unknown": "1m"},
+			expectedErr: "invalid plugin kind \"Unknown\"",
+		},
+		{
+			name:        "invalid duration is an error",
+			config:      map[string]string{"ObjectStore": "soon"},
+			expectedErr: "invalid timeout for plugin kind ObjectStore: time: invalid duration \"soon\"",
+		},
+		{
+			name:        "negative duration is an error",
+			config:      map[string]string{"ObjectStore": "-1m"},
+			expectedErr: "invalid timeout for plugin kind ObjectStore: must not be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			timeouts, err := ParseTimeouts(tc.config)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for kind, want := range tc.want {
+				assert.Equal(t, want, timeouts[kind], "timeout for %s", kind)
+			}
+		})
+	}
+}
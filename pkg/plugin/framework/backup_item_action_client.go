@@ -17,10 +17,11 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,12 +53,29 @@ func newBackupItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 	}
 }
 
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *BackupItemActionGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *BackupItemActionGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
 func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
 	req := &proto.BackupItemActionAppliesToRequest{
 		Plugin: c.plugin,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -92,7 +110,10 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, nil, fromGRPCError(err)
 	}
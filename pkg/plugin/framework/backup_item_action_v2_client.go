@@ -0,0 +1,192 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// NewBackupItemActionV2Plugin constructs a BackupItemActionV2Plugin.
+func NewBackupItemActionV2Plugin(options ...PluginOption) *BackupItemActionV2Plugin {
+	return &BackupItemActionV2Plugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupItemActionV2GRPCClient implements the version 2 backup/ItemAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type BackupItemActionV2GRPCClient struct {
+	*clientBase
+	grpcClient proto.BackupItemActionV2Client
+}
+
+func newBackupItemActionV2GRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupItemActionV2GRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewBackupItemActionV2Client(clientConn),
+	}
+}
+
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *BackupItemActionV2GRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *BackupItemActionV2GRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
+func (c *BackupItemActionV2GRPCClient) Name() string {
+	return c.plugin
+}
+
+func (c *BackupItemActionV2GRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	req := &proto.BackupItemActionAppliesToRequest{
+		Plugin: c.plugin,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *BackupItemActionV2GRPCClient) Execute(item runtime.Unstructured, backup *api.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error) {
+	itemJSON, err := json.Marshal(item.UnstructuredContent())
+	if err != nil {
+		return nil, nil, "", errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return nil, nil, "", errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionV2ExecuteRequest{
+		Plugin:  c.plugin,
+		Item:    itemJSON,
+		Backup:  backupJSON,
+		Cluster: clusterContextToProto(cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
+	if err != nil {
+		return nil, nil, "", fromGRPCError(err)
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &updatedItem); err != nil {
+		return nil, nil, "", errors.WithStack(err)
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+
+	for _, itm := range res.AdditionalItems {
+		newItem := velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		}
+
+		additionalItems = append(additionalItems, newItem)
+	}
+
+	return &updatedItem, additionalItems, res.OperationID, nil
+}
+
+func (c *BackupItemActionV2GRPCClient) Progress(operationID string, backup *api.Backup) (velero.OperationProgress, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionV2ProgressRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+		Backup:      backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, fromGRPCError(err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+func (c *BackupItemActionV2GRPCClient) Cancel(operationID string, backup *api.Backup) error {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.BackupItemActionV2CancelRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+		Backup:      backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Cancel(ctx, req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// Capabilities are optional features of a plugin kind. A plugin reports the capabilities it
+// implements through the PluginLister, so that Velero knows which optional calls it can make
+// before making them.
+const (
+	// CapabilityObjectLock is reported by ObjectStore plugins that implement velero.ObjectLocker.
+	CapabilityObjectLock = "ObjectLock"
+)
+
+// pluginCapabilities returns the capabilities implemented by impl, a plugin of the given kind.
+func pluginCapabilities(kind PluginKind, impl interface{}) []string {
+	var capabilities []string
+
+	switch kind {
+	case PluginKindObjectStore:
+		if _, ok := impl.(velero.ObjectLocker); ok {
+			capabilities = append(capabilities, CapabilityObjectLock)
+		}
+	}
+
+	return capabilities
+}
+
+// HasCapability returns whether id reported capability.
+func (id PluginIdentifier) HasCapability(capability string) bool {
+	for _, c := range id.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
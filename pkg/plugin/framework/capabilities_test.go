@@ -0,0 +1,55 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetNamesReportsCapabilities(t *testing.T) {
+	logger := test.NewLogger()
+	plugin := NewObjectStorePlugin(serverLogger(logger))
+
+	plugin.register("velero.io/locking", func(logrus.FieldLogger) (interface{}, error) {
+		return new(mocks.ObjectStore), nil
+	})
+	plugin.register("velero.io/plain", func(logrus.FieldLogger) (interface{}, error) {
+		return struct{ velero.ObjectStore }{}, nil
+	})
+	plugin.register("velero.io/broken", func(logrus.FieldLogger) (interface{}, error) {
+		return nil, errors.New("init error")
+	})
+
+	ids := getNames("/command", PluginKindObjectStore, plugin, logger)
+
+	assert.Equal(t, []PluginIdentifier{
+		{Command: "/command", Kind: PluginKindObjectStore, Name: "velero.io/broken"},
+		{Command: "/command", Kind: PluginKindObjectStore, Name: "velero.io/locking", Capabilities: []string{CapabilityObjectLock}},
+		{Command: "/command", Kind: PluginKindObjectStore, Name: "velero.io/plain"},
+	}, ids)
+
+	assert.True(t, ids[1].HasCapability(CapabilityObjectLock))
+	assert.False(t, ids[2].HasCapability(CapabilityObjectLock))
+}
@@ -17,14 +17,134 @@ limitations under the License.
 package framework
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // clientBase implements client and contains shared fields common to all clients.
 type clientBase struct {
 	plugin string
 	logger logrus.FieldLogger
+
+	// timeout is how long each call to the plugin server may take. Zero means calls
+	// don't time out. It's never changed, since clients are shared: a client with a
+	// different timeout is a copy, made by withTimeout.
+	timeout time.Duration
+
+	// ctx is the context of the operation the client's calls are made for, like the
+	// backup of an item, so the spans of the calls are part of its trace. Nil means
+	// context.Background(). Like timeout, it's only set on copies, made by withContext.
+	ctx context.Context
+}
+
+// Timeouter is implemented by the gRPC clients of all plugin kinds.
+type Timeouter interface {
+	// WithTimeout returns a copy of the client whose calls to the plugin may take
+	// timeout before they're canceled. Zero means calls don't time out. The client
+	// itself isn't changed, so it can be shared by callers with different timeouts.
+	WithTimeout(timeout time.Duration) interface{}
+}
+
+// WithTimeout returns plugin with its calls timing out after timeout, if it supports
+// timeouts, or plugin itself if it doesn't.
+func WithTimeout(plugin interface{}, timeout time.Duration) interface{} {
+	if timeouter, ok := plugin.(Timeouter); ok {
+		return timeouter.WithTimeout(timeout)
+	}
+	return plugin
+}
+
+// Contexter is implemented by the gRPC clients of all plugin kinds.
+type Contexter interface {
+	// WithContext returns a copy of the client whose calls to the plugin are made with
+	// ctx, so they're canceled with it and their spans are children of its span. The
+	// client itself isn't changed, so it can be shared by callers with different contexts.
+	WithContext(ctx context.Context) interface{}
+}
+
+// WithContext returns plugin with its calls made with ctx, if it supports contexts, or
+// plugin itself if it doesn't.
+func WithContext(plugin interface{}, ctx context.Context) interface{} {
+	if contexter, ok := plugin.(Contexter); ok {
+		return contexter.WithContext(ctx)
+	}
+	return plugin
+}
+
+// TimeoutSetter is implemented by the plugins of the kinds backup storage and volume
+// snapshot locations are for.
+type TimeoutSetter interface {
+	// SetTimeout sets the time each call to the plugin may take before it's canceled,
+	// overriding the timeout of the plugin's kind.
+	SetTimeout(timeout time.Duration)
+}
+
+// SetLocationTimeout sets the timeout of plugin's calls to the plugin timeout of a backup
+// storage or volume snapshot location, if the location has one and plugin supports it.
+func SetLocationTimeout(plugin interface{}, timeout *metav1.Duration) {
+	if timeout == nil {
+		return
+	}
+	if setter, ok := plugin.(TimeoutSetter); ok {
+		setter.SetTimeout(timeout.Duration)
+	}
+}
+
+// withTimeout returns a copy of c with the given timeout.
+func (c *clientBase) withTimeout(timeout time.Duration) *clientBase {
+	copy := *c
+	copy.timeout = timeout
+	return &copy
+}
+
+// withContext returns a copy of c with the given context.
+func (c *clientBase) withContext(ctx context.Context) *clientBase {
+	copy := *c
+	copy.ctx = ctx
+	return &copy
+}
+
+// parentContext returns the context the client's calls are made with.
+func (c *clientBase) parentContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// callContext returns the context for calls to the plugin server, which is canceled
+// once the client's timeout has passed, or with the client's context. The caller must
+// call the returned cancel func once the call is done.
+func (c *clientBase) callContext() (context.Context, context.CancelFunc) {
+	ctx := c.parentContext()
+
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// streamContext returns the context for streaming calls to the plugin server, like
+// uploading or downloading an object, which may take any time in total. It's canceled
+// once the client's timeout has passed without the call making progress, which is
+// reported by calling the returned progress func, like after each message is sent or
+// received. The caller must call the returned cancel func once the call is done.
+func (c *clientBase) streamContext() (context.Context, context.CancelFunc, func()) {
+	ctx, cancel := context.WithCancel(c.parentContext())
+	if c.timeout <= 0 {
+		return ctx, cancel, func() {}
+	}
+
+	timer := time.AfterFunc(c.timeout, cancel)
+	stop := func() {
+		timer.Stop()
+		cancel()
+	}
+	return ctx, stop, func() { timer.Reset(c.timeout) }
 }
 
 type ClientDispenser interface {
@@ -17,6 +17,7 @@ package framework
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,3 +80,44 @@ func TestClientFor(t *testing.T) {
 	typed = actual.(*fakeClient)
 	assert.Equal(t, 1, count)
 }
+
+func TestClientBaseCallContext(t *testing.T) {
+	base := &clientBase{plugin: "pod", logger: test.NewLogger()}
+
+	ctx, cancel := base.callContext()
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+	cancel()
+	assert.Error(t, ctx.Err())
+
+	withTimeout := base.withTimeout(time.Minute)
+	assert.Zero(t, base.timeout)
+	ctx, cancel = withTimeout.callContext()
+	defer cancel()
+	deadline, hasDeadline := ctx.Deadline()
+	require.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+}
+
+func TestClientBaseStreamContext(t *testing.T) {
+	base := &clientBase{plugin: "pod", logger: test.NewLogger(), timeout: 50 * time.Millisecond}
+
+	ctx, cancel, progress := base.streamContext()
+	defer cancel()
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+
+	// the stream isn't canceled as long as it makes progress within the timeout,
+	// however long it takes in total.
+	for i := 0; i < 4; i++ {
+		time.Sleep(25 * time.Millisecond)
+		require.NoError(t, ctx.Err())
+		progress()
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream without progress wasn't canceled")
+	}
+}
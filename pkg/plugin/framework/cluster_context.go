@@ -0,0 +1,106 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ClusterClientConfig returns a client config for the cluster a backup, restore or
+// backup deletion operates on. A remote cluster is accessed with the short-lived
+// token the Velero server minted for the plugin, so the config should be built for
+// each call rather than cached by the plugin.
+func ClusterClientConfig(cluster velero.ClusterContext) (*rest.Config, error) {
+	if cluster.IsLocal() {
+		// plugins run in the Velero server's pod, so they have the same access to
+		// the cluster Velero runs in.
+		return client.Config("", "", "velero-plugin", 0, 0)
+	}
+
+	return remoteClusterClientConfig(cluster)
+}
+
+func remoteClusterClientConfig(cluster velero.ClusterContext) (*rest.Config, error) {
+	if cluster.Token == "" {
+		return nil, errors.Errorf("the Velero server isn't configured to give plugins access to cluster %s", cluster.Host)
+	}
+
+	config := &rest.Config{
+		Host:        cluster.Host,
+		BearerToken: cluster.Token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   cluster.CAData,
+			Insecure: cluster.Insecure,
+		},
+	}
+	if cluster.ProxyURL != "" {
+		proxyURL, err := url.Parse(cluster.ProxyURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing the proxy URL of cluster %s", cluster.Host)
+		}
+		config.Proxy = http.ProxyURL(proxyURL)
+	}
+	return config, nil
+}
+
+// ClusterKubeClient returns a Kubernetes client for the cluster a backup, restore or
+// backup deletion operates on.
+func ClusterKubeClient(cluster velero.ClusterContext) (kubernetes.Interface, error) {
+	config, err := ClusterClientConfig(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return kubeClient, nil
+}
+
+func clusterContextToProto(cluster velero.ClusterContext) *proto.ClusterContext {
+	return &proto.ClusterContext{
+		Host:     cluster.Host,
+		Token:    cluster.Token,
+		CaData:   cluster.CAData,
+		Insecure: cluster.Insecure,
+		ProxyURL: cluster.ProxyURL,
+	}
+}
+
+func clusterContextFromProto(cluster *proto.ClusterContext) velero.ClusterContext {
+	if cluster == nil {
+		return velero.ClusterContext{}
+	}
+
+	return velero.ClusterContext{
+		Host:     cluster.Host,
+		Token:    cluster.Token,
+		CAData:   cluster.CaData,
+		Insecure: cluster.Insecure,
+		ProxyURL: cluster.ProxyURL,
+	}
+}
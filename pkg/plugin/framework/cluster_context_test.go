@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func TestClusterContextProtoRoundTrip(t *testing.T) {
+	cluster := velero.ClusterContext{
+		Host:     "https://source.example.com",
+		Token:    "token",
+		CAData:   []byte("ca"),
+		ProxyURL: "http://proxy.example.com:3128",
+	}
+
+	assert.Equal(t, cluster, clusterContextFromProto(clusterContextToProto(cluster)))
+	assert.Equal(t, velero.ClusterContext{}, clusterContextFromProto(nil))
+	assert.True(t, clusterContextFromProto(nil).IsLocal())
+}
+
+func TestRemoteClusterClientConfig(t *testing.T) {
+	config, err := remoteClusterClientConfig(velero.ClusterContext{
+		Host:     "https://source.example.com",
+		Token:    "token",
+		Insecure: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://source.example.com", config.Host)
+	assert.Equal(t, "token", config.BearerToken)
+	assert.True(t, config.Insecure)
+	assert.Nil(t, config.Proxy)
+
+	config, err = remoteClusterClientConfig(velero.ClusterContext{
+		Host:     "https://source.example.com",
+		Token:    "token",
+		ProxyURL: "http://proxy.example.com:3128",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, config.Proxy)
+	proxyURL, err := config.Proxy(&http.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+
+	_, err = remoteClusterClientConfig(velero.ClusterContext{Host: "https://source.example.com"})
+	assert.EqualError(t, err, "the Velero server isn't configured to give plugins access to cluster https://source.example.com")
+}
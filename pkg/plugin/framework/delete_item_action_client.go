@@ -17,10 +17,11 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -50,8 +51,25 @@ func newDeleteItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn
 	}
 }
 
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *DeleteItemActionGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *DeleteItemActionGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
 func (c *DeleteItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.DeleteItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -81,13 +99,17 @@ func (c *DeleteItemActionGRPCClient) Execute(input *velero.DeleteItemActionExecu
 	}
 
 	req := &proto.DeleteItemActionExecuteRequest{
-		Plugin: c.plugin,
-		Item:   itemJSON,
-		Backup: backupJSON,
+		Plugin:  c.plugin,
+		Item:    itemJSON,
+		Backup:  backupJSON,
+		Cluster: clusterContextToProto(input.Cluster),
 	}
 
+	ctx, cancel := c.callContext()
+	defer cancel()
+
 	// First return item is just an empty struct no matter what.
-	if _, err = c.grpcClient.Execute(context.Background(), req); err != nil {
+	if _, err = c.grpcClient.Execute(ctx, req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -102,8 +102,9 @@ func (s *DeleteItemActionGRPCServer) Execute(ctx context.Context, req *proto.Del
 	}
 
 	if err := impl.Execute(&velero.DeleteItemActionExecuteInput{
-		Item:   &item,
-		Backup: &backup,
+		Item:    &item,
+		Backup:  &backup,
+		Cluster: clusterContextFromProto(req.Cluster),
 	}); err != nil {
 		return nil, newGRPCError(err)
 	}
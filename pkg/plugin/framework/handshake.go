@@ -24,6 +24,10 @@ func Handshake() plugin.HandshakeConfig {
 		// The ProtocolVersion is the version that must match between Velero framework
 		// and Velero client plugins. This should be bumped whenever a change happens in
 		// one or the other that makes it so that they can't safely communicate.
+		//
+		// Plugin APIs aren't versioned by the handshake: a changed plugin interface is
+		// added as a new plugin kind (e.g. BackupItemAction/v2) next to the existing
+		// one, so that plugins built against older versions keep working.
 		ProtocolVersion: 2,
 
 		MagicCookieKey:   "VELERO_PLUGIN",
@@ -25,4 +25,7 @@ type Interface interface {
 	// names returns a list of all the registered implementations for this plugin (such as "pod" and "pvc" for
 	// BackupItemAction).
 	names() []string
+
+	// getHandler returns the implementation registered with name.
+	getHandler(name string) (interface{}, error)
 }
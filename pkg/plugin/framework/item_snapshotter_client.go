@@ -0,0 +1,285 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.ItemSnapshotter = &ItemSnapshotterGRPCClient{}
+
+// NewItemSnapshotterPlugin constructs an ItemSnapshotterPlugin.
+func NewItemSnapshotterPlugin(options ...PluginOption) *ItemSnapshotterPlugin {
+	return &ItemSnapshotterPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// ItemSnapshotterGRPCClient implements the ItemSnapshotter interface and uses a
+// gRPC client to make calls to the plugin server.
+type ItemSnapshotterGRPCClient struct {
+	*clientBase
+	grpcClient proto.ItemSnapshotterClient
+}
+
+func newItemSnapshotterGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &ItemSnapshotterGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewItemSnapshotterClient(clientConn),
+	}
+}
+
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *ItemSnapshotterGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *ItemSnapshotterGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
+// Init prepares the ItemSnapshotter for usage using the provided map of
+// configuration key-value pairs. It returns an error if the ItemSnapshotter
+// cannot be initialized from the provided config.
+func (c *ItemSnapshotterGRPCClient) Init(config map[string]string) error {
+	req := &proto.ItemSnapshotterInitRequest{
+		Plugin: c.plugin,
+		Config: config,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ItemSnapshotterGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.ItemSnapshotterAppliesToRequest{Plugin: c.plugin})
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *ItemSnapshotterGRPCClient) AlterBackupItem(input *velero.AlterBackupItemInput) (runtime.Unstructured, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(input.Backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.AlterBackupItemRequest{
+		Plugin:  c.plugin,
+		Item:    itemJSON,
+		Backup:  backupJSON,
+		Cluster: clusterContextToProto(input.Cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AlterBackupItem(ctx, req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &updatedItem); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &updatedItem, nil
+}
+
+func (c *ItemSnapshotterGRPCClient) Snapshot(input *velero.SnapshotItemInput) (*velero.SnapshotItemOutput, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(input.Backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.SnapshotItemRequest{
+		Plugin:  c.plugin,
+		Item:    itemJSON,
+		Backup:  backupJSON,
+		Cluster: clusterContextToProto(input.Cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Snapshot(ctx, req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	return &velero.SnapshotItemOutput{
+		SnapshotID:       res.SnapshotID,
+		SnapshotMetadata: res.SnapshotMetadata,
+		AdditionalItems:  resourceIdentifiersFromProto(res.AdditionalItems),
+	}, nil
+}
+
+func (c *ItemSnapshotterGRPCClient) Progress(input *velero.ItemSnapshotProgressInput) (velero.OperationProgress, error) {
+	backupJSON, err := json.Marshal(input.Backup)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.ItemSnapshotProgressRequest{
+		Plugin: c.plugin,
+		ItemID: &proto.ResourceIdentifier{
+			Group:     input.ItemID.Group,
+			Resource:  input.ItemID.Resource,
+			Namespace: input.ItemID.Namespace,
+			Name:      input.ItemID.Name,
+		},
+		SnapshotID: input.SnapshotID,
+		Backup:     backupJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, fromGRPCError(err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+func (c *ItemSnapshotterGRPCClient) Delete(input *velero.DeleteItemSnapshotInput) error {
+	req := &proto.DeleteItemSnapshotRequest{
+		Plugin:           c.plugin,
+		SnapshotID:       input.SnapshotID,
+		SnapshotMetadata: input.SnapshotMetadata,
+		Cluster:          clusterContextToProto(input.Cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Delete(ctx, req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ItemSnapshotterGRPCClient) Restore(input *velero.RestoreItemFromSnapshotInput) (*velero.RestoreItemFromSnapshotOutput, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	itemFromBackupJSON, err := json.Marshal(input.ItemFromBackup.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	restoreJSON, err := json.Marshal(input.Restore)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemFromSnapshotRequest{
+		Plugin:           c.plugin,
+		Item:             itemJSON,
+		ItemFromBackup:   itemFromBackupJSON,
+		SnapshotID:       input.SnapshotID,
+		SnapshotMetadata: input.SnapshotMetadata,
+		Restore:          restoreJSON,
+		Cluster:          clusterContextToProto(input.Cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Restore(ctx, req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &updatedItem); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &velero.RestoreItemFromSnapshotOutput{
+		UpdatedItem:     &updatedItem,
+		AdditionalItems: resourceIdentifiersFromProto(res.AdditionalItems),
+		SkipRestore:     res.SkipRestore,
+	}, nil
+}
+
+func resourceIdentifiersFromProto(ids []*proto.ResourceIdentifier) []velero.ResourceIdentifier {
+	var res []velero.ResourceIdentifier
+	for _, id := range ids {
+		res = append(res, velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    id.Group,
+				Resource: id.Resource,
+			},
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		})
+	}
+	return res
+}
@@ -0,0 +1,316 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ItemSnapshotterGRPCServer implements the proto-generated ItemSnapshotterServer interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type ItemSnapshotterGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *ItemSnapshotterGRPCServer) getImpl(name string) (velero.ItemSnapshotter, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemSnapshotter, ok := impl.(velero.ItemSnapshotter)
+	if !ok {
+		return nil, errors.Errorf("%T is not an item snapshotter", impl)
+	}
+
+	return itemSnapshotter, nil
+}
+
+// Init prepares the ItemSnapshotter for usage using the provided map of
+// configuration key-value pairs. It returns an error if the ItemSnapshotter
+// cannot be initialized from the provided config.
+func (s *ItemSnapshotterGRPCServer) Init(ctx context.Context, req *proto.ItemSnapshotterInitRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	if err := impl.Init(req.Config); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) AppliesTo(ctx context.Context, req *proto.ItemSnapshotterAppliesToRequest) (response *proto.ItemSnapshotterAppliesToResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	resourceSelector, err := impl.AppliesTo()
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.ItemSnapshotterAppliesToResponse{
+		ResourceSelector: &proto.ResourceSelector{
+			IncludedNamespaces: resourceSelector.IncludedNamespaces,
+			ExcludedNamespaces: resourceSelector.ExcludedNamespaces,
+			IncludedResources:  resourceSelector.IncludedResources,
+			ExcludedResources:  resourceSelector.ExcludedResources,
+			Selector:           resourceSelector.LabelSelector,
+		},
+	}, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) AlterBackupItem(ctx context.Context, req *proto.AlterBackupItemRequest) (response *proto.AlterBackupItemResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var item unstructured.Unstructured
+	var backup api.Backup
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	updatedItem, err := impl.AlterBackupItem(&velero.AlterBackupItemInput{
+		Item:    &item,
+		Backup:  &backup,
+		Cluster: clusterContextFromProto(req.Cluster),
+	})
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	// If the plugin implementation returned a nil updatedItem (meaning no modifications), reset updatedItem to the
+	// original item.
+	var updatedItemJSON []byte
+	if updatedItem == nil {
+		updatedItemJSON = req.Item
+	} else {
+		updatedItemJSON, err = json.Marshal(updatedItem.UnstructuredContent())
+		if err != nil {
+			return nil, newGRPCError(errors.WithStack(err))
+		}
+	}
+
+	return &proto.AlterBackupItemResponse{Item: updatedItemJSON}, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) Snapshot(ctx context.Context, req *proto.SnapshotItemRequest) (response *proto.SnapshotItemResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var item unstructured.Unstructured
+	var backup api.Backup
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	output, err := impl.Snapshot(&velero.SnapshotItemInput{
+		Item:    &item,
+		Backup:  &backup,
+		Cluster: clusterContextFromProto(req.Cluster),
+	})
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	res := &proto.SnapshotItemResponse{
+		SnapshotID:       output.SnapshotID,
+		SnapshotMetadata: output.SnapshotMetadata,
+	}
+
+	for _, item := range output.AdditionalItems {
+		res.AdditionalItems = append(res.AdditionalItems, backupResourceIdentifierToProto(item))
+	}
+
+	return res, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) Progress(ctx context.Context, req *proto.ItemSnapshotProgressRequest) (response *proto.ItemSnapshotProgressResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	input := &velero.ItemSnapshotProgressInput{
+		SnapshotID: req.SnapshotID,
+		Backup:     &backup,
+	}
+	if req.ItemID != nil {
+		input.ItemID = velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    req.ItemID.Group,
+				Resource: req.ItemID.Resource,
+			},
+			Namespace: req.ItemID.Namespace,
+			Name:      req.ItemID.Name,
+		}
+	}
+
+	progress, err := impl.Progress(input)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.ItemSnapshotProgressResponse{Progress: operationProgressToProto(progress)}, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) Delete(ctx context.Context, req *proto.DeleteItemSnapshotRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	if err := impl.Delete(&velero.DeleteItemSnapshotInput{
+		SnapshotID:       req.SnapshotID,
+		SnapshotMetadata: req.SnapshotMetadata,
+		Cluster:          clusterContextFromProto(req.Cluster),
+	}); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *ItemSnapshotterGRPCServer) Restore(ctx context.Context, req *proto.RestoreItemFromSnapshotRequest) (response *proto.RestoreItemFromSnapshotResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var (
+		item           unstructured.Unstructured
+		itemFromBackup unstructured.Unstructured
+		restoreObj     api.Restore
+	)
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.ItemFromBackup, &itemFromBackup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.Restore, &restoreObj); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	output, err := impl.Restore(&velero.RestoreItemFromSnapshotInput{
+		Item:             &item,
+		ItemFromBackup:   &itemFromBackup,
+		SnapshotID:       req.SnapshotID,
+		SnapshotMetadata: req.SnapshotMetadata,
+		Restore:          &restoreObj,
+		Cluster:          clusterContextFromProto(req.Cluster),
+	})
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	// If the plugin implementation returned a nil UpdatedItem (meaning no modifications), reset updatedItem to the
+	// original item.
+	var updatedItemJSON []byte
+	if output.UpdatedItem == nil {
+		updatedItemJSON = req.Item
+	} else {
+		updatedItemJSON, err = json.Marshal(output.UpdatedItem.UnstructuredContent())
+		if err != nil {
+			return nil, newGRPCError(errors.WithStack(err))
+		}
+	}
+
+	res := &proto.RestoreItemFromSnapshotResponse{
+		Item:        updatedItemJSON,
+		SkipRestore: output.SkipRestore,
+	}
+
+	for _, item := range output.AdditionalItems {
+		res.AdditionalItems = append(res.AdditionalItems, restoreResourceIdentifierToProto(item))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"testing"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestItemSnapshotterGRPCServerSnapshot(t *testing.T) {
+	item := []byte(`{"apiVersion":"db.example.io/v1","kind":"Database","metadata":{"namespace":"ns-1","name":"db-1"}}`)
+	backup, err := json.Marshal(builder.ForBackup("velero", "backup-1").Result())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		item        []byte
+		implOutput  *velero.SnapshotItemOutput
+		implError   error
+		expectError bool
+		skipMock    bool
+	}{
+		{
+			name:        "error unmarshaling item",
+			item:        []byte("this is gibberish json"),
+			expectError: true,
+			skipMock:    true,
+		},
+		{
+			name:        "error running impl",
+			item:        item,
+			implError:   errors.New("impl error"),
+			expectError: true,
+		},
+		{
+			name: "snapshot ID, metadata and additional items are returned",
+			item: item,
+			implOutput: &velero.SnapshotItemOutput{
+				SnapshotID:       "snapshot-1",
+				SnapshotMetadata: map[string]string{"engine": "postgres"},
+				AdditionalItems: []velero.ResourceIdentifier{
+					{GroupResource: schema.GroupResource{Resource: "secrets"}, Namespace: "ns-1", Name: "db-1-credentials"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			snapshotter := &mocks.ItemSnapshotter{}
+			defer snapshotter.AssertExpectations(t)
+
+			if !test.skipMock {
+				snapshotter.On("Snapshot", mock.MatchedBy(func(input *velero.SnapshotItemInput) bool {
+					return input.Item.UnstructuredContent()["kind"] == "Database" && input.Backup.Name == "backup-1"
+				})).Return(test.implOutput, test.implError)
+			}
+
+			s := &ItemSnapshotterGRPCServer{mux: &serverMux{
+				serverLog: velerotest.NewLogger(),
+				handlers: map[string]interface{}{
+					"xyz": snapshotter,
+				},
+			}}
+
+			res, err := s.Snapshot(context.Background(), &proto.SnapshotItemRequest{Plugin: "xyz", Item: test.item, Backup: backup})
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the response goes over the wire as protobuf
+			data, err := protobuf.Marshal(res)
+			require.NoError(t, err)
+			received := new(proto.SnapshotItemResponse)
+			require.NoError(t, protobuf.Unmarshal(data, received))
+
+			assert.Equal(t, test.implOutput.SnapshotID, received.SnapshotID)
+			assert.Equal(t, test.implOutput.SnapshotMetadata, received.SnapshotMetadata)
+			assert.Equal(t, test.implOutput.AdditionalItems, resourceIdentifiersFromProto(received.AdditionalItems))
+		})
+	}
+}
@@ -17,14 +17,17 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"io"
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -50,6 +53,20 @@ func newObjectStoreGRPCClient(base *clientBase, clientConn *grpc.ClientConn) int
 	}
 }
 
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *ObjectStoreGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *ObjectStoreGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
 // Init prepares the ObjectStore for usage using the provided map of
 // configuration key-value pairs. It returns an error if the ObjectStore
 // cannot be initialized from the provided config.
@@ -59,7 +76,10 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -69,7 +89,12 @@ func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
 // PutObject creates a new object using the data in body within the specified
 // object storage bucket with the given key.
 func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) error {
-	stream, err := c.grpcClient.PutObject(context.Background())
+	// the timeout applies to each chunk sent rather than to the whole upload, which
+	// may take any time.
+	ctx, cancel, progress := c.streamContext()
+	defer cancel()
+
+	stream, err := c.grpcClient.PutObject(ctx)
 	if err != nil {
 		return fromGRPCError(err)
 	}
@@ -93,6 +118,7 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 		if err := stream.Send(&proto.PutObjectRequest{Plugin: c.plugin, Bucket: bucket, Key: key, Body: chunk[0:n]}); err != nil {
 			return fromGRPCError(err)
 		}
+		progress()
 	}
 }
 
@@ -104,7 +130,10 @@ func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 		Key:    key,
 	}
 
-	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ObjectExists(ctx, req)
 	if err != nil {
 		return false, err
 	}
@@ -121,8 +150,14 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		Key:    key,
 	}
 
-	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	// the call's context is canceled when the object is closed, rather than when
+	// GetObject returns. The timeout applies to each chunk received rather than to
+	// the whole download, which may take any time.
+	ctx, cancel, progress := c.streamContext()
+
+	stream, err := c.grpcClient.GetObject(ctx, req)
 	if err != nil {
+		cancel()
 		return nil, fromGRPCError(err)
 	}
 
@@ -137,11 +172,13 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		if err != nil {
 			return nil, fromGRPCError(err)
 		}
+		progress()
 
 		return data.Data, nil
 	}
 
 	close := func() error {
+		defer cancel()
 		if err := stream.CloseSend(); err != nil {
 			return fromGRPCError(err)
 		}
@@ -162,7 +199,10 @@ func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter str
 		Delimiter: delimiter,
 	}
 
-	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ListCommonPrefixes(ctx, req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -178,7 +218,10 @@ func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, er
 		Prefix: prefix,
 	}
 
-	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.ListObjects(ctx, req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -195,7 +238,10 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 		Key:    key,
 	}
 
-	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteObject(ctx, req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -211,10 +257,67 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 		Ttl:    int64(ttl),
 	}
 
-	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSignedURL(ctx, req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
 
 	return res.Url, nil
 }
+
+// PutLockedObject creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it. Plugins that don't
+// implement velero.ObjectLocker return an error.
+func (c *ObjectStoreGRPCClient) PutLockedObject(bucket, key string, body io.Reader, lock velero.ObjectLock) error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	stream, err := c.grpcClient.PutLockedObject(ctx)
+	if err != nil {
+		return c.fromPutLockedObjectError(err)
+	}
+
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if err == io.EOF {
+			if _, resErr := stream.CloseAndRecv(); resErr != nil {
+				return c.fromPutLockedObjectError(resErr)
+			}
+			return nil
+		}
+		if err != nil {
+			stream.CloseSend()
+			return errors.WithStack(err)
+		}
+
+		req := &proto.PutLockedObjectRequest{
+			Plugin:      c.plugin,
+			Bucket:      bucket,
+			Key:         key,
+			Body:        chunk[0:n],
+			Mode:        lock.Mode,
+			RetainUntil: lock.RetainUntil.Unix(),
+			LegalHold:   lock.LegalHold,
+		}
+		if err := stream.Send(req); err != nil {
+			if err == io.EOF {
+				// the server ended the stream, so the error is in its response
+				_, err = stream.CloseAndRecv()
+			}
+			return c.fromPutLockedObjectError(err)
+		}
+	}
+}
+
+// fromPutLockedObjectError converts an error returned by a PutLockedObject call,
+// including the error returned by plugins built before the call existed.
+func (c *ObjectStoreGRPCClient) fromPutLockedObjectError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return errors.Errorf("object store plugin %s does not support object locks", c.plugin)
+	}
+	return fromGRPCError(err)
+}
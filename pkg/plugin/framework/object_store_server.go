@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -270,3 +271,73 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// PutLockedObject creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it. It returns an Unimplemented
+// error if the object store doesn't implement velero.ObjectLocker.
+func (s *ObjectStoreGRPCServer) PutLockedObject(stream proto.ObjectStore_PutLockedObjectServer) (err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	// we need to read the first chunk ahead of time to get the bucket, key and lock;
+	// in our receive method, we'll use `first` on the first call
+	firstChunk, err := stream.Recv()
+	if err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(firstChunk.Plugin)
+	if err != nil {
+		return newGRPCError(err)
+	}
+
+	locker, ok := impl.(velero.ObjectLocker)
+	if !ok {
+		return newGRPCErrorWithCode(errors.Errorf("%T does not support object locks", impl), codes.Unimplemented)
+	}
+
+	bucket := firstChunk.Bucket
+	key := firstChunk.Key
+	lock := velero.ObjectLock{
+		Mode:        firstChunk.Mode,
+		RetainUntil: time.Unix(firstChunk.RetainUntil, 0),
+		LegalHold:   firstChunk.LegalHold,
+	}
+
+	receive := func() ([]byte, error) {
+		if firstChunk != nil {
+			res := firstChunk.Body
+			firstChunk = nil
+			return res, nil
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	if err := locker.PutLockedObject(bucket, key, &StreamReadCloser{receive: receive, close: close}, lock); err != nil {
+		return newGRPCError(err)
+	}
+
+	if err := stream.SendAndClose(&proto.Empty{}); err != nil {
+		return newGRPCError(errors.WithStack(err))
+	}
+
+	return nil
+}
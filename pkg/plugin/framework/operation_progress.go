@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func operationProgressToProto(progress velero.OperationProgress) *proto.OperationProgress {
+	res := &proto.OperationProgress{
+		Completed:      progress.Completed,
+		Err:            progress.Err,
+		NCompleted:     progress.NCompleted,
+		NTotal:         progress.NTotal,
+		OperationUnits: progress.OperationUnits,
+		Description:    progress.Description,
+	}
+	if !progress.Started.IsZero() {
+		res.Started = progress.Started.Unix()
+	}
+	if !progress.Updated.IsZero() {
+		res.Updated = progress.Updated.Unix()
+	}
+	return res
+}
+
+func operationProgressFromProto(progress *proto.OperationProgress) velero.OperationProgress {
+	if progress == nil {
+		return velero.OperationProgress{}
+	}
+
+	res := velero.OperationProgress{
+		Completed:      progress.Completed,
+		Err:            progress.Err,
+		NCompleted:     progress.NCompleted,
+		NTotal:         progress.NTotal,
+		OperationUnits: progress.OperationUnits,
+		Description:    progress.Description,
+	}
+	if progress.Started != 0 {
+		res.Started = time.Unix(progress.Started, 0).UTC()
+	}
+	if progress.Updated != 0 {
+		res.Updated = time.Unix(progress.Updated, 0).UTC()
+	}
+	return res
+}
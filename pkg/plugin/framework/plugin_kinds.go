@@ -35,9 +35,18 @@ const (
 	// PluginKindBackupItemAction represents a backup item action plugin.
 	PluginKindBackupItemAction PluginKind = "BackupItemAction"
 
+	// PluginKindBackupItemActionV2 represents a version 2 backup item action plugin.
+	PluginKindBackupItemActionV2 PluginKind = "BackupItemAction/v2"
+
 	// PluginKindRestoreItemAction represents a restore item action plugin.
 	PluginKindRestoreItemAction PluginKind = "RestoreItemAction"
 
+	// PluginKindRestoreItemActionV2 represents a version 2 restore item action plugin.
+	PluginKindRestoreItemActionV2 PluginKind = "RestoreItemAction/v2"
+
+	// PluginKindItemSnapshotter represents an item snapshotter plugin.
+	PluginKindItemSnapshotter PluginKind = "ItemSnapshotter"
+
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
@@ -52,7 +61,21 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
+	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
+	allPluginKinds[PluginKindRestoreItemActionV2.String()] = PluginKindRestoreItemActionV2
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
+	allPluginKinds[PluginKindItemSnapshotter.String()] = PluginKindItemSnapshotter
 	return allPluginKinds
 }
+
+// PluginKindsAdaptableTo maps each versioned plugin kind to the older versions of the same
+// kind that Velero can call through the newer version's interface, newest first. A plugin
+// written against an older version of a plugin API keeps working when Velero moves to a
+// newer version of that API.
+func PluginKindsAdaptableTo() map[PluginKind][]PluginKind {
+	return map[PluginKind][]PluginKind{
+		PluginKindBackupItemActionV2:  {PluginKindBackupItemAction},
+		PluginKindRestoreItemActionV2: {PluginKindRestoreItemAction},
+	}
+}
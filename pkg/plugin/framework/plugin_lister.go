@@ -30,6 +30,9 @@ type PluginIdentifier struct {
 	Command string
 	Kind    PluginKind
 	Name    string
+
+	// Capabilities lists the optional features the plugin implements.
+	Capabilities []string
 }
 
 // PluginLister lists plugins.
@@ -78,24 +81,26 @@ type PluginListerGRPCClient struct {
 }
 
 // ListPlugins uses the gRPC client to request the list of plugins from the server. It translates the protobuf response
-// to []PluginIdentifier.
+// to []PluginIdentifier. Plugins of kinds this version of Velero doesn't know, such as versions of a plugin API newer
+// than Velero's, are left out so that a plugin built with a newer plugin library can still be used.
 func (c *PluginListerGRPCClient) ListPlugins() ([]PluginIdentifier, error) {
 	resp, err := c.grpcClient.ListPlugins(context.Background(), &proto.Empty{})
 	if err != nil {
 		return nil, err
 	}
 
-	ret := make([]PluginIdentifier, len(resp.Plugins))
-	for i, id := range resp.Plugins {
+	ret := make([]PluginIdentifier, 0, len(resp.Plugins))
+	for _, id := range resp.Plugins {
 		if _, ok := AllPluginKinds()[id.Kind]; !ok {
-			return nil, errors.Errorf("invalid plugin kind: %s", id.Kind)
+			continue
 		}
 
-		ret[i] = PluginIdentifier{
-			Command: id.Command,
-			Kind:    PluginKind(id.Kind),
-			Name:    id.Name,
-		}
+		ret = append(ret, PluginIdentifier{
+			Command:      id.Command,
+			Kind:         PluginKind(id.Kind),
+			Name:         id.Name,
+			Capabilities: id.Capabilities,
+		})
 	}
 
 	return ret, nil
@@ -131,9 +136,10 @@ func (s *PluginListerGRPCServer) ListPlugins(ctx context.Context, req *proto.Emp
 		}
 
 		plugins[i] = &proto.PluginIdentifier{
-			Command: id.Command,
-			Kind:    id.Kind.String(),
-			Name:    id.Name,
+			Command:      id.Command,
+			Kind:         id.Kind.String(),
+			Name:         id.Name,
+			Capabilities: id.Capabilities,
 		}
 	}
 	ret := &proto.ListPluginsResponse{
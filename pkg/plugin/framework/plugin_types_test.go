@@ -27,9 +27,12 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 	pluginImpls := []interface{}{
 		new(VolumeSnapshotterPlugin),
 		new(BackupItemActionPlugin),
+		new(BackupItemActionV2Plugin),
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
+		new(RestoreItemActionV2Plugin),
+		new(ItemSnapshotterPlugin),
 	}
 
 	for _, impl := range pluginImpls {
@@ -17,10 +17,11 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -52,8 +53,25 @@ func newRestoreItemActionGRPCClient(base *clientBase, clientConn *grpc.ClientCon
 	}
 }
 
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *RestoreItemActionGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *RestoreItemActionGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, fromGRPCError(err)
 	}
@@ -92,9 +110,13 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Item:           itemJSON,
 		ItemFromBackup: itemFromBackupJSON,
 		Restore:        restoreJSON,
+		Cluster:        clusterContextToProto(input.Cluster),
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
@@ -110,6 +110,7 @@ func (s *RestoreItemActionGRPCServer) Execute(ctx context.Context, req *proto.Re
 		Item:           &item,
 		ItemFromBackup: &itemFromBackup,
 		Restore:        &restoreObj,
+		Cluster:        clusterContextFromProto(req.Cluster),
 	})
 	if err != nil {
 		return nil, newGRPCError(err)
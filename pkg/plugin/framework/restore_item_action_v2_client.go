@@ -0,0 +1,200 @@
+/*
+Copyright 2019 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+)
+
+var _ riav2.RestoreItemAction = &RestoreItemActionV2GRPCClient{}
+
+// NewRestoreItemActionV2Plugin constructs a RestoreItemActionV2Plugin.
+func NewRestoreItemActionV2Plugin(options ...PluginOption) *RestoreItemActionV2Plugin {
+	return &RestoreItemActionV2Plugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// RestoreItemActionV2GRPCClient implements the version 2 restore/ItemAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type RestoreItemActionV2GRPCClient struct {
+	*clientBase
+	grpcClient proto.RestoreItemActionV2Client
+}
+
+func newRestoreItemActionV2GRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &RestoreItemActionV2GRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewRestoreItemActionV2Client(clientConn),
+	}
+}
+
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *RestoreItemActionV2GRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *RestoreItemActionV2GRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
+func (c *RestoreItemActionV2GRPCClient) Name() string {
+	return c.plugin
+}
+
+func (c *RestoreItemActionV2GRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.plugin})
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *RestoreItemActionV2GRPCClient) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	itemFromBackupJSON, err := json.Marshal(input.ItemFromBackup.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	restoreJSON, err := json.Marshal(input.Restore)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemActionV2ExecuteRequest{
+		Plugin:         c.plugin,
+		Item:           itemJSON,
+		ItemFromBackup: itemFromBackupJSON,
+		Restore:        restoreJSON,
+		Cluster:        clusterContextToProto(input.Cluster),
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(res.Item, &updatedItem); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+	for _, itm := range res.AdditionalItems {
+		newItem := velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		}
+
+		additionalItems = append(additionalItems, newItem)
+	}
+
+	return &velero.RestoreItemActionExecuteOutput{
+		UpdatedItem:     &updatedItem,
+		AdditionalItems: additionalItems,
+		SkipRestore:     res.SkipRestore,
+		OperationID:     res.OperationID,
+	}, nil
+}
+
+func (c *RestoreItemActionV2GRPCClient) Progress(operationID string, restore *api.Restore) (velero.OperationProgress, error) {
+	restoreJSON, err := json.Marshal(restore)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemActionV2ProgressRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+		Restore:     restoreJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, fromGRPCError(err)
+	}
+
+	return operationProgressFromProto(res.Progress), nil
+}
+
+func (c *RestoreItemActionV2GRPCClient) Cancel(operationID string, restore *api.Restore) error {
+	restoreJSON, err := json.Marshal(restore)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.RestoreItemActionV2CancelRequest{
+		Plugin:      c.plugin,
+		OperationID: operationID,
+		Restore:     restoreJSON,
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Cancel(ctx, req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
@@ -17,15 +17,20 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
 
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -46,6 +51,13 @@ type Server interface {
 	// RegisterBackupItemActions registers multiple backup item actions.
 	RegisterBackupItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterBackupItemActionV2 registers a version 2 backup item action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupItemActionsV2 registers multiple version 2 backup item actions.
+	RegisterBackupItemActionsV2(map[string]HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer HandlerInitializer) Server
@@ -67,6 +79,13 @@ type Server interface {
 	// RegisterRestoreItemActions registers multiple restore item actions.
 	RegisterRestoreItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterRestoreItemActionV2 registers a version 2 restore item action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterRestoreItemActionV2(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterRestoreItemActionsV2 registers multiple version 2 restore item actions.
+	RegisterRestoreItemActionsV2(map[string]HandlerInitializer) Server
+
 	// RegisterDeleteItemAction registers a delete item action. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterDeleteItemAction(pluginName string, initializer HandlerInitializer) Server
@@ -74,21 +93,31 @@ type Server interface {
 	// RegisterDeleteItemActions registers multiple Delete item actions.
 	RegisterDeleteItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterItemSnapshotter registers an item snapshotter. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterItemSnapshotter(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterItemSnapshotters registers multiple item snapshotters.
+	RegisterItemSnapshotters(map[string]HandlerInitializer) Server
+
 	// Server runs the plugin server.
 	Serve()
 }
 
 // server implements Server.
 type server struct {
-	log               *logrus.Logger
-	logLevelFlag      *logging.LevelFlag
-	flagSet           *pflag.FlagSet
-	featureSet        *veleroflag.StringArray
-	backupItemAction  *BackupItemActionPlugin
-	volumeSnapshotter *VolumeSnapshotterPlugin
-	objectStore       *ObjectStorePlugin
-	restoreItemAction *RestoreItemActionPlugin
-	deleteItemAction  *DeleteItemActionPlugin
+	log                 *logrus.Logger
+	logLevelFlag        *logging.LevelFlag
+	flagSet             *pflag.FlagSet
+	featureSet          *veleroflag.StringArray
+	backupItemAction    *BackupItemActionPlugin
+	backupItemActionV2  *BackupItemActionV2Plugin
+	volumeSnapshotter   *VolumeSnapshotterPlugin
+	objectStore         *ObjectStorePlugin
+	restoreItemAction   *RestoreItemActionPlugin
+	restoreItemActionV2 *RestoreItemActionV2Plugin
+	deleteItemAction    *DeleteItemActionPlugin
+	itemSnapshotter     *ItemSnapshotterPlugin
 }
 
 // NewServer returns a new Server
@@ -97,14 +126,17 @@ func NewServer() Server {
 	features := veleroflag.NewStringArray()
 
 	return &server{
-		log:               log,
-		logLevelFlag:      logging.LogLevelFlag(log.Level),
-		featureSet:        &features,
-		backupItemAction:  NewBackupItemActionPlugin(serverLogger(log)),
-		volumeSnapshotter: NewVolumeSnapshotterPlugin(serverLogger(log)),
-		objectStore:       NewObjectStorePlugin(serverLogger(log)),
-		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
-		deleteItemAction:  NewDeleteItemActionPlugin(serverLogger(log)),
+		log:                 log,
+		logLevelFlag:        logging.LogLevelFlag(log.Level),
+		featureSet:          &features,
+		backupItemAction:    NewBackupItemActionPlugin(serverLogger(log)),
+		backupItemActionV2:  NewBackupItemActionV2Plugin(serverLogger(log)),
+		volumeSnapshotter:   NewVolumeSnapshotterPlugin(serverLogger(log)),
+		objectStore:         NewObjectStorePlugin(serverLogger(log)),
+		restoreItemAction:   NewRestoreItemActionPlugin(serverLogger(log)),
+		restoreItemActionV2: NewRestoreItemActionV2Plugin(serverLogger(log)),
+		deleteItemAction:    NewDeleteItemActionPlugin(serverLogger(log)),
+		itemSnapshotter:     NewItemSnapshotterPlugin(serverLogger(log)),
 	}
 }
 
@@ -129,6 +161,18 @@ func (s *server) RegisterBackupItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.backupItemActionV2.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupItemActionsV2(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupItemActionV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer HandlerInitializer) Server {
 	s.volumeSnapshotter.register(name, initializer)
 	return s
@@ -165,6 +209,18 @@ func (s *server) RegisterRestoreItemActions(m map[string]HandlerInitializer) Ser
 	return s
 }
 
+func (s *server) RegisterRestoreItemActionV2(name string, initializer HandlerInitializer) Server {
+	s.restoreItemActionV2.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterRestoreItemActionsV2(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterRestoreItemActionV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterDeleteItemAction(name string, initializer HandlerInitializer) Server {
 	s.deleteItemAction.register(name, initializer)
 	return s
@@ -177,12 +233,32 @@ func (s *server) RegisterDeleteItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
-// getNames returns a list of PluginIdentifiers registered with plugin.
-func getNames(command string, kind PluginKind, plugin Interface) []PluginIdentifier {
+func (s *server) RegisterItemSnapshotter(name string, initializer HandlerInitializer) Server {
+	s.itemSnapshotter.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterItemSnapshotters(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterItemSnapshotter(name, m[name])
+	}
+	return s
+}
+
+// getNames returns a list of PluginIdentifiers registered with plugin, along with the
+// capabilities each of them implements.
+func getNames(command string, kind PluginKind, plugin Interface, log logrus.FieldLogger) []PluginIdentifier {
 	var pluginIdentifiers []PluginIdentifier
 
 	for _, name := range plugin.names() {
 		id := PluginIdentifier{Command: command, Kind: kind, Name: name}
+
+		if impl, err := plugin.getHandler(name); err != nil {
+			log.WithError(err).WithField("name", name).Warnf("Error initializing %s plugin, it won't report any capabilities", kind)
+		} else {
+			id.Capabilities = pluginCapabilities(kind, impl)
+		}
+
 		pluginIdentifiers = append(pluginIdentifiers, id)
 	}
 
@@ -201,24 +277,61 @@ func (s *server) Serve() {
 	command := os.Args[0]
 
 	var pluginIdentifiers []PluginIdentifier
-	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemAction, s.backupItemAction)...)
-	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
-	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
-	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
-	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemAction, s.backupItemAction, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupItemActionV2, s.backupItemActionV2, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindVolumeSnapshotter, s.volumeSnapshotter, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemActionV2, s.restoreItemActionV2, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction, s.log)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindItemSnapshotter, s.itemSnapshotter, s.log)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
+	shutdownTracing, err := tracing.ConfigureFromEnvironment("velero-plugin", s.log)
+	if err != nil {
+		s.log.WithError(err).Warn("Error configuring tracing, traces will not be exported")
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			s.log.WithError(err).Warn("Error exporting traces")
+		}
+	}()
+
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
 		Plugins: map[string]plugin.Plugin{
-			string(PluginKindBackupItemAction):  s.backupItemAction,
-			string(PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(PluginKindObjectStore):       s.objectStore,
-			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(PluginKindRestoreItemAction): s.restoreItemAction,
-			string(PluginKindDeleteItemAction):  s.deleteItemAction,
+			string(PluginKindBackupItemAction):    s.backupItemAction,
+			string(PluginKindBackupItemActionV2):  s.backupItemActionV2,
+			string(PluginKindVolumeSnapshotter):   s.volumeSnapshotter,
+			string(PluginKindObjectStore):         s.objectStore,
+			string(PluginKindPluginLister):        NewPluginListerPlugin(pluginLister),
+			string(PluginKindRestoreItemAction):   s.restoreItemAction,
+			string(PluginKindRestoreItemActionV2): s.restoreItemActionV2,
+			string(PluginKindDeleteItemAction):    s.deleteItemAction,
+			string(PluginKindItemSnapshotter):     s.itemSnapshotter,
 		},
-		GRPCServer: plugin.DefaultGRPCServer,
+		GRPCServer: newGRPCServer,
 	})
 }
+
+// newGRPCServer returns a gRPC server that records a span for each call, as a child
+// of the span propagated by the Velero server.
+func newGRPCServer(opts []grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	)
+	return plugin.DefaultGRPCServer(opts)
+}
+
+// GRPCDialOptions returns the options of the Velero server's connections to plugin
+// servers, which propagate the span of each call to the plugin server.
+func GRPCDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+}
@@ -17,10 +17,11 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -49,6 +50,20 @@ func newVolumeSnapshotterGRPCClient(base *clientBase, clientConn *grpc.ClientCon
 	}
 }
 
+// WithTimeout returns a copy of the client whose calls to the plugin time out after timeout.
+func (c *VolumeSnapshotterGRPCClient) WithTimeout(timeout time.Duration) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withTimeout(timeout)
+	return &copy
+}
+
+// WithContext returns a copy of the client whose calls to the plugin are made with ctx.
+func (c *VolumeSnapshotterGRPCClient) WithContext(ctx context.Context) interface{} {
+	copy := *c
+	copy.clientBase = c.clientBase.withContext(ctx)
+	return &copy
+}
+
 // Init prepares the VolumeSnapshotter for usage using the provided map of
 // configuration key-value pairs. It returns an error if the VolumeSnapshotter
 // cannot be initialized from the provided config.
@@ -58,7 +73,10 @@ func (c *VolumeSnapshotterGRPCClient) Init(config map[string]string) error {
 		Config: config,
 	}
 
-	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.Init(ctx, req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -81,7 +99,10 @@ func (c *VolumeSnapshotterGRPCClient) CreateVolumeFromSnapshot(snapshotID, volum
 		req.Iops = *iops
 	}
 
-	res, err := c.grpcClient.CreateVolumeFromSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateVolumeFromSnapshot(ctx, req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -98,7 +119,10 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeInfo(volumeID, volumeAZ string) (
 		VolumeAZ: volumeAZ,
 	}
 
-	res, err := c.grpcClient.GetVolumeInfo(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.GetVolumeInfo(ctx, req)
 	if err != nil {
 		return "", nil, fromGRPCError(err)
 	}
@@ -121,7 +145,10 @@ func (c *VolumeSnapshotterGRPCClient) CreateSnapshot(volumeID, volumeAZ string,
 		Tags:     tags,
 	}
 
-	res, err := c.grpcClient.CreateSnapshot(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.grpcClient.CreateSnapshot(ctx, req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -136,7 +163,10 @@ func (c *VolumeSnapshotterGRPCClient) DeleteSnapshot(snapshotID string) error {
 		SnapshotID: snapshotID,
 	}
 
-	if _, err := c.grpcClient.DeleteSnapshot(context.Background(), req); err != nil {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.grpcClient.DeleteSnapshot(ctx, req); err != nil {
 		return fromGRPCError(err)
 	}
 
@@ -154,7 +184,10 @@ func (c *VolumeSnapshotterGRPCClient) GetVolumeID(pv runtime.Unstructured) (stri
 		PersistentVolume: encodedPV,
 	}
 
-	resp, err := c.grpcClient.GetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.grpcClient.GetVolumeID(ctx, req)
 	if err != nil {
 		return "", fromGRPCError(err)
 	}
@@ -174,7 +207,10 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 		VolumeID:         volumeID,
 	}
 
-	resp, err := c.grpcClient.SetVolumeID(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.grpcClient.SetVolumeID(ctx, req)
 	if err != nil {
 		return nil, fromGRPCError(err)
 	}
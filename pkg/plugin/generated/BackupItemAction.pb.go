@@ -6,10 +6,13 @@ Package generated is a generated protocol buffer package.
 
 It is generated from these files:
 	BackupItemAction.proto
+	BackupItemActionV2.proto
 	DeleteItemAction.proto
+	ItemSnapshotter.proto
 	ObjectStore.proto
 	PluginLister.proto
 	RestoreItemAction.proto
+	RestoreItemActionV2.proto
 	Shared.proto
 	VolumeSnapshotter.proto
 
@@ -18,9 +21,26 @@ It has these top-level messages:
 	ExecuteResponse
 	BackupItemActionAppliesToRequest
 	BackupItemActionAppliesToResponse
+	BackupItemActionV2ExecuteRequest
+	BackupItemActionV2ExecuteResponse
+	BackupItemActionV2ProgressRequest
+	BackupItemActionV2ProgressResponse
+	BackupItemActionV2CancelRequest
 	DeleteItemActionExecuteRequest
 	DeleteItemActionAppliesToRequest
 	DeleteItemActionAppliesToResponse
+	ItemSnapshotterInitRequest
+	ItemSnapshotterAppliesToRequest
+	ItemSnapshotterAppliesToResponse
+	AlterBackupItemRequest
+	AlterBackupItemResponse
+	SnapshotItemRequest
+	SnapshotItemResponse
+	ItemSnapshotProgressRequest
+	ItemSnapshotProgressResponse
+	DeleteItemSnapshotRequest
+	RestoreItemFromSnapshotRequest
+	RestoreItemFromSnapshotResponse
 	PutObjectRequest
 	ObjectExistsRequest
 	ObjectExistsResponse
@@ -34,17 +54,25 @@ It has these top-level messages:
 	CreateSignedURLRequest
 	CreateSignedURLResponse
 	ObjectStoreInitRequest
+	PutLockedObjectRequest
 	PluginIdentifier
 	ListPluginsResponse
 	RestoreItemActionExecuteRequest
 	RestoreItemActionExecuteResponse
 	RestoreItemActionAppliesToRequest
 	RestoreItemActionAppliesToResponse
+	RestoreItemActionV2ExecuteRequest
+	RestoreItemActionV2ExecuteResponse
+	RestoreItemActionV2ProgressRequest
+	RestoreItemActionV2ProgressResponse
+	RestoreItemActionV2CancelRequest
 	Empty
 	Stack
 	StackFrame
 	ResourceIdentifier
 	ResourceSelector
+	OperationProgress
+	ClusterContext
 	CreateVolumeRequest
 	CreateVolumeResponse
 	GetVolumeInfoRequest
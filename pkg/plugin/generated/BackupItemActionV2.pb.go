@@ -0,0 +1,391 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: BackupItemActionV2.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type BackupItemActionV2ExecuteRequest struct {
+	Plugin  string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Backup  []byte          `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Cluster *ClusterContext `protobuf:"bytes,4,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *BackupItemActionV2ExecuteRequest) Reset()         { *m = BackupItemActionV2ExecuteRequest{} }
+func (m *BackupItemActionV2ExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2ExecuteRequest) ProtoMessage()    {}
+func (*BackupItemActionV2ExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{0}
+}
+
+func (m *BackupItemActionV2ExecuteRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionV2ExecuteRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type BackupItemActionV2ExecuteResponse struct {
+	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
+	OperationID     string                `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *BackupItemActionV2ExecuteResponse) Reset()         { *m = BackupItemActionV2ExecuteResponse{} }
+func (m *BackupItemActionV2ExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2ExecuteResponse) ProtoMessage()    {}
+func (*BackupItemActionV2ExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{1}
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetAdditionalItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.AdditionalItems
+	}
+	return nil
+}
+
+func (m *BackupItemActionV2ExecuteResponse) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type BackupItemActionV2ProgressRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Backup      []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionV2ProgressRequest) Reset()         { *m = BackupItemActionV2ProgressRequest{} }
+func (m *BackupItemActionV2ProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2ProgressRequest) ProtoMessage()    {}
+func (*BackupItemActionV2ProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{2}
+}
+
+func (m *BackupItemActionV2ProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionV2ProgressRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *BackupItemActionV2ProgressRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type BackupItemActionV2ProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *BackupItemActionV2ProgressResponse) Reset()         { *m = BackupItemActionV2ProgressResponse{} }
+func (m *BackupItemActionV2ProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2ProgressResponse) ProtoMessage()    {}
+func (*BackupItemActionV2ProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{3}
+}
+
+func (m *BackupItemActionV2ProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type BackupItemActionV2CancelRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Backup      []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupItemActionV2CancelRequest) Reset()         { *m = BackupItemActionV2CancelRequest{} }
+func (m *BackupItemActionV2CancelRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupItemActionV2CancelRequest) ProtoMessage()    {}
+func (*BackupItemActionV2CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{4}
+}
+
+func (m *BackupItemActionV2CancelRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupItemActionV2CancelRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *BackupItemActionV2CancelRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BackupItemActionV2ExecuteRequest)(nil), "generated.BackupItemActionV2ExecuteRequest")
+	proto.RegisterType((*BackupItemActionV2ExecuteResponse)(nil), "generated.BackupItemActionV2ExecuteResponse")
+	proto.RegisterType((*BackupItemActionV2ProgressRequest)(nil), "generated.BackupItemActionV2ProgressRequest")
+	proto.RegisterType((*BackupItemActionV2ProgressResponse)(nil), "generated.BackupItemActionV2ProgressResponse")
+	proto.RegisterType((*BackupItemActionV2CancelRequest)(nil), "generated.BackupItemActionV2CancelRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BackupItemActionV2 service
+
+type BackupItemActionV2Client interface {
+	AppliesTo(ctx context.Context, in *BackupItemActionAppliesToRequest, opts ...grpc.CallOption) (*BackupItemActionAppliesToResponse, error)
+	Execute(ctx context.Context, in *BackupItemActionV2ExecuteRequest, opts ...grpc.CallOption) (*BackupItemActionV2ExecuteResponse, error)
+	Progress(ctx context.Context, in *BackupItemActionV2ProgressRequest, opts ...grpc.CallOption) (*BackupItemActionV2ProgressResponse, error)
+	Cancel(ctx context.Context, in *BackupItemActionV2CancelRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type backupItemActionV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupItemActionV2Client(cc *grpc.ClientConn) BackupItemActionV2Client {
+	return &backupItemActionV2Client{cc}
+}
+
+func (c *backupItemActionV2Client) AppliesTo(ctx context.Context, in *BackupItemActionAppliesToRequest, opts ...grpc.CallOption) (*BackupItemActionAppliesToResponse, error) {
+	out := new(BackupItemActionAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Execute(ctx context.Context, in *BackupItemActionV2ExecuteRequest, opts ...grpc.CallOption) (*BackupItemActionV2ExecuteResponse, error) {
+	out := new(BackupItemActionV2ExecuteResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Execute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Progress(ctx context.Context, in *BackupItemActionV2ProgressRequest, opts ...grpc.CallOption) (*BackupItemActionV2ProgressResponse, error) {
+	out := new(BackupItemActionV2ProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupItemActionV2Client) Cancel(ctx context.Context, in *BackupItemActionV2CancelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.BackupItemActionV2/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupItemActionV2 service
+
+type BackupItemActionV2Server interface {
+	AppliesTo(context.Context, *BackupItemActionAppliesToRequest) (*BackupItemActionAppliesToResponse, error)
+	Execute(context.Context, *BackupItemActionV2ExecuteRequest) (*BackupItemActionV2ExecuteResponse, error)
+	Progress(context.Context, *BackupItemActionV2ProgressRequest) (*BackupItemActionV2ProgressResponse, error)
+	Cancel(context.Context, *BackupItemActionV2CancelRequest) (*Empty, error)
+}
+
+func RegisterBackupItemActionV2Server(s *grpc.Server, srv BackupItemActionV2Server) {
+	s.RegisterService(&_BackupItemActionV2_serviceDesc, srv)
+}
+
+func _BackupItemActionV2_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).AppliesTo(ctx, req.(*BackupItemActionAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionV2ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Execute(ctx, req.(*BackupItemActionV2ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionV2ProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Progress(ctx, req.(*BackupItemActionV2ProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupItemActionV2_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupItemActionV2CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupItemActionV2/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupItemActionV2Server).Cancel(ctx, req.(*BackupItemActionV2CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupItemActionV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupItemActionV2",
+	HandlerType: (*BackupItemActionV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppliesTo",
+			Handler:    _BackupItemActionV2_AppliesTo_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _BackupItemActionV2_Execute_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _BackupItemActionV2_Progress_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _BackupItemActionV2_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupItemActionV2.proto",
+}
+
+func init() { proto.RegisterFile("BackupItemActionV2.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0xaf, 0x93, 0x40,
+	0x14, 0x85, 0x43, 0xfb, 0xd2, 0xf7, 0xb8, 0x34, 0xd1, 0xcc, 0xa2, 0x41, 0xa2, 0x11, 0x59, 0x11,
+	0xad, 0x5d, 0xd0, 0x8d, 0xdb, 0x5a, 0xab, 0xe9, 0x4a, 0x83, 0xc6, 0xa5, 0x09, 0x85, 0x5b, 0x9c,
+	0x48, 0x67, 0xc6, 0x99, 0x21, 0xa9, 0xbf, 0xc3, 0xa5, 0x3b, 0x7f, 0xa9, 0x01, 0xa6, 0x04, 0x41,
+	0xb1, 0x1b, 0x77, 0xcc, 0xf0, 0xdd, 0x7b, 0xce, 0x3d, 0x33, 0x00, 0xee, 0xcb, 0x24, 0xfd, 0x52,
+	0x8a, 0xbd, 0xc6, 0xd3, 0x26, 0xd5, 0x94, 0xb3, 0x8f, 0xd1, 0x4a, 0x48, 0xae, 0x39, 0xb1, 0x73,
+	0x64, 0x28, 0x13, 0x8d, 0x99, 0xb7, 0xe8, 0x43, 0x0d, 0xe2, 0xcd, 0xdf, 0x7f, 0x4e, 0x24, 0x66,
+	0xcd, 0x2a, 0xf8, 0x61, 0x81, 0x3f, 0xec, 0xb6, 0x3b, 0x63, 0x5a, 0x6a, 0x8c, 0xf1, 0x6b, 0x89,
+	0x4a, 0x93, 0x05, 0xcc, 0x44, 0x51, 0xe6, 0x94, 0xb9, 0x96, 0x6f, 0x85, 0x76, 0x6c, 0x56, 0x84,
+	0xc0, 0x0d, 0xd5, 0x78, 0x72, 0x27, 0xbe, 0x15, 0xce, 0xe3, 0xfa, 0xb9, 0x62, 0x0f, 0x75, 0x3f,
+	0x77, 0x5a, 0xef, 0x9a, 0x15, 0x59, 0xc3, 0x6d, 0x5a, 0x94, 0x4a, 0xa3, 0x74, 0x6f, 0x7c, 0x2b,
+	0x74, 0xa2, 0x07, 0xab, 0xd6, 0xeb, 0x6a, 0xdb, 0xbc, 0xd9, 0x72, 0xa6, 0xf1, 0xac, 0xe3, 0x0b,
+	0x19, 0xfc, 0xb4, 0xe0, 0xc9, 0x88, 0x3b, 0x25, 0x38, 0x53, 0xd8, 0xda, 0xb0, 0x3a, 0x36, 0xde,
+	0xc0, 0xbd, 0x24, 0xcb, 0x68, 0x55, 0x90, 0x14, 0x55, 0xb1, 0x72, 0x27, 0xfe, 0x34, 0x74, 0xa2,
+	0x47, 0x1d, 0xd9, 0x18, 0x15, 0x2f, 0x65, 0x8a, 0xfb, 0x0c, 0x99, 0xa6, 0x47, 0x8a, 0x32, 0xee,
+	0x57, 0x11, 0x1f, 0x1c, 0x2e, 0x2a, 0x9e, 0x72, 0xb6, 0x7f, 0x55, 0x0f, 0x65, 0xc7, 0xdd, 0xad,
+	0xa0, 0xfc, 0x93, 0xc7, 0x77, 0x92, 0xe7, 0x12, 0x95, 0xfa, 0x57, 0x84, 0xbd, 0xf6, 0x93, 0x41,
+	0xfb, 0xbf, 0x05, 0x1a, 0x7c, 0x82, 0x60, 0x4c, 0xd6, 0x64, 0xf3, 0x02, 0xee, 0x84, 0xd9, 0xab,
+	0x95, 0x9d, 0xe8, 0x61, 0x27, 0x80, 0xb7, 0x17, 0x9d, 0xb6, 0xae, 0xa5, 0x03, 0x05, 0x8f, 0x87,
+	0xfd, 0xb7, 0x09, 0x4b, 0xb1, 0xf8, 0x6f, 0x43, 0x45, 0xdf, 0xa7, 0x40, 0x86, 0xaa, 0xe4, 0x08,
+	0xf6, 0x46, 0x88, 0x82, 0xa2, 0xfa, 0xc0, 0xc9, 0xb3, 0xce, 0x00, 0x7d, 0xb6, 0xa5, 0x8c, 0x45,
+	0x6f, 0x79, 0x1d, 0x6c, 0xd2, 0xca, 0xe0, 0xd6, 0x5c, 0xae, 0x51, 0x95, 0xfe, 0x07, 0xe2, 0x2d,
+	0xaf, 0x83, 0x8d, 0x4a, 0x0e, 0x77, 0x97, 0xbc, 0xc9, 0x78, 0x65, 0xef, 0x16, 0x79, 0xcf, 0xaf,
+	0xa4, 0x8d, 0xd0, 0x6b, 0x98, 0x35, 0x07, 0x46, 0x9e, 0x8e, 0x16, 0xfe, 0x76, 0xaa, 0xde, 0xfd,
+	0x0e, 0xbb, 0x3b, 0x09, 0xfd, 0xed, 0x30, 0xab, 0xff, 0x15, 0xeb, 0x5f, 0x03, 0x00, 0xb3, 0x98,
+	0x9c, 0xef, 0x78, 0x04, 0x00, 0x00,
+}
@@ -18,15 +18,16 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type DeleteItemActionExecuteRequest struct {
-	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
-	Item   []byte `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	Backup []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Plugin  string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Backup  []byte          `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Cluster *ClusterContext `protobuf:"bytes,4,opt,name=cluster" json:"cluster,omitempty"`
 }
 
 func (m *DeleteItemActionExecuteRequest) Reset()                    { *m = DeleteItemActionExecuteRequest{} }
 func (m *DeleteItemActionExecuteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteItemActionExecuteRequest) ProtoMessage()               {}
-func (*DeleteItemActionExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*DeleteItemActionExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
 
 func (m *DeleteItemActionExecuteRequest) GetPlugin() string {
 	if m != nil {
@@ -49,6 +50,13 @@ func (m *DeleteItemActionExecuteRequest) GetBackup() []byte {
 	return nil
 }
 
+func (m *DeleteItemActionExecuteRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
 type DeleteItemActionAppliesToRequest struct {
 	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 }
@@ -57,7 +65,7 @@ func (m *DeleteItemActionAppliesToRequest) Reset()         { *m = DeleteItemActi
 func (m *DeleteItemActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteItemActionAppliesToRequest) ProtoMessage()    {}
 func (*DeleteItemActionAppliesToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1}
+	return fileDescriptor2, []int{1}
 }
 
 func (m *DeleteItemActionAppliesToRequest) GetPlugin() string {
@@ -75,7 +83,7 @@ func (m *DeleteItemActionAppliesToResponse) Reset()         { *m = DeleteItemAct
 func (m *DeleteItemActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteItemActionAppliesToResponse) ProtoMessage()    {}
 func (*DeleteItemActionAppliesToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{2}
+	return fileDescriptor2, []int{2}
 }
 
 func (m *DeleteItemActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
@@ -196,24 +204,26 @@ var _DeleteItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "DeleteItemAction.proto",
 }
 
-func init() { proto.RegisterFile("DeleteItemAction.proto", fileDescriptor1) }
-
-var fileDescriptor1 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0xc3, 0x40,
-	0x14, 0x84, 0x89, 0x4a, 0x25, 0xcf, 0x1e, 0xc2, 0x1e, 0x4a, 0x88, 0x20, 0x31, 0xa7, 0x8a, 0x92,
-	0x43, 0xbd, 0x79, 0x2b, 0x58, 0xc5, 0x6b, 0xea, 0x1f, 0x48, 0x37, 0x63, 0x0d, 0x6e, 0xb2, 0xeb,
-	0xee, 0x5b, 0xd0, 0xbf, 0xe7, 0x2f, 0x13, 0x63, 0x28, 0x35, 0x42, 0xdb, 0xdb, 0xbe, 0xdd, 0x99,
-	0xf7, 0x31, 0x3b, 0x34, 0xb9, 0x87, 0x02, 0xe3, 0x89, 0xd1, 0xcc, 0x25, 0xd7, 0xba, 0xcd, 0x8d,
-	0xd5, 0xac, 0x45, 0xb8, 0x46, 0x0b, 0x5b, 0x32, 0xaa, 0x64, 0xbc, 0x7c, 0x2d, 0x2d, 0xaa, 0xdf,
-	0x87, 0xac, 0xa2, 0x8b, 0xa1, 0x65, 0xf1, 0x01, 0xe9, 0x19, 0x05, 0xde, 0x3d, 0x1c, 0x8b, 0x09,
-	0x8d, 0x8c, 0xf2, 0xeb, 0xba, 0x8d, 0x83, 0x34, 0x98, 0x86, 0x45, 0x3f, 0x09, 0x41, 0x27, 0x35,
-	0xa3, 0x89, 0x8f, 0xd2, 0x60, 0x3a, 0x2e, 0xba, 0xf3, 0x8f, 0x76, 0x55, 0xca, 0x37, 0x6f, 0xe2,
-	0xe3, 0xee, 0xb6, 0x9f, 0xb2, 0x3b, 0x4a, 0x87, 0x94, 0xb9, 0x31, 0xaa, 0x86, 0x7b, 0xd6, 0x7b,
-	0x38, 0x99, 0xa2, 0xcb, 0x1d, 0x5e, 0x67, 0x74, 0xeb, 0x20, 0x1e, 0x29, 0x2a, 0xe0, 0xb4, 0xb7,
-	0x12, 0x4b, 0x28, 0x48, 0xd6, 0xb6, 0x5b, 0x73, 0x36, 0x3b, 0xcf, 0x37, 0xd1, 0xf3, 0xa1, 0xa4,
-	0xf8, 0x67, 0x9a, 0x7d, 0x05, 0x14, 0x0d, 0x71, 0xe2, 0x85, 0xc2, 0x0d, 0x52, 0x5c, 0x6f, 0x2d,
-	0xdc, 0x17, 0x2a, 0xb9, 0x39, 0x4c, 0xdc, 0xa7, 0x78, 0xa0, 0xd3, 0xfe, 0xf3, 0xc5, 0xd5, 0x0e,
-	0xe3, 0xdf, 0x82, 0x92, 0x68, 0x4b, 0xba, 0x68, 0x0c, 0x7f, 0xae, 0x46, 0x5d, 0xb7, 0xb7, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x31, 0x0b, 0xd3, 0x0e, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("DeleteItemAction.proto", fileDescriptor2) }
+
+var fileDescriptor2 = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0xb3, 0x4a, 0x20, 0x0c, 0x1c, 0xc8, 0x1e, 0x48, 0xc5, 0xc4, 0xd4, 0x9e, 0x6a, 0x34,
+	0x3d, 0x94, 0x9b, 0x37, 0x82, 0x68, 0xbc, 0x2e, 0xbe, 0x40, 0x59, 0x46, 0x6c, 0xdc, 0x76, 0xd7,
+	0xdd, 0xd9, 0x04, 0x1f, 0xc5, 0xd7, 0xf1, 0xc9, 0x8c, 0xa5, 0x92, 0x5a, 0x13, 0xf0, 0xb6, 0xb3,
+	0xf3, 0xfd, 0x33, 0xff, 0x64, 0x06, 0xc6, 0x77, 0xa8, 0x90, 0xf0, 0x91, 0xb0, 0x98, 0x49, 0xca,
+	0x75, 0x99, 0x18, 0xab, 0x49, 0xf3, 0xfe, 0x06, 0x4b, 0xb4, 0x19, 0xe1, 0x7a, 0x32, 0x5c, 0xbe,
+	0x64, 0x16, 0xd7, 0xbb, 0x44, 0xf4, 0xc1, 0xe0, 0xa2, 0xad, 0x59, 0x6c, 0x51, 0x7a, 0x42, 0x81,
+	0x6f, 0x1e, 0x1d, 0xf1, 0x31, 0x74, 0x8d, 0xf2, 0x9b, 0xbc, 0x0c, 0x58, 0xc8, 0xe2, 0xbe, 0xa8,
+	0x23, 0xce, 0xa1, 0x93, 0x13, 0x16, 0xc1, 0x49, 0xc8, 0xe2, 0xa1, 0xa8, 0xde, 0xdf, 0xec, 0x2a,
+	0x93, 0xaf, 0xde, 0x04, 0xa7, 0xd5, 0x6f, 0x1d, 0xf1, 0x29, 0xf4, 0xa4, 0xf2, 0x8e, 0xd0, 0x06,
+	0x9d, 0x90, 0xc5, 0x83, 0xf4, 0x2c, 0xd9, 0x3b, 0x4a, 0xe6, 0xbb, 0xcc, 0x5c, 0x97, 0x84, 0x5b,
+	0x12, 0x3f, 0x64, 0x74, 0x0b, 0x61, 0xdb, 0xda, 0xcc, 0x18, 0x95, 0xa3, 0x7b, 0xd2, 0x47, 0xcc,
+	0x45, 0x0a, 0x2e, 0x0f, 0x68, 0x9d, 0xd1, 0xa5, 0x43, 0xfe, 0x00, 0x23, 0x81, 0x4e, 0x7b, 0x2b,
+	0x71, 0x89, 0x0a, 0x25, 0x69, 0x5b, 0x95, 0x19, 0xa4, 0xe7, 0x0d, 0x7b, 0x6d, 0x44, 0xfc, 0x11,
+	0xa5, 0x9f, 0x0c, 0x46, 0xed, 0x76, 0xfc, 0x19, 0xfa, 0xfb, 0x96, 0xfc, 0xba, 0x51, 0xf0, 0xd8,
+	0x50, 0x93, 0x9b, 0xff, 0xc1, 0xf5, 0x14, 0xf7, 0xd0, 0xab, 0x37, 0xc6, 0xaf, 0x0e, 0x08, 0x7f,
+	0x6f, 0x75, 0x32, 0x6a, 0xa0, 0x8b, 0xc2, 0xd0, 0xfb, 0xaa, 0x5b, 0x5d, 0xc4, 0xf4, 0x6b, 0x00,
+	0xe0, 0x03, 0x5e, 0x8c, 0x44, 0x02, 0x00, 0x00,
 }
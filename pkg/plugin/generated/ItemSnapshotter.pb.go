@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: ItemSnapshotter.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ItemSnapshotterInitRequest struct {
+	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ItemSnapshotterInitRequest) Reset()         { *m = ItemSnapshotterInitRequest{} }
+func (m *ItemSnapshotterInitRequest) String() string { return proto.CompactTextString(m) }
+func (*ItemSnapshotterInitRequest) ProtoMessage()    {}
+func (*ItemSnapshotterInitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{0}
+}
+
+func (m *ItemSnapshotterInitRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *ItemSnapshotterInitRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ItemSnapshotterAppliesToRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+}
+
+func (m *ItemSnapshotterAppliesToRequest) Reset()         { *m = ItemSnapshotterAppliesToRequest{} }
+func (m *ItemSnapshotterAppliesToRequest) String() string { return proto.CompactTextString(m) }
+func (*ItemSnapshotterAppliesToRequest) ProtoMessage()    {}
+func (*ItemSnapshotterAppliesToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{1}
+}
+
+func (m *ItemSnapshotterAppliesToRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+type ItemSnapshotterAppliesToResponse struct {
+	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
+}
+
+func (m *ItemSnapshotterAppliesToResponse) Reset()         { *m = ItemSnapshotterAppliesToResponse{} }
+func (m *ItemSnapshotterAppliesToResponse) String() string { return proto.CompactTextString(m) }
+func (*ItemSnapshotterAppliesToResponse) ProtoMessage()    {}
+func (*ItemSnapshotterAppliesToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{2}
+}
+
+func (m *ItemSnapshotterAppliesToResponse) GetResourceSelector() *ResourceSelector {
+	if m != nil {
+		return m.ResourceSelector
+	}
+	return nil
+}
+
+type AlterBackupItemRequest struct {
+	Plugin  string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Backup  []byte          `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Cluster *ClusterContext `protobuf:"bytes,4,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *AlterBackupItemRequest) Reset()         { *m = AlterBackupItemRequest{} }
+func (m *AlterBackupItemRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterBackupItemRequest) ProtoMessage()    {}
+func (*AlterBackupItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{3}
+}
+
+func (m *AlterBackupItemRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *AlterBackupItemRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *AlterBackupItemRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *AlterBackupItemRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type AlterBackupItemResponse struct {
+	Item []byte `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (m *AlterBackupItemResponse) Reset()         { *m = AlterBackupItemResponse{} }
+func (m *AlterBackupItemResponse) String() string { return proto.CompactTextString(m) }
+func (*AlterBackupItemResponse) ProtoMessage()    {}
+func (*AlterBackupItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{4}
+}
+
+func (m *AlterBackupItemResponse) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type SnapshotItemRequest struct {
+	Plugin  string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Backup  []byte          `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Cluster *ClusterContext `protobuf:"bytes,4,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *SnapshotItemRequest) Reset()         { *m = SnapshotItemRequest{} }
+func (m *SnapshotItemRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotItemRequest) ProtoMessage()    {}
+func (*SnapshotItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{5}
+}
+
+func (m *SnapshotItemRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *SnapshotItemRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *SnapshotItemRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *SnapshotItemRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type SnapshotItemResponse struct {
+	SnapshotID       string                `protobuf:"bytes,1,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	SnapshotMetadata map[string]string     `protobuf:"bytes,2,rep,name=snapshotMetadata" json:"snapshotMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AdditionalItems  []*ResourceIdentifier `protobuf:"bytes,3,rep,name=additionalItems" json:"additionalItems,omitempty"`
+}
+
+func (m *SnapshotItemResponse) Reset()         { *m = SnapshotItemResponse{} }
+func (m *SnapshotItemResponse) String() string { return proto.CompactTextString(m) }
+func (*SnapshotItemResponse) ProtoMessage()    {}
+func (*SnapshotItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{6}
+}
+
+func (m *SnapshotItemResponse) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *SnapshotItemResponse) GetSnapshotMetadata() map[string]string {
+	if m != nil {
+		return m.SnapshotMetadata
+	}
+	return nil
+}
+
+func (m *SnapshotItemResponse) GetAdditionalItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.AdditionalItems
+	}
+	return nil
+}
+
+type ItemSnapshotProgressRequest struct {
+	Plugin     string              `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	ItemID     *ResourceIdentifier `protobuf:"bytes,2,opt,name=itemID" json:"itemID,omitempty"`
+	SnapshotID string              `protobuf:"bytes,3,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	Backup     []byte              `protobuf:"bytes,4,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *ItemSnapshotProgressRequest) Reset()         { *m = ItemSnapshotProgressRequest{} }
+func (m *ItemSnapshotProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*ItemSnapshotProgressRequest) ProtoMessage()    {}
+func (*ItemSnapshotProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{7}
+}
+
+func (m *ItemSnapshotProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *ItemSnapshotProgressRequest) GetItemID() *ResourceIdentifier {
+	if m != nil {
+		return m.ItemID
+	}
+	return nil
+}
+
+func (m *ItemSnapshotProgressRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *ItemSnapshotProgressRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type ItemSnapshotProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *ItemSnapshotProgressResponse) Reset()         { *m = ItemSnapshotProgressResponse{} }
+func (m *ItemSnapshotProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*ItemSnapshotProgressResponse) ProtoMessage()    {}
+func (*ItemSnapshotProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{8}
+}
+
+func (m *ItemSnapshotProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type DeleteItemSnapshotRequest struct {
+	Plugin           string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	SnapshotID       string            `protobuf:"bytes,2,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	SnapshotMetadata map[string]string `protobuf:"bytes,3,rep,name=snapshotMetadata" json:"snapshotMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Cluster          *ClusterContext   `protobuf:"bytes,4,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *DeleteItemSnapshotRequest) Reset()         { *m = DeleteItemSnapshotRequest{} }
+func (m *DeleteItemSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteItemSnapshotRequest) ProtoMessage()    {}
+func (*DeleteItemSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{9}
+}
+
+func (m *DeleteItemSnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *DeleteItemSnapshotRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *DeleteItemSnapshotRequest) GetSnapshotMetadata() map[string]string {
+	if m != nil {
+		return m.SnapshotMetadata
+	}
+	return nil
+}
+
+func (m *DeleteItemSnapshotRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type RestoreItemFromSnapshotRequest struct {
+	Plugin           string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item             []byte            `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ItemFromBackup   []byte            `protobuf:"bytes,3,opt,name=itemFromBackup,proto3" json:"itemFromBackup,omitempty"`
+	SnapshotID       string            `protobuf:"bytes,4,opt,name=snapshotID" json:"snapshotID,omitempty"`
+	SnapshotMetadata map[string]string `protobuf:"bytes,5,rep,name=snapshotMetadata" json:"snapshotMetadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Restore          []byte            `protobuf:"bytes,6,opt,name=restore,proto3" json:"restore,omitempty"`
+	Cluster          *ClusterContext   `protobuf:"bytes,7,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *RestoreItemFromSnapshotRequest) Reset()         { *m = RestoreItemFromSnapshotRequest{} }
+func (m *RestoreItemFromSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemFromSnapshotRequest) ProtoMessage()    {}
+func (*RestoreItemFromSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{10}
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetItemFromBackup() []byte {
+	if m != nil {
+		return m.ItemFromBackup
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetSnapshotMetadata() map[string]string {
+	if m != nil {
+		return m.SnapshotMetadata
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type RestoreItemFromSnapshotResponse struct {
+	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
+	SkipRestore     bool                  `protobuf:"varint,3,opt,name=skipRestore" json:"skipRestore,omitempty"`
+}
+
+func (m *RestoreItemFromSnapshotResponse) Reset()         { *m = RestoreItemFromSnapshotResponse{} }
+func (m *RestoreItemFromSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemFromSnapshotResponse) ProtoMessage()    {}
+func (*RestoreItemFromSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{11}
+}
+
+func (m *RestoreItemFromSnapshotResponse) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotResponse) GetAdditionalItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.AdditionalItems
+	}
+	return nil
+}
+
+func (m *RestoreItemFromSnapshotResponse) GetSkipRestore() bool {
+	if m != nil {
+		return m.SkipRestore
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ItemSnapshotterInitRequest)(nil), "generated.ItemSnapshotterInitRequest")
+	proto.RegisterType((*ItemSnapshotterAppliesToRequest)(nil), "generated.ItemSnapshotterAppliesToRequest")
+	proto.RegisterType((*ItemSnapshotterAppliesToResponse)(nil), "generated.ItemSnapshotterAppliesToResponse")
+	proto.RegisterType((*AlterBackupItemRequest)(nil), "generated.AlterBackupItemRequest")
+	proto.RegisterType((*AlterBackupItemResponse)(nil), "generated.AlterBackupItemResponse")
+	proto.RegisterType((*SnapshotItemRequest)(nil), "generated.SnapshotItemRequest")
+	proto.RegisterType((*SnapshotItemResponse)(nil), "generated.SnapshotItemResponse")
+	proto.RegisterType((*ItemSnapshotProgressRequest)(nil), "generated.ItemSnapshotProgressRequest")
+	proto.RegisterType((*ItemSnapshotProgressResponse)(nil), "generated.ItemSnapshotProgressResponse")
+	proto.RegisterType((*DeleteItemSnapshotRequest)(nil), "generated.DeleteItemSnapshotRequest")
+	proto.RegisterType((*RestoreItemFromSnapshotRequest)(nil), "generated.RestoreItemFromSnapshotRequest")
+	proto.RegisterType((*RestoreItemFromSnapshotResponse)(nil), "generated.RestoreItemFromSnapshotResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ItemSnapshotter service
+
+type ItemSnapshotterClient interface {
+	Init(ctx context.Context, in *ItemSnapshotterInitRequest, opts ...grpc.CallOption) (*Empty, error)
+	AppliesTo(ctx context.Context, in *ItemSnapshotterAppliesToRequest, opts ...grpc.CallOption) (*ItemSnapshotterAppliesToResponse, error)
+	AlterBackupItem(ctx context.Context, in *AlterBackupItemRequest, opts ...grpc.CallOption) (*AlterBackupItemResponse, error)
+	Snapshot(ctx context.Context, in *SnapshotItemRequest, opts ...grpc.CallOption) (*SnapshotItemResponse, error)
+	Progress(ctx context.Context, in *ItemSnapshotProgressRequest, opts ...grpc.CallOption) (*ItemSnapshotProgressResponse, error)
+	Delete(ctx context.Context, in *DeleteItemSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
+	Restore(ctx context.Context, in *RestoreItemFromSnapshotRequest, opts ...grpc.CallOption) (*RestoreItemFromSnapshotResponse, error)
+}
+
+type itemSnapshotterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewItemSnapshotterClient(cc *grpc.ClientConn) ItemSnapshotterClient {
+	return &itemSnapshotterClient{cc}
+}
+
+func (c *itemSnapshotterClient) Init(ctx context.Context, in *ItemSnapshotterInitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/Init", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) AppliesTo(ctx context.Context, in *ItemSnapshotterAppliesToRequest, opts ...grpc.CallOption) (*ItemSnapshotterAppliesToResponse, error) {
+	out := new(ItemSnapshotterAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) AlterBackupItem(ctx context.Context, in *AlterBackupItemRequest, opts ...grpc.CallOption) (*AlterBackupItemResponse, error) {
+	out := new(AlterBackupItemResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/AlterBackupItem", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) Snapshot(ctx context.Context, in *SnapshotItemRequest, opts ...grpc.CallOption) (*SnapshotItemResponse, error) {
+	out := new(SnapshotItemResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/Snapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) Progress(ctx context.Context, in *ItemSnapshotProgressRequest, opts ...grpc.CallOption) (*ItemSnapshotProgressResponse, error) {
+	out := new(ItemSnapshotProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) Delete(ctx context.Context, in *DeleteItemSnapshotRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemSnapshotterClient) Restore(ctx context.Context, in *RestoreItemFromSnapshotRequest, opts ...grpc.CallOption) (*RestoreItemFromSnapshotResponse, error) {
+	out := new(RestoreItemFromSnapshotResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemSnapshotter/Restore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ItemSnapshotter service
+
+type ItemSnapshotterServer interface {
+	Init(context.Context, *ItemSnapshotterInitRequest) (*Empty, error)
+	AppliesTo(context.Context, *ItemSnapshotterAppliesToRequest) (*ItemSnapshotterAppliesToResponse, error)
+	AlterBackupItem(context.Context, *AlterBackupItemRequest) (*AlterBackupItemResponse, error)
+	Snapshot(context.Context, *SnapshotItemRequest) (*SnapshotItemResponse, error)
+	Progress(context.Context, *ItemSnapshotProgressRequest) (*ItemSnapshotProgressResponse, error)
+	Delete(context.Context, *DeleteItemSnapshotRequest) (*Empty, error)
+	Restore(context.Context, *RestoreItemFromSnapshotRequest) (*RestoreItemFromSnapshotResponse, error)
+}
+
+func RegisterItemSnapshotterServer(s *grpc.Server, srv ItemSnapshotterServer) {
+	s.RegisterService(&_ItemSnapshotter_serviceDesc, srv)
+}
+
+func _ItemSnapshotter_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemSnapshotterInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).Init(ctx, req.(*ItemSnapshotterInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemSnapshotterAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).AppliesTo(ctx, req.(*ItemSnapshotterAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_AlterBackupItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterBackupItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).AlterBackupItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/AlterBackupItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).AlterBackupItem(ctx, req.(*AlterBackupItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).Snapshot(ctx, req.(*SnapshotItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemSnapshotProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).Progress(ctx, req.(*ItemSnapshotProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).Delete(ctx, req.(*DeleteItemSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemSnapshotter_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemFromSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemSnapshotterServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemSnapshotter/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemSnapshotterServer).Restore(ctx, req.(*RestoreItemFromSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ItemSnapshotter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.ItemSnapshotter",
+	HandlerType: (*ItemSnapshotterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _ItemSnapshotter_Init_Handler,
+		},
+		{
+			MethodName: "AppliesTo",
+			Handler:    _ItemSnapshotter_AppliesTo_Handler,
+		},
+		{
+			MethodName: "AlterBackupItem",
+			Handler:    _ItemSnapshotter_AlterBackupItem_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _ItemSnapshotter_Snapshot_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _ItemSnapshotter_Progress_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ItemSnapshotter_Delete_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _ItemSnapshotter_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ItemSnapshotter.proto",
+}
+
+func init() { proto.RegisterFile("ItemSnapshotter.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x5f, 0x4f, 0xd3, 0x50,
+	0x14, 0x4f, 0xb7, 0xb1, 0x8d, 0x33, 0x22, 0xcb, 0x15, 0xb0, 0x14, 0x84, 0xd9, 0x28, 0x22, 0xc6,
+	0x25, 0x42, 0x48, 0x80, 0x17, 0x84, 0x81, 0x64, 0x31, 0x46, 0xd3, 0xf9, 0x40, 0xe2, 0x8b, 0x65,
+	0x3b, 0x8c, 0x66, 0xa5, 0xb7, 0xde, 0xde, 0x19, 0xf9, 0x12, 0x26, 0x3e, 0xf8, 0xee, 0x83, 0x89,
+	0x9f, 0xc0, 0x17, 0x3f, 0x8d, 0x1f, 0xc5, 0xec, 0xf6, 0x76, 0x5c, 0xba, 0x96, 0x55, 0x62, 0x8c,
+	0x6f, 0xbd, 0xa7, 0xe7, 0xfc, 0xce, 0xef, 0xfc, 0xb9, 0xe7, 0x5c, 0x98, 0x6d, 0x72, 0x3c, 0x6f,
+	0x79, 0xb6, 0x1f, 0x9c, 0x51, 0xce, 0x91, 0xd5, 0x7d, 0x46, 0x39, 0x25, 0x93, 0x5d, 0xf4, 0x90,
+	0xd9, 0x1c, 0x3b, 0xc6, 0x54, 0xeb, 0xcc, 0x66, 0xd8, 0x09, 0x7f, 0x98, 0x3f, 0x35, 0x30, 0x62,
+	0x26, 0x4d, 0xcf, 0xe1, 0x16, 0xbe, 0xef, 0x63, 0xc0, 0xc9, 0x1c, 0x14, 0x7d, 0xb7, 0xdf, 0x75,
+	0x3c, 0x5d, 0xab, 0x69, 0xab, 0x93, 0x96, 0x3c, 0x91, 0x26, 0x14, 0xdb, 0xd4, 0x3b, 0x75, 0xba,
+	0x7a, 0xae, 0x96, 0x5f, 0xad, 0xac, 0x3f, 0xad, 0x0f, 0x1d, 0xd4, 0xd3, 0xe1, 0xea, 0x0d, 0x61,
+	0x73, 0xe8, 0x71, 0x76, 0x61, 0x49, 0x00, 0x63, 0x1b, 0x2a, 0x8a, 0x98, 0x54, 0x21, 0xdf, 0xc3,
+	0x0b, 0xe9, 0x6e, 0xf0, 0x49, 0x66, 0x60, 0xe2, 0x83, 0xed, 0xf6, 0x51, 0xcf, 0x09, 0x59, 0x78,
+	0xd8, 0xc9, 0x6d, 0x69, 0xe6, 0x36, 0x2c, 0xc7, 0x9c, 0xed, 0xf9, 0xbe, 0xeb, 0x60, 0xf0, 0x86,
+	0x8e, 0x09, 0xc0, 0xec, 0x41, 0x2d, 0xdd, 0x34, 0xf0, 0xa9, 0x17, 0x20, 0x39, 0x82, 0xaa, 0x85,
+	0x01, 0xed, 0xb3, 0x36, 0xb6, 0xd0, 0xc5, 0x36, 0xa7, 0x4c, 0xa0, 0x54, 0xd6, 0x17, 0x94, 0x70,
+	0xe3, 0x2a, 0xd6, 0x88, 0x91, 0xf9, 0x59, 0x83, 0xb9, 0x3d, 0x97, 0x23, 0xdb, 0xb7, 0xdb, 0xbd,
+	0xbe, 0x3f, 0x70, 0x3c, 0x2e, 0xc1, 0x04, 0x0a, 0x0e, 0xc7, 0x73, 0x11, 0xf3, 0x94, 0x25, 0xbe,
+	0x07, 0xba, 0x27, 0x02, 0x40, 0xcf, 0x0b, 0xa9, 0x3c, 0x91, 0x0d, 0x28, 0xb5, 0xdd, 0x7e, 0xc0,
+	0x91, 0xe9, 0x05, 0x41, 0x6f, 0x5e, 0xa1, 0xd7, 0x08, 0xff, 0x34, 0xa8, 0xc7, 0xf1, 0x23, 0xb7,
+	0x22, 0x4d, 0xf3, 0x09, 0xdc, 0x19, 0xa1, 0x24, 0xe3, 0x8e, 0x7c, 0x6b, 0x97, 0xbe, 0xcd, 0x4f,
+	0x1a, 0xdc, 0x8e, 0x92, 0xf5, 0x5f, 0xf0, 0xff, 0x9e, 0x83, 0x99, 0xab, 0x84, 0x24, 0xfb, 0x25,
+	0x80, 0x20, 0x92, 0x1f, 0x48, 0x56, 0x8a, 0x84, 0xd8, 0x50, 0x8d, 0x4e, 0x2f, 0x91, 0xdb, 0x1d,
+	0x9b, 0xdb, 0xb2, 0x89, 0x37, 0x15, 0xb7, 0x49, 0xd0, 0xf5, 0x56, 0xcc, 0x2e, 0x6c, 0xe4, 0x11,
+	0x38, 0x72, 0x04, 0xd3, 0x76, 0xa7, 0xe3, 0x70, 0x87, 0x7a, 0xb6, 0x3b, 0x40, 0x08, 0xf4, 0xbc,
+	0xf0, 0x70, 0x37, 0xa1, 0x6f, 0x9a, 0x1d, 0xf4, 0xb8, 0x73, 0xea, 0x20, 0xb3, 0xe2, 0x56, 0x46,
+	0x03, 0x66, 0x13, 0x7d, 0xfe, 0xd1, 0x2d, 0xf9, 0xa6, 0xc1, 0x82, 0xda, 0xeb, 0xaf, 0x19, 0xed,
+	0x32, 0x0c, 0x82, 0x71, 0x25, 0xdc, 0x84, 0xe2, 0xa0, 0x6c, 0xcd, 0x03, 0x01, 0x39, 0x96, 0xbc,
+	0x54, 0x8e, 0xe5, 0x3f, 0x3f, 0x92, 0xff, 0xcb, 0x2e, 0x28, 0xa8, 0x5d, 0x60, 0x1e, 0xc3, 0x62,
+	0x32, 0x4b, 0x59, 0xd7, 0x2d, 0x28, 0xfb, 0x52, 0x26, 0x6f, 0xe1, 0xa2, 0x42, 0xe8, 0x95, 0x3f,
+	0xf8, 0x70, 0xa8, 0x37, 0xb4, 0x1b, 0x6a, 0x9b, 0x3f, 0x72, 0x30, 0x7f, 0x80, 0x2e, 0x72, 0x54,
+	0x1d, 0x8c, 0x0b, 0xff, 0x6a, 0x1c, 0xb9, 0x91, 0x38, 0x4e, 0x13, 0xfa, 0x28, 0xac, 0xf2, 0x8e,
+	0xc2, 0x2b, 0xd5, 0x6f, 0xe6, 0x66, 0xba, 0xc9, 0xed, 0xf8, 0x3b, 0x8d, 0xf3, 0x25, 0x0f, 0x4b,
+	0x16, 0x06, 0x9c, 0x32, 0x11, 0xc0, 0x73, 0x46, 0x33, 0x27, 0x2f, 0xe9, 0xfa, 0xaf, 0xc0, 0x2d,
+	0x47, 0xc2, 0xec, 0xab, 0x63, 0x20, 0x26, 0x8d, 0x25, 0xbe, 0x30, 0x92, 0xf8, 0x5e, 0x42, 0xe2,
+	0x27, 0x44, 0xe2, 0x77, 0xaf, 0x76, 0xe8, 0x35, 0xc4, 0x33, 0x67, 0x5f, 0x87, 0x12, 0x0b, 0x91,
+	0xf4, 0xa2, 0x60, 0x1b, 0x1d, 0xd5, 0xba, 0x94, 0xfe, 0x6d, 0x5d, 0xbe, 0x6a, 0xb0, 0x9c, 0x1a,
+	0x5e, 0xfa, 0x0c, 0x4f, 0x1a, 0x4b, 0xb9, 0x9b, 0x8c, 0x25, 0x52, 0x83, 0x4a, 0xd0, 0x73, 0x7c,
+	0xc9, 0x41, 0x94, 0xb1, 0x6c, 0xa9, 0xa2, 0xf5, 0x5f, 0x05, 0x98, 0x8e, 0xed, 0x57, 0xb2, 0x0b,
+	0x85, 0xc1, 0x5b, 0x80, 0x3c, 0xc8, 0xf4, 0x56, 0x30, 0xaa, 0x8a, 0xda, 0xe1, 0xb9, 0xcf, 0x2f,
+	0x48, 0x07, 0x26, 0x87, 0x4b, 0x9a, 0xac, 0xa5, 0xa3, 0xc4, 0x1f, 0x01, 0xc6, 0xe3, 0x4c, 0xba,
+	0x32, 0x73, 0xc7, 0x30, 0x1d, 0x5b, 0x8c, 0xe4, 0x9e, 0x62, 0x9f, 0xbc, 0xc7, 0x0d, 0xf3, 0x3a,
+	0x15, 0x89, 0xfc, 0x02, 0xca, 0x91, 0x67, 0xb2, 0x94, 0xba, 0x6b, 0x42, 0xbc, 0xe5, 0x31, 0xbb,
+	0x88, 0xbc, 0x85, 0x72, 0x34, 0xea, 0xc8, 0x4a, 0x4a, 0x7c, 0xb1, 0x49, 0x6f, 0x3c, 0x1c, 0xab,
+	0x27, 0xc1, 0x9f, 0x41, 0x31, 0x1c, 0x5c, 0xe4, 0x7e, 0x96, 0x59, 0x96, 0x50, 0xab, 0x77, 0x50,
+	0x92, 0xbd, 0x40, 0x1e, 0x65, 0xbe, 0x95, 0xc6, 0x5a, 0x16, 0xd5, 0x90, 0xe3, 0x49, 0x51, 0x3c,
+	0x60, 0x37, 0x7e, 0x0f, 0x00, 0x35, 0xaf, 0x0d, 0x5f, 0xf2, 0x0a, 0x00, 0x00,
+}
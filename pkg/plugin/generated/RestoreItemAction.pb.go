@@ -18,17 +18,18 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type RestoreItemActionExecuteRequest struct {
-	Plugin         string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
-	Item           []byte `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	Restore        []byte `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
-	ItemFromBackup []byte `protobuf:"bytes,4,opt,name=itemFromBackup,proto3" json:"itemFromBackup,omitempty"`
+	Plugin         string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item           []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Restore        []byte          `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+	ItemFromBackup []byte          `protobuf:"bytes,4,opt,name=itemFromBackup,proto3" json:"itemFromBackup,omitempty"`
+	Cluster        *ClusterContext `protobuf:"bytes,5,opt,name=cluster" json:"cluster,omitempty"`
 }
 
 func (m *RestoreItemActionExecuteRequest) Reset()         { *m = RestoreItemActionExecuteRequest{} }
 func (m *RestoreItemActionExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionExecuteRequest) ProtoMessage()    {}
 func (*RestoreItemActionExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{0}
+	return fileDescriptor6, []int{0}
 }
 
 func (m *RestoreItemActionExecuteRequest) GetPlugin() string {
@@ -59,6 +60,13 @@ func (m *RestoreItemActionExecuteRequest) GetItemFromBackup() []byte {
 	return nil
 }
 
+func (m *RestoreItemActionExecuteRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
 type RestoreItemActionExecuteResponse struct {
 	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
@@ -69,7 +77,7 @@ func (m *RestoreItemActionExecuteResponse) Reset()         { *m = RestoreItemAct
 func (m *RestoreItemActionExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionExecuteResponse) ProtoMessage()    {}
 func (*RestoreItemActionExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{1}
+	return fileDescriptor6, []int{1}
 }
 
 func (m *RestoreItemActionExecuteResponse) GetItem() []byte {
@@ -101,7 +109,7 @@ func (m *RestoreItemActionAppliesToRequest) Reset()         { *m = RestoreItemAc
 func (m *RestoreItemActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionAppliesToRequest) ProtoMessage()    {}
 func (*RestoreItemActionAppliesToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{2}
+	return fileDescriptor6, []int{2}
 }
 
 func (m *RestoreItemActionAppliesToRequest) GetPlugin() string {
@@ -119,7 +127,7 @@ func (m *RestoreItemActionAppliesToResponse) Reset()         { *m = RestoreItemA
 func (m *RestoreItemActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreItemActionAppliesToResponse) ProtoMessage()    {}
 func (*RestoreItemActionAppliesToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{3}
+	return fileDescriptor6, []int{3}
 }
 
 func (m *RestoreItemActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
@@ -241,29 +249,31 @@ var _RestoreItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "RestoreItemAction.proto",
 }
 
-func init() { proto.RegisterFile("RestoreItemAction.proto", fileDescriptor4) }
-
-var fileDescriptor4 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x4e, 0xc2, 0x30,
-	0x14, 0x4e, 0x81, 0x80, 0x1c, 0x88, 0x3f, 0xbd, 0xd0, 0x06, 0x63, 0x9c, 0xbb, 0x30, 0xc4, 0x1f,
-	0x2e, 0xf0, 0xd2, 0x2b, 0x4c, 0x94, 0x70, 0x5b, 0x7c, 0x81, 0xb1, 0x1d, 0xa1, 0x61, 0x5b, 0x6b,
-	0xdb, 0x25, 0xbe, 0x85, 0xcf, 0xe0, 0xa3, 0xf9, 0x26, 0x86, 0x31, 0x96, 0xc1, 0x74, 0x72, 0xd7,
-	0x73, 0xfa, 0x7d, 0xe7, 0xfb, 0xbe, 0xf6, 0xc0, 0x19, 0x47, 0x63, 0xa5, 0xc6, 0x89, 0xc5, 0x68,
-	0xe4, 0x5b, 0x21, 0xe3, 0x81, 0xd2, 0xd2, 0x4a, 0xda, 0x9e, 0x63, 0x8c, 0xda, 0xb3, 0x18, 0xf4,
-	0xba, 0xd3, 0x85, 0xa7, 0x31, 0x58, 0x5f, 0xb8, 0x9f, 0x04, 0x2e, 0x4b, 0xa4, 0xe7, 0x0f, 0xf4,
-	0x13, 0x8b, 0x1c, 0xdf, 0x13, 0x34, 0x96, 0x9e, 0x42, 0x53, 0x85, 0xc9, 0x5c, 0xc4, 0x8c, 0x38,
-	0xa4, 0xdf, 0xe6, 0x59, 0x45, 0x29, 0x34, 0x84, 0xc5, 0x88, 0xd5, 0x1c, 0xd2, 0xef, 0xf2, 0xf4,
-	0x4c, 0x19, 0xb4, 0xf4, 0x7a, 0x1c, 0xab, 0xa7, 0xed, 0x4d, 0x49, 0xaf, 0xe1, 0x70, 0x85, 0x78,
-	0xd1, 0x32, 0x7a, 0xf2, 0xfc, 0x65, 0xa2, 0x58, 0x23, 0x05, 0xec, 0x74, 0xdd, 0x2f, 0x02, 0xce,
-	0xdf, 0x8e, 0x8c, 0x92, 0xb1, 0xc1, 0x5c, 0x9a, 0x14, 0xa4, 0xc7, 0x70, 0xe4, 0x05, 0x81, 0x58,
-	0xc1, 0xbd, 0x70, 0x45, 0x35, 0xac, 0xe6, 0xd4, 0xfb, 0x9d, 0xe1, 0xc5, 0x20, 0x4f, 0x3f, 0xe0,
-	0x68, 0x64, 0xa2, 0x7d, 0x9c, 0x04, 0x18, 0x5b, 0xf1, 0x26, 0x50, 0xf3, 0x5d, 0x16, 0x75, 0xa0,
-	0x63, 0x96, 0x42, 0xf1, 0x42, 0x8e, 0x03, 0x5e, 0x6c, 0xb9, 0x8f, 0x70, 0x55, 0xb2, 0x38, 0x52,
-	0x2a, 0x14, 0x68, 0x5e, 0xe5, 0x3f, 0xcf, 0xe6, 0x46, 0xe0, 0x56, 0x91, 0xb3, 0x84, 0x63, 0x38,
-	0xde, 0x78, 0x9d, 0x62, 0x88, 0xbe, 0x95, 0x3a, 0x9d, 0xd3, 0x19, 0x9e, 0xff, 0x12, 0x67, 0x03,
-	0xe1, 0x25, 0xd2, 0xf0, 0x9b, 0xc0, 0x49, 0x49, 0x8f, 0x2e, 0xa0, 0x9d, 0x6b, 0xd2, 0xbb, 0xed,
-	0x89, 0xd5, 0xb9, 0x7a, 0xf7, 0x7b, 0xa2, 0xb3, 0x20, 0x33, 0x68, 0x65, 0xbf, 0x47, 0x6f, 0xaa,
-	0x98, 0xdb, 0x4b, 0xd7, 0xbb, 0xdd, 0x0b, 0xbb, 0xd6, 0x98, 0x35, 0xd3, 0x65, 0x7e, 0xf8, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0x4c, 0xdc, 0xb7, 0x00, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("RestoreItemAction.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xea, 0x30,
+	0x10, 0x95, 0x81, 0x0b, 0x97, 0x09, 0xba, 0x0f, 0x2f, 0xee, 0x4d, 0xa9, 0xaa, 0xa6, 0x59, 0x54,
+	0x51, 0x1f, 0x2c, 0xc2, 0xb2, 0x2b, 0x8a, 0x5a, 0xc4, 0xd6, 0xf4, 0x07, 0x42, 0x32, 0x05, 0x8b,
+	0x24, 0x4e, 0x6d, 0x47, 0xe2, 0x77, 0xfa, 0x2f, 0xfd, 0x91, 0xfe, 0x49, 0x45, 0x1e, 0x28, 0x90,
+	0x96, 0xb2, 0xcb, 0xcc, 0x9c, 0x33, 0xe7, 0x9c, 0xd8, 0x86, 0xff, 0x0c, 0x95, 0x16, 0x12, 0xa7,
+	0x1a, 0xa3, 0x91, 0xaf, 0xb9, 0x88, 0x07, 0x89, 0x14, 0x5a, 0xd0, 0xee, 0x02, 0x63, 0x94, 0x9e,
+	0xc6, 0xa0, 0xdf, 0x9b, 0x2d, 0x3d, 0x89, 0x41, 0x3e, 0xb0, 0xdf, 0x08, 0x9c, 0xd7, 0x48, 0x0f,
+	0x6b, 0xf4, 0x53, 0x8d, 0x0c, 0x5f, 0x52, 0x54, 0x9a, 0xfe, 0x83, 0x76, 0x12, 0xa6, 0x0b, 0x1e,
+	0x9b, 0xc4, 0x22, 0x4e, 0x97, 0x15, 0x15, 0xa5, 0xd0, 0xe2, 0x1a, 0x23, 0xb3, 0x61, 0x11, 0xa7,
+	0xc7, 0xb2, 0x6f, 0x6a, 0x42, 0x47, 0xe6, 0xeb, 0xcc, 0x66, 0xd6, 0x2e, 0x4b, 0x7a, 0x09, 0xbf,
+	0x36, 0x88, 0x47, 0x29, 0xa2, 0x7b, 0xcf, 0x5f, 0xa5, 0x89, 0xd9, 0xca, 0x00, 0x7b, 0x5d, 0x3a,
+	0x84, 0x8e, 0x1f, 0xa6, 0x4a, 0xa3, 0x34, 0x7f, 0x58, 0xc4, 0x31, 0xdc, 0x93, 0xc1, 0xd6, 0xfc,
+	0x60, 0x9c, 0x4f, 0xc6, 0x22, 0xd6, 0xb8, 0xd6, 0xac, 0x44, 0xda, 0xaf, 0x04, 0xac, 0xaf, 0x63,
+	0xa8, 0x44, 0xc4, 0x0a, 0xb7, 0x7e, 0x49, 0xc5, 0xef, 0x04, 0x7e, 0x7b, 0x41, 0xc0, 0x37, 0x70,
+	0x2f, 0xdc, 0x50, 0x95, 0xd9, 0xb0, 0x9a, 0x8e, 0xe1, 0x9e, 0x55, 0x54, 0x19, 0x2a, 0x91, 0x4a,
+	0x1f, 0xa7, 0x01, 0xc6, 0x9a, 0x3f, 0x73, 0x94, 0x6c, 0x9f, 0x45, 0x2d, 0x30, 0xd4, 0x8a, 0x27,
+	0xac, 0x12, 0xfe, 0x27, 0xab, 0xb6, 0xec, 0x3b, 0xb8, 0xa8, 0x59, 0x1c, 0x25, 0x49, 0xc8, 0x51,
+	0x3d, 0x89, 0x6f, 0xfe, 0xb5, 0x1d, 0x81, 0x7d, 0x88, 0x5c, 0x24, 0x9c, 0xc0, 0x9f, 0xd2, 0xeb,
+	0x0c, 0x43, 0xf4, 0xb5, 0x90, 0xd9, 0x1e, 0xc3, 0x3d, 0xfd, 0x24, 0x4e, 0x09, 0x61, 0x35, 0x92,
+	0xfb, 0x4e, 0xe0, 0x6f, 0x4d, 0x8f, 0x2e, 0xa1, 0xbb, 0xd5, 0xa4, 0x37, 0xbb, 0x1b, 0x0f, 0xe7,
+	0xea, 0xdf, 0x1e, 0x89, 0x2e, 0x82, 0xcc, 0xa1, 0x53, 0x9c, 0x1e, 0xbd, 0x3a, 0xc4, 0xdc, 0xbd,
+	0xa9, 0xfd, 0xeb, 0xa3, 0xb0, 0xb9, 0xc6, 0xbc, 0x9d, 0xbd, 0x80, 0xe1, 0xc7, 0x00, 0x93, 0xe5,
+	0x1f, 0x0c, 0x35, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: RestoreItemActionV2.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type RestoreItemActionV2ExecuteRequest struct {
+	Plugin         string          `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item           []byte          `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Restore        []byte          `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+	ItemFromBackup []byte          `protobuf:"bytes,4,opt,name=itemFromBackup,proto3" json:"itemFromBackup,omitempty"`
+	Cluster        *ClusterContext `protobuf:"bytes,5,opt,name=cluster" json:"cluster,omitempty"`
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) Reset()         { *m = RestoreItemActionV2ExecuteRequest{} }
+func (m *RestoreItemActionV2ExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionV2ExecuteRequest) ProtoMessage()    {}
+func (*RestoreItemActionV2ExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor7, []int{0}
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) GetItemFromBackup() []byte {
+	if m != nil {
+		return m.ItemFromBackup
+	}
+	return nil
+}
+
+func (m *RestoreItemActionV2ExecuteRequest) GetCluster() *ClusterContext {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type RestoreItemActionV2ExecuteResponse struct {
+	Item            []byte                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	AdditionalItems []*ResourceIdentifier `protobuf:"bytes,2,rep,name=additionalItems" json:"additionalItems,omitempty"`
+	SkipRestore     bool                  `protobuf:"varint,3,opt,name=skipRestore" json:"skipRestore,omitempty"`
+	OperationID     string                `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+}
+
+func (m *RestoreItemActionV2ExecuteResponse) Reset()         { *m = RestoreItemActionV2ExecuteResponse{} }
+func (m *RestoreItemActionV2ExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionV2ExecuteResponse) ProtoMessage()    {}
+func (*RestoreItemActionV2ExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor7, []int{1}
+}
+
+func (m *RestoreItemActionV2ExecuteResponse) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreItemActionV2ExecuteResponse) GetAdditionalItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.AdditionalItems
+	}
+	return nil
+}
+
+func (m *RestoreItemActionV2ExecuteResponse) GetSkipRestore() bool {
+	if m != nil {
+		return m.SkipRestore
+	}
+	return false
+}
+
+func (m *RestoreItemActionV2ExecuteResponse) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type RestoreItemActionV2ProgressRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Restore     []byte `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (m *RestoreItemActionV2ProgressRequest) Reset()         { *m = RestoreItemActionV2ProgressRequest{} }
+func (m *RestoreItemActionV2ProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionV2ProgressRequest) ProtoMessage()    {}
+func (*RestoreItemActionV2ProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor7, []int{2}
+}
+
+func (m *RestoreItemActionV2ProgressRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemActionV2ProgressRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *RestoreItemActionV2ProgressRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+type RestoreItemActionV2ProgressResponse struct {
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+}
+
+func (m *RestoreItemActionV2ProgressResponse) Reset()         { *m = RestoreItemActionV2ProgressResponse{} }
+func (m *RestoreItemActionV2ProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionV2ProgressResponse) ProtoMessage()    {}
+func (*RestoreItemActionV2ProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor7, []int{3}
+}
+
+func (m *RestoreItemActionV2ProgressResponse) GetProgress() *OperationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type RestoreItemActionV2CancelRequest struct {
+	Plugin      string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	OperationID string `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	Restore     []byte `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (m *RestoreItemActionV2CancelRequest) Reset()         { *m = RestoreItemActionV2CancelRequest{} }
+func (m *RestoreItemActionV2CancelRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreItemActionV2CancelRequest) ProtoMessage()    {}
+func (*RestoreItemActionV2CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor7, []int{4}
+}
+
+func (m *RestoreItemActionV2CancelRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreItemActionV2CancelRequest) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *RestoreItemActionV2CancelRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RestoreItemActionV2ExecuteRequest)(nil), "generated.RestoreItemActionV2ExecuteRequest")
+	proto.RegisterType((*RestoreItemActionV2ExecuteResponse)(nil), "generated.RestoreItemActionV2ExecuteResponse")
+	proto.RegisterType((*RestoreItemActionV2ProgressRequest)(nil), "generated.RestoreItemActionV2ProgressRequest")
+	proto.RegisterType((*RestoreItemActionV2ProgressResponse)(nil), "generated.RestoreItemActionV2ProgressResponse")
+	proto.RegisterType((*RestoreItemActionV2CancelRequest)(nil), "generated.RestoreItemActionV2CancelRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for RestoreItemActionV2 service
+
+type RestoreItemActionV2Client interface {
+	AppliesTo(ctx context.Context, in *RestoreItemActionAppliesToRequest, opts ...grpc.CallOption) (*RestoreItemActionAppliesToResponse, error)
+	Execute(ctx context.Context, in *RestoreItemActionV2ExecuteRequest, opts ...grpc.CallOption) (*RestoreItemActionV2ExecuteResponse, error)
+	Progress(ctx context.Context, in *RestoreItemActionV2ProgressRequest, opts ...grpc.CallOption) (*RestoreItemActionV2ProgressResponse, error)
+	Cancel(ctx context.Context, in *RestoreItemActionV2CancelRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type restoreItemActionV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewRestoreItemActionV2Client(cc *grpc.ClientConn) RestoreItemActionV2Client {
+	return &restoreItemActionV2Client{cc}
+}
+
+func (c *restoreItemActionV2Client) AppliesTo(ctx context.Context, in *RestoreItemActionAppliesToRequest, opts ...grpc.CallOption) (*RestoreItemActionAppliesToResponse, error) {
+	out := new(RestoreItemActionAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restoreItemActionV2Client) Execute(ctx context.Context, in *RestoreItemActionV2ExecuteRequest, opts ...grpc.CallOption) (*RestoreItemActionV2ExecuteResponse, error) {
+	out := new(RestoreItemActionV2ExecuteResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/Execute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restoreItemActionV2Client) Progress(ctx context.Context, in *RestoreItemActionV2ProgressRequest, opts ...grpc.CallOption) (*RestoreItemActionV2ProgressResponse, error) {
+	out := new(RestoreItemActionV2ProgressResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/Progress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restoreItemActionV2Client) Cancel(ctx context.Context, in *RestoreItemActionV2CancelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.RestoreItemActionV2/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RestoreItemActionV2 service
+
+type RestoreItemActionV2Server interface {
+	AppliesTo(context.Context, *RestoreItemActionAppliesToRequest) (*RestoreItemActionAppliesToResponse, error)
+	Execute(context.Context, *RestoreItemActionV2ExecuteRequest) (*RestoreItemActionV2ExecuteResponse, error)
+	Progress(context.Context, *RestoreItemActionV2ProgressRequest) (*RestoreItemActionV2ProgressResponse, error)
+	Cancel(context.Context, *RestoreItemActionV2CancelRequest) (*Empty, error)
+}
+
+func RegisterRestoreItemActionV2Server(s *grpc.Server, srv RestoreItemActionV2Server) {
+	s.RegisterService(&_RestoreItemActionV2_serviceDesc, srv)
+}
+
+func _RestoreItemActionV2_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).AppliesTo(ctx, req.(*RestoreItemActionAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestoreItemActionV2_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionV2ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).Execute(ctx, req.(*RestoreItemActionV2ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestoreItemActionV2_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionV2ProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).Progress(ctx, req.(*RestoreItemActionV2ProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestoreItemActionV2_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemActionV2CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreItemActionV2Server).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreItemActionV2/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreItemActionV2Server).Cancel(ctx, req.(*RestoreItemActionV2CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RestoreItemActionV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.RestoreItemActionV2",
+	HandlerType: (*RestoreItemActionV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppliesTo",
+			Handler:    _RestoreItemActionV2_AppliesTo_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _RestoreItemActionV2_Execute_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _RestoreItemActionV2_Progress_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _RestoreItemActionV2_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "RestoreItemActionV2.proto",
+}
+
+func init() { proto.RegisterFile("RestoreItemActionV2.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x8e, 0x94, 0x40,
+	0x10, 0xc6, 0xc3, 0xcc, 0x3a, 0x7f, 0x6a, 0x36, 0x6a, 0xda, 0x44, 0x59, 0xa2, 0x09, 0x62, 0x62,
+	0x26, 0xd1, 0x9d, 0x03, 0x7b, 0xf1, 0xba, 0x8e, 0xeb, 0x66, 0x4e, 0x9a, 0xd6, 0x78, 0x35, 0x08,
+	0xb5, 0xb3, 0x9d, 0x85, 0xee, 0xb6, 0xbb, 0x31, 0xe3, 0xb3, 0xf8, 0x3c, 0xfa, 0x5c, 0x06, 0x68,
+	0x08, 0xc3, 0x22, 0x3b, 0x17, 0x6f, 0x74, 0xf1, 0xab, 0xfa, 0xea, 0xab, 0x6a, 0x80, 0x13, 0x8a,
+	0xda, 0x08, 0x85, 0x1b, 0x83, 0xd9, 0x79, 0x6c, 0x98, 0xe0, 0x5f, 0xc2, 0x95, 0x54, 0xc2, 0x08,
+	0x32, 0xdf, 0x22, 0x47, 0x15, 0x19, 0x4c, 0xbc, 0x27, 0xb7, 0xa8, 0x8a, 0xf1, 0x8e, 0x3f, 0x5d,
+	0x47, 0x0a, 0x93, 0xea, 0x14, 0xfc, 0x71, 0xe0, 0x79, 0x4f, 0xbd, 0x8b, 0x1d, 0xc6, 0xb9, 0x41,
+	0x8a, 0xdf, 0x73, 0xd4, 0x86, 0x3c, 0x86, 0x89, 0x4c, 0xf3, 0x2d, 0xe3, 0xae, 0xe3, 0x3b, 0xcb,
+	0x39, 0xb5, 0x27, 0x42, 0xe0, 0x88, 0x19, 0xcc, 0xdc, 0x91, 0xef, 0x2c, 0x8f, 0x69, 0xf9, 0x4c,
+	0x5c, 0x98, 0xaa, 0xaa, 0xa0, 0x3b, 0x2e, 0xc3, 0xf5, 0x91, 0xbc, 0x84, 0xfb, 0x05, 0xf1, 0x5e,
+	0x89, 0xec, 0x6d, 0x14, 0xdf, 0xe4, 0xd2, 0x3d, 0x2a, 0x81, 0x4e, 0x94, 0x9c, 0xc1, 0x34, 0x4e,
+	0x73, 0x6d, 0x50, 0xb9, 0xf7, 0x7c, 0x67, 0xb9, 0x08, 0x4f, 0x56, 0x8d, 0xaf, 0xd5, 0xba, 0x7a,
+	0xb3, 0x16, 0xdc, 0xe0, 0xce, 0xd0, 0x9a, 0x0c, 0x7e, 0x3b, 0x10, 0x0c, 0x19, 0xd1, 0x52, 0x70,
+	0x8d, 0x4d, 0xc7, 0x4e, 0xab, 0xe3, 0x4b, 0x78, 0x10, 0x25, 0x09, 0x2b, 0x12, 0xa2, 0xb4, 0x48,
+	0xd6, 0xee, 0xc8, 0x1f, 0x2f, 0x17, 0xe1, 0xb3, 0x96, 0x2e, 0x45, 0x2d, 0x72, 0x15, 0xe3, 0x26,
+	0x41, 0x6e, 0xd8, 0x15, 0x43, 0x45, 0xbb, 0x59, 0xc4, 0x87, 0x85, 0xbe, 0x61, 0x92, 0xb6, 0xec,
+	0xcf, 0x68, 0x3b, 0x54, 0x10, 0x42, 0x16, 0x15, 0x99, 0xe0, 0x9b, 0x77, 0xa5, 0xff, 0x39, 0x6d,
+	0x87, 0x82, 0x5d, 0xaf, 0x8d, 0x8f, 0x4a, 0x6c, 0x15, 0x6a, 0x7d, 0xd7, 0x42, 0x3a, 0xf5, 0x47,
+	0xb7, 0xea, 0xff, 0x7b, 0x3d, 0xc1, 0x57, 0x78, 0x31, 0xa8, 0x6c, 0x27, 0xf8, 0x06, 0x66, 0xd2,
+	0xc6, 0x4a, 0xf1, 0x45, 0xf8, 0xb4, 0x35, 0xa6, 0x0f, 0xb5, 0x54, 0x93, 0xd7, 0xd0, 0xc1, 0x0f,
+	0xf0, 0x7b, 0x04, 0xd6, 0x11, 0x8f, 0x31, 0xfd, 0x8f, 0xc6, 0xc2, 0x5f, 0x63, 0x78, 0xd4, 0x23,
+	0x4c, 0xae, 0x61, 0x7e, 0x2e, 0x65, 0xca, 0x50, 0x7f, 0x16, 0xe4, 0xf5, 0xfe, 0xae, 0xf7, 0xe1,
+	0x06, 0xb3, 0x6d, 0x7a, 0xa7, 0x07, 0xd2, 0x76, 0x66, 0x57, 0x30, 0xb5, 0x17, 0x71, 0x58, 0xa7,
+	0xfb, 0xe1, 0x79, 0xa7, 0x07, 0xd2, 0x56, 0x87, 0xc1, 0xac, 0x9e, 0x3b, 0xb9, 0x23, 0xb5, 0x73,
+	0xa3, 0xbc, 0xd5, 0xa1, 0xb8, 0x95, 0xba, 0x84, 0x49, 0xb5, 0x39, 0xf2, 0x6a, 0x38, 0x73, 0x6f,
+	0xbf, 0xde, 0xc3, 0x16, 0x7c, 0x91, 0x49, 0xf3, 0xf3, 0xdb, 0xa4, 0xfc, 0x11, 0x9d, 0xfd, 0x1d,
+	0x00, 0x16, 0x80, 0x33, 0xdf, 0xd7, 0x04, 0x00, 0x00,
+}
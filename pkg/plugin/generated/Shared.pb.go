@@ -18,7 +18,7 @@ type Empty struct {
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
 
 type Stack struct {
 	Frames []*StackFrame `protobuf:"bytes,1,rep,name=frames" json:"frames,omitempty"`
@@ -27,7 +27,7 @@ type Stack struct {
 func (m *Stack) Reset()                    { *m = Stack{} }
 func (m *Stack) String() string            { return proto.CompactTextString(m) }
 func (*Stack) ProtoMessage()               {}
-func (*Stack) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+func (*Stack) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
 
 func (m *Stack) GetFrames() []*StackFrame {
 	if m != nil {
@@ -45,7 +45,7 @@ type StackFrame struct {
 func (m *StackFrame) Reset()                    { *m = StackFrame{} }
 func (m *StackFrame) String() string            { return proto.CompactTextString(m) }
 func (*StackFrame) ProtoMessage()               {}
-func (*StackFrame) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+func (*StackFrame) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
 
 func (m *StackFrame) GetFile() string {
 	if m != nil {
@@ -78,7 +78,7 @@ type ResourceIdentifier struct {
 func (m *ResourceIdentifier) Reset()                    { *m = ResourceIdentifier{} }
 func (m *ResourceIdentifier) String() string            { return proto.CompactTextString(m) }
 func (*ResourceIdentifier) ProtoMessage()               {}
-func (*ResourceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+func (*ResourceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
 
 func (m *ResourceIdentifier) GetGroup() string {
 	if m != nil {
@@ -119,7 +119,7 @@ type ResourceSelector struct {
 func (m *ResourceSelector) Reset()                    { *m = ResourceSelector{} }
 func (m *ResourceSelector) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSelector) ProtoMessage()               {}
-func (*ResourceSelector) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+func (*ResourceSelector) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
 
 func (m *ResourceSelector) GetIncludedNamespaces() []string {
 	if m != nil {
@@ -156,35 +156,169 @@ func (m *ResourceSelector) GetSelector() string {
 	return ""
 }
 
+type OperationProgress struct {
+	Completed      bool   `protobuf:"varint,1,opt,name=completed" json:"completed,omitempty"`
+	Err            string `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	NCompleted     int64  `protobuf:"varint,3,opt,name=nCompleted" json:"nCompleted,omitempty"`
+	NTotal         int64  `protobuf:"varint,4,opt,name=nTotal" json:"nTotal,omitempty"`
+	OperationUnits string `protobuf:"bytes,5,opt,name=operationUnits" json:"operationUnits,omitempty"`
+	Description    string `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+	// started and updated are Unix timestamps in seconds.
+	Started int64 `protobuf:"varint,7,opt,name=started" json:"started,omitempty"`
+	Updated int64 `protobuf:"varint,8,opt,name=updated" json:"updated,omitempty"`
+}
+
+func (m *OperationProgress) Reset()                    { *m = OperationProgress{} }
+func (m *OperationProgress) String() string            { return proto.CompactTextString(m) }
+func (*OperationProgress) ProtoMessage()               {}
+func (*OperationProgress) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
+
+func (m *OperationProgress) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *OperationProgress) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetNCompleted() int64 {
+	if m != nil {
+		return m.NCompleted
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetNTotal() int64 {
+	if m != nil {
+		return m.NTotal
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetOperationUnits() string {
+	if m != nil {
+		return m.OperationUnits
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OperationProgress) GetStarted() int64 {
+	if m != nil {
+		return m.Started
+	}
+	return 0
+}
+
+func (m *OperationProgress) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type ClusterContext struct {
+	Host     string `protobuf:"bytes,1,opt,name=host" json:"host,omitempty"`
+	Token    string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	CaData   []byte `protobuf:"bytes,3,opt,name=caData,proto3" json:"caData,omitempty"`
+	Insecure bool   `protobuf:"varint,4,opt,name=insecure" json:"insecure,omitempty"`
+	ProxyURL string `protobuf:"bytes,5,opt,name=proxyURL" json:"proxyURL,omitempty"`
+}
+
+func (m *ClusterContext) Reset()                    { *m = ClusterContext{} }
+func (m *ClusterContext) String() string            { return proto.CompactTextString(m) }
+func (*ClusterContext) ProtoMessage()               {}
+func (*ClusterContext) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
+
+func (m *ClusterContext) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *ClusterContext) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ClusterContext) GetCaData() []byte {
+	if m != nil {
+		return m.CaData
+	}
+	return nil
+}
+
+func (m *ClusterContext) GetInsecure() bool {
+	if m != nil {
+		return m.Insecure
+	}
+	return false
+}
+
+func (m *ClusterContext) GetProxyURL() string {
+	if m != nil {
+		return m.ProxyURL
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "generated.Empty")
 	proto.RegisterType((*Stack)(nil), "generated.Stack")
 	proto.RegisterType((*StackFrame)(nil), "generated.StackFrame")
 	proto.RegisterType((*ResourceIdentifier)(nil), "generated.ResourceIdentifier")
 	proto.RegisterType((*ResourceSelector)(nil), "generated.ResourceSelector")
+	proto.RegisterType((*OperationProgress)(nil), "generated.OperationProgress")
+	proto.RegisterType((*ClusterContext)(nil), "generated.ClusterContext")
 }
 
-func init() { proto.RegisterFile("Shared.proto", fileDescriptor5) }
-
-var fileDescriptor5 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x4e, 0xb5, 0x30,
-	0x10, 0x85, 0xc3, 0x05, 0xee, 0xff, 0x33, 0xba, 0xd0, 0x46, 0x93, 0xc6, 0xb8, 0x20, 0xac, 0x58,
-	0x28, 0x0b, 0x4d, 0x7c, 0x03, 0x4d, 0xdc, 0x18, 0x53, 0x9e, 0x00, 0xcb, 0x70, 0x6d, 0xe4, 0xb6,
-	0xa4, 0x94, 0xe4, 0xfa, 0xca, 0x3e, 0x85, 0x69, 0x4b, 0x61, 0x81, 0xbb, 0x39, 0x73, 0x3e, 0xce,
-	0x0c, 0x93, 0xc2, 0x79, 0xfd, 0xd9, 0x68, 0x6c, 0xab, 0x41, 0x2b, 0xa3, 0x48, 0x76, 0x40, 0x89,
-	0xba, 0x31, 0xd8, 0x16, 0xff, 0x20, 0x7d, 0x3e, 0x0e, 0xe6, 0xbb, 0x78, 0x82, 0xb4, 0x36, 0x0d,
-	0xff, 0x22, 0xf7, 0xb0, 0xef, 0x74, 0x73, 0xc4, 0x91, 0x46, 0x79, 0x5c, 0x9e, 0x3d, 0x5c, 0x57,
-	0x0b, 0x5d, 0x39, 0xe2, 0xc5, 0xba, 0x6c, 0x86, 0x8a, 0x77, 0x80, 0xb5, 0x4b, 0x08, 0x24, 0x9d,
-	0xe8, 0x91, 0x46, 0x79, 0x54, 0x66, 0xcc, 0xd5, 0xb6, 0xd7, 0x0b, 0x89, 0x74, 0x97, 0x47, 0x65,
-	0xca, 0x5c, 0x4d, 0x6e, 0xe0, 0x7f, 0x37, 0x49, 0x6e, 0x84, 0x92, 0x34, 0x76, 0xec, 0xa2, 0x8b,
-	0x13, 0x10, 0x86, 0xa3, 0x9a, 0x34, 0xc7, 0xd7, 0x16, 0xa5, 0x11, 0x9d, 0x40, 0x4d, 0xae, 0x20,
-	0x3d, 0x68, 0x35, 0x0d, 0x73, 0xb4, 0x17, 0x36, 0x47, 0xcf, 0xac, 0xcb, 0xcf, 0xd8, 0xa2, 0xc9,
-	0x2d, 0x64, 0xd2, 0xae, 0x38, 0x34, 0x1c, 0xe7, 0x21, 0x6b, 0xc3, 0x6e, 0x65, 0x05, 0x4d, 0xfc,
-	0xa6, 0xb6, 0x2e, 0x7e, 0x22, 0xb8, 0x08, 0xa3, 0x6b, 0xec, 0x91, 0x1b, 0xa5, 0x49, 0x05, 0x44,
-	0x48, 0xde, 0x4f, 0x2d, 0xb6, 0x6f, 0xe1, 0x6b, 0x7f, 0x9b, 0x8c, 0xfd, 0xe1, 0x58, 0x1e, 0x4f,
-	0x1b, 0x7e, 0xe7, 0xf9, 0xad, 0x43, 0xee, 0xe0, 0x32, 0xa4, 0x84, 0xd9, 0x23, 0x8d, 0x1d, 0xbe,
-	0x35, 0x2c, 0x1d, 0x32, 0x56, 0x3a, 0xf1, 0xf4, 0xc6, 0xb0, 0xe7, 0x19, 0xe7, 0xff, 0xa0, 0xa9,
-	0x3f, 0x4f, 0xd0, 0x1f, 0x7b, 0xf7, 0x16, 0x1e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x83,
-	0xa1, 0x97, 0x1b, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("Shared.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xdd, 0x6a, 0x14, 0x31,
+	0x14, 0x66, 0x3a, 0xfb, 0x7b, 0x5a, 0x4a, 0x1b, 0x54, 0x82, 0x88, 0x2c, 0x73, 0x21, 0x7b, 0xa1,
+	0x7b, 0xa1, 0xe0, 0x0b, 0xac, 0x0a, 0x82, 0x68, 0xc9, 0xda, 0x07, 0x88, 0x99, 0xb3, 0xdb, 0xd0,
+	0xd9, 0x64, 0x48, 0x32, 0xb0, 0x7d, 0x05, 0x1f, 0xd5, 0x37, 0xf0, 0x4e, 0x4e, 0x26, 0x99, 0x2d,
+	0x5d, 0xef, 0xce, 0xf7, 0x33, 0x39, 0x39, 0x5f, 0xce, 0xc0, 0xc5, 0xe6, 0x4e, 0x3a, 0xac, 0x57,
+	0xad, 0xb3, 0xc1, 0xb2, 0xf9, 0x0e, 0x0d, 0x3a, 0x19, 0xb0, 0xae, 0xa6, 0x30, 0xfe, 0xbc, 0x6f,
+	0xc3, 0x43, 0xf5, 0x11, 0xc6, 0x9b, 0x20, 0xd5, 0x3d, 0x7b, 0x07, 0x93, 0xad, 0x93, 0x7b, 0xf4,
+	0xbc, 0x58, 0x94, 0xcb, 0xf3, 0xf7, 0xcf, 0x57, 0x83, 0x7b, 0x15, 0x1d, 0x5f, 0x48, 0x15, 0xc9,
+	0x54, 0xdd, 0x00, 0x1c, 0x59, 0xc6, 0x60, 0xb4, 0xd5, 0x0d, 0xf2, 0x62, 0x51, 0x2c, 0xe7, 0x22,
+	0xd6, 0xc4, 0x35, 0xda, 0x20, 0x3f, 0x5b, 0x14, 0xcb, 0xb1, 0x88, 0x35, 0x7b, 0x09, 0xb3, 0x6d,
+	0x67, 0x54, 0xd0, 0xd6, 0xf0, 0x32, 0x7a, 0x07, 0x5c, 0x1d, 0x80, 0x09, 0xf4, 0xb6, 0x73, 0x0a,
+	0xbf, 0xd6, 0x68, 0x82, 0xde, 0x6a, 0x74, 0xec, 0x19, 0x8c, 0x77, 0xce, 0x76, 0x6d, 0x3a, 0xba,
+	0x07, 0x74, 0x8e, 0x4b, 0xde, 0x78, 0xfe, 0x5c, 0x0c, 0x98, 0xbd, 0x82, 0xb9, 0xa1, 0x2b, 0xb6,
+	0x52, 0x61, 0x6a, 0x72, 0x24, 0xe8, 0x56, 0x04, 0xf8, 0xa8, 0xbf, 0x29, 0xd5, 0xd5, 0x9f, 0x02,
+	0xae, 0x72, 0xeb, 0x0d, 0x36, 0xa8, 0x82, 0x75, 0x6c, 0x05, 0x4c, 0x1b, 0xd5, 0x74, 0x35, 0xd6,
+	0xdf, 0xf3, 0xd7, 0x7d, 0x36, 0x73, 0xf1, 0x1f, 0x85, 0xfc, 0x78, 0x38, 0xf1, 0x9f, 0xf5, 0xfe,
+	0x53, 0x85, 0xbd, 0x85, 0xeb, 0x7c, 0x4a, 0xee, 0xed, 0x79, 0x19, 0xed, 0xa7, 0x02, 0xb9, 0xf1,
+	0xf0, 0x84, 0xe4, 0xa3, 0xde, 0x7d, 0x22, 0x50, 0x3c, 0x3e, 0xcd, 0xc1, 0xc7, 0x7d, 0x3c, 0x19,
+	0x57, 0x7f, 0x0b, 0xb8, 0xfe, 0xd1, 0xd2, 0xc3, 0x6a, 0x6b, 0x6e, 0x9c, 0xdd, 0x39, 0xf4, 0x9e,
+	0x42, 0x53, 0x76, 0xdf, 0x36, 0x18, 0xb0, 0x8e, 0x51, 0xcf, 0xc4, 0x91, 0x60, 0x57, 0x50, 0xa2,
+	0x73, 0x29, 0x69, 0x2a, 0xd9, 0x6b, 0x00, 0xb3, 0x1e, 0x3e, 0xa0, 0x94, 0x4b, 0xf1, 0x88, 0x61,
+	0x2f, 0x60, 0x62, 0x7e, 0xda, 0x20, 0x9b, 0x18, 0x74, 0x29, 0x12, 0x62, 0x6f, 0xe0, 0xd2, 0xe6,
+	0xe6, 0xb7, 0x46, 0x07, 0x9f, 0xee, 0xf7, 0x84, 0x65, 0x0b, 0x38, 0xaf, 0xd1, 0x2b, 0xa7, 0x5b,
+	0xe2, 0xf8, 0x24, 0x9a, 0x1e, 0x53, 0x8c, 0xc3, 0xd4, 0x07, 0xe9, 0xa8, 0xfd, 0x34, 0xb6, 0xc8,
+	0x90, 0x94, 0xae, 0xad, 0x69, 0x71, 0xf9, 0xac, 0x57, 0x12, 0xac, 0x7e, 0x17, 0x70, 0xb9, 0x6e,
+	0x3a, 0x1f, 0xd0, 0xad, 0xad, 0x09, 0x78, 0x08, 0xb4, 0x0f, 0x77, 0xd6, 0x87, 0xbc, 0xb9, 0x54,
+	0xd3, 0xce, 0x05, 0x7b, 0x8f, 0x26, 0x0d, 0xdc, 0x03, 0x1a, 0x49, 0xc9, 0x4f, 0x32, 0xc8, 0x38,
+	0xee, 0x85, 0x48, 0x88, 0xc2, 0xd6, 0xc6, 0xa3, 0xea, 0x5c, 0xbf, 0x55, 0x33, 0x31, 0x60, 0xd2,
+	0x5a, 0x67, 0x0f, 0x0f, 0xb7, 0xe2, 0x5b, 0x7e, 0x88, 0x8c, 0x7f, 0x4d, 0xe2, 0x4f, 0xf9, 0xe1,
+	0xdf, 0x00, 0x43, 0x73, 0x6e, 0x2c, 0xa4, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"bytes"
+
+	"github.com/docker/cli/cli/config"
+	"github.com/docker/cli/cli/config/configfile"
+	"github.com/docker/cli/cli/config/types"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+)
+
+// Anonymous is the keychain of images that are pulled without credentials.
+var Anonymous authn.Keychain = authn.NewMultiKeychain()
+
+// pullSecretKeychain resolves the credentials for registries from the docker config
+// of an image pull secret.
+type pullSecretKeychain struct {
+	config *configfile.ConfigFile
+}
+
+// PullSecretKeychain returns the keychain of data, the content of a secret of type
+// kubernetes.io/dockerconfigjson. Only the credentials in the secret are used: its
+// credential helpers, if any, are ignored, so they can't run on the Velero server.
+func PullSecretKeychain(data []byte) (authn.Keychain, error) {
+	configFile, err := config.LoadFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing docker config")
+	}
+	configFile.CredentialsStore = ""
+	configFile.CredentialHelpers = nil
+
+	return &pullSecretKeychain{config: configFile}, nil
+}
+
+// Resolve returns the authenticator for the registry of target, or an anonymous one if
+// the docker config has no credentials for it.
+func (k *pullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	key := target.RegistryStr()
+	if key == name.DefaultRegistry {
+		key = authn.DefaultAuthKey
+	}
+
+	authConfig, err := k.config.GetAuthConfig(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if authConfig == (types.AuthConfig{}) {
+		return authn.Anonymous, nil
+	}
+
+	return authn.FromConfig(authn.AuthConfig{
+		Username:      authConfig.Username,
+		Password:      authConfig.Password,
+		Auth:          authConfig.Auth,
+		IdentityToken: authConfig.IdentityToken,
+		RegistryToken: authConfig.RegistryToken,
+	}), nil
+}
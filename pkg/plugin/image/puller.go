@@ -0,0 +1,253 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/pkg/errors"
+)
+
+// pluginsDir is the directory of a plugin image that holds its plugin binaries.
+const pluginsDir = "plugins"
+
+// Puller pulls plugin images from registries.
+type Puller struct {
+	transport http.RoundTripper
+	platform  v1.Platform
+}
+
+// NewPuller returns a Puller that makes its requests with transport, and pulls the
+// images of the platform Velero runs on from multi-platform images.
+func NewPuller(transport http.RoundTripper) *Puller {
+	return &Puller{
+		transport: transport,
+		platform:  v1.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH},
+	}
+}
+
+// Pull pulls the image with digest, authenticating with the credentials of keychain,
+// and unpacks the files in its /plugins directory into dir, which must exist. It returns
+// the paths of the unpacked files, relative to dir. The manifests and layers of the
+// image are checked against their digests before anything is unpacked.
+func (p *Puller) Pull(ctx context.Context, image, digest string, keychain authn.Keychain, dir string) ([]string, error) {
+	ref, err := ParseReference(image, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := remote.Image(ref,
+		remote.WithContext(ctx),
+		remote.WithTransport(p.transport),
+		remote.WithAuthFromKeychain(keychain),
+		remote.WithPlatform(p.platform),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error pulling %s", ref)
+	}
+
+	layersDir, err := ioutil.TempDir("", "velero-plugin-image-")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer os.RemoveAll(layersDir)
+
+	img, err = downloadLayers(img, layersDir)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error pulling %s", ref)
+	}
+
+	// Extract flattens the layers, applying their whiteouts, into one tar archive.
+	fs := mutate.Extract(img)
+	defer fs.Close()
+	if err := untar(fs, dir); err != nil {
+		return nil, errors.WithMessagef(err, "error unpacking %s", ref)
+	}
+
+	files, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.Errorf("image %s has no files in /%s", ref, pluginsDir)
+	}
+	return files, nil
+}
+
+// ParseReference returns the reference to the image with digest. The image is a name
+// like "velero/velero-plugin-for-aws" or "gcr.io/my-project/my-plugin", and a tag
+// is ignored. Images with a digest are rejected, so it can't disagree with digest.
+func ParseReference(image, digest string) (name.Digest, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return name.Digest{}, errors.Wrapf(err, "invalid image %q", image)
+	}
+	if _, ok := ref.(name.Digest); ok {
+		return name.Digest{}, errors.Errorf("image %q must not include a digest", image)
+	}
+
+	digestRef, err := name.NewDigest(ref.Context().Name() + "@" + digest)
+	if err != nil {
+		return name.Digest{}, errors.Wrapf(err, "invalid digest %q", digest)
+	}
+	return digestRef, nil
+}
+
+// downloadLayers downloads the layers of img into dir and returns an image of the
+// downloaded layers. A layer is only checked against its digest once all of it is read,
+// and unpacking an image stops reading its layers at the end of their tar archives, so
+// layers are downloaded whole before any of them is unpacked.
+func downloadLayers(img v1.Image, dir string) (v1.Image, error) {
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	downloaded := make([]v1.Layer, 0, len(layers))
+	for i, layer := range layers {
+		digest, err := layer.Digest()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("layer-%d", i))
+		if err := downloadLayer(layer, path); err != nil {
+			return nil, errors.WithMessagef(err, "error downloading layer %s", digest)
+		}
+
+		local, err := tarball.LayerFromFile(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		downloaded = append(downloaded, local)
+	}
+
+	return mutate.AppendLayers(empty.Image, downloaded...)
+}
+
+// downloadLayer writes the compressed contents of layer to a file at path. It fails if
+// the contents don't match the layer's digest.
+func downloadLayer(layer v1.Layer, path string) error {
+	contents, err := layer.Compressed()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer contents.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(file, contents); err != nil {
+		file.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(file.Close())
+}
+
+// untar writes the files of the tar archive that are in its /plugins directory into dir.
+func untar(r io.Reader, dir string) error {
+	tarReader := tar.NewReader(r)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading image")
+		}
+
+		// cleaning the path as an absolute one keeps it from leaving the archive's root
+		name := path.Clean("/" + header.Name)
+		rel := strings.TrimPrefix(name, "/"+pluginsDir+"/")
+		if rel == name {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(rel))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return errors.WithStack(err)
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, tarReader, header.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		default:
+			// links and special files aren't plugins
+		}
+	}
+}
+
+// writeFile writes the contents of r to a file at target, replacing it if it exists.
+func writeFile(target string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.RemoveAll(target); err != nil {
+		return errors.WithStack(err)
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "error writing %s", target)
+	}
+	return errors.WithStack(file.Close())
+}
+
+// listFiles returns the paths of the regular files in dir, relative to it.
+func listFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	return files, errors.WithStack(err)
+}
@@ -0,0 +1,332 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry is an in-memory registry that can require credentials, and replace
+// the content of blobs, once the test images are pushed to it.
+type fakeRegistry struct {
+	registry http.Handler
+	username string
+	password string
+	replaced map[string][]byte
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.username != "" {
+		if username, password, ok := req.BasicAuth(); !ok || username != r.username || password != r.password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	for digest, data := range r.replaced {
+		if req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/blobs/"+digest) {
+			w.Write(data)
+			return
+		}
+	}
+
+	r.registry.ServeHTTP(w, req)
+}
+
+func layer(t *testing.T, files map[string]string) v1.Layer {
+	buf := new(bytes.Buffer)
+	tarWriter := tar.NewWriter(buf)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0755,
+			Size:     int64(len(content)),
+		}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+
+	l, err := tarball.LayerFromReader(buf)
+	require.NoError(t, err)
+	return l
+}
+
+func testImage(t *testing.T, layers ...map[string]string) v1.Image {
+	img := empty.Image
+	for _, files := range layers {
+		var err error
+		img, err = mutate.AppendLayers(img, layer(t, files))
+		require.NoError(t, err)
+	}
+	return img
+}
+
+// push pushes img, or an index of it for linux/amd64 and another image for
+// windows/amd64, to the registry and returns the image name and the digest.
+func push(t *testing.T, server *httptest.Server, img v1.Image, multiPlatform bool) (string, string) {
+	image := strings.TrimPrefix(server.URL, "http://") + "/velero/plugin"
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+
+	if !multiPlatform {
+		require.NoError(t, remote.Write(ref, img))
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		return image, digest.String()
+	}
+
+	index := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: testImage(t, map[string]string{"plugins/velero-plugin-a.exe": "a"}), Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "windows", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: img, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+	)
+	require.NoError(t, remote.WriteIndex(ref, index))
+	digest, err := index.Digest()
+	require.NoError(t, err)
+	return image, digest.String()
+}
+
+func TestPull(t *testing.T) {
+	tests := []struct {
+		name          string
+		layers        []map[string]string
+		multiPlatform bool
+		username      string
+		wantFiles     map[string]string
+		wantErr       string
+	}{
+		{
+			name: "files in /plugins of all layers are unpacked",
+			layers: []map[string]string{
+				{"plugins/velero-plugin-a": "a", "bin/sh": "sh"},
+				{"plugins/nested/velero-plugin-b": "b", "plugins/velero-plugin-a": "a2"},
+			},
+			wantFiles: map[string]string{
+				"velero-plugin-a":        "a2",
+				"nested/velero-plugin-b": "b",
+			},
+		},
+		{
+			name: "whiteouts remove the files of lower layers",
+			layers: []map[string]string{
+				{"plugins/velero-plugin-a": "a", "plugins/velero-plugin-b": "b"},
+				{"plugins/.wh.velero-plugin-a": ""},
+			},
+			wantFiles: map[string]string{
+				"velero-plugin-b": "b",
+			},
+		},
+		{
+			name: "paths outside of /plugins aren't unpacked",
+			layers: []map[string]string{
+				{"plugins/../../escaped": "x", "plugins/velero-plugin-a": "a"},
+			},
+			wantFiles: map[string]string{
+				"velero-plugin-a": "a",
+			},
+		},
+		{
+			name: "the image for the platform is pulled from an index",
+			layers: []map[string]string{
+				{"plugins/velero-plugin-a": "a"},
+			},
+			multiPlatform: true,
+			wantFiles: map[string]string{
+				"velero-plugin-a": "a",
+			},
+		},
+		{
+			name: "an image without files in /plugins is an error",
+			layers: []map[string]string{
+				{"bin/sh": "sh"},
+			},
+			wantErr: "has no files in /plugins",
+		},
+		{
+			name: "the keychain's credentials are used when the registry asks for them",
+			layers: []map[string]string{
+				{"plugins/velero-plugin-a": "a"},
+			},
+			username: "user",
+			wantFiles: map[string]string{
+				"velero-plugin-a": "a",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := &fakeRegistry{registry: registry.New(registry.Logger(log.New(ioutil.Discard, "", 0)))}
+			server := httptest.NewServer(reg)
+			defer server.Close()
+
+			image, digest := push(t, server, testImage(t, tc.layers...), tc.multiPlatform)
+
+			keychain := Anonymous
+			if tc.username != "" {
+				reg.username, reg.password = tc.username, "pass"
+
+				var err error
+				keychain, err = PullSecretKeychain([]byte(fmt.Sprintf(`{"auths": {%q: {"username": %q, "password": "pass"}}}`, strings.TrimPrefix(server.URL, "http://"), tc.username)))
+				require.NoError(t, err)
+			}
+
+			dir, err := ioutil.TempDir("", "")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			puller := &Puller{transport: http.DefaultTransport, platform: v1.Platform{OS: "linux", Architecture: "amd64"}}
+			files, err := puller.Pull(context.Background(), image, digest, keychain, dir)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var wantFiles []string
+			for name, content := range tc.wantFiles {
+				wantFiles = append(wantFiles, name)
+
+				data, err := ioutil.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, content, string(data))
+			}
+			assert.ElementsMatch(t, wantFiles, files)
+		})
+	}
+}
+
+func TestPullRejectsContentThatDoesNotMatchItsDigest(t *testing.T) {
+	reg := &fakeRegistry{registry: registry.New(registry.Logger(log.New(ioutil.Discard, "", 0)))}
+	server := httptest.NewServer(reg)
+	defer server.Close()
+
+	image, digest := push(t, server, testImage(t, map[string]string{"plugins/velero-plugin-a": "a"}), false)
+
+	// serve a different layer than the one the manifest names
+	ref, err := name.ParseReference(image + "@" + digest)
+	require.NoError(t, err)
+	img, err := remote.Image(ref)
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	layerDigest, err := layers[0].Digest()
+	require.NoError(t, err)
+	tampered, err := layer(t, map[string]string{"plugins/velero-plugin-a": "tampered"}).Compressed()
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(tampered)
+	require.NoError(t, err)
+	reg.replaced = map[string][]byte{layerDigest.String(): data}
+
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	puller := &Puller{transport: http.DefaultTransport, platform: v1.Platform{OS: "linux", Architecture: "amd64"}}
+	_, err = puller.Pull(context.Background(), image, digest, Anonymous, dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error verifying sha256 checksum")
+}
+
+func TestParseReference(t *testing.T) {
+	const digest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+
+	tests := []struct {
+		image   string
+		want    string
+		wantErr bool
+	}{
+		{image: "velero/velero-plugin-for-aws", want: "index.docker.io/velero/velero-plugin-for-aws@" + digest},
+		{image: "velero/velero-plugin-for-aws:v1.2.0", want: "index.docker.io/velero/velero-plugin-for-aws@" + digest},
+		{image: "busybox", want: "index.docker.io/library/busybox@" + digest},
+		{image: "gcr.io/my-project/my-plugin", want: "gcr.io/my-project/my-plugin@" + digest},
+		{image: "localhost:5000/my-plugin:latest", want: "localhost:5000/my-plugin@" + digest},
+		{image: "", wantErr: true},
+		{image: "velero/plugin@" + digest, wantErr: true},
+		{image: "Velero/Plugin", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.image, func(t *testing.T) {
+			ref, err := ParseReference(tc.image, digest)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ref.Name())
+		})
+	}
+}
+
+func TestPullSecretKeychain(t *testing.T) {
+	keychain, err := PullSecretKeychain([]byte(`{
+		"auths": {
+			"https://index.docker.io/v1/": {"auth": "dXNlcjpwYXNz"},
+			"gcr.io": {"username": "_json_key", "password": "key"}
+		},
+		"credsStore": "evil"
+	}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		image string
+		want  *authn.AuthConfig
+	}{
+		{image: "velero/velero-plugin-for-aws", want: &authn.AuthConfig{Username: "user", Password: "pass"}},
+		{image: "gcr.io/my-project/my-plugin", want: &authn.AuthConfig{Username: "_json_key", Password: "key"}},
+		{image: "quay.io/my-project/my-plugin", want: &authn.AuthConfig{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.image, func(t *testing.T) {
+			ref, err := name.ParseReference(tc.image)
+			require.NoError(t, err)
+
+			authenticator, err := keychain.Resolve(ref.Context())
+			require.NoError(t, err)
+			authConfig, err := authenticator.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, authConfig)
+		})
+	}
+}
@@ -5,6 +5,10 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+
+	restoreitemactionv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+
+	v2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 )
 
 // Manager is an autogenerated mock type for the Manager type
@@ -63,6 +67,52 @@ func (_m *Manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	return r0, r1
 }
 
+// GetBackupItemActionV2 provides a mock function with given fields: name
+func (_m *Manager) GetBackupItemActionV2(name string) (v2.BackupItemAction, error) {
+	ret := _m.Called(name)
+
+	var r0 v2.BackupItemAction
+	if rf, ok := ret.Get(0).(func(string) v2.BackupItemAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v2.BackupItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemActionsV2 provides a mock function with given fields:
+func (_m *Manager) GetBackupItemActionsV2() ([]v2.BackupItemAction, error) {
+	ret := _m.Called()
+
+	var r0 []v2.BackupItemAction
+	if rf, ok := ret.Get(0).(func() []v2.BackupItemAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]v2.BackupItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeleteItemAction provides a mock function with given fields: name
 func (_m *Manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
 	ret := _m.Called(name)
@@ -109,6 +159,52 @@ func (_m *Manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	return r0, r1
 }
 
+// GetItemSnapshotter provides a mock function with given fields: name
+func (_m *Manager) GetItemSnapshotter(name string) (velero.ItemSnapshotter, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.ItemSnapshotter
+	if rf, ok := ret.Get(0).(func(string) velero.ItemSnapshotter); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.ItemSnapshotter)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemSnapshotters provides a mock function with given fields:
+func (_m *Manager) GetItemSnapshotters() (map[string]velero.ItemSnapshotter, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.ItemSnapshotter
+	if rf, ok := ret.Get(0).(func() map[string]velero.ItemSnapshotter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.ItemSnapshotter)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetObjectStore provides a mock function with given fields: name
 func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	ret := _m.Called(name)
@@ -178,6 +274,52 @@ func (_m *Manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 	return r0, r1
 }
 
+// GetRestoreItemActionV2 provides a mock function with given fields: name
+func (_m *Manager) GetRestoreItemActionV2(name string) (restoreitemactionv2.RestoreItemAction, error) {
+	ret := _m.Called(name)
+
+	var r0 restoreitemactionv2.RestoreItemAction
+	if rf, ok := ret.Get(0).(func(string) restoreitemactionv2.RestoreItemAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(restoreitemactionv2.RestoreItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestoreItemActionsV2 provides a mock function with given fields:
+func (_m *Manager) GetRestoreItemActionsV2() ([]restoreitemactionv2.RestoreItemAction, error) {
+	ret := _m.Called()
+
+	var r0 []restoreitemactionv2.RestoreItemAction
+	if rf, ok := ret.Get(0).(func() []restoreitemactionv2.RestoreItemAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]restoreitemactionv2.RestoreItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeSnapshotter provides a mock function with given fields: name
 func (_m *Manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
 	ret := _m.Called(name)
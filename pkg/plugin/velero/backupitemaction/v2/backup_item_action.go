@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v2 contains version 2 of the BackupItemAction plugin interface.
+package v2
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupItemAction is an actor that performs an operation on an individual item being backed up.
+// It is the interface Velero uses for all backup item actions: version 1 plugins are called
+// through an adapter.
+type BackupItemAction interface {
+	// Name returns the name the action is registered with.
+	Name() string
+
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// A BackupItemAction's Execute function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (velero.ResourceSelector, error)
+
+	// Execute allows the ItemAction to perform arbitrary logic with the item being backed up,
+	// including mutating the item itself prior to backup. The item (unmodified or modified)
+	// should be returned, along with an optional slice of ResourceIdentifiers specifying
+	// additional related items that should be backed up. If the action starts an asynchronous
+	// operation for the item, it returns the operation's ID, and the backup isn't complete
+	// until Progress reports the operation as completed. cluster is the cluster the item is
+	// backed up from.
+	Execute(item runtime.Unstructured, backup *api.Backup, cluster velero.ClusterContext) (runtime.Unstructured, []velero.ResourceIdentifier, string, error)
+
+	// Progress returns the progress of an operation started by Execute.
+	Progress(operationID string, backup *api.Backup) (velero.OperationProgress, error)
+
+	// Cancel stops an operation started by Execute. It's called when the
+	// operation doesn't complete in time.
+	Cancel(operationID string, backup *api.Backup) error
+}
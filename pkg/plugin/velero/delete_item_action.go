@@ -42,4 +42,6 @@ type DeleteItemActionExecuteInput struct {
 	Item runtime.Unstructured
 	// Backup is the representation of the restore resource processed by Velero.
 	Backup *velerov1api.Backup
+	// Cluster is the cluster the backup was taken from.
+	Cluster ClusterContext
 }
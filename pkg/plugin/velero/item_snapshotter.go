@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemSnapshotter takes snapshots of individual items during backup, such as a
+// database custom resource through its operator, and restores items from those
+// snapshots during restore. Unlike a VolumeSnapshotter, it isn't limited to
+// persistent volumes, and the snapshot can be application-consistent.
+type ItemSnapshotter interface {
+	// Init prepares the ItemSnapshotter for usage using the provided map of
+	// configuration key-value pairs. It returns an error if the ItemSnapshotter
+	// cannot be initialized from the provided config.
+	Init(config map[string]string) error
+
+	// AppliesTo returns information about which resources this snapshotter should be invoked for.
+	// An ItemSnapshotter's AlterBackupItem and Snapshot functions will only be invoked on items
+	// that match the returned selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// AlterBackupItem allows the ItemSnapshotter to change the item before it's snapshotted
+	// and written to the backup, e.g. to remove fields that are restored from the snapshot.
+	// The item (unmodified or modified) should be returned.
+	AlterBackupItem(input *AlterBackupItemInput) (runtime.Unstructured, error)
+
+	// Snapshot takes a snapshot of the item. The snapshot doesn't need to be complete when
+	// Snapshot returns: the backup isn't complete until Progress reports it as completed.
+	Snapshot(input *SnapshotItemInput) (*SnapshotItemOutput, error)
+
+	// Progress returns the progress of a snapshot taken by Snapshot.
+	Progress(input *ItemSnapshotProgressInput) (OperationProgress, error)
+
+	// Delete deletes a snapshot taken by Snapshot. It's called when the backup is deleted.
+	Delete(input *DeleteItemSnapshotInput) error
+
+	// Restore restores the item from its snapshot. The item to create (unmodified or
+	// modified) should be returned, along with an optional slice of ResourceIdentifiers
+	// specifying additional related items that should be restored.
+	Restore(input *RestoreItemFromSnapshotInput) (*RestoreItemFromSnapshotOutput, error)
+}
+
+// AlterBackupItemInput contains the input parameters for the ItemSnapshotter's
+// AlterBackupItem function.
+type AlterBackupItemInput struct {
+	// Item is the item being backed up.
+	Item runtime.Unstructured
+	// Backup is the representation of the backup resource processed by Velero.
+	Backup *api.Backup
+	// Cluster is the cluster the item is being backed up from.
+	Cluster ClusterContext
+}
+
+// SnapshotItemInput contains the input parameters for the ItemSnapshotter's
+// Snapshot function.
+type SnapshotItemInput struct {
+	// Item is the item being snapshotted, as returned by AlterBackupItem.
+	Item runtime.Unstructured
+	// Backup is the representation of the backup resource processed by Velero.
+	Backup *api.Backup
+	// Cluster is the cluster the item is being backed up from.
+	Cluster ClusterContext
+}
+
+// SnapshotItemOutput contains the output variables for the ItemSnapshotter's
+// Snapshot function.
+type SnapshotItemOutput struct {
+	// SnapshotID identifies the snapshot to the ItemSnapshotter.
+	SnapshotID string
+	// SnapshotMetadata is stored with the backup, and passed back to the
+	// ItemSnapshotter when the snapshot is deleted or restored.
+	SnapshotMetadata map[string]string
+	// AdditionalItems is a list of additional related items that should
+	// be backed up.
+	AdditionalItems []ResourceIdentifier
+}
+
+// ItemSnapshotProgressInput contains the input parameters for the ItemSnapshotter's
+// Progress function.
+type ItemSnapshotProgressInput struct {
+	// ItemID identifies the item that was snapshotted.
+	ItemID ResourceIdentifier
+	// SnapshotID is the ID returned by Snapshot.
+	SnapshotID string
+	// Backup is the representation of the backup resource processed by Velero.
+	Backup *api.Backup
+}
+
+// DeleteItemSnapshotInput contains the input parameters for the ItemSnapshotter's
+// Delete function.
+type DeleteItemSnapshotInput struct {
+	// SnapshotID is the ID returned by Snapshot.
+	SnapshotID string
+	// SnapshotMetadata is the metadata returned by Snapshot.
+	SnapshotMetadata map[string]string
+	// Cluster is the cluster the item was backed up from.
+	Cluster ClusterContext
+}
+
+// RestoreItemFromSnapshotInput contains the input parameters for the ItemSnapshotter's
+// Restore function.
+type RestoreItemFromSnapshotInput struct {
+	// Item is the item being restored, after the restore item actions ran.
+	Item runtime.Unstructured
+	// ItemFromBackup is the item taken from the pristine backed up version of resource.
+	ItemFromBackup runtime.Unstructured
+	// SnapshotID is the ID returned by Snapshot.
+	SnapshotID string
+	// SnapshotMetadata is the metadata returned by Snapshot.
+	SnapshotMetadata map[string]string
+	// Restore is the representation of the restore resource processed by Velero.
+	Restore *api.Restore
+	// Cluster is the cluster the item is being restored to.
+	Cluster ClusterContext
+}
+
+// RestoreItemFromSnapshotOutput contains the output variables for the ItemSnapshotter's
+// Restore function.
+type RestoreItemFromSnapshotOutput struct {
+	// UpdatedItem is the item to create.
+	UpdatedItem runtime.Unstructured
+	// AdditionalItems is a list of additional related items that should
+	// be restored.
+	AdditionalItems []ResourceIdentifier
+	// SkipRestore tells Velero not to create the item, e.g. because the
+	// ItemSnapshotter created it.
+	SkipRestore bool
+}
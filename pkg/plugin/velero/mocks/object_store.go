@@ -5,6 +5,7 @@ package mocks
 import io "io"
 import mock "github.com/stretchr/testify/mock"
 import time "time"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // ObjectStore is an autogenerated mock type for the ObjectStore type
 type ObjectStore struct {
@@ -150,6 +151,20 @@ func (_m *ObjectStore) ObjectExists(bucket string, key string) (bool, error) {
 	return r0, r1
 }
 
+// PutLockedObject provides a mock function with given fields: bucket, key, body, lock
+func (_m *ObjectStore) PutLockedObject(bucket string, key string, body io.Reader, lock velero.ObjectLock) error {
+	ret := _m.Called(bucket, key, body, lock)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, velero.ObjectLock) error); ok {
+		r0 = rf(bucket, key, body, lock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutObject provides a mock function with given fields: bucket, key, body
 func (_m *ObjectStore) PutObject(bucket string, key string, body io.Reader) error {
 	ret := _m.Called(bucket, key, body)
@@ -63,3 +63,26 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ObjectLocker is an optional interface an ObjectStore can implement to support
+// immutable backup storage locations, by writing objects that the object store
+// keeps from being deleted or overwritten until their lock expires.
+type ObjectLocker interface {
+	// PutLockedObject creates a new object using the data in body within the
+	// specified object storage bucket with the given key, and locks it. It returns
+	// an error if the bucket doesn't support object locks.
+	PutLockedObject(bucket, key string, body io.Reader, lock ObjectLock) error
+}
+
+// ObjectLock describes the lock set on an object.
+type ObjectLock struct {
+	// Mode is the retention mode of the lock, "Governance" or "Compliance".
+	Mode string
+
+	// RetainUntil is the time the lock expires.
+	RetainUntil time.Time
+
+	// LegalHold indicates the object is placed under a legal hold, which keeps it
+	// locked until the hold is removed, regardless of RetainUntil.
+	LegalHold bool
+}
@@ -47,6 +47,8 @@ type RestoreItemActionExecuteInput struct {
 	ItemFromBackup runtime.Unstructured
 	// Restore is the representation of the restore resource processed by Velero.
 	Restore *api.Restore
+	// Cluster is the cluster the item is being restored to.
+	Cluster ClusterContext
 }
 
 // RestoreItemActionExecuteOutput contains the output variables for the ItemAction's Execution function.
@@ -62,6 +64,11 @@ type RestoreItemActionExecuteOutput struct {
 	// on this item, and skip the restore step. When this field's
 	// value is true, AdditionalItems will be ignored.
 	SkipRestore bool
+
+	// OperationID identifies an asynchronous operation the action started
+	// for the item, which Velero waits for before completing the restore.
+	// It is only honored for RestoreItemAction/v2 plugins.
+	OperationID string
 }
 
 // NewRestoreItemActionExecuteOutput creates a new RestoreItemActionExecuteOutput
@@ -0,0 +1,50 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v2 contains version 2 of the RestoreItemAction plugin interface.
+package v2
+
+import (
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// RestoreItemAction is an actor that performs an operation on an individual item being restored.
+// It is the interface Velero uses for all restore item actions: version 1 plugins are called
+// through an adapter.
+type RestoreItemAction interface {
+	// Name returns the name the action is registered with.
+	Name() string
+
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// A RestoreItemAction's Execute function will only be invoked on items that match the returned
+	// selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (velero.ResourceSelector, error)
+
+	// Execute allows the ItemAction to perform arbitrary logic with the item being restored,
+	// including mutating the item itself prior to restore. It returns the same output as a
+	// version 1 action; in addition, if the action starts an asynchronous operation for the
+	// item, it sets the output's OperationID, and the restore isn't complete until Progress
+	// reports the operation as completed.
+	Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error)
+
+	// Progress returns the progress of an operation started by Execute.
+	Progress(operationID string, restore *api.Restore) (velero.OperationProgress, error)
+
+	// Cancel stops an operation started by Execute. It's called when the
+	// operation doesn't complete in time.
+	Cancel(operationID string, restore *api.Restore) error
+}
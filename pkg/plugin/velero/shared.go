@@ -20,6 +20,8 @@ limitations under the License.
 // plugins of any type can be implemented.
 package velero
 
+import "time"
+
 // ResourceSelector is a collection of included/excluded namespaces,
 // included/excluded resources, and a label-selector that can be used
 // to match a set of items from a cluster.
@@ -48,3 +50,56 @@ type ResourceSelector struct {
 	// for details on syntax.
 	LabelSelector string
 }
+
+// OperationProgress describes the progress of an asynchronous operation
+// started by a plugin.
+type OperationProgress struct {
+	// Completed is true when the operation is no longer running, whether
+	// it succeeded or failed.
+	Completed bool
+	// Err is the reason the operation failed. An empty Err for a completed
+	// operation means it succeeded.
+	Err string
+	// NCompleted is the number of units of work that are done.
+	NCompleted int64
+	// NTotal is the total number of units of work, or 0 if unknown.
+	NTotal int64
+	// OperationUnits is the unit of work NCompleted and NTotal are counted
+	// in, e.g. "bytes".
+	OperationUnits string
+	// Description describes the current state of the operation.
+	Description string
+	// Started is the time the operation was started.
+	Started time.Time
+	// Updated is the time the progress was last updated.
+	Updated time.Time
+}
+
+// ClusterContext identifies the cluster that a backup, restore or backup
+// deletion operates on. Velero can back up from and restore to remote
+// clusters, so it isn't necessarily the cluster Velero runs in. Use
+// framework.ClusterClientConfig to get a client for it.
+type ClusterContext struct {
+	// Host is the URL of the cluster's API server. It's empty for the
+	// cluster Velero runs in.
+	Host string
+	// Token is a short-lived bearer token for the service account that the
+	// Velero server gives plugins access to a remote cluster with. It's
+	// empty for the cluster Velero runs in, and for a remote cluster if the
+	// Velero server isn't configured with such a service account.
+	Token string
+	// CAData is the PEM-encoded certificate authority of the cluster's API
+	// server, if Velero knows it.
+	CAData []byte
+	// Insecure is whether the API server's certificate isn't verified, as
+	// for Velero's own connections to the cluster.
+	Insecure bool
+	// ProxyURL is the URL of the proxy that Velero connects to the cluster's
+	// API server through, if any.
+	ProxyURL string
+}
+
+// IsLocal returns whether the cluster is the one Velero runs in.
+func (c ClusterContext) IsLocal() bool {
+	return c.Host == ""
+}
@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podexec
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// HTTPHookExecutor is capable of sending the HTTP request of an HTTP hook to a Service.
+type HTTPHookExecutor interface {
+	// ExecuteHTTPHook sends the hook's request to its Service, in the given namespace unless the
+	// hook specifies one. If the request fails, takes longer than the hook's timeout or its response
+	// doesn't have the expected status, an error is returned.
+	ExecuteHTTPHook(log logrus.FieldLogger, namespace, hookName string, hook *api.HTTPHook) error
+}
+
+type verber interface {
+	Verb(verb string) *rest.Request
+}
+
+type defaultHTTPHookExecutor struct {
+	restClient verber
+}
+
+// NewHTTPHookExecutor creates a new HTTPHookExecutor sending requests through the service proxy of
+// the API server restClient talks to.
+func NewHTTPHookExecutor(restClient verber) HTTPHookExecutor {
+	return &defaultHTTPHookExecutor{
+		restClient: restClient,
+	}
+}
+
+func (e *defaultHTTPHookExecutor) ExecuteHTTPHook(log logrus.FieldLogger, namespace, hookName string, hook *api.HTTPHook) error {
+	if hookName == "" {
+		return errors.New("hookName is required")
+	}
+	if hook == nil {
+		return errors.New("hook is required")
+	}
+	if hook.Service == "" {
+		return errors.New("service is required")
+	}
+	if hook.Namespace != "" {
+		namespace = hook.Namespace
+	}
+	if namespace == "" {
+		return errors.New("namespace is required")
+	}
+
+	switch hook.OnError {
+	case api.HookErrorModeFail, api.HookErrorModeContinue:
+		// use the specified value
+	default:
+		// default to fail
+		hook.OnError = api.HookErrorModeFail
+	}
+
+	if hook.Scheme == "" {
+		hook.Scheme = "http"
+	}
+	if hook.Method == "" {
+		hook.Method = http.MethodGet
+	}
+	if hook.Path == "" {
+		hook.Path = "/"
+	}
+	if hook.ExpectedStatus == 0 {
+		hook.ExpectedStatus = http.StatusOK
+	}
+	if hook.Timeout.Duration == 0 {
+		hook.Timeout.Duration = defaultTimeout
+	}
+
+	hookLog := log.WithFields(
+		logrus.Fields{
+			"hookName":    hookName,
+			"hookService": fmt.Sprintf("%s/%s", namespace, hook.Service),
+			"hookMethod":  hook.Method,
+			"hookPath":    hook.Path,
+			"hookOnError": hook.OnError,
+			"hookTimeout": hook.Timeout,
+		},
+	)
+	hookLog.Info("running http hook")
+
+	ctx, cancel := context.WithTimeout(context.Background(), hook.Timeout.Duration)
+	defer cancel()
+
+	var status int
+	res := e.restClient.Verb(strings.ToUpper(hook.Method)).
+		Namespace(namespace).
+		Resource("services").
+		Name(fmt.Sprintf("%s:%s:%s", hook.Scheme, hook.Service, hook.Port)).
+		SubResource("proxy").
+		Suffix(hook.Path).
+		Do(ctx).
+		StatusCode(&status)
+
+	if status == 0 {
+		// responses that can't be decoded, e.g. plain text ones, only carry their status in the
+		// error of the result.
+		if apiStatus, ok := res.Error().(apierrors.APIStatus); ok {
+			status = int(apiStatus.Status().Code)
+		}
+	}
+	if status == 0 {
+		if err := res.Error(); err != nil {
+			return errors.Wrap(err, "error sending request")
+		}
+		return errors.New("error sending request: no response")
+	}
+
+	hookLog.Infof("response status: %d", status)
+	if status != hook.ExpectedStatus {
+		return errors.Errorf("unexpected response status %d, expected %d", status, hook.ExpectedStatus)
+	}
+
+	return nil
+}
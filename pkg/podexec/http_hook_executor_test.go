@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podexec
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest/fake"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExecuteHTTPHookValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		hookName      string
+		hook          *v1.HTTPHook
+		expectedError string
+	}{
+		{
+			name:          "missing hookName",
+			namespace:     "ns",
+			hook:          &v1.HTTPHook{Service: "svc"},
+			expectedError: "hookName is required",
+		},
+		{
+			name:          "missing hook",
+			namespace:     "ns",
+			hookName:      "hook",
+			expectedError: "hook is required",
+		},
+		{
+			name:          "missing service",
+			namespace:     "ns",
+			hookName:      "hook",
+			hook:          &v1.HTTPHook{},
+			expectedError: "service is required",
+		},
+		{
+			name:          "missing namespace",
+			hookName:      "hook",
+			hook:          &v1.HTTPHook{Service: "svc"},
+			expectedError: "namespace is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := NewHTTPHookExecutor(&fake.RESTClient{})
+			err := e.ExecuteHTTPHook(velerotest.NewLogger(), test.namespace, test.hookName, test.hook)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestExecuteHTTPHook(t *testing.T) {
+	tests := []struct {
+		name          string
+		hook          v1.HTTPHook
+		status        int
+		requestErr    error
+		expectedURL   string
+		expectedVerb  string
+		expectedError string
+	}{
+		{
+			name:         "defaults are used",
+			hook:         v1.HTTPHook{Service: "svc"},
+			status:       http.StatusOK,
+			expectedURL:  "https://localhost/namespaces/ns/services/http:svc:/proxy",
+			expectedVerb: http.MethodGet,
+		},
+		{
+			name: "hook's namespace, port, scheme, method and path are used",
+			hook: v1.HTTPHook{
+				Namespace:      "other",
+				Service:        "svc",
+				Port:           "admin",
+				Scheme:         "https",
+				Method:         "post",
+				Path:           "/quiesce",
+				ExpectedStatus: http.StatusAccepted,
+			},
+			status:       http.StatusAccepted,
+			expectedURL:  "https://localhost/namespaces/other/services/https:svc:admin/proxy/quiesce",
+			expectedVerb: http.MethodPost,
+		},
+		{
+			name:          "unexpected status returns an error",
+			hook:          v1.HTTPHook{Service: "svc"},
+			status:        http.StatusServiceUnavailable,
+			expectedVerb:  http.MethodGet,
+			expectedURL:   "https://localhost/namespaces/ns/services/http:svc:/proxy",
+			expectedError: "unexpected response status 503, expected 200",
+		},
+		{
+			name:          "request error returns an error",
+			hook:          v1.HTTPHook{Service: "svc"},
+			requestErr:    errors.New("connection refused"),
+			expectedError: "error sending request",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restClient := &fake.RESTClient{
+				NegotiatedSerializer: kscheme.Codecs.WithoutConversion(),
+				Err:                  test.requestErr,
+				Resp: &http.Response{
+					StatusCode: test.status,
+					Header:     http.Header{"Content-Type": []string{"text/plain"}},
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				},
+			}
+
+			e := NewHTTPHookExecutor(restClient)
+			err := e.ExecuteHTTPHook(velerotest.NewLogger(), "ns", "hook", &test.hook)
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if test.requestErr == nil {
+				require.NotNil(t, restClient.Req)
+				assert.Equal(t, test.expectedVerb, restClient.Req.Method)
+				assert.Equal(t, test.expectedURL, restClient.Req.URL.String())
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -41,6 +42,25 @@ type PodCommandExecutor interface {
 	ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error
 }
 
+// PodCommandOutput is the output of a command executed in a container.
+type PodCommandOutput struct {
+	Stdout string
+	Stderr string
+	// ExitCode is the exit code of the command, or nil if it's unknown, e.g. because the
+	// command timed out.
+	ExitCode *int
+}
+
+// PodCommandOutputExecutor is a PodCommandExecutor that can also return the output of the
+// commands it executes.
+type PodCommandOutputExecutor interface {
+	PodCommandExecutor
+
+	// ExecutePodCommandWithOutput executes a command like ExecutePodCommand and returns its
+	// output. The output is returned, if the command was executed, even when an error is.
+	ExecutePodCommandWithOutput(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*PodCommandOutput, error)
+}
+
 type poster interface {
 	Post() *rest.Request
 }
@@ -53,7 +73,7 @@ type defaultPodCommandExecutor struct {
 }
 
 // NewPodCommandExecutor creates a new PodCommandExecutor.
-func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) PodCommandExecutor {
+func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) PodCommandOutputExecutor {
 	return &defaultPodCommandExecutor{
 		restClientConfig: restClientConfig,
 		restClient:       restClient,
@@ -67,37 +87,42 @@ func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) Pod
 // possible to ensure the command is terminated when the timeout occurs, so it may continue to run
 // in the background).
 func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	_, err := e.ExecutePodCommandWithOutput(log, item, namespace, name, hookName, hook)
+	return err
+}
+
+func (e *defaultPodCommandExecutor) ExecutePodCommandWithOutput(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*PodCommandOutput, error) {
 	if item == nil {
-		return errors.New("item is required")
+		return nil, errors.New("item is required")
 	}
 	if namespace == "" {
-		return errors.New("namespace is required")
+		return nil, errors.New("namespace is required")
 	}
 	if name == "" {
-		return errors.New("name is required")
+		return nil, errors.New("name is required")
 	}
 	if hookName == "" {
-		return errors.New("hookName is required")
+		return nil, errors.New("hookName is required")
 	}
 	if hook == nil {
-		return errors.New("hook is required")
+		return nil, errors.New("hook is required")
 	}
 
 	pod := new(corev1api.Pod)
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	if hook.Container == "" {
 		if err := setDefaultHookContainer(pod, hook); err != nil {
-			return err
+			return nil, err
 		}
 	} else if err := ensureContainerExists(pod, hook.Container); err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(hook.Command) == 0 {
-		return errors.New("command is required")
+		return nil, errors.New("command is required")
 	}
 
 	switch hook.OnError {
@@ -138,7 +163,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	executor, err := e.streamExecutorFactory.NewSPDYExecutor(e.restClientConfig, "POST", req.URL())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var stdout, stderr bytes.Buffer
@@ -165,15 +190,62 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 	select {
 	case err = <-errCh:
 	case <-timeoutCh:
-		return errors.Errorf("timed out after %v", hook.Timeout.Duration)
+		return nil, errors.Errorf("timed out after %v", hook.Timeout.Duration)
 	}
 
 	hookLog.Infof("stdout: %s", stdout.String())
 	hookLog.Infof("stderr: %s", stderr.String())
 
+	output := &PodCommandOutput{
+		Stdout: stdout.String(),
+		Stderr: stderr.String(),
+	}
+	if err == nil {
+		output.ExitCode = new(int)
+	} else if exitErr, ok := err.(utilexec.ExitError); ok && exitErr.Exited() {
+		exitCode := exitErr.ExitStatus()
+		output.ExitCode = &exitCode
+	}
+
+	return output, err
+}
+
+// observedPodCommandExecutor reports how long each command executed by a PodCommandExecutor took.
+type observedPodCommandExecutor struct {
+	PodCommandExecutor
+	observe func(duration time.Duration, err error)
+}
+
+// NewObservedPodCommandExecutor returns a PodCommandExecutor that executes commands using
+// executor and calls observe with the duration and the result of each of them.
+func NewObservedPodCommandExecutor(executor PodCommandExecutor, observe func(duration time.Duration, err error)) PodCommandOutputExecutor {
+	return &observedPodCommandExecutor{
+		PodCommandExecutor: executor,
+		observe:            observe,
+	}
+}
+
+func (e *observedPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	start := time.Now()
+	err := e.PodCommandExecutor.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+	e.observe(time.Since(start), err)
 	return err
 }
 
+// ExecutePodCommandWithOutput returns the output of the command if the wrapped executor is a
+// PodCommandOutputExecutor, and nil otherwise.
+func (e *observedPodCommandExecutor) ExecutePodCommandWithOutput(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*PodCommandOutput, error) {
+	outputExecutor, ok := e.PodCommandExecutor.(PodCommandOutputExecutor)
+	if !ok {
+		return nil, e.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+	}
+
+	start := time.Now()
+	output, err := outputExecutor.ExecutePodCommandWithOutput(log, item, namespace, name, hookName, hook)
+	e.observe(time.Since(start), err)
+	return output, err
+}
+
 func ensureContainerExists(pod *corev1api.Pod, container string) error {
 	for _, c := range pod.Spec.Containers {
 		if c.Name == container {
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -220,6 +221,65 @@ func TestExecutePodCommand(t *testing.T) {
 	}
 }
 
+func TestExecutePodCommandWithOutput(t *testing.T) {
+	tests := []struct {
+		name             string
+		streamErr        error
+		expectedError    string
+		expectedExitCode *int
+	}{
+		{
+			name:             "successful command has exit code 0",
+			expectedExitCode: &[]int{0}[0],
+		},
+		{
+			name:             "failed command has its exit code",
+			streamErr:        utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2},
+			expectedError:    "command terminated with exit code 2",
+			expectedExitCode: &[]int{2}[0],
+		},
+		{
+			name:          "command failing to stream has no exit code",
+			streamErr:     errors.New("connection reset"),
+			expectedError: "connection reset",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod, err := velerotest.GetAsMap(`{"metadata": {"namespace": "namespace", "name": "name"}, "spec": {"containers": [{"name": "foo"}]}}`)
+			require.NoError(t, err)
+
+			poster := &mockPoster{}
+			podCommandExecutor := NewPodCommandExecutor(&rest.Config{}, poster).(*defaultPodCommandExecutor)
+			streamExecutorFactory := &mockStreamExecutorFactory{}
+			podCommandExecutor.streamExecutorFactory = streamExecutorFactory
+
+			baseUrl, _ := url.Parse("https://some.server")
+			poster.On("Post").Return(rest.NewRequestWithClient(baseUrl, "/api/v1", rest.ClientContentConfig{}, nil))
+
+			streamExecutor := &mockStreamExecutor{}
+			streamExecutorFactory.On("NewSPDYExecutor", mock.Anything, "POST", mock.Anything).Return(streamExecutor, nil)
+			streamExecutor.On("Stream", mock.Anything).Run(func(args mock.Arguments) {
+				options := args.Get(0).(remotecommand.StreamOptions)
+				options.Stdout.Write([]byte("out"))
+				options.Stderr.Write([]byte("err"))
+			}).Return(test.streamErr)
+
+			output, err := podCommandExecutor.ExecutePodCommandWithOutput(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &v1.ExecHook{Command: []string{"true"}})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NotNil(t, output)
+			assert.Equal(t, "out", output.Stdout)
+			assert.Equal(t, "err", output.Stderr)
+			assert.Equal(t, test.expectedExitCode, output.ExitCode)
+		})
+	}
+}
+
 func TestEnsureContainerExists(t *testing.T) {
 	pod := &corev1api.Pod{
 		Spec: corev1api.PodSpec{
@@ -238,6 +298,32 @@ func TestEnsureContainerExists(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestObservedPodCommandExecutor(t *testing.T) {
+	var (
+		log      = velerotest.NewLogger()
+		item     = map[string]interface{}{}
+		hook     = &v1.ExecHook{Command: []string{"true"}}
+		executor = &velerotest.MockPodCommandExecutor{}
+		observed []error
+	)
+	defer executor.AssertExpectations(t)
+
+	executor.On("ExecutePodCommand", log, item, "ns", "pod-1", "hook-1", hook).Return(nil)
+	executor.On("ExecutePodCommand", log, item, "ns", "pod-2", "hook-1", hook).Return(errors.New("exec failed"))
+
+	pce := NewObservedPodCommandExecutor(executor, func(duration time.Duration, err error) {
+		assert.True(t, duration >= 0)
+		observed = append(observed, err)
+	})
+
+	require.NoError(t, pce.ExecutePodCommand(log, item, "ns", "pod-1", "hook-1", hook))
+	require.EqualError(t, pce.ExecutePodCommand(log, item, "ns", "pod-2", "hook-1", hook), "exec failed")
+
+	require.Len(t, observed, 2)
+	assert.NoError(t, observed[0])
+	assert.EqualError(t, observed[1], "exec failed")
+}
+
 type mockStreamExecutorFactory struct {
 	mock.Mock
 }
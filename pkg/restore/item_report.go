@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sync"
+)
+
+// ItemStatus is what a restore did with an item from the backup.
+type ItemStatus string
+
+const (
+	// ItemStatusCreated means the item was created in the cluster.
+	ItemStatusCreated ItemStatus = "Created"
+
+	// ItemStatusUpdated means the item already existed in the cluster, and was updated with
+	// the backed up version.
+	ItemStatusUpdated ItemStatus = "Updated"
+
+	// ItemStatusAlreadyExists means the item already existed in the cluster, and was left as is.
+	ItemStatusAlreadyExists ItemStatus = "AlreadyExists"
+
+	// ItemStatusSkipped means the item wasn't restored, on purpose.
+	ItemStatusSkipped ItemStatus = "Skipped"
+
+	// ItemStatusFailed means the item failed to be restored.
+	ItemStatusFailed ItemStatus = "Failed"
+)
+
+// ItemStatuses are all the item statuses, in the order they're described.
+var ItemStatuses = []ItemStatus{
+	ItemStatusCreated,
+	ItemStatusUpdated,
+	ItemStatusAlreadyExists,
+	ItemStatusSkipped,
+	ItemStatusFailed,
+}
+
+// ItemResult is the record of what a restore did with an item from the backup.
+type ItemResult struct {
+	// Resource is the item's group-resource.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item was restored into, after namespace mapping. It's
+	// empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name in the backup.
+	Name string `json:"name"`
+
+	// NewName is the item's name in the cluster, if it was renamed.
+	NewName string `json:"newName,omitempty"`
+
+	// Status is what the restore did with the item.
+	Status ItemStatus `json:"status"`
+
+	// Reason is why the item was skipped, failed, or left as is.
+	Reason string `json:"reason,omitempty"`
+
+	// TransformedBy are the restore item actions that modified the item.
+	TransformedBy []string `json:"transformedBy,omitempty"`
+}
+
+// set records what the restore did with the item, and why.
+func (r *ItemResult) set(status ItemStatus, reason string) {
+	r.Status = status
+	r.Reason = reason
+}
+
+// fail records that the item failed to be restored because of err, and returns err.
+func (r *ItemResult) fail(err error) error {
+	r.set(ItemStatusFailed, err.Error())
+	return err
+}
+
+// ItemReport records what a restore did with each item from the backup. It's safe for
+// concurrent use. A nil ItemReport records nothing.
+type ItemReport struct {
+	lock  sync.Mutex
+	items []ItemResult
+}
+
+// NewItemReport returns an empty ItemReport.
+func NewItemReport() *ItemReport {
+	return &ItemReport{}
+}
+
+// Add records an item result.
+func (r *ItemReport) Add(item ItemResult) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.items = append(r.items, item)
+}
+
+// Items returns the recorded item results, in the order they were recorded.
+func (r *ItemReport) Items() []ItemResult {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]ItemResult(nil), r.items...)
+}
+
+// FilterItemResults returns the item results with any of the statuses. If no statuses are
+// specified, it returns all the item results.
+func FilterItemResults(items []ItemResult, statuses ...ItemStatus) []ItemResult {
+	if len(statuses) == 0 {
+		return items
+	}
+
+	var filtered []ItemResult
+	for _, item := range items {
+		for _, status := range statuses {
+			if item.Status == status {
+				filtered = append(filtered, item)
+				break
+			}
+		}
+	}
+	return filtered
+}
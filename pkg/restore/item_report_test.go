@@ -0,0 +1,56 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemReport(t *testing.T) {
+	report := NewItemReport()
+
+	created := ItemResult{Resource: "deployments.apps", Namespace: "ns-1", Name: "app"}
+	created.set(ItemStatusCreated, "")
+	report.Add(created)
+
+	failed := ItemResult{Resource: "persistentvolumes", Name: "pv-1"}
+	err := errors.New("error restoring persistentvolumes/pv-1")
+	assert.Equal(t, err, failed.fail(err))
+	report.Add(failed)
+
+	skipped := ItemResult{Resource: "pods", Namespace: "ns-1", Name: "mirror"}
+	skipped.set(ItemStatusSkipped, "it's a mirror pod")
+	report.Add(skipped)
+
+	items := report.Items()
+	assert.Equal(t, []ItemResult{
+		{Resource: "deployments.apps", Namespace: "ns-1", Name: "app", Status: ItemStatusCreated},
+		{Resource: "persistentvolumes", Name: "pv-1", Status: ItemStatusFailed, Reason: "error restoring persistentvolumes/pv-1"},
+		{Resource: "pods", Namespace: "ns-1", Name: "mirror", Status: ItemStatusSkipped, Reason: "it's a mirror pod"},
+	}, items)
+
+	assert.Equal(t, items, FilterItemResults(items))
+	assert.Equal(t, []ItemResult{items[1], items[2]}, FilterItemResults(items, ItemStatusFailed, ItemStatusSkipped))
+	assert.Empty(t, FilterItemResults(items, ItemStatusUpdated))
+
+	var nilReport *ItemReport
+	nilReport.Add(created)
+	assert.Empty(t, nilReport.Items())
+}
@@ -17,18 +17,22 @@ limitations under the License.
 package restore
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 type PVRestorer interface {
-	executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	executePVAction(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
 }
 
 type pvRestorer struct {
@@ -41,7 +45,7 @@ type pvRestorer struct {
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
 }
 
-func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (r *pvRestorer) executePVAction(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	pvName := obj.GetName()
 	if pvName == "" {
 		return nil, errors.New("PersistentVolume is missing its name")
@@ -72,10 +76,12 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	framework.SetLocationTimeout(volumeSnapshotter, snapshotInfo.location.Spec.PluginTimeout)
 
 	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
 		return nil, errors.WithStack(err)
 	}
+	volumeSnapshotter = framework.WithContext(volumeSnapshotter, ctx).(velero.VolumeSnapshotter)
 
 	volumeID, err := volumeSnapshotter.CreateVolumeFromSnapshot(snapshotInfo.providerSnapshotID, snapshotInfo.volumeType, snapshotInfo.volumeAZ, snapshotInfo.volumeIOPS)
 	if err != nil {
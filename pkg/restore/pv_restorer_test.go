@@ -17,6 +17,7 @@ limitations under the License.
 package restore
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -134,7 +135,7 @@ func TestExecutePVAction_NoSnapshotRestores(t *testing.T) {
 				require.NoError(t, snapshotLocationInformer.Informer().GetStore().Add(loc))
 			}
 
-			res, err := r.executePVAction(tc.obj)
+			res, err := r.executePVAction(context.Background(), tc.obj)
 			switch tc.expectedErr {
 			case true:
 				assert.Nil(t, res)
@@ -209,7 +210,7 @@ func TestExecutePVAction_SnapshotRestores(t *testing.T) {
 			volumeSnapshotter.On("CreateVolumeFromSnapshot", tc.expectedSnapshotID, tc.expectedVolumeType, tc.expectedVolumeAZ, tc.expectedVolumeIOPS).Return("volume-1", nil)
 			volumeSnapshotter.On("SetVolumeID", tc.obj, "volume-1").Return(tc.obj, nil)
 
-			_, err := r.executePVAction(tc.obj)
+			_, err := r.executePVAction(context.Background(), tc.obj)
 			assert.NoError(t, err)
 
 			volumeSnapshotter.AssertExpectations(t)
@@ -0,0 +1,290 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// defaultReadinessGateTimeout is how long a readiness gate waits if it doesn't specify a timeout.
+const defaultReadinessGateTimeout = 10 * time.Minute
+
+// readinessGatePollInterval is how often a readiness gate checks whether its items are ready.
+var readinessGatePollInterval = time.Second
+
+var (
+	apiServices  = schema.GroupResource{Group: "apiregistration.k8s.io", Resource: "apiservices"}
+	daemonSets   = schema.GroupResource{Group: "apps", Resource: "daemonsets"}
+	deployments  = schema.GroupResource{Group: "apps", Resource: "deployments"}
+	statefulSets = schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+)
+
+// readinessGate is a restore readiness gate whose resources are resolved.
+type readinessGate struct {
+	velerov1api.RestoreReadinessGate
+	afterResource string
+	resources     sets.String
+	namespaces    *collections.IncludesExcludes
+	// waited is whether the restore waited for this gate, which it only does once the items of
+	// afterResource are restored.
+	waited bool
+}
+
+// resolveReadinessGates resolves the resources of readiness gates with discoveryHelper. Resources
+// that can't be resolved are kept as is.
+func resolveReadinessGates(gates []velerov1api.RestoreReadinessGate, discoveryHelper discovery.Helper) []readinessGate {
+	resolve := func(resource string) string {
+		gvr, _, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			return resource
+		}
+		return gvr.GroupResource().String()
+	}
+
+	var resolved []readinessGate
+	for _, gate := range gates {
+		rg := readinessGate{
+			RestoreReadinessGate: gate,
+			afterResource:        resolve(gate.AfterResource),
+			resources:            sets.NewString(),
+			namespaces:           collections.NewIncludesExcludes().Includes(gate.IncludedNamespaces...),
+		}
+		resources := gate.Resources
+		if len(resources) == 0 {
+			resources = []string{gate.AfterResource}
+		}
+		for _, resource := range resources {
+			rg.resources.Insert(resolve(resource))
+		}
+		resolved = append(resolved, rg)
+	}
+	return resolved
+}
+
+// waitForReadinessGates waits for the readiness gates to pass once the items of resource are
+// restored. Gates whose items aren't ready in time are recorded as warnings or errors, according
+// to their OnError. failed is true if a gate whose OnError is Fail didn't pass, in which case the
+// restore must not go on to restore the next resources.
+func (ctx *restoreContext) waitForReadinessGates(resource string) (warnings, errs Result, failed bool) {
+	for i := range ctx.readinessGates {
+		gate := &ctx.readinessGates[i]
+		if gate.afterResource != resource {
+			continue
+		}
+		gate.waited = true
+
+		gateLog := ctx.log.WithField("readinessGate", gate.Name)
+		gateLog.Infof("Waiting for restored items of %s to be ready", strings.Join(gate.resources.List(), ", "))
+		if err := ctx.waitForReadinessGate(*gate); err != nil {
+			gateLog.WithError(err).Warn("Readiness gate didn't pass")
+			if gate.OnError == velerov1api.HookErrorModeFail {
+				errs.AddVeleroError(err)
+				failed = true
+			} else {
+				warnings.AddVeleroError(err)
+			}
+			continue
+		}
+		gateLog.Info("Readiness gate passed")
+	}
+	return warnings, errs, failed
+}
+
+// skippedReadinessGates returns warnings for the readiness gates the restore didn't wait for,
+// because it restored no resource matching their afterResource, such as when that resource isn't
+// in the backup, is excluded from the restore, or is misspelled.
+func (ctx *restoreContext) skippedReadinessGates() (warnings Result) {
+	for _, gate := range ctx.readinessGates {
+		if gate.waited {
+			continue
+		}
+		ctx.log.WithField("readinessGate", gate.Name).Warnf("Readiness gate was skipped because no items of %s were restored", gate.AfterResource)
+		warnings.AddVeleroError(errors.Errorf("readiness gate %s was skipped because no items of %s were restored", gate.Name, gate.AfterResource))
+	}
+	return warnings
+}
+
+// waitForReadinessGate waits, for up to the gate's timeout, until the restored items the gate
+// applies to are ready. Items that don't exist, because they failed to be restored or were
+// deleted since, are ignored.
+func (ctx *restoreContext) waitForReadinessGate(gate readinessGate) error {
+	var pending []velero.ResourceIdentifier
+	for item := range ctx.restoredItems {
+		if !gate.resources.Has(item.GroupResource.String()) {
+			continue
+		}
+		if len(gate.IncludedNamespaces) > 0 && !gate.namespaces.ShouldInclude(item.Namespace) {
+			continue
+		}
+		pending = append(pending, item)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return itemString(pending[i]) < itemString(pending[j])
+	})
+
+	timeout := gate.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultReadinessGateTimeout
+	}
+
+	err := wait.PollImmediate(readinessGatePollInterval, timeout, func() (bool, error) {
+		var notReady []velero.ResourceIdentifier
+		for _, item := range pending {
+			ready, err := ctx.isRestoredItemReady(item, gate.Condition)
+			if err != nil {
+				ctx.log.WithError(err).Debugf("Error checking whether %s is ready", itemString(item))
+			}
+			if !ready {
+				notReady = append(notReady, item)
+			}
+		}
+		pending = notReady
+		return len(pending) == 0, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		var items []string
+		for _, item := range pending {
+			items = append(items, itemString(item))
+		}
+		return errors.Errorf("readiness gate %s: %d item(s) not ready after %s: %s", gate.Name, len(items), timeout, strings.Join(items, ", "))
+	}
+	return err
+}
+
+// isRestoredItemReady gets a restored item from the cluster and returns whether it's ready.
+// Items that don't exist are considered ready.
+func (ctx *restoreContext) isRestoredItemReady(item velero.ResourceIdentifier, condition string) (bool, error) {
+	gvr, resource, err := ctx.discoveryHelper.ResourceFor(item.GroupResource.WithVersion(""))
+	if err != nil {
+		return false, errors.Wrapf(err, "error resolving %s", item.GroupResource)
+	}
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, item.Namespace)
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting client for %s", item.GroupResource)
+	}
+	obj, err := resourceClient.Get(item.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting %s", itemString(item))
+	}
+	return isItemReady(item.GroupResource, obj, condition)
+}
+
+// isItemReady returns whether an item is ready. If condition is specified, the item is ready once
+// that condition is True.
+func isItemReady(groupResource schema.GroupResource, obj *unstructured.Unstructured, condition string) (bool, error) {
+	if condition != "" {
+		return hasTrueCondition(obj, condition), nil
+	}
+
+	content := obj.UnstructuredContent()
+	switch groupResource {
+	case kuberesource.CustomResourceDefinitions:
+		return kube.IsUnstructuredCRDReady(obj)
+	case apiServices:
+		return hasTrueCondition(obj, "Available"), nil
+	case kuberesource.Pods:
+		return hasTrueCondition(obj, "Ready"), nil
+	case deployments:
+		if !isGenerationObserved(obj) {
+			return false, nil
+		}
+		replicas := nestedInt64(content, 1, "spec", "replicas")
+		return nestedInt64(content, 0, "status", "updatedReplicas") >= replicas &&
+			nestedInt64(content, 0, "status", "availableReplicas") >= replicas, nil
+	case statefulSets:
+		if !isGenerationObserved(obj) {
+			return false, nil
+		}
+		return nestedInt64(content, 0, "status", "readyReplicas") >= nestedInt64(content, 1, "spec", "replicas"), nil
+	case daemonSets:
+		if !isGenerationObserved(obj) {
+			return false, nil
+		}
+		return nestedInt64(content, 0, "status", "numberReady") >= nestedInt64(content, 0, "status", "desiredNumberScheduled"), nil
+	}
+
+	for _, conditionType := range []string{"Ready", "Available"} {
+		if status, found := conditionStatus(obj, conditionType); found {
+			return status == string(metav1.ConditionTrue), nil
+		}
+	}
+	return true, nil
+}
+
+// hasTrueCondition returns whether the object has the condition, with the status True, in its
+// status.conditions.
+func hasTrueCondition(obj *unstructured.Unstructured, conditionType string) bool {
+	status, _ := conditionStatus(obj, conditionType)
+	return status == string(metav1.ConditionTrue)
+}
+
+// conditionStatus returns the status of a condition in the object's status.conditions, and whether
+// the object has that condition.
+func conditionStatus(obj *unstructured.Unstructured, conditionType string) (string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		return status, true
+	}
+	return "", false
+}
+
+// isGenerationObserved returns whether the object's controller has observed its latest generation.
+func isGenerationObserved(obj *unstructured.Unstructured) bool {
+	return nestedInt64(obj.UnstructuredContent(), 0, "status", "observedGeneration") >= obj.GetGeneration()
+}
+
+// nestedInt64 returns the integer at the fields' path, or def if there's none.
+func nestedInt64(obj map[string]interface{}, def int64, fields ...string) int64 {
+	value, found, err := unstructured.NestedInt64(obj, fields...)
+	if err != nil || !found {
+		return def
+	}
+	return value
+}
+
+func itemString(item velero.ResourceIdentifier) string {
+	if item.Namespace == "" {
+		return fmt.Sprintf("%s %s", item.GroupResource, item.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", item.GroupResource, item.Namespace, item.Name)
+}
@@ -0,0 +1,257 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func unstructuredWithStatus(generation int64, spec, status map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec":   spec,
+		"status": status,
+	}}
+	obj.SetGeneration(generation)
+	return obj
+}
+
+func conditions(conditionType, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": conditionType, "status": status},
+		},
+	}
+}
+
+func TestIsItemReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		groupResource schema.GroupResource
+		obj           *unstructured.Unstructured
+		condition     string
+		expected      bool
+	}{
+		{
+			name:          "deployment with all replicas available is ready",
+			groupResource: deployments,
+			obj: unstructuredWithStatus(2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2), "updatedReplicas": int64(3), "availableReplicas": int64(3),
+			}),
+			expected: true,
+		},
+		{
+			name:          "deployment with unavailable replicas isn't ready",
+			groupResource: deployments,
+			obj: unstructuredWithStatus(2, map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2), "updatedReplicas": int64(3), "availableReplicas": int64(1),
+			}),
+		},
+		{
+			name:          "deployment whose generation isn't observed isn't ready",
+			groupResource: deployments,
+			obj: unstructuredWithStatus(2, map[string]interface{}{}, map[string]interface{}{
+				"observedGeneration": int64(1), "updatedReplicas": int64(1), "availableReplicas": int64(1),
+			}),
+		},
+		{
+			name:          "statefulset with all replicas ready is ready",
+			groupResource: statefulSets,
+			obj: unstructuredWithStatus(1, map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(1), "readyReplicas": int64(2),
+			}),
+			expected: true,
+		},
+		{
+			name:          "daemonset with pods not ready isn't ready",
+			groupResource: daemonSets,
+			obj: unstructuredWithStatus(1, map[string]interface{}{}, map[string]interface{}{
+				"observedGeneration": int64(1), "desiredNumberScheduled": int64(3), "numberReady": int64(2),
+			}),
+		},
+		{
+			name:          "available apiservice is ready",
+			groupResource: apiServices,
+			obj:           unstructuredWithStatus(1, nil, conditions("Available", "True")),
+			expected:      true,
+		},
+		{
+			name:          "unavailable apiservice isn't ready",
+			groupResource: apiServices,
+			obj:           unstructuredWithStatus(1, nil, conditions("Available", "False")),
+		},
+		{
+			name:          "established crd is ready",
+			groupResource: kuberesource.CustomResourceDefinitions,
+			obj: unstructuredWithStatus(1, nil, map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Established", "status": "True"},
+					map[string]interface{}{"type": "NamesAccepted", "status": "True"},
+				},
+			}),
+			expected: true,
+		},
+		{
+			name:          "other resource with a false ready condition isn't ready",
+			groupResource: schema.GroupResource{Group: "example.com", Resource: "databases"},
+			obj:           unstructuredWithStatus(1, nil, conditions("Ready", "False")),
+		},
+		{
+			name:          "other resource without conditions is ready",
+			groupResource: schema.GroupResource{Group: "example.com", Resource: "databases"},
+			obj:           unstructuredWithStatus(1, nil, map[string]interface{}{}),
+			expected:      true,
+		},
+		{
+			name:          "specified condition replaces the default checks",
+			groupResource: deployments,
+			obj:           unstructuredWithStatus(1, nil, conditions("Progressing", "True")),
+			condition:     "Progressing",
+			expected:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ready, err := isItemReady(test.groupResource, test.obj, test.condition)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ready)
+		})
+	}
+}
+
+func TestWaitForReadinessGates(t *testing.T) {
+	readinessGatePollInterval = time.Millisecond
+
+	notReady := unstructuredWithStatus(1, map[string]interface{}{"replicas": int64(1)}, map[string]interface{}{"observedGeneration": int64(1)})
+	ready := unstructuredWithStatus(1, map[string]interface{}{"replicas": int64(1)}, map[string]interface{}{
+		"observedGeneration": int64(1), "updatedReplicas": int64(1), "availableReplicas": int64(1),
+	})
+
+	tests := []struct {
+		name             string
+		onError          velerov1api.HookErrorMode
+		current          *unstructured.Unstructured
+		getErr           error
+		expectedWarnings int
+		expectedErrors   int
+		expectedFailed   bool
+	}{
+		{
+			name:    "gate whose items are ready passes",
+			current: ready,
+		},
+		{
+			name:    "gate whose items were deleted passes",
+			getErr:  apierrors.NewNotFound(deployments, "app"),
+			current: nil,
+		},
+		{
+			name:             "gate whose items aren't ready records a warning",
+			current:          notReady,
+			expectedWarnings: 1,
+		},
+		{
+			name:           "gate with onError fail whose items aren't ready records an error",
+			onError:        velerov1api.HookErrorModeFail,
+			current:        notReady,
+			expectedErrors: 1,
+			expectedFailed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			discoveryHelper := velerotest.NewFakeDiscoveryHelper(false, map[schema.GroupVersionResource]schema.GroupVersionResource{
+				{Resource: "deployments"}:                {Group: "apps", Version: "v1", Resource: "deployments"},
+				{Group: "apps", Resource: "deployments"}: {Group: "apps", Version: "v1", Resource: "deployments"},
+			})
+
+			resourceClient := &velerotest.FakeDynamicClient{}
+			resourceClient.On("Get", "app", metav1.GetOptions{}).Return(test.current, test.getErr)
+			dynamicFactory := &velerotest.FakeDynamicFactory{}
+			dynamicFactory.On("ClientForGroupVersionResource", schema.GroupVersion{Group: "apps", Version: "v1"}, mock.Anything, "ns-1").Return(resourceClient, nil)
+
+			ctx := &restoreContext{
+				log:             velerotest.NewLogger(),
+				discoveryHelper: discoveryHelper,
+				dynamicFactory:  dynamicFactory,
+				restoredItems: map[velero.ResourceIdentifier]struct{}{
+					{GroupResource: deployments, Namespace: "ns-1", Name: "app"}:                  {},
+					{GroupResource: deployments, Namespace: "ns-2", Name: "excluded"}:             {},
+					{GroupResource: kuberesource.ServiceAccounts, Namespace: "ns-1", Name: "app"}: {},
+				},
+				readinessGates: resolveReadinessGates([]velerov1api.RestoreReadinessGate{
+					{
+						Name:               "apps-ready",
+						AfterResource:      "deployments",
+						IncludedNamespaces: []string{"ns-1"},
+						Timeout:            metav1.Duration{Duration: 20 * time.Millisecond},
+						OnError:            test.onError,
+					},
+				}, discoveryHelper),
+			}
+
+			warnings, errs, failed := ctx.waitForReadinessGates("deployments.apps")
+			assert.Len(t, warnings.Velero, test.expectedWarnings)
+			assert.Len(t, errs.Velero, test.expectedErrors)
+			assert.Equal(t, test.expectedFailed, failed)
+			assert.Empty(t, ctx.skippedReadinessGates().Velero)
+
+			// Gates only wait after the items of their resource are restored.
+			warnings, errs, failed = ctx.waitForReadinessGates("services")
+			assert.Empty(t, warnings.Velero)
+			assert.Empty(t, errs.Velero)
+			assert.False(t, failed)
+		})
+	}
+}
+
+func TestSkippedReadinessGates(t *testing.T) {
+	discoveryHelper := velerotest.NewFakeDiscoveryHelper(false, map[schema.GroupVersionResource]schema.GroupVersionResource{
+		{Resource: "deployments"}: {Group: "apps", Version: "v1", Resource: "deployments"},
+	})
+
+	ctx := &restoreContext{
+		log:             velerotest.NewLogger(),
+		discoveryHelper: discoveryHelper,
+		dynamicFactory:  &velerotest.FakeDynamicFactory{},
+		readinessGates: resolveReadinessGates([]velerov1api.RestoreReadinessGate{
+			{Name: "apps-ready", AfterResource: "deployments"},
+			{Name: "misspelled", AfterResource: "deployment"},
+		}, discoveryHelper),
+	}
+
+	_, _, failed := ctx.waitForReadinessGates("deployments.apps")
+	require.False(t, failed)
+
+	warnings := ctx.skippedReadinessGates()
+	assert.Equal(t, []string{"readiness gate misspelled was skipped because no items of deployment were restored"}, warnings.Velero)
+}
@@ -31,6 +31,8 @@ import (
 	uuid "github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -45,6 +47,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -56,9 +59,12 @@ import (
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/tracing"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -86,13 +92,30 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// ItemSnapshots are the snapshots item snapshotters took of items in the backup,
+	// and ItemSnapshotters are the initialized snapshotters, by name, that took them.
+	ItemSnapshots    []*volume.ItemSnapshot
+	ItemSnapshotters map[string]velero.ItemSnapshotter
+
+	// HookReport, if set, records the hooks executed for the restored pods.
+	HookReport *hook.ExecutionReport
+
+	// ItemReport, if set, records what the restore did with each item from the backup.
+	ItemReport *ItemReport
+
+	// IncludedItems, if not empty, restricts the restore to these items, identified by their
+	// group-resource, the namespace they're restored into and their name in the backup.
+	IncludedItems []velero.ResourceIdentifier
 }
 
 // Restorer knows how to restore a backup.
 type Restorer interface {
 	// Restore restores the backup data from backupReader, returning warnings and errors.
-	Restore(req Request,
-		actions []velero.RestoreItemAction,
+	// The restore's spans are children of the span in goCtx.
+	Restore(goCtx go_context.Context,
+		req Request,
+		actions []riav2.RestoreItemAction,
 		snapshotLocationLister listers.VolumeSnapshotLocationLister,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
 	) (Result, Result)
@@ -113,8 +136,11 @@ type kubernetesRestorer struct {
 	pvRenamer                  func(string) (string, error)
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
+	httpHookExecutor           podexec.HTTPHookExecutor
 	podGetter                  cache.Getter
-	destClusterHost            string
+	eventRecorder              record.EventRecorder
+	destEventRecorder          record.EventRecorder
+	destCluster                client.ClusterContextProvider
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -129,8 +155,11 @@ func NewKubernetesRestorer(
 	resourceTerminatingTimeout time.Duration,
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
+	httpHookExecutor podexec.HTTPHookExecutor,
 	podGetter cache.Getter,
-	destKubeContext string,
+	eventRecorder record.EventRecorder,
+	destEventRecorder record.EventRecorder,
+	destCluster client.ClusterContextProvider,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		restoreClient:              restoreClient,
@@ -152,8 +181,11 @@ func NewKubernetesRestorer(
 		},
 		fileSystem:         filesystem.NewFileSystem(),
 		podCommandExecutor: podCommandExecutor,
+		httpHookExecutor:   httpHookExecutor,
 		podGetter:          podGetter,
-		destClusterHost:    destKubeContext,
+		eventRecorder:      eventRecorder,
+		destEventRecorder:  destEventRecorder,
+		destCluster:        destCluster,
 	}, nil
 }
 
@@ -161,8 +193,9 @@ func NewKubernetesRestorer(
 // and using data from the provided backup/backup reader. Returns a warnings and errors RestoreResult,
 // respectively, summarizing info about the restore.
 func (kr *kubernetesRestorer) Restore(
+	goCtx go_context.Context,
 	req Request,
-	actions []velero.RestoreItemAction,
+	actions []riav2.RestoreItemAction,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
 	volumeSnapshotterGetter VolumeSnapshotterGetter,
 ) (Result, Result) {
@@ -180,8 +213,8 @@ func (kr *kubernetesRestorer) Restore(
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
 
-	if kr.destClusterHost != "" {
-		kr.logger.Info("Using destination cluster at %s", kr.destClusterHost)
+	if kr.destCluster.Host() != "" {
+		kr.logger.Info("Using destination cluster at %s", kr.destCluster.Host())
 	} else {
 		kr.logger.Info("Using local cluster to restore onto")
 	}
@@ -216,12 +249,12 @@ func (kr *kubernetesRestorer) Restore(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	resticCtx, cancelFunc := go_context.WithTimeout(goCtx, podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticRestorer restic.Restorer
 	if kr.resticRestorerFactory != nil {
-		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(ctx, req.Restore)
+		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(resticCtx, req.Restore)
 		if err != nil {
 			return Result{}, Result{Velero: []string{err.Error()}}
 		}
@@ -231,12 +264,38 @@ func (kr *kubernetesRestorer) Restore(
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
-	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
+	resourceReadyHooks, err := hook.GetResourceReadyHooksFromSpec(&req.Restore.Spec.Hooks, kr.discoveryHelper)
+	if err != nil {
+		return Result{}, Result{Velero: []string{err.Error()}}
+	}
+	hooksCtx, hooksCancelFunc := go_context.WithCancel(goCtx)
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		Report: req.HookReport,
+	}
+	podRestoreHookHandler := &hook.DefaultPodRestoreHookHandler{
+		ListWatchFactory: &hook.DefaultListWatchFactory{
+			PodsGetter: kr.podGetter,
+		},
+		HTTPHookExecutor: kr.httpHookExecutor,
+		DynamicFactory:   kr.dynamicFactory,
+		Report:           req.HookReport,
+	}
+	resourceReadyHookHandler := &hook.DefaultResourceReadyHookHandler{
+		DynamicFactory:     kr.dynamicFactory,
+		PodCommandExecutor: kr.podCommandExecutor,
+		Report:             req.HookReport,
+	}
+
+	var includedItems map[velero.ResourceIdentifier]struct{}
+	if len(req.IncludedItems) > 0 {
+		includedItems = make(map[velero.ResourceIdentifier]struct{}, len(req.IncludedItems))
+		for _, item := range req.IncludedItems {
+			includedItems[item] = struct{}{}
+		}
 	}
 
 	pvRestorer := &pvRestorer{
@@ -268,38 +327,50 @@ func (kr *kubernetesRestorer) Restore(
 		pvsToProvision:             sets.NewString(),
 		pvRestorer:                 pvRestorer,
 		volumeSnapshots:            req.VolumeSnapshots,
+		itemSnapshots:              req.ItemSnapshots,
+		itemSnapshotters:           req.ItemSnapshotters,
 		podVolumeBackups:           req.PodVolumeBackups,
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
+		itemReport:                 req.ItemReport,
+		includedItems:              includedItems,
 		renamedPVs:                 make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
 		discoveryHelper:            kr.discoveryHelper,
 		resourcePriorities:         kr.resourcePriorities,
 		resourceRestoreHooks:       resourceRestoreHooks,
+		readinessGates:             resolveReadinessGates(req.Restore.Spec.ReadinessGates, kr.discoveryHelper),
 		hooksErrs:                  make(chan error),
 		waitExecHookHandler:        waitExecHookHandler,
+		podRestoreHookHandler:      podRestoreHookHandler,
+		resourceReadyHooks:         resourceReadyHooks,
+		resourceReadyHookHandler:   resourceReadyHookHandler,
+		hookSequencer:              hook.NewHookSequencer(),
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
 		restoreClient:              kr.restoreClient,
+		eventRecorder:              kr.eventRecorder,
+		destEventRecorder:          kr.destEventRecorder,
+		destCluster:                kr.destCluster,
 	}
 
-	return restoreCtx.execute()
+	return restoreCtx.execute(goCtx)
 }
 
 func (kr *kubernetesRestorer) DestClusterHost() string {
-	return kr.destClusterHost
+	return kr.destCluster.Host()
 }
 
 type resolvedAction struct {
-	velero.RestoreItemAction
+	riav2.RestoreItemAction
 
 	resourceIncludesExcludes  *collections.IncludesExcludes
 	namespaceIncludesExcludes *collections.IncludesExcludes
 	selector                  labels.Selector
 }
 
-func resolveActions(actions []velero.RestoreItemAction, helper discovery.Helper) ([]resolvedAction, error) {
+func resolveActions(actions []riav2.RestoreItemAction, helper discovery.Helper) ([]resolvedAction, error) {
 	var resolved []resolvedAction
 
 	for _, action := range actions {
@@ -353,10 +424,14 @@ type restoreContext struct {
 	pvsToProvision             sets.String
 	pvRestorer                 PVRestorer
 	volumeSnapshots            []*volume.Snapshot
+	itemSnapshots              []*volume.ItemSnapshot
+	itemSnapshotters           map[string]velero.ItemSnapshotter
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
 	resourceTerminatingTimeout time.Duration
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
+	itemReport                 *ItemReport
+	includedItems              map[velero.ResourceIdentifier]struct{}
 	renamedPVs                 map[string]string
 	pvRenamer                  func(string) (string, error)
 	discoveryHelper            discovery.Helper
@@ -364,9 +439,17 @@ type restoreContext struct {
 	hooksWaitGroup             sync.WaitGroup
 	hooksErrs                  chan error
 	resourceRestoreHooks       []hook.ResourceRestoreHook
+	readinessGates             []readinessGate
 	waitExecHookHandler        hook.WaitExecHookHandler
+	podRestoreHookHandler      hook.PodRestoreHookHandler
+	resourceReadyHooks         []hook.ResourceRestoreHook
+	resourceReadyHookHandler   hook.ResourceReadyHookHandler
+	hookSequencer              *hook.HookSequencer
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	eventRecorder              record.EventRecorder
+	destEventRecorder          record.EventRecorder
+	destCluster                client.ClusterContextProvider
 }
 
 type resourceClientKey struct {
@@ -395,8 +478,15 @@ type progressUpdate struct {
 	totalItems, itemsRestored int
 }
 
-func (ctx *restoreContext) execute() (Result, Result) {
-	warnings, errs := Result{}, Result{}
+func (ctx *restoreContext) execute(goCtx go_context.Context) (warnings, errs Result) {
+	goCtx, span := tracing.Start(goCtx, "restoreContext.execute")
+	defer func() {
+		span.SetAttributes(attribute.Int("velero.restore.items", len(ctx.restoredItems)))
+		endSpan(span, errs)
+	}()
+	// Pods' hooks waiting for the hooks of pods with a lower order must not wait forever if
+	// the restore ends early.
+	defer ctx.hookSequencer.Close()
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
 
@@ -485,10 +575,14 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		totalItems += selectedResource.totalItems
 	}
 
+	// gateFailed is whether a readiness gate with onError Fail didn't pass, which stops the
+	// restore of the next resources.
+	gateFailed := false
 	for _, selectedResource := range crdResourceCollection {
 		var w, e Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
+			goCtx,
 			selectedResource,
 			totalItems,
 			processedItems,
@@ -497,18 +591,28 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		)
 		warnings.Merge(&w)
 		errs.Merge(&e)
+
+		w, e, gateFailed = ctx.waitForReadinessGates(selectedResource.resource)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		if gateFailed {
+			break
+		}
 	}
 
-	// Restore everything else
-	selectedResourceCollection, _, w, e := ctx.getOrderedResourceCollection(
-		backupResources,
-		crdResourceCollection,
-		processedResources,
-		ctx.resourcePriorities,
-		true,
-	)
-	warnings.Merge(&w)
-	errs.Merge(&e)
+	// Restore everything else, unless a readiness gate with onError Fail didn't pass.
+	var selectedResourceCollection []restoreableResource
+	if !gateFailed {
+		selectedResourceCollection, _, w, e = ctx.getOrderedResourceCollection(
+			backupResources,
+			crdResourceCollection,
+			processedResources,
+			ctx.resourcePriorities,
+			true,
+		)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
 
 	// reset processedItems and totalItems before processing full resource list
 	processedItems = 0
@@ -521,6 +625,7 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		var w, e Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
+			goCtx,
 			selectedResource,
 			totalItems,
 			processedItems,
@@ -529,11 +634,29 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		)
 		warnings.Merge(&w)
 		errs.Merge(&e)
+
+		w, e, gateFailed = ctx.waitForReadinessGates(selectedResource.resource)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		if gateFailed {
+			break
+		}
+	}
+
+	if gateFailed {
+		ctx.log.Warn("Stopped restoring because a readiness gate with onError Fail didn't pass")
+		errs.AddVeleroError(errors.New("the remaining resources weren't restored because a readiness gate with onError Fail didn't pass"))
+	} else {
+		w = ctx.skippedReadinessGates()
+		warnings.Merge(&w)
 	}
 
 	// Close the progress update channel.
 	quit <- struct{}{}
 
+	// All pods are restored, so the hooks of pods with the lowest order left can be executed.
+	ctx.hookSequencer.Close()
+
 	// Do a final progress update as stopping the ticker might have left last few
 	// updates from taking place.
 	patch := fmt.Sprintf(
@@ -552,6 +675,10 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	if err != nil {
 		ctx.log.WithError(errors.WithStack((err))).Warn("Updating restore status.progress")
 	}
+	ctx.restore.Status.Progress = &velerov1api.RestoreProgress{
+		TotalItems:    len(ctx.restoredItems),
+		ItemsRestored: len(ctx.restoredItems),
+	}
 
 	// Wait for all of the restic restore goroutines to be done, which is
 	// only possible once all of their errors have been received by the loop
@@ -587,13 +714,37 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
 
+	ctx.recordRestoredNamespaces(errs)
+
 	return warnings, errs
 }
 
+// recordRestoredNamespaces records an event in each namespace that items were restored into,
+// in the destination cluster.
+func (ctx *restoreContext) recordRestoredNamespaces(errs Result) {
+	namespaces := sets.NewString()
+	for item := range ctx.restoredItems {
+		if item.Namespace != "" {
+			namespaces.Insert(item.Namespace)
+		}
+	}
+
+	for _, ns := range namespaces.List() {
+		if nsErrs := errs.Namespaces[ns]; len(nsErrs) > 0 {
+			ctx.destEventRecorder.Eventf(kube.NamespaceReference(ns), v1.EventTypeWarning, kube.EventReasonRestored,
+				"Namespace restored by restore %s/%s with %d error(s)", ctx.restore.Namespace, ctx.restore.Name, len(nsErrs))
+			continue
+		}
+		ctx.destEventRecorder.Eventf(kube.NamespaceReference(ns), v1.EventTypeNormal, kube.EventReasonRestored,
+			"Namespace restored by restore %s/%s", ctx.restore.Namespace, ctx.restore.Name)
+	}
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
 func (ctx *restoreContext) processSelectedResource(
+	goCtx go_context.Context,
 	selectedResource restoreableResource,
 	totalItems int,
 	processedItems int,
@@ -655,7 +806,7 @@ func (ctx *restoreContext) processSelectedResource(
 				continue
 			}
 
-			w, e := ctx.restoreItem(obj, groupResource, selectedItem.targetNamespace)
+			w, e := ctx.restoreItem(goCtx, obj, groupResource, selectedItem.targetNamespace)
 			warnings.Merge(&w)
 			errs.Merge(&e)
 			processedItems++
@@ -918,10 +1069,29 @@ func getResourceID(groupResource schema.GroupResource, namespace, name string) s
 	return fmt.Sprintf("%s/%s/%s", groupResource.String(), namespace, name)
 }
 
-func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (Result, Result) {
-	warnings, errs := Result{}, Result{}
+func (ctx *restoreContext) restoreItem(goCtx go_context.Context, obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (warnings, errs Result) {
 	resourceID := getResourceID(groupResource, namespace, obj.GetName())
 
+	goCtx, span := tracing.Start(goCtx, "restoreContext.restoreItem",
+		attribute.String("velero.resource", groupResource.String()),
+		attribute.String("velero.namespace", namespace),
+		attribute.String("velero.name", obj.GetName()),
+	)
+	defer func() {
+		endSpan(span, errs)
+	}()
+
+	itemResult := ItemResult{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      obj.GetName(),
+	}
+	defer func() {
+		if itemResult.Status != "" {
+			ctx.itemReport.Add(itemResult)
+		}
+	}()
+
 	// Check if group/resource should be restored. We need to do this here since
 	// this method may be getting called for an additional item which is a group/resource
 	// that's excluded.
@@ -931,6 +1101,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			"name":          obj.GetName(),
 			"groupResource": groupResource.String(),
 		}).Info("Not restoring item because resource is excluded")
+		itemResult.set(ItemStatusSkipped, "resource is excluded")
 		return warnings, errs
 	}
 
@@ -947,6 +1118,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because namespace is excluded")
+			itemResult.set(ItemStatusSkipped, "namespace is excluded")
 			return warnings, errs
 		}
 
@@ -955,7 +1127,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
 		if _, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
-			errs.AddVeleroError(err)
+			errs.AddVeleroError(itemResult.fail(err))
 			return warnings, errs
 		} else {
 			// Add the newly created namespace to the list of restored items.
@@ -966,6 +1138,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					Name:          nsToEnsure.Name,
 				}
 				ctx.restoredItems[itemKey] = struct{}{}
+				ctx.itemReport.Add(ItemResult{
+					Resource: kuberesource.Namespaces.String(),
+					Name:     nsToEnsure.Name,
+					Status:   ItemStatusCreated,
+				})
 			}
 		}
 	} else {
@@ -975,6 +1152,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because it's cluster-scoped")
+			itemResult.set(ItemStatusSkipped, "cluster-scoped resources are excluded")
 			return warnings, errs
 		}
 	}
@@ -985,11 +1163,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	complete, err := isCompleted(obj, groupResource)
 	if err != nil {
-		errs.Add(namespace, fmt.Errorf("error checking completion of %q: %v", resourceID, err))
+		errs.Add(namespace, itemResult.fail(fmt.Errorf("error checking completion of %q: %v", resourceID, err)))
 		return warnings, errs
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		itemResult.set(ItemStatusSkipped, "it's complete")
 		return warnings, errs
 	}
 
@@ -1011,12 +1190,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		itemResult.set(ItemStatusSkipped, "it's a mirror pod")
 		return warnings, errs
 	}
 
 	resourceClient, err := ctx.getResourceClient(groupResource, obj, namespace)
 	if err != nil {
-		errs.AddVeleroError(fmt.Errorf("error getting resource client for namespace %q, resource %q: %v", namespace, &groupResource, err))
+		errs.AddVeleroError(itemResult.fail(fmt.Errorf("error getting resource client for namespace %q, resource %q: %v", namespace, &groupResource, err)))
 		return warnings, errs
 	}
 
@@ -1026,7 +1206,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			oldName := obj.GetName()
 			shouldRenamePV, err := shouldRenamePV(ctx, obj, resourceClient)
 			if err != nil {
-				errs.Add(namespace, err)
+				errs.Add(namespace, itemResult.fail(err))
 				return warnings, errs
 			}
 
@@ -1034,7 +1214,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			// and do so if necessary.
 			_, err = remapClaimRefNS(ctx, obj)
 			if err != nil {
-				errs.Add(namespace, err)
+				errs.Add(namespace, itemResult.fail(err))
 				return warnings, errs
 			}
 
@@ -1044,7 +1224,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				// a volume from the snapshot, in order to avoid orphaned volumes (GH #609)
 				shouldRestoreSnapshot, err = ctx.shouldRestore(name, resourceClient)
 				if err != nil {
-					errs.Add(namespace, errors.Wrapf(err, "error waiting on in-cluster persistentvolume %s", name))
+					errs.Add(namespace, itemResult.fail(errors.Wrapf(err, "error waiting on in-cluster persistentvolume %s", name)))
 					return warnings, errs
 				}
 			} else {
@@ -1062,9 +1242,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 				// uses the original name to look up metadata about the snapshot.
 				ctx.log.Infof("Restoring persistent volume from snapshot.")
-				updatedObj, err := ctx.pvRestorer.executePVAction(obj)
+				updatedObj, err := ctx.executePVAction(goCtx, obj)
 				if err != nil {
-					errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
+					ctx.eventRecorder.Eventf(ctx.restore, v1.EventTypeWarning, kube.EventReasonSnapshotFailed,
+						"Restoring persistent volume %s from snapshot failed: %v", name, err)
+					errs.Add(namespace, itemResult.fail(fmt.Errorf("error executing PVAction for %s: %v", resourceID, err)))
 					return warnings, errs
 				}
 				obj = updatedObj
@@ -1081,7 +1263,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					// pvRestorer hasn't modified the PV name, we need to rename the PV.
 					pvName, err = ctx.pvRenamer(oldName)
 					if err != nil {
-						errs.Add(namespace, errors.Wrapf(err, "error renaming PV"))
+						errs.Add(namespace, itemResult.fail(errors.Wrapf(err, "error renaming PV")))
 						return warnings, errs
 					}
 				} else {
@@ -1105,6 +1287,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
+			itemResult.set(ItemStatusSkipped, "it will be dynamically re-provisioned because it has a restic backup")
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1113,6 +1296,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 			ctx.pvsToProvision.Insert(name)
+			itemResult.set(ItemStatusSkipped, "it will be dynamically re-provisioned because it has no snapshot and its reclaim policy is Delete")
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1124,9 +1308,9 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			obj = resetVolumeBindingInfo(obj)
 			// We call the pvRestorer here to clear out the PV's claimRef.UID,
 			// so it can be re-claimed when its PVC is restored and gets a new UID.
-			updatedObj, err := ctx.pvRestorer.executePVAction(obj)
+			updatedObj, err := ctx.executePVAction(goCtx, obj)
 			if err != nil {
-				errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
+				errs.Add(namespace, itemResult.fail(fmt.Errorf("error executing PVAction for %s: %v", resourceID, err)))
 				return warnings, errs
 			}
 			obj = updatedObj
@@ -1135,70 +1319,94 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	// Clear out non-core metadata fields and status.
 	if obj, err = resetMetadataAndStatus(obj); err != nil {
-		errs.Add(namespace, err)
+		errs.Add(namespace, itemResult.fail(err))
 		return warnings, errs
 	}
 
 	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
 		if !action.selector.Matches(labels.Set(obj.GetLabels())) {
+			itemResult.set(ItemStatusSkipped, fmt.Sprintf("it doesn't match the label selector of restore item action %s", action.Name()))
 			return warnings, errs
 		}
 
 		ctx.log.Infof("Executing item action for %v", &groupResource)
 
-		executeOutput, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+		beforeAction := obj.DeepCopy()
+
+		cluster, err := ctx.destCluster.ClusterContext()
+		if err != nil {
+			errs.Add(namespace, itemResult.fail(fmt.Errorf("error getting the destination cluster for %s: %v", resourceID, err)))
+			return warnings, errs
+		}
+
+		actionCtx, actionSpan := tracing.Start(goCtx, "RestoreItemAction.Execute", attribute.String("velero.plugin", action.Name()))
+		tracedAction := framework.WithContext(action.RestoreItemAction, actionCtx).(riav2.RestoreItemAction)
+		executeOutput, err := tracedAction.Execute(&velero.RestoreItemActionExecuteInput{
 			Item:           obj,
 			ItemFromBackup: itemFromBackup,
 			Restore:        ctx.restore,
+			Cluster:        cluster,
 		})
+		tracing.End(actionSpan, err)
 		if err != nil {
-			errs.Add(namespace, fmt.Errorf("error preparing %s: %v", resourceID, err))
+			errs.Add(namespace, itemResult.fail(fmt.Errorf("error preparing %s: %v", resourceID, err)))
 			return warnings, errs
 		}
 
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			itemResult.set(ItemStatusSkipped, fmt.Sprintf("restore item action %s discarded it", action.Name()))
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
 		if !ok {
-			errs.Add(namespace, fmt.Errorf("%s: unexpected type %T", resourceID, executeOutput.UpdatedItem))
+			errs.Add(namespace, itemResult.fail(fmt.Errorf("%s: unexpected type %T", resourceID, executeOutput.UpdatedItem)))
 			return warnings, errs
 		}
 
 		obj = unstructuredObj
+		if !equality.Semantic.DeepEqual(beforeAction, obj) {
+			itemResult.TransformedBy = append(itemResult.TransformedBy, action.Name())
+		}
+
+		// the restore won't be complete until the operation the action started finishes, so record
+		// it for the restore operations controller to track.
+		if executeOutput.OperationID != "" {
+			ctx.log.WithFields(logrus.Fields{"action": action.Name(), "operationID": executeOutput.OperationID}).Info("Restore item action started an asynchronous operation")
+			created := metav1.Now()
+			ctx.restore.Status.PluginOperations = append(ctx.restore.Status.PluginOperations, velerov1api.PluginOperation{
+				PluginName:  action.Name(),
+				OperationID: executeOutput.OperationID,
+				Resource:    groupResource.String(),
+				Namespace:   namespace,
+				Name:        name,
+				Phase:       velerov1api.PluginOperationPhaseInProgress,
+				Created:     &created,
+			})
+		}
+
+		w, e := ctx.restoreAdditionalItems(goCtx, namespace, executeOutput.AdditionalItems)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
 
-		for _, additionalItem := range executeOutput.AdditionalItems {
-			itemPath := archive.GetItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
-
-			if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
-				ctx.log.WithError(err).WithFields(logrus.Fields{
-					"additionalResource":          additionalItem.GroupResource.String(),
-					"additionalResourceNamespace": additionalItem.Namespace,
-					"additionalResourceName":      additionalItem.Name,
-				}).Warn("unable to restore additional item")
-				warnings.Add(additionalItem.Namespace, err)
-
-				continue
-			}
-
-			additionalResourceID := getResourceID(additionalItem.GroupResource, additionalItem.Namespace, additionalItem.Name)
-			additionalObj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
-			if err != nil {
-				errs.Add(namespace, errors.Wrapf(err, "error restoring additional item %s", additionalResourceID))
-			}
+	if snapshot := ctx.itemSnapshot(groupResource, itemFromBackup.GetNamespace(), itemFromBackup.GetName()); snapshot != nil {
+		restoredObj, additionalItems, err := ctx.restoreFromItemSnapshot(goCtx, snapshot, obj, itemFromBackup)
+		if err != nil {
+			errs.Add(namespace, itemResult.fail(fmt.Errorf("error restoring %s from its snapshot: %v", resourceID, err)))
+			return warnings, errs
+		}
 
-			additionalItemNamespace := additionalItem.Namespace
-			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
-					additionalItemNamespace = remapped
-				}
-			}
+		w, e := ctx.restoreAdditionalItems(goCtx, namespace, additionalItems)
+		warnings.Merge(&w)
+		errs.Merge(&e)
 
-			w, e := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
-			warnings.Merge(&w)
-			errs.Merge(&e)
+		if restoredObj == nil {
+			ctx.log.Infof("Skipping restore of %s: %v because the item snapshotter that took its snapshot restored it", obj.GroupVersionKind().Kind, name)
+			itemResult.set(ItemStatusSkipped, "the item snapshotter that took its snapshot restored it")
+			return warnings, errs
 		}
+		obj = restoredObj
 	}
 
 	// This comes after running item actions because we have built-in actions that restore
@@ -1212,7 +1420,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	if groupResource == kuberesource.PersistentVolumeClaims {
 		pvc := new(v1.PersistentVolumeClaim)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
-			errs.Add(namespace, err)
+			errs.Add(namespace, itemResult.fail(err))
 			return warnings, errs
 		}
 
@@ -1231,7 +1439,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
-				errs.Add(namespace, err)
+				errs.Add(namespace, itemResult.fail(err))
 				return warnings, errs
 			}
 		}
@@ -1249,6 +1457,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// and which backup they came from.
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
+	if obj.GetName() != name {
+		itemResult.NewName = obj.GetName()
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
 	if apierrors.IsAlreadyExists(restoreErr) {
@@ -1256,6 +1468,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if err != nil {
 			ctx.log.Infof("Error retrieving cluster version of %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
+			itemResult.set(ItemStatusAlreadyExists, err.Error())
 			return warnings, errs
 		}
 		// Remove insubstantial metadata.
@@ -1263,6 +1476,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		if err != nil {
 			ctx.log.Infof("Error trying to reset metadata for %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
+			itemResult.set(ItemStatusAlreadyExists, err.Error())
 			return warnings, errs
 		}
 
@@ -1278,6 +1492,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					ctx.log.Infof("error merging secrets for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					itemResult.set(ItemStatusAlreadyExists, err.Error())
 					return warnings, errs
 				}
 
@@ -1285,38 +1500,45 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					ctx.log.Infof("error generating patch for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					itemResult.set(ItemStatusAlreadyExists, err.Error())
 					return warnings, errs
 				}
 
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to
 					// the next item.
+					itemResult.set(ItemStatusAlreadyExists, "the in-cluster version is the same as the backed up version")
 					return warnings, errs
 				}
 
 				_, err = resourceClient.Patch(name, patchBytes)
 				if err != nil {
 					warnings.Add(namespace, err)
+					itemResult.set(ItemStatusAlreadyExists, err.Error())
 				} else {
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
+					itemResult.set(ItemStatusUpdated, "")
 				}
 			default:
 				e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
 				warnings.Add(namespace, e)
+				itemResult.set(ItemStatusAlreadyExists, "the in-cluster version is different than the backed up version")
 			}
 			return warnings, errs
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		itemResult.set(ItemStatusAlreadyExists, "the in-cluster version is the same as the backed up version")
 		return warnings, errs
 	}
 
 	// Error was something other than an AlreadyExists.
 	if restoreErr != nil {
 		ctx.log.Errorf("error restoring %s: %+v", name, restoreErr)
-		errs.Add(namespace, fmt.Errorf("error restoring %s: %v", resourceID, restoreErr))
+		errs.Add(namespace, itemResult.fail(fmt.Errorf("error restoring %s: %v", resourceID, restoreErr)))
 		return warnings, errs
 	}
+	itemResult.set(ItemStatusCreated, "")
 
 	if groupResource == kuberesource.Pods {
 		pod := new(v1.Pod)
@@ -1331,7 +1553,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 
 	if groupResource == kuberesource.Pods {
-		ctx.waitExec(createdObj)
+		ctx.waitExec(goCtx, createdObj)
+	}
+
+	if readyHooks := hook.GetResourceReadyHooks(ctx.resourceReadyHooks, groupResource, createdObj); len(readyHooks) > 0 {
+		ctx.waitResourceReady(goCtx, resourceClient, createdObj, readyHooks)
 	}
 
 	// Wait for a CRD to be available for instantiating resources
@@ -1459,13 +1685,139 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 	}
 }
 
-// waitExec executes hooks in a restored pod's containers when they become ready.
-func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
+// restoreAdditionalItems restores the additional items a plugin returned for an item
+// being restored into namespace.
+func (ctx *restoreContext) restoreAdditionalItems(goCtx go_context.Context, namespace string, additionalItems []velero.ResourceIdentifier) (warnings, errs Result) {
+	for _, additionalItem := range additionalItems {
+		itemPath := archive.GetItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
+
+		if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
+			ctx.log.WithError(err).WithFields(logrus.Fields{
+				"additionalResource":          additionalItem.GroupResource.String(),
+				"additionalResourceNamespace": additionalItem.Namespace,
+				"additionalResourceName":      additionalItem.Name,
+			}).Warn("unable to restore additional item")
+			warnings.Add(additionalItem.Namespace, err)
+
+			continue
+		}
+
+		additionalResourceID := getResourceID(additionalItem.GroupResource, additionalItem.Namespace, additionalItem.Name)
+		additionalObj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+		if err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error restoring additional item %s", additionalResourceID))
+		}
+
+		additionalItemNamespace := additionalItem.Namespace
+		if additionalItemNamespace != "" {
+			if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
+				additionalItemNamespace = remapped
+			}
+		}
+
+		w, e := ctx.restoreItem(goCtx, additionalObj, additionalItem.GroupResource, additionalItemNamespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
+	return warnings, errs
+}
+
+// itemSnapshot returns the snapshot an item snapshotter took of the backed up item, or
+// nil if there isn't one.
+func (ctx *restoreContext) itemSnapshot(groupResource schema.GroupResource, namespace, name string) *volume.ItemSnapshot {
+	for _, snapshot := range ctx.itemSnapshots {
+		if snapshot.Spec.Resource == groupResource.String() && snapshot.Spec.Namespace == namespace && snapshot.Spec.Name == name {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// restoreFromItemSnapshot restores an item from the snapshot an item snapshotter took of
+// it. It returns the item to create, or nil if the snapshotter restored the item itself,
+// and the additional items to restore.
+func (ctx *restoreContext) restoreFromItemSnapshot(
+	goCtx go_context.Context,
+	snapshot *volume.ItemSnapshot,
+	obj, itemFromBackup *unstructured.Unstructured,
+) (*unstructured.Unstructured, []velero.ResourceIdentifier, error) {
+	snapshotter, ok := ctx.itemSnapshotters[snapshot.Spec.ItemSnapshotter]
+	if !ok {
+		return nil, nil, errors.Errorf("item snapshotter %s isn't installed", snapshot.Spec.ItemSnapshotter)
+	}
+
+	ctx.log.WithField("itemSnapshotter", snapshot.Spec.ItemSnapshotter).Info("Restoring item from its snapshot")
+
+	cluster, err := ctx.destCluster.ClusterContext()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error getting the destination cluster")
+	}
+
+	goCtx, span := tracing.Start(goCtx, "ItemSnapshotter.Restore", attribute.String("velero.plugin", snapshot.Spec.ItemSnapshotter))
+	snapshotter = framework.WithContext(snapshotter, goCtx).(velero.ItemSnapshotter)
+	output, err := snapshotter.Restore(&velero.RestoreItemFromSnapshotInput{
+		Item:             obj,
+		ItemFromBackup:   itemFromBackup,
+		SnapshotID:       snapshot.Status.SnapshotID,
+		SnapshotMetadata: snapshot.Status.Metadata,
+		Restore:          ctx.restore,
+		Cluster:          cluster,
+	})
+	tracing.End(span, err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if output.SkipRestore {
+		return nil, output.AdditionalItems, nil
+	}
+
+	restoredObj, ok := output.UpdatedItem.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, errors.Errorf("unexpected type %T", output.UpdatedItem)
+	}
+
+	return restoredObj, output.AdditionalItems, nil
+}
+
+// executePVAction runs the pvRestorer on a persistent volume, in a span that's a child
+// of the span in goCtx.
+func (ctx *restoreContext) executePVAction(goCtx go_context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	goCtx, span := tracing.Start(goCtx, "pvRestorer.executePVAction")
+	updatedObj, err := ctx.pvRestorer.executePVAction(goCtx, obj)
+	tracing.End(span, err)
+	return updatedObj, err
+}
+
+// endSpan ends span, marking it as failed if errs holds any error.
+func endSpan(span trace.Span, errs Result) {
+	var err error
+	messages := append(append([]string{}, errs.Velero...), errs.Cluster...)
+	for _, nsErrs := range errs.Namespaces {
+		messages = append(messages, nsErrs...)
+	}
+	if len(messages) > 0 {
+		err = errors.New(strings.Join(messages, "; "))
+	}
+	tracing.End(span, err)
+}
+
+// waitExec executes hooks in a restored pod's containers when they become ready, followed by its
+// HTTP and job hooks once it's running. The hooks' span is a child of the span in goCtx, but
+// they're canceled with the restore's hooks.
+func (ctx *restoreContext) waitExec(goCtx go_context.Context, createdObj *unstructured.Unstructured) {
+	// The pod is added to the hook sequencer before its hooks are handled in the background,
+	// so that pods whose hooks have a higher order wait for it.
+	order := hook.PodRestoreHookOrder(ctx.resourceRestoreHooks, createdObj, ctx.log)
+	ctx.hookSequencer.Add(order)
+
 	ctx.hooksWaitGroup.Add(1)
 	go func() {
 		// Done() will only be called after all errors have been successfully sent
 		// on the ctx.resticErrs channel.
 		defer ctx.hooksWaitGroup.Done()
+		defer ctx.hookSequencer.Done(order)
 
 		pod := new(v1.Pod)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(createdObj.UnstructuredContent(), &pod); err != nil {
@@ -1484,10 +1836,73 @@ func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
 			return
 		}
 
-		if errs := ctx.waitExecHookHandler.HandleHooks(ctx.hooksContext, ctx.log, pod, execHooksByContainer); len(errs) > 0 {
+		// If waiting fails, the restore's hooks were canceled, and the handlers report the
+		// pod's hooks as not executed.
+		if err := ctx.hookSequencer.Wait(ctx.hooksContext, order); err != nil {
+			ctx.log.WithError(err).Warnf("Post-restore hooks for pod %s/%s will not be executed", pod.Namespace, pod.Name)
+		}
+
+		hooksCtx, span := tracing.Start(trace.ContextWithSpan(ctx.hooksContext, trace.SpanFromContext(goCtx)), "WaitExecHookHandler.HandleHooks",
+			attribute.String("velero.namespace", pod.Namespace),
+			attribute.String("velero.name", pod.Name),
+		)
+		errs := ctx.waitExecHookHandler.HandleHooks(hooksCtx, ctx.log, pod, execHooksByContainer)
+		if len(errs) == 0 {
+			podRestoreHooks := hook.GetPodRestoreHooks(ctx.resourceRestoreHooks, pod, ctx.log)
+			errs = ctx.podRestoreHookHandler.HandleHooks(hooksCtx, ctx.log, pod, podRestoreHooks)
+		}
+		tracing.End(span, kubeerrs.NewAggregate(errs))
+		if len(errs) > 0 {
 			ctx.log.WithError(kubeerrs.NewAggregate(errs)).Error("unable to successfully execute post-restore hooks")
 			ctx.hooksCancelFunc()
 
+			ctx.eventRecorder.Eventf(ctx.restore, v1.EventTypeWarning, kube.EventReasonHookFailed,
+				"Post-restore hooks for pod %s/%s failed: %v", pod.Namespace, pod.Name, kubeerrs.NewAggregate(errs))
+			ctx.destEventRecorder.Eventf(pod, v1.EventTypeWarning, kube.EventReasonHookFailed,
+				"Post-restore hooks for restore %s failed: %v", ctx.restore.Name, kubeerrs.NewAggregate(errs))
+
+			for _, err := range errs {
+				// Errors are already logged in the HandleHooks method.
+				ctx.hooksErrs <- err
+			}
+		}
+	}()
+}
+
+// waitResourceReady executes the resource ready hooks of a restored resource once it's ready. Like
+// the hooks of restored pods, they're ordered by the hook sequencer.
+func (ctx *restoreContext) waitResourceReady(goCtx go_context.Context, resourceClient client.Getter, createdObj *unstructured.Unstructured, readyHooks []hook.ResourceReadyHook) {
+	// The hooks are sorted by increasing order, so the resource's order is that of its first hook.
+	order := readyHooks[0].Order
+	ctx.hookSequencer.Add(order)
+
+	ctx.hooksWaitGroup.Add(1)
+	go func() {
+		// Done() will only be called after all errors have been successfully sent
+		// on the ctx.hooksErrs channel.
+		defer ctx.hooksWaitGroup.Done()
+		defer ctx.hookSequencer.Done(order)
+
+		resource := kube.NamespaceAndName(createdObj)
+		if err := ctx.hookSequencer.Wait(ctx.hooksContext, order); err != nil {
+			ctx.log.WithError(err).Warnf("Post-restore hooks for %s will not be executed", resource)
+		}
+
+		hooksCtx, span := tracing.Start(trace.ContextWithSpan(ctx.hooksContext, trace.SpanFromContext(goCtx)), "ResourceReadyHookHandler.HandleHooks",
+			attribute.String("velero.namespace", createdObj.GetNamespace()),
+			attribute.String("velero.name", createdObj.GetName()),
+		)
+		errs := ctx.resourceReadyHookHandler.HandleHooks(hooksCtx, ctx.log, resourceClient, createdObj, readyHooks)
+		tracing.End(span, kubeerrs.NewAggregate(errs))
+		if len(errs) > 0 {
+			ctx.log.WithError(kubeerrs.NewAggregate(errs)).Error("unable to successfully execute post-restore hooks")
+			ctx.hooksCancelFunc()
+
+			ctx.eventRecorder.Eventf(ctx.restore, v1.EventTypeWarning, kube.EventReasonHookFailed,
+				"Post-restore hooks for %s %s failed: %v", createdObj.GetKind(), resource, kubeerrs.NewAggregate(errs))
+			ctx.destEventRecorder.Eventf(createdObj, v1.EventTypeWarning, kube.EventReasonHookFailed,
+				"Post-restore hooks for restore %s failed: %v", ctx.restore.Name, kubeerrs.NewAggregate(errs))
+
 			for _, err := range errs {
 				// Errors are already logged in the HandleHooks method.
 				ctx.hooksErrs <- err
@@ -1792,6 +2207,17 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 	}
 
 	for _, item := range items {
+		if ctx.includedItems != nil {
+			itemKey := velero.ResourceIdentifier{
+				GroupResource: schema.ParseGroupResource(restorable.resource),
+				Namespace:     targetNamespace,
+				Name:          item,
+			}
+			if _, included := ctx.includedItems[itemKey]; !included {
+				continue
+			}
+		}
+
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resource, originalNamespace, item)
 
 		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
@@ -29,6 +29,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +44,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
@@ -47,6 +54,7 @@ import (
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	resticmocks "github.com/vmware-tanzu/velero/pkg/restic/mocks"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -543,6 +551,7 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -624,6 +633,7 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -706,6 +716,7 @@ func TestRestoreResourcePriorities(t *testing.T) {
 			BackupReader:     tc.tarball,
 		}
 		warnings, errs := h.restorer.Restore(
+			context.Background(),
 			data,
 			nil, // actions
 			nil, // snapshot location lister
@@ -783,6 +794,7 @@ func TestInvalidTarballContents(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -998,6 +1010,7 @@ func TestRestoreItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -1017,6 +1030,11 @@ type recordResourcesAction struct {
 	selector        velero.ResourceSelector
 	ids             []string
 	additionalItems []velero.ResourceIdentifier
+	operationID     string
+}
+
+func (a *recordResourcesAction) Name() string {
+	return "velero.io/record-resources"
 }
 
 func (a *recordResourcesAction) AppliesTo() (velero.ResourceSelector, error) {
@@ -1036,9 +1054,18 @@ func (a *recordResourcesAction) Execute(input *velero.RestoreItemActionExecuteIn
 	return &velero.RestoreItemActionExecuteOutput{
 		UpdatedItem:     input.Item,
 		AdditionalItems: a.additionalItems,
+		OperationID:     a.operationID,
 	}, nil
 }
 
+func (a *recordResourcesAction) Progress(operationID string, restore *velerov1api.Restore) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, nil
+}
+
+func (a *recordResourcesAction) Cancel(operationID string, restore *velerov1api.Restore) error {
+	return nil
+}
+
 func (a *recordResourcesAction) ForResource(resource string) *recordResourcesAction {
 	a.selector.IncludedResources = append(a.selector.IncludedResources, resource)
 	return a
@@ -1059,6 +1086,11 @@ func (a *recordResourcesAction) WithAdditionalItems(items []velero.ResourceIdent
 	return a
 }
 
+func (a *recordResourcesAction) WithOperationID(operationID string) *recordResourcesAction {
+	a.operationID = operationID
+	return a
+}
+
 // TestRestoreActionsRunsForCorrectItems runs restores with restore item actions, and
 // verifies that each restore item action is run for the correct set of resources based on its
 // AppliesTo() resource selector. Verification is done by using the recordResourcesAction struct,
@@ -1194,7 +1226,7 @@ func TestRestoreActionsRunForCorrectItems(t *testing.T) {
 				h.AddItems(t, r)
 			}
 
-			actions := []velero.RestoreItemAction{}
+			actions := []riav2.RestoreItemAction{}
 			for action := range tc.actions {
 				actions = append(actions, action)
 			}
@@ -1208,6 +1240,7 @@ func TestRestoreActionsRunForCorrectItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				actions,
 				nil, // snapshot location lister
@@ -1225,6 +1258,245 @@ func TestRestoreActionsRunForCorrectItems(t *testing.T) {
 	}
 }
 
+// TestRestoreRecordsPluginOperations verifies that when a restore item action
+// starts an asynchronous operation, the operation is recorded in the restore's
+// status so it can be tracked to completion.
+func TestRestoreRecordsPluginOperations(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	restore := defaultRestore().Result()
+	data := Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done(),
+	}
+	action := new(recordResourcesAction).ForResource("pods").WithOperationID("op-1")
+
+	warnings, errs := h.restorer.Restore(context.Background(), data, []riav2.RestoreItemAction{action}, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	require.Len(t, restore.Status.PluginOperations, 1)
+	op := restore.Status.PluginOperations[0]
+	assert.Equal(t, "velero.io/record-resources", op.PluginName)
+	assert.Equal(t, "op-1", op.OperationID)
+	assert.Equal(t, "pods", op.Resource)
+	assert.Equal(t, "ns-1", op.Namespace)
+	assert.Equal(t, "pod-1", op.Name)
+	assert.Equal(t, velerov1api.PluginOperationPhaseInProgress, op.Phase)
+	assert.True(t, velerov1api.PluginOperationsInProgress(restore.Status.PluginOperations))
+}
+
+// TestRestoreIsTraced runs a restore with an in-memory span exporter and verifies
+// that the restore, each item and each action are traced, and that actions are
+// called with the context of their spans.
+func TestRestoreIsTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	data := Request{
+		Log:          h.log,
+		Restore:      defaultRestore().Result(),
+		Backup:       defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-1", "pod-2").Result()).Done(),
+	}
+	action := &contextRecordingAction{recordResourcesAction: new(recordResourcesAction).ForResource("pods")}
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "Restore")
+	warnings, errs := h.restorer.Restore(ctx, data, []riav2.RestoreItemAction{action}, nil, nil)
+	parent.End()
+	assertEmptyResults(t, warnings, errs)
+
+	spans := exporter.GetSpans()
+
+	restoreSpans := spansNamed(spans, "restoreContext.execute")
+	require.Len(t, restoreSpans, 1)
+	restoreSpan := restoreSpans[0]
+	assert.Equal(t, parent.SpanContext().SpanID(), restoreSpan.Parent.SpanID())
+	assert.Equal(t, codes.Unset, restoreSpan.Status.Code)
+	// the pods' namespace is restored too.
+	assert.Contains(t, restoreSpan.Attributes, attribute.Int("velero.restore.items", 3))
+
+	itemSpans := spansNamed(spans, "restoreContext.restoreItem")
+	require.Len(t, itemSpans, 2)
+	for _, span := range itemSpans {
+		assert.Equal(t, restoreSpan.SpanContext.SpanID(), span.Parent.SpanID())
+	}
+
+	actionSpans := spansNamed(spans, "RestoreItemAction.Execute")
+	require.Len(t, actionSpans, 2)
+	for _, span := range actionSpans {
+		assert.Contains(t, []trace.SpanID{itemSpans[0].SpanContext.SpanID(), itemSpans[1].SpanContext.SpanID()}, span.Parent.SpanID())
+	}
+
+	require.Len(t, action.contexts, 2)
+	for _, ctx := range action.contexts {
+		spanID := trace.SpanContextFromContext(ctx).SpanID()
+		assert.Contains(t, []trace.SpanID{actionSpans[0].SpanContext.SpanID(), actionSpans[1].SpanContext.SpanID()}, spanID)
+	}
+}
+
+// spansNamed returns the spans with the given name.
+func spansNamed(spans tracetest.SpanStubs, name string) tracetest.SpanStubs {
+	var named tracetest.SpanStubs
+	for _, span := range spans {
+		if span.Name == name {
+			named = append(named, span)
+		}
+	}
+	return named
+}
+
+// contextRecordingAction is a RestoreItemAction that records the contexts its
+// calls are made with.
+type contextRecordingAction struct {
+	*recordResourcesAction
+	contexts []context.Context
+}
+
+func (a *contextRecordingAction) WithContext(ctx context.Context) interface{} {
+	a.contexts = append(a.contexts, ctx)
+	return a
+}
+
+// fakeItemSnapshotter is an ItemSnapshotter whose Restore function labels the item
+// with the ID of the snapshot it's restored from, or skips it if skipRestore is true.
+type fakeItemSnapshotter struct {
+	skipRestore bool
+}
+
+func (s *fakeItemSnapshotter) Init(config map[string]string) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (s *fakeItemSnapshotter) AlterBackupItem(input *velero.AlterBackupItemInput) (runtime.Unstructured, error) {
+	return input.Item, nil
+}
+
+func (s *fakeItemSnapshotter) Snapshot(input *velero.SnapshotItemInput) (*velero.SnapshotItemOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *fakeItemSnapshotter) Progress(input *velero.ItemSnapshotProgressInput) (velero.OperationProgress, error) {
+	return velero.OperationProgress{Completed: true}, nil
+}
+
+func (s *fakeItemSnapshotter) Delete(input *velero.DeleteItemSnapshotInput) error {
+	return nil
+}
+
+func (s *fakeItemSnapshotter) Restore(input *velero.RestoreItemFromSnapshotInput) (*velero.RestoreItemFromSnapshotOutput, error) {
+	if s.skipRestore {
+		return &velero.RestoreItemFromSnapshotOutput{SkipRestore: true}, nil
+	}
+
+	obj := input.Item.(*unstructured.Unstructured).DeepCopy()
+	obj.SetLabels(map[string]string{"restored-from": input.SnapshotID})
+	return &velero.RestoreItemFromSnapshotOutput{UpdatedItem: obj}, nil
+}
+
+// TestRestoreWithItemSnapshots verifies that items an item snapshotter took a snapshot of
+// are restored through the snapshotter, and that items without a snapshot aren't.
+func TestRestoreWithItemSnapshots(t *testing.T) {
+	itemSnapshots := []*volume.ItemSnapshot{
+		{
+			Spec: volume.ItemSnapshotSpec{
+				BackupName:      "backup-1",
+				ItemSnapshotter: "velero.io/fake",
+				Resource:        "pods",
+				Namespace:       "ns-1",
+				Name:            "pod-1",
+			},
+			Status: volume.ItemSnapshotStatus{SnapshotID: "snapshot-1"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		snapshotters map[string]velero.ItemSnapshotter
+		want         []*test.APIResource
+		wantErrs     bool
+	}{
+		{
+			name:         "snapshotted item is restored from its snapshot",
+			snapshotters: map[string]velero.ItemSnapshotter{"velero.io/fake": new(fakeItemSnapshotter)},
+			want: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("restored-from", "snapshot-1")).Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+				),
+			},
+		},
+		{
+			name:         "snapshotted item isn't created when the snapshotter skips it",
+			snapshotters: map[string]velero.ItemSnapshotter{"velero.io/fake": &fakeItemSnapshotter{skipRestore: true}},
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-2").Result()),
+			},
+		},
+		{
+			name: "snapshotted item fails to restore when the snapshotter isn't installed",
+			want: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-2").Result()),
+			},
+			wantErrs: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+
+			restore := defaultRestore().Result()
+			data := Request{
+				Log:     h.log,
+				Restore: restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+				).Done(),
+				ItemSnapshots:    itemSnapshots,
+				ItemSnapshotters: tc.snapshotters,
+			}
+
+			for _, resource := range tc.want {
+				for _, item := range resource.Items {
+					labels := item.GetLabels()
+					if labels == nil {
+						labels = make(map[string]string)
+					}
+
+					labels["velero.io/restore-name"] = restore.Name
+					labels["velero.io/backup-name"] = restore.Spec.BackupName
+
+					item.SetLabels(labels)
+				}
+			}
+
+			warnings, errs := h.restorer.Restore(context.Background(), data, nil, nil, nil)
+
+			assert.Empty(t, warnings.Namespaces)
+			if tc.wantErrs {
+				assert.NotEmpty(t, errs.Namespaces["ns-1"])
+			} else {
+				assertEmptyResults(t, warnings, errs)
+			}
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
 // pluggableAction is a restore item action that can be plugged with an Execute
 // function body at runtime.
 type pluggableAction struct {
@@ -1232,6 +1504,10 @@ type pluggableAction struct {
 	executeFunc func(*velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error)
 }
 
+func (a *pluggableAction) Name() string {
+	return "velero.io/pluggable"
+}
+
 func (a *pluggableAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	if a.executeFunc == nil {
 		return &velero.RestoreItemActionExecuteOutput{
@@ -1246,6 +1522,14 @@ func (a *pluggableAction) AppliesTo() (velero.ResourceSelector, error) {
 	return a.selector, nil
 }
 
+func (a *pluggableAction) Progress(operationID string, restore *velerov1api.Restore) (velero.OperationProgress, error) {
+	return velero.OperationProgress{}, nil
+}
+
+func (a *pluggableAction) Cancel(operationID string, restore *velerov1api.Restore) error {
+	return nil
+}
+
 // TestRestoreActionModifications runs restores with restore item actions that modify resources, and
 // verifies that that the modified item is correctly created in the API. Verification is done by looking
 // at the full object in the API.
@@ -1276,7 +1560,7 @@ func TestRestoreActionModifications(t *testing.T) {
 		backup       *velerov1api.Backup
 		apiResources []*test.APIResource
 		tarball      io.Reader
-		actions      []velero.RestoreItemAction
+		actions      []riav2.RestoreItemAction
 		want         []*test.APIResource
 	}{
 		{
@@ -1285,7 +1569,7 @@ func TestRestoreActionModifications(t *testing.T) {
 			backup:       defaultBackup().Result(),
 			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done(),
 			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.SetLabels(map[string]string{"updated": "true"})
 				}),
@@ -1302,7 +1586,7 @@ func TestRestoreActionModifications(t *testing.T) {
 			backup:       defaultBackup().Result(),
 			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("should-be-removed", "true")).Result()).Done(),
 			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				modifyingActionGetter(func(item *unstructured.Unstructured) {
 					item.SetLabels(nil)
 				}),
@@ -1347,6 +1631,7 @@ func TestRestoreActionModifications(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				tc.actions,
 				nil, // snapshot location lister
@@ -1369,7 +1654,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 		backup       *velerov1api.Backup
 		tarball      io.Reader
 		apiResources []*test.APIResource
-		actions      []velero.RestoreItemAction
+		actions      []riav2.RestoreItemAction
 		want         map[*test.APIResource][]string
 	}{
 		{
@@ -1378,7 +1663,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 			backup:       defaultBackup().Result(),
 			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-2", "pod-2").Result()).Done(),
 			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				&pluggableAction{
 					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
 					executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
@@ -1401,7 +1686,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 			backup:       defaultBackup().Result(),
 			tarball:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-2", "pod-2").Result()).Done(),
 			apiResources: []*test.APIResource{test.Pods()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				&pluggableAction{
 					executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 						return &velero.RestoreItemActionExecuteOutput{
@@ -1426,7 +1711,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
 				Done(),
 			apiResources: []*test.APIResource{test.Pods(), test.PVs()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				&pluggableAction{
 					executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 						return &velero.RestoreItemActionExecuteOutput{
@@ -1452,7 +1737,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
 				Done(),
 			apiResources: []*test.APIResource{test.Pods(), test.PVs()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				&pluggableAction{
 					executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 						return &velero.RestoreItemActionExecuteOutput{
@@ -1478,7 +1763,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
 				Done(),
 			apiResources: []*test.APIResource{test.Pods(), test.PVs()},
-			actions: []velero.RestoreItemAction{
+			actions: []riav2.RestoreItemAction{
 				&pluggableAction{
 					executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 						return &velero.RestoreItemActionExecuteOutput{
@@ -1514,6 +1799,7 @@ func TestRestoreActionAdditionalItems(t *testing.T) {
 				BackupReader:     tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				tc.actions,
 				nil, // snapshot location lister
@@ -2417,6 +2703,7 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				BackupReader:    tc.tarball,
 			}
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				vslInformer.Lister(),
@@ -2544,6 +2831,7 @@ func TestRestoreWithRestic(t *testing.T) {
 			}
 
 			warnings, errs := h.restorer.Restore(
+				context.Background(),
 				data,
 				nil, // actions
 				nil, // snapshot location lister
@@ -2830,6 +3118,9 @@ func newHarness(t *testing.T) *harness {
 			resourceTerminatingTimeout: time.Minute,
 			logger:                     log,
 			fileSystem:                 testutil.NewFakeFileSystem(),
+			eventRecorder:              &record.FakeRecorder{},
+			destEventRecorder:          &record.FakeRecorder{},
+			destCluster:                client.LocalClusterContextProvider{},
 
 			// unsupported
 			resticRestorerFactory: nil,
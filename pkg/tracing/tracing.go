@@ -0,0 +1,110 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing sets up OpenTelemetry tracing of Velero's operations. Spans are
+// recorded with the global tracer provider, which doesn't record anything unless an
+// OTLP endpoint is configured.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The standard OpenTelemetry environment variables that enable exporting traces.
+// The exporter reads the rest of its configuration, like headers, from the other
+// standard OTEL_EXPORTER_OTLP_* variables, and the service name from OTEL_SERVICE_NAME.
+const (
+	EndpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	TracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	ProtocolEnvVar       = "OTEL_EXPORTER_OTLP_PROTOCOL"
+)
+
+const (
+	otlpProtocolHTTPProtobuf = "http/protobuf"
+
+	instrumentationName = "github.com/vmware-tanzu/velero"
+)
+
+// Start starts a span named name that is a child of the span in ctx, if any, and
+// returns a context carrying the new span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End marks span as failed if err is not nil, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ConfigureFromEnvironment sets up the propagation of spans over gRPC and, if the
+// standard OpenTelemetry environment variables configure an OTLP endpoint, a tracer
+// provider that exports spans to it. It returns a function that exports the remaining
+// spans and stops exporting. Only the http/protobuf protocol is supported.
+func ConfigureFromEnvironment(defaultServiceName string, log logrus.FieldLogger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	noop := func(context.Context) error { return nil }
+	if os.Getenv(EndpointEnvVar) == "" && os.Getenv(TracesEndpointEnvVar) == "" {
+		return noop, nil
+	}
+
+	if protocol := os.Getenv(ProtocolEnvVar); protocol != "" && protocol != otlpProtocolHTTPProtobuf {
+		return noop, errors.Errorf("unsupported OTLP protocol %q, only %q is supported", protocol, otlpProtocolHTTPProtobuf)
+	}
+
+	exporter, err := otlptracehttp.New(context.Background())
+	if err != nil {
+		return noop, errors.Wrap(err, "error creating OTLP exporter")
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the default service name
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceNameKey.String(defaultServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, errors.Wrap(err, "error getting the resource attributes of traces")
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.WithError(err).Warn("Error exporting traces")
+	}))
+	log.Info("Exporting traces with OTLP")
+
+	return func(ctx context.Context) error {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		return provider.Shutdown(ctx)
+	}, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStartAndEnd(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, root := Start(context.Background(), "root")
+	_, child := Start(ctx, "child")
+	End(child, errors.New("child failed"))
+	End(root, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, root.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "child failed", spans[0].Status.Description)
+
+	assert.Equal(t, "root", spans[1].Name)
+	assert.False(t, spans[1].Parent.IsValid())
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+}
+
+func TestConfigureFromEnvironment(t *testing.T) {
+	requests := make(chan *collectortracepb.ExportTraceServiceRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("api-key"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		req := &collectortracepb.ExportTraceServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, req))
+		requests <- req
+	}))
+	defer server.Close()
+
+	os.Setenv(EndpointEnvVar, server.URL)
+	defer os.Unsetenv(EndpointEnvVar)
+	os.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "api-key=secret")
+	defer os.Unsetenv("OTEL_EXPORTER_OTLP_HEADERS")
+
+	shutdown, err := ConfigureFromEnvironment("velero", logrus.New())
+	require.NoError(t, err)
+
+	_, span := Start(context.Background(), "root")
+	End(span, nil)
+	require.NoError(t, shutdown(context.Background()))
+
+	req := <-requests
+	require.Len(t, req.ResourceSpans, 1)
+	var serviceName string
+	for _, attr := range req.ResourceSpans[0].Resource.Attributes {
+		if attr.Key == "service.name" {
+			serviceName = attr.Value.GetStringValue()
+		}
+	}
+	assert.Equal(t, "velero", serviceName)
+
+	require.Len(t, req.ResourceSpans[0].InstrumentationLibrarySpans, 1)
+	spans := req.ResourceSpans[0].InstrumentationLibrarySpans[0].Spans
+	require.Len(t, spans, 1)
+	assert.Equal(t, "root", spans[0].Name)
+}
+
+func TestConfigureFromEnvironmentWithoutEndpoint(t *testing.T) {
+	shutdown, err := ConfigureFromEnvironment("velero", logrus.New())
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	_, span := Start(context.Background(), "root")
+	assert.False(t, span.IsRecording())
+}
+
+func TestConfigureFromEnvironmentWithUnsupportedProtocol(t *testing.T) {
+	os.Setenv(EndpointEnvVar, "http://localhost:4318")
+	defer os.Unsetenv(EndpointEnvVar)
+	os.Setenv(ProtocolEnvVar, "grpc")
+	defer os.Unsetenv(ProtocolEnvVar)
+
+	_, err := ConfigureFromEnvironment("velero", logrus.New())
+	assert.EqualError(t, err, `unsupported OTLP protocol "grpc", only "http/protobuf" is supported`)
+}
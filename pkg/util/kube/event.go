@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// EventComponent is the source component of the Kubernetes Events recorded by Velero.
+const EventComponent = "velero"
+
+// Reasons of the Kubernetes Events recorded by Velero.
+const (
+	EventReasonPhaseChanged     = "PhaseChanged"
+	EventReasonFailedValidation = "FailedValidation"
+	EventReasonHookFailed       = "HookFailed"
+	EventReasonSnapshotFailed   = "SnapshotFailed"
+	EventReasonBackedUp         = "BackedUp"
+	EventReasonRestored         = "Restored"
+	EventReasonAvailable        = "Available"
+	EventReasonUnavailable      = "Unavailable"
+)
+
+// NewEventRecorder returns an EventRecorder that records events to the cluster of the
+// given client. Events about objects whose types are not registered in scheme can't
+// be recorded. Recording stops when stopCh is closed.
+func NewEventRecorder(kubeClient kubernetes.Interface, scheme *runtime.Scheme, stopCh <-chan struct{}) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	go func() {
+		<-stopCh
+		broadcaster.Shutdown()
+	}()
+
+	return broadcaster.NewRecorder(scheme, corev1api.EventSource{Component: EventComponent})
+}
+
+// NamespaceReference returns a reference to the namespace that can be used to record events
+// about the namespace in the namespace itself, rather than in the default namespace.
+func NamespaceReference(namespace string) *corev1api.ObjectReference {
+	return &corev1api.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Name:       namespace,
+		Namespace:  namespace,
+	}
+}
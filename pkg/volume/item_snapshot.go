@@ -0,0 +1,58 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+// ItemSnapshot stores information about a snapshot of an item taken by
+// an ItemSnapshotter plugin as part of a Velero backup.
+type ItemSnapshot struct {
+	Spec ItemSnapshotSpec `json:"spec"`
+
+	Status ItemSnapshotStatus `json:"status"`
+}
+
+type ItemSnapshotSpec struct {
+	// BackupName is the name of the Velero backup this snapshot
+	// is associated with.
+	BackupName string `json:"backupName"`
+
+	// BackupUID is the UID of the Velero backup this snapshot
+	// is associated with.
+	BackupUID string `json:"backupUID"`
+
+	// ItemSnapshotter is the name of the ItemSnapshotter plugin that
+	// took the snapshot.
+	ItemSnapshotter string `json:"itemSnapshotter"`
+
+	// Resource is the group-qualified resource of the snapshotted item.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace of the snapshotted item, empty for
+	// cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the snapshotted item.
+	Name string `json:"name"`
+}
+
+type ItemSnapshotStatus struct {
+	// SnapshotID is the ID the ItemSnapshotter returned for the snapshot.
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// Metadata is the metadata the ItemSnapshotter returned for the
+	// snapshot, passed back to it when the snapshot is deleted or restored.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}